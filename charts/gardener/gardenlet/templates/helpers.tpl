@@ -223,6 +223,10 @@ config.yaml: |
 {{ toYaml .Values.config.controllers.shootCare.conditionThresholds | indent 6 }}
       {{- end }}
       webhookRemediatorEnabled: {{ required ".Values.config.controllers.shootCare.webhookRemediatorEnabled is required" .Values.config.controllers.shootCare.webhookRemediatorEnabled }}
+      {{- if .Values.config.controllers.shootCare.userActivityFlowSchemas }}
+      userActivityFlowSchemas:
+{{ toYaml .Values.config.controllers.shootCare.userActivityFlowSchemas | indent 6 }}
+      {{- end }}
     seedCare:
       syncPeriod: {{ required ".Values.config.controllers.seedCare.syncPeriod is required" .Values.config.controllers.seedCare.syncPeriod }}
       conditionThresholds:
@@ -234,6 +238,11 @@ config.yaml: |
       concurrentSyncs: {{ required ".Values.config.controllers.shootState.concurrentSyncs is required" .Values.config.controllers.shootState.concurrentSyncs }}
       syncPeriod: {{ required ".Values.config.controllers.shootState.syncPeriod is required" .Values.config.controllers.shootState.syncPeriod }}
     {{- end }}
+    {{- if .Values.config.controllers.shootBackupVerification }}
+    shootBackupVerification:
+      concurrentSyncs: {{ required ".Values.config.controllers.shootBackupVerification.concurrentSyncs is required" .Values.config.controllers.shootBackupVerification.concurrentSyncs }}
+      syncPeriod: {{ required ".Values.config.controllers.shootBackupVerification.syncPeriod is required" .Values.config.controllers.shootBackupVerification.syncPeriod }}
+    {{- end }}
     {{- if .Values.config.controllers.managedSeed }}
     managedSeed:
       concurrentSyncs: {{ required ".Values.config.controllers.managedSeed.concurrentSyncs is required" .Values.config.controllers.managedSeed.concurrentSyncs }}
@@ -325,6 +334,10 @@ config.yaml: |
   nodeToleration:
 {{ toYaml .Values.nodeToleration | indent 4 }}
   {{- end}}
+  {{- if .Values.config.secretsManager }}
+  secretsManager:
+{{ toYaml .Values.config.secretsManager | indent 4 }}
+  {{- end }}
 {{- end -}}
 
 {{- define "gardenlet.config.name" -}}
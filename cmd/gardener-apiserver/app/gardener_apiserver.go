@@ -52,6 +52,8 @@ import (
 	gardencoreclientset "github.com/gardener/gardener/pkg/client/core/clientset/versioned"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	kubernetesclient "github.com/gardener/gardener/pkg/client/kubernetes"
+	operationsclientset "github.com/gardener/gardener/pkg/client/operations/clientset/versioned"
+	operationsinformers "github.com/gardener/gardener/pkg/client/operations/informers/externalversions"
 	seedmanagementclientset "github.com/gardener/gardener/pkg/client/seedmanagement/clientset/versioned"
 	seedmanagementinformers "github.com/gardener/gardener/pkg/client/seedmanagement/informers/externalversions"
 	settingsclientset "github.com/gardener/gardener/pkg/client/settings/clientset/versioned"
@@ -106,6 +108,7 @@ type Options struct {
 	KubeInformerFactory           kubeinformers.SharedInformerFactory
 	SeedManagementInformerFactory seedmanagementinformers.SharedInformerFactory
 	SettingsInformerFactory       settingsinformers.SharedInformerFactory
+	OperationsInformerFactory     operationsinformers.SharedInformerFactory
 
 	Logs *logsv1.LoggingConfiguration
 }
@@ -207,6 +210,13 @@ func (o *Options) config(kubeAPIServerConfig *rest.Config, kubeClient *kubernete
 	}
 	o.SettingsInformerFactory = settingsinformers.NewSharedInformerFactory(settingsClient, protobufLoopbackConfig.Timeout)
 
+	// operations client
+	operationsClient, err := operationsclientset.NewForConfig(&protobufLoopbackConfig)
+	if err != nil {
+		return nil, err
+	}
+	o.OperationsInformerFactory = operationsinformers.NewSharedInformerFactory(operationsClient, protobufLoopbackConfig.Timeout)
+
 	// dynamic client
 	dynamicClient, err := dynamic.NewForConfig(kubeAPIServerConfig)
 	if err != nil {
@@ -222,6 +232,7 @@ func (o *Options) config(kubeAPIServerConfig *rest.Config, kubeClient *kubernete
 				o.SeedManagementInformerFactory,
 				seedManagementClient,
 				o.SettingsInformerFactory,
+				o.OperationsInformerFactory,
 				o.KubeInformerFactory,
 				kubeClient,
 				dynamicClient,
@@ -297,6 +308,7 @@ func (o *Options) Run(ctx context.Context) error {
 		o.KubeInformerFactory.Start(context.StopCh)
 		o.SeedManagementInformerFactory.Start(context.StopCh)
 		o.SettingsInformerFactory.Start(context.StopCh)
+		o.OperationsInformerFactory.Start(context.StopCh)
 		return nil
 	}); err != nil {
 		return err
@@ -416,6 +428,7 @@ func (o *Options) ApplyTo(config *apiserver.Config, kubeClient kubernetes.Interf
 
 	resourceEncodingConfig := serverstorage.NewDefaultResourceEncodingConfig(api.Scheme)
 	resourceEncodingConfig.SetResourceEncoding(operations.Resource("bastions"), operationsv1alpha1.SchemeGroupVersion, operations.SchemeGroupVersion)
+	resourceEncodingConfig.SetResourceEncoding(operations.Resource("bastionpolicies"), operationsv1alpha1.SchemeGroupVersion, operations.SchemeGroupVersion)
 
 	storageFactory := &storage.GardenerStorageFactory{
 		DefaultStorageFactory: serverstorage.NewDefaultStorageFactory(
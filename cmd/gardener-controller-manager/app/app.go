@@ -24,6 +24,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/gardener/gardener/cmd/gardener-controller-manager/app/bootstrappers"
 	"github.com/gardener/gardener/cmd/utils"
@@ -93,6 +94,15 @@ func run(ctx context.Context, log logr.Logger, cfg *config.ControllerManagerConf
 		}
 	}
 
+	var webhookServer webhook.Server
+	if cfg.Server.ShootWakeUp != nil {
+		webhookServer = webhook.NewServer(webhook.Options{
+			Host:    cfg.Server.ShootWakeUp.BindAddress,
+			Port:    cfg.Server.ShootWakeUp.Port,
+			CertDir: cfg.Server.ShootWakeUp.TLS.ServerCertDir,
+		})
+	}
+
 	log.Info("Setting up manager")
 	mgr, err := manager.New(restConfig, manager.Options{
 		Logger:                  log,
@@ -104,6 +114,7 @@ func run(ctx context.Context, log logr.Logger, cfg *config.ControllerManagerConf
 			BindAddress:   net.JoinHostPort(cfg.Server.Metrics.BindAddress, strconv.Itoa(cfg.Server.Metrics.Port)),
 			ExtraHandlers: extraHandlers,
 		},
+		WebhookServer: webhookServer,
 
 		LeaderElection:                cfg.LeaderElection.LeaderElect,
 		LeaderElectionResourceLock:    cfg.LeaderElection.ResourceLock,
@@ -128,6 +139,11 @@ func run(ctx context.Context, log logr.Logger, cfg *config.ControllerManagerConf
 	if err := mgr.AddReadyzCheck("informer-sync", gardenerhealthz.NewCacheSyncHealthz(mgr.GetCache())); err != nil {
 		return err
 	}
+	if cfg.Server.ShootWakeUp != nil {
+		if err := mgr.AddReadyzCheck("webhook-server", mgr.GetWebhookServer().StartedChecker()); err != nil {
+			return err
+		}
+	}
 
 	log.Info("Adding field indexes to informers")
 	if err := addAllFieldIndexes(ctx, mgr.GetFieldIndexer()); err != nil {
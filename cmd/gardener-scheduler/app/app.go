@@ -27,6 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	cmdutils "github.com/gardener/gardener/cmd/utils"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -87,6 +88,15 @@ func run(ctx context.Context, log logr.Logger, cfg *config.SchedulerConfiguratio
 		}
 	}
 
+	var webhookServer webhook.Server
+	if cfg.Server.Explain != nil {
+		webhookServer = webhook.NewServer(webhook.Options{
+			Host:    cfg.Server.Explain.BindAddress,
+			Port:    cfg.Server.Explain.Port,
+			CertDir: cfg.Server.Explain.TLS.ServerCertDir,
+		})
+	}
+
 	log.Info("Setting up manager")
 	mgr, err := manager.New(restCfg, manager.Options{
 		Logger:                  log,
@@ -98,6 +108,7 @@ func run(ctx context.Context, log logr.Logger, cfg *config.SchedulerConfiguratio
 			BindAddress:   net.JoinHostPort(cfg.Server.Metrics.BindAddress, strconv.Itoa(cfg.Server.Metrics.Port)),
 			ExtraHandlers: extraHandlers,
 		},
+		WebhookServer: webhookServer,
 
 		Cache: cache.Options{
 			ByObject: map[client.Object]cache.ByObject{
@@ -129,6 +140,11 @@ func run(ctx context.Context, log logr.Logger, cfg *config.SchedulerConfiguratio
 	if err := mgr.AddHealthzCheck("ping", healthz.Ping); err != nil {
 		return err
 	}
+	if cfg.Server.Explain != nil {
+		if err := mgr.AddReadyzCheck("webhook-server", mgr.GetWebhookServer().StartedChecker()); err != nil {
+			return err
+		}
+	}
 
 	log.Info("Adding controllers to manager")
 	if err := controller.AddToManager(mgr, cfg); err != nil {
@@ -28,6 +28,7 @@ AVAILABLE_CODEGEN_OPTIONS=(
   "shootresourcereservation_groups"
   "shoottolerationrestriction_groups"
   "shootdnsrewriting_groups"
+  "celpolicy_groups"
   "provider_local_groups"
   "extensions_config_groups"
   "nodeagent_groups"
@@ -189,6 +190,14 @@ export -f settings_groups
 operations_groups() {
   echo "Generating API groups for pkg/apis/operations"
 
+  bash "${CODE_GEN_DIR}"/generate-internal-groups.sh \
+    client,deepcopy,informer,lister \
+    github.com/gardener/gardener/pkg/client/operations \
+    "" \
+    github.com/gardener/gardener/pkg/apis \
+    "operations:v1alpha1" \
+    -h "${PROJECT_ROOT}/hack/LICENSE_BOILERPLATE.txt"
+
   bash "${CODE_GEN_DIR}"/generate-internal-groups.sh \
     deepcopy,defaulter \
     github.com/gardener/gardener/pkg/apis \
@@ -444,6 +453,28 @@ shootdnsrewriting_groups() {
 }
 export -f shootdnsrewriting_groups
 
+celpolicy_groups() {
+  echo "Generating API groups for plugin/pkg/global/celpolicy/apis/celpolicy"
+
+  bash "${CODE_GEN_DIR}"/generate-internal-groups.sh \
+    deepcopy,defaulter \
+    github.com/gardener/gardener/pkg/client/componentconfig \
+    github.com/gardener/gardener/plugin/pkg/global/celpolicy/apis \
+    github.com/gardener/gardener/plugin/pkg/global/celpolicy/apis \
+    "celpolicy:v1alpha1" \
+    -h "${PROJECT_ROOT}/hack/LICENSE_BOILERPLATE.txt"
+
+  bash "${CODE_GEN_DIR}"/generate-internal-groups.sh \
+    conversion \
+    github.com/gardener/gardener/pkg/client/componentconfig \
+    github.com/gardener/gardener/plugin/pkg/global/celpolicy/apis \
+    github.com/gardener/gardener/plugin/pkg/global/celpolicy/apis \
+    "celpolicy:v1alpha1" \
+    --extra-peer-dirs=github.com/gardener/gardener/plugin/pkg/global/celpolicy/apis/celpolicy,github.com/gardener/gardener/plugin/pkg/global/celpolicy/apis/celpolicy/v1alpha1,k8s.io/apimachinery/pkg/apis/meta/v1,k8s.io/apimachinery/pkg/conversion,k8s.io/apimachinery/pkg/runtime,k8s.io/component-base/config,k8s.io/component-base/config/v1alpha1 \
+    -h "${PROJECT_ROOT}/hack/LICENSE_BOILERPLATE.txt"
+}
+export -f celpolicy_groups
+
 shootresourcereservation_groups() {
   echo "Generating API groups for plugin/pkg/shoot/resourcereservation/apis/shootresourcereservation"
 
@@ -44,6 +44,21 @@ type BackupEntrySpec struct {
 	BucketName string
 	// SeedName holds the name of the seed to which this BackupEntry is scheduled
 	SeedName *string
+	// RetentionPolicy contains the retention and lifecycle settings for this BackupEntry.
+	RetentionPolicy *BackupRetentionPolicy
+}
+
+// BackupRetentionPolicy contains the retention and lifecycle settings for the etcd backups of Shoots.
+type BackupRetentionPolicy struct {
+	// RetentionAfterDeletion is the duration for which the backup is kept after the deletion of the Shoot. If not set,
+	// the deletion grace period configured for the responsible gardenlet applies.
+	RetentionAfterDeletion *metav1.Duration
+	// LegalHold prevents the deletion of the backup as long as it is set to true, even if the retention period expired
+	// or the deletion was forced.
+	LegalHold *bool
+	// FullSnapshotsToKeep is the number of full snapshots which are kept for the etcd of the Shoot by the garbage
+	// collection of etcd-backup-restore. If not set, older snapshots are garbage collected exponentially.
+	FullSnapshotsToKeep *int32
 }
 
 // BackupEntryStatus holds the most recently observed status of the Backup Entry.
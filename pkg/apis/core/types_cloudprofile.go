@@ -21,6 +21,8 @@ type CloudProfile struct {
 	metav1.ObjectMeta
 	// Spec defines the provider environment properties.
 	Spec CloudProfileSpec
+	// Status contains the current status of the CloudProfile.
+	Status CloudProfileStatus
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -66,6 +68,47 @@ func (c *CloudProfile) GetProviderType() string {
 	return c.Spec.Type
 }
 
+// CloudProfileStatus contains the status of a CloudProfile.
+type CloudProfileStatus struct {
+	// KubernetesVersions contains the state of the staged rollout of the Kubernetes versions across Shoots. It is
+	// maintained by gardener-controller-manager if rollout waves are configured for the shoot maintenance.
+	KubernetesVersions []VersionRolloutStatus
+	// MachineImages contains the state of the staged rollout of the machine image versions across Shoots. It is
+	// maintained by gardener-controller-manager if rollout waves are configured for the shoot maintenance.
+	MachineImages []MachineImageRolloutStatus
+}
+
+// MachineImageRolloutStatus contains the state of the staged rollout of the versions of a machine image.
+type MachineImageRolloutStatus struct {
+	// Name is the name of the machine image.
+	Name string
+	// Versions contains the state of the staged rollout of the versions of the machine image.
+	Versions []VersionRolloutStatus
+}
+
+// VersionRolloutStatus contains the state of the staged rollout of a version across Shoots.
+type VersionRolloutStatus struct {
+	// Version is the version identifier.
+	Version string
+	// Waves are the rollout waves the version was released to, in rollout order.
+	Waves []RolloutWaveStatus
+	// Completed is true if the version was released to all Shoots.
+	Completed bool
+	// Message describes why the rollout of the version is currently paused.
+	Message *string
+}
+
+// RolloutWaveStatus contains the state of the staged rollout of a version in a single rollout wave.
+type RolloutWaveStatus struct {
+	// Name is the name of the rollout wave.
+	Name string
+	// ReleasedAt is the time when the version was released to the rollout wave.
+	ReleasedAt metav1.Time
+	// HealthySince is the time since when no Shoot of this or an earlier rollout wave running the version was reported
+	// to be unhealthy.
+	HealthySince metav1.Time
+}
+
 // SeedSelector contains constraints for selecting seed to be usable for shoots using a profile
 type SeedSelector struct {
 	// LabelSelector is optional and can be used to select seeds by their label settings
@@ -136,6 +179,8 @@ type MachineType struct {
 	Usable *bool
 	// Architecture is the CPU architecture of this machine type.
 	Architecture *string
+	// Price is the price per hour of a machine of this machine type.
+	Price *Price
 }
 
 // MachineTypeStorage is the amount of storage associated with the root volume of this machine type.
@@ -183,6 +228,16 @@ type VolumeType struct {
 	Usable *bool
 	// MinSize is the minimal supported storage size.
 	MinSize *resource.Quantity
+	// Price is the price per GiB and hour of a volume of this volume type.
+	Price *Price
+}
+
+// Price is the price of a machine type or volume type. It is used to estimate the cost of Shoots.
+type Price struct {
+	// Currency is the currency of the price, e.g. "USD". All prices of a CloudProfile must use the same currency.
+	Currency string
+	// Amount is the amount of the price in the given currency.
+	Amount resource.Quantity
 }
 
 const (
@@ -56,6 +56,13 @@ type ProjectSpec struct {
 	Namespace *string
 	// Tolerations contains the default tolerations and a list for allowed taints on seed clusters.
 	Tolerations *ProjectTolerations
+	// BackupRetentionPolicy contains the default retention and lifecycle settings for the etcd backups of all Shoots in
+	// this project.
+	BackupRetentionPolicy *BackupRetentionPolicy
+	// Maintenance contains the maintenance settings for all Shoots in this project.
+	Maintenance *ProjectMaintenance
+	// Hibernation contains the hibernation settings for all Shoots in this project.
+	Hibernation *ProjectHibernation
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -71,6 +78,9 @@ type ProjectStatus struct {
 	StaleAutoDeleteTimestamp *metav1.Time
 	// LastActivityTimestamp contains the timestamp from the last activity performed in this project.
 	LastActivityTimestamp *metav1.Time
+	// EstimatedCosts contains the estimated cost of all Shoots of the project per currency. It is maintained by
+	// gardener-controller-manager.
+	EstimatedCosts []EstimatedCost
 }
 
 // ProjectMember is a member of a project.
@@ -114,6 +124,37 @@ const (
 	ProjectMemberExtensionPrefix = "extension:"
 )
 
+// ProjectMaintenance contains the maintenance settings for all Shoots in a project.
+type ProjectMaintenance struct {
+	// FreezePeriods are periods in which automatic updates of the Kubernetes and machine image versions of the Shoots in
+	// this project are suppressed. Forced updates of expired versions are still performed.
+	FreezePeriods []MaintenanceFreezePeriod
+}
+
+// ProjectHibernation contains the hibernation settings for all Shoots in a project.
+type ProjectHibernation struct {
+	// IdleTimeout is the duration without user activity after which the Shoots in this project are hibernated
+	// automatically. If not set, Shoots are not hibernated because of missing user activity.
+	IdleTimeout *metav1.Duration
+}
+
+// MaintenanceFreezePeriod is a period in which automatic maintenance of Shoots is suppressed. It is either specified
+// as an absolute time range via Begin and End, or as a recurring period via Schedule and Duration.
+type MaintenanceFreezePeriod struct {
+	// Name is the name of the freeze period.
+	Name string
+	// Begin is the beginning of an absolute freeze period.
+	Begin *metav1.Time
+	// End is the end of an absolute freeze period.
+	End *metav1.Time
+	// Schedule is a cron expression (standard format) describing the beginnings of a recurring freeze period.
+	Schedule *string
+	// Duration is the duration of a recurring freeze period.
+	Duration *metav1.Duration
+	// Location is the time zone in which the schedule of a recurring freeze period is evaluated (defaults to UTC).
+	Location *string
+}
+
 // ProjectPhase is a label for the condition of a project at the current time.
 type ProjectPhase string
 
@@ -137,4 +178,8 @@ const (
 	ProjectEventNamespaceDeletionFailed = "NamespaceDeletionFailed"
 	// ProjectEventNamespaceMarkedForDeletion indicates that the namespace has been successfully marked for deletion.
 	ProjectEventNamespaceMarkedForDeletion = "NamespaceMarkedForDeletion"
+	// ProjectEventStaleDeletionScheduled indicates that the project is stale and will be deleted automatically.
+	ProjectEventStaleDeletionScheduled = "StaleProjectDeletionScheduled"
+	// ProjectEventStaleDeleted indicates that the project was stale and has been deleted automatically.
+	ProjectEventStaleDeleted = "StaleProjectDeleted"
 )
@@ -19,6 +19,8 @@ type Quota struct {
 	metav1.ObjectMeta
 	// Spec defines the Quota constraints.
 	Spec QuotaSpec
+	// Status contains the current usage of the Quota.
+	Status QuotaStatus
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -40,6 +42,28 @@ type QuotaSpec struct {
 	Metrics corev1.ResourceList
 	// Scope is the scope of the Quota object, either 'project' or 'secret'. This field is immutable.
 	Scope corev1.ObjectReference
+	// SoftLimits is a list of resources whose usage is checked against the given limits. Exceeding a soft limit does
+	// not reject a request but causes a warning and an event.
+	SoftLimits corev1.ResourceList
+}
+
+// QuotaStatus holds the most recently observed status of the Quota.
+type QuotaStatus struct {
+	// Usage contains the resources allocated by all Shoots referencing the Quota. For Quotas with scope 'project', it is
+	// the total across all projects, see ProjectUsages for the usage per project. It is maintained by
+	// gardener-controller-manager.
+	Usage corev1.ResourceList
+	// ProjectUsages contains the resources allocated by the Shoots referencing the Quota per project. It is only
+	// maintained for Quotas with scope 'project'.
+	ProjectUsages []QuotaProjectUsage
+}
+
+// QuotaProjectUsage contains the resources allocated by the Shoots of a project which reference a Quota.
+type QuotaProjectUsage struct {
+	// Namespace is the namespace of the project.
+	Namespace string
+	// Usage contains the resources allocated by the Shoots in the project.
+	Usage corev1.ResourceList
 }
 
 const (
@@ -55,4 +79,11 @@ const (
 	QuotaMetricStoragePremium corev1.ResourceName = corev1.ResourceStorage + ".premium"
 	// QuotaMetricLoadbalancer is the constraint for the amount of loadbalancers
 	QuotaMetricLoadbalancer corev1.ResourceName = "loadbalancer"
+	// QuotaMetricShoots is the constraint for the amount of Shoots
+	QuotaMetricShoots corev1.ResourceName = "shoots"
+	// QuotaMetricNodes is the constraint for the amount of worker nodes (sum of the worker pools' maximum)
+	QuotaMetricNodes corev1.ResourceName = "nodes"
+	// QuotaMetricMaxSurge is the constraint for the amount of additional worker nodes which may be created during
+	// rolling updates (sum of the worker pools' maxSurge)
+	QuotaMetricMaxSurge corev1.ResourceName = "maxsurge"
 )
@@ -102,6 +102,11 @@ type ShootSpec struct {
 	SchedulerName *string
 	// CloudProfile is a reference to a CloudProfile or a NamespacedCloudProfile.
 	CloudProfile *CloudProfileReference
+	// Blueprint is a reference to a ShootBlueprint the specification of this Shoot is derived from.
+	Blueprint *ShootBlueprintReference
+	// BackupRetentionPolicy contains the retention and lifecycle settings for the etcd backup of this Shoot. If both
+	// the Shoot and its Project configure a retention, the longer one applies.
+	BackupRetentionPolicy *BackupRetentionPolicy
 }
 
 // GetProviderType gets the type of the provider.
@@ -156,6 +161,19 @@ type ShootStatus struct {
 	// Secrets are encrypted by default and are not part of the list.
 	// See https://github.com/gardener/gardener/blob/master/docs/usage/etcd_encryption_config.md for more details.
 	EncryptedResources []string
+	// EstimatedCost is the estimated cost of the Shoot based on the prices of the machine types and volume types defined
+	// in its CloudProfile. It is maintained by gardener-controller-manager.
+	EstimatedCost *EstimatedCost
+}
+
+// EstimatedCost is the estimated cost of a Shoot or of all Shoots of a Project.
+type EstimatedCost struct {
+	// Currency is the currency of the cost.
+	Currency string
+	// Hourly is the current cost per hour.
+	Hourly resource.Quantity
+	// Monthly is the cost per month taking the hibernation schedules into account.
+	Monthly resource.Quantity
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -1316,6 +1334,8 @@ const (
 	ShootEventImageVersionMaintenance = "MachineImageVersionMaintenance"
 	// ShootEventK8sVersionMaintenance indicates that a maintenance operation regarding the K8s version has been performed.
 	ShootEventK8sVersionMaintenance = "KubernetesVersionMaintenance"
+	// ShootEventMaintenanceSkipped indicates that a maintenance operation has been skipped due to a maintenance freeze period.
+	ShootEventMaintenanceSkipped = "MaintenanceSkipped"
 	// ShootEventHibernationEnabled indicates that hibernation started.
 	ShootEventHibernationEnabled = "Hibernated"
 	// ShootEventHibernationDisabled indicates that hibernation ended.
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootBlueprint is a reusable template for Shoots in the same namespace.
+type ShootBlueprint struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// Spec contains the specification of this ShootBlueprint.
+	Spec ShootBlueprintSpec
+	// Status contains the most recently observed status of the ShootBlueprint.
+	Status ShootBlueprintStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootBlueprintList is a collection of ShootBlueprints.
+type ShootBlueprintList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+	// Items is the list of ShootBlueprints.
+	Items []ShootBlueprint
+}
+
+// ShootBlueprintSpec is the specification of a ShootBlueprint.
+type ShootBlueprintSpec struct {
+	// Template describes the Shoot that is derived from this blueprint. Only labels and annotations of its metadata are
+	// taken over into derived Shoots.
+	Template ShootTemplate
+}
+
+// ShootBlueprintStatus holds the most recently observed status of the ShootBlueprint.
+type ShootBlueprintStatus struct {
+	// ObservedGeneration is the most recent generation observed for this ShootBlueprint.
+	ObservedGeneration int64
+	// OutdatedShoots is the list of names of Shoots which are derived from this blueprint but have not yet been
+	// updated to its most recent generation.
+	OutdatedShoots []string
+}
+
+// ShootBlueprintReference is a reference to a ShootBlueprint in the namespace of the Shoot.
+type ShootBlueprintReference struct {
+	// Name is the name of the ShootBlueprint.
+	Name string
+	// Overrides is a strategic merge patch which is applied on top of the blueprint's template.
+	Overrides *runtime.RawExtension
+}
@@ -179,6 +179,9 @@ const (
 	ETCDRoleMain = "main"
 	// ETCDRoleEvents is a constant for the events etcd role.
 	ETCDRoleEvents = "events"
+	// ETCDFullSnapshotsToKeepLimitBased is the number of full snapshots which are kept by etcd-backup-restore if etcd-druid
+	// configures the limit-based garbage collection. etcd-druid does not support configuring a different number.
+	ETCDFullSnapshotsToKeepLimitBased = 7
 	// ETCDMain is a constant for the name of etcd-main Etcd object.
 	ETCDMain = "etcd-" + ETCDRoleMain
 	// ETCDEvents is a constant for the name of etcd-events Etcd object.
@@ -351,6 +354,12 @@ const (
 	// ShootOperationRetry is a constant for an annotation on a Shoot indicating that a failed Shoot reconciliation shall be
 	// retried.
 	ShootOperationRetry = "retry"
+	// ShootOperationApplyBlueprint is a constant for an annotation on a Shoot indicating that the most recent version
+	// of the referenced ShootBlueprint shall be applied to the Shoot.
+	ShootOperationApplyBlueprint = "apply-blueprint"
+	// ShootOperationVerifyBackup is a constant for an annotation on a Shoot indicating that the latest etcd backup of
+	// the Shoot shall be verified by restoring it into a temporary etcd.
+	ShootOperationVerifyBackup = "verify-backup"
 	// OperationRotateCredentialsStart is a constant for an annotation indicating that the rotation of all credentials
 	// shall be started. This includes CAs, certificates, kubeconfigs, SSH keypairs, observability credentials, and
 	// ServiceAccount signing key.
@@ -597,6 +606,13 @@ const (
 	// AnnotationSchedulingCloudProfiles is a constant for an annotation key on a configmap which denotes
 	// the linked cloudprofiles containing the region distances.
 	AnnotationSchedulingCloudProfiles = "scheduling.gardener.cloud/cloudprofiles"
+	// AnnotationSchedulingRebalancing is a constant for an annotation on a Shoot resource whose value must be set to
+	// "true" in order to allow the gardener-scheduler to migrate its control plane away from an overloaded seed.
+	AnnotationSchedulingRebalancing = "scheduling.gardener.cloud/rebalancing"
+	// AnnotationSchedulingRebalancingProposed is a constant for an annotation on a Shoot resource that contains the
+	// most recently proposed migration of its control plane away from an overloaded seed (format
+	// "<source-seed>/<target-seed>"). It is maintained by gardener-scheduler.
+	AnnotationSchedulingRebalancingProposed = "scheduling.gardener.cloud/rebalancing-proposed"
 
 	// AnnotationConfirmationForceDeletion is a constant for an annotation on a Shoot resource whose value must be set to "true" in order to
 	// trigger force-deletion of the cluster. It can only be set if the Shoot has a deletion timestamp and contains an ErrorCode in the Shoot Status.
@@ -633,6 +649,42 @@ const (
 	// Note that changing this value only applies to new nodes. Existing nodes which already computed their individual
 	// delays will not recompute it.
 	AnnotationShootCloudConfigExecutionMaxDelaySeconds = "shoot.gardener.cloud/cloud-config-execution-max-delay-seconds"
+	// AnnotationShootNodeAgentMaxConcurrentDisruptiveUpdates is a key for an annotation on a Shoot resource that
+	// declares the maximum number (e.g. "1") or percentage (e.g. "25%") of nodes per worker pool which may apply
+	// disruptive operating system config changes (i.e., restarts of systemd units) at the same time. If the annotation
+	// is not set, all nodes apply such changes as soon as they observe them.
+	AnnotationShootNodeAgentMaxConcurrentDisruptiveUpdates = "shoot.gardener.cloud/node-agent-max-concurrent-disruptive-updates"
+	// AnnotationShootNodeAgentRollbackUnhealthyThreshold is a key for an annotation on a Shoot resource that declares
+	// the duration (e.g. "5m") for which kubelet or containerd may stay unhealthy after an update of the operating
+	// system config before gardener-node-agent rolls back to the last-known-good operating system config. If the
+	// annotation is not set, no rollback is performed.
+	AnnotationShootNodeAgentRollbackUnhealthyThreshold = "shoot.gardener.cloud/node-agent-rollback-unhealthy-threshold"
+	// AnnotationShootHibernationKeepAwakeUntil is a key for an annotation on a Shoot resource that declares a point in
+	// time (RFC3339 format, e.g. "2024-04-12T18:00:00+02:00") until which the hibernation schedules must not hibernate
+	// the Shoot. Once the time has passed, the most recent hibernation schedule is applied and the annotation is removed.
+	AnnotationShootHibernationKeepAwakeUntil = "shoot.gardener.cloud/hibernation-keep-awake-until"
+	// AnnotationShootHibernationExcludedDates is a key for an annotation on a Shoot resource that declares a
+	// comma-separated list of dates (format "2006-01-02", e.g. public holidays) on which the hibernation schedules are
+	// not executed. The dates are interpreted in the location of the respective hibernation schedule.
+	AnnotationShootHibernationExcludedDates = "shoot.gardener.cloud/hibernation-excluded-dates"
+	// AnnotationShootHibernationWakeUpIdleTimeout is a key for an annotation on a Shoot resource that declares the
+	// duration (e.g. "2h") after which a Shoot which was woken up on demand is hibernated again if no further wake-up
+	// request was received.
+	AnnotationShootHibernationWakeUpIdleTimeout = "shoot.gardener.cloud/hibernation-wake-up-idle-timeout"
+	// AnnotationShootHibernationWakeUpRequestedAt is a key for an annotation on a Shoot resource that contains the time
+	// (RFC3339 format) of the last wake-up request for the Shoot. It is maintained by gardener-controller-manager.
+	AnnotationShootHibernationWakeUpRequestedAt = "shoot.gardener.cloud/hibernation-wake-up-requested-at"
+	// AnnotationShootLastUserActivity is a key for an annotation on a Shoot resource that contains the time (RFC3339
+	// format) of the last observed request of a user to the kube-apiserver of the Shoot. It is maintained by gardenlet
+	// if user activity tracking is enabled for the shoot care controller.
+	AnnotationShootLastUserActivity = "shoot.gardener.cloud/last-user-activity"
+	// AnnotationShootIdleHibernationNotified is a key for an annotation on a Shoot resource that contains the time
+	// (RFC3339 format) of the pending idle hibernation which was most recently announced via an event. It is maintained
+	// by gardener-controller-manager.
+	AnnotationShootIdleHibernationNotified = "shoot.gardener.cloud/idle-hibernation-notified"
+	// AnnotationShootBlueprintGeneration is a key for an annotation on a Shoot resource that contains the generation of
+	// the referenced ShootBlueprint which was most recently applied to the Shoot. It is maintained by gardener-apiserver.
+	AnnotationShootBlueprintGeneration = "shoot.gardener.cloud/blueprint-generation"
 	// AnnotationCoreDNSRewritingDisabled disables core dns query rewriting even if the corresponding feature gate is enabled.
 	AnnotationCoreDNSRewritingDisabled = "alpha.featuregates.shoot.gardener.cloud/core-dns-rewriting-disabled"
 
@@ -702,6 +754,8 @@ const (
 	// EventResourceReferenced indicates that the resource deletion is in waiting mode because the resource is still
 	// being referenced by at least one other resource (e.g. a SecretBinding is still referenced by a Shoot)
 	EventResourceReferenced = "ResourceReferenced"
+	// EventQuotaSoftLimitExceeded indicates that the usage of a quota exceeds at least one of its soft limits.
+	EventQuotaSoftLimitExceeded = "QuotaSoftLimitExceeded"
 
 	// ReferencedResourcesPrefix is the prefix used when copying referenced resources to the Shoot namespace in the Seed,
 	// to avoid naming collisions with resources managed by Gardener.
@@ -743,6 +797,17 @@ const (
 	// skipped by the stale project controller. If the project has already configured stale timestamps in its status
 	// then they will be reset.
 	ProjectSkipStaleCheck = "project.gardener.cloud/skip-stale-check"
+	// ProjectStaleSnoozeUntil is the key of an annotation on a project whose value holds a timestamp (RFC3339) until
+	// which the project is not considered stale by the stale project controller. Project owners can use it to prevent
+	// the automatic deletion of projects they still need.
+	ProjectStaleSnoozeUntil = "project.gardener.cloud/stale-snooze-until"
+	// ProjectStaleNotifiedDaysBeforeDeletion is the key of an annotation on a project whose value holds the number of
+	// days before the automatic deletion for which the owner of the stale project was notified last. It is maintained by
+	// the stale project controller.
+	ProjectStaleNotifiedDaysBeforeDeletion = "project.gardener.cloud/stale-notified-days-before-deletion"
+	// LabelProjectDeletionAudit is the key of a label on config maps in the garden namespace which record the automatic
+	// deletion of stale projects.
+	LabelProjectDeletionAudit = "project.gardener.cloud/deletion-audit"
 	// NamespaceProject is the key of an annotation on namespace whose value holds the project uid.
 	NamespaceProject = "namespace.gardener.cloud/project"
 	// NamespaceKeepAfterProjectDeletion is a constant for an annotation on a `Namespace` resource that states that it
@@ -545,10 +545,38 @@ func (m *BackupEntryStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BackupEntryStatus proto.InternalMessageInfo
 
+func (m *BackupRetentionPolicy) Reset()      { *m = BackupRetentionPolicy{} }
+func (*BackupRetentionPolicy) ProtoMessage() {}
+func (*BackupRetentionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{18}
+}
+func (m *BackupRetentionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupRetentionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BackupRetentionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupRetentionPolicy.Merge(m, src)
+}
+func (m *BackupRetentionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupRetentionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupRetentionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupRetentionPolicy proto.InternalMessageInfo
+
 func (m *CARotation) Reset()      { *m = CARotation{} }
 func (*CARotation) ProtoMessage() {}
 func (*CARotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{18}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{19}
 }
 func (m *CARotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -576,7 +604,7 @@ var xxx_messageInfo_CARotation proto.InternalMessageInfo
 func (m *CRI) Reset()      { *m = CRI{} }
 func (*CRI) ProtoMessage() {}
 func (*CRI) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{19}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{20}
 }
 func (m *CRI) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -604,7 +632,7 @@ var xxx_messageInfo_CRI proto.InternalMessageInfo
 func (m *CloudProfile) Reset()      { *m = CloudProfile{} }
 func (*CloudProfile) ProtoMessage() {}
 func (*CloudProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{20}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{21}
 }
 func (m *CloudProfile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -632,7 +660,7 @@ var xxx_messageInfo_CloudProfile proto.InternalMessageInfo
 func (m *CloudProfileList) Reset()      { *m = CloudProfileList{} }
 func (*CloudProfileList) ProtoMessage() {}
 func (*CloudProfileList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{21}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{22}
 }
 func (m *CloudProfileList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -660,7 +688,7 @@ var xxx_messageInfo_CloudProfileList proto.InternalMessageInfo
 func (m *CloudProfileReference) Reset()      { *m = CloudProfileReference{} }
 func (*CloudProfileReference) ProtoMessage() {}
 func (*CloudProfileReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{22}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{23}
 }
 func (m *CloudProfileReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,7 +716,7 @@ var xxx_messageInfo_CloudProfileReference proto.InternalMessageInfo
 func (m *CloudProfileSpec) Reset()      { *m = CloudProfileSpec{} }
 func (*CloudProfileSpec) ProtoMessage() {}
 func (*CloudProfileSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{23}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{24}
 }
 func (m *CloudProfileSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -713,10 +741,38 @@ func (m *CloudProfileSpec) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CloudProfileSpec proto.InternalMessageInfo
 
+func (m *CloudProfileStatus) Reset()      { *m = CloudProfileStatus{} }
+func (*CloudProfileStatus) ProtoMessage() {}
+func (*CloudProfileStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{25}
+}
+func (m *CloudProfileStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CloudProfileStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CloudProfileStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloudProfileStatus.Merge(m, src)
+}
+func (m *CloudProfileStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *CloudProfileStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloudProfileStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloudProfileStatus proto.InternalMessageInfo
+
 func (m *ClusterAutoscaler) Reset()      { *m = ClusterAutoscaler{} }
 func (*ClusterAutoscaler) ProtoMessage() {}
 func (*ClusterAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{24}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{26}
 }
 func (m *ClusterAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -744,7 +800,7 @@ var xxx_messageInfo_ClusterAutoscaler proto.InternalMessageInfo
 func (m *ClusterAutoscalerOptions) Reset()      { *m = ClusterAutoscalerOptions{} }
 func (*ClusterAutoscalerOptions) ProtoMessage() {}
 func (*ClusterAutoscalerOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{25}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{27}
 }
 func (m *ClusterAutoscalerOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -772,7 +828,7 @@ var xxx_messageInfo_ClusterAutoscalerOptions proto.InternalMessageInfo
 func (m *Condition) Reset()      { *m = Condition{} }
 func (*Condition) ProtoMessage() {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{26}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{28}
 }
 func (m *Condition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -800,7 +856,7 @@ var xxx_messageInfo_Condition proto.InternalMessageInfo
 func (m *ContainerRuntime) Reset()      { *m = ContainerRuntime{} }
 func (*ContainerRuntime) ProtoMessage() {}
 func (*ContainerRuntime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{27}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{29}
 }
 func (m *ContainerRuntime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,7 +884,7 @@ var xxx_messageInfo_ContainerRuntime proto.InternalMessageInfo
 func (m *ControlPlane) Reset()      { *m = ControlPlane{} }
 func (*ControlPlane) ProtoMessage() {}
 func (*ControlPlane) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{28}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{30}
 }
 func (m *ControlPlane) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -856,7 +912,7 @@ var xxx_messageInfo_ControlPlane proto.InternalMessageInfo
 func (m *ControllerDeployment) Reset()      { *m = ControllerDeployment{} }
 func (*ControllerDeployment) ProtoMessage() {}
 func (*ControllerDeployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{29}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{31}
 }
 func (m *ControllerDeployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -884,7 +940,7 @@ var xxx_messageInfo_ControllerDeployment proto.InternalMessageInfo
 func (m *ControllerDeploymentList) Reset()      { *m = ControllerDeploymentList{} }
 func (*ControllerDeploymentList) ProtoMessage() {}
 func (*ControllerDeploymentList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{30}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{32}
 }
 func (m *ControllerDeploymentList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -912,7 +968,7 @@ var xxx_messageInfo_ControllerDeploymentList proto.InternalMessageInfo
 func (m *ControllerInstallation) Reset()      { *m = ControllerInstallation{} }
 func (*ControllerInstallation) ProtoMessage() {}
 func (*ControllerInstallation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{31}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{33}
 }
 func (m *ControllerInstallation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -940,7 +996,7 @@ var xxx_messageInfo_ControllerInstallation proto.InternalMessageInfo
 func (m *ControllerInstallationList) Reset()      { *m = ControllerInstallationList{} }
 func (*ControllerInstallationList) ProtoMessage() {}
 func (*ControllerInstallationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{32}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{34}
 }
 func (m *ControllerInstallationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -968,7 +1024,7 @@ var xxx_messageInfo_ControllerInstallationList proto.InternalMessageInfo
 func (m *ControllerInstallationSpec) Reset()      { *m = ControllerInstallationSpec{} }
 func (*ControllerInstallationSpec) ProtoMessage() {}
 func (*ControllerInstallationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{33}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{35}
 }
 func (m *ControllerInstallationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -996,7 +1052,7 @@ var xxx_messageInfo_ControllerInstallationSpec proto.InternalMessageInfo
 func (m *ControllerInstallationStatus) Reset()      { *m = ControllerInstallationStatus{} }
 func (*ControllerInstallationStatus) ProtoMessage() {}
 func (*ControllerInstallationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{34}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{36}
 }
 func (m *ControllerInstallationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1024,7 +1080,7 @@ var xxx_messageInfo_ControllerInstallationStatus proto.InternalMessageInfo
 func (m *ControllerRegistration) Reset()      { *m = ControllerRegistration{} }
 func (*ControllerRegistration) ProtoMessage() {}
 func (*ControllerRegistration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{35}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{37}
 }
 func (m *ControllerRegistration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1052,7 +1108,7 @@ var xxx_messageInfo_ControllerRegistration proto.InternalMessageInfo
 func (m *ControllerRegistrationDeployment) Reset()      { *m = ControllerRegistrationDeployment{} }
 func (*ControllerRegistrationDeployment) ProtoMessage() {}
 func (*ControllerRegistrationDeployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{36}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{38}
 }
 func (m *ControllerRegistrationDeployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1080,7 +1136,7 @@ var xxx_messageInfo_ControllerRegistrationDeployment proto.InternalMessageInfo
 func (m *ControllerRegistrationList) Reset()      { *m = ControllerRegistrationList{} }
 func (*ControllerRegistrationList) ProtoMessage() {}
 func (*ControllerRegistrationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{37}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{39}
 }
 func (m *ControllerRegistrationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1108,7 +1164,7 @@ var xxx_messageInfo_ControllerRegistrationList proto.InternalMessageInfo
 func (m *ControllerRegistrationSpec) Reset()      { *m = ControllerRegistrationSpec{} }
 func (*ControllerRegistrationSpec) ProtoMessage() {}
 func (*ControllerRegistrationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{38}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{40}
 }
 func (m *ControllerRegistrationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1136,7 +1192,7 @@ var xxx_messageInfo_ControllerRegistrationSpec proto.InternalMessageInfo
 func (m *ControllerResource) Reset()      { *m = ControllerResource{} }
 func (*ControllerResource) ProtoMessage() {}
 func (*ControllerResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{39}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{41}
 }
 func (m *ControllerResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1164,7 +1220,7 @@ var xxx_messageInfo_ControllerResource proto.InternalMessageInfo
 func (m *ControllerResourceLifecycle) Reset()      { *m = ControllerResourceLifecycle{} }
 func (*ControllerResourceLifecycle) ProtoMessage() {}
 func (*ControllerResourceLifecycle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{40}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{42}
 }
 func (m *ControllerResourceLifecycle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1192,7 +1248,7 @@ var xxx_messageInfo_ControllerResourceLifecycle proto.InternalMessageInfo
 func (m *CoreDNS) Reset()      { *m = CoreDNS{} }
 func (*CoreDNS) ProtoMessage() {}
 func (*CoreDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{41}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{43}
 }
 func (m *CoreDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1220,7 +1276,7 @@ var xxx_messageInfo_CoreDNS proto.InternalMessageInfo
 func (m *CoreDNSAutoscaling) Reset()      { *m = CoreDNSAutoscaling{} }
 func (*CoreDNSAutoscaling) ProtoMessage() {}
 func (*CoreDNSAutoscaling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{42}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{44}
 }
 func (m *CoreDNSAutoscaling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1248,7 +1304,7 @@ var xxx_messageInfo_CoreDNSAutoscaling proto.InternalMessageInfo
 func (m *CoreDNSRewriting) Reset()      { *m = CoreDNSRewriting{} }
 func (*CoreDNSRewriting) ProtoMessage() {}
 func (*CoreDNSRewriting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{43}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{45}
 }
 func (m *CoreDNSRewriting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1276,7 +1332,7 @@ var xxx_messageInfo_CoreDNSRewriting proto.InternalMessageInfo
 func (m *DNS) Reset()      { *m = DNS{} }
 func (*DNS) ProtoMessage() {}
 func (*DNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{44}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{46}
 }
 func (m *DNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1304,7 +1360,7 @@ var xxx_messageInfo_DNS proto.InternalMessageInfo
 func (m *DNSIncludeExclude) Reset()      { *m = DNSIncludeExclude{} }
 func (*DNSIncludeExclude) ProtoMessage() {}
 func (*DNSIncludeExclude) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{45}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{47}
 }
 func (m *DNSIncludeExclude) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1332,7 +1388,7 @@ var xxx_messageInfo_DNSIncludeExclude proto.InternalMessageInfo
 func (m *DNSProvider) Reset()      { *m = DNSProvider{} }
 func (*DNSProvider) ProtoMessage() {}
 func (*DNSProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{46}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{48}
 }
 func (m *DNSProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1360,7 +1416,7 @@ var xxx_messageInfo_DNSProvider proto.InternalMessageInfo
 func (m *DataVolume) Reset()      { *m = DataVolume{} }
 func (*DataVolume) ProtoMessage() {}
 func (*DataVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{47}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{49}
 }
 func (m *DataVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1388,7 +1444,7 @@ var xxx_messageInfo_DataVolume proto.InternalMessageInfo
 func (m *DeploymentRef) Reset()      { *m = DeploymentRef{} }
 func (*DeploymentRef) ProtoMessage() {}
 func (*DeploymentRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{48}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{50}
 }
 func (m *DeploymentRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1416,7 +1472,7 @@ var xxx_messageInfo_DeploymentRef proto.InternalMessageInfo
 func (m *ETCDEncryptionKeyRotation) Reset()      { *m = ETCDEncryptionKeyRotation{} }
 func (*ETCDEncryptionKeyRotation) ProtoMessage() {}
 func (*ETCDEncryptionKeyRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{49}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{51}
 }
 func (m *ETCDEncryptionKeyRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1444,7 +1500,7 @@ var xxx_messageInfo_ETCDEncryptionKeyRotation proto.InternalMessageInfo
 func (m *EncryptionConfig) Reset()      { *m = EncryptionConfig{} }
 func (*EncryptionConfig) ProtoMessage() {}
 func (*EncryptionConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{50}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{52}
 }
 func (m *EncryptionConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1469,10 +1525,38 @@ func (m *EncryptionConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EncryptionConfig proto.InternalMessageInfo
 
+func (m *EstimatedCost) Reset()      { *m = EstimatedCost{} }
+func (*EstimatedCost) ProtoMessage() {}
+func (*EstimatedCost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{53}
+}
+func (m *EstimatedCost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimatedCost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EstimatedCost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimatedCost.Merge(m, src)
+}
+func (m *EstimatedCost) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimatedCost) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimatedCost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimatedCost proto.InternalMessageInfo
+
 func (m *ExpirableVersion) Reset()      { *m = ExpirableVersion{} }
 func (*ExpirableVersion) ProtoMessage() {}
 func (*ExpirableVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{51}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{54}
 }
 func (m *ExpirableVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1500,7 +1584,7 @@ var xxx_messageInfo_ExpirableVersion proto.InternalMessageInfo
 func (m *ExposureClass) Reset()      { *m = ExposureClass{} }
 func (*ExposureClass) ProtoMessage() {}
 func (*ExposureClass) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{52}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{55}
 }
 func (m *ExposureClass) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1528,7 +1612,7 @@ var xxx_messageInfo_ExposureClass proto.InternalMessageInfo
 func (m *ExposureClassList) Reset()      { *m = ExposureClassList{} }
 func (*ExposureClassList) ProtoMessage() {}
 func (*ExposureClassList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{53}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{56}
 }
 func (m *ExposureClassList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1556,7 +1640,7 @@ var xxx_messageInfo_ExposureClassList proto.InternalMessageInfo
 func (m *ExposureClassScheduling) Reset()      { *m = ExposureClassScheduling{} }
 func (*ExposureClassScheduling) ProtoMessage() {}
 func (*ExposureClassScheduling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{54}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{57}
 }
 func (m *ExposureClassScheduling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1584,7 +1668,7 @@ var xxx_messageInfo_ExposureClassScheduling proto.InternalMessageInfo
 func (m *Extension) Reset()      { *m = Extension{} }
 func (*Extension) ProtoMessage() {}
 func (*Extension) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{55}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{58}
 }
 func (m *Extension) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1612,7 +1696,7 @@ var xxx_messageInfo_Extension proto.InternalMessageInfo
 func (m *ExtensionResourceState) Reset()      { *m = ExtensionResourceState{} }
 func (*ExtensionResourceState) ProtoMessage() {}
 func (*ExtensionResourceState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{56}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{59}
 }
 func (m *ExtensionResourceState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1640,7 +1724,7 @@ var xxx_messageInfo_ExtensionResourceState proto.InternalMessageInfo
 func (m *FailureTolerance) Reset()      { *m = FailureTolerance{} }
 func (*FailureTolerance) ProtoMessage() {}
 func (*FailureTolerance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{57}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{60}
 }
 func (m *FailureTolerance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1668,7 +1752,7 @@ var xxx_messageInfo_FailureTolerance proto.InternalMessageInfo
 func (m *Gardener) Reset()      { *m = Gardener{} }
 func (*Gardener) ProtoMessage() {}
 func (*Gardener) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{58}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{61}
 }
 func (m *Gardener) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1696,7 +1780,7 @@ var xxx_messageInfo_Gardener proto.InternalMessageInfo
 func (m *GardenerResourceData) Reset()      { *m = GardenerResourceData{} }
 func (*GardenerResourceData) ProtoMessage() {}
 func (*GardenerResourceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{59}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{62}
 }
 func (m *GardenerResourceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1724,7 +1808,7 @@ var xxx_messageInfo_GardenerResourceData proto.InternalMessageInfo
 func (m *Hibernation) Reset()      { *m = Hibernation{} }
 func (*Hibernation) ProtoMessage() {}
 func (*Hibernation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{60}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{63}
 }
 func (m *Hibernation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1752,7 +1836,7 @@ var xxx_messageInfo_Hibernation proto.InternalMessageInfo
 func (m *HibernationSchedule) Reset()      { *m = HibernationSchedule{} }
 func (*HibernationSchedule) ProtoMessage() {}
 func (*HibernationSchedule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{61}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{64}
 }
 func (m *HibernationSchedule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1780,7 +1864,7 @@ var xxx_messageInfo_HibernationSchedule proto.InternalMessageInfo
 func (m *HighAvailability) Reset()      { *m = HighAvailability{} }
 func (*HighAvailability) ProtoMessage() {}
 func (*HighAvailability) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{62}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{65}
 }
 func (m *HighAvailability) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1808,7 +1892,7 @@ var xxx_messageInfo_HighAvailability proto.InternalMessageInfo
 func (m *HorizontalPodAutoscalerConfig) Reset()      { *m = HorizontalPodAutoscalerConfig{} }
 func (*HorizontalPodAutoscalerConfig) ProtoMessage() {}
 func (*HorizontalPodAutoscalerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{63}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{66}
 }
 func (m *HorizontalPodAutoscalerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1836,7 +1920,7 @@ var xxx_messageInfo_HorizontalPodAutoscalerConfig proto.InternalMessageInfo
 func (m *Ingress) Reset()      { *m = Ingress{} }
 func (*Ingress) ProtoMessage() {}
 func (*Ingress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{64}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{67}
 }
 func (m *Ingress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1864,7 +1948,7 @@ var xxx_messageInfo_Ingress proto.InternalMessageInfo
 func (m *IngressController) Reset()      { *m = IngressController{} }
 func (*IngressController) ProtoMessage() {}
 func (*IngressController) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{65}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{68}
 }
 func (m *IngressController) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1892,7 +1976,7 @@ var xxx_messageInfo_IngressController proto.InternalMessageInfo
 func (m *InternalSecret) Reset()      { *m = InternalSecret{} }
 func (*InternalSecret) ProtoMessage() {}
 func (*InternalSecret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{66}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{69}
 }
 func (m *InternalSecret) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1920,7 +2004,7 @@ var xxx_messageInfo_InternalSecret proto.InternalMessageInfo
 func (m *InternalSecretList) Reset()      { *m = InternalSecretList{} }
 func (*InternalSecretList) ProtoMessage() {}
 func (*InternalSecretList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{67}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{70}
 }
 func (m *InternalSecretList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1948,7 +2032,7 @@ var xxx_messageInfo_InternalSecretList proto.InternalMessageInfo
 func (m *KubeAPIServerConfig) Reset()      { *m = KubeAPIServerConfig{} }
 func (*KubeAPIServerConfig) ProtoMessage() {}
 func (*KubeAPIServerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{68}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{71}
 }
 func (m *KubeAPIServerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1976,7 +2060,7 @@ var xxx_messageInfo_KubeAPIServerConfig proto.InternalMessageInfo
 func (m *KubeControllerManagerConfig) Reset()      { *m = KubeControllerManagerConfig{} }
 func (*KubeControllerManagerConfig) ProtoMessage() {}
 func (*KubeControllerManagerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{69}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{72}
 }
 func (m *KubeControllerManagerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2004,7 +2088,7 @@ var xxx_messageInfo_KubeControllerManagerConfig proto.InternalMessageInfo
 func (m *KubeProxyConfig) Reset()      { *m = KubeProxyConfig{} }
 func (*KubeProxyConfig) ProtoMessage() {}
 func (*KubeProxyConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{70}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{73}
 }
 func (m *KubeProxyConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2032,7 +2116,7 @@ var xxx_messageInfo_KubeProxyConfig proto.InternalMessageInfo
 func (m *KubeSchedulerConfig) Reset()      { *m = KubeSchedulerConfig{} }
 func (*KubeSchedulerConfig) ProtoMessage() {}
 func (*KubeSchedulerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{71}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{74}
 }
 func (m *KubeSchedulerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2060,7 +2144,7 @@ var xxx_messageInfo_KubeSchedulerConfig proto.InternalMessageInfo
 func (m *KubeletConfig) Reset()      { *m = KubeletConfig{} }
 func (*KubeletConfig) ProtoMessage() {}
 func (*KubeletConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{72}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{75}
 }
 func (m *KubeletConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2088,7 +2172,7 @@ var xxx_messageInfo_KubeletConfig proto.InternalMessageInfo
 func (m *KubeletConfigEviction) Reset()      { *m = KubeletConfigEviction{} }
 func (*KubeletConfigEviction) ProtoMessage() {}
 func (*KubeletConfigEviction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{73}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{76}
 }
 func (m *KubeletConfigEviction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2116,7 +2200,7 @@ var xxx_messageInfo_KubeletConfigEviction proto.InternalMessageInfo
 func (m *KubeletConfigEvictionMinimumReclaim) Reset()      { *m = KubeletConfigEvictionMinimumReclaim{} }
 func (*KubeletConfigEvictionMinimumReclaim) ProtoMessage() {}
 func (*KubeletConfigEvictionMinimumReclaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{74}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{77}
 }
 func (m *KubeletConfigEvictionMinimumReclaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2144,7 +2228,7 @@ var xxx_messageInfo_KubeletConfigEvictionMinimumReclaim proto.InternalMessageInf
 func (m *KubeletConfigEvictionSoftGracePeriod) Reset()      { *m = KubeletConfigEvictionSoftGracePeriod{} }
 func (*KubeletConfigEvictionSoftGracePeriod) ProtoMessage() {}
 func (*KubeletConfigEvictionSoftGracePeriod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{75}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{78}
 }
 func (m *KubeletConfigEvictionSoftGracePeriod) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2172,7 +2256,7 @@ var xxx_messageInfo_KubeletConfigEvictionSoftGracePeriod proto.InternalMessageIn
 func (m *KubeletConfigReserved) Reset()      { *m = KubeletConfigReserved{} }
 func (*KubeletConfigReserved) ProtoMessage() {}
 func (*KubeletConfigReserved) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{76}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{79}
 }
 func (m *KubeletConfigReserved) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2200,7 +2284,7 @@ var xxx_messageInfo_KubeletConfigReserved proto.InternalMessageInfo
 func (m *Kubernetes) Reset()      { *m = Kubernetes{} }
 func (*Kubernetes) ProtoMessage() {}
 func (*Kubernetes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{77}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{80}
 }
 func (m *Kubernetes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2228,7 +2312,7 @@ var xxx_messageInfo_Kubernetes proto.InternalMessageInfo
 func (m *KubernetesConfig) Reset()      { *m = KubernetesConfig{} }
 func (*KubernetesConfig) ProtoMessage() {}
 func (*KubernetesConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{78}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{81}
 }
 func (m *KubernetesConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2256,7 +2340,7 @@ var xxx_messageInfo_KubernetesConfig proto.InternalMessageInfo
 func (m *KubernetesDashboard) Reset()      { *m = KubernetesDashboard{} }
 func (*KubernetesDashboard) ProtoMessage() {}
 func (*KubernetesDashboard) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{79}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{82}
 }
 func (m *KubernetesDashboard) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2284,7 +2368,7 @@ var xxx_messageInfo_KubernetesDashboard proto.InternalMessageInfo
 func (m *KubernetesSettings) Reset()      { *m = KubernetesSettings{} }
 func (*KubernetesSettings) ProtoMessage() {}
 func (*KubernetesSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{80}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{83}
 }
 func (m *KubernetesSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2312,7 +2396,7 @@ var xxx_messageInfo_KubernetesSettings proto.InternalMessageInfo
 func (m *LastError) Reset()      { *m = LastError{} }
 func (*LastError) ProtoMessage() {}
 func (*LastError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{81}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{84}
 }
 func (m *LastError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2340,7 +2424,7 @@ var xxx_messageInfo_LastError proto.InternalMessageInfo
 func (m *LastMaintenance) Reset()      { *m = LastMaintenance{} }
 func (*LastMaintenance) ProtoMessage() {}
 func (*LastMaintenance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{82}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{85}
 }
 func (m *LastMaintenance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2368,7 +2452,7 @@ var xxx_messageInfo_LastMaintenance proto.InternalMessageInfo
 func (m *LastOperation) Reset()      { *m = LastOperation{} }
 func (*LastOperation) ProtoMessage() {}
 func (*LastOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{83}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{86}
 }
 func (m *LastOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2396,7 +2480,7 @@ var xxx_messageInfo_LastOperation proto.InternalMessageInfo
 func (m *Machine) Reset()      { *m = Machine{} }
 func (*Machine) ProtoMessage() {}
 func (*Machine) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{84}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{87}
 }
 func (m *Machine) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2424,7 +2508,7 @@ var xxx_messageInfo_Machine proto.InternalMessageInfo
 func (m *MachineControllerManagerSettings) Reset()      { *m = MachineControllerManagerSettings{} }
 func (*MachineControllerManagerSettings) ProtoMessage() {}
 func (*MachineControllerManagerSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{85}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{88}
 }
 func (m *MachineControllerManagerSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2452,7 +2536,7 @@ var xxx_messageInfo_MachineControllerManagerSettings proto.InternalMessageInfo
 func (m *MachineImage) Reset()      { *m = MachineImage{} }
 func (*MachineImage) ProtoMessage() {}
 func (*MachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{86}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{89}
 }
 func (m *MachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2477,10 +2561,38 @@ func (m *MachineImage) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MachineImage proto.InternalMessageInfo
 
+func (m *MachineImageRolloutStatus) Reset()      { *m = MachineImageRolloutStatus{} }
+func (*MachineImageRolloutStatus) ProtoMessage() {}
+func (*MachineImageRolloutStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{90}
+}
+func (m *MachineImageRolloutStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MachineImageRolloutStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MachineImageRolloutStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MachineImageRolloutStatus.Merge(m, src)
+}
+func (m *MachineImageRolloutStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MachineImageRolloutStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MachineImageRolloutStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MachineImageRolloutStatus proto.InternalMessageInfo
+
 func (m *MachineImageVersion) Reset()      { *m = MachineImageVersion{} }
 func (*MachineImageVersion) ProtoMessage() {}
 func (*MachineImageVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{87}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{91}
 }
 func (m *MachineImageVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2508,7 +2620,7 @@ var xxx_messageInfo_MachineImageVersion proto.InternalMessageInfo
 func (m *MachineType) Reset()      { *m = MachineType{} }
 func (*MachineType) ProtoMessage() {}
 func (*MachineType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{88}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{92}
 }
 func (m *MachineType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2536,7 +2648,7 @@ var xxx_messageInfo_MachineType proto.InternalMessageInfo
 func (m *MachineTypeStorage) Reset()      { *m = MachineTypeStorage{} }
 func (*MachineTypeStorage) ProtoMessage() {}
 func (*MachineTypeStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{89}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{93}
 }
 func (m *MachineTypeStorage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2564,7 +2676,7 @@ var xxx_messageInfo_MachineTypeStorage proto.InternalMessageInfo
 func (m *Maintenance) Reset()      { *m = Maintenance{} }
 func (*Maintenance) ProtoMessage() {}
 func (*Maintenance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{90}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{94}
 }
 func (m *Maintenance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2592,7 +2704,7 @@ var xxx_messageInfo_Maintenance proto.InternalMessageInfo
 func (m *MaintenanceAutoUpdate) Reset()      { *m = MaintenanceAutoUpdate{} }
 func (*MaintenanceAutoUpdate) ProtoMessage() {}
 func (*MaintenanceAutoUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{91}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{95}
 }
 func (m *MaintenanceAutoUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2617,10 +2729,38 @@ func (m *MaintenanceAutoUpdate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MaintenanceAutoUpdate proto.InternalMessageInfo
 
+func (m *MaintenanceFreezePeriod) Reset()      { *m = MaintenanceFreezePeriod{} }
+func (*MaintenanceFreezePeriod) ProtoMessage() {}
+func (*MaintenanceFreezePeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{96}
+}
+func (m *MaintenanceFreezePeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceFreezePeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MaintenanceFreezePeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceFreezePeriod.Merge(m, src)
+}
+func (m *MaintenanceFreezePeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceFreezePeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceFreezePeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceFreezePeriod proto.InternalMessageInfo
+
 func (m *MaintenanceTimeWindow) Reset()      { *m = MaintenanceTimeWindow{} }
 func (*MaintenanceTimeWindow) ProtoMessage() {}
 func (*MaintenanceTimeWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{92}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{97}
 }
 func (m *MaintenanceTimeWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2648,7 +2788,7 @@ var xxx_messageInfo_MaintenanceTimeWindow proto.InternalMessageInfo
 func (m *MemorySwapConfiguration) Reset()      { *m = MemorySwapConfiguration{} }
 func (*MemorySwapConfiguration) ProtoMessage() {}
 func (*MemorySwapConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{93}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{98}
 }
 func (m *MemorySwapConfiguration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2676,7 +2816,7 @@ var xxx_messageInfo_MemorySwapConfiguration proto.InternalMessageInfo
 func (m *Monitoring) Reset()      { *m = Monitoring{} }
 func (*Monitoring) ProtoMessage() {}
 func (*Monitoring) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{94}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{99}
 }
 func (m *Monitoring) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2704,7 +2844,7 @@ var xxx_messageInfo_Monitoring proto.InternalMessageInfo
 func (m *NamedResourceReference) Reset()      { *m = NamedResourceReference{} }
 func (*NamedResourceReference) ProtoMessage() {}
 func (*NamedResourceReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{95}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{100}
 }
 func (m *NamedResourceReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2732,7 +2872,7 @@ var xxx_messageInfo_NamedResourceReference proto.InternalMessageInfo
 func (m *NamespacedCloudProfile) Reset()      { *m = NamespacedCloudProfile{} }
 func (*NamespacedCloudProfile) ProtoMessage() {}
 func (*NamespacedCloudProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{96}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{101}
 }
 func (m *NamespacedCloudProfile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2760,7 +2900,7 @@ var xxx_messageInfo_NamespacedCloudProfile proto.InternalMessageInfo
 func (m *NamespacedCloudProfileList) Reset()      { *m = NamespacedCloudProfileList{} }
 func (*NamespacedCloudProfileList) ProtoMessage() {}
 func (*NamespacedCloudProfileList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{97}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{102}
 }
 func (m *NamespacedCloudProfileList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2788,7 +2928,7 @@ var xxx_messageInfo_NamespacedCloudProfileList proto.InternalMessageInfo
 func (m *NamespacedCloudProfileSpec) Reset()      { *m = NamespacedCloudProfileSpec{} }
 func (*NamespacedCloudProfileSpec) ProtoMessage() {}
 func (*NamespacedCloudProfileSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{98}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{103}
 }
 func (m *NamespacedCloudProfileSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2816,7 +2956,7 @@ var xxx_messageInfo_NamespacedCloudProfileSpec proto.InternalMessageInfo
 func (m *NamespacedCloudProfileStatus) Reset()      { *m = NamespacedCloudProfileStatus{} }
 func (*NamespacedCloudProfileStatus) ProtoMessage() {}
 func (*NamespacedCloudProfileStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{99}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{104}
 }
 func (m *NamespacedCloudProfileStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2844,7 +2984,7 @@ var xxx_messageInfo_NamespacedCloudProfileStatus proto.InternalMessageInfo
 func (m *Networking) Reset()      { *m = Networking{} }
 func (*Networking) ProtoMessage() {}
 func (*Networking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{100}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{105}
 }
 func (m *Networking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2872,7 +3012,7 @@ var xxx_messageInfo_Networking proto.InternalMessageInfo
 func (m *NginxIngress) Reset()      { *m = NginxIngress{} }
 func (*NginxIngress) ProtoMessage() {}
 func (*NginxIngress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{101}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{106}
 }
 func (m *NginxIngress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2900,7 +3040,7 @@ var xxx_messageInfo_NginxIngress proto.InternalMessageInfo
 func (m *NodeLocalDNS) Reset()      { *m = NodeLocalDNS{} }
 func (*NodeLocalDNS) ProtoMessage() {}
 func (*NodeLocalDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{102}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{107}
 }
 func (m *NodeLocalDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2928,7 +3068,7 @@ var xxx_messageInfo_NodeLocalDNS proto.InternalMessageInfo
 func (m *OIDCConfig) Reset()      { *m = OIDCConfig{} }
 func (*OIDCConfig) ProtoMessage() {}
 func (*OIDCConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{103}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{108}
 }
 func (m *OIDCConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2956,7 +3096,7 @@ var xxx_messageInfo_OIDCConfig proto.InternalMessageInfo
 func (m *ObservabilityRotation) Reset()      { *m = ObservabilityRotation{} }
 func (*ObservabilityRotation) ProtoMessage() {}
 func (*ObservabilityRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{104}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{109}
 }
 func (m *ObservabilityRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2984,7 +3124,7 @@ var xxx_messageInfo_ObservabilityRotation proto.InternalMessageInfo
 func (m *OpenIDConnectClientAuthentication) Reset()      { *m = OpenIDConnectClientAuthentication{} }
 func (*OpenIDConnectClientAuthentication) ProtoMessage() {}
 func (*OpenIDConnectClientAuthentication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{105}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{110}
 }
 func (m *OpenIDConnectClientAuthentication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3009,10 +3149,38 @@ func (m *OpenIDConnectClientAuthentication) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OpenIDConnectClientAuthentication proto.InternalMessageInfo
 
+func (m *Price) Reset()      { *m = Price{} }
+func (*Price) ProtoMessage() {}
+func (*Price) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{111}
+}
+func (m *Price) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Price) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Price) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Price.Merge(m, src)
+}
+func (m *Price) XXX_Size() int {
+	return m.Size()
+}
+func (m *Price) XXX_DiscardUnknown() {
+	xxx_messageInfo_Price.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Price proto.InternalMessageInfo
+
 func (m *Project) Reset()      { *m = Project{} }
 func (*Project) ProtoMessage() {}
 func (*Project) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{106}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{112}
 }
 func (m *Project) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3037,10 +3205,38 @@ func (m *Project) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Project proto.InternalMessageInfo
 
+func (m *ProjectHibernation) Reset()      { *m = ProjectHibernation{} }
+func (*ProjectHibernation) ProtoMessage() {}
+func (*ProjectHibernation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{113}
+}
+func (m *ProjectHibernation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectHibernation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectHibernation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectHibernation.Merge(m, src)
+}
+func (m *ProjectHibernation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectHibernation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectHibernation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectHibernation proto.InternalMessageInfo
+
 func (m *ProjectList) Reset()      { *m = ProjectList{} }
 func (*ProjectList) ProtoMessage() {}
 func (*ProjectList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{107}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{114}
 }
 func (m *ProjectList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3065,10 +3261,38 @@ func (m *ProjectList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ProjectList proto.InternalMessageInfo
 
+func (m *ProjectMaintenance) Reset()      { *m = ProjectMaintenance{} }
+func (*ProjectMaintenance) ProtoMessage() {}
+func (*ProjectMaintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{115}
+}
+func (m *ProjectMaintenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectMaintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectMaintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectMaintenance.Merge(m, src)
+}
+func (m *ProjectMaintenance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectMaintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectMaintenance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectMaintenance proto.InternalMessageInfo
+
 func (m *ProjectMember) Reset()      { *m = ProjectMember{} }
 func (*ProjectMember) ProtoMessage() {}
 func (*ProjectMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{108}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{116}
 }
 func (m *ProjectMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3096,7 +3320,7 @@ var xxx_messageInfo_ProjectMember proto.InternalMessageInfo
 func (m *ProjectSpec) Reset()      { *m = ProjectSpec{} }
 func (*ProjectSpec) ProtoMessage() {}
 func (*ProjectSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{109}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{117}
 }
 func (m *ProjectSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3124,7 +3348,7 @@ var xxx_messageInfo_ProjectSpec proto.InternalMessageInfo
 func (m *ProjectStatus) Reset()      { *m = ProjectStatus{} }
 func (*ProjectStatus) ProtoMessage() {}
 func (*ProjectStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{110}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{118}
 }
 func (m *ProjectStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3152,7 +3376,7 @@ var xxx_messageInfo_ProjectStatus proto.InternalMessageInfo
 func (m *ProjectTolerations) Reset()      { *m = ProjectTolerations{} }
 func (*ProjectTolerations) ProtoMessage() {}
 func (*ProjectTolerations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{111}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{119}
 }
 func (m *ProjectTolerations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3180,7 +3404,7 @@ var xxx_messageInfo_ProjectTolerations proto.InternalMessageInfo
 func (m *Provider) Reset()      { *m = Provider{} }
 func (*Provider) ProtoMessage() {}
 func (*Provider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{112}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{120}
 }
 func (m *Provider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3208,7 +3432,7 @@ var xxx_messageInfo_Provider proto.InternalMessageInfo
 func (m *Quota) Reset()      { *m = Quota{} }
 func (*Quota) ProtoMessage() {}
 func (*Quota) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{113}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{121}
 }
 func (m *Quota) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3236,7 +3460,7 @@ var xxx_messageInfo_Quota proto.InternalMessageInfo
 func (m *QuotaList) Reset()      { *m = QuotaList{} }
 func (*QuotaList) ProtoMessage() {}
 func (*QuotaList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{114}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{122}
 }
 func (m *QuotaList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3261,10 +3485,38 @@ func (m *QuotaList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QuotaList proto.InternalMessageInfo
 
+func (m *QuotaProjectUsage) Reset()      { *m = QuotaProjectUsage{} }
+func (*QuotaProjectUsage) ProtoMessage() {}
+func (*QuotaProjectUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{123}
+}
+func (m *QuotaProjectUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaProjectUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QuotaProjectUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaProjectUsage.Merge(m, src)
+}
+func (m *QuotaProjectUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaProjectUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaProjectUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaProjectUsage proto.InternalMessageInfo
+
 func (m *QuotaSpec) Reset()      { *m = QuotaSpec{} }
 func (*QuotaSpec) ProtoMessage() {}
 func (*QuotaSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{115}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{124}
 }
 func (m *QuotaSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3289,10 +3541,38 @@ func (m *QuotaSpec) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QuotaSpec proto.InternalMessageInfo
 
+func (m *QuotaStatus) Reset()      { *m = QuotaStatus{} }
+func (*QuotaStatus) ProtoMessage() {}
+func (*QuotaStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{125}
+}
+func (m *QuotaStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QuotaStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaStatus.Merge(m, src)
+}
+func (m *QuotaStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaStatus proto.InternalMessageInfo
+
 func (m *Region) Reset()      { *m = Region{} }
 func (*Region) ProtoMessage() {}
 func (*Region) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{116}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{126}
 }
 func (m *Region) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3320,7 +3600,7 @@ var xxx_messageInfo_Region proto.InternalMessageInfo
 func (m *ResourceData) Reset()      { *m = ResourceData{} }
 func (*ResourceData) ProtoMessage() {}
 func (*ResourceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{117}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{127}
 }
 func (m *ResourceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3348,7 +3628,7 @@ var xxx_messageInfo_ResourceData proto.InternalMessageInfo
 func (m *ResourceWatchCacheSize) Reset()      { *m = ResourceWatchCacheSize{} }
 func (*ResourceWatchCacheSize) ProtoMessage() {}
 func (*ResourceWatchCacheSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{118}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{128}
 }
 func (m *ResourceWatchCacheSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3373,10 +3653,38 @@ func (m *ResourceWatchCacheSize) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceWatchCacheSize proto.InternalMessageInfo
 
+func (m *RolloutWaveStatus) Reset()      { *m = RolloutWaveStatus{} }
+func (*RolloutWaveStatus) ProtoMessage() {}
+func (*RolloutWaveStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{129}
+}
+func (m *RolloutWaveStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutWaveStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutWaveStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutWaveStatus.Merge(m, src)
+}
+func (m *RolloutWaveStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutWaveStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutWaveStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutWaveStatus proto.InternalMessageInfo
+
 func (m *SSHAccess) Reset()      { *m = SSHAccess{} }
 func (*SSHAccess) ProtoMessage() {}
 func (*SSHAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{119}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{130}
 }
 func (m *SSHAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3404,7 +3712,7 @@ var xxx_messageInfo_SSHAccess proto.InternalMessageInfo
 func (m *SecretBinding) Reset()      { *m = SecretBinding{} }
 func (*SecretBinding) ProtoMessage() {}
 func (*SecretBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{120}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{131}
 }
 func (m *SecretBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3432,7 +3740,7 @@ var xxx_messageInfo_SecretBinding proto.InternalMessageInfo
 func (m *SecretBindingList) Reset()      { *m = SecretBindingList{} }
 func (*SecretBindingList) ProtoMessage() {}
 func (*SecretBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{121}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{132}
 }
 func (m *SecretBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3460,7 +3768,7 @@ var xxx_messageInfo_SecretBindingList proto.InternalMessageInfo
 func (m *SecretBindingProvider) Reset()      { *m = SecretBindingProvider{} }
 func (*SecretBindingProvider) ProtoMessage() {}
 func (*SecretBindingProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{122}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{133}
 }
 func (m *SecretBindingProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3488,7 +3796,7 @@ var xxx_messageInfo_SecretBindingProvider proto.InternalMessageInfo
 func (m *Seed) Reset()      { *m = Seed{} }
 func (*Seed) ProtoMessage() {}
 func (*Seed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{123}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{134}
 }
 func (m *Seed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3516,7 +3824,7 @@ var xxx_messageInfo_Seed proto.InternalMessageInfo
 func (m *SeedBackup) Reset()      { *m = SeedBackup{} }
 func (*SeedBackup) ProtoMessage() {}
 func (*SeedBackup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{124}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{135}
 }
 func (m *SeedBackup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3544,7 +3852,7 @@ var xxx_messageInfo_SeedBackup proto.InternalMessageInfo
 func (m *SeedDNS) Reset()      { *m = SeedDNS{} }
 func (*SeedDNS) ProtoMessage() {}
 func (*SeedDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{125}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{136}
 }
 func (m *SeedDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3572,7 +3880,7 @@ var xxx_messageInfo_SeedDNS proto.InternalMessageInfo
 func (m *SeedDNSProvider) Reset()      { *m = SeedDNSProvider{} }
 func (*SeedDNSProvider) ProtoMessage() {}
 func (*SeedDNSProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{126}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{137}
 }
 func (m *SeedDNSProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3600,7 +3908,7 @@ var xxx_messageInfo_SeedDNSProvider proto.InternalMessageInfo
 func (m *SeedList) Reset()      { *m = SeedList{} }
 func (*SeedList) ProtoMessage() {}
 func (*SeedList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{127}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{138}
 }
 func (m *SeedList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3628,7 +3936,7 @@ var xxx_messageInfo_SeedList proto.InternalMessageInfo
 func (m *SeedNetworks) Reset()      { *m = SeedNetworks{} }
 func (*SeedNetworks) ProtoMessage() {}
 func (*SeedNetworks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{128}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{139}
 }
 func (m *SeedNetworks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3656,7 +3964,7 @@ var xxx_messageInfo_SeedNetworks proto.InternalMessageInfo
 func (m *SeedProvider) Reset()      { *m = SeedProvider{} }
 func (*SeedProvider) ProtoMessage() {}
 func (*SeedProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{129}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{140}
 }
 func (m *SeedProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3684,7 +3992,7 @@ var xxx_messageInfo_SeedProvider proto.InternalMessageInfo
 func (m *SeedSelector) Reset()      { *m = SeedSelector{} }
 func (*SeedSelector) ProtoMessage() {}
 func (*SeedSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{130}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{141}
 }
 func (m *SeedSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3712,7 +4020,7 @@ var xxx_messageInfo_SeedSelector proto.InternalMessageInfo
 func (m *SeedSettingDependencyWatchdog) Reset()      { *m = SeedSettingDependencyWatchdog{} }
 func (*SeedSettingDependencyWatchdog) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{131}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{142}
 }
 func (m *SeedSettingDependencyWatchdog) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3740,7 +4048,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdog proto.InternalMessageInfo
 func (m *SeedSettingDependencyWatchdogProber) Reset()      { *m = SeedSettingDependencyWatchdogProber{} }
 func (*SeedSettingDependencyWatchdogProber) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdogProber) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{132}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{143}
 }
 func (m *SeedSettingDependencyWatchdogProber) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3768,7 +4076,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdogProber proto.InternalMessageInf
 func (m *SeedSettingDependencyWatchdogWeeder) Reset()      { *m = SeedSettingDependencyWatchdogWeeder{} }
 func (*SeedSettingDependencyWatchdogWeeder) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdogWeeder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{133}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{144}
 }
 func (m *SeedSettingDependencyWatchdogWeeder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3796,7 +4104,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdogWeeder proto.InternalMessageInf
 func (m *SeedSettingExcessCapacityReservation) Reset()      { *m = SeedSettingExcessCapacityReservation{} }
 func (*SeedSettingExcessCapacityReservation) ProtoMessage() {}
 func (*SeedSettingExcessCapacityReservation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{134}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{145}
 }
 func (m *SeedSettingExcessCapacityReservation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3826,7 +4134,7 @@ func (m *SeedSettingExcessCapacityReservationConfig) Reset() {
 }
 func (*SeedSettingExcessCapacityReservationConfig) ProtoMessage() {}
 func (*SeedSettingExcessCapacityReservationConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{135}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{146}
 }
 func (m *SeedSettingExcessCapacityReservationConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3854,7 +4162,7 @@ var xxx_messageInfo_SeedSettingExcessCapacityReservationConfig proto.InternalMes
 func (m *SeedSettingLoadBalancerServices) Reset()      { *m = SeedSettingLoadBalancerServices{} }
 func (*SeedSettingLoadBalancerServices) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{136}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{147}
 }
 func (m *SeedSettingLoadBalancerServices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3882,7 +4190,7 @@ var xxx_messageInfo_SeedSettingLoadBalancerServices proto.InternalMessageInfo
 func (m *SeedSettingLoadBalancerServicesZones) Reset()      { *m = SeedSettingLoadBalancerServicesZones{} }
 func (*SeedSettingLoadBalancerServicesZones) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServicesZones) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{137}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{148}
 }
 func (m *SeedSettingLoadBalancerServicesZones) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3910,7 +4218,7 @@ var xxx_messageInfo_SeedSettingLoadBalancerServicesZones proto.InternalMessageIn
 func (m *SeedSettingScheduling) Reset()      { *m = SeedSettingScheduling{} }
 func (*SeedSettingScheduling) ProtoMessage() {}
 func (*SeedSettingScheduling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{138}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{149}
 }
 func (m *SeedSettingScheduling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3938,7 +4246,7 @@ var xxx_messageInfo_SeedSettingScheduling proto.InternalMessageInfo
 func (m *SeedSettingTopologyAwareRouting) Reset()      { *m = SeedSettingTopologyAwareRouting{} }
 func (*SeedSettingTopologyAwareRouting) ProtoMessage() {}
 func (*SeedSettingTopologyAwareRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{139}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{150}
 }
 func (m *SeedSettingTopologyAwareRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3966,7 +4274,7 @@ var xxx_messageInfo_SeedSettingTopologyAwareRouting proto.InternalMessageInfo
 func (m *SeedSettingVerticalPodAutoscaler) Reset()      { *m = SeedSettingVerticalPodAutoscaler{} }
 func (*SeedSettingVerticalPodAutoscaler) ProtoMessage() {}
 func (*SeedSettingVerticalPodAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{140}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{151}
 }
 func (m *SeedSettingVerticalPodAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3994,7 +4302,7 @@ var xxx_messageInfo_SeedSettingVerticalPodAutoscaler proto.InternalMessageInfo
 func (m *SeedSettings) Reset()      { *m = SeedSettings{} }
 func (*SeedSettings) ProtoMessage() {}
 func (*SeedSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{141}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{152}
 }
 func (m *SeedSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4022,7 +4330,7 @@ var xxx_messageInfo_SeedSettings proto.InternalMessageInfo
 func (m *SeedSpec) Reset()      { *m = SeedSpec{} }
 func (*SeedSpec) ProtoMessage() {}
 func (*SeedSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{142}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{153}
 }
 func (m *SeedSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4050,7 +4358,7 @@ var xxx_messageInfo_SeedSpec proto.InternalMessageInfo
 func (m *SeedStatus) Reset()      { *m = SeedStatus{} }
 func (*SeedStatus) ProtoMessage() {}
 func (*SeedStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{143}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{154}
 }
 func (m *SeedStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4078,7 +4386,7 @@ var xxx_messageInfo_SeedStatus proto.InternalMessageInfo
 func (m *SeedTaint) Reset()      { *m = SeedTaint{} }
 func (*SeedTaint) ProtoMessage() {}
 func (*SeedTaint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{144}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{155}
 }
 func (m *SeedTaint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4106,7 +4414,7 @@ var xxx_messageInfo_SeedTaint proto.InternalMessageInfo
 func (m *SeedTemplate) Reset()      { *m = SeedTemplate{} }
 func (*SeedTemplate) ProtoMessage() {}
 func (*SeedTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{145}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{156}
 }
 func (m *SeedTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4134,7 +4442,7 @@ var xxx_messageInfo_SeedTemplate proto.InternalMessageInfo
 func (m *SeedVolume) Reset()      { *m = SeedVolume{} }
 func (*SeedVolume) ProtoMessage() {}
 func (*SeedVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{146}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{157}
 }
 func (m *SeedVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4162,7 +4470,7 @@ var xxx_messageInfo_SeedVolume proto.InternalMessageInfo
 func (m *SeedVolumeProvider) Reset()      { *m = SeedVolumeProvider{} }
 func (*SeedVolumeProvider) ProtoMessage() {}
 func (*SeedVolumeProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{147}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{158}
 }
 func (m *SeedVolumeProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4190,7 +4498,7 @@ var xxx_messageInfo_SeedVolumeProvider proto.InternalMessageInfo
 func (m *ServiceAccountConfig) Reset()      { *m = ServiceAccountConfig{} }
 func (*ServiceAccountConfig) ProtoMessage() {}
 func (*ServiceAccountConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{148}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{159}
 }
 func (m *ServiceAccountConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4218,7 +4526,7 @@ var xxx_messageInfo_ServiceAccountConfig proto.InternalMessageInfo
 func (m *ServiceAccountKeyRotation) Reset()      { *m = ServiceAccountKeyRotation{} }
 func (*ServiceAccountKeyRotation) ProtoMessage() {}
 func (*ServiceAccountKeyRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{149}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{160}
 }
 func (m *ServiceAccountKeyRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4246,7 +4554,7 @@ var xxx_messageInfo_ServiceAccountKeyRotation proto.InternalMessageInfo
 func (m *Shoot) Reset()      { *m = Shoot{} }
 func (*Shoot) ProtoMessage() {}
 func (*Shoot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{150}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{161}
 }
 func (m *Shoot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4274,7 +4582,7 @@ var xxx_messageInfo_Shoot proto.InternalMessageInfo
 func (m *ShootAdvertisedAddress) Reset()      { *m = ShootAdvertisedAddress{} }
 func (*ShootAdvertisedAddress) ProtoMessage() {}
 func (*ShootAdvertisedAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{151}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{162}
 }
 func (m *ShootAdvertisedAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4299,10 +4607,150 @@ func (m *ShootAdvertisedAddress) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ShootAdvertisedAddress proto.InternalMessageInfo
 
+func (m *ShootBlueprint) Reset()      { *m = ShootBlueprint{} }
+func (*ShootBlueprint) ProtoMessage() {}
+func (*ShootBlueprint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{163}
+}
+func (m *ShootBlueprint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShootBlueprint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShootBlueprint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShootBlueprint.Merge(m, src)
+}
+func (m *ShootBlueprint) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShootBlueprint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShootBlueprint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShootBlueprint proto.InternalMessageInfo
+
+func (m *ShootBlueprintList) Reset()      { *m = ShootBlueprintList{} }
+func (*ShootBlueprintList) ProtoMessage() {}
+func (*ShootBlueprintList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{164}
+}
+func (m *ShootBlueprintList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShootBlueprintList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShootBlueprintList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShootBlueprintList.Merge(m, src)
+}
+func (m *ShootBlueprintList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShootBlueprintList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShootBlueprintList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShootBlueprintList proto.InternalMessageInfo
+
+func (m *ShootBlueprintReference) Reset()      { *m = ShootBlueprintReference{} }
+func (*ShootBlueprintReference) ProtoMessage() {}
+func (*ShootBlueprintReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{165}
+}
+func (m *ShootBlueprintReference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShootBlueprintReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShootBlueprintReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShootBlueprintReference.Merge(m, src)
+}
+func (m *ShootBlueprintReference) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShootBlueprintReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShootBlueprintReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShootBlueprintReference proto.InternalMessageInfo
+
+func (m *ShootBlueprintSpec) Reset()      { *m = ShootBlueprintSpec{} }
+func (*ShootBlueprintSpec) ProtoMessage() {}
+func (*ShootBlueprintSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{166}
+}
+func (m *ShootBlueprintSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShootBlueprintSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShootBlueprintSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShootBlueprintSpec.Merge(m, src)
+}
+func (m *ShootBlueprintSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShootBlueprintSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShootBlueprintSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShootBlueprintSpec proto.InternalMessageInfo
+
+func (m *ShootBlueprintStatus) Reset()      { *m = ShootBlueprintStatus{} }
+func (*ShootBlueprintStatus) ProtoMessage() {}
+func (*ShootBlueprintStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{167}
+}
+func (m *ShootBlueprintStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShootBlueprintStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShootBlueprintStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShootBlueprintStatus.Merge(m, src)
+}
+func (m *ShootBlueprintStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShootBlueprintStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShootBlueprintStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShootBlueprintStatus proto.InternalMessageInfo
+
 func (m *ShootCredentials) Reset()      { *m = ShootCredentials{} }
 func (*ShootCredentials) ProtoMessage() {}
 func (*ShootCredentials) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{152}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{168}
 }
 func (m *ShootCredentials) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4330,7 +4778,7 @@ var xxx_messageInfo_ShootCredentials proto.InternalMessageInfo
 func (m *ShootCredentialsRotation) Reset()      { *m = ShootCredentialsRotation{} }
 func (*ShootCredentialsRotation) ProtoMessage() {}
 func (*ShootCredentialsRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{153}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{169}
 }
 func (m *ShootCredentialsRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4358,7 +4806,7 @@ var xxx_messageInfo_ShootCredentialsRotation proto.InternalMessageInfo
 func (m *ShootKubeconfigRotation) Reset()      { *m = ShootKubeconfigRotation{} }
 func (*ShootKubeconfigRotation) ProtoMessage() {}
 func (*ShootKubeconfigRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{154}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{170}
 }
 func (m *ShootKubeconfigRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4386,7 +4834,7 @@ var xxx_messageInfo_ShootKubeconfigRotation proto.InternalMessageInfo
 func (m *ShootList) Reset()      { *m = ShootList{} }
 func (*ShootList) ProtoMessage() {}
 func (*ShootList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{155}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{171}
 }
 func (m *ShootList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4414,7 +4862,7 @@ var xxx_messageInfo_ShootList proto.InternalMessageInfo
 func (m *ShootMachineImage) Reset()      { *m = ShootMachineImage{} }
 func (*ShootMachineImage) ProtoMessage() {}
 func (*ShootMachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{156}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{172}
 }
 func (m *ShootMachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4442,7 +4890,7 @@ var xxx_messageInfo_ShootMachineImage proto.InternalMessageInfo
 func (m *ShootNetworks) Reset()      { *m = ShootNetworks{} }
 func (*ShootNetworks) ProtoMessage() {}
 func (*ShootNetworks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{157}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{173}
 }
 func (m *ShootNetworks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4470,7 +4918,7 @@ var xxx_messageInfo_ShootNetworks proto.InternalMessageInfo
 func (m *ShootSSHKeypairRotation) Reset()      { *m = ShootSSHKeypairRotation{} }
 func (*ShootSSHKeypairRotation) ProtoMessage() {}
 func (*ShootSSHKeypairRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{158}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{174}
 }
 func (m *ShootSSHKeypairRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4498,7 +4946,7 @@ var xxx_messageInfo_ShootSSHKeypairRotation proto.InternalMessageInfo
 func (m *ShootSpec) Reset()      { *m = ShootSpec{} }
 func (*ShootSpec) ProtoMessage() {}
 func (*ShootSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{159}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{175}
 }
 func (m *ShootSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4526,7 +4974,7 @@ var xxx_messageInfo_ShootSpec proto.InternalMessageInfo
 func (m *ShootState) Reset()      { *m = ShootState{} }
 func (*ShootState) ProtoMessage() {}
 func (*ShootState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{160}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{176}
 }
 func (m *ShootState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4554,7 +5002,7 @@ var xxx_messageInfo_ShootState proto.InternalMessageInfo
 func (m *ShootStateList) Reset()      { *m = ShootStateList{} }
 func (*ShootStateList) ProtoMessage() {}
 func (*ShootStateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{161}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{177}
 }
 func (m *ShootStateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4582,7 +5030,7 @@ var xxx_messageInfo_ShootStateList proto.InternalMessageInfo
 func (m *ShootStateSpec) Reset()      { *m = ShootStateSpec{} }
 func (*ShootStateSpec) ProtoMessage() {}
 func (*ShootStateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{162}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{178}
 }
 func (m *ShootStateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4610,7 +5058,7 @@ var xxx_messageInfo_ShootStateSpec proto.InternalMessageInfo
 func (m *ShootStatus) Reset()      { *m = ShootStatus{} }
 func (*ShootStatus) ProtoMessage() {}
 func (*ShootStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{163}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{179}
 }
 func (m *ShootStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4638,7 +5086,7 @@ var xxx_messageInfo_ShootStatus proto.InternalMessageInfo
 func (m *ShootTemplate) Reset()      { *m = ShootTemplate{} }
 func (*ShootTemplate) ProtoMessage() {}
 func (*ShootTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{164}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{180}
 }
 func (m *ShootTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4666,7 +5114,7 @@ var xxx_messageInfo_ShootTemplate proto.InternalMessageInfo
 func (m *SystemComponents) Reset()      { *m = SystemComponents{} }
 func (*SystemComponents) ProtoMessage() {}
 func (*SystemComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{165}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{181}
 }
 func (m *SystemComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4694,7 +5142,7 @@ var xxx_messageInfo_SystemComponents proto.InternalMessageInfo
 func (m *Toleration) Reset()      { *m = Toleration{} }
 func (*Toleration) ProtoMessage() {}
 func (*Toleration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{166}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{182}
 }
 func (m *Toleration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4719,10 +5167,38 @@ func (m *Toleration) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Toleration proto.InternalMessageInfo
 
+func (m *VersionRolloutStatus) Reset()      { *m = VersionRolloutStatus{} }
+func (*VersionRolloutStatus) ProtoMessage() {}
+func (*VersionRolloutStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{183}
+}
+func (m *VersionRolloutStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersionRolloutStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *VersionRolloutStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionRolloutStatus.Merge(m, src)
+}
+func (m *VersionRolloutStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersionRolloutStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionRolloutStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionRolloutStatus proto.InternalMessageInfo
+
 func (m *VerticalPodAutoscaler) Reset()      { *m = VerticalPodAutoscaler{} }
 func (*VerticalPodAutoscaler) ProtoMessage() {}
 func (*VerticalPodAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{167}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{184}
 }
 func (m *VerticalPodAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4750,7 +5226,7 @@ var xxx_messageInfo_VerticalPodAutoscaler proto.InternalMessageInfo
 func (m *Volume) Reset()      { *m = Volume{} }
 func (*Volume) ProtoMessage() {}
 func (*Volume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{168}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{185}
 }
 func (m *Volume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4778,7 +5254,7 @@ var xxx_messageInfo_Volume proto.InternalMessageInfo
 func (m *VolumeType) Reset()      { *m = VolumeType{} }
 func (*VolumeType) ProtoMessage() {}
 func (*VolumeType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{169}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{186}
 }
 func (m *VolumeType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4806,7 +5282,7 @@ var xxx_messageInfo_VolumeType proto.InternalMessageInfo
 func (m *WatchCacheSizes) Reset()      { *m = WatchCacheSizes{} }
 func (*WatchCacheSizes) ProtoMessage() {}
 func (*WatchCacheSizes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{170}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{187}
 }
 func (m *WatchCacheSizes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4834,7 +5310,7 @@ var xxx_messageInfo_WatchCacheSizes proto.InternalMessageInfo
 func (m *Worker) Reset()      { *m = Worker{} }
 func (*Worker) ProtoMessage() {}
 func (*Worker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{171}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{188}
 }
 func (m *Worker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4862,7 +5338,7 @@ var xxx_messageInfo_Worker proto.InternalMessageInfo
 func (m *WorkerKubernetes) Reset()      { *m = WorkerKubernetes{} }
 func (*WorkerKubernetes) ProtoMessage() {}
 func (*WorkerKubernetes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{172}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{189}
 }
 func (m *WorkerKubernetes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4890,7 +5366,7 @@ var xxx_messageInfo_WorkerKubernetes proto.InternalMessageInfo
 func (m *WorkerSystemComponents) Reset()      { *m = WorkerSystemComponents{} }
 func (*WorkerSystemComponents) ProtoMessage() {}
 func (*WorkerSystemComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{173}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{190}
 }
 func (m *WorkerSystemComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4918,7 +5394,7 @@ var xxx_messageInfo_WorkerSystemComponents proto.InternalMessageInfo
 func (m *WorkersSettings) Reset()      { *m = WorkersSettings{} }
 func (*WorkersSettings) ProtoMessage() {}
 func (*WorkersSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{174}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{191}
 }
 func (m *WorkersSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4962,12 +5438,14 @@ func init() {
 	proto.RegisterType((*BackupEntryList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.BackupEntryList")
 	proto.RegisterType((*BackupEntrySpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.BackupEntrySpec")
 	proto.RegisterType((*BackupEntryStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.BackupEntryStatus")
+	proto.RegisterType((*BackupRetentionPolicy)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.BackupRetentionPolicy")
 	proto.RegisterType((*CARotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CARotation")
 	proto.RegisterType((*CRI)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CRI")
 	proto.RegisterType((*CloudProfile)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CloudProfile")
 	proto.RegisterType((*CloudProfileList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CloudProfileList")
 	proto.RegisterType((*CloudProfileReference)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CloudProfileReference")
 	proto.RegisterType((*CloudProfileSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CloudProfileSpec")
+	proto.RegisterType((*CloudProfileStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CloudProfileStatus")
 	proto.RegisterType((*ClusterAutoscaler)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ClusterAutoscaler")
 	proto.RegisterType((*ClusterAutoscalerOptions)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ClusterAutoscalerOptions")
 	proto.RegisterType((*Condition)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Condition")
@@ -4995,6 +5473,7 @@ func init() {
 	proto.RegisterType((*DeploymentRef)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.DeploymentRef")
 	proto.RegisterType((*ETCDEncryptionKeyRotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ETCDEncryptionKeyRotation")
 	proto.RegisterType((*EncryptionConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.EncryptionConfig")
+	proto.RegisterType((*EstimatedCost)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.EstimatedCost")
 	proto.RegisterType((*ExpirableVersion)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ExpirableVersion")
 	proto.RegisterType((*ExposureClass)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ExposureClass")
 	proto.RegisterType((*ExposureClassList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ExposureClassList")
@@ -5036,11 +5515,13 @@ func init() {
 	proto.RegisterType((*Machine)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Machine")
 	proto.RegisterType((*MachineControllerManagerSettings)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MachineControllerManagerSettings")
 	proto.RegisterType((*MachineImage)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MachineImage")
+	proto.RegisterType((*MachineImageRolloutStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MachineImageRolloutStatus")
 	proto.RegisterType((*MachineImageVersion)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MachineImageVersion")
 	proto.RegisterType((*MachineType)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MachineType")
 	proto.RegisterType((*MachineTypeStorage)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MachineTypeStorage")
 	proto.RegisterType((*Maintenance)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Maintenance")
 	proto.RegisterType((*MaintenanceAutoUpdate)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceAutoUpdate")
+	proto.RegisterType((*MaintenanceFreezePeriod)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceFreezePeriod")
 	proto.RegisterType((*MaintenanceTimeWindow)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceTimeWindow")
 	proto.RegisterType((*MemorySwapConfiguration)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MemorySwapConfiguration")
 	proto.RegisterType((*Monitoring)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Monitoring")
@@ -5058,8 +5539,11 @@ func init() {
 	proto.RegisterType((*ObservabilityRotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ObservabilityRotation")
 	proto.RegisterType((*OpenIDConnectClientAuthentication)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.OpenIDConnectClientAuthentication")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.OpenIDConnectClientAuthentication.ExtraConfigEntry")
+	proto.RegisterType((*Price)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Price")
 	proto.RegisterType((*Project)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Project")
+	proto.RegisterType((*ProjectHibernation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectHibernation")
 	proto.RegisterType((*ProjectList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectList")
+	proto.RegisterType((*ProjectMaintenance)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectMaintenance")
 	proto.RegisterType((*ProjectMember)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectMember")
 	proto.RegisterType((*ProjectSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectSpec")
 	proto.RegisterType((*ProjectStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectStatus")
@@ -5067,12 +5551,18 @@ func init() {
 	proto.RegisterType((*Provider)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Provider")
 	proto.RegisterType((*Quota)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Quota")
 	proto.RegisterType((*QuotaList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaList")
+	proto.RegisterType((*QuotaProjectUsage)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaProjectUsage")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaProjectUsage.UsageEntry")
 	proto.RegisterType((*QuotaSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaSpec")
 	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaSpec.MetricsEntry")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaSpec.SoftLimitsEntry")
+	proto.RegisterType((*QuotaStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaStatus")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaStatus.UsageEntry")
 	proto.RegisterType((*Region)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Region")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Region.LabelsEntry")
 	proto.RegisterType((*ResourceData)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ResourceData")
 	proto.RegisterType((*ResourceWatchCacheSize)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ResourceWatchCacheSize")
+	proto.RegisterType((*RolloutWaveStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.RolloutWaveStatus")
 	proto.RegisterType((*SSHAccess)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SSHAccess")
 	proto.RegisterType((*SecretBinding)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SecretBinding")
 	proto.RegisterType((*SecretBindingList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SecretBindingList")
@@ -5112,6 +5602,11 @@ func init() {
 	proto.RegisterType((*ServiceAccountKeyRotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ServiceAccountKeyRotation")
 	proto.RegisterType((*Shoot)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Shoot")
 	proto.RegisterType((*ShootAdvertisedAddress)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootAdvertisedAddress")
+	proto.RegisterType((*ShootBlueprint)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootBlueprint")
+	proto.RegisterType((*ShootBlueprintList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootBlueprintList")
+	proto.RegisterType((*ShootBlueprintReference)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootBlueprintReference")
+	proto.RegisterType((*ShootBlueprintSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootBlueprintSpec")
+	proto.RegisterType((*ShootBlueprintStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootBlueprintStatus")
 	proto.RegisterType((*ShootCredentials)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootCredentials")
 	proto.RegisterType((*ShootCredentialsRotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootCredentialsRotation")
 	proto.RegisterType((*ShootKubeconfigRotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootKubeconfigRotation")
@@ -5127,6 +5622,7 @@ func init() {
 	proto.RegisterType((*ShootTemplate)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootTemplate")
 	proto.RegisterType((*SystemComponents)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SystemComponents")
 	proto.RegisterType((*Toleration)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Toleration")
+	proto.RegisterType((*VersionRolloutStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.VersionRolloutStatus")
 	proto.RegisterType((*VerticalPodAutoscaler)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.VerticalPodAutoscaler")
 	proto.RegisterType((*Volume)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Volume")
 	proto.RegisterType((*VolumeType)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.VolumeType")
@@ -5145,776 +5641,836 @@ func init() {
 }
 
 var fileDescriptor_ca37af0df9a5bbd2 = []byte{
-	// 12300 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7d, 0x6c, 0x24, 0xc9,
-	0x75, 0x18, 0xae, 0x1e, 0x7e, 0x3f, 0x7e, 0x2c, 0x59, 0xbb, 0xdc, 0xe3, 0x71, 0xf7, 0x76, 0x56,
-	0x7d, 0x27, 0xfd, 0xee, 0x7c, 0x32, 0xd7, 0x77, 0x3e, 0xf9, 0x74, 0x2b, 0x9f, 0x4e, 0xe4, 0x0c,
-	0xb9, 0x3b, 0x5e, 0x92, 0xcb, 0xab, 0x21, 0xef, 0xce, 0x67, 0xff, 0xce, 0x6e, 0xf6, 0x14, 0x87,
-	0x7d, 0xec, 0xe9, 0x9e, 0xeb, 0xee, 0xe1, 0x92, 0x77, 0x72, 0x64, 0x29, 0xb6, 0xe3, 0x3b, 0x5b,
-	0x81, 0x2d, 0xc0, 0x11, 0x4e, 0x72, 0x60, 0x19, 0x86, 0x91, 0x0f, 0x07, 0x8a, 0xe3, 0xc0, 0x01,
-	0x6c, 0x23, 0x80, 0x21, 0xc0, 0xb1, 0x64, 0x58, 0x86, 0x20, 0x25, 0x88, 0x94, 0xc4, 0x74, 0xc4,
-	0x28, 0x76, 0x80, 0x04, 0x46, 0x10, 0x23, 0x30, 0xb2, 0x31, 0xec, 0xa0, 0xbe, 0xba, 0xab, 0xbf,
-	0x86, 0x64, 0x0f, 0x49, 0xe9, 0x60, 0xfd, 0x45, 0x4e, 0xbd, 0xaa, 0xf7, 0xaa, 0xaa, 0xab, 0x5e,
-	0xbd, 0xf7, 0xea, 0xbd, 0x57, 0xb0, 0xd0, 0xb4, 0x82, 0xed, 0xce, 0xe6, 0x9c, 0xe9, 0xb6, 0x6e,
-	0x34, 0x0d, 0xaf, 0x41, 0x1c, 0xe2, 0x45, 0xff, 0xb4, 0x77, 0x9a, 0x37, 0x8c, 0xb6, 0xe5, 0xdf,
-	0x30, 0x5d, 0x8f, 0xdc, 0xd8, 0x7d, 0x62, 0x93, 0x04, 0xc6, 0x13, 0x37, 0x9a, 0x14, 0x66, 0x04,
-	0xa4, 0x31, 0xd7, 0xf6, 0xdc, 0xc0, 0x45, 0x4f, 0x46, 0x38, 0xe6, 0x64, 0xd3, 0xe8, 0x9f, 0xf6,
-	0x4e, 0x73, 0x8e, 0xe2, 0x98, 0xa3, 0x38, 0xe6, 0x04, 0x8e, 0xd9, 0xef, 0x56, 0xe9, 0xba, 0x4d,
-	0xf7, 0x06, 0x43, 0xb5, 0xd9, 0xd9, 0x62, 0xbf, 0xd8, 0x0f, 0xf6, 0x1f, 0x27, 0x31, 0xfb, 0xd8,
-	0xce, 0x07, 0xfc, 0x39, 0xcb, 0xa5, 0x9d, 0xb9, 0x61, 0x74, 0x02, 0xd7, 0x37, 0x0d, 0xdb, 0x72,
-	0x9a, 0x37, 0x76, 0x53, 0xbd, 0x99, 0xd5, 0x95, 0xaa, 0xa2, 0xdb, 0x5d, 0xeb, 0x78, 0x9b, 0x86,
-	0x99, 0x55, 0xe7, 0xa9, 0xa8, 0x4e, 0xcb, 0x30, 0xb7, 0x2d, 0x87, 0x78, 0xfb, 0x72, 0x42, 0x6e,
-	0x78, 0xc4, 0x77, 0x3b, 0x9e, 0x49, 0x4e, 0xd4, 0xca, 0xbf, 0xd1, 0x22, 0x81, 0x91, 0x45, 0xeb,
-	0x46, 0x5e, 0x2b, 0xaf, 0xe3, 0x04, 0x56, 0x2b, 0x4d, 0xe6, 0xfb, 0x8e, 0x6a, 0xe0, 0x9b, 0xdb,
-	0xa4, 0x65, 0xa4, 0xda, 0x7d, 0x6f, 0x5e, 0xbb, 0x4e, 0x60, 0xd9, 0x37, 0x2c, 0x27, 0xf0, 0x03,
-	0x2f, 0xd9, 0x48, 0x7f, 0x4b, 0x83, 0xc9, 0xf9, 0xb5, 0x5a, 0x9d, 0x78, 0xbb, 0xc4, 0x5b, 0x76,
-	0x9b, 0x4d, 0xcb, 0x69, 0xa2, 0xc7, 0x61, 0x64, 0x97, 0x78, 0x9b, 0xae, 0x6f, 0x05, 0xfb, 0x33,
-	0xda, 0x75, 0xed, 0xd1, 0x81, 0x85, 0xf1, 0xc3, 0x83, 0xf2, 0xc8, 0x0b, 0xb2, 0x10, 0x47, 0x70,
-	0x54, 0x83, 0x8b, 0xdb, 0x41, 0xd0, 0x9e, 0x37, 0x4d, 0xe2, 0xfb, 0x61, 0x8d, 0x99, 0x12, 0x6b,
-	0xf6, 0xc0, 0xe1, 0x41, 0xf9, 0xe2, 0xed, 0xf5, 0xf5, 0xb5, 0x04, 0x18, 0x67, 0xb5, 0xd1, 0x7f,
-	0x43, 0x83, 0xa9, 0xb0, 0x33, 0x98, 0xbc, 0xd6, 0x21, 0x7e, 0xe0, 0x23, 0x0c, 0x97, 0x5b, 0xc6,
-	0xde, 0xaa, 0xeb, 0xac, 0x74, 0x02, 0x23, 0xb0, 0x9c, 0x66, 0xcd, 0xd9, 0xb2, 0xad, 0xe6, 0x76,
-	0x20, 0xba, 0x36, 0x7b, 0x78, 0x50, 0xbe, 0xbc, 0x92, 0x59, 0x03, 0xe7, 0xb4, 0xa4, 0x9d, 0x6e,
-	0x19, 0x7b, 0x29, 0x84, 0x4a, 0xa7, 0x57, 0xd2, 0x60, 0x9c, 0xd5, 0x46, 0x7f, 0x12, 0x06, 0xe6,
-	0x1b, 0x0d, 0xd7, 0x41, 0x8f, 0xc1, 0x10, 0x71, 0x8c, 0x4d, 0x9b, 0x34, 0x58, 0xc7, 0x86, 0x17,
-	0x2e, 0x7c, 0xe1, 0xa0, 0xfc, 0xae, 0xc3, 0x83, 0xf2, 0xd0, 0x22, 0x2f, 0xc6, 0x12, 0xae, 0xff,
-	0x42, 0x09, 0x06, 0x59, 0x23, 0x1f, 0x7d, 0x52, 0x83, 0x8b, 0x3b, 0x9d, 0x4d, 0xe2, 0x39, 0x24,
-	0x20, 0x7e, 0xd5, 0xf0, 0xb7, 0x37, 0x5d, 0xc3, 0xe3, 0x28, 0x46, 0x9f, 0xbc, 0x35, 0x77, 0xf2,
-	0xfd, 0x37, 0x77, 0x27, 0x8d, 0x8e, 0x8f, 0x29, 0x03, 0x80, 0xb3, 0x88, 0xa3, 0x5d, 0x18, 0x73,
-	0x9a, 0x96, 0xb3, 0x57, 0x73, 0x9a, 0x1e, 0xf1, 0x7d, 0x36, 0x2f, 0xa3, 0x4f, 0x7e, 0xb8, 0x48,
-	0x67, 0x56, 0x15, 0x3c, 0x0b, 0x93, 0x87, 0x07, 0xe5, 0x31, 0xb5, 0x04, 0xc7, 0xe8, 0xe8, 0x7f,
-	0xad, 0xc1, 0x85, 0xf9, 0x46, 0xcb, 0xf2, 0x7d, 0xcb, 0x75, 0xd6, 0xec, 0x4e, 0xd3, 0x72, 0xd0,
-	0x75, 0xe8, 0x77, 0x8c, 0x16, 0x61, 0x13, 0x32, 0xb2, 0x30, 0x26, 0xe6, 0xb4, 0x7f, 0xd5, 0x68,
-	0x11, 0xcc, 0x20, 0xe8, 0x79, 0x18, 0x34, 0x5d, 0x67, 0xcb, 0x6a, 0x8a, 0x7e, 0x7e, 0xf7, 0x1c,
-	0xdf, 0x09, 0x73, 0xea, 0x4e, 0x60, 0xdd, 0x13, 0x3b, 0x68, 0x0e, 0x1b, 0xf7, 0x16, 0xf7, 0x02,
-	0xe2, 0x50, 0x32, 0x0b, 0x70, 0x78, 0x50, 0x1e, 0xac, 0x30, 0x04, 0x58, 0x20, 0x42, 0x8f, 0xc2,
-	0x70, 0xc3, 0xf2, 0xf9, 0xc7, 0xec, 0x63, 0x1f, 0x73, 0xec, 0xf0, 0xa0, 0x3c, 0x5c, 0x15, 0x65,
-	0x38, 0x84, 0xa2, 0x65, 0xb8, 0x44, 0x67, 0x90, 0xb7, 0xab, 0x13, 0xd3, 0x23, 0x01, 0xed, 0xda,
-	0x4c, 0x3f, 0xeb, 0xee, 0xcc, 0xe1, 0x41, 0xf9, 0xd2, 0x9d, 0x0c, 0x38, 0xce, 0x6c, 0xa5, 0x2f,
-	0xc1, 0xf0, 0xbc, 0x4d, 0x3c, 0xba, 0xc0, 0xd0, 0x4d, 0x98, 0x20, 0x2d, 0xc3, 0xb2, 0x31, 0x31,
-	0x89, 0xb5, 0x4b, 0x3c, 0x7f, 0x46, 0xbb, 0xde, 0xf7, 0xe8, 0xc8, 0x02, 0x3a, 0x3c, 0x28, 0x4f,
-	0x2c, 0xc6, 0x20, 0x38, 0x51, 0x53, 0xff, 0x98, 0x06, 0xa3, 0xf3, 0x9d, 0x86, 0x15, 0xf0, 0x71,
-	0x21, 0x0f, 0x46, 0x0d, 0xfa, 0x73, 0xcd, 0xb5, 0x2d, 0x73, 0x5f, 0x2c, 0xae, 0xe7, 0x8a, 0x7c,
-	0xcf, 0xf9, 0x08, 0xcd, 0xc2, 0x85, 0xc3, 0x83, 0xf2, 0xa8, 0x52, 0x80, 0x55, 0x22, 0xfa, 0x36,
-	0xa8, 0x30, 0xf4, 0x83, 0x30, 0xc6, 0x87, 0xbb, 0x62, 0xb4, 0x31, 0xd9, 0x12, 0x7d, 0x78, 0x58,
-	0xf9, 0x56, 0x92, 0xd0, 0xdc, 0xdd, 0xcd, 0x57, 0x89, 0x19, 0x60, 0xb2, 0x45, 0x3c, 0xe2, 0x98,
-	0x84, 0x2f, 0x9b, 0x8a, 0xd2, 0x18, 0xc7, 0x50, 0xe9, 0x7f, 0x42, 0x99, 0xd8, 0xae, 0x61, 0xd9,
-	0xc6, 0xa6, 0x65, 0x5b, 0xc1, 0xfe, 0xcb, 0xae, 0x43, 0x8e, 0xb1, 0x6e, 0x36, 0xe0, 0x81, 0x8e,
-	0x63, 0xf0, 0x76, 0x36, 0x59, 0xe1, 0x2b, 0x65, 0x7d, 0xbf, 0x4d, 0xe8, 0x82, 0xa7, 0x33, 0x7d,
-	0xe5, 0xf0, 0xa0, 0xfc, 0xc0, 0x46, 0x76, 0x15, 0x9c, 0xd7, 0x96, 0xf2, 0x2b, 0x05, 0xf4, 0x82,
-	0x6b, 0x77, 0x5a, 0x02, 0x6b, 0x1f, 0xc3, 0xca, 0xf8, 0xd5, 0x46, 0x66, 0x0d, 0x9c, 0xd3, 0x52,
-	0xff, 0x42, 0x09, 0xc6, 0x16, 0x0c, 0x73, 0xa7, 0xd3, 0x5e, 0xe8, 0x98, 0x3b, 0x24, 0x40, 0x3f,
-	0x0a, 0xc3, 0xf4, 0xc0, 0x69, 0x18, 0x81, 0x21, 0x66, 0xf2, 0x7b, 0x72, 0x57, 0x3d, 0xfb, 0x88,
-	0xb4, 0x76, 0x34, 0xb7, 0x2b, 0x24, 0x30, 0x16, 0x90, 0x98, 0x13, 0x88, 0xca, 0x70, 0x88, 0x15,
-	0x6d, 0x41, 0xbf, 0xdf, 0x26, 0xa6, 0xd8, 0x53, 0xd5, 0x22, 0x6b, 0x45, 0xed, 0x71, 0xbd, 0x4d,
-	0xcc, 0xe8, 0x2b, 0xd0, 0x5f, 0x98, 0xe1, 0x47, 0x0e, 0x0c, 0xfa, 0x81, 0x11, 0x74, 0x7c, 0xb6,
-	0xd1, 0x46, 0x9f, 0x5c, 0xea, 0x99, 0x12, 0xc3, 0xb6, 0x30, 0x21, 0x68, 0x0d, 0xf2, 0xdf, 0x58,
-	0x50, 0xd1, 0xff, 0xbd, 0x06, 0x93, 0x6a, 0xf5, 0x65, 0xcb, 0x0f, 0xd0, 0x0f, 0xa7, 0xa6, 0x73,
-	0xee, 0x78, 0xd3, 0x49, 0x5b, 0xb3, 0xc9, 0x9c, 0x14, 0xe4, 0x86, 0x65, 0x89, 0x32, 0x95, 0x04,
-	0x06, 0xac, 0x80, 0xb4, 0xf8, 0xb2, 0x2a, 0xc8, 0x47, 0xd5, 0x2e, 0x2f, 0x8c, 0x0b, 0x62, 0x03,
-	0x35, 0x8a, 0x16, 0x73, 0xec, 0xfa, 0x8f, 0xc2, 0x25, 0xb5, 0xd6, 0x9a, 0xe7, 0xee, 0x5a, 0x0d,
-	0xe2, 0xd1, 0x9d, 0x10, 0xec, 0xb7, 0x53, 0x3b, 0x81, 0xae, 0x2c, 0xcc, 0x20, 0xe8, 0xbd, 0x30,
-	0xe8, 0x91, 0xa6, 0xe5, 0x3a, 0xec, 0x6b, 0x8f, 0x44, 0x73, 0x87, 0x59, 0x29, 0x16, 0x50, 0xfd,
-	0x7f, 0x97, 0xe2, 0x73, 0x47, 0x3f, 0x23, 0xda, 0x85, 0xe1, 0xb6, 0x20, 0x25, 0xe6, 0xee, 0x76,
-	0xaf, 0x03, 0x94, 0x5d, 0x8f, 0x66, 0x55, 0x96, 0xe0, 0x90, 0x16, 0xb2, 0x60, 0x42, 0xfe, 0x5f,
-	0xe9, 0x81, 0xfd, 0x33, 0x76, 0xba, 0x16, 0x43, 0x84, 0x13, 0x88, 0xd1, 0x3a, 0x8c, 0xf8, 0x8c,
-	0x49, 0x53, 0xc6, 0xd5, 0x97, 0xcf, 0xb8, 0xea, 0xb2, 0x92, 0x60, 0x5c, 0x53, 0xa2, 0xfb, 0x23,
-	0x21, 0x00, 0x47, 0x88, 0xe8, 0x21, 0xe3, 0x13, 0xd2, 0x50, 0x8e, 0x0b, 0x76, 0xc8, 0xd4, 0x45,
-	0x19, 0x0e, 0xa1, 0xfa, 0x67, 0xfb, 0x01, 0xa5, 0x97, 0xb8, 0x3a, 0x03, 0xbc, 0x44, 0xcc, 0x7f,
-	0x2f, 0x33, 0x20, 0x76, 0x4b, 0x02, 0x31, 0x7a, 0x1d, 0xc6, 0x6d, 0xc3, 0x0f, 0xee, 0xb6, 0xa9,
-	0xf4, 0x28, 0x17, 0xca, 0xe8, 0x93, 0xf3, 0x45, 0xbe, 0xf4, 0xb2, 0x8a, 0x68, 0x61, 0xea, 0xf0,
-	0xa0, 0x3c, 0x1e, 0x2b, 0xc2, 0x71, 0x52, 0xe8, 0x55, 0x18, 0xa1, 0x05, 0x8b, 0x9e, 0xe7, 0x7a,
-	0x62, 0xf6, 0x9f, 0x2d, 0x4a, 0x97, 0x21, 0xe1, 0xd2, 0x6c, 0xf8, 0x13, 0x47, 0xe8, 0xd1, 0x0f,
-	0x00, 0x72, 0x37, 0x7d, 0x2a, 0x80, 0x36, 0x6e, 0x71, 0x51, 0x99, 0x0e, 0x96, 0x7e, 0x9d, 0xbe,
-	0x85, 0x59, 0xf1, 0x35, 0xd1, 0xdd, 0x54, 0x0d, 0x9c, 0xd1, 0x0a, 0xed, 0x00, 0x0a, 0xc5, 0xed,
-	0x70, 0x01, 0xcc, 0x0c, 0x1c, 0x7f, 0xf9, 0x5c, 0xa6, 0xc4, 0x6e, 0xa5, 0x50, 0xe0, 0x0c, 0xb4,
-	0xfa, 0xef, 0x95, 0x60, 0x94, 0x2f, 0x91, 0x45, 0x27, 0xf0, 0xf6, 0xcf, 0xe1, 0x80, 0x20, 0xb1,
-	0x03, 0xa2, 0x52, 0x7c, 0xcf, 0xb3, 0x0e, 0xe7, 0x9e, 0x0f, 0xad, 0xc4, 0xf9, 0xb0, 0xd8, 0x2b,
-	0xa1, 0xee, 0xc7, 0xc3, 0xbf, 0xd3, 0xe0, 0x82, 0x52, 0xfb, 0x1c, 0x4e, 0x87, 0x46, 0xfc, 0x74,
-	0x78, 0xae, 0xc7, 0xf1, 0xe5, 0x1c, 0x0e, 0x6e, 0x6c, 0x58, 0x8c, 0x71, 0x3f, 0x09, 0xb0, 0xc9,
-	0xd8, 0xc9, 0x6a, 0x24, 0x27, 0x85, 0x9f, 0x7c, 0x21, 0x84, 0x60, 0xa5, 0x56, 0x8c, 0x67, 0x95,
-	0xba, 0xf2, 0xac, 0xff, 0xda, 0x07, 0x53, 0xa9, 0x69, 0x4f, 0xf3, 0x11, 0xed, 0x5b, 0xc4, 0x47,
-	0x4a, 0xdf, 0x0a, 0x3e, 0xd2, 0x57, 0x88, 0x8f, 0x1c, 0xfb, 0x9c, 0x40, 0x1e, 0xa0, 0x96, 0xd5,
-	0xe4, 0xcd, 0xea, 0x81, 0xe1, 0x05, 0xeb, 0x56, 0x8b, 0x08, 0x8e, 0xf3, 0x5d, 0xc7, 0x5b, 0xb2,
-	0xb4, 0x05, 0x67, 0x3c, 0x2b, 0x29, 0x4c, 0x38, 0x03, 0xbb, 0xfe, 0x95, 0x7e, 0x80, 0xca, 0x3c,
-	0x76, 0x03, 0xde, 0xd9, 0xe7, 0x60, 0xa0, 0xbd, 0x6d, 0xf8, 0x72, 0x3d, 0x3d, 0x26, 0x17, 0xe3,
-	0x1a, 0x2d, 0xbc, 0x7f, 0x50, 0x9e, 0xa9, 0x78, 0xa4, 0x41, 0x9c, 0xc0, 0x32, 0x6c, 0x5f, 0x36,
-	0x62, 0x30, 0xcc, 0xdb, 0xd1, 0x31, 0xd0, 0x69, 0xac, 0xb8, 0xad, 0xb6, 0x4d, 0x28, 0x94, 0x8d,
-	0xa1, 0x54, 0x6c, 0x0c, 0xcb, 0x29, 0x4c, 0x38, 0x03, 0xbb, 0xa4, 0x59, 0x73, 0xac, 0xc0, 0x32,
-	0x42, 0x9a, 0x7d, 0xc5, 0x69, 0xc6, 0x31, 0xe1, 0x0c, 0xec, 0xe8, 0x2d, 0x0d, 0x66, 0xe3, 0xc5,
-	0x4b, 0x96, 0x63, 0xf9, 0xdb, 0xa4, 0xc1, 0x88, 0xf7, 0x9f, 0x98, 0xf8, 0xb5, 0xc3, 0x83, 0xf2,
-	0xec, 0x72, 0x2e, 0x46, 0xdc, 0x85, 0x1a, 0xfa, 0x84, 0x06, 0x57, 0x12, 0xf3, 0xe2, 0x59, 0xcd,
-	0x26, 0xf1, 0x44, 0x6f, 0x4e, 0xbe, 0x84, 0xca, 0x87, 0x07, 0xe5, 0x2b, 0xcb, 0xf9, 0x28, 0x71,
-	0x37, 0x7a, 0xfa, 0xe7, 0x35, 0xe8, 0xab, 0xe0, 0x1a, 0x7a, 0x3c, 0xa6, 0xc4, 0x3d, 0xa0, 0x2a,
-	0x71, 0xf7, 0x0f, 0xca, 0x43, 0x15, 0x5c, 0x53, 0xf4, 0xb9, 0x4f, 0x68, 0x30, 0x65, 0xba, 0x4e,
-	0x60, 0xd0, 0x7e, 0x61, 0x2e, 0xe9, 0x48, 0xae, 0x5a, 0x48, 0x7f, 0xa9, 0x24, 0x90, 0x2d, 0x3c,
-	0x28, 0x3a, 0x30, 0x95, 0x84, 0xf8, 0x38, 0x4d, 0x59, 0xff, 0x9a, 0x06, 0x63, 0x15, 0xdb, 0xed,
-	0x34, 0xd6, 0x3c, 0x77, 0xcb, 0xb2, 0xc9, 0x3b, 0x43, 0x69, 0x53, 0x7b, 0x9c, 0x77, 0x28, 0x33,
-	0x25, 0x4a, 0xad, 0xf8, 0x0e, 0x51, 0xa2, 0xd4, 0x2e, 0xe7, 0x9c, 0x93, 0x3f, 0x04, 0xd3, 0x6a,
-	0xad, 0x50, 0x18, 0xa3, 0x5a, 0xd4, 0x8e, 0xe5, 0x34, 0x92, 0x5a, 0xd4, 0x1d, 0xcb, 0x69, 0x60,
-	0x06, 0x09, 0x2d, 0x0e, 0xa5, 0x3c, 0x8b, 0x83, 0xfe, 0x0b, 0x43, 0xf1, 0x69, 0x63, 0xc7, 0xf0,
-	0xa3, 0x30, 0x6c, 0x1a, 0x0b, 0x1d, 0xa7, 0x61, 0x87, 0x2a, 0x1a, 0x9d, 0x82, 0xca, 0x3c, 0x2f,
-	0xc3, 0x21, 0x14, 0xbd, 0x0e, 0x10, 0x59, 0xeb, 0xc4, 0x37, 0x5e, 0xea, 0xcd, 0x42, 0x58, 0x27,
-	0x41, 0x60, 0x39, 0x4d, 0x3f, 0x5a, 0x57, 0x11, 0x0c, 0x2b, 0xd4, 0xd0, 0x8f, 0xc1, 0xb8, 0xf8,
-	0x82, 0xb5, 0x96, 0xd1, 0x14, 0xc6, 0x8c, 0x82, 0x9f, 0x61, 0x45, 0x41, 0xb4, 0x30, 0x2d, 0x08,
-	0x8f, 0xab, 0xa5, 0x3e, 0x8e, 0x53, 0x43, 0xfb, 0x30, 0xd6, 0x52, 0x0d, 0x34, 0xfd, 0xc5, 0x65,
-	0x25, 0xc5, 0x58, 0xb3, 0x70, 0x49, 0x10, 0x1f, 0x8b, 0x99, 0x76, 0x62, 0xa4, 0x32, 0xf4, 0xcc,
-	0x81, 0xb3, 0xd2, 0x33, 0x09, 0x0c, 0x71, 0x4d, 0xdb, 0x9f, 0x19, 0x64, 0x03, 0xbc, 0x59, 0x64,
-	0x80, 0x5c, 0x69, 0x8f, 0xcc, 0xcf, 0xfc, 0xb7, 0x8f, 0x25, 0x6e, 0xb4, 0x0b, 0x63, 0x54, 0x64,
-	0xa8, 0x13, 0x9b, 0x98, 0x81, 0xeb, 0xcd, 0x0c, 0x15, 0x37, 0xef, 0xd6, 0x15, 0x3c, 0xdc, 0x4e,
-	0xa7, 0x96, 0xe0, 0x18, 0x9d, 0xd0, 0x10, 0x31, 0x9c, 0x6b, 0x88, 0xe8, 0xc0, 0xe8, 0xae, 0x62,
-	0x30, 0x1b, 0x61, 0x93, 0xf0, 0xa1, 0x22, 0x1d, 0x8b, 0xac, 0x67, 0x0b, 0x17, 0x05, 0xa1, 0x51,
-	0xd5, 0xd2, 0xa6, 0xd2, 0xd1, 0x3f, 0x37, 0x0a, 0x53, 0x15, 0xbb, 0xe3, 0x07, 0xc4, 0x9b, 0x17,
-	0x37, 0x50, 0xc4, 0x43, 0x1f, 0xd7, 0xe0, 0x32, 0xfb, 0xb7, 0xea, 0xde, 0x73, 0xaa, 0xc4, 0x36,
-	0xf6, 0xe7, 0xb7, 0x68, 0x8d, 0x46, 0xe3, 0x64, 0xec, 0xad, 0xda, 0x11, 0x22, 0x2a, 0xb3, 0xfc,
-	0xd5, 0x33, 0x31, 0xe2, 0x1c, 0x4a, 0xe8, 0x67, 0x34, 0x78, 0x30, 0x03, 0x54, 0x25, 0x36, 0x09,
-	0xa4, 0x58, 0x74, 0xd2, 0x7e, 0x3c, 0x74, 0x78, 0x50, 0x7e, 0xb0, 0x9e, 0x87, 0x14, 0xe7, 0xd3,
-	0x43, 0x7f, 0x5f, 0x83, 0xd9, 0x0c, 0xe8, 0x92, 0x61, 0xd9, 0x1d, 0x4f, 0x4a, 0x4c, 0x27, 0xed,
-	0x0e, 0x13, 0x5c, 0xea, 0xb9, 0x58, 0x71, 0x17, 0x8a, 0xe8, 0xa3, 0x30, 0x1d, 0x42, 0x37, 0x1c,
-	0x87, 0x90, 0x46, 0x4c, 0x7e, 0x3a, 0x69, 0x57, 0x1e, 0x3c, 0x3c, 0x28, 0x4f, 0xd7, 0xb3, 0x10,
-	0xe2, 0x6c, 0x3a, 0xa8, 0x09, 0x0f, 0x45, 0x80, 0xc0, 0xb2, 0xad, 0xd7, 0xb9, 0x88, 0xb7, 0xed,
-	0x11, 0x7f, 0xdb, 0xb5, 0x1b, 0x8c, 0x59, 0x68, 0x0b, 0xef, 0x3e, 0x3c, 0x28, 0x3f, 0x54, 0xef,
-	0x56, 0x11, 0x77, 0xc7, 0x83, 0x1a, 0x30, 0xe6, 0x9b, 0x86, 0x53, 0x73, 0x02, 0xe2, 0xed, 0x1a,
-	0xf6, 0xcc, 0x60, 0xa1, 0x01, 0xf2, 0x2d, 0xaa, 0xe0, 0xc1, 0x31, 0xac, 0xe8, 0x03, 0x30, 0x4c,
-	0xf6, 0xda, 0x86, 0xd3, 0x20, 0x9c, 0x2d, 0x8c, 0x2c, 0x5c, 0xa5, 0x87, 0xd1, 0xa2, 0x28, 0xbb,
-	0x7f, 0x50, 0x1e, 0x93, 0xff, 0xaf, 0xb8, 0x0d, 0x82, 0xc3, 0xda, 0xe8, 0x23, 0x70, 0x89, 0x5d,
-	0xb6, 0x35, 0x08, 0x63, 0x72, 0xbe, 0x94, 0xa2, 0x87, 0x0b, 0xf5, 0x93, 0x5d, 0x9c, 0xac, 0x64,
-	0xe0, 0xc3, 0x99, 0x54, 0xe8, 0x67, 0x68, 0x19, 0x7b, 0xb7, 0x3c, 0xc3, 0x24, 0x5b, 0x1d, 0x7b,
-	0x9d, 0x78, 0x2d, 0xcb, 0xe1, 0x8a, 0x0a, 0x31, 0x5d, 0xa7, 0x41, 0x59, 0x89, 0xf6, 0xe8, 0x00,
-	0xff, 0x0c, 0x2b, 0xdd, 0x2a, 0xe2, 0xee, 0x78, 0xd0, 0x53, 0x30, 0x66, 0x35, 0x1d, 0xd7, 0x23,
-	0xeb, 0x86, 0xe5, 0x04, 0xfe, 0x0c, 0x30, 0x9b, 0x3e, 0x9b, 0xd6, 0x9a, 0x52, 0x8e, 0x63, 0xb5,
-	0xd0, 0x2e, 0x20, 0x87, 0xdc, 0x5b, 0x73, 0x1b, 0x6c, 0x09, 0x6c, 0xb4, 0xd9, 0x42, 0x9e, 0x19,
-	0x2d, 0x34, 0x35, 0x4c, 0xc9, 0x58, 0x4d, 0x61, 0xc3, 0x19, 0x14, 0xd0, 0x12, 0xa0, 0x96, 0xb1,
-	0xb7, 0xd8, 0x6a, 0x07, 0xfb, 0x0b, 0x1d, 0x7b, 0x47, 0x70, 0x8d, 0x31, 0x36, 0x17, 0x5c, 0xc9,
-	0x4b, 0x41, 0x71, 0x46, 0x0b, 0x64, 0xc0, 0x15, 0x3e, 0x9e, 0xaa, 0x41, 0x5a, 0xae, 0xe3, 0x93,
-	0xc0, 0x57, 0x16, 0xe9, 0xcc, 0x38, 0xbb, 0x22, 0x63, 0x22, 0x7f, 0x2d, 0xbf, 0x1a, 0xee, 0x86,
-	0x23, 0x7e, 0xe9, 0x3c, 0xd1, 0xfd, 0xd2, 0x59, 0xff, 0x5f, 0xfd, 0x30, 0x93, 0x62, 0xd8, 0x77,
-	0xdb, 0x01, 0x3b, 0xde, 0x8e, 0xdc, 0x92, 0xda, 0x29, 0x6d, 0xc9, 0x36, 0x5c, 0x0f, 0x2b, 0xdc,
-	0x6a, 0x77, 0x32, 0x69, 0x95, 0x18, 0xad, 0x47, 0x0e, 0x0f, 0xca, 0xd7, 0xeb, 0x47, 0xd4, 0xc5,
-	0x47, 0x62, 0xcb, 0x67, 0x77, 0x7d, 0xe7, 0xc4, 0xee, 0x3e, 0x02, 0x97, 0x14, 0x80, 0x47, 0x8c,
-	0xc6, 0x7e, 0x0f, 0xec, 0x96, 0xed, 0xf2, 0x7a, 0x06, 0x3e, 0x9c, 0x49, 0x25, 0x97, 0xc7, 0x0c,
-	0x9c, 0x07, 0x8f, 0xd1, 0x0f, 0xfa, 0x60, 0xa4, 0xe2, 0x3a, 0x0d, 0x8b, 0xad, 0xd7, 0x27, 0x62,
-	0xb7, 0x2a, 0x0f, 0xa9, 0xc2, 0xcc, 0xfd, 0x83, 0xf2, 0x78, 0x58, 0x51, 0x91, 0x6e, 0x9e, 0x09,
-	0x4d, 0x99, 0x5c, 0x45, 0x78, 0x77, 0xdc, 0x06, 0x79, 0xff, 0xa0, 0x7c, 0x21, 0x6c, 0x16, 0x37,
-	0x4b, 0x52, 0x06, 0x42, 0xf5, 0xe5, 0x75, 0xcf, 0x70, 0x7c, 0xab, 0x07, 0x0b, 0x45, 0x68, 0x7b,
-	0x5a, 0x4e, 0x61, 0xc3, 0x19, 0x14, 0xd0, 0xab, 0x30, 0x41, 0x4b, 0x37, 0xda, 0x0d, 0x23, 0x20,
-	0x05, 0x0d, 0x13, 0x97, 0x05, 0xcd, 0x89, 0xe5, 0x18, 0x26, 0x9c, 0xc0, 0xcc, 0x6f, 0xa1, 0x0c,
-	0xdf, 0x75, 0xd8, 0xf7, 0x8c, 0xdd, 0x42, 0xd1, 0x52, 0x2c, 0xa0, 0xe8, 0x31, 0x18, 0x6a, 0x11,
-	0xdf, 0x37, 0x9a, 0x84, 0x1d, 0x82, 0x23, 0x91, 0xa4, 0xbb, 0xc2, 0x8b, 0xb1, 0x84, 0xa3, 0xf7,
-	0xc1, 0x80, 0xe9, 0x36, 0x88, 0x3f, 0x33, 0xc4, 0xd8, 0x34, 0x65, 0x79, 0x03, 0x15, 0x5a, 0x70,
-	0xff, 0xa0, 0x3c, 0xc2, 0x2c, 0x75, 0xf4, 0x17, 0xe6, 0x95, 0xf4, 0x5f, 0xa2, 0x5a, 0x6d, 0x42,
-	0x8d, 0x3f, 0xc6, 0xed, 0xd9, 0xf9, 0x5d, 0x44, 0xe9, 0x9f, 0xd2, 0x60, 0x8c, 0xf6, 0xd0, 0x73,
-	0xed, 0x35, 0xdb, 0x70, 0x08, 0xfa, 0x29, 0x0d, 0x26, 0xb7, 0xad, 0xe6, 0xb6, 0x7a, 0xfd, 0x2d,
-	0xa4, 0xd3, 0x42, 0xda, 0xff, 0xed, 0x04, 0xae, 0x85, 0x4b, 0x87, 0x07, 0xe5, 0xc9, 0x64, 0x29,
-	0x4e, 0xd1, 0xd4, 0xdf, 0x2c, 0xc1, 0x25, 0xd1, 0x33, 0x9b, 0x8a, 0x8b, 0x6d, 0xdb, 0xdd, 0x6f,
-	0x11, 0xe7, 0x3c, 0x6e, 0xaa, 0xe5, 0x17, 0x2a, 0xe5, 0x7e, 0xa1, 0x56, 0xea, 0x0b, 0xf5, 0x15,
-	0xf9, 0x42, 0xe1, 0x42, 0x3e, 0xe2, 0x2b, 0xfd, 0x99, 0x06, 0x33, 0x59, 0x73, 0x71, 0x0e, 0x56,
-	0x92, 0x56, 0xdc, 0x4a, 0x72, 0xbb, 0xa8, 0xd9, 0x2b, 0xd9, 0xf5, 0x1c, 0x6b, 0xc9, 0x9f, 0x96,
-	0xe0, 0x72, 0x54, 0xbd, 0xe6, 0xf8, 0x81, 0x61, 0xdb, 0xfc, 0x3c, 0x3f, 0xfb, 0xef, 0xde, 0x8e,
-	0x19, 0xbb, 0x56, 0x7b, 0x1b, 0xaa, 0xda, 0xf7, 0xdc, 0xbb, 0xa8, 0xbd, 0xc4, 0x5d, 0xd4, 0xda,
-	0x29, 0xd2, 0xec, 0x7e, 0x2d, 0xf5, 0xdf, 0x35, 0x98, 0xcd, 0x6e, 0x78, 0x0e, 0x8b, 0xca, 0x8d,
-	0x2f, 0xaa, 0x1f, 0x38, 0xbd, 0x51, 0xe7, 0x2c, 0xab, 0xdf, 0x28, 0xe5, 0x8d, 0x96, 0x59, 0xcc,
-	0xb6, 0xe0, 0x82, 0x47, 0x9a, 0x96, 0x1f, 0x88, 0x4b, 0x93, 0x93, 0x79, 0x13, 0x49, 0x2b, 0xf2,
-	0x05, 0x1c, 0xc7, 0x81, 0x93, 0x48, 0xd1, 0x2a, 0x0c, 0xf9, 0x84, 0x34, 0x28, 0xfe, 0xd2, 0xf1,
-	0xf1, 0x87, 0xa7, 0x51, 0x9d, 0xb7, 0xc5, 0x12, 0x09, 0xfa, 0x61, 0x18, 0x6f, 0x84, 0x3b, 0xea,
-	0x08, 0x57, 0x82, 0x24, 0x56, 0x76, 0xbd, 0x55, 0x55, 0x5b, 0xe3, 0x38, 0x32, 0xfd, 0xaf, 0x34,
-	0xb8, 0xda, 0x6d, 0x6d, 0xa1, 0xd7, 0x00, 0x4c, 0x29, 0x5e, 0x70, 0x67, 0xb2, 0x82, 0x17, 0x60,
-	0xa1, 0x90, 0x12, 0x6d, 0xd0, 0xb0, 0xc8, 0xc7, 0x0a, 0x91, 0x0c, 0x0f, 0x85, 0xd2, 0x19, 0x79,
-	0x28, 0xe8, 0xff, 0x43, 0x53, 0x59, 0x91, 0xfa, 0x6d, 0xdf, 0x69, 0xac, 0x48, 0xed, 0x7b, 0xae,
-	0x05, 0xfe, 0xab, 0x25, 0xb8, 0x9e, 0xdd, 0x44, 0x39, 0x7b, 0x3f, 0x0c, 0x83, 0x6d, 0xee, 0xf1,
-	0xd7, 0xc7, 0xce, 0xc6, 0x47, 0x29, 0x67, 0xe1, 0xfe, 0x78, 0xf7, 0x0f, 0xca, 0xb3, 0x59, 0x8c,
-	0x5e, 0x78, 0xf2, 0x89, 0x76, 0xc8, 0x4a, 0x98, 0x0a, 0xb9, 0xf4, 0xf7, 0xbd, 0xc7, 0x64, 0x2e,
-	0xc6, 0x26, 0xb1, 0x8f, 0x6d, 0x1d, 0xfc, 0x98, 0x06, 0x13, 0xb1, 0x15, 0xed, 0xcf, 0x0c, 0xb0,
-	0x35, 0x5a, 0xe8, 0x72, 0x38, 0xb6, 0x55, 0xa2, 0x93, 0x3b, 0x56, 0xec, 0xe3, 0x04, 0xc1, 0x04,
-	0x9b, 0x55, 0x67, 0xf5, 0x1d, 0xc7, 0x66, 0xd5, 0xce, 0xe7, 0xb0, 0xd9, 0x5f, 0x2c, 0xe5, 0x8d,
-	0x96, 0xb1, 0xd9, 0x7b, 0x30, 0x22, 0x7d, 0xe1, 0x25, 0xbb, 0x58, 0xea, 0xb5, 0x4f, 0x1c, 0x5d,
-	0xe4, 0x18, 0x25, 0x4b, 0x7c, 0x1c, 0xd1, 0x42, 0x3f, 0xa1, 0x01, 0x44, 0x1f, 0x46, 0x6c, 0xaa,
-	0xf5, 0xd3, 0x9b, 0x0e, 0x45, 0xac, 0x99, 0xa0, 0x5b, 0x5a, 0x59, 0x14, 0x0a, 0x5d, 0xfd, 0xff,
-	0xf4, 0x01, 0x4a, 0xf7, 0xfd, 0x78, 0x17, 0x41, 0x47, 0x08, 0xa4, 0xcf, 0xc2, 0x85, 0xa6, 0xed,
-	0x6e, 0x1a, 0xb6, 0xbd, 0x2f, 0x9c, 0xc3, 0x85, 0x9b, 0xf1, 0x45, 0x7a, 0x30, 0xdd, 0x8a, 0x83,
-	0x70, 0xb2, 0x2e, 0x6a, 0xc3, 0xa4, 0x47, 0x4c, 0xd7, 0x31, 0x2d, 0x9b, 0xa9, 0x4e, 0x6e, 0x27,
-	0x28, 0xa8, 0x81, 0x33, 0xf1, 0x1e, 0x27, 0x70, 0xe1, 0x14, 0x76, 0xf4, 0x1e, 0x18, 0x6a, 0x7b,
-	0x56, 0xcb, 0xf0, 0xf6, 0x99, 0x72, 0x36, 0xbc, 0x30, 0x4a, 0x4f, 0xb8, 0x35, 0x5e, 0x84, 0x25,
-	0x0c, 0x7d, 0x04, 0x46, 0x6c, 0x6b, 0x8b, 0x98, 0xfb, 0xa6, 0x4d, 0x84, 0x85, 0xf2, 0xee, 0xe9,
-	0x2c, 0x99, 0x65, 0x89, 0x56, 0x38, 0x5d, 0xc8, 0x9f, 0x38, 0x22, 0x88, 0x6a, 0x70, 0xf1, 0x9e,
-	0xeb, 0xed, 0x10, 0xcf, 0x26, 0xbe, 0x5f, 0xef, 0xb4, 0xdb, 0xae, 0x17, 0x90, 0x06, 0xb3, 0x63,
-	0x0e, 0x73, 0x0f, 0xf8, 0x17, 0xd3, 0x60, 0x9c, 0xd5, 0x46, 0x7f, 0xab, 0x04, 0x57, 0xba, 0x74,
-	0x02, 0x61, 0xba, 0x37, 0xc4, 0x1c, 0x89, 0x95, 0xf0, 0x14, 0x5f, 0xcf, 0xa2, 0xf0, 0xfe, 0x41,
-	0xf9, 0xe1, 0x2e, 0x08, 0xea, 0x74, 0x29, 0x92, 0xe6, 0x3e, 0x8e, 0xd0, 0xa0, 0x1a, 0x0c, 0x36,
-	0x22, 0xb3, 0xfe, 0xc8, 0xc2, 0x13, 0x94, 0x5b, 0x73, 0x03, 0xdc, 0x71, 0xb1, 0x09, 0x04, 0x68,
-	0x19, 0x86, 0xb8, 0xab, 0x06, 0x11, 0x9c, 0xff, 0x49, 0xa6, 0x1e, 0xf3, 0xa2, 0xe3, 0x22, 0x93,
-	0x28, 0xf4, 0xbf, 0xd4, 0x60, 0xa8, 0xe2, 0x7a, 0xa4, 0xba, 0x5a, 0x47, 0xfb, 0x30, 0xaa, 0x04,
-	0xe9, 0x08, 0x2e, 0x58, 0x90, 0x2d, 0x30, 0x8c, 0xf3, 0x11, 0x36, 0xe9, 0x50, 0x1e, 0x16, 0x60,
-	0x95, 0x16, 0x7a, 0x8d, 0xce, 0xf9, 0x3d, 0xcf, 0x0a, 0x28, 0xe1, 0x5e, 0x6e, 0xb8, 0x39, 0x61,
-	0x2c, 0x71, 0xf1, 0x15, 0x15, 0xfe, 0xc4, 0x11, 0x15, 0x7d, 0x8d, 0x72, 0x80, 0x64, 0x37, 0xd1,
-	0x4d, 0xe8, 0x6f, 0xb9, 0x0d, 0xf9, 0xdd, 0xdf, 0x2b, 0xf7, 0xf7, 0x8a, 0xdb, 0xa0, 0x73, 0x7b,
-	0x39, 0xdd, 0x82, 0x99, 0xca, 0x59, 0x1b, 0x7d, 0x15, 0x26, 0x93, 0xf4, 0xd1, 0x4d, 0x98, 0x30,
-	0xdd, 0x56, 0xcb, 0x75, 0xea, 0x9d, 0xad, 0x2d, 0x6b, 0x8f, 0xc4, 0x3c, 0xfd, 0x2b, 0x31, 0x08,
-	0x4e, 0xd4, 0xd4, 0x3f, 0xa3, 0x41, 0x1f, 0xfd, 0x2e, 0x3a, 0x0c, 0x36, 0xdc, 0x96, 0x61, 0x39,
-	0xa2, 0x57, 0x2c, 0xaa, 0xa1, 0xca, 0x4a, 0xb0, 0x80, 0xa0, 0x36, 0x8c, 0x48, 0xa1, 0xa9, 0x27,
-	0x6f, 0xb3, 0xea, 0x6a, 0x3d, 0xf4, 0xd0, 0x0d, 0x39, 0xb9, 0x2c, 0xf1, 0x71, 0x44, 0x44, 0x37,
-	0x60, 0xaa, 0xba, 0x5a, 0xaf, 0x39, 0xa6, 0xdd, 0x69, 0x90, 0xc5, 0x3d, 0xf6, 0x87, 0xf2, 0x12,
-	0x8b, 0x97, 0x88, 0x71, 0x32, 0x5e, 0x22, 0x2a, 0x61, 0x09, 0xa3, 0xd5, 0x08, 0x6f, 0x21, 0xdc,
-	0xf1, 0x59, 0x35, 0x81, 0x04, 0x4b, 0x98, 0xfe, 0xb5, 0x12, 0x8c, 0x2a, 0x1d, 0x42, 0x36, 0x0c,
-	0xf1, 0xe1, 0x4a, 0x6f, 0xd8, 0xc5, 0x82, 0x43, 0x8c, 0xf7, 0x9a, 0x53, 0xe7, 0x13, 0xea, 0x63,
-	0x49, 0x42, 0xe5, 0x8b, 0xa5, 0x2e, 0x7c, 0x71, 0x0e, 0xc0, 0x8f, 0x62, 0x43, 0xf8, 0x96, 0x64,
-	0x47, 0x8f, 0x12, 0x11, 0xa2, 0xd4, 0x40, 0x57, 0xc5, 0x09, 0xc2, 0xdd, 0xbd, 0x86, 0x13, 0xa7,
-	0xc7, 0x16, 0x0c, 0xbc, 0xee, 0x3a, 0xc4, 0x17, 0x76, 0xcf, 0x53, 0x1a, 0xe0, 0x08, 0x95, 0x0f,
-	0x5e, 0xa6, 0x78, 0x31, 0x47, 0xaf, 0xff, 0xb2, 0x06, 0x50, 0x35, 0x02, 0x83, 0xdf, 0x9b, 0x1e,
-	0x23, 0xa2, 0xe2, 0x6a, 0xec, 0xe0, 0x1b, 0x4e, 0x79, 0x99, 0xf7, 0xfb, 0xd6, 0xeb, 0x72, 0xf8,
-	0xa1, 0x40, 0xcd, 0xb1, 0xd7, 0xad, 0xd7, 0x09, 0x66, 0x70, 0xf4, 0x38, 0x8c, 0x10, 0xc7, 0xf4,
-	0xf6, 0xdb, 0x94, 0x79, 0xf7, 0xb3, 0x59, 0x65, 0x3b, 0x74, 0x51, 0x16, 0xe2, 0x08, 0xae, 0x3f,
-	0x01, 0x71, 0xad, 0xe8, 0xe8, 0x5e, 0xea, 0xdf, 0xe8, 0x87, 0x07, 0x17, 0xd7, 0x2b, 0x55, 0x81,
-	0xcf, 0x72, 0x9d, 0x3b, 0x64, 0xff, 0x3b, 0x0e, 0x6c, 0xdf, 0x71, 0x60, 0x3b, 0x45, 0x07, 0xb6,
-	0xe7, 0x60, 0x32, 0x5a, 0x5e, 0xc2, 0xbb, 0xe3, 0xf1, 0xa4, 0x3c, 0x3d, 0x22, 0x4f, 0x9e, 0xb4,
-	0x0c, 0xac, 0xdf, 0xd7, 0x60, 0x72, 0x71, 0xaf, 0x6d, 0x79, 0x2c, 0x14, 0x88, 0x78, 0x54, 0x0f,
-	0x46, 0x8f, 0xc1, 0xd0, 0x2e, 0xff, 0x57, 0xac, 0xce, 0xd0, 0xd6, 0x20, 0x6a, 0x60, 0x09, 0x47,
-	0x5b, 0x30, 0x41, 0x58, 0x73, 0x26, 0xf0, 0x1a, 0x41, 0x91, 0x15, 0xc8, 0x23, 0xcd, 0x62, 0x58,
-	0x70, 0x02, 0x2b, 0xaa, 0xc3, 0x84, 0x69, 0x1b, 0xbe, 0x6f, 0x6d, 0x59, 0x66, 0xe4, 0xe4, 0x3a,
-	0xb2, 0xf0, 0x38, 0x3b, 0xbb, 0x62, 0x90, 0xfb, 0x07, 0xe5, 0x69, 0xd1, 0xcf, 0x38, 0x00, 0x27,
-	0x50, 0xe8, 0x6f, 0x97, 0x60, 0x7c, 0x71, 0xaf, 0xed, 0xfa, 0x1d, 0x8f, 0xb0, 0xaa, 0xe7, 0xa0,
-	0xc2, 0x3f, 0x06, 0x43, 0xdb, 0x86, 0xd3, 0xb0, 0x89, 0x27, 0xd8, 0x57, 0x38, 0xb7, 0xb7, 0x79,
-	0x31, 0x96, 0x70, 0xf4, 0x06, 0x80, 0x6f, 0x6e, 0x93, 0x46, 0x87, 0x89, 0x40, 0x7c, 0x97, 0xdd,
-	0x29, 0xc2, 0x84, 0x63, 0x63, 0xac, 0x87, 0x28, 0xc5, 0xd1, 0x10, 0xfe, 0xc6, 0x0a, 0x39, 0xfd,
-	0xeb, 0x1a, 0x4c, 0xc5, 0xda, 0x9d, 0x83, 0x66, 0xba, 0x15, 0xd7, 0x4c, 0xe7, 0x7b, 0x1e, 0x6b,
-	0x8e, 0x42, 0xfa, 0xd3, 0x25, 0x78, 0x20, 0x67, 0x4e, 0x52, 0x4e, 0x4b, 0xda, 0x39, 0x39, 0x2d,
-	0x75, 0x60, 0x34, 0x70, 0x6d, 0xe1, 0x8b, 0x2d, 0x67, 0xa0, 0x90, 0x4b, 0xd2, 0x7a, 0x88, 0x26,
-	0x72, 0x49, 0x8a, 0xca, 0x7c, 0xac, 0xd2, 0xd1, 0x3f, 0xaf, 0xc1, 0x48, 0x68, 0x00, 0xfb, 0xb6,
-	0xba, 0x84, 0x3a, 0x7e, 0x70, 0xac, 0xfe, 0x87, 0x25, 0xb8, 0x1c, 0xe2, 0x96, 0x6c, 0xae, 0x1e,
-	0x50, 0xbe, 0x71, 0xb4, 0x16, 0x7d, 0x35, 0xe6, 0x4e, 0x39, 0x9c, 0x10, 0x35, 0xa8, 0xe0, 0xd5,
-	0xf1, 0xda, 0xae, 0x2f, 0xe5, 0x09, 0x2e, 0x78, 0xf1, 0x22, 0x2c, 0x61, 0x68, 0x15, 0x06, 0x7c,
-	0x4a, 0x4f, 0x1c, 0x47, 0x27, 0x9c, 0x0d, 0x26, 0x12, 0xb1, 0xfe, 0x62, 0x8e, 0x06, 0xbd, 0xa1,
-	0xf2, 0xf0, 0x81, 0xe2, 0x76, 0x1a, 0x3a, 0x92, 0x86, 0x9c, 0x91, 0x8c, 0x80, 0xb1, 0xcc, 0x33,
-	0x61, 0x19, 0x26, 0x85, 0xdf, 0x13, 0x5f, 0x36, 0x8e, 0x49, 0xd0, 0x07, 0x62, 0x2b, 0xe3, 0x91,
-	0xc4, 0x35, 0xf4, 0xa5, 0x64, 0xfd, 0x68, 0xc5, 0xe8, 0x3e, 0x0c, 0xdf, 0x12, 0x9d, 0x44, 0xb3,
-	0x50, 0xb2, 0xe4, 0xb7, 0x00, 0x81, 0xa3, 0x54, 0xab, 0xe2, 0x92, 0x75, 0x0c, 0xb7, 0x56, 0xf5,
-	0x58, 0xea, 0xeb, 0x7e, 0x2c, 0xe9, 0xdf, 0x2c, 0xc1, 0x25, 0x49, 0x55, 0x8e, 0xb1, 0x2a, 0x2e,
-	0xf1, 0x8e, 0x10, 0x2e, 0x8f, 0xb6, 0xaa, 0xdc, 0x85, 0x7e, 0xc6, 0x00, 0x0b, 0x5d, 0xee, 0x85,
-	0x08, 0x69, 0x77, 0x30, 0x43, 0x84, 0x3e, 0x02, 0x83, 0xb6, 0xb1, 0x49, 0x6c, 0xe9, 0x6f, 0x5a,
-	0xc8, 0x06, 0x95, 0x35, 0x5c, 0x6e, 0x1a, 0xf5, 0x79, 0xc0, 0x4e, 0x78, 0xe7, 0xc3, 0x0b, 0xb1,
-	0xa0, 0x39, 0xfb, 0x0c, 0x8c, 0x2a, 0xd5, 0xd0, 0x24, 0xf4, 0xed, 0x10, 0x7e, 0xb9, 0x3b, 0x82,
-	0xe9, 0xbf, 0xe8, 0x12, 0x0c, 0xec, 0x1a, 0x76, 0x47, 0x4c, 0x09, 0xe6, 0x3f, 0x6e, 0x96, 0x3e,
-	0xa0, 0xe9, 0x9f, 0xd3, 0x60, 0xf4, 0xb6, 0xb5, 0x49, 0x3c, 0xee, 0xbc, 0xc4, 0x74, 0xa9, 0x58,
-	0x6e, 0x82, 0xd1, 0xac, 0xbc, 0x04, 0x68, 0x0f, 0x46, 0xc4, 0x49, 0x13, 0x3a, 0xce, 0xdf, 0x2a,
-	0x76, 0x8b, 0x1c, 0x92, 0x16, 0x1c, 0x5c, 0x8d, 0x85, 0x94, 0x14, 0x70, 0x44, 0x4c, 0x7f, 0x03,
-	0x2e, 0x66, 0x34, 0x42, 0x65, 0xb6, 0x7d, 0xbd, 0x40, 0x2c, 0x0b, 0xb9, 0x1f, 0xbd, 0x00, 0xf3,
-	0x72, 0xf4, 0x20, 0xf4, 0x11, 0xa7, 0x21, 0xd6, 0xc4, 0xd0, 0xe1, 0x41, 0xb9, 0x6f, 0xd1, 0x69,
-	0x60, 0x5a, 0x46, 0xd9, 0x94, 0xed, 0xc6, 0x64, 0x12, 0xc6, 0xa6, 0x96, 0x45, 0x19, 0x0e, 0xa1,
-	0xec, 0xde, 0x3f, 0x79, 0xc5, 0x4d, 0xc5, 0xdb, 0xc9, 0xad, 0xc4, 0xee, 0xe9, 0xe5, 0x66, 0x3d,
-	0xb9, 0x13, 0x17, 0x66, 0xc4, 0x84, 0xa4, 0xf6, 0x34, 0x4e, 0xd1, 0xd5, 0x7f, 0xbb, 0x1f, 0x1e,
-	0xba, 0xed, 0x7a, 0xd6, 0xeb, 0xae, 0x13, 0x18, 0xf6, 0x9a, 0xdb, 0x88, 0xbc, 0x9e, 0x04, 0x53,
-	0xfe, 0x49, 0x0d, 0x1e, 0x30, 0xdb, 0x1d, 0x2e, 0x1e, 0x4b, 0xc7, 0xa1, 0x35, 0xe2, 0x59, 0x6e,
-	0x51, 0x6f, 0x55, 0x16, 0xfd, 0x5e, 0x59, 0xdb, 0xc8, 0x42, 0x89, 0xf3, 0x68, 0x31, 0xa7, 0xd9,
-	0x86, 0x7b, 0xcf, 0x61, 0x9d, 0xab, 0x07, 0x6c, 0x36, 0x5f, 0x8f, 0x3e, 0x42, 0x41, 0xa7, 0xd9,
-	0x6a, 0x26, 0x46, 0x9c, 0x43, 0x09, 0x7d, 0x14, 0xa6, 0x2d, 0xde, 0x39, 0x4c, 0x8c, 0x86, 0xe5,
-	0x10, 0xdf, 0xe7, 0x1e, 0x77, 0x3d, 0x78, 0x85, 0xd6, 0xb2, 0x10, 0xe2, 0x6c, 0x3a, 0xe8, 0x15,
-	0x00, 0x7f, 0xdf, 0x31, 0xc5, 0xfc, 0x17, 0x73, 0x4f, 0xe2, 0x42, 0x60, 0x88, 0x05, 0x2b, 0x18,
-	0xa9, 0x2a, 0x11, 0x84, 0x8b, 0x72, 0x90, 0xb9, 0x98, 0x31, 0x55, 0x22, 0x5a, 0x43, 0x11, 0x5c,
-	0xff, 0x67, 0x1a, 0x0c, 0x89, 0x0c, 0x1b, 0xe8, 0xbd, 0x09, 0x33, 0x51, 0xc8, 0x7b, 0x12, 0xa6,
-	0xa2, 0x7d, 0x76, 0x57, 0x28, 0x4c, 0x84, 0x42, 0x94, 0x28, 0x64, 0x67, 0x10, 0x84, 0x23, 0x7b,
-	0x63, 0xec, 0xce, 0x50, 0xda, 0x20, 0x15, 0x62, 0xfa, 0x67, 0x35, 0x98, 0x4a, 0xb5, 0x3a, 0x86,
-	0xbc, 0x70, 0x8e, 0x6e, 0x38, 0x5f, 0xed, 0x87, 0x09, 0xe6, 0x32, 0xeb, 0x18, 0x36, 0xb7, 0xe0,
-	0x9c, 0x83, 0x82, 0xf2, 0x38, 0x8c, 0x58, 0xad, 0x56, 0x27, 0xa0, 0xac, 0x5a, 0x18, 0xe1, 0xd9,
-	0x37, 0xaf, 0xc9, 0x42, 0x1c, 0xc1, 0x91, 0x23, 0x8e, 0x42, 0xce, 0xc4, 0x97, 0x8b, 0x7d, 0x39,
-	0x75, 0x80, 0x73, 0xf4, 0xd8, 0xe2, 0xe7, 0x55, 0xd6, 0x49, 0xf9, 0x53, 0x1a, 0x80, 0x1f, 0x78,
-	0x96, 0xd3, 0xa4, 0x85, 0xe2, 0xb8, 0xc4, 0xa7, 0x40, 0xb6, 0x1e, 0x22, 0xe5, 0xc4, 0xc3, 0x39,
-	0x8a, 0x00, 0x58, 0xa1, 0x8c, 0xe6, 0x85, 0x94, 0xc0, 0x39, 0xfe, 0x77, 0x27, 0xe4, 0xa1, 0x87,
-	0xd2, 0x09, 0xa4, 0x44, 0xd4, 0x75, 0x24, 0x46, 0xcc, 0x3e, 0x0d, 0x23, 0x21, 0xbd, 0xa3, 0x4e,
-	0xdd, 0x31, 0xe5, 0xd4, 0x9d, 0x7d, 0x16, 0x2e, 0x24, 0xba, 0x7b, 0xa2, 0x43, 0xfb, 0x3f, 0x6a,
-	0x80, 0xe2, 0xa3, 0x3f, 0x07, 0xd5, 0xae, 0x19, 0x57, 0xed, 0x16, 0x7a, 0xff, 0x64, 0x39, 0xba,
-	0xdd, 0xd7, 0x27, 0x80, 0x25, 0x20, 0x0a, 0x13, 0x3c, 0x89, 0x83, 0x8b, 0x9e, 0xb3, 0x51, 0x9c,
-	0x91, 0xd8, 0xb9, 0x3d, 0x9c, 0xb3, 0x77, 0x12, 0xb8, 0xa2, 0x73, 0x36, 0x09, 0xc1, 0x29, 0xba,
-	0xe8, 0x4d, 0x0d, 0x26, 0x8d, 0x78, 0x02, 0x22, 0x39, 0x33, 0x85, 0x02, 0xdc, 0x13, 0xc9, 0x8c,
-	0xa2, 0xbe, 0x24, 0x00, 0x3e, 0x4e, 0x91, 0x45, 0x4f, 0xc1, 0x98, 0xd1, 0xb6, 0xe6, 0x3b, 0x0d,
-	0x8b, 0xaa, 0x06, 0x32, 0x7b, 0x0c, 0x53, 0x57, 0xe7, 0xd7, 0x6a, 0x61, 0x39, 0x8e, 0xd5, 0x0a,
-	0x33, 0xfd, 0x88, 0x89, 0xec, 0xef, 0x31, 0xd3, 0x8f, 0x98, 0xc3, 0x28, 0xd3, 0x8f, 0x98, 0x3a,
-	0x95, 0x08, 0x72, 0x00, 0x5c, 0xab, 0x61, 0x0a, 0x92, 0xfc, 0xda, 0xaf, 0x90, 0x86, 0x7c, 0xb7,
-	0x56, 0xad, 0x08, 0x8a, 0xec, 0xf4, 0x8b, 0x7e, 0x63, 0x85, 0x02, 0xfa, 0x94, 0x06, 0xe3, 0x82,
-	0x77, 0x0b, 0x9a, 0x43, 0xec, 0x13, 0xbd, 0x5c, 0x74, 0xbd, 0x24, 0xd6, 0xe4, 0x1c, 0x56, 0x91,
-	0x73, 0xbe, 0x13, 0x86, 0xa9, 0xc5, 0x60, 0x38, 0xde, 0x0f, 0xf4, 0x0f, 0x34, 0xb8, 0xe4, 0x13,
-	0x6f, 0xd7, 0x32, 0xc9, 0xbc, 0x69, 0xba, 0x1d, 0x47, 0x7e, 0x87, 0xe1, 0xe2, 0x89, 0x51, 0xea,
-	0x19, 0xf8, 0x84, 0xe7, 0x74, 0x06, 0x04, 0x67, 0xd2, 0xa7, 0x62, 0xd9, 0x85, 0x7b, 0x46, 0x60,
-	0x6e, 0x57, 0x0c, 0x73, 0x9b, 0x19, 0xdb, 0x79, 0x48, 0x44, 0xc1, 0x75, 0xfd, 0x62, 0x1c, 0x15,
-	0xbf, 0xb6, 0x4e, 0x14, 0xe2, 0x24, 0x41, 0xe4, 0xc2, 0xb0, 0x27, 0xb2, 0xba, 0xcd, 0x40, 0x71,
-	0x91, 0x22, 0x95, 0x22, 0x8e, 0x0b, 0xf6, 0xf2, 0x17, 0x0e, 0x89, 0xa0, 0x26, 0x3c, 0xc4, 0x55,
-	0x9b, 0x79, 0xc7, 0x75, 0xf6, 0x5b, 0x6e, 0xc7, 0x9f, 0xef, 0x04, 0xdb, 0xc4, 0x09, 0xa4, 0xad,
-	0x72, 0x94, 0x1d, 0xa3, 0x2c, 0x12, 0x60, 0xb1, 0x5b, 0x45, 0xdc, 0x1d, 0x0f, 0x7a, 0x09, 0x86,
-	0xc9, 0x2e, 0x71, 0x82, 0xf5, 0xf5, 0x65, 0x16, 0x5d, 0x71, 0x72, 0x69, 0x8f, 0x0d, 0x61, 0x51,
-	0xe0, 0xc0, 0x21, 0x36, 0xb4, 0x03, 0x43, 0x36, 0x4f, 0xcb, 0xc7, 0xa2, 0x2c, 0x0a, 0x32, 0xc5,
-	0x64, 0x8a, 0x3f, 0xae, 0xff, 0x89, 0x1f, 0x58, 0x52, 0x40, 0x6d, 0xb8, 0xde, 0x20, 0x5b, 0x46,
-	0xc7, 0x0e, 0x56, 0xdd, 0x00, 0x33, 0xb7, 0xfb, 0xd0, 0x24, 0x25, 0x03, 0x69, 0x26, 0x58, 0x0e,
-	0x03, 0x16, 0xd0, 0x50, 0x3d, 0xa2, 0x2e, 0x3e, 0x12, 0x1b, 0xda, 0x87, 0x87, 0x45, 0x1d, 0xe6,
-	0xe7, 0x6f, 0x6e, 0xd3, 0x59, 0x4e, 0x13, 0xbd, 0xc0, 0x88, 0xfe, 0x7f, 0x87, 0x07, 0xe5, 0x87,
-	0xab, 0x47, 0x57, 0xc7, 0xc7, 0xc1, 0xc9, 0x5c, 0xa7, 0x49, 0xc2, 0x46, 0x3f, 0x33, 0x59, 0x7c,
-	0x8e, 0x93, 0xf6, 0x7e, 0xee, 0x5b, 0x91, 0x2c, 0xc5, 0x29, 0x9a, 0xb3, 0x1f, 0x06, 0x94, 0x66,
-	0x38, 0x47, 0x49, 0x0e, 0xc3, 0xaa, 0xe4, 0xf0, 0xe9, 0x01, 0xb8, 0x42, 0xf9, 0x58, 0x24, 0x2f,
-	0xaf, 0x18, 0x8e, 0xd1, 0xfc, 0xf6, 0x3c, 0x63, 0x3f, 0xa7, 0xc1, 0x03, 0xdb, 0xd9, 0xba, 0xac,
-	0x90, 0xd8, 0x9f, 0x2f, 0x64, 0x73, 0xe8, 0xa6, 0x1e, 0xf3, 0x2d, 0xde, 0xb5, 0x0a, 0xce, 0xeb,
-	0x14, 0xfa, 0x30, 0x4c, 0x3a, 0x6e, 0x83, 0x54, 0x6a, 0x55, 0xbc, 0x62, 0xf8, 0x3b, 0x75, 0x79,
-	0x87, 0x39, 0xc0, 0xbf, 0xf0, 0x6a, 0x02, 0x86, 0x53, 0xb5, 0xd1, 0x2e, 0xa0, 0xb6, 0xdb, 0x58,
-	0xdc, 0xb5, 0x4c, 0x79, 0x7b, 0x56, 0xdc, 0x63, 0x87, 0x5d, 0xd1, 0xad, 0xa5, 0xb0, 0xe1, 0x0c,
-	0x0a, 0x4c, 0x19, 0xa7, 0x9d, 0x59, 0x71, 0x1d, 0x2b, 0x70, 0x3d, 0x16, 0xd6, 0xd6, 0x93, 0x4e,
-	0xca, 0x94, 0xf1, 0xd5, 0x4c, 0x8c, 0x38, 0x87, 0x92, 0xfe, 0x3f, 0x35, 0xb8, 0x40, 0x97, 0xc5,
-	0x9a, 0xe7, 0xee, 0xed, 0x7f, 0x3b, 0x2e, 0xc8, 0xc7, 0x84, 0x3b, 0x07, 0x37, 0x22, 0x4d, 0x2b,
-	0xae, 0x1c, 0x23, 0xac, 0xcf, 0x91, 0xf7, 0x86, 0x6a, 0x47, 0xeb, 0xcb, 0xb7, 0xa3, 0xe9, 0x9f,
-	0x2a, 0x71, 0x59, 0x57, 0xda, 0xb1, 0xbe, 0x2d, 0xf7, 0xe1, 0xd3, 0x30, 0x4e, 0xcb, 0x56, 0x8c,
-	0xbd, 0xb5, 0xea, 0x0b, 0xae, 0x2d, 0x83, 0x92, 0x98, 0xa3, 0xf1, 0x1d, 0x15, 0x80, 0xe3, 0xf5,
-	0xd0, 0x4d, 0x18, 0x6a, 0xf3, 0xfc, 0x05, 0x42, 0xcb, 0xba, 0xce, 0x7d, 0x1e, 0x58, 0xd1, 0xfd,
-	0x83, 0xf2, 0x54, 0x74, 0x6b, 0x23, 0xb3, 0x28, 0xc8, 0x06, 0xfa, 0xdf, 0x5c, 0x04, 0x86, 0xdc,
-	0x26, 0xc1, 0xb7, 0xe3, 0x9c, 0x3c, 0x01, 0xa3, 0x66, 0xbb, 0x53, 0x59, 0xaa, 0x3f, 0xdf, 0x71,
-	0x99, 0xf6, 0xcc, 0xf2, 0xb8, 0x52, 0xe1, 0xb7, 0xb2, 0xb6, 0x21, 0x8b, 0xb1, 0x5a, 0x87, 0x72,
-	0x07, 0xb3, 0xdd, 0x11, 0xfc, 0x76, 0x4d, 0xf5, 0xb6, 0x65, 0xdc, 0xa1, 0xb2, 0xb6, 0x11, 0x83,
-	0xe1, 0x54, 0x6d, 0xf4, 0x51, 0x18, 0x23, 0x62, 0xe3, 0xde, 0x36, 0xbc, 0x86, 0xe0, 0x0b, 0xb5,
-	0xa2, 0x83, 0x0f, 0xa7, 0x56, 0x72, 0x03, 0xae, 0x33, 0x2c, 0x2a, 0x24, 0x70, 0x8c, 0x20, 0xfa,
-	0x21, 0x78, 0x50, 0xfe, 0xa6, 0x5f, 0xd9, 0x6d, 0x24, 0x19, 0xc5, 0x00, 0x0f, 0x19, 0x5f, 0xcc,
-	0xab, 0x84, 0xf3, 0xdb, 0xa3, 0x5f, 0xd3, 0xe0, 0x72, 0x08, 0xb5, 0x1c, 0xab, 0xd5, 0x69, 0x61,
-	0x62, 0xda, 0x86, 0xd5, 0x12, 0x9a, 0xc2, 0x8b, 0xa7, 0x36, 0xd0, 0x38, 0x7a, 0xce, 0xac, 0xb2,
-	0x61, 0x38, 0xa7, 0x4b, 0xe8, 0xb3, 0x1a, 0x5c, 0x97, 0xa0, 0x35, 0x8f, 0xf8, 0x7e, 0xc7, 0x23,
-	0x51, 0x48, 0x9c, 0x98, 0x92, 0xa1, 0x42, 0xbc, 0x93, 0x89, 0x4c, 0x8b, 0x47, 0xe0, 0xc6, 0x47,
-	0x52, 0x57, 0x97, 0x4b, 0xdd, 0xdd, 0x0a, 0x84, 0x6a, 0x71, 0x56, 0xcb, 0x85, 0x92, 0xc0, 0x31,
-	0x82, 0xe8, 0x9f, 0x6b, 0xf0, 0x80, 0x5a, 0xa0, 0xae, 0x16, 0xae, 0x53, 0xbc, 0x74, 0x6a, 0x9d,
-	0x49, 0xe0, 0xe7, 0x46, 0xe9, 0x1c, 0x20, 0xce, 0xeb, 0x15, 0x65, 0xdb, 0x2d, 0xb6, 0x30, 0xb9,
-	0xde, 0x31, 0xc0, 0xd9, 0x36, 0x5f, 0xab, 0x3e, 0x96, 0x30, 0xaa, 0x71, 0xb7, 0xdd, 0xc6, 0x9a,
-	0xd5, 0xf0, 0x97, 0xad, 0x96, 0x15, 0x30, 0xed, 0xa0, 0x8f, 0x4f, 0xc7, 0x9a, 0xdb, 0x58, 0xab,
-	0x55, 0x79, 0x39, 0x8e, 0xd5, 0x42, 0x73, 0x00, 0x5b, 0x86, 0x65, 0xd7, 0xef, 0x19, 0xed, 0xbb,
-	0x32, 0x14, 0x9a, 0x69, 0xaf, 0x4b, 0x61, 0x29, 0x56, 0x6a, 0xd0, 0xef, 0x47, 0xf9, 0x0e, 0x26,
-	0x3c, 0xd1, 0x17, 0x13, 0xa8, 0x4f, 0xe3, 0xfb, 0x49, 0x84, 0xbc, 0xc3, 0x77, 0x14, 0x12, 0x38,
-	0x46, 0x10, 0xfd, 0xa4, 0x06, 0x13, 0xfe, 0xbe, 0x1f, 0x90, 0x56, 0xd8, 0x87, 0x0b, 0xa7, 0xdd,
-	0x07, 0x66, 0x45, 0xad, 0xc7, 0x88, 0xe0, 0x04, 0x51, 0x16, 0x54, 0xde, 0x32, 0x9a, 0xe4, 0x56,
-	0xe5, 0xb6, 0xd5, 0xdc, 0x0e, 0x83, 0x9c, 0xd7, 0x88, 0x67, 0x12, 0x27, 0x60, 0xa2, 0xf8, 0x80,
-	0x08, 0x2a, 0xcf, 0xaf, 0x86, 0xbb, 0xe1, 0x40, 0xaf, 0xc0, 0xac, 0x00, 0x2f, 0xbb, 0xf7, 0x52,
-	0x14, 0xa6, 0x18, 0x05, 0xe6, 0x76, 0x54, 0xcb, 0xad, 0x85, 0xbb, 0x60, 0x40, 0x35, 0xb8, 0xe8,
-	0x13, 0x8f, 0x5d, 0x82, 0xf0, 0x4c, 0x35, 0x6b, 0x1d, 0xdb, 0xf6, 0x67, 0x50, 0xe4, 0x71, 0x5c,
-	0x4f, 0x83, 0x71, 0x56, 0x1b, 0xf4, 0x6c, 0x18, 0xd4, 0xb4, 0x4f, 0x0b, 0x9e, 0x5f, 0xab, 0xcf,
-	0x5c, 0x64, 0xfd, 0xbb, 0xa8, 0xc4, 0x2a, 0x49, 0x10, 0x4e, 0xd6, 0xa5, 0xa7, 0xb9, 0x2c, 0x5a,
-	0xe8, 0x78, 0x7e, 0x30, 0x73, 0x89, 0x35, 0x66, 0xa7, 0x39, 0x56, 0x01, 0x38, 0x5e, 0x0f, 0xdd,
-	0x84, 0x09, 0x9f, 0x98, 0xa6, 0xdb, 0x6a, 0x0b, 0xcd, 0x6a, 0x66, 0x9a, 0xf5, 0x9e, 0x7f, 0xc1,
-	0x18, 0x04, 0x27, 0x6a, 0xa2, 0x7d, 0xb8, 0x18, 0xa6, 0xbd, 0x5a, 0x76, 0x9b, 0x2b, 0xc6, 0x1e,
-	0x13, 0x8e, 0x2f, 0x1f, 0xcd, 0x1f, 0xe7, 0xe4, 0xad, 0xf6, 0xdc, 0xf3, 0x1d, 0xc3, 0x09, 0xac,
-	0x60, 0x9f, 0x4f, 0x57, 0x25, 0x8d, 0x0e, 0x67, 0xd1, 0x40, 0xcb, 0x70, 0x29, 0x51, 0xbc, 0x64,
-	0xd9, 0xc4, 0x9f, 0x79, 0x80, 0x0d, 0x9b, 0x99, 0x47, 0x2a, 0x19, 0x70, 0x9c, 0xd9, 0x0a, 0xdd,
-	0x85, 0xe9, 0xb6, 0xe7, 0x06, 0xc4, 0x0c, 0xee, 0x50, 0x81, 0xc0, 0x16, 0x03, 0xf4, 0x67, 0x66,
-	0xd8, 0x5c, 0xb0, 0x0b, 0xa0, 0xb5, 0xac, 0x0a, 0x38, 0xbb, 0x1d, 0xfa, 0xb4, 0x06, 0xd7, 0xfc,
-	0xc0, 0x23, 0x46, 0xcb, 0x72, 0x9a, 0x15, 0xd7, 0x71, 0x08, 0x63, 0x4c, 0xb5, 0x46, 0xe4, 0xb0,
-	0xff, 0x60, 0xa1, 0x53, 0x44, 0x3f, 0x3c, 0x28, 0x5f, 0xab, 0x77, 0xc5, 0x8c, 0x8f, 0xa0, 0x8c,
-	0xde, 0x00, 0x68, 0x91, 0x96, 0xeb, 0xed, 0x53, 0x8e, 0x34, 0x33, 0x5b, 0xdc, 0x7f, 0x69, 0x25,
-	0xc4, 0xc2, 0xb7, 0x7f, 0xec, 0xea, 0x2a, 0x02, 0x62, 0x85, 0x9c, 0x7e, 0x50, 0x82, 0xe9, 0x4c,
-	0x56, 0x4f, 0x77, 0x00, 0xaf, 0x37, 0x2f, 0x53, 0x60, 0x8b, 0xdb, 0x1e, 0xb6, 0x03, 0x56, 0xe2,
-	0x20, 0x9c, 0xac, 0x4b, 0x05, 0x31, 0xb6, 0x53, 0x97, 0xea, 0x51, 0xfb, 0x52, 0x24, 0x88, 0xd5,
-	0x12, 0x30, 0x9c, 0xaa, 0x8d, 0x2a, 0x30, 0x25, 0xca, 0x6a, 0x54, 0x97, 0xf1, 0x97, 0x3c, 0x22,
-	0x45, 0x5c, 0xaa, 0x15, 0x4c, 0xd5, 0x92, 0x40, 0x9c, 0xae, 0x4f, 0x47, 0x41, 0x7f, 0xa8, 0xbd,
-	0xe8, 0x8f, 0x46, 0xb1, 0x1a, 0x07, 0xe1, 0x64, 0x5d, 0xa9, 0x6c, 0xc6, 0xba, 0x30, 0x10, 0x8d,
-	0x62, 0x35, 0x01, 0xc3, 0xa9, 0xda, 0xfa, 0x7f, 0xea, 0x87, 0x87, 0x8f, 0x21, 0x1e, 0xa1, 0x56,
-	0xf6, 0x74, 0x9f, 0x7c, 0xe3, 0x1e, 0xef, 0xf3, 0xb4, 0x73, 0x3e, 0xcf, 0xc9, 0xe9, 0x1d, 0xf7,
-	0x73, 0xfa, 0x79, 0x9f, 0xf3, 0xe4, 0x24, 0x8f, 0xff, 0xf9, 0x5b, 0xd9, 0x9f, 0xbf, 0xe0, 0xac,
-	0x1e, 0xb9, 0x5c, 0xda, 0x39, 0xcb, 0xa5, 0xe0, 0xac, 0x1e, 0x63, 0x79, 0xfd, 0x71, 0x3f, 0x3c,
-	0x72, 0x1c, 0x51, 0xad, 0xe0, 0xfa, 0xca, 0x60, 0x79, 0x67, 0xba, 0xbe, 0xf2, 0x62, 0xa2, 0xce,
-	0x70, 0x7d, 0x65, 0x90, 0x3c, 0xeb, 0xf5, 0x95, 0x37, 0xab, 0x67, 0xb5, 0xbe, 0xf2, 0x66, 0xf5,
-	0x18, 0xeb, 0xeb, 0x2f, 0x92, 0xe7, 0x43, 0x28, 0x2f, 0xd6, 0xa0, 0xcf, 0x6c, 0x77, 0x0a, 0x32,
-	0x29, 0xe6, 0x1b, 0x54, 0x59, 0xdb, 0xc0, 0x14, 0x07, 0xc2, 0x30, 0xc8, 0xd7, 0x4f, 0x41, 0x16,
-	0xc4, 0xa2, 0x6b, 0xf8, 0x92, 0xc4, 0x02, 0x13, 0x9d, 0x2a, 0xd2, 0xde, 0x26, 0x2d, 0xe2, 0x19,
-	0x76, 0x3d, 0x70, 0x3d, 0xa3, 0x59, 0x94, 0xdb, 0x70, 0xc3, 0x71, 0x02, 0x17, 0x4e, 0x61, 0xa7,
-	0x13, 0xd2, 0xb6, 0x1a, 0x05, 0xf9, 0x0b, 0x9b, 0x90, 0xb5, 0x5a, 0x15, 0x53, 0x1c, 0xfa, 0x17,
-	0x87, 0x41, 0xc9, 0xfc, 0x88, 0xde, 0xd2, 0x60, 0xca, 0x4c, 0xe6, 0x57, 0xea, 0xc5, 0x0d, 0x24,
-	0x95, 0xac, 0x89, 0x2f, 0xf9, 0x54, 0x31, 0x4e, 0x93, 0x45, 0x3f, 0xae, 0x71, 0x4b, 0x55, 0x78,
-	0x89, 0x21, 0xa6, 0xf5, 0xd6, 0x29, 0x5d, 0xf7, 0x45, 0x26, 0xaf, 0xe8, 0x66, 0x29, 0x4e, 0x10,
-	0x7d, 0x56, 0x83, 0xe9, 0x9d, 0x2c, 0x03, 0xbb, 0x98, 0xfc, 0xbb, 0x45, 0xbb, 0x92, 0x63, 0xb1,
-	0xe7, 0x12, 0x67, 0x66, 0x05, 0x9c, 0xdd, 0x91, 0x70, 0x96, 0x42, 0x9b, 0xa3, 0xd8, 0xa7, 0x85,
-	0x67, 0x29, 0x61, 0xbc, 0x8c, 0x66, 0x29, 0x04, 0xe0, 0x38, 0x41, 0xd4, 0x86, 0x91, 0x1d, 0x69,
-	0xe8, 0x15, 0xc6, 0x9d, 0x4a, 0x51, 0xea, 0x8a, 0xb5, 0x98, 0xbb, 0xb9, 0x84, 0x85, 0x38, 0x22,
-	0x82, 0xb6, 0x61, 0x68, 0x87, 0xf3, 0x0a, 0x61, 0x94, 0x99, 0xef, 0x59, 0x85, 0xe5, 0xb6, 0x01,
-	0x51, 0x84, 0x25, 0x7a, 0xd5, 0xc7, 0x75, 0xf8, 0x88, 0xd0, 0x8b, 0x4f, 0x6b, 0x30, 0xbd, 0x4b,
-	0xbc, 0xc0, 0x32, 0x93, 0xd7, 0x1b, 0x23, 0xc5, 0xd5, 0xec, 0x17, 0xb2, 0x10, 0xf2, 0x65, 0x92,
-	0x09, 0xc2, 0xd9, 0x5d, 0xa0, 0x4a, 0x37, 0xb7, 0x52, 0xd7, 0x03, 0x23, 0xb0, 0xcc, 0x75, 0x77,
-	0x87, 0x38, 0xd1, 0x03, 0x45, 0xcc, 0x3c, 0x22, 0x32, 0xb9, 0x2d, 0xe6, 0x57, 0xc3, 0xdd, 0x70,
-	0xe8, 0x7f, 0xaa, 0x41, 0xca, 0xd6, 0x8a, 0x7e, 0x4e, 0x83, 0xb1, 0x2d, 0x62, 0x04, 0x1d, 0x8f,
-	0xdc, 0x32, 0x82, 0x30, 0xa0, 0xfc, 0x85, 0xd3, 0x30, 0xf1, 0xce, 0x2d, 0x29, 0x88, 0xf9, 0x75,
-	0x7d, 0x98, 0xd8, 0x55, 0x05, 0xe1, 0x58, 0x0f, 0x66, 0x9f, 0x83, 0xa9, 0x54, 0xc3, 0x13, 0x5d,
-	0xbb, 0xfd, 0x6b, 0x0d, 0xb2, 0xde, 0xd4, 0x42, 0xaf, 0xc0, 0x80, 0xd1, 0x68, 0x84, 0x8f, 0x64,
-	0x3c, 0x53, 0xcc, 0x73, 0xa4, 0xa1, 0xc6, 0xed, 0xb3, 0x9f, 0x98, 0xa3, 0x45, 0x4b, 0x80, 0x8c,
-	0xd8, 0xfd, 0xf3, 0x4a, 0x14, 0x8d, 0xca, 0xae, 0x87, 0xe6, 0x53, 0x50, 0x9c, 0xd1, 0x42, 0xff,
-	0x69, 0x0d, 0x50, 0x3a, 0x15, 0x30, 0xf2, 0x60, 0x58, 0x2c, 0x65, 0xf9, 0x95, 0xaa, 0x05, 0x03,
-	0x3e, 0x62, 0xd1, 0x4b, 0x91, 0x1b, 0x92, 0x28, 0xf0, 0x71, 0x48, 0x47, 0xff, 0x2b, 0x0d, 0xa2,
-	0x44, 0xfa, 0xe8, 0xfd, 0x30, 0xda, 0x20, 0xbe, 0xe9, 0x59, 0xed, 0x20, 0x8a, 0x75, 0x0a, 0x63,
-	0x26, 0xaa, 0x11, 0x08, 0xab, 0xf5, 0x90, 0x0e, 0x83, 0x81, 0xe1, 0xef, 0xd4, 0xaa, 0x42, 0xef,
-	0x63, 0xa7, 0xf4, 0x3a, 0x2b, 0xc1, 0x02, 0x12, 0x65, 0x04, 0xeb, 0x3b, 0x46, 0x46, 0x30, 0xb4,
-	0x75, 0x0a, 0xe9, 0xcf, 0xd0, 0xd1, 0xa9, 0xcf, 0xf4, 0x5f, 0x2d, 0xc1, 0x05, 0x5a, 0x65, 0xc5,
-	0xb0, 0x9c, 0x80, 0x38, 0xcc, 0xb3, 0xbf, 0xe0, 0x24, 0x34, 0x61, 0x3c, 0x88, 0x85, 0xbe, 0x9d,
-	0x3c, 0xee, 0x2b, 0xf4, 0x75, 0x89, 0x07, 0xbc, 0xc5, 0xf1, 0xa2, 0x67, 0x64, 0x68, 0x05, 0xd7,
-	0x90, 0x1f, 0x96, 0x4b, 0x95, 0xc5, 0x4b, 0xdc, 0x17, 0x71, 0x84, 0xe1, 0xeb, 0x0b, 0xb1, 0x28,
-	0x8a, 0xa7, 0x61, 0x5c, 0xb8, 0x38, 0xf3, 0xd4, 0x6e, 0x42, 0x43, 0x66, 0x27, 0xcc, 0x92, 0x0a,
-	0xc0, 0xf1, 0x7a, 0xfa, 0x57, 0x4a, 0x10, 0x7f, 0xe3, 0xa1, 0xe8, 0x2c, 0xa5, 0xf3, 0xda, 0x95,
-	0xce, 0x2c, 0xaf, 0xdd, 0xfb, 0xd8, 0x03, 0x49, 0xfc, 0x25, 0x3d, 0x7e, 0x6f, 0xac, 0x3e, 0x6b,
-	0xc4, 0xdf, 0xc1, 0x0b, 0x6b, 0x44, 0xd3, 0xda, 0x7f, 0xe2, 0x69, 0x7d, 0xbf, 0xf0, 0x7d, 0x1c,
-	0x88, 0x65, 0x17, 0x94, 0xbe, 0x8f, 0x53, 0xb1, 0x86, 0x4a, 0x20, 0xc8, 0x17, 0x35, 0x18, 0x12,
-	0xf9, 0xaf, 0x8f, 0x11, 0x68, 0xb4, 0x05, 0x03, 0x4c, 0x2b, 0xe9, 0x45, 0x1a, 0xac, 0x6f, 0xbb,
-	0x6e, 0x10, 0xcb, 0x02, 0xce, 0x3c, 0xfb, 0xd9, 0xbf, 0x98, 0xa3, 0x67, 0xee, 0x6f, 0x9e, 0xb9,
-	0x6d, 0x05, 0xc4, 0x0c, 0x64, 0x6e, 0x61, 0xe9, 0xfe, 0xa6, 0x94, 0xe3, 0x58, 0x2d, 0xfd, 0x33,
-	0xfd, 0x70, 0x5d, 0x20, 0x4e, 0x89, 0x48, 0x21, 0x83, 0xdb, 0x87, 0x8b, 0xe2, 0xdb, 0x56, 0x3d,
-	0xc3, 0x0a, 0xef, 0xe3, 0x8b, 0x69, 0xa7, 0xe2, 0xb5, 0xc8, 0x14, 0x3a, 0x9c, 0x45, 0x83, 0x67,
-	0xb0, 0x64, 0xc5, 0xb7, 0x89, 0x61, 0x07, 0xdb, 0x92, 0x76, 0xa9, 0x97, 0x0c, 0x96, 0x69, 0x7c,
-	0x38, 0x93, 0x0a, 0xf3, 0x07, 0x10, 0x80, 0x8a, 0x47, 0x0c, 0xd5, 0x19, 0xa1, 0x07, 0xe7, 0xfc,
-	0x95, 0x4c, 0x8c, 0x38, 0x87, 0x12, 0x33, 0xf3, 0x19, 0x7b, 0xcc, 0x6a, 0x80, 0x49, 0xe0, 0x59,
-	0x2c, 0x9b, 0x7b, 0x68, 0xe8, 0x5e, 0x89, 0x83, 0x70, 0xb2, 0x2e, 0xba, 0x09, 0x13, 0xcc, 0xbf,
-	0x22, 0xca, 0x64, 0x35, 0x10, 0x25, 0x4b, 0x58, 0x8d, 0x41, 0x70, 0xa2, 0xa6, 0xfe, 0xb1, 0x12,
-	0x8c, 0xa9, 0xcb, 0xee, 0x18, 0x51, 0x47, 0x1d, 0xe5, 0x30, 0xec, 0x21, 0x22, 0x46, 0xa5, 0x7a,
-	0x8c, 0xf3, 0x10, 0xbd, 0x04, 0x13, 0x1d, 0xc6, 0x41, 0x64, 0x36, 0x0e, 0xb1, 0xfe, 0xbf, 0x87,
-	0x8e, 0x72, 0x23, 0x06, 0xb9, 0x7f, 0x50, 0x9e, 0x55, 0xd1, 0xc7, 0xa1, 0x38, 0x81, 0x47, 0xff,
-	0x44, 0x1f, 0x5c, 0xcc, 0xe8, 0x0d, 0xbb, 0x87, 0x27, 0x89, 0x23, 0xbb, 0x97, 0x7b, 0xf8, 0xd4,
-	0xf1, 0x1f, 0xde, 0xc3, 0x27, 0x21, 0x38, 0x45, 0x17, 0xbd, 0x00, 0x7d, 0xa6, 0x67, 0x89, 0x09,
-	0x7f, 0xba, 0x90, 0xc2, 0x89, 0x6b, 0x0b, 0xa3, 0x82, 0x62, 0x5f, 0x05, 0xd7, 0x30, 0x45, 0x48,
-	0x0f, 0x1e, 0x95, 0x5d, 0x48, 0x29, 0x80, 0x1d, 0x3c, 0x2a, 0x57, 0xf1, 0x71, 0xbc, 0x1e, 0x7a,
-	0x09, 0x66, 0x84, 0x26, 0x20, 0x23, 0x98, 0x5d, 0xc7, 0x0f, 0xe8, 0xce, 0x0e, 0x04, 0xa3, 0xbe,
-	0x7a, 0x78, 0x50, 0x9e, 0xb9, 0x93, 0x53, 0x07, 0xe7, 0xb6, 0xd6, 0xff, 0xbc, 0x0f, 0x46, 0x95,
-	0xd7, 0x07, 0xd0, 0x4a, 0x2f, 0x56, 0x8e, 0x68, 0xc4, 0xd2, 0xd2, 0xb1, 0x02, 0x7d, 0xcd, 0x76,
-	0xa7, 0xa0, 0x99, 0x23, 0x44, 0x77, 0x8b, 0xa2, 0x6b, 0xb6, 0x3b, 0xe8, 0x85, 0xd0, 0x70, 0x52,
-	0xcc, 0xb4, 0x11, 0xc6, 0x9b, 0x24, 0x8c, 0x27, 0x72, 0x23, 0xf6, 0xe7, 0x6e, 0xc4, 0x16, 0x0c,
-	0xf9, 0xc2, 0xaa, 0x32, 0x50, 0x3c, 0xe9, 0x8c, 0x32, 0xd3, 0xc2, 0x8a, 0xc2, 0xf5, 0x3d, 0x69,
-	0x64, 0x91, 0x34, 0xa8, 0x2c, 0xd9, 0x61, 0x51, 0xac, 0x4c, 0x91, 0x1d, 0xe6, 0xb2, 0xe4, 0x06,
-	0x2b, 0xc1, 0x02, 0x92, 0x3a, 0xa2, 0x86, 0x8e, 0x75, 0x44, 0xfd, 0xbd, 0x12, 0xa0, 0x74, 0x37,
-	0xd0, 0xc3, 0x30, 0xc0, 0xa2, 0xe0, 0x05, 0x2f, 0x0a, 0x25, 0x7f, 0x16, 0x07, 0x8d, 0x39, 0x0c,
-	0xd5, 0x45, 0x0a, 0x8d, 0x62, 0x9f, 0x93, 0x39, 0xb2, 0x08, 0x7a, 0x4a, 0xbe, 0x8d, 0xeb, 0xb1,
-	0x90, 0x89, 0xac, 0x33, 0x7f, 0x03, 0x86, 0x5a, 0x96, 0xc3, 0xee, 0xf6, 0x8a, 0x19, 0x9b, 0xf8,
-	0x7d, 0x3b, 0x47, 0x81, 0x25, 0x2e, 0xfd, 0x8f, 0x4b, 0x74, 0xe9, 0x47, 0x12, 0xef, 0x3e, 0x80,
-	0xd1, 0x09, 0x5c, 0xce, 0xc0, 0xc4, 0x0e, 0xa8, 0x15, 0xfb, 0xca, 0x21, 0xd2, 0xf9, 0x10, 0x21,
-	0xbf, 0x95, 0x8a, 0x7e, 0x63, 0x85, 0x18, 0x25, 0x1d, 0x58, 0x2d, 0xf2, 0xa2, 0xe5, 0x34, 0xdc,
-	0x7b, 0x62, 0x7a, 0x7b, 0x25, 0xbd, 0x1e, 0x22, 0xe4, 0xa4, 0xa3, 0xdf, 0x58, 0x21, 0x46, 0x59,
-	0x0b, 0x53, 0x9c, 0x1d, 0xf6, 0x1c, 0x8c, 0xe8, 0x9b, 0x6b, 0xdb, 0xf2, 0x54, 0x1e, 0xe6, 0xac,
-	0xa5, 0x92, 0x53, 0x07, 0xe7, 0xb6, 0xd6, 0x7f, 0x4d, 0x83, 0xe9, 0xcc, 0xa9, 0x40, 0xb7, 0x60,
-	0x2a, 0xf2, 0x7d, 0x52, 0x99, 0xfd, 0x70, 0xf4, 0xc6, 0xd1, 0x9d, 0x64, 0x05, 0x9c, 0x6e, 0xc3,
-	0x1f, 0xd2, 0x4e, 0x1d, 0x26, 0xc2, 0x71, 0x4a, 0x15, 0x8d, 0x54, 0x30, 0xce, 0x6a, 0xa3, 0xff,
-	0x50, 0xac, 0xb3, 0xd1, 0x64, 0xd1, 0x9d, 0xb1, 0x49, 0x9a, 0x61, 0xc8, 0x5a, 0xb8, 0x33, 0x16,
-	0x68, 0x21, 0xe6, 0x30, 0xf4, 0x90, 0x1a, 0x08, 0x1a, 0xf2, 0x2d, 0x19, 0x0c, 0xaa, 0xff, 0x08,
-	0x3c, 0x90, 0x73, 0x59, 0x89, 0xaa, 0x30, 0xe6, 0xdf, 0x33, 0xda, 0x0b, 0x64, 0xdb, 0xd8, 0xb5,
-	0x44, 0x62, 0x01, 0xee, 0xd3, 0x36, 0x56, 0x57, 0xca, 0xef, 0x27, 0x7e, 0xe3, 0x58, 0x2b, 0x3d,
-	0x00, 0x10, 0xbe, 0x8f, 0x96, 0xd3, 0x44, 0x5b, 0x30, 0x6c, 0x88, 0x77, 0x9c, 0xc5, 0x3a, 0xfe,
-	0xfe, 0x42, 0x46, 0x00, 0x81, 0x83, 0x7b, 0x87, 0xcb, 0x5f, 0x38, 0xc4, 0xad, 0xff, 0x13, 0x0d,
-	0x2e, 0x67, 0x87, 0x92, 0x1f, 0x43, 0xb4, 0x69, 0xc1, 0xa8, 0x17, 0x35, 0x13, 0x8b, 0xfe, 0xfb,
-	0xd4, 0x64, 0xa4, 0x4a, 0xf6, 0x2d, 0x2a, 0xf6, 0x55, 0x3c, 0xd7, 0x97, 0x5f, 0x3e, 0x99, 0x9f,
-	0x34, 0x54, 0xb9, 0x94, 0x9e, 0x60, 0x15, 0x3f, 0xcb, 0x15, 0x4c, 0xa9, 0xfb, 0x6d, 0xc3, 0x24,
-	0x8d, 0x73, 0x7e, 0x18, 0xeb, 0x14, 0x12, 0x74, 0x66, 0xf7, 0xfd, 0x6c, 0x73, 0x05, 0xe7, 0xd0,
-	0x3c, 0x3a, 0x57, 0x70, 0x76, 0xc3, 0x77, 0x48, 0x12, 0xcb, 0xec, 0xce, 0xe7, 0xc4, 0x95, 0xbd,
-	0x39, 0x98, 0x37, 0xda, 0x13, 0xbe, 0xae, 0xb5, 0x7b, 0x86, 0xaf, 0x6b, 0x4d, 0x7c, 0xe7, 0x65,
-	0xad, 0x8c, 0x97, 0xb5, 0x94, 0xe7, 0xae, 0x06, 0xce, 0xf0, 0xb9, 0xab, 0xc4, 0xa3, 0x52, 0x83,
-	0xe7, 0xf3, 0xa8, 0x14, 0x7a, 0x0d, 0x06, 0xdb, 0x86, 0x47, 0x1c, 0x79, 0x35, 0x51, 0xeb, 0xf5,
-	0xc5, 0xba, 0x88, 0xd9, 0x86, 0x3b, 0x7f, 0x8d, 0x11, 0xc0, 0x82, 0x90, 0xfe, 0x97, 0x1a, 0x5c,
-	0xed, 0xc6, 0x32, 0x98, 0x92, 0x67, 0x26, 0xb6, 0x48, 0x2f, 0x4a, 0x5e, 0x8a, 0x13, 0x86, 0x4a,
-	0x5e, 0x12, 0x82, 0x53, 0x74, 0x73, 0xde, 0x48, 0x2d, 0x15, 0x79, 0x23, 0x55, 0xff, 0xed, 0x12,
-	0xc0, 0x2a, 0x09, 0xee, 0xb9, 0xde, 0x0e, 0x3d, 0x7f, 0xaf, 0xc6, 0xcc, 0x58, 0xc3, 0xdf, 0xba,
-	0x5c, 0x39, 0x57, 0xa1, 0xbf, 0xed, 0x36, 0x7c, 0x21, 0x5b, 0xb3, 0x8e, 0x30, 0x9f, 0x53, 0x56,
-	0x8a, 0xca, 0x30, 0xc0, 0x2e, 0xbe, 0x85, 0xda, 0xc3, 0x8c, 0x60, 0xab, 0xb4, 0x00, 0xf3, 0x72,
-	0xfe, 0xf4, 0x2b, 0x0b, 0xe7, 0xf3, 0x85, 0x55, 0x4f, 0x3c, 0xfd, 0xca, 0xcb, 0x70, 0x08, 0x45,
-	0x37, 0x01, 0xac, 0xf6, 0x92, 0xd1, 0xb2, 0x6c, 0x4b, 0xac, 0xf1, 0x11, 0x66, 0x9d, 0x81, 0xda,
-	0x9a, 0x2c, 0xbd, 0x7f, 0x50, 0x1e, 0x16, 0xbf, 0xf6, 0xb1, 0x52, 0x5b, 0xff, 0xeb, 0x3e, 0x18,
-	0x5b, 0x6d, 0x5a, 0xce, 0x9e, 0xcc, 0x12, 0x10, 0x5e, 0x60, 0x68, 0x67, 0x73, 0x81, 0xf1, 0x12,
-	0xcc, 0xd8, 0xae, 0xd1, 0x58, 0x30, 0x6c, 0x2a, 0xea, 0x79, 0x75, 0x2e, 0x23, 0x18, 0x4e, 0x53,
-	0xa4, 0x1d, 0x11, 0xda, 0xf4, 0x72, 0x4e, 0x1d, 0x9c, 0xdb, 0x1a, 0x05, 0x30, 0x68, 0xca, 0x17,
-	0x1e, 0x0a, 0x47, 0xbe, 0xab, 0x73, 0x31, 0xa7, 0x06, 0x81, 0x86, 0xfb, 0x4e, 0x7c, 0x6d, 0x41,
-	0x0b, 0x7d, 0x5c, 0x83, 0x69, 0xb2, 0xc7, 0x83, 0xa0, 0xd7, 0x3d, 0x63, 0x6b, 0xcb, 0x32, 0x45,
-	0x24, 0x00, 0xff, 0xb0, 0xcb, 0x87, 0x07, 0xe5, 0xe9, 0xc5, 0xac, 0x0a, 0xf7, 0x0f, 0xca, 0x37,
-	0x32, 0x63, 0xd2, 0xd9, 0x67, 0xcd, 0x6c, 0x82, 0xb3, 0x49, 0xcd, 0x3e, 0x03, 0xa3, 0x27, 0x88,
-	0x1f, 0x8b, 0x45, 0x9e, 0xff, 0x4e, 0x09, 0xc6, 0xe8, 0xba, 0x5b, 0x76, 0x4d, 0xc3, 0xae, 0xae,
-	0xd6, 0xd1, 0x63, 0xc9, 0x7c, 0x31, 0x21, 0x77, 0x4d, 0xe5, 0x8c, 0x59, 0x86, 0x4b, 0x5b, 0xae,
-	0x67, 0x92, 0xf5, 0xca, 0xda, 0xba, 0x2b, 0xee, 0xf3, 0xab, 0xab, 0x75, 0xa1, 0x02, 0x30, 0x0b,
-	0xe5, 0x52, 0x06, 0x1c, 0x67, 0xb6, 0x42, 0x77, 0x61, 0x3a, 0x2a, 0xdf, 0x68, 0x73, 0x47, 0x46,
-	0x8a, 0xae, 0x2f, 0x72, 0xc4, 0x5c, 0xca, 0xaa, 0x80, 0xb3, 0xdb, 0x21, 0x03, 0xae, 0x88, 0x74,
-	0x54, 0x4b, 0xae, 0x77, 0xcf, 0xf0, 0x1a, 0x71, 0xb4, 0xfd, 0xd1, 0x7d, 0x67, 0x35, 0xbf, 0x1a,
-	0xee, 0x86, 0x43, 0xff, 0xc5, 0x41, 0x50, 0x22, 0x95, 0x4f, 0x20, 0x71, 0xfc, 0x8a, 0x06, 0x97,
-	0x4c, 0xdb, 0x22, 0x4e, 0x90, 0x08, 0x4b, 0xe5, 0xec, 0x68, 0xa3, 0x50, 0x08, 0x75, 0x9b, 0x38,
-	0xb5, 0xaa, 0xf0, 0xfb, 0xac, 0x64, 0x20, 0x17, 0xbe, 0xb1, 0x19, 0x10, 0x9c, 0xd9, 0x19, 0x36,
-	0x1e, 0x56, 0x5e, 0xab, 0xaa, 0x79, 0x74, 0x2a, 0xa2, 0x0c, 0x87, 0x50, 0xf4, 0x04, 0x8c, 0x36,
-	0x3d, 0xb7, 0xd3, 0xf6, 0x2b, 0x2c, 0xbc, 0x83, 0xaf, 0x7d, 0x66, 0x74, 0xb8, 0x15, 0x15, 0x63,
-	0xb5, 0x0e, 0x7a, 0x0a, 0xc6, 0xf8, 0xcf, 0x35, 0x8f, 0x6c, 0x59, 0x7b, 0x82, 0xc9, 0x31, 0x13,
-	0xca, 0x2d, 0xa5, 0x1c, 0xc7, 0x6a, 0xb1, 0x54, 0x18, 0xbe, 0xdf, 0x21, 0xde, 0x06, 0x5e, 0x16,
-	0x6f, 0x00, 0xf1, 0x54, 0x18, 0xb2, 0x10, 0x47, 0x70, 0xf4, 0x49, 0x0d, 0x26, 0x3c, 0xf2, 0x5a,
-	0xc7, 0xf2, 0xe8, 0x91, 0x68, 0x58, 0x2d, 0x5f, 0x84, 0x8b, 0xe3, 0xde, 0x42, 0xd4, 0xe7, 0x70,
-	0x0c, 0x29, 0xe7, 0x10, 0xe1, 0x9d, 0x50, 0x1c, 0x88, 0x13, 0x3d, 0xa0, 0x53, 0xe5, 0x5b, 0x4d,
-	0xc7, 0x72, 0x9a, 0xf3, 0x76, 0xd3, 0x9f, 0x19, 0x66, 0x4c, 0x8f, 0xdb, 0x67, 0xa2, 0x62, 0xac,
-	0xd6, 0x41, 0x4f, 0xc3, 0x78, 0xc7, 0xa7, 0xfb, 0xbe, 0x45, 0xf8, 0xfc, 0x8e, 0x44, 0x97, 0x66,
-	0x1b, 0x2a, 0x00, 0xc7, 0xeb, 0xa1, 0x9b, 0x30, 0x21, 0x0b, 0xc4, 0x2c, 0x03, 0xcf, 0xc0, 0xca,
-	0x6c, 0xc9, 0x31, 0x08, 0x4e, 0xd4, 0x9c, 0x9d, 0x87, 0x8b, 0x19, 0xc3, 0x3c, 0x11, 0x73, 0xf9,
-	0x1b, 0x0d, 0xa6, 0xf9, 0x29, 0x2e, 0x5f, 0x0f, 0x92, 0xa9, 0x56, 0xb3, 0xb3, 0x96, 0x6a, 0x67,
-	0x9a, 0xb5, 0xf4, 0x5b, 0x90, 0x9d, 0x55, 0xff, 0x47, 0x25, 0x78, 0xf7, 0x91, 0xfb, 0x12, 0xfd,
-	0x43, 0x0d, 0x46, 0xc9, 0x5e, 0xe0, 0x19, 0x61, 0x0c, 0x1c, 0x5d, 0xa4, 0x5b, 0x67, 0xc2, 0x04,
-	0xe6, 0x16, 0x23, 0x42, 0x7c, 0xe1, 0x86, 0xf2, 0xac, 0x02, 0xc1, 0x6a, 0x7f, 0x90, 0x0e, 0x83,
-	0x3c, 0x43, 0xb1, 0x7a, 0xbb, 0xce, 0x53, 0x7e, 0x60, 0x01, 0x99, 0xfd, 0x10, 0x4c, 0x26, 0x31,
-	0x9f, 0x68, 0xad, 0xfc, 0x56, 0x09, 0x86, 0xd6, 0x3c, 0x97, 0x2a, 0xf0, 0xe7, 0x60, 0x14, 0x30,
-	0x62, 0x46, 0x81, 0x42, 0x2a, 0x8f, 0xe8, 0x6c, 0xae, 0x15, 0xc0, 0x4a, 0x58, 0x01, 0xe6, 0x7b,
-	0x21, 0xd2, 0x5d, 0xed, 0xff, 0x92, 0x06, 0xa3, 0xa2, 0xe6, 0x39, 0xe8, 0xf9, 0x3f, 0x1a, 0xd7,
-	0xf3, 0x3f, 0xd8, 0xc3, 0xb8, 0x72, 0x14, 0xfb, 0x4f, 0x6b, 0x30, 0x2e, 0x6a, 0xac, 0x90, 0xd6,
-	0x26, 0xf1, 0xd0, 0x12, 0x0c, 0xf9, 0x1d, 0xf6, 0x21, 0xc5, 0x80, 0xae, 0xa8, 0xc6, 0x2a, 0x6f,
-	0xd3, 0x30, 0x69, 0xf7, 0xeb, 0xbc, 0x8a, 0xf2, 0x0e, 0x0f, 0x2f, 0xc0, 0xb2, 0x31, 0xba, 0x0e,
-	0xfd, 0x9e, 0x6b, 0xa7, 0x32, 0x09, 0x62, 0xd7, 0x26, 0x98, 0x41, 0xa8, 0x60, 0x4e, 0xff, 0xca,
-	0xfb, 0x21, 0x26, 0x98, 0x53, 0xb0, 0x8f, 0x79, 0xb9, 0xfe, 0x93, 0xfd, 0xe1, 0x64, 0x33, 0x5d,
-	0xe6, 0x36, 0x8c, 0x98, 0x1e, 0x31, 0x02, 0xd2, 0x58, 0xd8, 0x3f, 0x4e, 0xe7, 0xd8, 0x71, 0x55,
-	0x91, 0x2d, 0x70, 0xd4, 0x98, 0x9e, 0x0c, 0xaa, 0x43, 0x43, 0x29, 0x3a, 0x44, 0x73, 0x9d, 0x19,
-	0xbe, 0x1f, 0x06, 0xdc, 0x7b, 0x4e, 0xe8, 0x17, 0xd9, 0x95, 0x30, 0x1b, 0xca, 0x5d, 0x5a, 0x1b,
-	0xf3, 0x46, 0x6a, 0x26, 0xcd, 0xfe, 0x2e, 0x99, 0x34, 0x6d, 0x18, 0x6a, 0xb1, 0xcf, 0xd0, 0xd3,
-	0xb3, 0x2c, 0xb1, 0x0f, 0xaa, 0x3e, 0xdc, 0xc7, 0x30, 0x63, 0x49, 0x82, 0x9e, 0xf0, 0x8e, 0x54,
-	0x64, 0xd5, 0x13, 0x3e, 0xd4, 0x6e, 0x71, 0x04, 0x47, 0xfb, 0xf1, 0x14, 0xad, 0x43, 0xc5, 0x4d,
-	0x37, 0xa2, 0x7b, 0x4a, 0x56, 0x56, 0x3e, 0xf5, 0xb9, 0x69, 0x5a, 0x7f, 0xa6, 0x3f, 0x5c, 0xa4,
-	0x42, 0xc5, 0xce, 0xd6, 0x6a, 0xb5, 0x22, 0x5a, 0x2d, 0xfa, 0x5e, 0x99, 0x8b, 0xbc, 0x14, 0x7b,
-	0x64, 0x32, 0xcc, 0x45, 0x3e, 0x26, 0x48, 0xc7, 0xf2, 0x8f, 0x77, 0xe0, 0xa2, 0x1f, 0x18, 0x36,
-	0xa9, 0x5b, 0xc2, 0x8c, 0xee, 0x07, 0x46, 0xab, 0x5d, 0x20, 0x19, 0x38, 0x8f, 0x5f, 0x4b, 0xa3,
-	0xc2, 0x59, 0xf8, 0xd1, 0x4f, 0x68, 0x30, 0xc3, 0xca, 0xe7, 0x3b, 0x81, 0xcb, 0x5f, 0xad, 0x88,
-	0x88, 0x9f, 0xdc, 0x6b, 0x8a, 0x29, 0x80, 0xf5, 0x1c, 0x7c, 0x38, 0x97, 0x12, 0x7a, 0x03, 0xa6,
-	0xe9, 0x09, 0x3c, 0x6f, 0x06, 0xd6, 0xae, 0x15, 0xec, 0x47, 0x5d, 0x38, 0x79, 0x06, 0x70, 0xa6,
-	0x6c, 0x2c, 0x67, 0x21, 0xc3, 0xd9, 0x34, 0xf4, 0xbf, 0xd0, 0x00, 0xa5, 0x97, 0x10, 0xb2, 0x61,
-	0xb8, 0x21, 0x03, 0xca, 0xb4, 0x53, 0xc9, 0x1f, 0x1c, 0x72, 0xe6, 0x30, 0x0e, 0x2d, 0xa4, 0x80,
-	0x5c, 0x18, 0xb9, 0xb7, 0x6d, 0x05, 0xc4, 0xb6, 0xfc, 0xe0, 0x94, 0xd2, 0x15, 0x87, 0xb9, 0x3b,
-	0x5f, 0x94, 0x88, 0x71, 0x44, 0x43, 0xff, 0xd9, 0x7e, 0x18, 0x0e, 0x9f, 0x5f, 0x38, 0xda, 0x81,
-	0xa8, 0x03, 0xc8, 0x54, 0x9e, 0xb0, 0xec, 0xc5, 0x02, 0xc3, 0x84, 0xb0, 0x4a, 0x0a, 0x19, 0xce,
-	0x20, 0x80, 0xde, 0x80, 0x4b, 0x96, 0xb3, 0xe5, 0x19, 0x7e, 0xe0, 0x75, 0xd8, 0x45, 0x6c, 0x2f,
-	0x2f, 0x41, 0x32, 0x1d, 0xaa, 0x96, 0x81, 0x0e, 0x67, 0x12, 0x41, 0x04, 0x86, 0xf8, 0x2b, 0x33,
-	0xd2, 0xbe, 0x5a, 0xc8, 0xd2, 0xc9, 0x5f, 0xaf, 0x89, 0xb8, 0x26, 0xff, 0xed, 0x63, 0x89, 0x9b,
-	0x67, 0x79, 0xe2, 0xff, 0x4b, 0xd3, 0xb3, 0x58, 0xf7, 0x95, 0xe2, 0xf4, 0x22, 0x2b, 0x36, 0xcf,
-	0xf2, 0x14, 0x2f, 0xc4, 0x49, 0x82, 0xfa, 0x1f, 0x68, 0x30, 0xc0, 0x53, 0x23, 0x9c, 0xbd, 0x04,
-	0xf7, 0x23, 0x31, 0x09, 0xae, 0xd0, 0x63, 0x76, 0xac, 0xab, 0xb9, 0xcf, 0xac, 0x7d, 0x51, 0x83,
-	0x11, 0x56, 0xe3, 0x1c, 0x44, 0xaa, 0x57, 0xe2, 0x22, 0xd5, 0x33, 0x85, 0x47, 0x93, 0x23, 0x50,
-	0xfd, 0x41, 0x9f, 0x18, 0x0b, 0x93, 0x58, 0x6a, 0x70, 0x51, 0x84, 0x5a, 0x2c, 0x5b, 0x5b, 0x84,
-	0x2e, 0xf1, 0xaa, 0xb1, 0xcf, 0xbd, 0x0f, 0x06, 0x44, 0x2c, 0x6e, 0x1a, 0x8c, 0xb3, 0xda, 0xa0,
-	0xdf, 0xd1, 0xa8, 0x6c, 0x10, 0x78, 0x96, 0xd9, 0xd3, 0xb5, 0x4f, 0xd8, 0xb7, 0xb9, 0x15, 0x8e,
-	0x8c, 0x6b, 0x26, 0x1b, 0x91, 0x90, 0xc0, 0x4a, 0xef, 0x1f, 0x94, 0xcb, 0x19, 0x26, 0xb3, 0xe8,
-	0x1d, 0x23, 0x3f, 0xf8, 0xf8, 0x9f, 0x74, 0xad, 0xc2, 0xee, 0x40, 0x65, 0x8f, 0xd1, 0x6d, 0x18,
-	0xf0, 0x4d, 0xb7, 0x4d, 0x4e, 0xf2, 0x1a, 0x63, 0x38, 0xc1, 0x75, 0xda, 0x12, 0x73, 0x04, 0xb3,
-	0xaf, 0xc2, 0x98, 0xda, 0xf3, 0x0c, 0xcd, 0xa7, 0xaa, 0x6a, 0x3e, 0x27, 0x76, 0xa3, 0x50, 0x35,
-	0xa5, 0xdf, 0x2d, 0xc1, 0x20, 0xbf, 0xe9, 0x38, 0xc6, 0x4d, 0xaf, 0x25, 0x1f, 0x8c, 0x29, 0x15,
-	0x77, 0xe7, 0x56, 0x93, 0x23, 0xbf, 0xec, 0x3a, 0xca, 0x1c, 0xa8, 0x6f, 0xc6, 0x20, 0x27, 0x4c,
-	0x99, 0xdd, 0x57, 0xfc, 0xc5, 0x38, 0x3e, 0xb0, 0xb3, 0x4e, 0x92, 0xfd, 0x47, 0x1a, 0x8c, 0xc5,
-	0x72, 0x90, 0xb7, 0xa0, 0xcf, 0x0b, 0xdf, 0x12, 0x2d, 0x7a, 0x11, 0x2e, 0x1d, 0x76, 0xaf, 0x74,
-	0xa9, 0x84, 0x29, 0x9d, 0x30, 0x5d, 0x79, 0xe9, 0x94, 0xd2, 0x95, 0xeb, 0x9f, 0xd2, 0xe0, 0xb2,
-	0x1c, 0x50, 0x3c, 0x19, 0x1f, 0x7a, 0x14, 0x86, 0x8d, 0xb6, 0xc5, 0x4c, 0x6a, 0xaa, 0x51, 0x72,
-	0x7e, 0xad, 0xc6, 0xca, 0x70, 0x08, 0x45, 0xef, 0x83, 0x61, 0xb9, 0xf0, 0x84, 0xd8, 0x19, 0xf2,
-	0xac, 0xf0, 0x6a, 0x3f, 0xac, 0x81, 0xde, 0xa3, 0xbc, 0xe9, 0x33, 0x10, 0xc9, 0x09, 0x21, 0x61,
-	0xee, 0x62, 0xa4, 0x7f, 0x1f, 0x8c, 0xd4, 0xeb, 0xb7, 0xe7, 0x4d, 0x93, 0xf8, 0xfe, 0x09, 0x8c,
-	0xcb, 0xfa, 0x9b, 0x7d, 0x30, 0x2e, 0xb2, 0x8a, 0x5a, 0x4e, 0xc3, 0x72, 0x9a, 0xe7, 0x70, 0xa6,
-	0xac, 0xc3, 0x08, 0xb7, 0x66, 0x1c, 0xf1, 0xee, 0x6b, 0x5d, 0x56, 0x4a, 0xe6, 0xee, 0x0f, 0x01,
-	0x38, 0x42, 0x84, 0xee, 0xc0, 0xe0, 0x6b, 0x94, 0xbf, 0xc9, 0x7d, 0x71, 0x2c, 0x36, 0x13, 0x2e,
-	0x7a, 0xc6, 0x1a, 0x7d, 0x2c, 0x50, 0x20, 0x9f, 0x79, 0x94, 0x33, 0x81, 0xab, 0x97, 0x6c, 0x41,
-	0xb1, 0x99, 0x0d, 0x5f, 0xf4, 0x1a, 0x13, 0x8e, 0xe9, 0xec, 0x17, 0x0e, 0x09, 0xb1, 0x87, 0x47,
-	0x62, 0x2d, 0xde, 0x21, 0x0f, 0x8f, 0xc4, 0xfa, 0x9c, 0x73, 0x34, 0x3e, 0x03, 0xd3, 0x99, 0x93,
-	0x71, 0xb4, 0x38, 0xab, 0xff, 0x7a, 0x09, 0xfa, 0xeb, 0x84, 0x34, 0xce, 0x61, 0x65, 0xbe, 0x12,
-	0x93, 0x76, 0xbe, 0xbf, 0xf0, 0xd3, 0x27, 0x79, 0xc6, 0xaa, 0xad, 0x84, 0xb1, 0xea, 0x43, 0x85,
-	0x29, 0x74, 0xb7, 0x54, 0xfd, 0x52, 0x09, 0x80, 0x56, 0x5b, 0x30, 0xcc, 0x1d, 0xce, 0x71, 0xc2,
-	0xd5, 0xac, 0xc5, 0x39, 0x4e, 0x7a, 0x19, 0x9e, 0xe7, 0xe5, 0xad, 0x0e, 0x83, 0xdc, 0x87, 0x40,
-	0xdc, 0x7b, 0x30, 0x8b, 0x27, 0x3f, 0x9b, 0xb0, 0x80, 0xc4, 0xb9, 0x45, 0xff, 0x29, 0x71, 0x0b,
-	0x7d, 0x0f, 0xd8, 0xeb, 0xd1, 0xd5, 0xd5, 0x3a, 0x6a, 0x29, 0xb3, 0x53, 0x2a, 0x2e, 0xcb, 0x0b,
-	0x74, 0x47, 0xee, 0xf2, 0x37, 0x35, 0xb8, 0x90, 0xa8, 0x7b, 0x0c, 0x9d, 0xee, 0x4c, 0x78, 0xa6,
-	0xfe, 0xfb, 0x1a, 0x0c, 0xd3, 0xbe, 0x9c, 0x03, 0xa3, 0xf9, 0xff, 0xe3, 0x8c, 0xe6, 0x03, 0x45,
-	0xa7, 0x38, 0x87, 0xbf, 0xfc, 0x59, 0x09, 0xd8, 0x1b, 0x43, 0xc2, 0x45, 0x41, 0xb9, 0xf9, 0xd7,
-	0x72, 0x6e, 0xfe, 0xaf, 0x0b, 0xc7, 0x81, 0x84, 0x8d, 0x52, 0x71, 0x1e, 0x78, 0x9f, 0xe2, 0x1b,
-	0xd0, 0x17, 0xdf, 0x36, 0x19, 0xfe, 0x01, 0xaf, 0xc3, 0xb8, 0xbf, 0xed, 0xba, 0x41, 0x98, 0xd9,
-	0xa6, 0xbf, 0xb8, 0x3d, 0x9a, 0x85, 0xef, 0xc8, 0xa1, 0xf0, 0x0b, 0xa8, 0xba, 0x8a, 0x1b, 0xc7,
-	0x49, 0xa1, 0x39, 0x80, 0x4d, 0xdb, 0x35, 0x77, 0x2a, 0xb5, 0x2a, 0x96, 0xe1, 0x1a, 0xcc, 0x23,
-	0x6a, 0x21, 0x2c, 0xc5, 0x4a, 0x8d, 0x9e, 0x7c, 0x19, 0xbe, 0xa9, 0xf1, 0x99, 0x3e, 0xc1, 0xe2,
-	0x3d, 0x47, 0x8e, 0xf2, 0xde, 0x04, 0x47, 0x09, 0x39, 0x64, 0x82, 0xab, 0x94, 0xa5, 0xc0, 0xde,
-	0x1f, 0xd9, 0x9f, 0x63, 0x4f, 0x33, 0xfe, 0x96, 0x18, 0x66, 0xf8, 0x4c, 0x55, 0x1b, 0xc6, 0x6d,
-	0xf5, 0xb9, 0x6d, 0xb1, 0x47, 0x0a, 0xbd, 0xd4, 0x1d, 0x3a, 0x8e, 0xc5, 0x8a, 0x71, 0x9c, 0x00,
-	0x7a, 0x1a, 0xc6, 0xe5, 0xe8, 0xb8, 0x63, 0x55, 0x29, 0x8a, 0xa5, 0x58, 0x53, 0x01, 0x38, 0x5e,
-	0x4f, 0x7f, 0xbb, 0x04, 0x0f, 0xf1, 0xbe, 0x33, 0x8b, 0x41, 0x95, 0xb4, 0x89, 0xd3, 0x20, 0x8e,
-	0xb9, 0xcf, 0x64, 0xd6, 0x86, 0xdb, 0x44, 0x6f, 0xc0, 0xe0, 0x3d, 0x42, 0x1a, 0xa1, 0x45, 0xfb,
-	0xc5, 0xe2, 0xaf, 0x7c, 0xe5, 0x90, 0x78, 0x91, 0xa1, 0xe7, 0x1c, 0x9d, 0xff, 0x8f, 0x05, 0x49,
-	0x4a, 0xbc, 0xed, 0xb9, 0x9b, 0xa1, 0x68, 0x75, 0xfa, 0xc4, 0xd7, 0x18, 0x7a, 0x4e, 0x9c, 0xff,
-	0x8f, 0x05, 0x49, 0x7d, 0x0d, 0x1e, 0x3e, 0x46, 0xd3, 0x93, 0x88, 0xd0, 0x47, 0x61, 0xe4, 0xa3,
-	0x3f, 0x09, 0xc6, 0xaf, 0x6b, 0xf0, 0x88, 0x82, 0x72, 0x71, 0x8f, 0x4a, 0xf5, 0x15, 0xa3, 0x6d,
-	0x98, 0x54, 0x47, 0x65, 0xd9, 0x3a, 0x4e, 0xf4, 0xea, 0xd0, 0x9b, 0x1a, 0x0c, 0x71, 0x47, 0x1a,
-	0xc9, 0x7e, 0x5f, 0xe9, 0x71, 0xca, 0x73, 0xbb, 0x24, 0xd3, 0xd9, 0xcb, 0xb1, 0xf1, 0xdf, 0x3e,
-	0x96, 0xf4, 0xf5, 0x7f, 0x33, 0x00, 0xdf, 0x75, 0x7c, 0x44, 0xe8, 0x9b, 0x5a, 0xfa, 0x8d, 0xf4,
-	0xd6, 0xd9, 0x76, 0x3e, 0xb4, 0x62, 0x08, 0xc5, 0xf8, 0xc5, 0xd4, 0x93, 0x61, 0xa7, 0x64, 0x20,
-	0x51, 0x1e, 0x64, 0xff, 0xa7, 0x1a, 0x8c, 0xd1, 0x63, 0x29, 0x64, 0x2e, 0xfc, 0x33, 0xb5, 0xcf,
-	0x78, 0xa4, 0xab, 0x0a, 0xc9, 0x44, 0x58, 0xbf, 0x0a, 0xc2, 0xb1, 0xbe, 0xa1, 0x8d, 0xf8, 0x6d,
-	0x10, 0x57, 0xb7, 0xae, 0x65, 0x49, 0x23, 0x27, 0x79, 0x90, 0x6f, 0xd6, 0x86, 0x89, 0xf8, 0xcc,
-	0x9f, 0xa5, 0x79, 0x67, 0xf6, 0x39, 0x98, 0x4a, 0x8d, 0xfe, 0x44, 0xc6, 0x8d, 0xbf, 0xdb, 0x0f,
-	0x65, 0x65, 0xaa, 0x63, 0xae, 0x74, 0x52, 0x26, 0xf8, 0x8c, 0x06, 0xa3, 0x86, 0xe3, 0x08, 0x77,
-	0x0c, 0xb9, 0x7e, 0x1b, 0x3d, 0x7e, 0xd5, 0x2c, 0x52, 0x73, 0xf3, 0x11, 0x99, 0x84, 0xbf, 0x81,
-	0x02, 0xc1, 0x6a, 0x6f, 0xba, 0x38, 0xd5, 0x95, 0xce, 0xcd, 0xa9, 0x0e, 0xfd, 0x98, 0x3c, 0x88,
-	0xf9, 0x32, 0x7a, 0xe9, 0x0c, 0xe6, 0x86, 0x9d, 0xeb, 0xd9, 0xd6, 0xb4, 0xd9, 0x0f, 0xc1, 0x64,
-	0x72, 0xe6, 0x4e, 0xb4, 0x0a, 0x7e, 0xbd, 0x2f, 0xc6, 0xaa, 0x73, 0xc9, 0x1f, 0xc3, 0x86, 0xf8,
-	0xd9, 0xc4, 0x62, 0xe1, 0x2c, 0xc0, 0x3a, 0xab, 0x09, 0x39, 0xdd, 0x15, 0xd3, 0x77, 0x7e, 0x6e,
-	0x98, 0xbd, 0x7e, 0xb2, 0x05, 0x98, 0x56, 0xe6, 0x47, 0x79, 0x00, 0xf5, 0x31, 0x18, 0xda, 0xb5,
-	0x7c, 0x4b, 0xe6, 0x51, 0x53, 0x4e, 0xe8, 0x17, 0x78, 0x31, 0x96, 0x70, 0x7d, 0x39, 0xb6, 0xf7,
-	0xd7, 0xdd, 0xb6, 0x6b, 0xbb, 0xcd, 0xfd, 0xf9, 0x7b, 0x86, 0x47, 0xb0, 0xdb, 0x09, 0x04, 0xb6,
-	0xe3, 0x9e, 0xf7, 0x2b, 0x70, 0x5d, 0xc1, 0x96, 0x99, 0x6d, 0xe6, 0x24, 0xe8, 0xbe, 0x34, 0x24,
-	0x45, 0x57, 0x11, 0x8e, 0xff, 0x9b, 0x1a, 0x3c, 0x48, 0xf2, 0x8e, 0x02, 0x21, 0xc7, 0xbe, 0x74,
-	0x56, 0x47, 0x8d, 0xc8, 0x6c, 0x9d, 0x07, 0xc6, 0xf9, 0x3d, 0x43, 0xfb, 0xb1, 0x67, 0x80, 0x4b,
-	0xbd, 0xd8, 0xe1, 0x32, 0xbe, 0x77, 0xb7, 0x47, 0x80, 0xd1, 0x2f, 0x6b, 0x70, 0xc9, 0xce, 0xd8,
-	0x3a, 0x42, 0x64, 0xad, 0x9f, 0xc1, 0xae, 0xe4, 0x77, 0x9e, 0x59, 0x10, 0x9c, 0xd9, 0x15, 0xf4,
-	0xab, 0xb9, 0x69, 0x90, 0xf8, 0x95, 0xe4, 0x7a, 0x8f, 0x9d, 0x3c, 0xad, 0x8c, 0x48, 0x6f, 0x6b,
-	0x80, 0x1a, 0x29, 0xb1, 0x58, 0x78, 0x91, 0x3c, 0x7f, 0xea, 0xc2, 0x3f, 0xbf, 0xb4, 0x4e, 0x97,
-	0xe3, 0x8c, 0x4e, 0xb0, 0xef, 0x1c, 0x64, 0x6c, 0x5f, 0x91, 0xf4, 0xbb, 0xd7, 0xef, 0x9c, 0xc5,
-	0x19, 0xf8, 0x77, 0xce, 0x82, 0xe0, 0xcc, 0xae, 0xe8, 0xbf, 0x37, 0xc8, 0xad, 0x34, 0xec, 0x56,
-	0x71, 0x13, 0x06, 0x37, 0x99, 0x55, 0x4f, 0xec, 0xdb, 0xc2, 0x26, 0x44, 0x6e, 0x1b, 0xe4, 0x3a,
-	0x12, 0xff, 0x1f, 0x0b, 0xcc, 0xe8, 0x65, 0xe8, 0x6b, 0x38, 0x32, 0x42, 0xeb, 0x83, 0x3d, 0x18,
-	0xc3, 0xa2, 0x38, 0xd1, 0xea, 0x6a, 0x1d, 0x53, 0xa4, 0xc8, 0x81, 0x61, 0x47, 0x18, 0x36, 0x84,
-	0xee, 0x59, 0xf8, 0x85, 0xe9, 0xd0, 0x40, 0x12, 0x9a, 0x65, 0x64, 0x09, 0x0e, 0x69, 0x50, 0x7a,
-	0x09, 0x4b, 0x7e, 0x61, 0x7a, 0xa1, 0x69, 0xaf, 0x9b, 0xf5, 0x94, 0xc0, 0x60, 0x60, 0x58, 0x4e,
-	0x20, 0xc3, 0xa0, 0x9e, 0x2d, 0x4a, 0x6d, 0x9d, 0x62, 0x89, 0xec, 0x17, 0xec, 0xa7, 0x8f, 0x05,
-	0x72, 0xba, 0x0c, 0x78, 0x28, 0x94, 0xd8, 0x46, 0x85, 0x97, 0x01, 0x8f, 0xae, 0xe2, 0xcb, 0x80,
-	0xff, 0x8f, 0x05, 0x66, 0xf4, 0x2a, 0x0c, 0xfb, 0xd2, 0xc9, 0x61, 0xb8, 0xd7, 0xc7, 0xc0, 0x85,
-	0x87, 0x83, 0x88, 0xae, 0x11, 0xae, 0x0d, 0x21, 0x7e, 0xb4, 0x09, 0x43, 0x16, 0x8f, 0x07, 0x11,
-	0x39, 0xdc, 0x3e, 0xd8, 0xc3, 0x5b, 0x98, 0x5c, 0x0d, 0x16, 0x3f, 0xb0, 0x44, 0xac, 0x7f, 0x09,
-	0xb8, 0x55, 0x5c, 0xf8, 0x91, 0x6d, 0xc1, 0xb0, 0x44, 0xd7, 0x4b, 0x08, 0xb1, 0x7c, 0x7d, 0x98,
-	0x0f, 0x2d, 0x7c, 0x8b, 0x38, 0xc4, 0x8d, 0x2a, 0x59, 0xa1, 0xe0, 0xd1, 0x53, 0x28, 0xc7, 0x0b,
-	0x03, 0x7f, 0x8d, 0x3d, 0x17, 0x2a, 0x13, 0xb2, 0xf4, 0x15, 0x5f, 0x5a, 0x61, 0xb2, 0x96, 0xd8,
-	0x33, 0xa1, 0x32, 0x9f, 0x8b, 0x42, 0x24, 0xc7, 0xcf, 0xae, 0xbf, 0x90, 0x9f, 0xdd, 0xb3, 0x70,
-	0x41, 0xf8, 0x35, 0xd4, 0x1a, 0x84, 0xe9, 0x62, 0x22, 0x10, 0x81, 0x79, 0xbc, 0x54, 0xe2, 0x20,
-	0x9c, 0xac, 0x8b, 0x7e, 0x57, 0x83, 0x61, 0x53, 0x08, 0x08, 0x62, 0x5f, 0x2d, 0xf7, 0x76, 0x75,
-	0x32, 0x27, 0xe5, 0x0d, 0x2e, 0xfa, 0xbe, 0x20, 0x77, 0xb4, 0x2c, 0x3e, 0x25, 0x15, 0x3f, 0xec,
-	0x35, 0xfa, 0x43, 0x2a, 0xdd, 0xdb, 0xec, 0x45, 0x64, 0x96, 0xf4, 0x82, 0x47, 0x48, 0xdc, 0xed,
-	0x71, 0x14, 0xf3, 0x11, 0x46, 0x3e, 0x90, 0x1f, 0x0c, 0x65, 0xf8, 0x08, 0x72, 0x4a, 0x63, 0x51,
-	0xbb, 0x8f, 0xfe, 0xb1, 0x06, 0x8f, 0xf0, 0xb0, 0x94, 0x0a, 0x3d, 0xf3, 0xb7, 0x2c, 0xd3, 0x08,
-	0x08, 0xcf, 0x3b, 0x23, 0xbd, 0xf2, 0xb9, 0x57, 0xe0, 0xf0, 0x89, 0xbd, 0x02, 0x1f, 0x3d, 0x3c,
-	0x28, 0x3f, 0x52, 0x39, 0x06, 0x6e, 0x7c, 0xac, 0x1e, 0xa0, 0xd7, 0x61, 0xdc, 0x56, 0x13, 0x73,
-	0x09, 0x06, 0x53, 0xc8, 0x30, 0x1f, 0xcb, 0xf0, 0xc5, 0x2d, 0xb1, 0xb1, 0x22, 0x1c, 0x27, 0x35,
-	0xbb, 0x03, 0xe3, 0xb1, 0x85, 0x76, 0xa6, 0x26, 0x0d, 0x07, 0x26, 0x93, 0xeb, 0xe1, 0x4c, 0x3d,
-	0x64, 0xee, 0xc0, 0x48, 0x78, 0x50, 0xa1, 0x87, 0x14, 0x42, 0xd1, 0xb1, 0x7f, 0x87, 0xec, 0x73,
-	0xaa, 0xe5, 0x98, 0x3a, 0xc6, 0xed, 0xed, 0x2f, 0xd0, 0x02, 0x81, 0x50, 0xff, 0xb2, 0xb0, 0xb7,
-	0xaf, 0x93, 0x56, 0xdb, 0x36, 0x02, 0xf2, 0xce, 0xbf, 0xed, 0xd5, 0xff, 0x9b, 0xc6, 0xcf, 0x1b,
-	0x7e, 0xac, 0x22, 0x03, 0x46, 0x5b, 0x3c, 0x41, 0x3c, 0xcb, 0xf3, 0xa2, 0x15, 0xcf, 0x30, 0xb3,
-	0x12, 0xa1, 0xc1, 0x2a, 0x4e, 0x74, 0x0f, 0x46, 0xa4, 0x20, 0x22, 0xed, 0x07, 0x4b, 0xbd, 0x09,
-	0x06, 0xa1, 0xcc, 0x13, 0x5e, 0x24, 0xca, 0x12, 0x1f, 0x47, 0xb4, 0x74, 0x03, 0x50, 0xba, 0x0d,
-	0xd5, 0x59, 0xa5, 0xe3, 0xbb, 0x16, 0x4f, 0xe9, 0x9a, 0x72, 0x7e, 0x97, 0xe6, 0x91, 0x52, 0x9e,
-	0x79, 0x44, 0xff, 0x7c, 0x09, 0x32, 0xdf, 0xe3, 0x44, 0x3a, 0x0c, 0xf2, 0x58, 0x34, 0x41, 0x84,
-	0x89, 0x32, 0x3c, 0x50, 0x0d, 0x0b, 0x08, 0xba, 0xcb, 0xed, 0x16, 0x4e, 0x83, 0xa5, 0x52, 0x8d,
-	0xb8, 0x84, 0x1a, 0xf5, 0xb8, 0x98, 0x55, 0x01, 0x67, 0xb7, 0x43, 0xbb, 0x80, 0x5a, 0xc6, 0x5e,
-	0x12, 0x5b, 0x0f, 0x0f, 0xce, 0xad, 0xa4, 0xb0, 0xe1, 0x0c, 0x0a, 0xf4, 0x20, 0x35, 0x4c, 0x93,
-	0xb4, 0x03, 0xd2, 0xe0, 0x43, 0x94, 0xd7, 0x7d, 0xec, 0x20, 0x9d, 0x8f, 0x83, 0x70, 0xb2, 0xae,
-	0xfe, 0x8d, 0x7e, 0x78, 0x30, 0x3e, 0x89, 0x74, 0x87, 0xca, 0x70, 0xb1, 0xe7, 0xa4, 0x37, 0x3c,
-	0x9f, 0xc8, 0xc7, 0x92, 0xde, 0xf0, 0x33, 0x15, 0x8f, 0xb0, 0x23, 0xd9, 0xb0, 0x7d, 0xd9, 0x28,
-	0xe6, 0x19, 0xff, 0x2d, 0x88, 0xfd, 0xca, 0x89, 0x71, 0xeb, 0x3b, 0xd3, 0x18, 0xb7, 0xb7, 0x34,
-	0x98, 0x8d, 0x17, 0x2f, 0x59, 0x8e, 0xe5, 0x6f, 0x8b, 0x84, 0xa0, 0x27, 0x77, 0xc6, 0x67, 0x4f,
-	0xe4, 0x2c, 0xe7, 0x62, 0xc4, 0x5d, 0xa8, 0xa1, 0x4f, 0x68, 0x70, 0x25, 0x31, 0x2f, 0xb1, 0xf4,
-	0xa4, 0x27, 0xf7, 0xcb, 0x67, 0xd1, 0xba, 0xcb, 0xf9, 0x28, 0x71, 0x37, 0x7a, 0xfa, 0xbf, 0x2c,
-	0xc1, 0x00, 0xbb, 0xad, 0x7e, 0x67, 0xb8, 0x27, 0xb3, 0xae, 0xe6, 0x7a, 0xec, 0x34, 0x13, 0x1e,
-	0x3b, 0xcf, 0x15, 0x27, 0xd1, 0xdd, 0x65, 0xe7, 0x07, 0xe1, 0x32, 0xab, 0x36, 0xdf, 0x60, 0x46,
-	0x14, 0x9f, 0x34, 0xe6, 0x1b, 0x0d, 0x96, 0x2b, 0xe0, 0x68, 0xcb, 0xf1, 0x43, 0xd0, 0xd7, 0xf1,
-	0xec, 0x64, 0x6a, 0xa6, 0x0d, 0xbc, 0x8c, 0x69, 0xb9, 0xfe, 0x96, 0x06, 0x93, 0x0c, 0xb7, 0xb2,
-	0x7d, 0xd1, 0x2e, 0x0c, 0x7b, 0x62, 0x0b, 0x8b, 0x6f, 0xb3, 0x5c, 0x78, 0x68, 0x19, 0x6c, 0x41,
-	0xbc, 0x18, 0x2c, 0x7e, 0xe1, 0x90, 0x96, 0xfe, 0xb5, 0x41, 0x98, 0xc9, 0x6b, 0x84, 0x3e, 0xa9,
-	0xc1, 0x65, 0x33, 0x92, 0xe6, 0xe6, 0x3b, 0xc1, 0xb6, 0xeb, 0x59, 0x81, 0x25, 0xdc, 0x38, 0x0a,
-	0xaa, 0xb9, 0x95, 0xf9, 0xb0, 0x57, 0x2c, 0x9d, 0x66, 0x25, 0x93, 0x02, 0xce, 0xa1, 0x8c, 0xde,
-	0xe0, 0x69, 0x6b, 0x4c, 0xd5, 0x73, 0xe1, 0x4e, 0xe1, 0xb9, 0x52, 0x72, 0x7c, 0xcb, 0x4e, 0x85,
-	0xb9, 0x6b, 0x44, 0xb9, 0x42, 0x8e, 0x12, 0xf7, 0xfd, 0xed, 0x3b, 0x64, 0xbf, 0x6d, 0x58, 0xf2,
-	0xb2, 0xbe, 0x38, 0xf1, 0x7a, 0xfd, 0xb6, 0x40, 0x15, 0x27, 0xae, 0x94, 0x2b, 0xe4, 0xd0, 0xc7,
-	0x35, 0x18, 0x77, 0xd5, 0xc0, 0xe2, 0x5e, 0x7c, 0x21, 0x33, 0x23, 0x94, 0xb9, 0x08, 0x1d, 0x07,
-	0xc5, 0x49, 0xd2, 0x35, 0x31, 0xe5, 0x27, 0x8f, 0x2c, 0xc1, 0xd4, 0x56, 0x7a, 0x7f, 0xee, 0x5b,
-	0x39, 0xff, 0xb8, 0x3a, 0x9e, 0x06, 0xa7, 0xc9, 0xb3, 0x4e, 0x91, 0xc0, 0x6c, 0x44, 0x8f, 0x0f,
-	0xd3, 0x4e, 0x0d, 0x16, 0xef, 0xd4, 0xe2, 0x7a, 0xa5, 0x1a, 0x43, 0x16, 0xef, 0x54, 0x1a, 0x9c,
-	0x26, 0xaf, 0x7f, 0xac, 0x04, 0x0f, 0xe4, 0xac, 0xb1, 0xbf, 0x35, 0x91, 0xe0, 0x5f, 0xd4, 0x60,
-	0x84, 0xcd, 0xc1, 0x3b, 0x24, 0x9c, 0x84, 0xf5, 0x35, 0xc7, 0xa7, 0xed, 0xf7, 0x35, 0x98, 0x4a,
-	0x25, 0x72, 0x3e, 0x56, 0x30, 0xc2, 0xb9, 0xb9, 0x5b, 0xbd, 0x27, 0x7a, 0xb4, 0xa1, 0x2f, 0x0a,
-	0x6d, 0x4d, 0x3e, 0xd8, 0xa0, 0xbf, 0x08, 0xe3, 0x31, 0x97, 0xb6, 0x30, 0x6b, 0x8f, 0x96, 0x99,
-	0xb5, 0x47, 0x4d, 0xca, 0x53, 0xea, 0x96, 0x94, 0x27, 0x5a, 0xf2, 0x69, 0xce, 0xf6, 0xb7, 0x66,
-	0xc9, 0xff, 0x87, 0x49, 0xb1, 0xe4, 0xd9, 0xfd, 0xc0, 0x2b, 0x30, 0xc8, 0x52, 0x00, 0xc9, 0x13,
-	0xf3, 0x66, 0xe1, 0xd4, 0x42, 0x3e, 0xd7, 0xa4, 0xf8, 0xff, 0x58, 0x60, 0x45, 0xd5, 0x78, 0x7e,
-	0xab, 0xd5, 0x48, 0x69, 0xcb, 0xcc, 0x4c, 0xc5, 0x96, 0x65, 0xaa, 0x05, 0xc2, 0xfc, 0x86, 0x81,
-	0x9f, 0x67, 0x85, 0xd2, 0x0f, 0x57, 0x57, 0xeb, 0xfc, 0x85, 0x9d, 0xf0, 0x66, 0xe1, 0x35, 0x00,
-	0x22, 0x17, 0xaf, 0x8c, 0x02, 0x7c, 0xb6, 0x58, 0x62, 0xe5, 0x70, 0x0b, 0x48, 0xe1, 0x33, 0x2c,
-	0xf2, 0xb1, 0x42, 0x04, 0x79, 0x30, 0xba, 0x6d, 0x6d, 0x12, 0xcf, 0xe1, 0x72, 0xd4, 0x40, 0x71,
-	0x11, 0xf1, 0x76, 0x84, 0x86, 0xeb, 0xf8, 0x4a, 0x01, 0x56, 0x89, 0x20, 0x2f, 0x96, 0x45, 0x6f,
-	0xb0, 0xb8, 0x58, 0x14, 0xd9, 0x9d, 0xa3, 0x71, 0xe6, 0x64, 0xd0, 0x73, 0x00, 0x9c, 0x30, 0xf7,
-	0x57, 0x2f, 0x37, 0x0e, 0x51, 0x06, 0x31, 0x2e, 0x78, 0x44, 0xbf, 0xb1, 0x42, 0x81, 0xce, 0x6b,
-	0x2b, 0xca, 0x54, 0x2a, 0x6c, 0x88, 0xcf, 0xf5, 0x98, 0x2d, 0x56, 0xd8, 0x4e, 0xa2, 0x02, 0xac,
-	0x12, 0xa1, 0x63, 0x6c, 0x85, 0xf9, 0x45, 0x85, 0x8d, 0xb0, 0xd0, 0x18, 0xa3, 0x2c, 0xa5, 0xe2,
-	0x99, 0xc6, 0xf0, 0x37, 0x56, 0x28, 0xa0, 0x57, 0x95, 0x8b, 0x29, 0x28, 0x6e, 0x81, 0x3a, 0xd6,
-	0xa5, 0xd4, 0xfb, 0x23, 0x43, 0xcc, 0x28, 0xdb, 0xab, 0x57, 0x14, 0x23, 0x0c, 0xcb, 0xbb, 0x4a,
-	0xf9, 0x47, 0xca, 0x28, 0x13, 0x39, 0xd3, 0x8e, 0x75, 0x75, 0xa6, 0xad, 0x50, 0x09, 0x4d, 0x09,
-	0xee, 0x60, 0x4c, 0x61, 0x3c, 0xba, 0xe1, 0xa8, 0x27, 0x81, 0x38, 0x5d, 0x9f, 0x33, 0x7d, 0xd2,
-	0x60, 0x6d, 0x27, 0x54, 0xa6, 0xcf, 0xcb, 0x70, 0x08, 0x45, 0xbb, 0x30, 0xe6, 0x2b, 0x9e, 0xb9,
-	0xe2, 0x6d, 0xdd, 0x1e, 0xee, 0xa6, 0x84, 0x57, 0x2e, 0x4b, 0x8a, 0xa4, 0x96, 0xe0, 0x18, 0x1d,
-	0xf4, 0x86, 0xea, 0x8a, 0x38, 0xd9, 0x5b, 0xf6, 0xcd, 0x74, 0x3e, 0xd9, 0xc8, 0xc2, 0x16, 0x7a,
-	0xc1, 0xa9, 0x1e, 0x82, 0x9d, 0xb8, 0xd3, 0xdd, 0xd4, 0xa9, 0x84, 0x9d, 0x1f, 0xe9, 0x94, 0x47,
-	0x3f, 0x2d, 0xd9, 0x6b, 0xbb, 0x7e, 0xc7, 0x23, 0x2c, 0x4f, 0x36, 0xfb, 0x3c, 0x28, 0xfa, 0xb4,
-	0x8b, 0x49, 0x20, 0x4e, 0xd7, 0x47, 0x3f, 0xa5, 0xc1, 0x24, 0x7f, 0x9a, 0x98, 0x1e, 0x5d, 0xae,
-	0x43, 0x9c, 0xc0, 0x67, 0x6f, 0xef, 0x16, 0x8c, 0x94, 0xac, 0x27, 0x70, 0xf1, 0xf7, 0xdc, 0x92,
-	0xa5, 0x38, 0x45, 0x93, 0xae, 0x1c, 0x35, 0x70, 0x9d, 0x3d, 0xe1, 0x5b, 0x70, 0xe5, 0xa8, 0x41,
-	0xf1, 0x7c, 0xe5, 0xa8, 0x25, 0x38, 0x46, 0x07, 0x3d, 0x0d, 0xe3, 0xbe, 0x7c, 0xc4, 0x8b, 0xcd,
-	0xe0, 0x74, 0x94, 0x59, 0xaa, 0xae, 0x02, 0x70, 0xbc, 0x1e, 0xfa, 0x28, 0x8c, 0xa9, 0x67, 0xa7,
-	0x78, 0xf8, 0xf7, 0x14, 0x13, 0x5d, 0xf2, 0x9e, 0xab, 0xa0, 0x18, 0x41, 0xfd, 0xdf, 0x6a, 0x00,
-	0xa1, 0xf9, 0xe2, 0x3c, 0x8c, 0xf2, 0x8d, 0x98, 0x45, 0x67, 0xa1, 0x27, 0x73, 0x4b, 0x6e, 0xee,
-	0x60, 0xfd, 0xab, 0x1a, 0x4c, 0x44, 0xd5, 0xce, 0x41, 0x57, 0x30, 0xe3, 0xba, 0xc2, 0x87, 0x7a,
-	0x1b, 0x57, 0x8e, 0xc2, 0xf0, 0x7f, 0x4b, 0xea, 0xa8, 0x98, 0x38, 0xb8, 0x1b, 0xbb, 0xe4, 0xa6,
-	0xa4, 0x6f, 0xf7, 0x72, 0xc9, 0xad, 0x46, 0xf3, 0x46, 0xe3, 0xcd, 0xb8, 0xf4, 0xfe, 0x3b, 0x31,
-	0x61, 0xac, 0x87, 0x98, 0xf5, 0x50, 0xf2, 0x92, 0xa4, 0xf9, 0x04, 0x1c, 0x25, 0x99, 0xbd, 0xa6,
-	0xf2, 0xea, 0x1e, 0xf2, 0xfd, 0xc6, 0x06, 0xdc, 0x95, 0x43, 0xeb, 0x3f, 0x3f, 0x01, 0xa3, 0x8a,
-	0xa5, 0x2f, 0x71, 0x65, 0xaf, 0x9d, 0xc7, 0x95, 0x7d, 0x00, 0xa3, 0x66, 0xf8, 0xf0, 0x85, 0x9c,
-	0xf6, 0x1e, 0x69, 0x86, 0x67, 0x44, 0xf4, 0xa4, 0x86, 0x8f, 0x55, 0x32, 0x54, 0x92, 0x09, 0xd7,
-	0x58, 0xdf, 0x29, 0x38, 0x52, 0x74, 0x5b, 0x57, 0x4f, 0x01, 0x48, 0x61, 0x98, 0x34, 0x44, 0x72,
-	0xc9, 0xd0, 0x67, 0xbd, 0xe6, 0xdf, 0x0e, 0x61, 0x58, 0xa9, 0x97, 0xbe, 0x02, 0x1e, 0x38, 0xb7,
-	0x2b, 0x60, 0xba, 0x0c, 0x6c, 0xf9, 0xee, 0x5a, 0x4f, 0x4e, 0x41, 0xe1, 0xeb, 0x6d, 0xd1, 0x32,
-	0x08, 0x8b, 0x7c, 0xac, 0x10, 0xc9, 0xf1, 0xdc, 0x18, 0x2a, 0xe4, 0xb9, 0xd1, 0x81, 0x8b, 0x1e,
-	0x09, 0xbc, 0xfd, 0xca, 0xbe, 0xc9, 0x92, 0x1c, 0x7b, 0x01, 0x53, 0x69, 0x87, 0x8b, 0x25, 0x3b,
-	0xc2, 0x69, 0x54, 0x38, 0x0b, 0x7f, 0x4c, 0x1a, 0x1c, 0xe9, 0x2a, 0x0d, 0xbe, 0x1f, 0x46, 0x03,
-	0x62, 0x6e, 0x3b, 0x96, 0x69, 0xd8, 0xb5, 0xaa, 0xc8, 0xbc, 0x18, 0x09, 0x36, 0x11, 0x08, 0xab,
-	0xf5, 0xd0, 0x02, 0xf4, 0x75, 0xac, 0x86, 0x10, 0x87, 0xbf, 0x27, 0xb4, 0x99, 0xd7, 0xaa, 0xf7,
-	0x0f, 0xca, 0xef, 0x8e, 0x5c, 0x21, 0xc2, 0x51, 0xdd, 0x68, 0xef, 0x34, 0x6f, 0x04, 0xfb, 0x6d,
-	0xe2, 0xcf, 0x6d, 0xd4, 0xaa, 0x98, 0x36, 0xce, 0xf2, 0x6a, 0x19, 0x3b, 0x81, 0x57, 0xcb, 0xdb,
-	0x1a, 0x5c, 0x34, 0x92, 0xe6, 0x7e, 0xe2, 0xcf, 0x8c, 0x17, 0xe7, 0x96, 0xd9, 0x57, 0x08, 0x0b,
-	0x57, 0xc4, 0xf8, 0x2e, 0xce, 0xa7, 0xc9, 0xe1, 0xac, 0x3e, 0x20, 0x0f, 0x50, 0xcb, 0x6a, 0x86,
-	0x4f, 0xa0, 0x89, 0xaf, 0x3e, 0x51, 0xcc, 0x90, 0xb1, 0x92, 0xc2, 0x84, 0x33, 0xb0, 0xa3, 0x7b,
-	0x30, 0x6a, 0x46, 0x97, 0x02, 0x42, 0xac, 0xaf, 0x9e, 0xc6, 0xad, 0x04, 0x57, 0xfd, 0xd4, 0x1b,
-	0x07, 0x95, 0x52, 0x78, 0x9d, 0xa7, 0xe8, 0xdc, 0xe2, 0x4a, 0x8b, 0x8d, 0x7a, 0xb2, 0xf8, 0x75,
-	0x5e, 0x36, 0x46, 0xdc, 0x85, 0x1a, 0x4b, 0x31, 0x64, 0xc7, 0x5f, 0x2a, 0x9c, 0x99, 0x2a, 0x1e,
-	0x96, 0x9c, 0x78, 0xf4, 0x90, 0x2f, 0xcd, 0x44, 0x21, 0x4e, 0x12, 0x44, 0x4b, 0x80, 0x08, 0xb7,
-	0x2d, 0x47, 0x9a, 0x8a, 0x3f, 0x83, 0xc2, 0x17, 0x1d, 0xd1, 0x62, 0x0a, 0x8a, 0x33, 0x5a, 0xe8,
-	0x5f, 0xd1, 0x84, 0xe5, 0xef, 0x1c, 0xdd, 0x3a, 0xce, 0xfa, 0x4e, 0x50, 0xff, 0x73, 0x0d, 0x52,
-	0xca, 0x06, 0xda, 0x84, 0x21, 0x8a, 0xa2, 0xba, 0x5a, 0x17, 0xc3, 0xfa, 0x60, 0xb1, 0x63, 0x97,
-	0xa1, 0xe0, 0x66, 0x54, 0xf1, 0x03, 0x4b, 0xc4, 0x54, 0x7d, 0x71, 0x94, 0x24, 0xd2, 0x62, 0x84,
-	0x85, 0xe4, 0x1a, 0x35, 0x19, 0x35, 0x57, 0x02, 0xd4, 0x12, 0x1c, 0xa3, 0xa3, 0x2f, 0x03, 0x44,
-	0x0a, 0x62, 0xcf, 0x9e, 0x3e, 0xff, 0x62, 0x10, 0xa6, 0x7b, 0x8d, 0x71, 0x60, 0x0f, 0xed, 0x91,
-	0x5d, 0xcb, 0x0c, 0xe6, 0xb7, 0x02, 0xe2, 0xdd, 0xbd, 0xbb, 0xb2, 0xbe, 0xed, 0x11, 0x7f, 0xdb,
-	0xb5, 0x1b, 0x05, 0x5f, 0xfa, 0x63, 0x37, 0x83, 0x8b, 0x99, 0x18, 0x71, 0x0e, 0x25, 0xa6, 0x1c,
-	0x8b, 0xb7, 0xf9, 0x31, 0x15, 0x4a, 0x3b, 0x9e, 0x1f, 0x88, 0x44, 0x2d, 0x5c, 0x39, 0x4e, 0x02,
-	0x71, 0xba, 0x7e, 0x12, 0xc9, 0xb2, 0xd5, 0xb2, 0xf8, 0x8b, 0x67, 0x5a, 0x1a, 0x09, 0x03, 0xe2,
-	0x74, 0x7d, 0x15, 0x09, 0xff, 0x52, 0x94, 0x6b, 0x0c, 0xa4, 0x91, 0x84, 0x40, 0x9c, 0xae, 0x8f,
-	0x1a, 0x70, 0xd5, 0x23, 0xa6, 0xdb, 0x6a, 0x11, 0xa7, 0xc1, 0xdf, 0xb0, 0x35, 0xbc, 0xa6, 0xe5,
-	0x2c, 0x79, 0x06, 0xab, 0xc8, 0x6c, 0x8d, 0x1a, 0x7b, 0xb7, 0xe7, 0x2a, 0xee, 0x52, 0x0f, 0x77,
-	0xc5, 0x82, 0x5a, 0x70, 0x81, 0x3f, 0x98, 0xe7, 0xd5, 0x9c, 0x80, 0x78, 0xbb, 0x86, 0x2d, 0x0c,
-	0x8a, 0x85, 0xde, 0xd7, 0xdf, 0x88, 0xa3, 0xc2, 0x49, 0xdc, 0x68, 0x9f, 0xca, 0x2f, 0xa2, 0x3b,
-	0x0a, 0xc9, 0xe1, 0xe2, 0x4f, 0x51, 0xe2, 0x34, 0x3a, 0x9c, 0x45, 0x03, 0xd5, 0xe0, 0x62, 0x60,
-	0x78, 0x4d, 0x12, 0x54, 0xd6, 0x36, 0xd6, 0x88, 0x67, 0xd2, 0xe3, 0xc6, 0xe6, 0xe2, 0x8c, 0xc6,
-	0x51, 0xad, 0xa7, 0xc1, 0x38, 0xab, 0x8d, 0xfe, 0xb6, 0x06, 0xc2, 0x3b, 0x1b, 0x5d, 0x8d, 0xdd,
-	0xff, 0x0c, 0x27, 0xee, 0x7e, 0xe4, 0xbb, 0x0c, 0xa5, 0xcc, 0x77, 0x19, 0xde, 0xab, 0x24, 0x13,
-	0x1a, 0x89, 0xd8, 0x28, 0xc7, 0xac, 0x3c, 0x58, 0xf6, 0x38, 0x8c, 0x84, 0xcc, 0x5c, 0x08, 0xd9,
-	0x2c, 0x39, 0x68, 0xc4, 0xf5, 0x23, 0xb8, 0xfe, 0x47, 0x1a, 0x40, 0xf4, 0x46, 0xc7, 0xf1, 0x9e,
-	0x59, 0x3b, 0xd2, 0xdd, 0x4b, 0x79, 0x1e, 0xae, 0x2f, 0xf7, 0x79, 0xb8, 0x33, 0x7a, 0x35, 0xed,
-	0x37, 0x35, 0xb8, 0x10, 0xcf, 0xee, 0xe4, 0xa3, 0xf7, 0xc0, 0x90, 0xc8, 0xff, 0x28, 0x12, 0xb8,
-	0xb1, 0xa6, 0x22, 0x01, 0x03, 0x96, 0xb0, 0xb8, 0x89, 0xb0, 0x07, 0xad, 0x37, 0x3b, 0xc9, 0xd4,
-	0x11, 0x0a, 0xe8, 0xdb, 0x53, 0x30, 0xc8, 0x93, 0x07, 0x52, 0xf6, 0x98, 0x11, 0x78, 0x7a, 0xa7,
-	0x78, 0x8e, 0xc2, 0x22, 0xd1, 0x82, 0x6a, 0x9e, 0xfe, 0x52, 0xd7, 0x3c, 0xfd, 0x98, 0xbf, 0x46,
-	0xd9, 0xc3, 0x75, 0x50, 0x05, 0xd7, 0xf8, 0x75, 0x50, 0xf8, 0x12, 0x65, 0x10, 0xbb, 0x27, 0xe9,
-	0x2f, 0x2e, 0x4c, 0xf2, 0x09, 0x50, 0x6e, 0x4b, 0x26, 0xba, 0xde, 0x94, 0xc8, 0xec, 0x6c, 0x03,
-	0xc5, 0xdd, 0x2f, 0xc5, 0x94, 0x1f, 0x23, 0x3b, 0x5b, 0xb8, 0x91, 0x06, 0x73, 0x37, 0xd2, 0x16,
-	0x0c, 0x89, 0xad, 0x20, 0xf8, 0xec, 0x07, 0x7b, 0x78, 0x79, 0x48, 0x49, 0x28, 0xcc, 0x0b, 0xb0,
-	0x44, 0x4e, 0x0f, 0xef, 0x96, 0xb1, 0x67, 0xb5, 0x3a, 0x2d, 0xc6, 0x5c, 0x07, 0xd4, 0xaa, 0xac,
-	0x18, 0x4b, 0x38, 0xab, 0xca, 0xbd, 0x56, 0x19, 0x33, 0x54, 0xab, 0xf2, 0x62, 0x2c, 0xe1, 0xe8,
-	0x65, 0x18, 0x6e, 0x19, 0x7b, 0xf5, 0x8e, 0xd7, 0x24, 0xe2, 0x96, 0x24, 0x5f, 0x5c, 0xec, 0x04,
-	0x96, 0x3d, 0x67, 0x39, 0x81, 0x1f, 0x78, 0x73, 0x35, 0x27, 0xb8, 0xeb, 0xd5, 0x03, 0x2f, 0x7c,
-	0xdb, 0x6d, 0x45, 0x60, 0xc1, 0x21, 0x3e, 0x64, 0xc3, 0x44, 0xcb, 0xd8, 0xdb, 0x70, 0x0c, 0x9e,
-	0x78, 0xcf, 0xe6, 0x97, 0x23, 0x45, 0x28, 0xb0, 0xab, 0xf2, 0x95, 0x18, 0x2e, 0x9c, 0xc0, 0x9d,
-	0x71, 0x2b, 0x3f, 0x76, 0x56, 0xb7, 0xf2, 0xf3, 0x61, 0x0c, 0x12, 0x57, 0x25, 0x1f, 0xcc, 0x8c,
-	0xcd, 0xef, 0x1a, 0x5f, 0xf4, 0x4a, 0x18, 0x5f, 0x34, 0x51, 0xfc, 0x1a, 0xb9, 0x4b, 0x6c, 0x51,
-	0x07, 0x46, 0xa9, 0xb0, 0xce, 0x4b, 0xa9, 0xae, 0x57, 0xd8, 0x2a, 0x5a, 0x0d, 0xd1, 0x28, 0xaf,
-	0x92, 0x47, 0xa8, 0xb1, 0x4a, 0x07, 0xdd, 0x85, 0x69, 0xf1, 0x4e, 0x6c, 0x54, 0x85, 0xd9, 0x18,
-	0x26, 0xd9, 0xfe, 0x61, 0x7e, 0xc0, 0x77, 0xb2, 0x2a, 0xe0, 0xec, 0x76, 0x51, 0x1e, 0x99, 0xa9,
-	0xec, 0x3c, 0x32, 0xe8, 0x67, 0xb3, 0xee, 0x3e, 0x10, 0x9b, 0xd3, 0x1f, 0x28, 0xce, 0x1b, 0x0a,
-	0xdf, 0x80, 0xfc, 0x2b, 0x0d, 0x66, 0x5a, 0x39, 0xcf, 0x77, 0x8b, 0x2b, 0x99, 0xf5, 0x1e, 0xf8,
-	0x43, 0xee, 0x93, 0xe0, 0x0b, 0x8f, 0x1c, 0x1e, 0x94, 0x8f, 0x7c, 0x38, 0x1c, 0xe7, 0xf6, 0x0d,
-	0x79, 0x30, 0xe4, 0xef, 0xfb, 0x66, 0x60, 0xfb, 0x33, 0x97, 0x8a, 0xbf, 0x12, 0x2d, 0x38, 0x6b,
-	0x9d, 0x63, 0xe2, 0xac, 0x35, 0x4a, 0x63, 0xcf, 0x4b, 0xb1, 0x24, 0x84, 0x7e, 0x5e, 0x83, 0x29,
-	0x61, 0xb4, 0x51, 0x82, 0x6b, 0xa7, 0x8b, 0x7b, 0x4b, 0x56, 0x92, 0xc8, 0xee, 0xb6, 0x79, 0x0e,
-	0x74, 0x26, 0xa4, 0xa7, 0xa0, 0x38, 0x4d, 0xbd, 0xd7, 0xe8, 0xf7, 0x1e, 0xd2, 0x79, 0xce, 0xde,
-	0x84, 0x31, 0x75, 0xe2, 0x4e, 0x14, 0x74, 0xff, 0x2b, 0x1a, 0x4c, 0x26, 0x0f, 0x52, 0xb4, 0x0d,
-	0x43, 0x62, 0x57, 0x09, 0x9d, 0x79, 0xbe, 0xa8, 0x1f, 0x83, 0x4d, 0x44, 0x34, 0x00, 0x97, 0xcb,
-	0x44, 0x11, 0x96, 0xe8, 0x55, 0x3f, 0xa5, 0x52, 0x17, 0x3f, 0xa5, 0x67, 0xe1, 0x72, 0xf6, 0xfe,
-	0xa2, 0x52, 0xad, 0x61, 0xdb, 0xee, 0x3d, 0xa1, 0x98, 0x46, 0xaf, 0x6e, 0xd1, 0x42, 0xcc, 0x61,
-	0xfa, 0x8f, 0x41, 0x32, 0x79, 0x33, 0x7a, 0x15, 0x46, 0x7c, 0x7f, 0x9b, 0xe7, 0xe5, 0x14, 0x83,
-	0x2c, 0x66, 0x91, 0x90, 0xc9, 0x3d, 0xb9, 0x20, 0x1e, 0xfe, 0xc4, 0x11, 0xfa, 0x85, 0x97, 0xbe,
-	0xf0, 0x8d, 0x6b, 0xef, 0xfa, 0xf2, 0x37, 0xae, 0xbd, 0xeb, 0x6b, 0xdf, 0xb8, 0xf6, 0xae, 0x1f,
-	0x3f, 0xbc, 0xa6, 0x7d, 0xe1, 0xf0, 0x9a, 0xf6, 0xe5, 0xc3, 0x6b, 0xda, 0xd7, 0x0e, 0xaf, 0x69,
-	0xff, 0xf9, 0xf0, 0x9a, 0xf6, 0x73, 0xff, 0xe5, 0xda, 0xbb, 0x5e, 0x7e, 0x32, 0xa2, 0x7e, 0x43,
-	0x12, 0x8d, 0xfe, 0x69, 0xef, 0x34, 0x6f, 0x50, 0xea, 0x32, 0x04, 0x8c, 0x51, 0xff, 0x7f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x2b, 0x35, 0x14, 0xf0, 0xe9, 0xf4, 0x00, 0x00,
+	// 13253 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7d, 0x70, 0x25, 0xd9,
+	0x55, 0x18, 0xee, 0x7e, 0xfa, 0x3e, 0xfa, 0x98, 0xd1, 0x9d, 0xaf, 0xb7, 0xda, 0xdd, 0xd1, 0xb8,
+	0x77, 0xf1, 0xcf, 0x8b, 0x8d, 0x06, 0xaf, 0x6d, 0xec, 0x5d, 0x58, 0xaf, 0xa5, 0x27, 0xcd, 0x8c,
+	0x18, 0x69, 0x46, 0x3e, 0x4f, 0xda, 0x5d, 0x2f, 0xfc, 0x16, 0x5a, 0xef, 0x5d, 0x3d, 0xf5, 0x4e,
+	0xbf, 0xee, 0xb7, 0xdd, 0xfd, 0x34, 0xd2, 0xda, 0xfc, 0xf8, 0xfa, 0xc1, 0xcf, 0xf6, 0x0f, 0x27,
+	0x84, 0x82, 0x38, 0x36, 0x24, 0x98, 0xa2, 0xa8, 0x24, 0x90, 0x22, 0x84, 0x84, 0x54, 0x80, 0x4a,
+	0x15, 0x45, 0x15, 0xc1, 0xa4, 0x80, 0xa2, 0x20, 0xa9, 0x98, 0x22, 0x88, 0x58, 0x21, 0x90, 0xaa,
+	0x50, 0xa9, 0x24, 0x54, 0x2a, 0x95, 0x21, 0x81, 0xd4, 0xfd, 0xea, 0xbe, 0xfd, 0xf5, 0xf4, 0xd4,
+	0x4f, 0x92, 0xbd, 0x05, 0xff, 0xcc, 0xe8, 0xdd, 0x73, 0xef, 0x39, 0xb7, 0xef, 0xc7, 0xb9, 0xe7,
+	0x9e, 0x7b, 0x3e, 0x60, 0xa9, 0x65, 0x87, 0xbb, 0xdd, 0xed, 0x85, 0x86, 0xd7, 0xbe, 0xd9, 0xb2,
+	0xfc, 0x26, 0x75, 0xa9, 0x1f, 0xff, 0xd1, 0x79, 0xd0, 0xba, 0x69, 0x75, 0xec, 0xe0, 0x66, 0xc3,
+	0xf3, 0xe9, 0xcd, 0xbd, 0xf7, 0x6c, 0xd3, 0xd0, 0x7a, 0xcf, 0xcd, 0x16, 0x83, 0x59, 0x21, 0x6d,
+	0x2e, 0x74, 0x7c, 0x2f, 0xf4, 0xc8, 0xb3, 0x31, 0x8e, 0x05, 0xd5, 0x34, 0xfe, 0xa3, 0xf3, 0xa0,
+	0xb5, 0xc0, 0x70, 0x2c, 0x30, 0x1c, 0x0b, 0x12, 0xc7, 0xdc, 0xd7, 0xe8, 0x74, 0xbd, 0x96, 0x77,
+	0x93, 0xa3, 0xda, 0xee, 0xee, 0xf0, 0x5f, 0xfc, 0x07, 0xff, 0x4b, 0x90, 0x98, 0x7b, 0xe6, 0xc1,
+	0x07, 0x83, 0x05, 0xdb, 0x63, 0x9d, 0xb9, 0x69, 0x75, 0x43, 0x2f, 0x68, 0x58, 0x8e, 0xed, 0xb6,
+	0x6e, 0xee, 0x65, 0x7a, 0x33, 0x67, 0x6a, 0x55, 0x65, 0xb7, 0x7b, 0xd6, 0xf1, 0xb7, 0xad, 0x46,
+	0x5e, 0x9d, 0xf7, 0xc5, 0x75, 0xda, 0x56, 0x63, 0xd7, 0x76, 0xa9, 0x7f, 0xa0, 0x06, 0xe4, 0xa6,
+	0x4f, 0x03, 0xaf, 0xeb, 0x37, 0xe8, 0x89, 0x5a, 0x05, 0x37, 0xdb, 0x34, 0xb4, 0xf2, 0x68, 0xdd,
+	0x2c, 0x6a, 0xe5, 0x77, 0xdd, 0xd0, 0x6e, 0x67, 0xc9, 0x7c, 0xdd, 0x71, 0x0d, 0x82, 0xc6, 0x2e,
+	0x6d, 0x5b, 0x99, 0x76, 0xef, 0x2d, 0x6a, 0xd7, 0x0d, 0x6d, 0xe7, 0xa6, 0xed, 0x86, 0x41, 0xe8,
+	0xa7, 0x1b, 0x99, 0x9f, 0x32, 0xe0, 0xe2, 0xe2, 0xc6, 0x6a, 0x9d, 0xfa, 0x7b, 0xd4, 0x5f, 0xf3,
+	0x5a, 0x2d, 0xdb, 0x6d, 0x91, 0x77, 0xc1, 0xc4, 0x1e, 0xf5, 0xb7, 0xbd, 0xc0, 0x0e, 0x0f, 0xaa,
+	0xc6, 0x0d, 0xe3, 0x9d, 0x23, 0x4b, 0xd3, 0x47, 0x87, 0xf3, 0x13, 0x2f, 0xa9, 0x42, 0x8c, 0xe1,
+	0x64, 0x15, 0x2e, 0xed, 0x86, 0x61, 0x67, 0xb1, 0xd1, 0xa0, 0x41, 0x10, 0xd5, 0xa8, 0x56, 0x78,
+	0xb3, 0x6b, 0x47, 0x87, 0xf3, 0x97, 0xee, 0x6c, 0x6e, 0x6e, 0xa4, 0xc0, 0x98, 0xd7, 0xc6, 0xfc,
+	0x59, 0x03, 0x66, 0xa3, 0xce, 0x20, 0x7d, 0xa3, 0x4b, 0x83, 0x30, 0x20, 0x08, 0x57, 0xdb, 0xd6,
+	0xfe, 0x3d, 0xcf, 0x5d, 0xef, 0x86, 0x56, 0x68, 0xbb, 0xad, 0x55, 0x77, 0xc7, 0xb1, 0x5b, 0xbb,
+	0xa1, 0xec, 0xda, 0xdc, 0xd1, 0xe1, 0xfc, 0xd5, 0xf5, 0xdc, 0x1a, 0x58, 0xd0, 0x92, 0x75, 0xba,
+	0x6d, 0xed, 0x67, 0x10, 0x6a, 0x9d, 0x5e, 0xcf, 0x82, 0x31, 0xaf, 0x8d, 0xf9, 0x2c, 0x8c, 0x2c,
+	0x36, 0x9b, 0x9e, 0x4b, 0x9e, 0x81, 0x31, 0xea, 0x5a, 0xdb, 0x0e, 0x6d, 0xf2, 0x8e, 0x8d, 0x2f,
+	0x5d, 0xf8, 0xc2, 0xe1, 0xfc, 0xdb, 0x8e, 0x0e, 0xe7, 0xc7, 0x56, 0x44, 0x31, 0x2a, 0xb8, 0xf9,
+	0x43, 0x15, 0x18, 0xe5, 0x8d, 0x02, 0xf2, 0x03, 0x06, 0x5c, 0x7a, 0xd0, 0xdd, 0xa6, 0xbe, 0x4b,
+	0x43, 0x1a, 0x2c, 0x5b, 0xc1, 0xee, 0xb6, 0x67, 0xf9, 0x02, 0xc5, 0xe4, 0xb3, 0xb7, 0x17, 0x4e,
+	0xbe, 0xff, 0x16, 0xee, 0x66, 0xd1, 0x89, 0x6f, 0xca, 0x01, 0x60, 0x1e, 0x71, 0xb2, 0x07, 0x53,
+	0x6e, 0xcb, 0x76, 0xf7, 0x57, 0xdd, 0x96, 0x4f, 0x83, 0x80, 0x8f, 0xcb, 0xe4, 0xb3, 0x1f, 0x2e,
+	0xd3, 0x99, 0x7b, 0x1a, 0x9e, 0xa5, 0x8b, 0x47, 0x87, 0xf3, 0x53, 0x7a, 0x09, 0x26, 0xe8, 0x98,
+	0x7f, 0x6e, 0xc0, 0x85, 0xc5, 0x66, 0xdb, 0x0e, 0x02, 0xdb, 0x73, 0x37, 0x9c, 0x6e, 0xcb, 0x76,
+	0xc9, 0x0d, 0x18, 0x76, 0xad, 0x36, 0xe5, 0x03, 0x32, 0xb1, 0x34, 0x25, 0xc7, 0x74, 0xf8, 0x9e,
+	0xd5, 0xa6, 0xc8, 0x21, 0xe4, 0x23, 0x30, 0xda, 0xf0, 0xdc, 0x1d, 0xbb, 0x25, 0xfb, 0xf9, 0x35,
+	0x0b, 0x62, 0x27, 0x2c, 0xe8, 0x3b, 0x81, 0x77, 0x4f, 0xee, 0xa0, 0x05, 0xb4, 0x1e, 0xae, 0xec,
+	0x87, 0xd4, 0x65, 0x64, 0x96, 0xe0, 0xe8, 0x70, 0x7e, 0xb4, 0xc6, 0x11, 0xa0, 0x44, 0x44, 0xde,
+	0x09, 0xe3, 0x4d, 0x3b, 0x10, 0x93, 0x39, 0xc4, 0x27, 0x73, 0xea, 0xe8, 0x70, 0x7e, 0x7c, 0x59,
+	0x96, 0x61, 0x04, 0x25, 0x6b, 0x70, 0x99, 0x8d, 0xa0, 0x68, 0x57, 0xa7, 0x0d, 0x9f, 0x86, 0xac,
+	0x6b, 0xd5, 0x61, 0xde, 0xdd, 0xea, 0xd1, 0xe1, 0xfc, 0xe5, 0xbb, 0x39, 0x70, 0xcc, 0x6d, 0x65,
+	0xde, 0x82, 0xf1, 0x45, 0x87, 0xfa, 0x6c, 0x81, 0x91, 0xe7, 0x61, 0x86, 0xb6, 0x2d, 0xdb, 0x41,
+	0xda, 0xa0, 0xf6, 0x1e, 0xf5, 0x83, 0xaa, 0x71, 0x63, 0xe8, 0x9d, 0x13, 0x4b, 0xe4, 0xe8, 0x70,
+	0x7e, 0x66, 0x25, 0x01, 0xc1, 0x54, 0x4d, 0xf3, 0x3b, 0x0d, 0x98, 0x5c, 0xec, 0x36, 0xed, 0x50,
+	0x7c, 0x17, 0xf1, 0x61, 0xd2, 0x62, 0x3f, 0x37, 0x3c, 0xc7, 0x6e, 0x1c, 0xc8, 0xc5, 0xf5, 0x62,
+	0x99, 0xf9, 0x5c, 0x8c, 0xd1, 0x2c, 0x5d, 0x38, 0x3a, 0x9c, 0x9f, 0xd4, 0x0a, 0x50, 0x27, 0x62,
+	0xee, 0x82, 0x0e, 0x23, 0x1f, 0x85, 0x29, 0xf1, 0xb9, 0xeb, 0x56, 0x07, 0xe9, 0x8e, 0xec, 0xc3,
+	0x53, 0xda, 0x5c, 0x29, 0x42, 0x0b, 0xf7, 0xb7, 0x5f, 0xa7, 0x8d, 0x10, 0xe9, 0x0e, 0xf5, 0xa9,
+	0xdb, 0xa0, 0x62, 0xd9, 0xd4, 0xb4, 0xc6, 0x98, 0x40, 0x65, 0xfe, 0x01, 0x63, 0x62, 0x7b, 0x96,
+	0xed, 0x58, 0xdb, 0xb6, 0x63, 0x87, 0x07, 0xaf, 0x7a, 0x2e, 0xed, 0x63, 0xdd, 0x6c, 0xc1, 0xb5,
+	0xae, 0x6b, 0x89, 0x76, 0x0e, 0x5d, 0x17, 0x2b, 0x65, 0xf3, 0xa0, 0x43, 0xd9, 0x82, 0x67, 0x23,
+	0xfd, 0xf8, 0xd1, 0xe1, 0xfc, 0xb5, 0xad, 0xfc, 0x2a, 0x58, 0xd4, 0x96, 0xf1, 0x2b, 0x0d, 0xf4,
+	0x92, 0xe7, 0x74, 0xdb, 0x12, 0xeb, 0x10, 0xc7, 0xca, 0xf9, 0xd5, 0x56, 0x6e, 0x0d, 0x2c, 0x68,
+	0x69, 0x7e, 0xa1, 0x02, 0x53, 0x4b, 0x56, 0xe3, 0x41, 0xb7, 0xb3, 0xd4, 0x6d, 0x3c, 0xa0, 0x21,
+	0xf9, 0x56, 0x18, 0x67, 0x07, 0x4e, 0xd3, 0x0a, 0x2d, 0x39, 0x92, 0x5f, 0x5b, 0xb8, 0xea, 0xf9,
+	0x24, 0xb2, 0xda, 0xf1, 0xd8, 0xae, 0xd3, 0xd0, 0x5a, 0x22, 0x72, 0x4c, 0x20, 0x2e, 0xc3, 0x08,
+	0x2b, 0xd9, 0x81, 0xe1, 0xa0, 0x43, 0x1b, 0x72, 0x4f, 0x2d, 0x97, 0x59, 0x2b, 0x7a, 0x8f, 0xeb,
+	0x1d, 0xda, 0x88, 0x67, 0x81, 0xfd, 0x42, 0x8e, 0x9f, 0xb8, 0x30, 0x1a, 0x84, 0x56, 0xd8, 0x0d,
+	0xf8, 0x46, 0x9b, 0x7c, 0xf6, 0xd6, 0xc0, 0x94, 0x38, 0xb6, 0xa5, 0x19, 0x49, 0x6b, 0x54, 0xfc,
+	0x46, 0x49, 0xc5, 0xfc, 0x37, 0x06, 0x5c, 0xd4, 0xab, 0xaf, 0xd9, 0x41, 0x48, 0xbe, 0x39, 0x33,
+	0x9c, 0x0b, 0xfd, 0x0d, 0x27, 0x6b, 0xcd, 0x07, 0xf3, 0xa2, 0x24, 0x37, 0xae, 0x4a, 0xb4, 0xa1,
+	0xa4, 0x30, 0x62, 0x87, 0xb4, 0x2d, 0x96, 0x55, 0x49, 0x3e, 0xaa, 0x77, 0x79, 0x69, 0x5a, 0x12,
+	0x1b, 0x59, 0x65, 0x68, 0x51, 0x60, 0x37, 0xbf, 0x15, 0x2e, 0xeb, 0xb5, 0x36, 0x7c, 0x6f, 0xcf,
+	0x6e, 0x52, 0x9f, 0xed, 0x84, 0xf0, 0xa0, 0x93, 0xd9, 0x09, 0x6c, 0x65, 0x21, 0x87, 0x90, 0x77,
+	0xc0, 0xa8, 0x4f, 0x5b, 0xb6, 0xe7, 0xf2, 0xd9, 0x9e, 0x88, 0xc7, 0x0e, 0x79, 0x29, 0x4a, 0xa8,
+	0xf9, 0xdf, 0x2b, 0xc9, 0xb1, 0x63, 0xd3, 0x48, 0xf6, 0x60, 0xbc, 0x23, 0x49, 0xc9, 0xb1, 0xbb,
+	0x33, 0xe8, 0x07, 0xaa, 0xae, 0xc7, 0xa3, 0xaa, 0x4a, 0x30, 0xa2, 0x45, 0x6c, 0x98, 0x51, 0x7f,
+	0xd7, 0x06, 0x60, 0xff, 0x9c, 0x9d, 0x6e, 0x24, 0x10, 0x61, 0x0a, 0x31, 0xd9, 0x84, 0x89, 0x80,
+	0x33, 0x69, 0xc6, 0xb8, 0x86, 0x8a, 0x19, 0x57, 0x5d, 0x55, 0x92, 0x8c, 0x6b, 0x56, 0x76, 0x7f,
+	0x22, 0x02, 0x60, 0x8c, 0x88, 0x1d, 0x32, 0x01, 0xa5, 0x4d, 0xed, 0xb8, 0xe0, 0x87, 0x4c, 0x5d,
+	0x96, 0x61, 0x04, 0x35, 0x3f, 0x3f, 0x0c, 0x24, 0xbb, 0xc4, 0xf5, 0x11, 0x10, 0x25, 0x55, 0x63,
+	0xe0, 0x11, 0x90, 0xbb, 0x25, 0x85, 0x98, 0xbc, 0x09, 0xd3, 0x8e, 0x15, 0x84, 0xf7, 0x3b, 0xd4,
+	0xb7, 0x42, 0xb5, 0x50, 0x26, 0x9f, 0x5d, 0x2c, 0x33, 0xd3, 0x6b, 0x3a, 0xa2, 0xa5, 0xd9, 0xa3,
+	0xc3, 0xf9, 0xe9, 0x44, 0x11, 0x26, 0x49, 0x91, 0xd7, 0x61, 0x82, 0x15, 0xac, 0xf8, 0xbe, 0xe7,
+	0xcb, 0xd1, 0x7f, 0xa1, 0x2c, 0x5d, 0x8e, 0x44, 0x48, 0xb3, 0xd1, 0x4f, 0x8c, 0xd1, 0x93, 0x6f,
+	0x04, 0xe2, 0x6d, 0x07, 0x4c, 0x00, 0x6d, 0xde, 0xa6, 0xae, 0xfa, 0x58, 0x36, 0x3b, 0x43, 0x4b,
+	0x73, 0x72, 0x36, 0xc9, 0xfd, 0x4c, 0x0d, 0xcc, 0x69, 0x45, 0x1e, 0x00, 0x89, 0xc4, 0xed, 0x68,
+	0x01, 0x54, 0x47, 0xfa, 0x5f, 0x3e, 0x57, 0x19, 0xb1, 0xdb, 0x19, 0x14, 0x98, 0x83, 0xd6, 0xfc,
+	0x95, 0x0a, 0x4c, 0x8a, 0x25, 0xb2, 0xe2, 0x86, 0xfe, 0xc1, 0x39, 0x1c, 0x10, 0x34, 0x71, 0x40,
+	0xd4, 0xca, 0xef, 0x79, 0xde, 0xe1, 0xc2, 0xf3, 0xa1, 0x9d, 0x3a, 0x1f, 0x56, 0x06, 0x25, 0xd4,
+	0xfb, 0x78, 0xf8, 0xd7, 0x06, 0x5c, 0xd0, 0x6a, 0x9f, 0xc3, 0xe9, 0xd0, 0x4c, 0x9e, 0x0e, 0x2f,
+	0x0e, 0xf8, 0x7d, 0x05, 0x87, 0xc3, 0xa3, 0xe4, 0x77, 0x71, 0xce, 0xfd, 0x2c, 0xc0, 0x36, 0xe7,
+	0x27, 0xf7, 0x62, 0x41, 0x29, 0x9a, 0xf3, 0xa5, 0x08, 0x82, 0x5a, 0xad, 0x04, 0xd3, 0xaa, 0xf4,
+	0x62, 0x5a, 0xe4, 0x13, 0x06, 0x5c, 0xf0, 0x69, 0x48, 0x5d, 0xb6, 0x19, 0xa4, 0xe0, 0x29, 0xa6,
+	0x70, 0xb5, 0xfc, 0x27, 0x62, 0x12, 0xe1, 0xd2, 0xa5, 0xa3, 0xc3, 0xf9, 0x0b, 0xa9, 0x42, 0x4c,
+	0x93, 0x35, 0xff, 0xc3, 0x10, 0xcc, 0x66, 0x96, 0x40, 0x96, 0xa7, 0x19, 0x5f, 0x26, 0x9e, 0x56,
+	0xf9, 0x72, 0xf0, 0xb4, 0xa1, 0x52, 0x3c, 0xad, 0xef, 0x33, 0x8b, 0xf8, 0x40, 0xda, 0x76, 0x4b,
+	0x34, 0xab, 0x87, 0x96, 0x1f, 0x6e, 0xda, 0x6d, 0x2a, 0xb9, 0xdf, 0x57, 0xf7, 0xb7, 0x7d, 0x58,
+	0x0b, 0xc1, 0x04, 0xd7, 0x33, 0x98, 0x30, 0x07, 0xbb, 0xf9, 0x83, 0x15, 0xb8, 0x92, 0xbb, 0x4e,
+	0xc8, 0x77, 0x19, 0x70, 0x35, 0x5a, 0x15, 0x8b, 0x3b, 0x21, 0xf5, 0x97, 0xa9, 0x43, 0xb5, 0x59,
+	0xef, 0x73, 0x47, 0x2f, 0x77, 0xe5, 0x14, 0x73, 0x29, 0x1e, 0x73, 0x31, 0x62, 0x01, 0x25, 0xa6,
+	0x57, 0x71, 0x68, 0xcb, 0x72, 0xee, 0x78, 0x4e, 0x93, 0x4f, 0xfa, 0xb8, 0x9c, 0x35, 0x55, 0x88,
+	0x31, 0x9c, 0xa9, 0x28, 0x76, 0xba, 0x8e, 0x53, 0x77, 0xad, 0x4e, 0xb0, 0xeb, 0x85, 0xc1, 0xa6,
+	0x77, 0x97, 0xd2, 0x4e, 0x75, 0x28, 0x56, 0x51, 0xdc, 0xca, 0x82, 0x31, 0xaf, 0x8d, 0xf9, 0xdb,
+	0xc3, 0x00, 0xb5, 0x45, 0xf4, 0x42, 0x31, 0x87, 0x2f, 0xc2, 0x48, 0x67, 0xd7, 0x0a, 0xd4, 0x8e,
+	0x7f, 0x46, 0xf1, 0x8b, 0x0d, 0x56, 0xf8, 0xe8, 0x70, 0xbe, 0x5a, 0xf3, 0x69, 0x93, 0xf5, 0xdf,
+	0x72, 0x02, 0xd5, 0x88, 0xc3, 0x50, 0xb4, 0x63, 0x53, 0xcb, 0x56, 0x57, 0xcd, 0x6b, 0x77, 0xc4,
+	0x97, 0xf1, 0xa9, 0xad, 0x94, 0x9b, 0xda, 0xb5, 0x0c, 0x26, 0xcc, 0xc1, 0xae, 0x68, 0xae, 0xba,
+	0x76, 0x68, 0x5b, 0x11, 0xcd, 0xa1, 0xf2, 0x34, 0x93, 0x98, 0x30, 0x07, 0x3b, 0xf9, 0x94, 0x01,
+	0x73, 0xc9, 0xe2, 0x5b, 0xb6, 0x6b, 0x07, 0xbb, 0xb4, 0xb9, 0x69, 0xcb, 0xf5, 0x7f, 0x32, 0xe2,
+	0xd7, 0x8f, 0x0e, 0xe7, 0xe7, 0xd6, 0x0a, 0x31, 0x62, 0x0f, 0x6a, 0xe4, 0xd3, 0x06, 0x3c, 0x9e,
+	0x1a, 0x17, 0xdf, 0x6e, 0xb5, 0xa8, 0x4f, 0x9b, 0x25, 0x77, 0xd6, 0xfc, 0xd1, 0xe1, 0xfc, 0xe3,
+	0x6b, 0xc5, 0x28, 0xb1, 0x17, 0x3d, 0xf3, 0x97, 0x0d, 0x18, 0xaa, 0xe1, 0x2a, 0x79, 0x57, 0xe2,
+	0x9e, 0x7d, 0x4d, 0xbf, 0x67, 0x3f, 0x3a, 0x9c, 0x1f, 0xab, 0xe1, 0xaa, 0x76, 0xe5, 0xfe, 0xb4,
+	0x01, 0xb3, 0x0d, 0xcf, 0x0d, 0x2d, 0xd6, 0x2f, 0x14, 0xc2, 0xa8, 0x3a, 0xf8, 0x4a, 0x5d, 0x31,
+	0x6b, 0x29, 0x64, 0x4b, 0x8f, 0xc9, 0x0e, 0xcc, 0xa6, 0x21, 0x01, 0x66, 0x29, 0xf3, 0x7b, 0x75,
+	0xcd, 0xf1, 0xba, 0xcd, 0x0d, 0xdf, 0xdb, 0xb1, 0x1d, 0xfa, 0xd6, 0xb8, 0x57, 0xeb, 0x3d, 0x3e,
+	0xdb, 0x7b, 0x75, 0x82, 0xd2, 0xf1, 0xf7, 0x6a, 0xbd, 0xfa, 0x5b, 0xe4, 0x5e, 0xad, 0x77, 0xb9,
+	0x40, 0x74, 0xfa, 0x26, 0xb8, 0xa2, 0xd7, 0x8a, 0xe4, 0x73, 0x76, 0xb1, 0x7e, 0x60, 0xbb, 0xcd,
+	0xf4, 0xc5, 0xfa, 0xae, 0xed, 0x36, 0x91, 0x43, 0x22, 0x25, 0x54, 0xa5, 0x48, 0x09, 0x65, 0xfe,
+	0xd0, 0x58, 0x72, 0xd8, 0xb8, 0x60, 0xf6, 0x4e, 0x18, 0x6f, 0x58, 0x4b, 0x5d, 0xb7, 0xe9, 0x44,
+	0xb7, 0x76, 0x36, 0x04, 0xb5, 0x45, 0x51, 0x86, 0x11, 0x94, 0xbc, 0x09, 0x10, 0x2b, 0x70, 0xab,
+	0x95, 0xf2, 0x33, 0x1d, 0xeb, 0x86, 0xeb, 0x34, 0x0c, 0x6d, 0xb7, 0x15, 0xc4, 0xeb, 0x38, 0x86,
+	0xa1, 0x46, 0x8d, 0x7c, 0x1b, 0x4c, 0xcb, 0x19, 0x5c, 0x6d, 0x5b, 0x2d, 0xa9, 0xdf, 0x2a, 0x39,
+	0x0d, 0xeb, 0x1a, 0xa2, 0xa5, 0x2b, 0x92, 0xf0, 0xb4, 0x5e, 0x1a, 0x60, 0x92, 0x1a, 0x39, 0x80,
+	0xa9, 0xb6, 0xae, 0xb3, 0x1b, 0x2e, 0x2f, 0x3e, 0x6b, 0xfa, 0xbb, 0xa5, 0xcb, 0x92, 0xf8, 0x54,
+	0x42, 0xdb, 0x97, 0x20, 0x95, 0xa3, 0x7a, 0x18, 0x39, 0x2b, 0xd5, 0x03, 0x85, 0x31, 0xa1, 0x7c,
+	0x09, 0xaa, 0xa3, 0xfc, 0x03, 0x9f, 0x2f, 0xf3, 0x81, 0x42, 0x8f, 0x13, 0xbf, 0x48, 0x88, 0xdf,
+	0x01, 0x2a, 0xdc, 0x4c, 0xe3, 0xcf, 0x24, 0xb7, 0x3a, 0x75, 0x68, 0x23, 0xf4, 0xfc, 0xea, 0x58,
+	0x79, 0x8d, 0x7f, 0x5d, 0xc3, 0x23, 0x54, 0xb7, 0x7a, 0x09, 0x26, 0xe8, 0x44, 0xba, 0xa9, 0xf1,
+	0x42, 0xdd, 0x54, 0x17, 0x26, 0xf7, 0x34, 0x1d, 0xea, 0x04, 0x1f, 0x84, 0x0f, 0x95, 0xe9, 0x58,
+	0xac, 0x50, 0x5d, 0xba, 0x24, 0x09, 0x4d, 0xea, 0xca, 0x57, 0x9d, 0x8e, 0xf9, 0xcf, 0x2a, 0x40,
+	0xb2, 0xdc, 0x8f, 0xfc, 0xa0, 0x01, 0x24, 0xde, 0x02, 0x2f, 0x51, 0x3f, 0xe0, 0x53, 0x63, 0xdc,
+	0x18, 0x2a, 0xab, 0xf7, 0x92, 0x38, 0xd0, 0x73, 0x1c, 0xaf, 0xab, 0x94, 0x97, 0x91, 0x58, 0x7e,
+	0x37, 0x43, 0x0b, 0x73, 0xe8, 0x33, 0x49, 0x25, 0xb5, 0x17, 0x05, 0x4b, 0x5c, 0x1f, 0x74, 0x2f,
+	0x26, 0xbb, 0xd5, 0xd7, 0xc6, 0x34, 0x7f, 0x7a, 0x12, 0x66, 0x6b, 0x4e, 0x37, 0x08, 0xa9, 0xbf,
+	0x28, 0xdf, 0x73, 0xa9, 0xcf, 0x25, 0x70, 0xfe, 0xe7, 0xb2, 0xf7, 0xd0, 0x5d, 0xa6, 0x8e, 0x75,
+	0xc0, 0x85, 0xe3, 0xc5, 0x66, 0x73, 0x10, 0x09, 0xbc, 0x9e, 0x8b, 0x11, 0x0b, 0x28, 0x91, 0xff,
+	0xdf, 0x80, 0xc7, 0x72, 0x40, 0x5c, 0x42, 0x57, 0x12, 0xec, 0x49, 0xfb, 0xf1, 0xe4, 0xd1, 0xe1,
+	0xfc, 0x63, 0xf5, 0x22, 0xa4, 0x58, 0x4c, 0x8f, 0xfc, 0x35, 0x03, 0xe6, 0x72, 0xa0, 0xb7, 0x2c,
+	0xdb, 0xe9, 0xfa, 0x4a, 0xb8, 0x3d, 0x69, 0x77, 0xb8, 0x8c, 0x59, 0x2f, 0xc4, 0x8a, 0x3d, 0x28,
+	0x92, 0x6f, 0x87, 0x2b, 0x11, 0x74, 0xcb, 0x75, 0x29, 0x6d, 0x26, 0x44, 0xdd, 0x93, 0x76, 0xe5,
+	0xb1, 0xa3, 0xc3, 0xf9, 0x2b, 0xf5, 0x3c, 0x84, 0x98, 0x4f, 0x87, 0xb4, 0xe0, 0xc9, 0x18, 0x10,
+	0xda, 0x8e, 0xfd, 0xa6, 0x90, 0xc6, 0x77, 0x7d, 0x1a, 0xec, 0xb2, 0x5b, 0x13, 0xe3, 0xb3, 0xc6,
+	0xd2, 0xdb, 0x8f, 0x0e, 0xe7, 0x9f, 0xac, 0xf7, 0xaa, 0x88, 0xbd, 0xf1, 0x90, 0x26, 0x4c, 0x05,
+	0x0d, 0xcb, 0x5d, 0x75, 0x43, 0xea, 0xef, 0x59, 0x4e, 0x75, 0xb4, 0xd4, 0x07, 0x0a, 0xee, 0xa6,
+	0xe1, 0xc1, 0x04, 0x56, 0xf2, 0x41, 0x18, 0xa7, 0xfb, 0x1d, 0xcb, 0x6d, 0x52, 0xc1, 0x51, 0x27,
+	0x96, 0x9e, 0x60, 0xe7, 0xf8, 0x8a, 0x2c, 0x7b, 0x74, 0x38, 0x3f, 0xa5, 0xfe, 0x5e, 0xf7, 0x9a,
+	0x14, 0xa3, 0xda, 0xe4, 0xe3, 0x70, 0x99, 0x3f, 0x5d, 0x37, 0x29, 0x3f, 0x1f, 0x02, 0x75, 0xe1,
+	0x19, 0x2f, 0xd5, 0x4f, 0xfe, 0x0c, 0xb9, 0x9e, 0x83, 0x0f, 0x73, 0xa9, 0xb0, 0x69, 0x68, 0x5b,
+	0xfb, 0xb7, 0x7d, 0xab, 0x41, 0x77, 0xba, 0xce, 0x26, 0xf5, 0xdb, 0xb6, 0x2b, 0xae, 0xda, 0xec,
+	0xc9, 0xb2, 0xc9, 0xb8, 0x30, 0xbb, 0x85, 0xf2, 0x69, 0x58, 0xef, 0x55, 0x11, 0x7b, 0xe3, 0x21,
+	0xef, 0x83, 0x29, 0xbb, 0xe5, 0x7a, 0x3e, 0xdd, 0xb4, 0x6c, 0x37, 0x0c, 0xaa, 0xc0, 0x5f, 0xc8,
+	0xf8, 0xb0, 0xae, 0x6a, 0xe5, 0x98, 0xa8, 0x45, 0xf6, 0x80, 0xb8, 0xf4, 0xe1, 0x86, 0xd7, 0xe4,
+	0x4b, 0x60, 0xab, 0xc3, 0x17, 0x72, 0x75, 0xb2, 0xd4, 0xd0, 0xf0, 0xfb, 0xe0, 0xbd, 0x0c, 0x36,
+	0xcc, 0xa1, 0x40, 0x6e, 0x01, 0x69, 0x5b, 0xfb, 0x2b, 0xed, 0x4e, 0x78, 0xb0, 0xd4, 0x75, 0x1e,
+	0x48, 0xae, 0x31, 0xc5, 0xc7, 0x42, 0xa8, 0x29, 0x32, 0x50, 0xcc, 0x69, 0x41, 0x2c, 0x78, 0x5c,
+	0x7c, 0xcf, 0xb2, 0x45, 0xdb, 0x9e, 0x1b, 0xd0, 0x30, 0xd0, 0x16, 0x69, 0x75, 0x9a, 0x6b, 0x06,
+	0xf8, 0xed, 0x6c, 0xb5, 0xb8, 0x1a, 0xf6, 0xc2, 0x91, 0x34, 0xe1, 0x98, 0xe9, 0x6d, 0xc2, 0x61,
+	0xfe, 0xb7, 0x61, 0xa8, 0x66, 0x18, 0xf6, 0xfd, 0x4e, 0xc8, 0x8f, 0x96, 0x63, 0xb7, 0xa4, 0x71,
+	0x4a, 0x5b, 0xb2, 0x03, 0x37, 0xa2, 0x0a, 0xb7, 0x3b, 0xdd, 0x5c, 0x5a, 0x15, 0x4e, 0xeb, 0xe9,
+	0xa3, 0xc3, 0xf9, 0x1b, 0xf5, 0x63, 0xea, 0xe2, 0xb1, 0xd8, 0x8a, 0xd9, 0xdd, 0xd0, 0x39, 0xb1,
+	0xbb, 0x8f, 0xc3, 0x65, 0x0d, 0xe0, 0x53, 0xab, 0x79, 0x30, 0x00, 0xbb, 0xe5, 0xbb, 0xbc, 0x9e,
+	0x83, 0x0f, 0x73, 0xa9, 0x14, 0xf2, 0x98, 0x91, 0xf3, 0xe0, 0x31, 0xe6, 0xe1, 0x10, 0x4c, 0xd4,
+	0x3c, 0xb7, 0x69, 0xf3, 0xf5, 0xfa, 0x9e, 0xc4, 0x1b, 0xe5, 0x93, 0xba, 0x1c, 0xf8, 0xe8, 0x70,
+	0x7e, 0x3a, 0xaa, 0xa8, 0x09, 0x86, 0xcf, 0x45, 0x17, 0x5c, 0x71, 0xbb, 0x7a, 0x7b, 0xf2, 0x62,
+	0xfa, 0xe8, 0x70, 0xfe, 0x42, 0xd4, 0x2c, 0x79, 0x57, 0x65, 0x0c, 0x84, 0xa9, 0x36, 0x36, 0x7d,
+	0xcb, 0x0d, 0xec, 0x01, 0x94, 0x49, 0x91, 0x98, 0xb6, 0x96, 0xc1, 0x86, 0x39, 0x14, 0xc8, 0xeb,
+	0x30, 0xc3, 0x4a, 0xb7, 0x3a, 0x4d, 0x2b, 0xa4, 0x25, 0x75, 0x48, 0x57, 0x25, 0xcd, 0x99, 0xb5,
+	0x04, 0x26, 0x4c, 0x61, 0x16, 0x6f, 0xba, 0x56, 0xe0, 0xb9, 0xd5, 0x91, 0xf4, 0x9b, 0xae, 0x15,
+	0x88, 0x37, 0x5d, 0x2b, 0x10, 0x66, 0x4b, 0x6d, 0x1a, 0x04, 0x56, 0x8b, 0xf2, 0x43, 0x70, 0x22,
+	0xbe, 0x24, 0xac, 0x8b, 0x62, 0x54, 0x70, 0xf2, 0x6e, 0x18, 0x69, 0x78, 0x4d, 0x1a, 0x54, 0xc7,
+	0x38, 0x9b, 0x66, 0x2c, 0x6f, 0xa4, 0xc6, 0x0a, 0x1e, 0x1d, 0xce, 0x4f, 0x70, 0x5d, 0x33, 0xfb,
+	0x85, 0xa2, 0x92, 0xf9, 0xa3, 0x4c, 0x21, 0x90, 0xd2, 0xb8, 0xf4, 0xf1, 0x16, 0x7d, 0x7e, 0xcf,
+	0xba, 0xe6, 0x67, 0x0c, 0x60, 0x66, 0x25, 0xa1, 0xef, 0x39, 0x1b, 0x8e, 0xe5, 0x52, 0xf2, 0xbd,
+	0x06, 0x5c, 0xdc, 0xb5, 0x5b, 0xbb, 0xba, 0x31, 0x49, 0xd5, 0x28, 0xaf, 0xa8, 0xb9, 0x93, 0xc2,
+	0xb5, 0x74, 0xf9, 0xe8, 0x70, 0xfe, 0x62, 0xba, 0x14, 0x33, 0x34, 0xcd, 0x4f, 0x56, 0xe0, 0xb2,
+	0xec, 0x99, 0xc3, 0xc4, 0xc5, 0x8e, 0xe3, 0x1d, 0xb4, 0xa9, 0x7b, 0x1e, 0x76, 0x1f, 0x6a, 0x86,
+	0x2a, 0x85, 0x33, 0xd4, 0xce, 0xcc, 0xd0, 0x50, 0x99, 0x19, 0x8a, 0x16, 0xf2, 0x31, 0xb3, 0xf4,
+	0xc7, 0x06, 0x54, 0xf3, 0xc6, 0xe2, 0x1c, 0x14, 0x4c, 0xed, 0xa4, 0x82, 0xe9, 0x4e, 0x59, 0x0d,
+	0x65, 0xba, 0xeb, 0x05, 0x8a, 0xa6, 0x3f, 0xaa, 0xc0, 0xd5, 0xb8, 0xfa, 0xaa, 0x1b, 0x84, 0x96,
+	0xe3, 0x88, 0xf3, 0xfc, 0xec, 0xe7, 0xbd, 0x93, 0xd0, 0x4b, 0xde, 0x1b, 0xec, 0x53, 0xf5, 0xbe,
+	0x17, 0x6a, 0x28, 0xf7, 0x53, 0x1a, 0xca, 0x8d, 0x53, 0xa4, 0xd9, 0x5b, 0x57, 0xf9, 0x9f, 0x0c,
+	0x98, 0xcb, 0x6f, 0x78, 0x0e, 0x8b, 0xca, 0x4b, 0x2e, 0xaa, 0x6f, 0x3c, 0xbd, 0xaf, 0x2e, 0x58,
+	0x56, 0x3f, 0x5b, 0x29, 0xfa, 0x5a, 0xae, 0x6c, 0xdc, 0x61, 0xcf, 0xb4, 0x2d, 0x3b, 0x08, 0xe5,
+	0xb3, 0xdf, 0xc9, 0x6c, 0xf3, 0x94, 0xc2, 0xff, 0x02, 0x26, 0x71, 0x60, 0x1a, 0x29, 0xb9, 0x07,
+	0x63, 0x4c, 0xf5, 0xc3, 0xf0, 0x57, 0xfa, 0xc7, 0x1f, 0x9d, 0x46, 0x75, 0xd1, 0x16, 0x15, 0x12,
+	0xf2, 0xcd, 0x30, 0xdd, 0x8c, 0x76, 0xd4, 0x31, 0x86, 0x39, 0x69, 0xac, 0xfc, 0x81, 0x76, 0x59,
+	0x6f, 0x8d, 0x49, 0x64, 0xe6, 0xff, 0x32, 0xe0, 0x89, 0x5e, 0x6b, 0x8b, 0xbc, 0x01, 0xd0, 0x50,
+	0xe2, 0x85, 0x52, 0x00, 0xbd, 0x50, 0x72, 0x2e, 0x05, 0x96, 0x78, 0x83, 0x46, 0x45, 0x01, 0x6a,
+	0x44, 0x72, 0xec, 0x7d, 0x2a, 0x67, 0x64, 0xef, 0x63, 0xfe, 0x89, 0xa1, 0xb3, 0x22, 0x7d, 0x6e,
+	0xdf, 0x6a, 0xac, 0x48, 0xef, 0x7b, 0x11, 0x2b, 0x32, 0x7f, 0xa7, 0x02, 0x37, 0xf2, 0x9b, 0x68,
+	0x67, 0xef, 0x87, 0x61, 0xb4, 0x13, 0x9b, 0x31, 0x4c, 0x2c, 0xbd, 0x93, 0x71, 0x16, 0xf1, 0xbe,
+	0xfc, 0xe8, 0x70, 0x7e, 0x2e, 0x8f, 0xd1, 0x0b, 0x28, 0xca, 0x76, 0xc4, 0x4e, 0x69, 0x59, 0x85,
+	0xf4, 0xf7, 0xde, 0x3e, 0x99, 0x8b, 0xb5, 0x4d, 0x9d, 0xbe, 0x15, 0xab, 0xdf, 0x69, 0xc0, 0x4c,
+	0x62, 0x45, 0x07, 0xd5, 0x91, 0x1b, 0x43, 0x65, 0xcd, 0x1b, 0x12, 0x5b, 0x25, 0x3e, 0xb9, 0x13,
+	0xc5, 0x01, 0xa6, 0x08, 0xa6, 0xd8, 0xac, 0x3e, 0xaa, 0x6f, 0x39, 0x36, 0xab, 0x77, 0xbe, 0x80,
+	0xcd, 0xfe, 0x48, 0xa5, 0xe8, 0x6b, 0x39, 0x9b, 0x7d, 0x08, 0x13, 0xca, 0xb3, 0x44, 0xb1, 0x8b,
+	0x5b, 0x83, 0xf6, 0x49, 0xa0, 0x8b, 0xcd, 0x0c, 0x55, 0x49, 0x80, 0x31, 0x2d, 0xf2, 0xff, 0x1a,
+	0x00, 0xf1, 0xc4, 0xc8, 0x4d, 0xb5, 0x79, 0x7a, 0xc3, 0xa1, 0x89, 0x35, 0x33, 0x6c, 0x4b, 0xc7,
+	0xbf, 0x51, 0xa3, 0x6b, 0xfe, 0xcf, 0x21, 0x20, 0xd9, 0xbe, 0xf7, 0xf7, 0x86, 0x76, 0x8c, 0x40,
+	0xfa, 0x02, 0x5c, 0x68, 0x39, 0xde, 0xb6, 0xe5, 0x38, 0x07, 0xd2, 0xd5, 0x42, 0x1a, 0xed, 0x73,
+	0xeb, 0xa0, 0xdb, 0x49, 0x10, 0xa6, 0xeb, 0x92, 0x0e, 0x5c, 0xf4, 0x99, 0x3e, 0xaa, 0x61, 0x3b,
+	0xfc, 0xea, 0xe4, 0x75, 0xc3, 0x92, 0x37, 0x70, 0x2e, 0xde, 0x63, 0x0a, 0x17, 0x66, 0xb0, 0x93,
+	0xaf, 0x82, 0xb1, 0x8e, 0x6f, 0xb7, 0x2d, 0xff, 0x80, 0x5f, 0xce, 0xc6, 0x97, 0x26, 0xd9, 0x09,
+	0xb7, 0x21, 0x8a, 0x50, 0xc1, 0xc8, 0xc7, 0x61, 0xc2, 0xb1, 0x77, 0x68, 0xe3, 0xa0, 0xe1, 0x50,
+	0xa9, 0xa1, 0xbc, 0x7f, 0x3a, 0x4b, 0x66, 0x4d, 0xa1, 0x95, 0x06, 0x28, 0xea, 0x27, 0xc6, 0x04,
+	0x99, 0x01, 0xca, 0x43, 0xcf, 0x7f, 0x40, 0x7d, 0x87, 0x06, 0x41, 0xbd, 0xdb, 0xe9, 0x78, 0x7e,
+	0x48, 0x9b, 0x5c, 0x8f, 0x39, 0x2e, 0x0c, 0x50, 0x5e, 0xce, 0x82, 0x31, 0xaf, 0x8d, 0xf9, 0xa9,
+	0x0a, 0x3c, 0xde, 0xa3, 0x13, 0x04, 0x61, 0x22, 0x1a, 0x23, 0xb9, 0x12, 0xde, 0x27, 0xd6, 0xb3,
+	0x2c, 0x7c, 0x74, 0x38, 0xff, 0x54, 0x0f, 0x04, 0x75, 0xb6, 0x14, 0x69, 0xeb, 0x00, 0x63, 0x34,
+	0x64, 0x15, 0x46, 0x9b, 0xb1, 0x5a, 0x7f, 0x62, 0xe9, 0x3d, 0x8c, 0x5b, 0x0b, 0x05, 0x5c, 0xbf,
+	0xd8, 0x24, 0x02, 0xb2, 0x06, 0x63, 0xc2, 0xd8, 0x88, 0x4a, 0xce, 0xff, 0x2c, 0xbf, 0x1e, 0x8b,
+	0xa2, 0x7e, 0x91, 0x29, 0x14, 0xe6, 0xff, 0x30, 0x60, 0xac, 0xc6, 0x14, 0x77, 0xf7, 0xea, 0xe4,
+	0x80, 0xf9, 0x65, 0x44, 0x2e, 0x6f, 0x92, 0x0b, 0x96, 0x64, 0x0b, 0x1c, 0xe3, 0x62, 0x8c, 0x4d,
+	0xb9, 0x67, 0x44, 0x05, 0xa8, 0xd3, 0x22, 0x6f, 0xb0, 0x31, 0x7f, 0xe8, 0xdb, 0x21, 0x23, 0x3c,
+	0x88, 0x31, 0x82, 0x20, 0x8c, 0x0a, 0x97, 0x58, 0x51, 0xd1, 0x4f, 0x8c, 0xa9, 0x98, 0x1b, 0x40,
+	0x64, 0x6d, 0xad, 0x57, 0xe4, 0x79, 0x18, 0x6e, 0x7b, 0x4d, 0x35, 0xef, 0xef, 0x50, 0xfb, 0x9b,
+	0x29, 0xc4, 0x1f, 0x1d, 0xce, 0x5f, 0xcd, 0xb6, 0x60, 0x10, 0xe4, 0x6d, 0xcc, 0x7b, 0x70, 0x51,
+	0xc2, 0x23, 0x82, 0xcc, 0x6f, 0xa6, 0xe1, 0xb5, 0xdb, 0x9e, 0x5b, 0xef, 0xee, 0xec, 0xd8, 0xfb,
+	0x34, 0xe1, 0x37, 0x53, 0x4b, 0x40, 0x30, 0x55, 0xd3, 0xfc, 0x61, 0x03, 0x86, 0xd8, 0xbc, 0x98,
+	0x30, 0xda, 0xf4, 0xda, 0x96, 0xed, 0xca, 0x5e, 0x71, 0x1f, 0xa1, 0x65, 0x5e, 0x82, 0x12, 0x42,
+	0x3a, 0x30, 0xa1, 0x84, 0xa6, 0x81, 0x6c, 0x37, 0x97, 0xef, 0xd5, 0x23, 0x7b, 0xf7, 0x88, 0x93,
+	0xab, 0x92, 0x00, 0x63, 0x22, 0xa6, 0x05, 0xb3, 0xcb, 0xf7, 0xea, 0xab, 0x6e, 0xc3, 0xe9, 0x36,
+	0xe9, 0xca, 0x3e, 0xff, 0x8f, 0xf1, 0x12, 0x5b, 0x94, 0xc8, 0xef, 0xe4, 0xbc, 0x44, 0x56, 0x42,
+	0x05, 0x63, 0xd5, 0xa8, 0x68, 0x51, 0xad, 0xc4, 0xd5, 0x24, 0x12, 0x54, 0x30, 0xf3, 0x8b, 0x15,
+	0x98, 0xd4, 0x3a, 0x44, 0x1c, 0x18, 0x13, 0x9f, 0xab, 0x6c, 0xcb, 0x57, 0x4a, 0x7e, 0x62, 0xb2,
+	0xd7, 0x82, 0xba, 0x18, 0xd0, 0x00, 0x15, 0x09, 0x9d, 0x2f, 0x56, 0x7a, 0xf0, 0xc5, 0x05, 0x80,
+	0x20, 0xf6, 0xb4, 0x12, 0x5b, 0x92, 0x1f, 0x3d, 0x9a, 0x7f, 0x95, 0x56, 0x83, 0x3c, 0x21, 0x4f,
+	0x10, 0x61, 0xb0, 0x38, 0x9e, 0x3a, 0x3d, 0x76, 0x60, 0xe4, 0x4d, 0xcf, 0xa5, 0x41, 0x75, 0xe4,
+	0x34, 0x3f, 0x70, 0x82, 0xc9, 0x07, 0xcc, 0x11, 0x29, 0x40, 0x81, 0xde, 0xfc, 0x31, 0x03, 0x60,
+	0xd9, 0x0a, 0x2d, 0xf1, 0xe4, 0xdc, 0x87, 0x7f, 0xd2, 0x13, 0x89, 0x83, 0x6f, 0x3c, 0xe3, 0xb3,
+	0x31, 0x1c, 0xd8, 0x6f, 0xaa, 0xcf, 0x8f, 0x04, 0x6a, 0x81, 0xbd, 0x6e, 0xbf, 0x49, 0x91, 0xc3,
+	0xd9, 0x4b, 0x00, 0x75, 0x1b, 0xfe, 0x41, 0x87, 0x31, 0xef, 0xe1, 0xd8, 0xe8, 0x70, 0x45, 0x15,
+	0x62, 0x0c, 0x37, 0xdf, 0x03, 0xc9, 0x5b, 0xd1, 0xf1, 0xbd, 0x34, 0xbf, 0x34, 0x0c, 0x8f, 0xad,
+	0x6c, 0xd6, 0x96, 0x25, 0x3e, 0xdb, 0x73, 0xef, 0xd2, 0x83, 0xbf, 0xb2, 0x35, 0xfc, 0x2b, 0x5b,
+	0xc3, 0x53, 0xb4, 0x35, 0x7c, 0x11, 0x2e, 0xc6, 0xcb, 0x4b, 0x1a, 0xc6, 0xbc, 0x2b, 0x2d, 0x4f,
+	0x4f, 0xa8, 0x93, 0x27, 0x2b, 0x03, 0x9b, 0xff, 0xc5, 0x80, 0xe9, 0x95, 0x20, 0xb4, 0xdb, 0x56,
+	0x48, 0x9b, 0x35, 0x2f, 0x08, 0xc9, 0xbb, 0x61, 0xbc, 0xd1, 0xf5, 0x7d, 0xea, 0x4a, 0x77, 0xc8,
+	0x89, 0xf8, 0x32, 0x51, 0x93, 0xe5, 0x18, 0xd5, 0x20, 0x2f, 0xc1, 0xe8, 0xae, 0xd7, 0xf5, 0x9d,
+	0x83, 0x7e, 0x6c, 0x04, 0x16, 0x14, 0xdd, 0x85, 0x8f, 0x74, 0x2d, 0x37, 0x64, 0x7a, 0xdf, 0x48,
+	0x11, 0x75, 0x87, 0x63, 0x41, 0x89, 0x8d, 0x7c, 0x14, 0xc6, 0xda, 0x9e, 0x1b, 0xee, 0x3a, 0x07,
+	0xd5, 0xa1, 0x52, 0x88, 0x63, 0x65, 0xbd, 0x40, 0x83, 0x0a, 0x1f, 0x33, 0xf8, 0xbf, 0xb8, 0xb2,
+	0xdf, 0xb1, 0x7d, 0xee, 0x4b, 0x28, 0x0c, 0x45, 0x98, 0xb2, 0x7f, 0x4f, 0xfc, 0x29, 0x3f, 0x3a,
+	0x6a, 0x2f, 0x6b, 0xa0, 0x82, 0x93, 0x1d, 0x98, 0xa1, 0xbc, 0x39, 0x97, 0xf1, 0xad, 0xb0, 0xcc,
+	0xa6, 0x13, 0xae, 0xaa, 0x09, 0x2c, 0x98, 0xc2, 0x4a, 0xea, 0x30, 0xd3, 0x70, 0xac, 0x20, 0xb0,
+	0x77, 0xec, 0x46, 0x6c, 0x99, 0x3e, 0xb1, 0xf4, 0x2e, 0x7e, 0x5c, 0x27, 0x20, 0x8f, 0x0e, 0xe7,
+	0xaf, 0xc8, 0x7e, 0x26, 0x01, 0x98, 0x42, 0x61, 0x7e, 0xb6, 0x02, 0xd3, 0x2b, 0xfb, 0x1d, 0x2f,
+	0xe8, 0xfa, 0x94, 0x57, 0x3d, 0x07, 0xad, 0xc5, 0x33, 0x30, 0xb6, 0x6b, 0x31, 0xa3, 0x3c, 0xbf,
+	0x5a, 0x49, 0x8e, 0xed, 0x1d, 0x51, 0x8c, 0x0a, 0x4e, 0x3e, 0x06, 0xc0, 0x9c, 0xf8, 0x9b, 0x5d,
+	0x2e, 0xf5, 0x89, 0x99, 0xbf, 0x5b, 0xe6, 0xdc, 0x49, 0x7c, 0x63, 0x3d, 0x42, 0x29, 0x4f, 0xc3,
+	0xe8, 0x37, 0x6a, 0xe4, 0xcc, 0xdf, 0x35, 0x60, 0x36, 0xd1, 0xee, 0x1c, 0x2e, 0xe3, 0x3b, 0xc9,
+	0xcb, 0xf8, 0xe2, 0xc0, 0xdf, 0x5a, 0x70, 0x07, 0xff, 0x44, 0x05, 0xae, 0x15, 0x8c, 0x49, 0xc6,
+	0xc4, 0xcd, 0x38, 0x27, 0x13, 0xb7, 0x2e, 0x4c, 0x86, 0x9e, 0x23, 0x1d, 0x28, 0xd4, 0x08, 0x94,
+	0x32, 0x60, 0xdb, 0x8c, 0xd0, 0xc4, 0x06, 0x6c, 0x71, 0x59, 0x80, 0x3a, 0x1d, 0x66, 0x9f, 0x3d,
+	0x11, 0xe9, 0xfc, 0xbe, 0xa2, 0xde, 0xdd, 0xfa, 0xf7, 0xae, 0x37, 0x7f, 0xbd, 0x02, 0x57, 0x23,
+	0xdc, 0x8a, 0xb3, 0x33, 0x15, 0x65, 0x3f, 0x8a, 0x83, 0x27, 0x12, 0xc6, 0xb7, 0xe3, 0x29, 0xe9,
+	0x8a, 0xc9, 0x9a, 0x5d, 0xbf, 0xe3, 0x05, 0x4a, 0x84, 0x12, 0xb2, 0xa6, 0x28, 0x42, 0x05, 0x23,
+	0xf7, 0x60, 0x24, 0x60, 0xf4, 0xaa, 0xc3, 0x65, 0x46, 0x83, 0x4b, 0x81, 0xbc, 0xbf, 0x28, 0xd0,
+	0x90, 0x8f, 0xe9, 0xc7, 0xd6, 0x48, 0x79, 0xd5, 0x14, 0xfb, 0x92, 0xa6, 0x1a, 0x91, 0x1c, 0x8f,
+	0xd3, 0xdc, 0x63, 0x70, 0x0d, 0x2e, 0x4a, 0x53, 0x2f, 0xb1, 0x6c, 0x98, 0x11, 0xf3, 0x07, 0x13,
+	0x2b, 0xe3, 0xe9, 0xd4, 0xcb, 0xfb, 0xe5, 0x74, 0xfd, 0x78, 0xc5, 0x98, 0x01, 0x8c, 0xdf, 0x96,
+	0x9d, 0x24, 0x73, 0x50, 0xb1, 0xd5, 0x5c, 0x80, 0xc4, 0x51, 0x59, 0x5d, 0xc6, 0x8a, 0xdd, 0x87,
+	0x11, 0xb4, 0x7e, 0x2c, 0x0d, 0xf5, 0x3e, 0x96, 0xcc, 0x3f, 0xac, 0xc0, 0x65, 0x45, 0x55, 0x7d,
+	0xe3, 0xb2, 0x7c, 0xb7, 0x3c, 0x46, 0x9e, 0x3e, 0x5e, 0x91, 0x74, 0x1f, 0x86, 0x39, 0x03, 0x2c,
+	0xf5, 0x9e, 0x19, 0x21, 0x64, 0xdd, 0x41, 0x8e, 0x88, 0x7c, 0x1c, 0x46, 0x1d, 0xa6, 0xb6, 0x55,
+	0xd6, 0xc9, 0xa5, 0xd4, 0x6e, 0x79, 0x9f, 0x2b, 0xb4, 0xc1, 0x81, 0xf0, 0xf8, 0x8b, 0xa4, 0x0b,
+	0x51, 0x88, 0x92, 0xe6, 0xdc, 0x73, 0x30, 0xa9, 0x55, 0x23, 0x17, 0x61, 0xe8, 0x01, 0x95, 0xd2,
+	0x0e, 0xb2, 0x3f, 0xc9, 0x65, 0x18, 0xd9, 0xb3, 0x9c, 0xae, 0x1c, 0x12, 0x14, 0x3f, 0x9e, 0xaf,
+	0x7c, 0xd0, 0x30, 0x7f, 0xda, 0x80, 0xc9, 0x3b, 0xf6, 0x36, 0xf5, 0x85, 0xbd, 0x16, 0xbf, 0x3e,
+	0x26, 0x82, 0x9b, 0x4c, 0xe6, 0x05, 0x36, 0x21, 0xfb, 0x30, 0x21, 0x4f, 0x9a, 0xc8, 0x04, 0xf5,
+	0x76, 0xb9, 0x87, 0xf3, 0x88, 0xb4, 0xe4, 0xe0, 0xba, 0x33, 0xb5, 0xa2, 0x80, 0x31, 0x31, 0xf3,
+	0x63, 0x70, 0x29, 0xa7, 0x11, 0x99, 0xe7, 0xdb, 0xd7, 0x0f, 0xe5, 0xb2, 0x50, 0xfb, 0xd1, 0x0f,
+	0x51, 0x94, 0x93, 0xc7, 0x60, 0x88, 0xba, 0x4d, 0xb9, 0x26, 0xc6, 0x8e, 0x0e, 0xe7, 0x87, 0x56,
+	0xdc, 0x26, 0xb2, 0x32, 0xc6, 0xa6, 0x1c, 0x2f, 0x21, 0x93, 0x70, 0x36, 0xb5, 0x26, 0xcb, 0x30,
+	0x82, 0x72, 0x53, 0x87, 0xf4, 0xab, 0x3e, 0x93, 0xe8, 0x2f, 0xee, 0xa4, 0x76, 0xcf, 0x20, 0xc6,
+	0x04, 0xe9, 0x9d, 0xb8, 0x54, 0x95, 0x03, 0x92, 0xd9, 0xd3, 0x98, 0xa1, 0x6b, 0xfe, 0xc2, 0x30,
+	0x3c, 0x79, 0xc7, 0xf3, 0xed, 0x37, 0x3d, 0x37, 0xb4, 0x9c, 0x0d, 0xaf, 0x19, 0x1b, 0x7a, 0x49,
+	0xa6, 0xfc, 0x3d, 0x06, 0x5c, 0x6b, 0x74, 0xba, 0xe2, 0x46, 0xa0, 0x6c, 0xa5, 0x36, 0xa8, 0x6f,
+	0x7b, 0x65, 0x0d, 0x74, 0x79, 0xf8, 0x8c, 0xda, 0xc6, 0x56, 0x1e, 0x4a, 0x2c, 0xa2, 0xc5, 0xed,
+	0x84, 0x9b, 0xde, 0x43, 0x97, 0x77, 0xae, 0x1e, 0xf2, 0xd1, 0x7c, 0x33, 0x9e, 0x84, 0x92, 0x76,
+	0xc2, 0xcb, 0xb9, 0x18, 0xb1, 0x80, 0x12, 0xb3, 0x0c, 0xb3, 0x45, 0xe7, 0x90, 0x5a, 0x4d, 0xdb,
+	0xa5, 0x41, 0x20, 0x8c, 0x0c, 0x07, 0x30, 0x84, 0x5d, 0xcd, 0x43, 0x88, 0xf9, 0x74, 0xc8, 0x6b,
+	0x00, 0xc1, 0x81, 0xdb, 0x90, 0xe3, 0x5f, 0xce, 0x22, 0x4b, 0x08, 0x81, 0x11, 0x16, 0xd4, 0x30,
+	0xb2, 0xdb, 0x53, 0x18, 0x2d, 0xca, 0x51, 0x6e, 0x55, 0xc7, 0x6f, 0x4f, 0xf1, 0x1a, 0x8a, 0xe1,
+	0xe6, 0x3f, 0x30, 0x60, 0x4c, 0x86, 0xe8, 0x61, 0x66, 0x45, 0x09, 0xcd, 0x58, 0xc4, 0x7b, 0x52,
+	0xda, 0xb1, 0x03, 0xfe, 0x3c, 0x2a, 0xb5, 0xa2, 0x52, 0x94, 0x28, 0xa5, 0x5a, 0x91, 0x84, 0x63,
+	0x15, 0x6b, 0xe2, 0x99, 0x54, 0x96, 0xa1, 0x46, 0xcc, 0xfc, 0xbc, 0x01, 0xb3, 0x99, 0x56, 0x7d,
+	0xc8, 0x0b, 0xe7, 0x68, 0x79, 0xf4, 0x3b, 0xc3, 0x30, 0xc3, 0xad, 0x84, 0x5d, 0xcb, 0x11, 0x4a,
+	0xab, 0x73, 0xb8, 0xa0, 0xbc, 0x0b, 0x26, 0xec, 0x76, 0xbb, 0x1b, 0x32, 0x56, 0x2d, 0xdf, 0x1d,
+	0xf8, 0x9c, 0xaf, 0xaa, 0x42, 0x8c, 0xe1, 0xc4, 0x95, 0x47, 0xa1, 0x60, 0xe2, 0x6b, 0xe5, 0x66,
+	0x4e, 0xff, 0xc0, 0x05, 0x76, 0x6c, 0x89, 0xf3, 0x2a, 0xef, 0xa4, 0xfc, 0x5e, 0x03, 0x20, 0x08,
+	0x7d, 0xdb, 0x6d, 0xb1, 0x42, 0x79, 0x5c, 0xe2, 0x29, 0x90, 0xad, 0x47, 0x48, 0x05, 0xf1, 0x68,
+	0x8c, 0x62, 0x00, 0x6a, 0x94, 0xc9, 0xa2, 0x94, 0x12, 0x04, 0xc7, 0xff, 0x9a, 0x94, 0x3c, 0xf4,
+	0x64, 0x36, 0x02, 0x9d, 0x0c, 0xdb, 0x10, 0x8b, 0x11, 0x73, 0x1f, 0x80, 0x89, 0x88, 0xde, 0x71,
+	0xa7, 0xee, 0x94, 0x76, 0xea, 0xce, 0xbd, 0x00, 0x17, 0x52, 0xdd, 0x3d, 0xd1, 0xa1, 0xfd, 0x7b,
+	0x06, 0x90, 0xe4, 0xd7, 0x9f, 0xc3, 0xd5, 0xae, 0x95, 0xbc, 0xda, 0x2d, 0x0d, 0x3e, 0x65, 0x05,
+	0x77, 0xbb, 0xdf, 0x9d, 0x01, 0x1e, 0xc1, 0x2c, 0x8a, 0x10, 0x27, 0x0f, 0x2e, 0x76, 0xce, 0xc6,
+	0x2e, 0x31, 0x72, 0xe7, 0x0e, 0x70, 0xce, 0xde, 0x4d, 0xe1, 0x8a, 0xcf, 0xd9, 0x34, 0x04, 0x33,
+	0x74, 0xc9, 0x27, 0x0d, 0xb8, 0x68, 0x25, 0x23, 0x98, 0xa9, 0x91, 0x29, 0x15, 0x21, 0x23, 0x15,
+	0x0d, 0x2d, 0xee, 0x4b, 0x0a, 0x10, 0x60, 0x86, 0x2c, 0x33, 0xae, 0xb7, 0x3a, 0x36, 0x8b, 0xc1,
+	0xc5, 0xae, 0x06, 0x2a, 0xfc, 0x14, 0xbf, 0xae, 0x2e, 0x6e, 0xac, 0x46, 0xe5, 0x98, 0xa8, 0x15,
+	0x85, 0x0a, 0x93, 0x03, 0x39, 0x3c, 0x60, 0xa8, 0x30, 0x39, 0x86, 0x71, 0xa8, 0x30, 0x39, 0x74,
+	0x3a, 0x11, 0xe2, 0x02, 0x78, 0x76, 0xb3, 0x21, 0x49, 0x8a, 0x97, 0xce, 0x52, 0x37, 0xe4, 0xfb,
+	0xab, 0xcb, 0x35, 0x49, 0x91, 0x9f, 0x7e, 0xf1, 0x6f, 0xd4, 0x28, 0x90, 0xcf, 0x18, 0x30, 0x2d,
+	0x79, 0xb7, 0xa4, 0x39, 0xc6, 0xa7, 0xe8, 0xd5, 0xb2, 0xeb, 0x25, 0xb5, 0x26, 0x17, 0x50, 0x47,
+	0x2e, 0xf8, 0x4e, 0xe4, 0x3b, 0x95, 0x80, 0x61, 0xb2, 0x1f, 0xe4, 0x6f, 0x1a, 0x70, 0x39, 0xa0,
+	0xfe, 0x9e, 0xdd, 0xa0, 0x8b, 0x8d, 0x86, 0xd7, 0x75, 0xd5, 0x3c, 0x8c, 0x97, 0x8f, 0xac, 0x54,
+	0xcf, 0xc1, 0x27, 0x8d, 0xc5, 0x73, 0x20, 0x98, 0x4b, 0x9f, 0x89, 0x65, 0x17, 0x1e, 0x5a, 0x61,
+	0x63, 0xb7, 0x66, 0x35, 0x76, 0xf9, 0xfb, 0x82, 0xf0, 0x02, 0x29, 0xb9, 0xae, 0x5f, 0x4e, 0xa2,
+	0x12, 0x2f, 0xf5, 0xa9, 0x42, 0x4c, 0x13, 0x24, 0x1e, 0x8c, 0xfb, 0x32, 0x2c, 0x64, 0x15, 0xca,
+	0x8b, 0x14, 0x99, 0x18, 0x93, 0x42, 0xb0, 0x57, 0xbf, 0x30, 0x22, 0xc2, 0x9c, 0x1f, 0xc4, 0xd5,
+	0x66, 0xd1, 0xf5, 0xdc, 0x83, 0xb6, 0xd7, 0x0d, 0x16, 0xbb, 0xe1, 0x2e, 0x75, 0x43, 0xa5, 0xab,
+	0x9c, 0xe4, 0xc7, 0x28, 0x77, 0x7e, 0x58, 0xe9, 0x55, 0x11, 0x7b, 0xe3, 0x21, 0xaf, 0xc0, 0x38,
+	0xdd, 0xa3, 0x6e, 0xb8, 0xb9, 0xb9, 0x56, 0x9d, 0x3a, 0x09, 0x8f, 0x8e, 0xa4, 0x3d, 0xfe, 0x09,
+	0x2b, 0x12, 0x07, 0x46, 0xd8, 0xc8, 0x03, 0x18, 0x73, 0x44, 0x5c, 0xcf, 0xea, 0x74, 0x79, 0xa6,
+	0x98, 0x8e, 0x11, 0x2a, 0xee, 0x7f, 0xf2, 0x07, 0x2a, 0x0a, 0xcc, 0x87, 0xa3, 0x49, 0x77, 0xac,
+	0xae, 0x13, 0xde, 0xf3, 0x42, 0xe4, 0x9e, 0x06, 0x91, 0x4a, 0x4a, 0xf9, 0x0e, 0xcd, 0xf0, 0xc0,
+	0x23, 0xdc, 0x87, 0x63, 0xf9, 0x98, 0xba, 0x78, 0x2c, 0x36, 0x72, 0x00, 0x4f, 0xc9, 0x3a, 0xdc,
+	0xb5, 0xa1, 0xb1, 0xcb, 0x46, 0x39, 0x4b, 0xf4, 0x02, 0x27, 0xfa, 0x7f, 0x1d, 0x1d, 0xce, 0x3f,
+	0xb5, 0x7c, 0x7c, 0x75, 0xec, 0x07, 0x27, 0xb7, 0x16, 0xa7, 0xa9, 0x67, 0x89, 0xea, 0xc5, 0xf2,
+	0x63, 0x9c, 0x7e, 0xe2, 0x10, 0xe6, 0x24, 0xe9, 0x52, 0xcc, 0xd0, 0x9c, 0xfb, 0x30, 0x90, 0x2c,
+	0xc3, 0x39, 0x4e, 0x72, 0x18, 0xd7, 0x25, 0x87, 0xcf, 0x8d, 0xc0, 0xe3, 0x8c, 0x8f, 0xc5, 0xf2,
+	0xf2, 0xba, 0xe5, 0x5a, 0xad, 0xaf, 0xcc, 0x33, 0xf6, 0xa7, 0x0d, 0xb8, 0xb6, 0x9b, 0x7f, 0x97,
+	0x95, 0x12, 0xfb, 0x47, 0x4a, 0xe9, 0x1c, 0x7a, 0x5d, 0x8f, 0xc5, 0x16, 0xef, 0x59, 0x05, 0x8b,
+	0x3a, 0x45, 0x3e, 0x0c, 0x17, 0x5d, 0xaf, 0x49, 0x6b, 0xab, 0xcb, 0xb8, 0x6e, 0x05, 0x0f, 0xea,
+	0xea, 0xd9, 0x76, 0x44, 0xcc, 0xf0, 0xbd, 0x14, 0x0c, 0x33, 0xb5, 0x99, 0xc3, 0x4a, 0xc7, 0x6b,
+	0xae, 0xec, 0xd9, 0x0d, 0xf5, 0x60, 0x58, 0xde, 0x48, 0x89, 0xbf, 0x4a, 0x6e, 0x64, 0xb0, 0x61,
+	0x0e, 0x05, 0x7e, 0x19, 0x67, 0x9d, 0x59, 0xf7, 0x5c, 0x3b, 0xf4, 0x7c, 0xee, 0xc9, 0x37, 0xd0,
+	0x9d, 0x94, 0x5f, 0xc6, 0xef, 0xe5, 0x62, 0xc4, 0x02, 0x4a, 0xec, 0xf1, 0xee, 0x02, 0x5b, 0x16,
+	0x1b, 0xbe, 0xb7, 0x7f, 0xf0, 0x95, 0xb8, 0x20, 0x9f, 0x91, 0x16, 0x2c, 0x42, 0x89, 0x74, 0x45,
+	0xb3, 0x5e, 0x99, 0xe0, 0x7d, 0x8e, 0x0d, 0x56, 0x74, 0x3d, 0xda, 0x50, 0xb1, 0x1e, 0xcd, 0xfc,
+	0x4c, 0x45, 0xc8, 0xba, 0x4a, 0x8f, 0xf5, 0x15, 0xb9, 0x0f, 0x3f, 0x00, 0xd3, 0xac, 0x6c, 0xdd,
+	0xda, 0xdf, 0x58, 0x7e, 0xc9, 0x73, 0x94, 0x1f, 0x16, 0xb7, 0xad, 0xbe, 0xab, 0x03, 0x30, 0x59,
+	0x8f, 0x3c, 0xcf, 0xcc, 0x3c, 0xb8, 0x57, 0xbd, 0xbc, 0x65, 0xdd, 0x10, 0x66, 0x1e, 0xbc, 0xe8,
+	0xd1, 0xe1, 0xfc, 0x6c, 0xfc, 0x6a, 0x23, 0x0b, 0x51, 0x35, 0x30, 0xff, 0xe2, 0x12, 0x70, 0xe4,
+	0x0e, 0x0d, 0xbf, 0x12, 0xc7, 0xe4, 0x3d, 0x30, 0xd9, 0xe8, 0x74, 0x6b, 0xb7, 0xea, 0x1f, 0xe9,
+	0x7a, 0xfc, 0xf6, 0xcc, 0x03, 0x41, 0x33, 0xe1, 0xb7, 0xb6, 0xb1, 0xa5, 0x8a, 0x51, 0xaf, 0xc3,
+	0xb8, 0x43, 0xa3, 0xd3, 0x95, 0xfc, 0x76, 0x43, 0x37, 0x30, 0xe6, 0xdc, 0xa1, 0xb6, 0xb1, 0x95,
+	0x80, 0x61, 0xa6, 0x36, 0xf9, 0x76, 0x98, 0xa2, 0x72, 0xe3, 0xde, 0x61, 0xb1, 0xa3, 0x87, 0xcb,
+	0x47, 0x59, 0x4b, 0x0c, 0xad, 0xe2, 0x06, 0xe2, 0xce, 0xb0, 0xa2, 0x91, 0xc0, 0x04, 0x41, 0xf2,
+	0x4d, 0xf0, 0x98, 0xfa, 0xcd, 0x66, 0xd9, 0x6b, 0xa6, 0x19, 0xc5, 0x88, 0xf0, 0x92, 0x5f, 0x29,
+	0xaa, 0x84, 0xc5, 0xed, 0xc9, 0x4f, 0x19, 0x70, 0x35, 0x82, 0xda, 0xae, 0xdd, 0xee, 0xb6, 0x91,
+	0x36, 0x1c, 0xcb, 0x6e, 0xcb, 0x9b, 0xc2, 0xcb, 0xa7, 0xf6, 0xa1, 0x49, 0xf4, 0x82, 0x59, 0xe5,
+	0xc3, 0xb0, 0xa0, 0x4b, 0xe4, 0xf3, 0x06, 0xdc, 0x50, 0xa0, 0x0d, 0x9f, 0x06, 0xec, 0x25, 0x32,
+	0xf6, 0x02, 0x94, 0x43, 0x32, 0x56, 0x8a, 0x77, 0x72, 0x91, 0x69, 0xe5, 0x18, 0xdc, 0x78, 0x2c,
+	0x75, 0x7d, 0xb9, 0xd4, 0xbd, 0x9d, 0xb0, 0x3a, 0x7e, 0xa6, 0xcb, 0x85, 0x91, 0xc0, 0x04, 0x41,
+	0xf2, 0x0f, 0x0d, 0xb8, 0xa6, 0x17, 0xe8, 0xab, 0x45, 0xdc, 0x29, 0x5e, 0x39, 0xb5, 0xce, 0xa4,
+	0xf0, 0x0b, 0xa5, 0x74, 0x01, 0x10, 0x8b, 0x7a, 0xc5, 0xd8, 0x76, 0x9b, 0x2f, 0x4c, 0x71, 0xef,
+	0x18, 0x11, 0x6c, 0x5b, 0xac, 0xd5, 0x00, 0x15, 0x8c, 0xdd, 0xb8, 0x3b, 0x5e, 0x73, 0xc3, 0x6e,
+	0x06, 0x6b, 0x76, 0xdb, 0x0e, 0xf9, 0xed, 0x60, 0x48, 0x0c, 0xc7, 0x86, 0xd7, 0xdc, 0x58, 0x5d,
+	0x16, 0xe5, 0x98, 0xa8, 0xc5, 0xcc, 0xd9, 0x98, 0xbe, 0xbe, 0xfe, 0xd0, 0xea, 0xdc, 0x57, 0xde,
+	0xdf, 0xfc, 0xf6, 0x7a, 0x2b, 0x2a, 0x45, 0xad, 0x06, 0x9b, 0x3f, 0xc6, 0x77, 0x90, 0x8a, 0xe8,
+	0x7c, 0xd5, 0x99, 0x53, 0x9a, 0x3f, 0x85, 0x50, 0x74, 0xf8, 0xae, 0x46, 0x02, 0x13, 0x04, 0xd9,
+	0x53, 0xc1, 0x4c, 0x70, 0x10, 0x84, 0xb4, 0x1d, 0xf5, 0xe1, 0xc2, 0x69, 0xf7, 0x81, 0x6b, 0x51,
+	0xeb, 0x09, 0x22, 0x98, 0x22, 0xca, 0xfd, 0xe8, 0xdb, 0x56, 0x8b, 0xde, 0xae, 0xb1, 0xc7, 0x97,
+	0xc8, 0xaf, 0x7b, 0x83, 0xfa, 0x0d, 0x66, 0xe9, 0x7e, 0x91, 0xcf, 0x94, 0xf0, 0xa3, 0x2f, 0xae,
+	0x86, 0xbd, 0x70, 0x90, 0xd7, 0x60, 0x4e, 0x82, 0xd7, 0xbc, 0x87, 0x19, 0x0a, 0xb3, 0x9c, 0x02,
+	0xb7, 0xb4, 0x5a, 0x2d, 0xac, 0x85, 0x3d, 0x30, 0x30, 0x23, 0xeb, 0x80, 0xfa, 0xfc, 0x11, 0x44,
+	0x84, 0x4f, 0xd9, 0xe8, 0x3a, 0x4e, 0x50, 0x25, 0xb1, 0x91, 0x75, 0x3d, 0x0b, 0xc6, 0xbc, 0x36,
+	0xcc, 0x0a, 0x5e, 0xba, 0x5c, 0x1d, 0xb0, 0x82, 0x8f, 0x6c, 0xd4, 0xab, 0x97, 0x78, 0xff, 0x2e,
+	0x69, 0xee, 0x59, 0x0a, 0x84, 0xe9, 0xba, 0xec, 0x34, 0x57, 0x45, 0x4b, 0x5d, 0x3f, 0x08, 0xab,
+	0x97, 0x79, 0x63, 0x7e, 0x9a, 0xa3, 0x0e, 0xc0, 0x64, 0x3d, 0x66, 0x6f, 0x1b, 0xd0, 0x46, 0xc3,
+	0x6b, 0x77, 0xe4, 0xcd, 0xaa, 0x7a, 0x85, 0xf7, 0x5e, 0xcc, 0x60, 0x02, 0x82, 0xa9, 0x9a, 0xe4,
+	0x00, 0x2e, 0x45, 0x41, 0xd9, 0xd6, 0xbc, 0xd6, 0xba, 0xb5, 0xcf, 0x85, 0xe3, 0xab, 0xa5, 0x6c,
+	0xa1, 0xf8, 0x70, 0xd5, 0xb2, 0xe8, 0x30, 0x8f, 0x06, 0x0b, 0xdc, 0x9f, 0x2a, 0xbe, 0x65, 0xb3,
+	0x57, 0xcb, 0x6b, 0xfc, 0xb3, 0xb9, 0x7a, 0xa4, 0x96, 0x03, 0xc7, 0xdc, 0x56, 0xe4, 0x3e, 0x5c,
+	0xe9, 0xf8, 0x5e, 0x48, 0x1b, 0xe1, 0x5d, 0xea, 0xbb, 0xd4, 0x91, 0x1f, 0x18, 0x54, 0xab, 0x7c,
+	0x2c, 0xf8, 0x03, 0xd0, 0x46, 0x5e, 0x05, 0xcc, 0x6f, 0x47, 0x3e, 0x67, 0xc0, 0xf5, 0x20, 0xf4,
+	0xa9, 0xd5, 0xb6, 0xdd, 0x56, 0xcd, 0x73, 0x5d, 0xca, 0x19, 0xd3, 0x6a, 0x33, 0xf6, 0x51, 0x78,
+	0xac, 0xd4, 0x29, 0x62, 0x1e, 0x1d, 0xce, 0x5f, 0xaf, 0xf7, 0xc4, 0x8c, 0xc7, 0x50, 0x66, 0xf6,
+	0x4b, 0x6d, 0xda, 0xf6, 0xfc, 0x03, 0xc6, 0x91, 0xaa, 0x73, 0xe5, 0xed, 0x97, 0xd6, 0x23, 0x2c,
+	0x62, 0xfb, 0x27, 0x9e, 0xae, 0x62, 0x20, 0x6a, 0xe4, 0xcc, 0xc3, 0x0a, 0x5c, 0xc9, 0x65, 0xf5,
+	0x6c, 0x07, 0x88, 0x7a, 0x8b, 0x2a, 0x86, 0xbe, 0x7c, 0xed, 0xe1, 0x3b, 0x60, 0x3d, 0x09, 0xc2,
+	0x74, 0x5d, 0x26, 0x88, 0xf1, 0x9d, 0x7a, 0xab, 0x1e, 0xb7, 0xaf, 0xc4, 0x82, 0xd8, 0x6a, 0x0a,
+	0x86, 0x99, 0xda, 0xa4, 0x06, 0xb3, 0xb2, 0x6c, 0x95, 0xdd, 0x65, 0x82, 0x5b, 0x3e, 0x55, 0x22,
+	0x2e, 0xbb, 0x15, 0xcc, 0xae, 0xa6, 0x81, 0x98, 0xad, 0xcf, 0xbe, 0x82, 0xfd, 0xd0, 0x7b, 0x31,
+	0x1c, 0x7f, 0xc5, 0xbd, 0x24, 0x08, 0xd3, 0x75, 0xd5, 0x65, 0x33, 0xd1, 0x85, 0x91, 0xf8, 0x2b,
+	0xee, 0xa5, 0x60, 0x98, 0xa9, 0x6d, 0xfe, 0xdb, 0x61, 0x78, 0xaa, 0x0f, 0xf1, 0x88, 0xb4, 0xf3,
+	0x87, 0xfb, 0xe4, 0x1b, 0xb7, 0xbf, 0xe9, 0xe9, 0x14, 0x4c, 0xcf, 0xc9, 0xe9, 0xf5, 0x3b, 0x9d,
+	0x41, 0xd1, 0x74, 0x9e, 0x9c, 0x64, 0xff, 0xd3, 0xdf, 0xce, 0x9f, 0xfe, 0x92, 0xa3, 0x7a, 0xec,
+	0x72, 0xe9, 0x14, 0x2c, 0x97, 0x92, 0xa3, 0xda, 0xc7, 0xf2, 0xfa, 0xfd, 0x61, 0x78, 0xba, 0x1f,
+	0x51, 0xad, 0xe4, 0xfa, 0xca, 0x61, 0x79, 0x67, 0xba, 0xbe, 0x8a, 0xdc, 0xc0, 0xce, 0x70, 0x7d,
+	0xe5, 0x90, 0x3c, 0xeb, 0xf5, 0x55, 0x34, 0xaa, 0x67, 0xb5, 0xbe, 0x8a, 0x46, 0xb5, 0x8f, 0xf5,
+	0xf5, 0xa7, 0xe9, 0xf3, 0x21, 0x92, 0x17, 0x57, 0x61, 0xa8, 0xd1, 0xe9, 0x96, 0x64, 0x52, 0xdc,
+	0x36, 0xa8, 0xb6, 0xb1, 0x85, 0x0c, 0x07, 0x41, 0x18, 0x15, 0xeb, 0xa7, 0x24, 0x0b, 0xe2, 0x0e,
+	0x45, 0x62, 0x49, 0xa2, 0xc4, 0xc4, 0x86, 0x8a, 0x76, 0x76, 0x69, 0x9b, 0xfa, 0x96, 0x53, 0x0f,
+	0x3d, 0xdf, 0x6a, 0x95, 0xe5, 0x36, 0x42, 0x71, 0x9c, 0xc2, 0x85, 0x19, 0xec, 0x6c, 0x40, 0x3a,
+	0x76, 0xb3, 0x3a, 0x5c, 0x7e, 0x40, 0x36, 0x56, 0x97, 0x91, 0xe1, 0x30, 0x7f, 0x6d, 0x1c, 0xb4,
+	0x38, 0xa1, 0x4c, 0x29, 0x33, 0xdb, 0x48, 0x87, 0x94, 0x1a, 0xc4, 0x0c, 0x24, 0x13, 0x9f, 0x4a,
+	0x2c, 0xf9, 0x4c, 0x31, 0x66, 0xc9, 0x92, 0xef, 0x30, 0x84, 0xa6, 0x2a, 0x7a, 0xc4, 0x90, 0xc3,
+	0x7a, 0xfb, 0x94, 0x9e, 0xfb, 0x62, 0x95, 0x57, 0x04, 0xc0, 0x24, 0x41, 0xa6, 0x16, 0xb8, 0xf2,
+	0x20, 0x4f, 0xc1, 0x5e, 0x1d, 0x2e, 0xef, 0xd7, 0xd9, 0x43, 0x63, 0x2f, 0x24, 0xce, 0xdc, 0x0a,
+	0x98, 0xdf, 0x91, 0x68, 0x94, 0x22, 0x9d, 0x63, 0x75, 0x64, 0xb0, 0x51, 0x4a, 0x29, 0x2f, 0xe3,
+	0x51, 0x8a, 0x00, 0x98, 0x24, 0xc8, 0x5c, 0xea, 0x1e, 0x28, 0x45, 0x6f, 0x75, 0xb4, 0xfc, 0xeb,
+	0x62, 0x4a, 0x5b, 0x2c, 0xcc, 0x5c, 0xa2, 0x42, 0x8c, 0x89, 0x90, 0x5d, 0x18, 0x7b, 0x20, 0x78,
+	0x85, 0x54, 0xca, 0x2c, 0x0e, 0x7c, 0x85, 0x15, 0xba, 0x01, 0x59, 0x84, 0x0a, 0xbd, 0x6e, 0xe3,
+	0x3a, 0x7e, 0x8c, 0xeb, 0xc5, 0xe7, 0x0c, 0xb8, 0xb2, 0x47, 0xfd, 0xd0, 0x6e, 0xa4, 0x9f, 0x37,
+	0x26, 0xca, 0x5f, 0xb3, 0x5f, 0xca, 0x43, 0x28, 0x96, 0x49, 0x2e, 0x08, 0xf3, 0xbb, 0xc0, 0x2e,
+	0xdd, 0x42, 0x4b, 0x5d, 0x0f, 0xad, 0xd0, 0x6e, 0x6c, 0x7a, 0x0f, 0xa8, 0x1b, 0x67, 0x38, 0xab,
+	0x42, 0x1c, 0xbc, 0x6e, 0xa5, 0xb8, 0x1a, 0xf6, 0xc2, 0x61, 0xfe, 0x91, 0x01, 0x19, 0x5d, 0x2b,
+	0xf9, 0x7e, 0x03, 0xa6, 0x76, 0xa8, 0x15, 0x76, 0x7d, 0x7a, 0xdb, 0x0a, 0x23, 0x1f, 0xfa, 0x97,
+	0x4e, 0x43, 0xc5, 0xbb, 0x70, 0x4b, 0x43, 0x2c, 0x9e, 0xeb, 0xa3, 0x30, 0xc0, 0x3a, 0x08, 0x13,
+	0x3d, 0x98, 0x7b, 0x11, 0x66, 0x33, 0x0d, 0x4f, 0xf4, 0xec, 0xf6, 0xcf, 0x0d, 0xc8, 0x4b, 0xca,
+	0x47, 0x5e, 0x83, 0x11, 0x8b, 0xa5, 0x07, 0x94, 0x0c, 0xf3, 0xb9, 0x72, 0x96, 0x23, 0x4d, 0x3d,
+	0x54, 0x01, 0xff, 0x89, 0x02, 0x2d, 0x0b, 0x64, 0x68, 0x25, 0xde, 0x9f, 0xd7, 0x63, 0x07, 0x5c,
+	0xfe, 0x3c, 0xb4, 0x98, 0x81, 0x62, 0x4e, 0x0b, 0xf3, 0x13, 0x06, 0x90, 0x6c, 0xe0, 0x68, 0xe2,
+	0xc3, 0xf8, 0x5e, 0x32, 0x32, 0xee, 0x72, 0x49, 0x87, 0x8f, 0x84, 0xf7, 0x52, 0x6c, 0x86, 0x14,
+	0xc5, 0xc2, 0x8d, 0xe8, 0xb0, 0x78, 0x2d, 0x71, 0xf6, 0x0b, 0xf2, 0x7e, 0x98, 0x6c, 0xd2, 0xa0,
+	0xe1, 0xdb, 0x9d, 0x30, 0xf6, 0x75, 0x8a, 0x7c, 0x26, 0x96, 0x63, 0x10, 0xea, 0xf5, 0x98, 0xdb,
+	0x6f, 0x68, 0x05, 0x0f, 0x56, 0x97, 0xe5, 0xbd, 0x8f, 0x9f, 0xd2, 0x9b, 0xbc, 0x04, 0x25, 0x24,
+	0x0e, 0x82, 0x36, 0xd4, 0x47, 0x10, 0x34, 0xe6, 0x45, 0x35, 0x70, 0xc4, 0x37, 0x72, 0x7c, 0xb4,
+	0x37, 0xf3, 0x27, 0x2a, 0x70, 0x81, 0x55, 0x59, 0xb7, 0x6c, 0x37, 0xa4, 0x2e, 0xb7, 0xec, 0x2f,
+	0x39, 0x08, 0x2d, 0x98, 0x0e, 0x13, 0xde, 0x7e, 0x27, 0xf7, 0xfb, 0x8a, 0x6c, 0x5d, 0x92, 0x3e,
+	0x7e, 0x49, 0xbc, 0xe4, 0x39, 0xe5, 0x5a, 0x21, 0x6e, 0xc8, 0x4f, 0xa9, 0xa5, 0xca, 0xfd, 0x25,
+	0x1e, 0x49, 0xd7, 0xc9, 0x28, 0x65, 0x4a, 0xc2, 0x8b, 0xe2, 0x03, 0x30, 0x2d, 0x4d, 0x9c, 0x45,
+	0x34, 0x3b, 0x79, 0x43, 0xe6, 0x27, 0xcc, 0x2d, 0x1d, 0x80, 0xc9, 0x7a, 0xe6, 0x6f, 0x57, 0x20,
+	0x99, 0x98, 0xa5, 0xec, 0x28, 0x65, 0x43, 0xf9, 0x55, 0xce, 0x2c, 0x94, 0xdf, 0xbb, 0x79, 0x86,
+	0x35, 0x91, 0x8a, 0x53, 0xbc, 0x1b, 0xeb, 0x79, 0xd1, 0x78, 0x39, 0x46, 0x35, 0xe2, 0x61, 0x1d,
+	0x3e, 0xf1, 0xb0, 0xbe, 0x5f, 0xda, 0x3e, 0x8e, 0x24, 0x02, 0x2a, 0x2a, 0xdb, 0xc7, 0xd9, 0x44,
+	0x43, 0xcd, 0x11, 0xe4, 0xd7, 0x0c, 0x18, 0x93, 0x11, 0xa1, 0xfb, 0x70, 0x34, 0x62, 0xbe, 0x60,
+	0xec, 0x56, 0x32, 0x88, 0x34, 0x58, 0xdf, 0xf5, 0xbc, 0x30, 0x11, 0x33, 0x9e, 0x5b, 0xf6, 0xf3,
+	0x3f, 0x51, 0xa0, 0xe7, 0xe6, 0x6f, 0x7e, 0x63, 0xd7, 0x0e, 0x69, 0x23, 0x54, 0xe1, 0x94, 0x95,
+	0xf9, 0x9b, 0x56, 0x8e, 0x89, 0x5a, 0xe6, 0x0f, 0x0f, 0xc3, 0x0d, 0x89, 0x38, 0x23, 0x22, 0x45,
+	0x0c, 0xee, 0x80, 0xa5, 0x8f, 0xe5, 0x75, 0x96, 0x7d, 0xcb, 0x8e, 0xde, 0xe3, 0xcb, 0xdd, 0x4e,
+	0x65, 0xba, 0xd9, 0x0c, 0x3a, 0xcc, 0xa3, 0x21, 0x82, 0x76, 0xf2, 0xe2, 0x3b, 0xd4, 0x72, 0xc2,
+	0x5d, 0x45, 0xbb, 0x32, 0x48, 0xd0, 0xce, 0x2c, 0x3e, 0xcc, 0xa5, 0xc2, 0xed, 0x01, 0x24, 0xa0,
+	0xe6, 0x53, 0x4b, 0x37, 0x46, 0x18, 0xc0, 0x38, 0x7f, 0x3d, 0x17, 0x23, 0x16, 0x50, 0xe2, 0x6a,
+	0x3e, 0x6b, 0x9f, 0x6b, 0x0d, 0x90, 0x86, 0xbe, 0xcd, 0x63, 0xff, 0x47, 0x8a, 0xee, 0xf5, 0x24,
+	0x08, 0xd3, 0x75, 0x99, 0xbe, 0x9a, 0xdb, 0x57, 0xc4, 0xc1, 0xbb, 0x46, 0xe2, 0xf8, 0x10, 0xf7,
+	0x12, 0x10, 0x4c, 0xd5, 0x34, 0xbf, 0xb3, 0x02, 0x53, 0xfa, 0xb2, 0xeb, 0xc3, 0xeb, 0xa8, 0xab,
+	0x1d, 0x86, 0x03, 0x78, 0xc4, 0xe8, 0x54, 0xfb, 0x38, 0x0f, 0xc9, 0x2b, 0x30, 0xd3, 0xe5, 0x1c,
+	0x44, 0x05, 0x20, 0x91, 0xeb, 0xff, 0x6b, 0xd9, 0x57, 0x6e, 0x25, 0x20, 0x2c, 0x78, 0x95, 0x8e,
+	0x3e, 0x09, 0xc5, 0x14, 0x1e, 0xf3, 0xe7, 0x0c, 0x78, 0xac, 0x30, 0x44, 0x7c, 0x1f, 0x03, 0xb2,
+	0x97, 0x19, 0x90, 0xd3, 0x8b, 0x9b, 0xdf, 0x4b, 0x42, 0xf8, 0xf4, 0x10, 0x5c, 0xca, 0x19, 0x45,
+	0x6e, 0x3f, 0x40, 0x53, 0xa2, 0xc6, 0x20, 0xf6, 0x03, 0x19, 0xb1, 0x25, 0xb2, 0x1f, 0x48, 0x43,
+	0x30, 0x43, 0x97, 0xbc, 0x04, 0x43, 0x0d, 0xdf, 0x96, 0xe3, 0xf2, 0x81, 0x52, 0x17, 0x65, 0x5c,
+	0x5d, 0x9a, 0x94, 0x14, 0x59, 0x82, 0x1e, 0x64, 0x08, 0xd9, 0x81, 0xa9, 0xb3, 0x39, 0x25, 0xbd,
+	0xf0, 0x03, 0x53, 0xe7, 0x86, 0x01, 0x26, 0xeb, 0x91, 0x57, 0xa0, 0x2a, 0x6f, 0x30, 0xca, 0xf3,
+	0xda, 0x73, 0x83, 0x90, 0x71, 0xa4, 0xb0, 0x3a, 0x1c, 0x85, 0x34, 0xaf, 0xde, 0x2d, 0xa8, 0x83,
+	0x85, 0xad, 0xcd, 0x7f, 0x39, 0x0c, 0x93, 0x5a, 0x8e, 0x0d, 0xb2, 0x3e, 0x88, 0x76, 0x26, 0xfe,
+	0x62, 0xa5, 0xa1, 0x59, 0x87, 0xa1, 0x56, 0xa7, 0x5b, 0xad, 0x0c, 0x86, 0xee, 0x36, 0x43, 0xd7,
+	0xea, 0x74, 0x59, 0x04, 0x00, 0xa9, 0xf0, 0x19, 0x1a, 0x2c, 0x02, 0x40, 0x4a, 0xe9, 0xa3, 0xf6,
+	0xcb, 0x70, 0xe1, 0x7e, 0x69, 0xc3, 0x58, 0x20, 0xb5, 0x41, 0x23, 0xe5, 0xe3, 0x03, 0x69, 0x23,
+	0x2d, 0xb5, 0x3f, 0xe2, 0x9e, 0x2a, 0x7f, 0xa0, 0xa2, 0xc1, 0x64, 0xe0, 0x2e, 0xf7, 0xbe, 0xe5,
+	0x17, 0xf0, 0x71, 0x21, 0x03, 0x6f, 0xf1, 0x12, 0x94, 0x90, 0xcc, 0xd1, 0x3a, 0xd6, 0xcf, 0xd1,
+	0x4a, 0x5e, 0x85, 0x91, 0x8e, 0x6f, 0x37, 0x54, 0x10, 0xfb, 0x52, 0xb7, 0x9a, 0x0d, 0x86, 0x40,
+	0x1c, 0xf6, 0xfc, 0x4f, 0x14, 0x28, 0xcd, 0xff, 0xaf, 0x02, 0x24, 0xfb, 0x89, 0xe4, 0x29, 0x18,
+	0xe1, 0x91, 0x01, 0x24, 0x3b, 0x8a, 0x6e, 0x43, 0xdc, 0x37, 0x1c, 0x05, 0x8c, 0xd4, 0x65, 0x24,
+	0x95, 0x72, 0x4b, 0x85, 0x1b, 0xf7, 0x48, 0x7a, 0x5a, 0xd8, 0x95, 0x1b, 0x09, 0x37, 0x92, 0x3c,
+	0x39, 0x68, 0x8b, 0x45, 0x95, 0x72, 0x59, 0x93, 0x92, 0x0a, 0x38, 0x61, 0x83, 0x20, 0x50, 0xa0,
+	0xc2, 0x65, 0xfe, 0x7e, 0x05, 0x26, 0xf5, 0x5b, 0xc0, 0x01, 0x80, 0xd5, 0x0d, 0x3d, 0xc1, 0xd4,
+	0xab, 0x46, 0x79, 0x05, 0x82, 0x86, 0x74, 0x31, 0x42, 0x28, 0x5e, 0xea, 0xe2, 0xdf, 0xa8, 0x11,
+	0x63, 0xa4, 0x43, 0xbb, 0x4d, 0x5f, 0xb6, 0xdd, 0xa6, 0xf7, 0xb0, 0x5a, 0x39, 0x15, 0xd2, 0x9b,
+	0x11, 0x42, 0x41, 0x3a, 0xfe, 0x8d, 0x1a, 0x31, 0xc6, 0xb6, 0xb8, 0x32, 0xc1, 0xe5, 0x09, 0x95,
+	0x64, 0xdf, 0xc4, 0x51, 0x21, 0x0d, 0xef, 0x38, 0xdb, 0xaa, 0x15, 0xd4, 0xc1, 0xc2, 0xd6, 0xe6,
+	0x4f, 0x19, 0x70, 0x25, 0x77, 0x28, 0xc8, 0x6d, 0x98, 0xcd, 0xa4, 0x66, 0x91, 0xde, 0xb2, 0x51,
+	0x56, 0xb2, 0x4c, 0x3e, 0x17, 0xcc, 0xb6, 0x61, 0x46, 0x01, 0xed, 0xec, 0x41, 0x25, 0x8d, 0xc9,
+	0x74, 0x71, 0x51, 0x07, 0x63, 0x5e, 0x1b, 0xf3, 0x7f, 0x57, 0xe0, 0x9a, 0xd6, 0x5b, 0xa6, 0x20,
+	0x7f, 0x53, 0xbd, 0xaf, 0x1c, 0x7f, 0x54, 0xdf, 0x85, 0x91, 0x6d, 0xda, 0xb2, 0xdd, 0x12, 0x77,
+	0x1b, 0xbe, 0x45, 0x97, 0x58, 0x63, 0x14, 0x38, 0xc8, 0x8a, 0xf0, 0xb4, 0x3d, 0x79, 0x18, 0x9d,
+	0x8c, 0x57, 0xae, 0xf2, 0xfa, 0x4d, 0x64, 0xa0, 0x94, 0x65, 0x18, 0x41, 0x99, 0x4d, 0x7d, 0x53,
+	0xca, 0x99, 0x25, 0x9f, 0x1c, 0x38, 0x66, 0xf5, 0x0b, 0x23, 0x6c, 0x09, 0xcf, 0xe0, 0xd1, 0x9e,
+	0x9e, 0xc1, 0xdf, 0x94, 0x58, 0x2c, 0xf1, 0x62, 0x65, 0x9c, 0x49, 0x0c, 0x6d, 0x8a, 0x33, 0x25,
+	0x86, 0xec, 0x49, 0xdd, 0x39, 0x39, 0x3a, 0x93, 0xd4, 0x50, 0x98, 0xdf, 0x02, 0xd7, 0x0a, 0x1e,
+	0xd0, 0xc9, 0x32, 0x4c, 0x05, 0x0f, 0xad, 0xce, 0x12, 0xdd, 0xb5, 0xf6, 0x6c, 0x19, 0xec, 0x42,
+	0xd8, 0x59, 0x4e, 0xd5, 0xb5, 0xf2, 0x47, 0xa9, 0xdf, 0x98, 0x68, 0x65, 0x86, 0x00, 0xd2, 0x1e,
+	0x97, 0x19, 0xf7, 0xef, 0xc0, 0xb8, 0xe5, 0x50, 0x3f, 0x8c, 0x23, 0xd5, 0x7d, 0x43, 0x29, 0xc5,
+	0x94, 0xc4, 0x21, 0xc6, 0x4c, 0xfd, 0xc2, 0x08, 0xb7, 0xf9, 0xf7, 0x0d, 0xb8, 0x9a, 0x1f, 0xde,
+	0xa0, 0x8f, 0x25, 0xdb, 0x86, 0x49, 0x3f, 0x6e, 0x26, 0x17, 0xee, 0xd7, 0x69, 0xf3, 0xbe, 0xa0,
+	0x05, 0xc1, 0x63, 0x93, 0x5d, 0xf3, 0xbd, 0x40, 0xed, 0xbc, 0x74, 0x98, 0xe0, 0x48, 0x0d, 0xa0,
+	0xf5, 0x04, 0x75, 0xfc, 0x3c, 0x64, 0x37, 0xa3, 0x1e, 0x74, 0xac, 0x06, 0x6d, 0x9e, 0x73, 0x2a,
+	0xc1, 0x53, 0x88, 0x93, 0x9b, 0xdf, 0xf7, 0xb3, 0x0d, 0xd9, 0x5d, 0x40, 0xf3, 0xf8, 0x90, 0xdd,
+	0xf9, 0x0d, 0xdf, 0x22, 0xb1, 0x64, 0xf3, 0x3b, 0x5f, 0xe0, 0xeb, 0xf8, 0xc9, 0xd1, 0xa2, 0xaf,
+	0x3d, 0x61, 0x7e, 0xc0, 0xbd, 0x33, 0xcc, 0x0f, 0x38, 0xf3, 0x57, 0xb9, 0x01, 0x73, 0x72, 0x03,
+	0x6a, 0x09, 0xfb, 0x46, 0xce, 0x30, 0x61, 0x5f, 0x2a, 0x2d, 0xde, 0xe8, 0xf9, 0xa4, 0xc5, 0x23,
+	0x6f, 0xc0, 0x68, 0xc7, 0xf2, 0x99, 0xed, 0xe3, 0x58, 0x79, 0x71, 0x2e, 0x37, 0x9b, 0x66, 0xbc,
+	0xf3, 0x37, 0x38, 0x01, 0x94, 0x84, 0x58, 0xbc, 0xd4, 0x27, 0x7a, 0xb1, 0x0c, 0x7e, 0x81, 0x6f,
+	0xa4, 0xb6, 0xc8, 0x20, 0x17, 0xf8, 0x0c, 0x27, 0x8c, 0x2e, 0xf0, 0x69, 0x08, 0x66, 0xe8, 0x16,
+	0x24, 0xdb, 0xae, 0x94, 0x49, 0xb6, 0x6d, 0xfe, 0x42, 0x05, 0xe0, 0x1e, 0x0d, 0x59, 0x44, 0x5d,
+	0x76, 0xfe, 0x3e, 0x91, 0x50, 0xad, 0x8e, 0x7f, 0xf9, 0xe2, 0x37, 0x3d, 0x01, 0xc3, 0x1d, 0xaf,
+	0x29, 0xce, 0x00, 0xd9, 0x11, 0x6e, 0x07, 0xcd, 0x4b, 0x59, 0xc8, 0x15, 0x6e, 0x8c, 0x21, 0xa5,
+	0x33, 0x2e, 0x08, 0x32, 0xb5, 0x5a, 0x80, 0xa2, 0x5c, 0xe4, 0x10, 0xe7, 0x2e, 0xa6, 0x41, 0x75,
+	0x24, 0xe6, 0x5e, 0xd2, 0x19, 0x35, 0xc0, 0x08, 0x4a, 0x9e, 0x07, 0xb0, 0x3b, 0xb7, 0xac, 0xb6,
+	0xed, 0xd8, 0x72, 0x8d, 0x4f, 0x70, 0x8d, 0x21, 0xac, 0x6e, 0xa8, 0xd2, 0x47, 0x87, 0xf3, 0xe3,
+	0xf2, 0xd7, 0x01, 0x6a, 0xb5, 0xcd, 0x3f, 0x1f, 0x82, 0xa9, 0x7b, 0x2d, 0xdb, 0xdd, 0x57, 0x91,
+	0x2b, 0xa2, 0x47, 0x35, 0xe3, 0x6c, 0x1e, 0xd5, 0x5e, 0x81, 0xaa, 0xe3, 0x59, 0xcd, 0x25, 0xcb,
+	0x61, 0xa2, 0x9e, 0x5f, 0x17, 0x32, 0x82, 0xe5, 0xaa, 0x6c, 0x8c, 0x52, 0x53, 0xb2, 0x56, 0x50,
+	0x07, 0x0b, 0x5b, 0x93, 0x10, 0x46, 0x1b, 0x2a, 0xd1, 0x4a, 0xe9, 0x68, 0x0c, 0xfa, 0x58, 0x2c,
+	0xe8, 0x8e, 0xc9, 0xd1, 0xbe, 0x93, 0xb3, 0x2d, 0x69, 0x31, 0x5d, 0xef, 0x15, 0xba, 0x2f, 0x1c,
+	0xf3, 0x37, 0x7d, 0x6b, 0x67, 0xc7, 0x6e, 0x48, 0xef, 0x14, 0x31, 0xb1, 0x6b, 0xec, 0xe9, 0x78,
+	0x25, 0xaf, 0xc2, 0xa3, 0xc3, 0xf9, 0x9b, 0xb9, 0x71, 0x12, 0xf8, 0xb4, 0xe6, 0x36, 0xc1, 0x7c,
+	0x52, 0x2c, 0x84, 0xd1, 0x09, 0x7c, 0x1a, 0x13, 0xd1, 0x10, 0x7e, 0xb1, 0x02, 0x53, 0x6c, 0xdd,
+	0x31, 0xa9, 0xdc, 0x61, 0x41, 0x7d, 0x9f, 0x49, 0xc7, 0x30, 0x8a, 0xb8, 0x6b, 0x26, 0x8e, 0xd1,
+	0x1a, 0x5c, 0xde, 0xf1, 0xfc, 0x06, 0xdd, 0xac, 0x6d, 0x6c, 0x7a, 0xd2, 0xc6, 0x64, 0xf9, 0x5e,
+	0x5d, 0x5e, 0xc1, 0xb8, 0xd6, 0xfc, 0x56, 0x0e, 0x1c, 0x73, 0x5b, 0x31, 0xe3, 0xe0, 0xb8, 0x7c,
+	0xab, 0x23, 0x8c, 0x6b, 0x19, 0xba, 0xa1, 0xd8, 0x38, 0xf8, 0x56, 0x5e, 0x05, 0xcc, 0x6f, 0xc7,
+	0xde, 0xe0, 0x65, 0x88, 0xb4, 0x5b, 0x9e, 0xff, 0xd0, 0xf2, 0x9b, 0x49, 0xb4, 0xc3, 0xf1, 0x1b,
+	0xfc, 0x72, 0x71, 0x35, 0xec, 0x85, 0xc3, 0xfc, 0x91, 0x51, 0xd0, 0xbc, 0xe7, 0x4f, 0x20, 0x71,
+	0xfc, 0xb8, 0x01, 0x97, 0x1b, 0x8e, 0x4d, 0xdd, 0x30, 0xe5, 0x2a, 0x2d, 0xd8, 0xd1, 0x56, 0x29,
+	0xb7, 0xfe, 0x0e, 0x75, 0x57, 0x97, 0xa5, 0x2d, 0x72, 0x2d, 0x07, 0xb9, 0xb4, 0xd7, 0xce, 0x81,
+	0x60, 0x6e, 0x67, 0xf8, 0xf7, 0xf0, 0xf2, 0xd5, 0x65, 0x3d, 0xb6, 0x53, 0x4d, 0x96, 0x61, 0x04,
+	0x65, 0x1e, 0x5d, 0x2d, 0xdf, 0xeb, 0x76, 0x82, 0x1a, 0x77, 0x39, 0x12, 0x6b, 0x9f, 0x2b, 0x7d,
+	0x6e, 0xc7, 0xc5, 0xa8, 0xd7, 0x61, 0xea, 0x31, 0xf1, 0x73, 0xc3, 0xa7, 0x3b, 0xf6, 0x7e, 0x75,
+	0x24, 0x56, 0x8f, 0xdd, 0xd6, 0xca, 0x31, 0x51, 0x8b, 0x87, 0x67, 0x09, 0x82, 0x2e, 0xf5, 0xb7,
+	0x70, 0x4d, 0xde, 0x2a, 0x45, 0x78, 0x16, 0x55, 0x88, 0x31, 0x9c, 0xfc, 0x80, 0x01, 0x33, 0xcc,
+	0x4b, 0xdd, 0xf6, 0xd9, 0x91, 0x68, 0xd9, 0xed, 0xa0, 0x3a, 0x56, 0x3e, 0x64, 0x4a, 0x3c, 0xd1,
+	0x0b, 0x98, 0x40, 0x2a, 0x38, 0x44, 0xf4, 0x4e, 0x99, 0x04, 0x62, 0xaa, 0x07, 0x6c, 0xa8, 0x02,
+	0xbb, 0xe5, 0xda, 0x6e, 0x6b, 0xd1, 0x69, 0x05, 0xd5, 0xf1, 0x1b, 0x43, 0x6a, 0xa8, 0xea, 0x71,
+	0x31, 0xea, 0x75, 0x98, 0x5e, 0xba, 0x1b, 0xb0, 0x7d, 0xdf, 0xa6, 0x62, 0x7c, 0x27, 0xe2, 0x87,
+	0xdc, 0x2d, 0x1d, 0x80, 0xc9, 0x7a, 0xec, 0x15, 0x47, 0x15, 0xc8, 0x51, 0x06, 0xde, 0x92, 0x9f,
+	0x5f, 0x5b, 0x09, 0x08, 0xa6, 0x6a, 0xce, 0x2d, 0xc2, 0xa5, 0x9c, 0xcf, 0x3c, 0x11, 0x73, 0xf9,
+	0x0b, 0x03, 0xae, 0x88, 0x53, 0x5c, 0x25, 0xf1, 0x52, 0x11, 0x8f, 0xf3, 0x83, 0x07, 0x1b, 0x67,
+	0x1a, 0x3c, 0xf8, 0xcb, 0x10, 0x24, 0xd9, 0xfc, 0xbb, 0x15, 0x78, 0xfb, 0xb1, 0xfb, 0x92, 0xfc,
+	0x6d, 0x03, 0x26, 0xe9, 0x7e, 0xe8, 0x5b, 0x91, 0x5f, 0x26, 0x5b, 0xa4, 0x3b, 0x67, 0xc2, 0x04,
+	0x16, 0x56, 0x62, 0x42, 0x62, 0xe1, 0x46, 0xf2, 0xac, 0x06, 0x41, 0xbd, 0x3f, 0x4c, 0xdb, 0x2d,
+	0x02, 0x85, 0xeb, 0x16, 0x1f, 0x22, 0x0c, 0x0d, 0x4a, 0xc8, 0xdc, 0x87, 0x58, 0x20, 0xdd, 0x24,
+	0xe6, 0x13, 0xad, 0x95, 0x4f, 0x1b, 0x20, 0x94, 0xd5, 0x27, 0x0f, 0x3a, 0x6c, 0xb5, 0xbd, 0xae,
+	0x1b, 0x0e, 0x1a, 0x74, 0x78, 0x91, 0x63, 0x41, 0x89, 0xcd, 0xfc, 0xf9, 0x0a, 0x30, 0x67, 0x5b,
+	0xa6, 0x50, 0x38, 0x07, 0x25, 0x85, 0x95, 0x50, 0x52, 0xbc, 0x58, 0x4e, 0xe9, 0xcf, 0x3b, 0x5b,
+	0xa8, 0x95, 0xb0, 0x53, 0x5a, 0x89, 0xc5, 0x41, 0x88, 0xf4, 0x56, 0x43, 0x3c, 0x04, 0x22, 0x2b,
+	0xea, 0xd1, 0x11, 0x2d, 0x98, 0xb4, 0x35, 0xc7, 0x9c, 0x72, 0x76, 0x00, 0x9c, 0x51, 0xea, 0x5e,
+	0x38, 0x3a, 0x4e, 0xf3, 0x37, 0x0c, 0x98, 0x94, 0x94, 0xcf, 0x41, 0xe1, 0xf1, 0xad, 0x49, 0x85,
+	0xc7, 0xd7, 0x0f, 0x30, 0xa0, 0x05, 0x1a, 0x8e, 0x1f, 0x35, 0xa2, 0x91, 0xd4, 0x5f, 0x2b, 0x3e,
+	0x61, 0xc0, 0xf4, 0x8e, 0xa6, 0xa4, 0x56, 0x06, 0x64, 0x77, 0x07, 0x7c, 0x36, 0xd0, 0x15, 0xdf,
+	0xb1, 0x0e, 0x41, 0x2f, 0x0d, 0x30, 0x49, 0xd8, 0xfc, 0x9c, 0x01, 0xd3, 0xaa, 0x87, 0xb4, 0xbd,
+	0x4d, 0x7d, 0x72, 0x0b, 0xc6, 0x82, 0x2e, 0x5f, 0xe3, 0x72, 0xc8, 0x1f, 0xd7, 0x86, 0x7c, 0xc1,
+	0xdf, 0xb6, 0x1a, 0x6c, 0x80, 0xeb, 0xa2, 0x8a, 0x96, 0xb9, 0x4c, 0x14, 0xa0, 0x6a, 0xcc, 0xb4,
+	0x98, 0xbe, 0xe7, 0x64, 0x02, 0x91, 0xa2, 0xe7, 0x50, 0xe4, 0x10, 0x76, 0x87, 0x62, 0xff, 0xab,
+	0x67, 0x5a, 0x7e, 0x87, 0x62, 0xe0, 0x00, 0x45, 0xb9, 0xf9, 0xb7, 0xc6, 0xa2, 0xe5, 0xc0, 0xaf,
+	0x9d, 0x77, 0x60, 0xa2, 0xe1, 0x53, 0x2b, 0xa4, 0xcd, 0xa5, 0x83, 0x7e, 0x3a, 0xc7, 0x25, 0x8b,
+	0x9a, 0x6a, 0x81, 0x71, 0x63, 0x76, 0x88, 0xeb, 0xf6, 0x50, 0x95, 0x58, 0xde, 0x29, 0xb4, 0x85,
+	0xfa, 0x06, 0x18, 0xf1, 0x1e, 0xba, 0x91, 0x59, 0x75, 0x4f, 0xc2, 0xfc, 0x53, 0xee, 0xb3, 0xda,
+	0x28, 0x1a, 0xe9, 0x81, 0x78, 0x87, 0x7b, 0x04, 0xe2, 0x75, 0x58, 0x9e, 0x52, 0x36, 0x0d, 0x03,
+	0x25, 0xb2, 0x4a, 0x4c, 0xa8, 0x9e, 0xea, 0x94, 0x63, 0x46, 0x45, 0x82, 0x09, 0x63, 0xae, 0xd2,
+	0x39, 0xe8, 0xc2, 0x58, 0xa4, 0x88, 0xc0, 0x18, 0xce, 0xb2, 0xb8, 0xe8, 0x11, 0x9e, 0xc7, 0xca,
+	0x6b, 0xd9, 0x64, 0xf7, 0xb4, 0xa0, 0xce, 0x62, 0xe8, 0x8b, 0xa2, 0x3c, 0x73, 0x53, 0xe1, 0x6d,
+	0xab, 0xf1, 0xa0, 0xdb, 0x41, 0x1a, 0xb2, 0xa3, 0xd0, 0x73, 0xe5, 0x25, 0x6d, 0x00, 0xaf, 0xee,
+	0xa5, 0x3c, 0x84, 0xe2, 0x9a, 0x92, 0x0b, 0xc2, 0xfc, 0x2e, 0xb0, 0x71, 0x69, 0xc7, 0x5b, 0xb0,
+	0x3a, 0x31, 0xf0, 0xb8, 0x68, 0x1b, 0x5a, 0x8c, 0x8b, 0x56, 0x80, 0x3a, 0x2d, 0x46, 0x7a, 0x37,
+	0x66, 0xd0, 0x55, 0x18, 0x98, 0xb4, 0xc6, 0xee, 0x05, 0x69, 0xad, 0x00, 0x75, 0x5a, 0xe6, 0x5f,
+	0x1f, 0x89, 0xf8, 0x86, 0x54, 0x50, 0xe5, 0xeb, 0x84, 0x8c, 0x32, 0x3a, 0x21, 0xf2, 0x5e, 0x95,
+	0x50, 0xa3, 0x92, 0xc8, 0x94, 0x1c, 0x25, 0xd4, 0x98, 0x92, 0xa4, 0x13, 0x49, 0x34, 0xba, 0x70,
+	0x29, 0x08, 0x59, 0x90, 0x53, 0x5b, 0x3e, 0x42, 0x05, 0xa1, 0xd5, 0xee, 0x94, 0x78, 0x8a, 0x13,
+	0x1e, 0xc9, 0x59, 0x54, 0x98, 0x87, 0x9f, 0x65, 0x1e, 0xab, 0xf2, 0x72, 0xf6, 0x48, 0x2a, 0x52,
+	0x2f, 0xc5, 0xc4, 0x4f, 0x6e, 0x07, 0xcb, 0xd5, 0x27, 0xf5, 0x02, 0x7c, 0x58, 0x48, 0x89, 0x7c,
+	0x0c, 0xae, 0x30, 0xf9, 0x75, 0xb1, 0x11, 0xda, 0x7b, 0x76, 0x78, 0x10, 0x77, 0xe1, 0xe4, 0x69,
+	0x2c, 0xf8, 0x1e, 0x58, 0xcb, 0x43, 0x86, 0xf9, 0x34, 0x78, 0x1e, 0x3e, 0xaa, 0x67, 0x9e, 0x50,
+	0xba, 0xda, 0x72, 0x31, 0xf0, 0x75, 0x4c, 0xf1, 0xbd, 0x2c, 0x51, 0x1c, 0x60, 0x8a, 0xa0, 0xf9,
+	0xa7, 0xf1, 0x59, 0xab, 0x31, 0x12, 0xe2, 0xc0, 0x78, 0x53, 0xb9, 0x29, 0x1b, 0xa7, 0x12, 0x95,
+	0x3e, 0x12, 0x29, 0x22, 0xef, 0xe6, 0x88, 0x02, 0xf1, 0x60, 0xe2, 0xe1, 0xae, 0x1d, 0x52, 0xc7,
+	0x0e, 0xc2, 0x53, 0x0a, 0x82, 0x1f, 0x45, 0x84, 0x7e, 0x59, 0x21, 0xc6, 0x98, 0x86, 0xf9, 0x7d,
+	0xc3, 0x30, 0x1e, 0xe5, 0x31, 0x3a, 0xde, 0x2c, 0xb5, 0x0b, 0xa4, 0xa1, 0xe5, 0x82, 0x1e, 0x44,
+	0x87, 0xca, 0xaf, 0x51, 0xb5, 0x0c, 0x32, 0xcc, 0x21, 0x40, 0x3e, 0x06, 0x97, 0x6d, 0x77, 0xc7,
+	0xb7, 0x82, 0xd0, 0xef, 0x72, 0x33, 0x99, 0x41, 0x52, 0x2a, 0x73, 0x2d, 0xc8, 0x6a, 0x0e, 0x3a,
+	0xcc, 0x25, 0xc2, 0xde, 0x2a, 0x44, 0xba, 0x36, 0xf5, 0x42, 0x52, 0xea, 0xad, 0x42, 0xa4, 0x81,
+	0x8b, 0x0f, 0x53, 0xf1, 0x3b, 0x40, 0x85, 0x5b, 0xc4, 0x0e, 0x14, 0x7f, 0xab, 0xc7, 0xa3, 0xea,
+	0x48, 0x79, 0xef, 0x9e, 0x97, 0x93, 0xa8, 0x64, 0xec, 0xc0, 0x64, 0x21, 0xa6, 0x09, 0x9a, 0xff,
+	0xb8, 0x02, 0x23, 0x22, 0xe0, 0xce, 0xd9, 0xdf, 0x79, 0xbe, 0x25, 0x71, 0xe7, 0x29, 0x95, 0x15,
+	0x96, 0x77, 0xb5, 0xf0, 0xc6, 0xd3, 0x4a, 0xdd, 0x78, 0x5e, 0x2c, 0x4f, 0xa2, 0xf7, 0x7d, 0xe7,
+	0xd7, 0x0c, 0x98, 0xe0, 0xf5, 0xce, 0xe1, 0xd2, 0xf1, 0x5a, 0xf2, 0xd2, 0xf1, 0x5c, 0xe9, 0x6f,
+	0x2a, 0xb8, 0x72, 0xfc, 0x49, 0x05, 0x66, 0x39, 0x5c, 0xf2, 0xc2, 0x2d, 0x9e, 0xd4, 0xfe, 0xa6,
+	0x2e, 0xe9, 0x09, 0xf6, 0x10, 0xf1, 0x95, 0x5c, 0x69, 0xef, 0x17, 0x0d, 0x18, 0xe9, 0x06, 0xc2,
+	0x80, 0x7d, 0xa8, 0xec, 0x1b, 0x78, 0xa6, 0x1f, 0x0b, 0xfc, 0x5f, 0xa1, 0xb6, 0xa8, 0xab, 0xee,
+	0xf3, 0xb2, 0x47, 0x87, 0xf3, 0xf3, 0x39, 0xda, 0xf4, 0x38, 0xd3, 0x60, 0x10, 0x7e, 0xd7, 0x1f,
+	0xf4, 0xac, 0xc2, 0x3e, 0x03, 0x45, 0x9f, 0xe7, 0x76, 0x01, 0x62, 0x4a, 0x39, 0x6a, 0x8c, 0x65,
+	0x5d, 0x8d, 0x71, 0x62, 0x9d, 0x83, 0xae, 0xf6, 0xf8, 0xee, 0x51, 0xb9, 0x74, 0xf8, 0x05, 0x65,
+	0x15, 0x2e, 0x49, 0xc7, 0x4c, 0x96, 0x1a, 0x91, 0xb1, 0xae, 0x65, 0xeb, 0x40, 0xd8, 0xe5, 0x8d,
+	0xc8, 0xc8, 0x1d, 0x59, 0x30, 0xe6, 0xb5, 0x61, 0x13, 0x30, 0xd6, 0xa6, 0xa1, 0x6f, 0x37, 0x06,
+	0x7a, 0x90, 0x8f, 0xfa, 0xb6, 0xb0, 0x2e, 0x90, 0x89, 0xc1, 0xdf, 0x8a, 0xef, 0x04, 0xbc, 0xf4,
+	0x94, 0x86, 0x5f, 0xf5, 0x98, 0xdc, 0x81, 0x91, 0xa0, 0xe1, 0x75, 0xe8, 0x49, 0xd2, 0x55, 0x47,
+	0xeb, 0xb9, 0xce, 0x5a, 0xa2, 0x40, 0x40, 0x7e, 0x95, 0x85, 0xcc, 0xf6, 0x76, 0x42, 0x1e, 0x45,
+	0x48, 0x71, 0xf0, 0xf5, 0xc1, 0x86, 0xa2, 0x1e, 0xe1, 0x13, 0xa3, 0xf1, 0x4a, 0x14, 0x2d, 0x3b,
+	0x02, 0x9c, 0xd2, 0x80, 0x68, 0x5d, 0x9f, 0x7b, 0x1d, 0xa6, 0xf4, 0x39, 0x38, 0xcb, 0x65, 0x39,
+	0xd7, 0x86, 0x0b, 0xa9, 0x8f, 0x3c, 0xd3, 0x5d, 0xf0, 0x4f, 0x86, 0x60, 0x52, 0x63, 0xb4, 0xe4,
+	0xe7, 0x22, 0xee, 0x61, 0x0c, 0xba, 0x74, 0x39, 0xc2, 0x73, 0xe2, 0x1b, 0xec, 0x0c, 0x9f, 0xee,
+	0x68, 0xfc, 0x4a, 0x6d, 0xbd, 0x95, 0x53, 0xe1, 0x7e, 0xb1, 0x4a, 0x46, 0x2f, 0x0d, 0x30, 0x49,
+	0xf2, 0x1c, 0x99, 0xd7, 0x2f, 0x55, 0x60, 0x54, 0xd8, 0x5c, 0xf4, 0x61, 0x73, 0x66, 0xab, 0x0c,
+	0x92, 0x95, 0xf2, 0xce, 0x8e, 0x7a, 0xea, 0x10, 0x96, 0x36, 0x32, 0xde, 0xf3, 0x7a, 0x12, 0x49,
+	0xe2, 0x46, 0x09, 0x65, 0x86, 0xca, 0xa7, 0x90, 0x16, 0x1f, 0x76, 0xd6, 0x29, 0x64, 0x7e, 0xd3,
+	0x80, 0xa9, 0x44, 0x86, 0x9e, 0x36, 0x0c, 0xf9, 0x74, 0xa7, 0x6a, 0x0c, 0x64, 0x92, 0xa7, 0xdc,
+	0xd9, 0x1e, 0xef, 0x51, 0x09, 0x19, 0x9d, 0x28, 0x99, 0x4f, 0xe5, 0x94, 0x92, 0xf9, 0x98, 0x9f,
+	0x31, 0xe0, 0xaa, 0xfa, 0xa0, 0x64, 0xa8, 0x6a, 0xf6, 0x9c, 0x68, 0x75, 0x6c, 0xfe, 0xb8, 0xa7,
+	0x3f, 0x8f, 0x2e, 0x6e, 0xac, 0xf2, 0x32, 0x8c, 0xa0, 0xec, 0x09, 0x40, 0x2d, 0x3c, 0x79, 0x85,
+	0x8f, 0x44, 0x22, 0x85, 0x1b, 0xa3, 0x1a, 0xe4, 0xab, 0xb4, 0x24, 0x9f, 0x23, 0xb1, 0x5c, 0x12,
+	0x11, 0x16, 0xc6, 0xe6, 0xe6, 0x9f, 0x19, 0x30, 0x2b, 0xed, 0x93, 0x5f, 0xb6, 0xf6, 0x68, 0xdf,
+	0xae, 0x38, 0xaf, 0x01, 0xf8, 0xd4, 0xa1, 0x56, 0x40, 0x9b, 0x8b, 0x61, 0x99, 0xfc, 0x7e, 0xea,
+	0x74, 0xc0, 0x08, 0x0b, 0x6a, 0x18, 0x49, 0x13, 0xa6, 0x76, 0xb9, 0xff, 0xd8, 0x01, 0xd7, 0x0c,
+	0x94, 0x50, 0x38, 0x44, 0x16, 0x57, 0x77, 0x34, 0x3c, 0x98, 0xc0, 0x6a, 0x7e, 0x1d, 0x4c, 0xd4,
+	0xeb, 0x77, 0x16, 0x1b, 0x0d, 0x66, 0xe5, 0xd1, 0xff, 0x23, 0xbf, 0xf9, 0xc9, 0x21, 0x98, 0x96,
+	0x19, 0x07, 0x6c, 0xb7, 0xc9, 0x2c, 0x6c, 0xce, 0xfe, 0x66, 0xb0, 0x09, 0x13, 0xe2, 0x55, 0x29,
+	0x36, 0x4e, 0xcd, 0x95, 0x00, 0xea, 0xaa, 0x52, 0x3a, 0xaf, 0x57, 0x04, 0xc0, 0x18, 0x11, 0xb9,
+	0x0b, 0xa3, 0x6f, 0x30, 0x96, 0xaa, 0xb8, 0x42, 0x5f, 0x42, 0x45, 0xb4, 0xe5, 0x39, 0x37, 0x0e,
+	0x50, 0xa2, 0x20, 0x01, 0xf7, 0x36, 0xe5, 0xd7, 0xe6, 0x41, 0x22, 0x89, 0x26, 0x46, 0x36, 0x4a,
+	0x70, 0x3c, 0x25, 0x9d, 0x56, 0xf9, 0x2f, 0x8c, 0x08, 0xf1, 0xa4, 0x84, 0x89, 0x16, 0x6f, 0x91,
+	0xa4, 0x84, 0x89, 0x3e, 0x17, 0xdc, 0x3b, 0x9e, 0x83, 0x2b, 0xb9, 0x83, 0x71, 0xbc, 0x52, 0xc2,
+	0xfc, 0x99, 0x0a, 0x0c, 0xb3, 0xd4, 0x82, 0xe7, 0xb0, 0x32, 0x5f, 0x4b, 0xdc, 0x59, 0xbf, 0xa1,
+	0x74, 0x5a, 0xc4, 0xa2, 0x2b, 0xeb, 0x4e, 0xea, 0xca, 0xfa, 0xa1, 0xd2, 0x14, 0x7a, 0xdf, 0x58,
+	0x7f, 0xb4, 0x02, 0xc0, 0xaa, 0x09, 0x4d, 0xb5, 0xf4, 0x9d, 0x16, 0xab, 0x39, 0xf5, 0xe4, 0x9a,
+	0x5d, 0x86, 0xe7, 0x69, 0x44, 0x67, 0xc2, 0xa8, 0xb0, 0xe5, 0xac, 0x0e, 0xc5, 0x2f, 0xcf, 0xe2,
+	0x64, 0x46, 0x09, 0x49, 0x72, 0x8b, 0xe1, 0x53, 0xe2, 0x16, 0xe6, 0x3e, 0x8c, 0xb1, 0x01, 0x62,
+	0x86, 0x44, 0x6d, 0x6d, 0x74, 0x2a, 0xe5, 0x35, 0x32, 0x12, 0xdd, 0xb1, 0xbb, 0xfc, 0x93, 0x06,
+	0x5c, 0x48, 0xd5, 0xed, 0x43, 0x33, 0x77, 0x26, 0x3c, 0xd3, 0xfc, 0x55, 0x03, 0xc6, 0x59, 0x5f,
+	0xce, 0x81, 0xd1, 0xfc, 0xdf, 0x49, 0x46, 0xf3, 0xc1, 0xb2, 0x43, 0x5c, 0xc0, 0x5f, 0xfe, 0xb8,
+	0x02, 0x3c, 0xff, 0xa8, 0x34, 0x15, 0xd5, 0x2c, 0x30, 0x8d, 0x02, 0x0b, 0xcc, 0x1b, 0xd2, 0x80,
+	0x33, 0xf5, 0x00, 0xa9, 0x19, 0x71, 0xbe, 0x5b, 0xb3, 0xd1, 0x1c, 0x4a, 0x6e, 0x9b, 0x1c, 0x3b,
+	0xcd, 0x37, 0x61, 0x3a, 0x60, 0x1e, 0xf9, 0x51, 0xd4, 0xcb, 0xe1, 0xf2, 0xef, 0xf0, 0xdc, 0xb5,
+	0x5f, 0x7d, 0x8a, 0x30, 0x04, 0xaa, 0xeb, 0xb8, 0x31, 0x49, 0x8a, 0x45, 0xcf, 0xdd, 0x76, 0xbc,
+	0xc6, 0x03, 0x16, 0x2f, 0x5f, 0xb9, 0x72, 0x73, 0xcb, 0xf4, 0xa5, 0xa8, 0x14, 0xb5, 0x1a, 0x03,
+	0xd9, 0x94, 0xfe, 0xa1, 0x21, 0x46, 0xfa, 0x04, 0x8b, 0xf7, 0x1c, 0x39, 0xca, 0x3b, 0x52, 0x1c,
+	0x25, 0xe2, 0x90, 0x29, 0xae, 0x32, 0xaf, 0xae, 0x2b, 0xc3, 0xf1, 0xe3, 0x72, 0x22, 0x53, 0xfd,
+	0xcf, 0xcb, 0xcf, 0x8c, 0x52, 0xd8, 0x76, 0x60, 0x9a, 0xdf, 0x07, 0x52, 0xb9, 0x73, 0xdf, 0xdb,
+	0xe7, 0x1e, 0xd1, 0x9b, 0xc6, 0x37, 0xbd, 0x44, 0x31, 0x26, 0x09, 0x30, 0xbb, 0x30, 0xf5, 0x75,
+	0xc2, 0xc0, 0xbd, 0x12, 0xfb, 0x2b, 0x6f, 0xe8, 0x00, 0x4c, 0xd6, 0x63, 0x99, 0x9f, 0x9f, 0x14,
+	0x7d, 0xe7, 0x7a, 0xdf, 0x65, 0xda, 0xa1, 0x6e, 0x93, 0x99, 0xd3, 0x70, 0x89, 0xbd, 0xe9, 0x31,
+	0x8d, 0xfb, 0xe8, 0x43, 0x4a, 0x9b, 0xd1, 0x73, 0xf5, 0xcb, 0xa5, 0x0f, 0xa2, 0x22, 0x12, 0x2f,
+	0x73, 0xf4, 0x82, 0xa3, 0x8b, 0xbf, 0x51, 0x92, 0x64, 0xc4, 0x3b, 0xbe, 0xb7, 0x1d, 0x89, 0x56,
+	0xa7, 0x4f, 0x7c, 0x83, 0xa3, 0x17, 0xc4, 0xc5, 0xdf, 0x28, 0x49, 0x9a, 0x1b, 0xf0, 0x54, 0x1f,
+	0x4d, 0x4f, 0x22, 0x42, 0x1f, 0x87, 0x51, 0x7c, 0xfd, 0x49, 0x30, 0xfe, 0xae, 0x01, 0x4f, 0x6b,
+	0x28, 0x57, 0xf6, 0x99, 0x54, 0x5f, 0xb3, 0x3a, 0x56, 0x83, 0xdd, 0xd0, 0x79, 0x24, 0xbf, 0x13,
+	0x65, 0x24, 0xfd, 0xa4, 0x01, 0x63, 0xc2, 0xa0, 0x59, 0xb1, 0xdf, 0xd7, 0x06, 0x1c, 0xf2, 0xc2,
+	0x2e, 0xa9, 0x54, 0x57, 0xea, 0xdb, 0xc4, 0xef, 0x00, 0x15, 0x7d, 0xf3, 0x5f, 0x8c, 0xc0, 0x57,
+	0xf7, 0x8f, 0x88, 0xfc, 0xa1, 0x91, 0x4e, 0x71, 0x3f, 0xf9, 0x6c, 0xfb, 0x6c, 0x3b, 0x1f, 0xa9,
+	0x7e, 0xa4, 0x5a, 0xe0, 0xe5, 0x4c, 0x3a, 0xe1, 0x53, 0xd2, 0x2a, 0xc5, 0x1f, 0x46, 0x7e, 0xd2,
+	0x80, 0x29, 0x76, 0x2c, 0x45, 0xcc, 0x45, 0x4c, 0x53, 0xe7, 0x8c, 0xbf, 0xf4, 0x9e, 0x46, 0x32,
+	0x15, 0xf2, 0x4b, 0x07, 0x61, 0xa2, 0x6f, 0x64, 0x2b, 0x69, 0xea, 0x21, 0xae, 0x5b, 0xd7, 0xf3,
+	0xa4, 0x91, 0x93, 0x24, 0xeb, 0x9e, 0x73, 0x60, 0x26, 0x39, 0xf2, 0x67, 0xaa, 0x02, 0x7d, 0x11,
+	0x66, 0x33, 0x5f, 0x7f, 0x22, 0xd5, 0xce, 0x77, 0x0f, 0xc3, 0xbc, 0x36, 0xd4, 0x09, 0x97, 0x06,
+	0x25, 0x13, 0xfc, 0xb0, 0x01, 0x93, 0x96, 0xeb, 0x4a, 0xb3, 0x58, 0xb5, 0x7e, 0x9b, 0x03, 0xce,
+	0x6a, 0x1e, 0xa9, 0x85, 0xc5, 0x98, 0x4c, 0xca, 0xee, 0x53, 0x83, 0xa0, 0xde, 0x9b, 0x1e, 0xce,
+	0x0d, 0x95, 0x73, 0x73, 0x6e, 0x20, 0xdf, 0xa6, 0x0e, 0x62, 0xb1, 0x8c, 0x5e, 0x39, 0x83, 0xb1,
+	0xe1, 0xe7, 0x7a, 0xbe, 0x2e, 0x91, 0xd9, 0xb5, 0xa6, 0x47, 0xee, 0x44, 0xab, 0xe0, 0x67, 0x86,
+	0xe0, 0xe9, 0x7e, 0xc8, 0xf7, 0xa1, 0x88, 0xfa, 0x7c, 0x6a, 0xb1, 0x08, 0x16, 0x60, 0x9f, 0xd5,
+	0x80, 0x9c, 0xee, 0x8a, 0x19, 0x3a, 0x3f, 0x77, 0x98, 0x41, 0xa7, 0x6c, 0x09, 0xae, 0x68, 0xe3,
+	0x13, 0xa7, 0xd9, 0xe1, 0xd1, 0x29, 0xed, 0xc0, 0x56, 0x31, 0x96, 0xb5, 0x13, 0xfa, 0x25, 0x51,
+	0x8c, 0x0a, 0x6e, 0xae, 0x25, 0xf6, 0xfe, 0xa6, 0xd7, 0xf1, 0x1c, 0xaf, 0x75, 0xb0, 0xf8, 0xd0,
+	0xf2, 0x29, 0x7a, 0xdd, 0x50, 0x62, 0xeb, 0xf7, 0xbc, 0x5f, 0x87, 0x1b, 0x1a, 0xb6, 0xdc, 0x48,
+	0x94, 0x27, 0x41, 0xf7, 0x1b, 0x63, 0x30, 0xa5, 0xe1, 0xe3, 0xef, 0x2d, 0x8f, 0xd1, 0xa2, 0xa3,
+	0x40, 0xca, 0xb1, 0xaf, 0x9c, 0xd5, 0x51, 0x23, 0xb3, 0xde, 0x14, 0x81, 0xb1, 0xb8, 0x67, 0x2c,
+	0x76, 0x46, 0x10, 0x4d, 0xcf, 0x20, 0xb1, 0x33, 0x72, 0xe7, 0x5b, 0xe6, 0x86, 0x8e, 0x7e, 0xa3,
+	0x46, 0x8c, 0xfc, 0x98, 0x01, 0x97, 0x9d, 0x9c, 0xad, 0x23, 0x45, 0xd6, 0xfa, 0x19, 0xec, 0x4a,
+	0x61, 0xb9, 0x92, 0x07, 0xc1, 0xdc, 0xae, 0x90, 0x9f, 0x28, 0x0c, 0x91, 0x2a, 0x0c, 0x4b, 0x36,
+	0x07, 0xec, 0xe4, 0x69, 0x45, 0x4b, 0xfd, 0xac, 0x01, 0xa4, 0x99, 0x11, 0x8b, 0xab, 0x63, 0xe5,
+	0xd3, 0xd4, 0xf5, 0x94, 0xb7, 0x85, 0xe9, 0x51, 0xb6, 0x1c, 0x73, 0x3a, 0xc1, 0xe7, 0x39, 0xcc,
+	0xd9, 0xbe, 0xd5, 0xf1, 0x53, 0x99, 0xe7, 0x3c, 0xce, 0x20, 0xe6, 0x39, 0x0f, 0x82, 0xb9, 0x5d,
+	0x31, 0x7f, 0x65, 0x54, 0x68, 0x69, 0xb8, 0x0d, 0xc1, 0x36, 0x8c, 0x0a, 0x43, 0xd3, 0xaa, 0x31,
+	0x98, 0x0a, 0x51, 0xe8, 0x06, 0xc5, 0x1d, 0x49, 0xfc, 0x8d, 0x12, 0x33, 0x79, 0x15, 0x86, 0x9a,
+	0xae, 0xf2, 0x94, 0xff, 0xfa, 0x01, 0x94, 0x61, 0x71, 0xbc, 0x0e, 0xe6, 0x6b, 0xc7, 0x90, 0x12,
+	0x17, 0xc6, 0x5d, 0xa9, 0xd8, 0x90, 0x77, 0xcf, 0x0f, 0x97, 0x25, 0x10, 0x29, 0x48, 0x22, 0xb5,
+	0x8c, 0x2a, 0xc1, 0x88, 0x06, 0xa3, 0x97, 0xd2, 0xe4, 0x97, 0xa6, 0x17, 0xa9, 0xf6, 0x7a, 0x69,
+	0x4f, 0x29, 0x0b, 0x9f, 0x6a, 0xbb, 0x91, 0x89, 0xe3, 0x0b, 0x65, 0xa9, 0x6d, 0x32, 0x2c, 0xb1,
+	0xfe, 0x82, 0xff, 0x0c, 0x50, 0x22, 0x67, 0xcb, 0x40, 0xb8, 0xa4, 0x57, 0xc7, 0x06, 0x5b, 0x06,
+	0xc2, 0xcb, 0x5d, 0x2c, 0x03, 0xf1, 0x37, 0x4a, 0xcc, 0xe4, 0x75, 0xa6, 0xff, 0x92, 0xa6, 0x6a,
+	0xe3, 0x83, 0x0d, 0x5d, 0x64, 0xa7, 0x26, 0xbd, 0x9c, 0xc5, 0x2f, 0x8c, 0xf0, 0x93, 0x6d, 0x18,
+	0xb3, 0x85, 0x5f, 0x6e, 0x75, 0xa2, 0xfc, 0xb2, 0x93, 0xae, 0xbd, 0xe2, 0x1a, 0x2c, 0x7f, 0xa0,
+	0x42, 0x6c, 0xfe, 0x06, 0x08, 0xad, 0xb8, 0x7c, 0x1a, 0xdc, 0x81, 0x71, 0x85, 0x6e, 0x90, 0x50,
+	0x2e, 0xb7, 0x25, 0x58, 0x7c, 0x9a, 0xfa, 0x85, 0x11, 0x6e, 0x96, 0x10, 0x25, 0x1b, 0x12, 0x29,
+	0x4e, 0x93, 0xd8, 0x5f, 0x38, 0xa4, 0x37, 0x00, 0x1a, 0x51, 0x08, 0xc6, 0xea, 0x50, 0xf9, 0xa5,
+	0x15, 0x05, 0x72, 0x8c, 0x9f, 0x42, 0xa2, 0xa2, 0x00, 0x35, 0x22, 0x05, 0x16, 0xdb, 0xc3, 0xa5,
+	0x2c, 0xb6, 0x5f, 0x80, 0x0b, 0xd2, 0x8a, 0x69, 0xb5, 0x49, 0xf9, 0x5d, 0x4c, 0x3a, 0x84, 0x72,
+	0xbb, 0xc5, 0x5a, 0x12, 0x84, 0xe9, 0xba, 0xe4, 0x97, 0x0c, 0xe6, 0x7a, 0x2b, 0x04, 0x84, 0xea,
+	0x68, 0x79, 0xff, 0xef, 0x78, 0xf6, 0x17, 0x94, 0xbc, 0x21, 0x44, 0xdf, 0x97, 0x22, 0x17, 0x34,
+	0x59, 0x7c, 0x4a, 0x57, 0xfc, 0xa8, 0xd7, 0xe4, 0xd7, 0x99, 0x74, 0xef, 0xf0, 0x98, 0x48, 0x3c,
+	0xb0, 0x9c, 0xf0, 0x54, 0xbd, 0x3f, 0xe0, 0x57, 0x2c, 0xc6, 0x18, 0xc5, 0x87, 0x7c, 0x34, 0x92,
+	0xe1, 0x63, 0xc8, 0x29, 0x7d, 0x8b, 0xde, 0x7d, 0xf2, 0xf7, 0x0c, 0x78, 0x5a, 0xb8, 0x07, 0xd7,
+	0xa8, 0x1f, 0xda, 0x3b, 0x76, 0xc3, 0x0a, 0xa9, 0x88, 0xed, 0xa8, 0xbc, 0x23, 0x85, 0x7d, 0xf9,
+	0xf8, 0x89, 0x9f, 0xbb, 0xdf, 0x79, 0x74, 0x38, 0xff, 0x74, 0xad, 0x0f, 0xdc, 0xd8, 0x57, 0x0f,
+	0x98, 0x62, 0xde, 0xd1, 0x83, 0xf6, 0x56, 0x27, 0xca, 0x2b, 0xe6, 0x13, 0xd1, 0x7f, 0x85, 0x26,
+	0x36, 0x51, 0x84, 0x49, 0x52, 0x73, 0x0f, 0x60, 0x3a, 0xb1, 0xd0, 0xce, 0x54, 0xa5, 0xe1, 0xc2,
+	0xc5, 0xf4, 0x7a, 0x38, 0x53, 0xfb, 0xa0, 0xbb, 0x30, 0x11, 0x1d, 0x54, 0xe4, 0x49, 0x8d, 0x50,
+	0x7c, 0xec, 0xdf, 0xa5, 0x07, 0x82, 0xea, 0x7c, 0xe2, 0x3a, 0x26, 0xf4, 0xed, 0x2f, 0xb1, 0x02,
+	0x89, 0xd0, 0xfc, 0x2d, 0xa9, 0x6f, 0xdf, 0xa4, 0xed, 0x8e, 0x63, 0x85, 0xf4, 0xad, 0xff, 0xda,
+	0x6b, 0xfe, 0x47, 0x43, 0x9c, 0x37, 0xe2, 0x58, 0x65, 0x0e, 0x92, 0x6d, 0x91, 0x3c, 0x8a, 0xc7,
+	0x3b, 0x34, 0xca, 0x47, 0x5a, 0x5c, 0x8f, 0xd1, 0xa0, 0x8e, 0x93, 0x3c, 0x84, 0x09, 0x25, 0x88,
+	0x28, 0xfd, 0xc1, 0xad, 0xc1, 0x04, 0x83, 0x48, 0xe6, 0x89, 0x1e, 0x12, 0x55, 0x49, 0x80, 0x31,
+	0x2d, 0xd3, 0x02, 0x92, 0x6d, 0xc3, 0xee, 0xac, 0xca, 0xab, 0xcd, 0x48, 0xa6, 0x7b, 0xc8, 0x78,
+	0xb6, 0x29, 0xf5, 0x48, 0xa5, 0x48, 0x3d, 0x62, 0xfe, 0x72, 0x05, 0x72, 0x73, 0xf5, 0xb3, 0x47,
+	0x64, 0x11, 0x13, 0x40, 0x12, 0xe1, 0xa2, 0x8c, 0x08, 0x18, 0x80, 0x12, 0xc2, 0xa2, 0x4f, 0x30,
+	0x65, 0x82, 0xdb, 0xe4, 0x69, 0x16, 0x62, 0x2e, 0xa1, 0x47, 0x9f, 0x58, 0xc9, 0xab, 0x80, 0xf9,
+	0xed, 0x58, 0x32, 0xea, 0xb6, 0xb5, 0x9f, 0xc6, 0x36, 0x40, 0x32, 0xea, 0xf5, 0x0c, 0x36, 0xcc,
+	0xa1, 0xc0, 0x0e, 0x52, 0xab, 0xd1, 0xa0, 0x9d, 0x90, 0x36, 0xc5, 0x27, 0xaa, 0xe7, 0x3e, 0x7e,
+	0x90, 0x2e, 0x26, 0x41, 0x98, 0xae, 0x6b, 0x7e, 0x69, 0x18, 0x1e, 0x4b, 0x0e, 0x22, 0xdb, 0xa1,
+	0xca, 0x6d, 0xff, 0x45, 0xe5, 0x57, 0x25, 0x06, 0xf2, 0x99, 0xb4, 0x5f, 0x55, 0xb5, 0xe6, 0x53,
+	0x7e, 0x24, 0x5b, 0x4e, 0xa0, 0x1a, 0x25, 0x7c, 0xac, 0xbe, 0x0c, 0x3e, 0xf8, 0x05, 0xb1, 0x06,
+	0x86, 0xce, 0x34, 0xd6, 0xc0, 0xa7, 0x0c, 0x98, 0x4b, 0x16, 0xdf, 0xb2, 0x5d, 0x3b, 0xd8, 0x95,
+	0xc9, 0x02, 0x4e, 0xee, 0xd6, 0xc5, 0xd3, 0x67, 0xae, 0x15, 0x62, 0xc4, 0x1e, 0xd4, 0xc8, 0xa7,
+	0x0d, 0x78, 0x3c, 0x35, 0x2e, 0x89, 0xd4, 0x05, 0x27, 0xf7, 0xf0, 0xe2, 0x51, 0x53, 0xd6, 0x8a,
+	0x51, 0x62, 0x2f, 0x7a, 0xdc, 0xc9, 0x84, 0xbf, 0x56, 0xbf, 0x35, 0x9c, 0x4c, 0x78, 0x57, 0xcf,
+	0xd6, 0xc9, 0x44, 0x90, 0xe8, 0x6d, 0xb2, 0xf3, 0x51, 0xb8, 0xca, 0xab, 0x2d, 0x36, 0xb9, 0x12,
+	0x85, 0xd9, 0x0e, 0x36, 0x9b, 0x3c, 0x66, 0xd3, 0xf1, 0x9a, 0xe3, 0x27, 0x61, 0xa8, 0xeb, 0x3b,
+	0xe9, 0x10, 0x99, 0x2c, 0x5a, 0x0a, 0x2b, 0x37, 0x7f, 0xb3, 0x02, 0x33, 0x1c, 0xf7, 0x92, 0xd3,
+	0xa5, 0x1d, 0xdf, 0x76, 0xcf, 0x63, 0x66, 0x76, 0x13, 0x33, 0x73, 0xab, 0xf4, 0xb0, 0x45, 0x7d,
+	0x2e, 0x9c, 0xa2, 0x4e, 0x6a, 0x8a, 0xee, 0x9c, 0x02, 0xad, 0xde, 0x73, 0xf5, 0x7b, 0x06, 0x90,
+	0x64, 0x83, 0x73, 0x30, 0xa0, 0x69, 0x25, 0x0d, 0x68, 0x96, 0x06, 0xff, 0xca, 0x02, 0x53, 0x9a,
+	0xcf, 0x18, 0x70, 0x2d, 0x59, 0xf1, 0x24, 0xb1, 0x47, 0x5f, 0x85, 0x09, 0x6f, 0x8f, 0xfa, 0xbe,
+	0xdd, 0xa4, 0x41, 0x39, 0x33, 0x0f, 0xee, 0x63, 0x7e, 0x5f, 0xe1, 0xc0, 0x18, 0x9d, 0xf9, 0x3d,
+	0x99, 0x71, 0xe7, 0x2a, 0x31, 0x0f, 0xc6, 0x43, 0x29, 0x35, 0x56, 0x8d, 0xf2, 0xb2, 0x3d, 0xc7,
+	0xac, 0xc4, 0xcf, 0x78, 0x2a, 0x54, 0x09, 0x46, 0x44, 0xcc, 0xbf, 0x63, 0xc0, 0xe5, 0xbc, 0x05,
+	0x73, 0xaa, 0x4e, 0xce, 0xcf, 0xc3, 0x8c, 0xd7, 0x0d, 0x9b, 0x56, 0x48, 0x9b, 0x9c, 0x96, 0x32,
+	0xff, 0xe0, 0x56, 0x30, 0xf7, 0x13, 0x10, 0x4c, 0xd5, 0x34, 0x59, 0x34, 0x40, 0xfe, 0xa7, 0x76,
+	0x60, 0xb3, 0x9c, 0x03, 0xbe, 0x17, 0xc6, 0x5d, 0x2a, 0x7b, 0x87, 0x4e, 0xe1, 0x55, 0x82, 0x80,
+	0xd0, 0x7f, 0xa8, 0x5f, 0x18, 0xd1, 0x32, 0xbf, 0x38, 0x0a, 0xd5, 0xa2, 0x46, 0x2c, 0x86, 0xd3,
+	0xd5, 0x46, 0x7c, 0x7f, 0x63, 0xc1, 0x6c, 0x3c, 0xdf, 0x0e, 0x6d, 0x69, 0xb8, 0x55, 0x52, 0xb1,
+	0x55, 0x5b, 0x8c, 0x7a, 0xc5, 0x93, 0x6b, 0xd4, 0x72, 0x29, 0x60, 0x01, 0x65, 0x96, 0xda, 0xf7,
+	0x41, 0x9c, 0xcd, 0xab, 0x52, 0x3e, 0xb5, 0x2f, 0xff, 0x6c, 0x2d, 0xe3, 0x97, 0xea, 0x54, 0x14,
+	0x35, 0x54, 0x96, 0x6b, 0xe4, 0x18, 0xf1, 0x20, 0xd8, 0xbd, 0x4b, 0x0f, 0x3a, 0x96, 0xad, 0xcc,
+	0x73, 0xca, 0x13, 0xaf, 0xd7, 0xef, 0x48, 0x54, 0x49, 0xe2, 0x5a, 0xb9, 0x46, 0x8e, 0x7b, 0xb8,
+	0x78, 0x7a, 0x48, 0xa7, 0x41, 0xac, 0x9f, 0x73, 0x63, 0x43, 0x89, 0x4b, 0x73, 0x12, 0x94, 0x24,
+	0xc9, 0xd6, 0xc4, 0x6c, 0x90, 0x16, 0x52, 0xa5, 0x18, 0xb3, 0x5e, 0xee, 0x3a, 0x53, 0x20, 0xf1,
+	0x0a, 0x05, 0x5c, 0x16, 0x9c, 0x25, 0xcf, 0x3b, 0x45, 0xc3, 0x46, 0x73, 0xc5, 0x6d, 0xf8, 0x07,
+	0x3c, 0xe4, 0x07, 0xeb, 0xd4, 0x68, 0xf9, 0x4e, 0xad, 0x6c, 0xd6, 0x96, 0x13, 0xc8, 0x92, 0x9d,
+	0xca, 0x82, 0xb3, 0xe4, 0x59, 0x2a, 0x96, 0x6b, 0x05, 0x6b, 0xec, 0x2f, 0x4d, 0x0c, 0x2e, 0xe6,
+	0x9d, 0xcb, 0xc7, 0xe0, 0x2d, 0xe2, 0x9d, 0x2b, 0x0e, 0x8e, 0xfc, 0xa3, 0xf7, 0x57, 0x99, 0x07,
+	0x40, 0x3a, 0xad, 0x53, 0x5f, 0xce, 0x57, 0xe7, 0x66, 0x60, 0xf9, 0x55, 0x71, 0x0a, 0xc7, 0xa1,
+	0x38, 0x52, 0x4d, 0x3a, 0x7d, 0xa3, 0xf9, 0x32, 0x4c, 0x27, 0x8c, 0x58, 0xa3, 0x78, 0xa9, 0x46,
+	0x6e, 0xbc, 0x54, 0x3d, 0x1c, 0x6a, 0xa5, 0x57, 0x38, 0xd4, 0x78, 0xc9, 0x67, 0x39, 0xdb, 0x5f,
+	0x9a, 0x25, 0xff, 0x5f, 0x89, 0x5c, 0xf2, 0x5c, 0xfc, 0x79, 0x0d, 0x46, 0x79, 0xf0, 0x55, 0x75,
+	0x62, 0x3e, 0x5f, 0x3a, 0xa8, 0x6b, 0x20, 0x74, 0x27, 0xe2, 0x6f, 0x94, 0x58, 0xc9, 0x72, 0x32,
+	0xb2, 0xf0, 0xbd, 0x58, 0x4d, 0x93, 0x1b, 0x13, 0x98, 0x2f, 0xcb, 0x4c, 0x0b, 0x82, 0xe2, 0x4d,
+	0x51, 0x9c, 0x67, 0xa5, 0x92, 0xfa, 0xb0, 0xf7, 0xc4, 0xb1, 0xc4, 0x5b, 0xe2, 0x1b, 0x00, 0x54,
+	0x2d, 0x5e, 0xe5, 0xfb, 0xfb, 0x42, 0xb9, 0x74, 0x45, 0xd1, 0x16, 0x50, 0x97, 0x9a, 0xa8, 0x28,
+	0x40, 0x8d, 0x08, 0xf1, 0x93, 0x31, 0x75, 0x46, 0xca, 0x5f, 0x0a, 0xfb, 0x0e, 0xa6, 0x43, 0xfc,
+	0x44, 0xfc, 0xf2, 0xd1, 0xf2, 0x62, 0x51, 0xfc, 0xd2, 0x14, 0x7f, 0x67, 0x41, 0xec, 0x72, 0x17,
+	0xc0, 0x8d, 0xa2, 0x2e, 0x0f, 0xf2, 0xc6, 0x18, 0xc7, 0x6e, 0x16, 0x82, 0x47, 0xfc, 0x1b, 0x35,
+	0x0a, 0x6c, 0x5c, 0xf5, 0x30, 0x49, 0xe3, 0xe5, 0xc7, 0xb5, 0xff, 0xf8, 0x48, 0x2e, 0x40, 0x3b,
+	0xca, 0xec, 0x50, 0x9d, 0x28, 0xff, 0x8d, 0x71, 0x7e, 0x08, 0xf1, 0x8d, 0xf1, 0x6f, 0xd4, 0x28,
+	0xb0, 0xf7, 0xd4, 0xe8, 0x29, 0x1a, 0xca, 0xeb, 0x9c, 0xfb, 0x7a, 0x86, 0x7e, 0x7f, 0xac, 0x7a,
+	0x9d, 0xe4, 0x7b, 0xf5, 0x71, 0x4d, 0xed, 0xca, 0x33, 0x5e, 0x30, 0xfe, 0x91, 0x51, 0xc3, 0xc6,
+	0xe6, 0xf3, 0x53, 0x3d, 0xcd, 0xe7, 0x6b, 0x30, 0x2b, 0xbc, 0x48, 0xa4, 0x3b, 0x17, 0x67, 0x0a,
+	0xd3, 0xf1, 0x9b, 0x66, 0x3d, 0x0d, 0xc4, 0x6c, 0x7d, 0xc1, 0xf4, 0x69, 0x93, 0xb7, 0x9d, 0xd1,
+	0x99, 0xbe, 0x28, 0xc3, 0x08, 0x4a, 0xf6, 0x60, 0x2a, 0xd0, 0x6c, 0xf1, 0xab, 0x17, 0x06, 0x7d,
+	0x8d, 0x16, 0x78, 0x44, 0x38, 0x5a, 0xbd, 0x04, 0x13, 0x74, 0xc8, 0xc7, 0x74, 0xe3, 0xe3, 0x8b,
+	0x83, 0xe5, 0x3d, 0xc8, 0x66, 0xf2, 0x88, 0x75, 0xea, 0x0a, 0x14, 0xe8, 0x36, 0xc1, 0xdd, 0xa4,
+	0x99, 0xed, 0xec, 0xa9, 0x84, 0x0b, 0x3a, 0xd6, 0x0c, 0x97, 0x4d, 0x2d, 0xdd, 0xef, 0x78, 0x01,
+	0x8b, 0x90, 0xe3, 0x58, 0x41, 0xc0, 0xa7, 0x87, 0xc4, 0x53, 0xbb, 0x92, 0x06, 0x62, 0xb6, 0x3e,
+	0xf9, 0x5e, 0x03, 0x2e, 0x06, 0x07, 0x41, 0x48, 0xdb, 0xec, 0xe8, 0xf2, 0x5c, 0xca, 0x0c, 0x22,
+	0x2e, 0x95, 0x0f, 0x47, 0x5f, 0x4f, 0xe1, 0x12, 0xd9, 0xdd, 0xd3, 0xa5, 0x98, 0xa1, 0xc9, 0x56,
+	0x8e, 0x1e, 0x70, 0xa8, 0x7a, 0xb9, 0xfc, 0xca, 0xd1, 0x83, 0x19, 0x89, 0x95, 0xa3, 0x97, 0x60,
+	0x82, 0x0e, 0xf3, 0xdd, 0x50, 0x39, 0x78, 0x7c, 0x3e, 0x82, 0x57, 0xe2, 0x98, 0xbe, 0x75, 0x1d,
+	0x80, 0xc9, 0x7a, 0xe4, 0xdb, 0x61, 0x4a, 0x3f, 0x3b, 0xab, 0x57, 0x4f, 0x3b, 0xc5, 0x80, 0xe8,
+	0xb9, 0x0e, 0x4a, 0x10, 0x24, 0xfb, 0x30, 0xb1, 0xad, 0xd4, 0x1a, 0xd5, 0x6b, 0x03, 0x5e, 0x3f,
+	0xb3, 0x2a, 0x24, 0xa1, 0xde, 0x89, 0xcb, 0x63, 0x62, 0x3d, 0xe2, 0xf8, 0x55, 0xbf, 0xec, 0x71,
+	0xfc, 0xcc, 0x7f, 0xc5, 0x1e, 0xf3, 0x94, 0x1e, 0xf7, 0x3c, 0x5e, 0x27, 0x9b, 0x09, 0x05, 0xea,
+	0xd2, 0x40, 0x7a, 0xe7, 0xc2, 0x64, 0x36, 0xe6, 0xef, 0x18, 0x30, 0x13, 0x57, 0x3b, 0x87, 0x2b,
+	0x54, 0x23, 0x79, 0x85, 0xfa, 0xd0, 0x60, 0xdf, 0x55, 0x70, 0x8f, 0xfa, 0xb3, 0x8a, 0xfe, 0x55,
+	0x5c, 0x4a, 0xde, 0x4b, 0x58, 0xfb, 0x94, 0xce, 0xb8, 0x19, 0xd9, 0xf7, 0x68, 0x41, 0x1d, 0xe2,
+	0xef, 0xcd, 0xb1, 0xfe, 0xf9, 0x7f, 0x12, 0x32, 0xea, 0x00, 0xa1, 0x7a, 0x22, 0x81, 0x54, 0x91,
+	0x16, 0x03, 0x70, 0x9c, 0xc0, 0xfa, 0x86, 0x7e, 0x84, 0x0d, 0x90, 0x80, 0x26, 0xf1, 0xc1, 0x3d,
+	0x0f, 0x2e, 0xf3, 0xd3, 0x17, 0x60, 0x52, 0x7b, 0xf2, 0x48, 0xd9, 0x2e, 0x19, 0xe7, 0x61, 0xbb,
+	0x14, 0xc2, 0x64, 0x23, 0xca, 0xb2, 0xa9, 0x86, 0x7d, 0x40, 0x9a, 0xd1, 0xd1, 0x19, 0xe7, 0xef,
+	0x0c, 0x50, 0x27, 0xc3, 0x04, 0xbc, 0x68, 0x8d, 0x0d, 0x9d, 0x82, 0x45, 0x59, 0xaf, 0x75, 0xf5,
+	0x3e, 0x00, 0x75, 0x47, 0xa0, 0x4d, 0x99, 0xed, 0x20, 0x72, 0xde, 0x59, 0x0d, 0xee, 0x44, 0x30,
+	0xd4, 0xea, 0x65, 0x6d, 0x61, 0x46, 0xce, 0xcd, 0x16, 0x86, 0x2d, 0x03, 0x47, 0x25, 0xa7, 0x1f,
+	0xc8, 0x3a, 0x32, 0x4a, 0x71, 0x1f, 0x2f, 0x83, 0xa8, 0x28, 0x40, 0x8d, 0x48, 0x81, 0x3e, 0x7e,
+	0xac, 0x94, 0x3e, 0xbe, 0x0b, 0x97, 0x7c, 0x1a, 0xfa, 0x07, 0xb5, 0x83, 0x06, 0xcf, 0xba, 0xe3,
+	0x87, 0xfc, 0xa6, 0x3f, 0x5e, 0x2e, 0x7e, 0x28, 0x66, 0x51, 0x61, 0x1e, 0xfe, 0x84, 0x90, 0x3c,
+	0xd1, 0x53, 0x48, 0x7e, 0x3f, 0x4c, 0x86, 0xb4, 0xb1, 0xeb, 0xda, 0x0d, 0xcb, 0x59, 0x5d, 0x96,
+	0xa9, 0x00, 0x62, 0x79, 0x2f, 0x06, 0xa1, 0x5e, 0x8f, 0x2c, 0xc1, 0x50, 0xd7, 0x6e, 0xca, 0x5b,
+	0xc2, 0xd7, 0x46, 0x8f, 0x87, 0xab, 0xcb, 0x8f, 0x0e, 0xe7, 0xdf, 0x1e, 0xdb, 0x84, 0x45, 0x5f,
+	0x75, 0xb3, 0xf3, 0xa0, 0x75, 0x93, 0xb9, 0xf5, 0x06, 0x0b, 0x5b, 0xab, 0xcb, 0xc8, 0x1a, 0xe7,
+	0x99, 0xf7, 0x4d, 0x9d, 0xc0, 0xbc, 0xef, 0xb3, 0x06, 0x5c, 0xb2, 0xd2, 0xef, 0x9e, 0x34, 0xa8,
+	0x4e, 0x97, 0xe7, 0x96, 0xf9, 0x6f, 0xa9, 0x4b, 0x8f, 0xcb, 0xef, 0xbb, 0xb4, 0x98, 0x25, 0x87,
+	0x79, 0x7d, 0x60, 0xfa, 0x9d, 0xb6, 0xdd, 0x8a, 0xf2, 0xc4, 0xcb, 0x59, 0x9f, 0x29, 0xa7, 0xdf,
+	0x59, 0xcf, 0x60, 0xc2, 0x1c, 0xec, 0xe4, 0x21, 0x4c, 0x36, 0xe2, 0xb7, 0x92, 0xea, 0x85, 0x01,
+	0xe4, 0xe6, 0xd4, 0xbb, 0x8b, 0xb8, 0x11, 0x6b, 0x05, 0xa8, 0x53, 0x8a, 0xec, 0x1a, 0x34, 0x55,
+	0x84, 0x7c, 0xdb, 0xe7, 0x5f, 0x7d, 0xb1, 0xbc, 0x5d, 0x43, 0x3e, 0x46, 0xec, 0x41, 0x8d, 0x47,
+	0xcc, 0x64, 0x60, 0xed, 0xfe, 0x5e, 0x9d, 0x2d, 0x1f, 0x9f, 0x61, 0x2d, 0x89, 0x4a, 0x2c, 0xcd,
+	0x54, 0x21, 0xa6, 0x09, 0x92, 0x5b, 0x40, 0xa8, 0x50, 0xb9, 0xc7, 0x17, 0xb8, 0xa0, 0x4a, 0xf8,
+	0x4b, 0x1c, 0x9f, 0xd2, 0x95, 0x0c, 0x14, 0x73, 0x5a, 0x30, 0xc6, 0x9b, 0x08, 0x48, 0x5b, 0xbd,
+	0x54, 0x9e, 0xf1, 0x26, 0x03, 0xe0, 0x72, 0xc6, 0x9b, 0x28, 0xc2, 0x24, 0x29, 0xf3, 0xb7, 0x0d,
+	0xa9, 0x8c, 0x3d, 0x47, 0xdb, 0xba, 0xb3, 0x36, 0xcc, 0x30, 0xff, 0x33, 0x7b, 0xe2, 0x4c, 0xdf,
+	0xf6, 0xb6, 0x99, 0x9f, 0xb3, 0x4f, 0x59, 0xfe, 0x1f, 0xa3, 0xbc, 0x15, 0x79, 0x4d, 0xa0, 0x10,
+	0x9a, 0x6d, 0xf9, 0x03, 0x15, 0x62, 0x76, 0xa3, 0x74, 0xb5, 0x8c, 0x4a, 0xf2, 0x0b, 0x4b, 0xc9,
+	0x54, 0x7a, 0x66, 0x26, 0x71, 0x2f, 0xd3, 0x4b, 0x30, 0x41, 0xc7, 0x5c, 0x03, 0x88, 0xef, 0xec,
+	0x03, 0x9b, 0x5b, 0x7e, 0x4f, 0x05, 0x2e, 0xe7, 0x65, 0x8f, 0xe7, 0x4e, 0x70, 0x5a, 0xda, 0x5e,
+	0xcd, 0x66, 0x2f, 0xad, 0xe3, 0x27, 0xaf, 0xc3, 0xc8, 0x43, 0x6b, 0x6f, 0xb0, 0x28, 0x78, 0x99,
+	0x88, 0x5d, 0xb1, 0x44, 0xcf, 0xca, 0x02, 0x14, 0x24, 0x58, 0x84, 0xd2, 0x86, 0x50, 0x82, 0xd3,
+	0xa6, 0x34, 0xda, 0x8b, 0x23, 0x81, 0x29, 0x00, 0xc6, 0x75, 0xd8, 0x3b, 0x45, 0x9b, 0x06, 0x3c,
+	0xc8, 0xa0, 0x16, 0x51, 0x7f, 0x5d, 0x14, 0xa1, 0x82, 0x99, 0xff, 0x68, 0x14, 0xae, 0x0c, 0xea,
+	0x70, 0xc7, 0x38, 0xd5, 0x55, 0xba, 0x67, 0x37, 0xc2, 0xc5, 0x9d, 0x90, 0xfa, 0xf7, 0xef, 0xaf,
+	0x6f, 0xee, 0xfa, 0x34, 0xd8, 0xf5, 0x9c, 0x66, 0x3f, 0x46, 0xb6, 0x39, 0x16, 0x81, 0xfc, 0xd1,
+	0x7a, 0x25, 0x17, 0x23, 0x16, 0x50, 0xe2, 0x7a, 0x1b, 0x06, 0x61, 0x53, 0xc4, 0x2e, 0x06, 0x5d,
+	0x3f, 0x08, 0x65, 0xcc, 0x34, 0xa1, 0xb7, 0x49, 0x03, 0x31, 0x5b, 0x3f, 0x8d, 0x84, 0x07, 0x88,
+	0xe4, 0xe3, 0x67, 0x64, 0x91, 0x70, 0x20, 0x66, 0xeb, 0xeb, 0x48, 0xc4, 0x8a, 0x65, 0x9c, 0x7b,
+	0x24, 0x8b, 0x24, 0x02, 0x62, 0xb6, 0x3e, 0x69, 0xc2, 0x13, 0x3e, 0x6d, 0x78, 0xed, 0x36, 0x75,
+	0x9b, 0x7c, 0x50, 0xd6, 0x2d, 0xbf, 0x65, 0xbb, 0xb7, 0x7c, 0xab, 0x11, 0xa5, 0x1c, 0x36, 0x78,
+	0x32, 0xdf, 0x27, 0xb0, 0x47, 0x3d, 0xec, 0x89, 0x85, 0xb4, 0xe1, 0x42, 0x97, 0x27, 0xae, 0xf6,
+	0x57, 0xdd, 0x90, 0x3d, 0x41, 0x3b, 0xd5, 0xb1, 0x52, 0x33, 0xc6, 0x4f, 0x93, 0xad, 0x24, 0x2a,
+	0x4c, 0xe3, 0x26, 0x07, 0x70, 0x29, 0xea, 0x8e, 0x46, 0x72, 0xbc, 0x14, 0x49, 0x29, 0x47, 0x66,
+	0xd0, 0x61, 0x1e, 0x0d, 0x16, 0x7b, 0x36, 0xb4, 0xfc, 0x16, 0x0d, 0x6b, 0x1b, 0x5b, 0x1b, 0xd4,
+	0x6f, 0xb0, 0x23, 0xdf, 0x11, 0x22, 0xa5, 0x21, 0x50, 0x6d, 0x66, 0xc1, 0x98, 0xd7, 0xc6, 0xfc,
+	0xac, 0x01, 0xd2, 0x55, 0x88, 0xbd, 0xea, 0x69, 0x4f, 0x93, 0xe3, 0xa9, 0x67, 0x49, 0x95, 0xac,
+	0xb1, 0x92, 0x9b, 0xac, 0xf1, 0x1d, 0x5a, 0x5c, 0xbf, 0x89, 0xf8, 0x38, 0x11, 0x98, 0xb5, 0x2c,
+	0xf2, 0xef, 0x82, 0x89, 0xe8, 0x40, 0x95, 0x17, 0x1d, 0xae, 0x43, 0x8a, 0x4f, 0xde, 0x18, 0x6e,
+	0xfe, 0x64, 0x05, 0x20, 0x4e, 0xdc, 0xd9, 0x5f, 0xee, 0xfb, 0x63, 0x6d, 0x8f, 0x99, 0x89, 0xb1,
+	0xc8, 0xfa, 0x2f, 0x59, 0x0f, 0x7f, 0x26, 0xdb, 0xe2, 0x25, 0x28, 0x21, 0x67, 0x94, 0xca, 0x9e,
+	0xbc, 0x0a, 0x22, 0xbb, 0xbf, 0xbc, 0xa9, 0x3d, 0x57, 0xee, 0xc5, 0xc0, 0x6e, 0xc8, 0x6c, 0xe4,
+	0xfc, 0x4f, 0x14, 0x28, 0xcd, 0x9f, 0x33, 0xe0, 0x42, 0x32, 0x88, 0x63, 0xc0, 0xf8, 0xa6, 0x0c,
+	0x57, 0x2f, 0xe3, 0x12, 0xf3, 0x6e, 0xc9, 0x48, 0x43, 0xa8, 0x60, 0x49, 0xcd, 0xf8, 0x00, 0x5a,
+	0x8d, 0xfc, 0x58, 0x92, 0xc7, 0x28, 0x18, 0x3e, 0x3b, 0x0b, 0xa3, 0x22, 0xd6, 0x39, 0x63, 0xbd,
+	0x39, 0x11, 0x16, 0xee, 0x96, 0x0f, 0xa9, 0x5e, 0xc6, 0x2d, 0x5e, 0x4f, 0x0c, 0x58, 0xe9, 0x99,
+	0x18, 0x10, 0x61, 0xa8, 0xe1, 0xdb, 0x83, 0xbc, 0x82, 0xd6, 0x70, 0x55, 0xbc, 0x82, 0xd6, 0x70,
+	0x15, 0x19, 0x32, 0x12, 0x26, 0x9e, 0x07, 0x87, 0xcb, 0x5f, 0x16, 0xc4, 0x00, 0x68, 0x8f, 0x84,
+	0x33, 0x3d, 0x1f, 0x08, 0x55, 0x10, 0xd6, 0x91, 0xf2, 0x7e, 0x06, 0x72, 0xc8, 0xfb, 0x08, 0xc2,
+	0x1a, 0x6d, 0xd2, 0xd1, 0xc2, 0x4d, 0xba, 0x03, 0x63, 0x72, 0x9b, 0x55, 0xc7, 0xca, 0x0b, 0x7f,
+	0xd2, 0xf2, 0x42, 0x4b, 0x8b, 0x23, 0x0a, 0x50, 0x21, 0x67, 0x82, 0x41, 0xdb, 0xda, 0x67, 0x3e,
+	0x17, 0x9c, 0x71, 0x8f, 0xe8, 0x55, 0x79, 0x31, 0x2a, 0x38, 0xaf, 0x2a, 0xdc, 0x33, 0xaa, 0x13,
+	0xa9, 0xaa, 0xa2, 0x18, 0x15, 0x9c, 0xbc, 0x0a, 0xe3, 0x6d, 0x6b, 0xbf, 0xde, 0xf5, 0x5b, 0xb4,
+	0x0a, 0xc7, 0x88, 0xe4, 0xdd, 0xd0, 0x76, 0x16, 0x98, 0xc6, 0x29, 0xf4, 0x17, 0x56, 0xdd, 0xf0,
+	0xbe, 0x5f, 0x0f, 0xfd, 0x28, 0x99, 0xfc, 0xba, 0xc4, 0x82, 0x11, 0x3e, 0xe2, 0xc0, 0x4c, 0xdb,
+	0xda, 0xdf, 0x72, 0x2d, 0x11, 0x5f, 0xd7, 0x11, 0x6f, 0x82, 0x65, 0x28, 0x70, 0x0b, 0x91, 0xf5,
+	0x04, 0x2e, 0x4c, 0xe1, 0xce, 0x31, 0x46, 0x99, 0x3a, 0x2b, 0x63, 0x94, 0xc5, 0xc8, 0xd9, 0x56,
+	0xa8, 0x0a, 0x1e, 0xcb, 0x0d, 0x42, 0xd3, 0xd3, 0x91, 0xf6, 0xb5, 0xc8, 0x91, 0x76, 0xa6, 0xbc,
+	0xf5, 0x44, 0x0f, 0x27, 0xda, 0x2e, 0x4c, 0xb2, 0x0b, 0x91, 0x28, 0x65, 0x77, 0xf9, 0xd2, 0x5a,
+	0xef, 0xe5, 0x08, 0x4d, 0xcc, 0x92, 0xe2, 0xb2, 0x00, 0x75, 0x3a, 0xcc, 0xe1, 0x85, 0x6d, 0x56,
+	0x87, 0x86, 0x71, 0x95, 0x7b, 0x96, 0xbc, 0xc3, 0x4f, 0x88, 0xf7, 0x8f, 0xbb, 0x79, 0x15, 0x30,
+	0xbf, 0x5d, 0x1c, 0x30, 0x6d, 0x36, 0x3f, 0x60, 0x1a, 0xf9, 0xbe, 0xbc, 0x27, 0x3f, 0x72, 0xc3,
+	0x28, 0x7b, 0x32, 0x08, 0xde, 0x50, 0xfa, 0xe1, 0xef, 0x9f, 0x1a, 0x50, 0x95, 0xab, 0x4c, 0x3e,
+	0xd3, 0x39, 0xd4, 0x5f, 0xb7, 0x5c, 0xab, 0x45, 0xfd, 0xea, 0xa5, 0xf2, 0xf1, 0x11, 0xd6, 0x0b,
+	0x70, 0x46, 0x1e, 0xce, 0x4f, 0x1f, 0x1d, 0xce, 0xdf, 0x38, 0xae, 0x16, 0x16, 0xf6, 0x8d, 0xf8,
+	0x30, 0x16, 0x1c, 0x04, 0x8d, 0xd0, 0x09, 0xaa, 0x97, 0xf9, 0x62, 0xb9, 0x3d, 0x00, 0x67, 0xad,
+	0x0b, 0x4c, 0x82, 0xb5, 0xc6, 0xc9, 0xd8, 0x44, 0x29, 0x2a, 0x42, 0xe4, 0x6f, 0x18, 0x30, 0x2b,
+	0x95, 0x72, 0x5a, 0x14, 0x89, 0x2b, 0xe5, 0x8d, 0x84, 0x6b, 0x69, 0x64, 0xf7, 0x3b, 0x22, 0x93,
+	0x17, 0xbf, 0x00, 0x64, 0xa0, 0x98, 0xa5, 0x3e, 0x68, 0x98, 0x97, 0x01, 0xa2, 0x76, 0xcf, 0x3d,
+	0x0f, 0x53, 0xfa, 0xc0, 0x9d, 0xa4, 0xad, 0xf9, 0xe3, 0x06, 0x5c, 0x4c, 0x1f, 0xa4, 0x64, 0x17,
+	0xc6, 0xe4, 0xae, 0x1a, 0xc4, 0x40, 0x5d, 0xee, 0x57, 0x19, 0x62, 0x8d, 0xcb, 0x65, 0xb2, 0x08,
+	0x15, 0x7a, 0xdd, 0x3c, 0xaf, 0xd2, 0xc3, 0x3c, 0xef, 0x05, 0xb8, 0x9a, 0xbf, 0xbf, 0x98, 0xc4,
+	0x6c, 0x39, 0x8e, 0xf7, 0x50, 0x5e, 0x7a, 0xe3, 0x34, 0xdf, 0xac, 0x10, 0x05, 0xcc, 0xfc, 0x36,
+	0x48, 0xe7, 0x9a, 0x21, 0xaf, 0xc3, 0x44, 0x10, 0xec, 0x8a, 0x00, 0xd4, 0x55, 0x63, 0x00, 0xad,
+	0x8f, 0x8a, 0x62, 0x2d, 0x84, 0xfc, 0xe8, 0x27, 0xc6, 0xe8, 0x97, 0x5e, 0xf9, 0xc2, 0x97, 0xae,
+	0xbf, 0xed, 0xb7, 0xbe, 0x74, 0xfd, 0x6d, 0x5f, 0xfc, 0xd2, 0xf5, 0xb7, 0x7d, 0xc7, 0xd1, 0x75,
+	0xe3, 0x0b, 0x47, 0xd7, 0x8d, 0xdf, 0x3a, 0xba, 0x6e, 0x7c, 0xf1, 0xe8, 0xba, 0xf1, 0xef, 0x8e,
+	0xae, 0x1b, 0xdf, 0xff, 0xef, 0xaf, 0xbf, 0xed, 0xd5, 0x67, 0x63, 0xea, 0x37, 0x15, 0xd1, 0xf8,
+	0x0f, 0xa6, 0xc5, 0x66, 0xd4, 0x95, 0xaf, 0x33, 0xa7, 0xfe, 0x7f, 0x06, 0x00, 0xd7, 0xa6, 0x3f,
+	0x1c, 0x2f, 0x0c, 0x01, 0x00,
 }
 
 func (m *APIServerLogging) Marshal() (dAtA []byte, err error) {
@@ -6655,6 +7211,18 @@ func (m *BackupEntrySpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RetentionPolicy != nil {
+		{
+			size, err := m.RetentionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.SeedName != nil {
 		i -= len(*m.SeedName)
 		copy(dAtA[i:], *m.SeedName)
@@ -6739,6 +7307,56 @@ func (m *BackupEntryStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BackupRetentionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupRetentionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupRetentionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FullSnapshotsToKeep != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.FullSnapshotsToKeep))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LegalHold != nil {
+		i--
+		if *m.LegalHold {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RetentionAfterDeletion != nil {
+		{
+			size, err := m.RetentionAfterDeletion.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CARotation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6877,6 +7495,16 @@ func (m *CloudProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -7105,6 +7733,57 @@ func (m *CloudProfileSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CloudProfileStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CloudProfileStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CloudProfileStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MachineImages) > 0 {
+		for iNdEx := len(m.MachineImages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MachineImages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.KubernetesVersions) > 0 {
+		for iNdEx := len(m.KubernetesVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KubernetesVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ClusterAutoscaler) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8551,6 +9230,54 @@ func (m *EncryptionConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EstimatedCost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimatedCost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimatedCost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Monthly.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Hourly.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Currency)
+	copy(dAtA[i:], m.Currency)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Currency)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ExpirableVersion) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10933,6 +11660,48 @@ func (m *MachineImage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MachineImageRolloutStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MachineImageRolloutStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MachineImageRolloutStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MachineImageVersion) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11016,6 +11785,18 @@ func (m *MachineType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Price != nil {
+		{
+			size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Architecture != nil {
 		i -= len(*m.Architecture)
 		copy(dAtA[i:], *m.Architecture)
@@ -11238,6 +12019,84 @@ func (m *MaintenanceAutoUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MaintenanceFreezePeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceFreezePeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceFreezePeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Location != nil {
+		i -= len(*m.Location)
+		copy(dAtA[i:], *m.Location)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Location)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Duration != nil {
+		{
+			size, err := m.Duration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Schedule != nil {
+		i -= len(*m.Schedule)
+		copy(dAtA[i:], *m.Schedule)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Schedule)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.End != nil {
+		{
+			size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Begin != nil {
+		{
+			size, err := m.Begin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MaintenanceTimeWindow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12042,6 +12901,44 @@ func (m *OpenIDConnectClientAuthentication) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *Price) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Price) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Price) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Currency)
+	copy(dAtA[i:], m.Currency)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Currency)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Project) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12095,6 +12992,41 @@ func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProjectHibernation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectHibernation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectHibernation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IdleTimeout != nil {
+		{
+			size, err := m.IdleTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ProjectList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12142,6 +13074,43 @@ func (m *ProjectList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProjectMaintenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectMaintenance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectMaintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FreezePeriods) > 0 {
+		for iNdEx := len(m.FreezePeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FreezePeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ProjectMember) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12209,6 +13178,42 @@ func (m *ProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Hibernation != nil {
+		{
+			size, err := m.Hibernation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Maintenance != nil {
+		{
+			size, err := m.Maintenance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.BackupRetentionPolicy != nil {
+		{
+			size, err := m.BackupRetentionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Tolerations != nil {
 		{
 			size, err := m.Tolerations.MarshalToSizedBuffer(dAtA[:i])
@@ -12303,6 +13308,20 @@ func (m *ProjectStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EstimatedCosts) > 0 {
+		for iNdEx := len(m.EstimatedCosts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EstimatedCosts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.LastActivityTimestamp != nil {
 		{
 			size, err := m.LastActivityTimestamp.MarshalToSizedBuffer(dAtA[:i])
@@ -12499,6 +13518,16 @@ func (m *Quota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -12569,6 +13598,63 @@ func (m *QuotaList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QuotaProjectUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaProjectUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaProjectUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Usage) > 0 {
+		keysForUsage := make([]string, 0, len(m.Usage))
+		for k := range m.Usage {
+			keysForUsage = append(keysForUsage, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForUsage)
+		for iNdEx := len(keysForUsage) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Usage[k8s_io_api_core_v1.ResourceName(keysForUsage[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForUsage[iNdEx])
+			copy(dAtA[i:], keysForUsage[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForUsage[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QuotaSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12589,6 +13675,35 @@ func (m *QuotaSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SoftLimits) > 0 {
+		keysForSoftLimits := make([]string, 0, len(m.SoftLimits))
+		for k := range m.SoftLimits {
+			keysForSoftLimits = append(keysForSoftLimits, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForSoftLimits)
+		for iNdEx := len(keysForSoftLimits) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.SoftLimits[k8s_io_api_core_v1.ResourceName(keysForSoftLimits[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForSoftLimits[iNdEx])
+			copy(dAtA[i:], keysForSoftLimits[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForSoftLimits[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -12636,6 +13751,72 @@ func (m *QuotaSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QuotaStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProjectUsages) > 0 {
+		for iNdEx := len(m.ProjectUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProjectUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Usage) > 0 {
+		keysForUsage := make([]string, 0, len(m.Usage))
+		for k := range m.Usage {
+			keysForUsage = append(keysForUsage, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForUsage)
+		for iNdEx := len(keysForUsage) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Usage[k8s_io_api_core_v1.ResourceName(keysForUsage[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForUsage[iNdEx])
+			copy(dAtA[i:], keysForUsage[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForUsage[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Region) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12783,6 +13964,54 @@ func (m *ResourceWatchCacheSize) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *RolloutWaveStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutWaveStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutWaveStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.HealthySince.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.ReleasedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SSHAccess) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -14561,6 +15790,214 @@ func (m *ShootAdvertisedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ShootBlueprint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShootBlueprint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShootBlueprint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ShootBlueprintList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShootBlueprintList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShootBlueprintList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ShootBlueprintReference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShootBlueprintReference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShootBlueprintReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Overrides != nil {
+		{
+			size, err := m.Overrides.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ShootBlueprintSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShootBlueprintSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShootBlueprintSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ShootBlueprintStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShootBlueprintStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShootBlueprintStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OutdatedShoots) > 0 {
+		for iNdEx := len(m.OutdatedShoots) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OutdatedShoots[iNdEx])
+			copy(dAtA[i:], m.OutdatedShoots[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.OutdatedShoots[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *ShootCredentials) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -14936,6 +16373,34 @@ func (m *ShootSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BackupRetentionPolicy != nil {
+		{
+			size, err := m.BackupRetentionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.Blueprint != nil {
+		{
+			size, err := m.Blueprint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
 	if m.CloudProfile != nil {
 		{
 			size, err := m.CloudProfile.MarshalToSizedBuffer(dAtA[:i])
@@ -15355,6 +16820,20 @@ func (m *ShootStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EstimatedCost != nil {
+		{
+			size, err := m.EstimatedCost.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
 	if len(m.EncryptedResources) > 0 {
 		for iNdEx := len(m.EncryptedResources) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.EncryptedResources[iNdEx])
@@ -15671,6 +17150,63 @@ func (m *Toleration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VersionRolloutStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionRolloutStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionRolloutStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Message != nil {
+		i -= len(*m.Message)
+		copy(dAtA[i:], *m.Message)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Message)))
+		i--
+		dAtA[i] = 0x22
+	}
+	i--
+	if m.Completed {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	if len(m.Waves) > 0 {
+		for iNdEx := len(m.Waves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Waves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Version)
+	copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *VerticalPodAutoscaler) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15839,6 +17375,18 @@ func (m *VolumeType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Price != nil {
+		{
+			size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.MinSize != nil {
 		{
 			size, err := m.MinSize.MarshalToSizedBuffer(dAtA[:i])
@@ -16601,6 +18149,10 @@ func (m *BackupEntrySpec) Size() (n int) {
 		l = len(*m.SeedName)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.RetentionPolicy != nil {
+		l = m.RetentionPolicy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16630,6 +18182,25 @@ func (m *BackupEntryStatus) Size() (n int) {
 	return n
 }
 
+func (m *BackupRetentionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RetentionAfterDeletion != nil {
+		l = m.RetentionAfterDeletion.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.LegalHold != nil {
+		n += 2
+	}
+	if m.FullSnapshotsToKeep != nil {
+		n += 1 + sovGenerated(uint64(*m.FullSnapshotsToKeep))
+	}
+	return n
+}
+
 func (m *CARotation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16684,6 +18255,8 @@ func (m *CloudProfile) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Spec.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -16766,6 +18339,27 @@ func (m *CloudProfileSpec) Size() (n int) {
 	return n
 }
 
+func (m *CloudProfileStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.KubernetesVersions) > 0 {
+		for _, e := range m.KubernetesVersions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.MachineImages) > 0 {
+		for _, e := range m.MachineImages {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ClusterAutoscaler) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17317,6 +18911,21 @@ func (m *EncryptionConfig) Size() (n int) {
 	return n
 }
 
+func (m *EstimatedCost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Currency)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Hourly.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Monthly.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ExpirableVersion) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18206,6 +19815,23 @@ func (m *MachineImage) Size() (n int) {
 	return n
 }
 
+func (m *MachineImageRolloutStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MachineImageVersion) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18258,6 +19884,10 @@ func (m *MachineType) Size() (n int) {
 		l = len(*m.Architecture)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -18315,6 +19945,37 @@ func (m *MaintenanceAutoUpdate) Size() (n int) {
 	return n
 }
 
+func (m *MaintenanceFreezePeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Begin != nil {
+		l = m.Begin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.End != nil {
+		l = m.End.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Schedule != nil {
+		l = len(*m.Schedule)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Duration != nil {
+		l = m.Duration.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Location != nil {
+		l = len(*m.Location)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *MaintenanceTimeWindow) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18630,6 +20291,19 @@ func (m *OpenIDConnectClientAuthentication) Size() (n int) {
 	return n
 }
 
+func (m *Price) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Currency)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Project) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18645,6 +20319,19 @@ func (m *Project) Size() (n int) {
 	return n
 }
 
+func (m *ProjectHibernation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IdleTimeout != nil {
+		l = m.IdleTimeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *ProjectList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18662,6 +20349,21 @@ func (m *ProjectList) Size() (n int) {
 	return n
 }
 
+func (m *ProjectMaintenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FreezePeriods) > 0 {
+		for _, e := range m.FreezePeriods {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ProjectMember) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18717,6 +20419,18 @@ func (m *ProjectSpec) Size() (n int) {
 		l = m.Tolerations.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.BackupRetentionPolicy != nil {
+		l = m.BackupRetentionPolicy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Maintenance != nil {
+		l = m.Maintenance.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Hibernation != nil {
+		l = m.Hibernation.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -18741,6 +20455,12 @@ func (m *ProjectStatus) Size() (n int) {
 		l = m.LastActivityTimestamp.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.EstimatedCosts) > 0 {
+		for _, e := range m.EstimatedCosts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -18804,6 +20524,8 @@ func (m *Quota) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Spec.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -18824,6 +20546,26 @@ func (m *QuotaList) Size() (n int) {
 	return n
 }
 
+func (m *QuotaProjectUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Usage) > 0 {
+		for k, v := range m.Usage {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *QuotaSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18844,6 +20586,39 @@ func (m *QuotaSpec) Size() (n int) {
 	}
 	l = m.Scope.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.SoftLimits) > 0 {
+		for k, v := range m.SoftLimits {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QuotaStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Usage) > 0 {
+		for k, v := range m.Usage {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ProjectUsages) > 0 {
+		for _, e := range m.ProjectUsages {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -18901,6 +20676,21 @@ func (m *ResourceWatchCacheSize) Size() (n int) {
 	return n
 }
 
+func (m *RolloutWaveStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.ReleasedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.HealthySince.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *SSHAccess) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19546,6 +21336,80 @@ func (m *ShootAdvertisedAddress) Size() (n int) {
 	return n
 }
 
+func (m *ShootBlueprint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ShootBlueprintList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ShootBlueprintReference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Overrides != nil {
+		l = m.Overrides.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ShootBlueprintSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Template.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ShootBlueprintStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
+	if len(m.OutdatedShoots) > 0 {
+		for _, s := range m.OutdatedShoots {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ShootCredentials) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19771,6 +21635,14 @@ func (m *ShootSpec) Size() (n int) {
 		l = m.CloudProfile.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.Blueprint != nil {
+		l = m.Blueprint.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.BackupRetentionPolicy != nil {
+		l = m.BackupRetentionPolicy.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -19907,6 +21779,10 @@ func (m *ShootStatus) Size() (n int) {
 			n += 2 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.EstimatedCost != nil {
+		l = m.EstimatedCost.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -19955,6 +21831,28 @@ func (m *Toleration) Size() (n int) {
 	return n
 }
 
+func (m *VersionRolloutStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Waves) > 0 {
+		for _, e := range m.Waves {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
+	if m.Message != nil {
+		l = len(*m.Message)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *VerticalPodAutoscaler) Size() (n int) {
 	if m == nil {
 		return 0
@@ -20031,6 +21929,10 @@ func (m *VolumeType) Size() (n int) {
 		l = m.MinSize.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -20398,6 +22300,7 @@ func (this *BackupEntrySpec) String() string {
 	s := strings.Join([]string{`&BackupEntrySpec{`,
 		`BucketName:` + fmt.Sprintf("%v", this.BucketName) + `,`,
 		`SeedName:` + valueToStringGenerated(this.SeedName) + `,`,
+		`RetentionPolicy:` + strings.Replace(this.RetentionPolicy.String(), "BackupRetentionPolicy", "BackupRetentionPolicy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20416,6 +22319,18 @@ func (this *BackupEntryStatus) String() string {
 	}, "")
 	return s
 }
+func (this *BackupRetentionPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BackupRetentionPolicy{`,
+		`RetentionAfterDeletion:` + strings.Replace(fmt.Sprintf("%v", this.RetentionAfterDeletion), "Duration", "v11.Duration", 1) + `,`,
+		`LegalHold:` + valueToStringGenerated(this.LegalHold) + `,`,
+		`FullSnapshotsToKeep:` + valueToStringGenerated(this.FullSnapshotsToKeep) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *CARotation) String() string {
 	if this == nil {
 		return "nil"
@@ -20453,6 +22368,7 @@ func (this *CloudProfile) String() string {
 	s := strings.Join([]string{`&CloudProfile{`,
 		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "CloudProfileSpec", "CloudProfileSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "CloudProfileStatus", "CloudProfileStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20522,6 +22438,27 @@ func (this *CloudProfileSpec) String() string {
 	}, "")
 	return s
 }
+func (this *CloudProfileStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForKubernetesVersions := "[]VersionRolloutStatus{"
+	for _, f := range this.KubernetesVersions {
+		repeatedStringForKubernetesVersions += strings.Replace(strings.Replace(f.String(), "VersionRolloutStatus", "VersionRolloutStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForKubernetesVersions += "}"
+	repeatedStringForMachineImages := "[]MachineImageRolloutStatus{"
+	for _, f := range this.MachineImages {
+		repeatedStringForMachineImages += strings.Replace(strings.Replace(f.String(), "MachineImageRolloutStatus", "MachineImageRolloutStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMachineImages += "}"
+	s := strings.Join([]string{`&CloudProfileStatus{`,
+		`KubernetesVersions:` + repeatedStringForKubernetesVersions + `,`,
+		`MachineImages:` + repeatedStringForMachineImages + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ClusterAutoscaler) String() string {
 	if this == nil {
 		return "nil"
@@ -20887,6 +22824,18 @@ func (this *EncryptionConfig) String() string {
 	}, "")
 	return s
 }
+func (this *EstimatedCost) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EstimatedCost{`,
+		`Currency:` + fmt.Sprintf("%v", this.Currency) + `,`,
+		`Hourly:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Hourly), "Quantity", "resource.Quantity", 1), `&`, ``, 1) + `,`,
+		`Monthly:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Monthly), "Quantity", "resource.Quantity", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ExpirableVersion) String() string {
 	if this == nil {
 		return "nil"
@@ -21456,6 +23405,22 @@ func (this *MachineImage) String() string {
 	}, "")
 	return s
 }
+func (this *MachineImageRolloutStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVersions := "[]VersionRolloutStatus{"
+	for _, f := range this.Versions {
+		repeatedStringForVersions += strings.Replace(strings.Replace(f.String(), "VersionRolloutStatus", "VersionRolloutStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForVersions += "}"
+	s := strings.Join([]string{`&MachineImageRolloutStatus{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Versions:` + repeatedStringForVersions + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *MachineImageVersion) String() string {
 	if this == nil {
 		return "nil"
@@ -21486,6 +23451,7 @@ func (this *MachineType) String() string {
 		`Storage:` + strings.Replace(this.Storage.String(), "MachineTypeStorage", "MachineTypeStorage", 1) + `,`,
 		`Usable:` + valueToStringGenerated(this.Usable) + `,`,
 		`Architecture:` + valueToStringGenerated(this.Architecture) + `,`,
+		`Price:` + strings.Replace(this.Price.String(), "Price", "Price", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21526,6 +23492,21 @@ func (this *MaintenanceAutoUpdate) String() string {
 	}, "")
 	return s
 }
+func (this *MaintenanceFreezePeriod) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MaintenanceFreezePeriod{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Begin:` + strings.Replace(fmt.Sprintf("%v", this.Begin), "Time", "v11.Time", 1) + `,`,
+		`End:` + strings.Replace(fmt.Sprintf("%v", this.End), "Time", "v11.Time", 1) + `,`,
+		`Schedule:` + valueToStringGenerated(this.Schedule) + `,`,
+		`Duration:` + strings.Replace(fmt.Sprintf("%v", this.Duration), "Duration", "v11.Duration", 1) + `,`,
+		`Location:` + valueToStringGenerated(this.Location) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *MaintenanceTimeWindow) String() string {
 	if this == nil {
 		return "nil"
@@ -21755,6 +23736,17 @@ func (this *OpenIDConnectClientAuthentication) String() string {
 	}, "")
 	return s
 }
+func (this *Price) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Price{`,
+		`Currency:` + fmt.Sprintf("%v", this.Currency) + `,`,
+		`Amount:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Amount), "Quantity", "resource.Quantity", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Project) String() string {
 	if this == nil {
 		return "nil"
@@ -21767,6 +23759,16 @@ func (this *Project) String() string {
 	}, "")
 	return s
 }
+func (this *ProjectHibernation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectHibernation{`,
+		`IdleTimeout:` + strings.Replace(fmt.Sprintf("%v", this.IdleTimeout), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ProjectList) String() string {
 	if this == nil {
 		return "nil"
@@ -21783,6 +23785,21 @@ func (this *ProjectList) String() string {
 	}, "")
 	return s
 }
+func (this *ProjectMaintenance) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFreezePeriods := "[]MaintenanceFreezePeriod{"
+	for _, f := range this.FreezePeriods {
+		repeatedStringForFreezePeriods += strings.Replace(strings.Replace(f.String(), "MaintenanceFreezePeriod", "MaintenanceFreezePeriod", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForFreezePeriods += "}"
+	s := strings.Join([]string{`&ProjectMaintenance{`,
+		`FreezePeriods:` + repeatedStringForFreezePeriods + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ProjectMember) String() string {
 	if this == nil {
 		return "nil"
@@ -21812,6 +23829,9 @@ func (this *ProjectSpec) String() string {
 		`Members:` + repeatedStringForMembers + `,`,
 		`Namespace:` + valueToStringGenerated(this.Namespace) + `,`,
 		`Tolerations:` + strings.Replace(this.Tolerations.String(), "ProjectTolerations", "ProjectTolerations", 1) + `,`,
+		`BackupRetentionPolicy:` + strings.Replace(this.BackupRetentionPolicy.String(), "BackupRetentionPolicy", "BackupRetentionPolicy", 1) + `,`,
+		`Maintenance:` + strings.Replace(this.Maintenance.String(), "ProjectMaintenance", "ProjectMaintenance", 1) + `,`,
+		`Hibernation:` + strings.Replace(this.Hibernation.String(), "ProjectHibernation", "ProjectHibernation", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21820,12 +23840,18 @@ func (this *ProjectStatus) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForEstimatedCosts := "[]EstimatedCost{"
+	for _, f := range this.EstimatedCosts {
+		repeatedStringForEstimatedCosts += strings.Replace(strings.Replace(f.String(), "EstimatedCost", "EstimatedCost", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForEstimatedCosts += "}"
 	s := strings.Join([]string{`&ProjectStatus{`,
 		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
 		`StaleSinceTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.StaleSinceTimestamp), "Time", "v11.Time", 1) + `,`,
 		`StaleAutoDeleteTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.StaleAutoDeleteTimestamp), "Time", "v11.Time", 1) + `,`,
 		`LastActivityTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.LastActivityTimestamp), "Time", "v11.Time", 1) + `,`,
+		`EstimatedCosts:` + repeatedStringForEstimatedCosts + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21877,6 +23903,7 @@ func (this *Quota) String() string {
 	s := strings.Join([]string{`&Quota{`,
 		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "QuotaSpec", "QuotaSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "QuotaStatus", "QuotaStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21897,6 +23924,27 @@ func (this *QuotaList) String() string {
 	}, "")
 	return s
 }
+func (this *QuotaProjectUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForUsage := make([]string, 0, len(this.Usage))
+	for k := range this.Usage {
+		keysForUsage = append(keysForUsage, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUsage)
+	mapStringForUsage := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForUsage {
+		mapStringForUsage += fmt.Sprintf("%v: %v,", k, this.Usage[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForUsage += "}"
+	s := strings.Join([]string{`&QuotaProjectUsage{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Usage:` + mapStringForUsage + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *QuotaSpec) String() string {
 	if this == nil {
 		return "nil"
@@ -21911,10 +23959,47 @@ func (this *QuotaSpec) String() string {
 		mapStringForMetrics += fmt.Sprintf("%v: %v,", k, this.Metrics[k8s_io_api_core_v1.ResourceName(k)])
 	}
 	mapStringForMetrics += "}"
+	keysForSoftLimits := make([]string, 0, len(this.SoftLimits))
+	for k := range this.SoftLimits {
+		keysForSoftLimits = append(keysForSoftLimits, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSoftLimits)
+	mapStringForSoftLimits := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForSoftLimits {
+		mapStringForSoftLimits += fmt.Sprintf("%v: %v,", k, this.SoftLimits[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForSoftLimits += "}"
 	s := strings.Join([]string{`&QuotaSpec{`,
 		`ClusterLifetimeDays:` + valueToStringGenerated(this.ClusterLifetimeDays) + `,`,
 		`Metrics:` + mapStringForMetrics + `,`,
 		`Scope:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Scope), "ObjectReference", "v1.ObjectReference", 1), `&`, ``, 1) + `,`,
+		`SoftLimits:` + mapStringForSoftLimits + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QuotaStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForProjectUsages := "[]QuotaProjectUsage{"
+	for _, f := range this.ProjectUsages {
+		repeatedStringForProjectUsages += strings.Replace(strings.Replace(f.String(), "QuotaProjectUsage", "QuotaProjectUsage", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForProjectUsages += "}"
+	keysForUsage := make([]string, 0, len(this.Usage))
+	for k := range this.Usage {
+		keysForUsage = append(keysForUsage, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUsage)
+	mapStringForUsage := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForUsage {
+		mapStringForUsage += fmt.Sprintf("%v: %v,", k, this.Usage[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForUsage += "}"
+	s := strings.Join([]string{`&QuotaStatus{`,
+		`Usage:` + mapStringForUsage + `,`,
+		`ProjectUsages:` + repeatedStringForProjectUsages + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21969,6 +24054,18 @@ func (this *ResourceWatchCacheSize) String() string {
 	}, "")
 	return s
 }
+func (this *RolloutWaveStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutWaveStatus{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ReleasedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ReleasedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`HealthySince:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.HealthySince), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SSHAccess) String() string {
 	if this == nil {
 		return "nil"
@@ -22466,6 +24563,66 @@ func (this *ShootAdvertisedAddress) String() string {
 	}, "")
 	return s
 }
+func (this *ShootBlueprint) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShootBlueprint{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ShootBlueprintSpec", "ShootBlueprintSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ShootBlueprintStatus", "ShootBlueprintStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShootBlueprintList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]ShootBlueprint{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ShootBlueprint", "ShootBlueprint", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ShootBlueprintList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShootBlueprintReference) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShootBlueprintReference{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Overrides:` + strings.Replace(fmt.Sprintf("%v", this.Overrides), "RawExtension", "runtime.RawExtension", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShootBlueprintSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShootBlueprintSpec{`,
+		`Template:` + strings.Replace(strings.Replace(this.Template.String(), "ShootTemplate", "ShootTemplate", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShootBlueprintStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShootBlueprintStatus{`,
+		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
+		`OutdatedShoots:` + fmt.Sprintf("%v", this.OutdatedShoots) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ShootCredentials) String() string {
 	if this == nil {
 		return "nil"
@@ -22594,6 +24751,8 @@ func (this *ShootSpec) String() string {
 		`ControlPlane:` + strings.Replace(this.ControlPlane.String(), "ControlPlane", "ControlPlane", 1) + `,`,
 		`SchedulerName:` + valueToStringGenerated(this.SchedulerName) + `,`,
 		`CloudProfile:` + strings.Replace(this.CloudProfile.String(), "CloudProfileReference", "CloudProfileReference", 1) + `,`,
+		`Blueprint:` + strings.Replace(this.Blueprint.String(), "ShootBlueprintReference", "ShootBlueprintReference", 1) + `,`,
+		`BackupRetentionPolicy:` + strings.Replace(this.BackupRetentionPolicy.String(), "BackupRetentionPolicy", "BackupRetentionPolicy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22695,6 +24854,7 @@ func (this *ShootStatus) String() string {
 		`LastHibernationTriggerTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHibernationTriggerTime), "Time", "v11.Time", 1) + `,`,
 		`LastMaintenance:` + strings.Replace(this.LastMaintenance.String(), "LastMaintenance", "LastMaintenance", 1) + `,`,
 		`EncryptedResources:` + fmt.Sprintf("%v", this.EncryptedResources) + `,`,
+		`EstimatedCost:` + strings.Replace(this.EstimatedCost.String(), "EstimatedCost", "EstimatedCost", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22732,6 +24892,24 @@ func (this *Toleration) String() string {
 	}, "")
 	return s
 }
+func (this *VersionRolloutStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForWaves := "[]RolloutWaveStatus{"
+	for _, f := range this.Waves {
+		repeatedStringForWaves += strings.Replace(strings.Replace(f.String(), "RolloutWaveStatus", "RolloutWaveStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForWaves += "}"
+	s := strings.Join([]string{`&VersionRolloutStatus{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Waves:` + repeatedStringForWaves + `,`,
+		`Completed:` + fmt.Sprintf("%v", this.Completed) + `,`,
+		`Message:` + valueToStringGenerated(this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *VerticalPodAutoscaler) String() string {
 	if this == nil {
 		return "nil"
@@ -22772,6 +24950,7 @@ func (this *VolumeType) String() string {
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Usable:` + valueToStringGenerated(this.Usable) + `,`,
 		`MinSize:` + strings.Replace(fmt.Sprintf("%v", this.MinSize), "Quantity", "resource.Quantity", 1) + `,`,
+		`Price:` + strings.Replace(this.Price.String(), "Price", "Price", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -24983,6 +27162,42 @@ func (m *BackupEntrySpec) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.SeedName = &s
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetentionPolicy == nil {
+				m.RetentionPolicy = &BackupRetentionPolicy{}
+			}
+			if err := m.RetentionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25214,6 +27429,133 @@ func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BackupRetentionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackupRetentionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackupRetentionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionAfterDeletion", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetentionAfterDeletion == nil {
+				m.RetentionAfterDeletion = &v11.Duration{}
+			}
+			if err := m.RetentionAfterDeletion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegalHold", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.LegalHold = &b
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullSnapshotsToKeep", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FullSnapshotsToKeep = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CARotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -25651,6 +27993,39 @@ func (m *CloudProfile) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26259,6 +28634,124 @@ func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CloudProfileStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloudProfileStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloudProfileStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubernetesVersions = append(m.KubernetesVersions, VersionRolloutStatus{})
+			if err := m.KubernetesVersions[len(m.KubernetesVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineImages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MachineImages = append(m.MachineImages, MachineImageRolloutStatus{})
+			if err := m.MachineImages[len(m.MachineImages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ClusterAutoscaler) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -30372,7 +32865,7 @@ func (m *EncryptionConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
+func (m *EstimatedCost) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30395,15 +32888,15 @@ func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExpirableVersion: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimatedCost: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExpirableVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimatedCost: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30431,11 +32924,11 @@ func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Currency = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hourly", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30462,18 +32955,15 @@ func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpirationDate == nil {
-				m.ExpirationDate = &v11.Time{}
-			}
-			if err := m.ExpirationDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Hourly.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Classification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Monthly", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30483,24 +32973,24 @@ func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := VersionClassification(dAtA[iNdEx:postIndex])
-			m.Classification = &s
+			if err := m.Monthly.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30523,7 +33013,7 @@ func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExposureClass) Unmarshal(dAtA []byte) error {
+func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30546,17 +33036,17 @@ func (m *ExposureClass) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExposureClass: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExpirableVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExposureClass: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExpirableVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30566,30 +33056,29 @@ func (m *ExposureClass) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Handler", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30599,29 +33088,33 @@ func (m *ExposureClass) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Handler = string(dAtA[iNdEx:postIndex])
+			if m.ExpirationDate == nil {
+				m.ExpirationDate = &v11.Time{}
+			}
+			if err := m.ExpirationDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scheduling", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Classification", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30631,27 +33124,24 @@ func (m *ExposureClass) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Scheduling == nil {
-				m.Scheduling = &ExposureClassScheduling{}
-			}
-			if err := m.Scheduling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := VersionClassification(dAtA[iNdEx:postIndex])
+			m.Classification = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30674,7 +33164,7 @@ func (m *ExposureClass) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExposureClassList) Unmarshal(dAtA []byte) error {
+func (m *ExposureClass) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30697,15 +33187,15 @@ func (m *ExposureClassList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExposureClassList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExposureClass: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExposureClassList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExposureClass: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30732,13 +33222,164 @@ func (m *ExposureClassList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Handler", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Handler = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheduling", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scheduling == nil {
+				m.Scheduling = &ExposureClassScheduling{}
+			}
+			if err := m.Scheduling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExposureClassList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExposureClassList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExposureClassList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37565,7 +40206,7 @@ func (m *MachineImage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MachineImageVersion) Unmarshal(dAtA []byte) error {
+func (m *MachineImageRolloutStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37588,17 +40229,17 @@ func (m *MachineImageVersion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MachineImageVersion: wiretype end group for non-group")
+			return fmt.Errorf("proto: MachineImageRolloutStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MachineImageVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MachineImageRolloutStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirableVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37608,28 +40249,27 @@ func (m *MachineImageVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExpirableVersion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CRI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37656,76 +40296,11 @@ func (m *MachineImageVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CRI = append(m.CRI, CRI{})
-			if err := m.CRI[len(m.CRI)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Versions = append(m.Versions, VersionRolloutStatus{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Architectures", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Architectures = append(m.Architectures, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubeletVersionConstraint", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.KubeletVersionConstraint = &s
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -37747,7 +40322,7 @@ func (m *MachineImageVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MachineType) Unmarshal(dAtA []byte) error {
+func (m *MachineImageVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37770,15 +40345,15 @@ func (m *MachineType) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MachineType: wiretype end group for non-group")
+			return fmt.Errorf("proto: MachineImageVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MachineType: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MachineImageVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CPU", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirableVersion", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37805,13 +40380,13 @@ func (m *MachineType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CPU.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExpirableVersion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GPU", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CRI", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37838,46 +40413,228 @@ func (m *MachineType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GPU.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CRI = append(m.CRI, CRI{})
+			if err := m.CRI[len(m.CRI)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Memory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Architectures", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Architectures = append(m.Architectures, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeletVersionConstraint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.KubeletVersionConstraint = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MachineType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MachineType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MachineType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPU", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CPU.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GPU", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GPU.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Memory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37997,6 +40754,42 @@ func (m *MachineType) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.Architecture = &s
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Price == nil {
+				m.Price = &Price{}
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -38438,7 +41231,7 @@ func (m *MaintenanceAutoUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceFreezePeriod) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38461,47 +41254,303 @@ func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MaintenanceTimeWindow: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceFreezePeriod: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MaintenanceTimeWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceFreezePeriod: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Begin = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Begin == nil {
+				m.Begin = &v11.Time{}
+			}
+			if err := m.Begin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.End == nil {
+				m.End = &v11.Time{}
+			}
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Schedule = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Duration == nil {
+				m.Duration = &v11.Duration{}
+			}
+			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Location = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaintenanceTimeWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaintenanceTimeWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Begin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40958,6 +44007,121 @@ func (m *OpenIDConnectClientAuthentication) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Price) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Price: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Price: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Currency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Project) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -41107,6 +44271,92 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProjectHibernation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectHibernation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectHibernation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdleTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IdleTimeout == nil {
+				m.IdleTimeout = &v11.Duration{}
+			}
+			if err := m.IdleTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ProjectList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -41224,6 +44474,90 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProjectMaintenance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectMaintenance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreezePeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FreezePeriods = append(m.FreezePeriods, MaintenanceFreezePeriod{})
+			if err := m.FreezePeriods[len(m.FreezePeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ProjectMember) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -41641,6 +44975,114 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupRetentionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BackupRetentionPolicy == nil {
+				m.BackupRetentionPolicy = &BackupRetentionPolicy{}
+			}
+			if err := m.BackupRetentionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maintenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Maintenance == nil {
+				m.Maintenance = &ProjectMaintenance{}
+			}
+			if err := m.Maintenance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hibernation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hibernation == nil {
+				m.Hibernation = &ProjectHibernation{}
+			}
+			if err := m.Hibernation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -41850,6 +45292,40 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedCosts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EstimatedCosts = append(m.EstimatedCosts, EstimatedCost{})
+			if err := m.EstimatedCosts[len(m.EstimatedCosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -42308,6 +45784,39 @@ func (m *Quota) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -42446,7 +45955,7 @@ func (m *QuotaList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
+func (m *QuotaProjectUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42469,17 +45978,17 @@ func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuotaSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuotaProjectUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuotaSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuotaProjectUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterLifetimeDays", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42489,15 +45998,27 @@ func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ClusterLifetimeDays = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42524,8 +46045,8 @@ func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metrics == nil {
-				m.Metrics = make(k8s_io_api_core_v1.ResourceList)
+			if m.Usage == nil {
+				m.Usage = make(k8s_io_api_core_v1.ResourceList)
 			}
 			var mapkey k8s_io_api_core_v1.ResourceName
 			mapvalue := &resource.Quantity{}
@@ -42622,40 +46143,7 @@ func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Metrics[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Usage[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -42678,7 +46166,7 @@ func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Region) Unmarshal(dAtA []byte) error {
+func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42701,17 +46189,17 @@ func (m *Region) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Region: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuotaSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Region: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuotaSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterLifetimeDays", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42721,27 +46209,601 @@ func (m *Region) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.ClusterLifetimeDays = &v
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metrics == nil {
+				m.Metrics = make(k8s_io_api_core_v1.ResourceList)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metrics[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SoftLimits == nil {
+				m.SoftLimits = make(k8s_io_api_core_v1.ResourceList)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SoftLimits[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Usage == nil {
+				m.Usage = make(k8s_io_api_core_v1.ResourceList)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Usage[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectUsages = append(m.ProjectUsages, QuotaProjectUsage{})
+			if err := m.ProjectUsages[len(m.ProjectUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Region) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Region: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Region: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -43171,6 +47233,154 @@ func (m *ResourceWatchCacheSize) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RolloutWaveStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutWaveStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutWaveStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReleasedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthySince", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HealthySince.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SSHAccess) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -47726,17 +51936,529 @@ func (m *SeedVolumeProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SeedVolumeProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: SeedVolumeProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeedVolumeProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purpose = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceAccountConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceAccountConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceAccountConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Issuer = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendTokenExpiration", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.ExtendTokenExpiration = &b
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokenExpiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxTokenExpiration == nil {
+				m.MaxTokenExpiration = &v11.Duration{}
+			}
+			if err := m.MaxTokenExpiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedIssuers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedIssuers = append(m.AcceptedIssuers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceAccountKeyRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceAccountKeyRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceAccountKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = CredentialsRotationPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastCompletionTime == nil {
+				m.LastCompletionTime = &v11.Time{}
+			}
+			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastInitiationTime == nil {
+				m.LastInitiationTime = &v11.Time{}
+			}
+			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationFinishedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastInitiationFinishedTime == nil {
+				m.LastInitiationFinishedTime = &v11.Time{}
+			}
+			if err := m.LastInitiationFinishedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTriggeredTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastCompletionTriggeredTime == nil {
+				m.LastCompletionTriggeredTime = &v11.Time{}
+			}
+			if err := m.LastCompletionTriggeredTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Shoot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Shoot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SeedVolumeProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Shoot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47746,29 +52468,30 @@ func (m *SeedVolumeProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Purpose = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47778,23 +52501,57 @@ func (m *SeedVolumeProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -47817,7 +52574,7 @@ func (m *SeedVolumeProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ServiceAccountConfig) Unmarshal(dAtA []byte) error {
+func (m *ShootAdvertisedAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -47840,15 +52597,15 @@ func (m *ServiceAccountConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ServiceAccountConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShootAdvertisedAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServiceAccountConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShootAdvertisedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47876,69 +52633,11 @@ func (m *ServiceAccountConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Issuer = &s
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtendTokenExpiration", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.ExtendTokenExpiration = &b
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokenExpiration", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.MaxTokenExpiration == nil {
-				m.MaxTokenExpiration = &v11.Duration{}
-			}
-			if err := m.MaxTokenExpiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedIssuers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47966,7 +52665,7 @@ func (m *ServiceAccountConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AcceptedIssuers = append(m.AcceptedIssuers, string(dAtA[iNdEx:postIndex]))
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -47989,7 +52688,7 @@ func (m *ServiceAccountConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ServiceAccountKeyRotation) Unmarshal(dAtA []byte) error {
+func (m *ShootBlueprint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48012,17 +52711,17 @@ func (m *ServiceAccountKeyRotation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ServiceAccountKeyRotation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShootBlueprint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServiceAccountKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShootBlueprint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48032,27 +52731,28 @@ func (m *ServiceAccountKeyRotation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = CredentialsRotationPhase(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48079,16 +52779,13 @@ func (m *ServiceAccountKeyRotation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastCompletionTime == nil {
-				m.LastCompletionTime = &v11.Time{}
-			}
-			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48115,16 +52812,63 @@ func (m *ServiceAccountKeyRotation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastInitiationTime == nil {
-				m.LastInitiationTime = &v11.Time{}
-			}
-			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShootBlueprintList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShootBlueprintList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShootBlueprintList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationFinishedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48151,16 +52895,13 @@ func (m *ServiceAccountKeyRotation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastInitiationFinishedTime == nil {
-				m.LastInitiationFinishedTime = &v11.Time{}
-			}
-			if err := m.LastInitiationFinishedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTriggeredTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48187,10 +52928,8 @@ func (m *ServiceAccountKeyRotation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastCompletionTriggeredTime == nil {
-				m.LastCompletionTriggeredTime = &v11.Time{}
-			}
-			if err := m.LastCompletionTriggeredTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, ShootBlueprint{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -48215,7 +52954,7 @@ func (m *ServiceAccountKeyRotation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Shoot) Unmarshal(dAtA []byte) error {
+func (m *ShootBlueprintReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48238,17 +52977,17 @@ func (m *Shoot) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Shoot: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShootBlueprintReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Shoot: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShootBlueprintReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48258,61 +52997,27 @@ func (m *Shoot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48339,7 +53044,10 @@ func (m *Shoot) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Overrides == nil {
+				m.Overrides = &runtime.RawExtension{}
+			}
+			if err := m.Overrides.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -48364,7 +53072,7 @@ func (m *Shoot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShootAdvertisedAddress) Unmarshal(dAtA []byte) error {
+func (m *ShootBlueprintSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48387,17 +53095,17 @@ func (m *ShootAdvertisedAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShootAdvertisedAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShootBlueprintSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShootAdvertisedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShootBlueprintSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48407,27 +53115,97 @@ func (m *ShootAdvertisedAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShootBlueprintStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShootBlueprintStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShootBlueprintStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutdatedShoots", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48455,7 +53233,7 @@ func (m *ShootAdvertisedAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.OutdatedShoots = append(m.OutdatedShoots, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -50244,6 +55022,78 @@ func (m *ShootSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blueprint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Blueprint == nil {
+				m.Blueprint = &ShootBlueprintReference{}
+			}
+			if err := m.Blueprint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupRetentionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BackupRetentionPolicy == nil {
+				m.BackupRetentionPolicy = &BackupRetentionPolicy{}
+			}
+			if err := m.BackupRetentionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -51118,18 +55968,126 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MigrationStartTime == nil {
-				m.MigrationStartTime = &v11.Time{}
+			if m.MigrationStartTime == nil {
+				m.MigrationStartTime = &v11.Time{}
+			}
+			if err := m.MigrationStartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &ShootCredentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHibernationTriggerTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastHibernationTriggerTime == nil {
+				m.LastHibernationTriggerTime = &v11.Time{}
+			}
+			if err := m.LastHibernationTriggerTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMaintenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastMaintenance == nil {
+				m.LastMaintenance = &LastMaintenance{}
 			}
-			if err := m.MigrationStartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastMaintenance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedResources", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -51139,31 +56097,27 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Credentials == nil {
-				m.Credentials = &ShootCredentials{}
-			}
-			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EncryptedResources = append(m.EncryptedResources, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 16:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHibernationTriggerTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedCost", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51190,16 +56144,66 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastHibernationTriggerTime == nil {
-				m.LastHibernationTriggerTime = &v11.Time{}
+			if m.EstimatedCost == nil {
+				m.EstimatedCost = &EstimatedCost{}
 			}
-			if err := m.LastHibernationTriggerTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EstimatedCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShootTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShootTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShootTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastMaintenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51226,18 +56230,15 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastMaintenance == nil {
-				m.LastMaintenance = &LastMaintenance{}
-			}
-			if err := m.LastMaintenance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -51247,23 +56248,24 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncryptedResources = append(m.EncryptedResources, string(dAtA[iNdEx:postIndex]))
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -51286,7 +56288,7 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShootTemplate) Unmarshal(dAtA []byte) error {
+func (m *SystemComponents) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -51309,15 +56311,15 @@ func (m *ShootTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShootTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: SystemComponents: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShootTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SystemComponents: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoreDNS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51344,13 +56346,16 @@ func (m *ShootTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CoreDNS == nil {
+				m.CoreDNS = &CoreDNS{}
+			}
+			if err := m.CoreDNS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeLocalDNS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51377,7 +56382,10 @@ func (m *ShootTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.NodeLocalDNS == nil {
+				m.NodeLocalDNS = &NodeLocalDNS{}
+			}
+			if err := m.NodeLocalDNS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -51402,7 +56410,7 @@ func (m *ShootTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SystemComponents) Unmarshal(dAtA []byte) error {
+func (m *Toleration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -51425,17 +56433,17 @@ func (m *SystemComponents) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SystemComponents: wiretype end group for non-group")
+			return fmt.Errorf("proto: Toleration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SystemComponents: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Toleration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoreDNS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -51445,33 +56453,29 @@ func (m *SystemComponents) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CoreDNS == nil {
-				m.CoreDNS = &CoreDNS{}
-			}
-			if err := m.CoreDNS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeLocalDNS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -51481,27 +56485,24 @@ func (m *SystemComponents) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeLocalDNS == nil {
-				m.NodeLocalDNS = &NodeLocalDNS{}
-			}
-			if err := m.NodeLocalDNS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Value = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -51524,7 +56525,7 @@ func (m *SystemComponents) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Toleration) Unmarshal(dAtA []byte) error {
+func (m *VersionRolloutStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -51547,15 +56548,15 @@ func (m *Toleration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Toleration: wiretype end group for non-group")
+			return fmt.Errorf("proto: VersionRolloutStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Toleration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VersionRolloutStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -51583,11 +56584,65 @@ func (m *Toleration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Waves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Waves = append(m.Waves, RolloutWaveStatus{})
+			if err := m.Waves[len(m.Waves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -51616,7 +56671,7 @@ func (m *Toleration) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Value = &s
+			m.Message = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -52204,6 +57259,42 @@ func (m *VolumeType) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Price == nil {
+				m.Price = &Price{}
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
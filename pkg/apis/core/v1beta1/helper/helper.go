@@ -1052,6 +1052,42 @@ func SeedBackupSecretRefEqual(oldBackup, newBackup *gardencorev1beta1.SeedBackup
 	return apiequality.Semantic.DeepEqual(oldSecretRef, newSecretRef)
 }
 
+// EffectiveBackupRetentionPolicy returns the retention policy for the etcd backup of the given Shoot. The longer of
+// the retentions after deletion configured for the Shoot and the Project applies, i.e., a Shoot cannot shorten the
+// retention required by its Project. The same applies to the number of full snapshots to keep. A legal hold configured
+// for either of them applies. It returns nil if neither the Shoot nor the Project configure a policy.
+func EffectiveBackupRetentionPolicy(project *gardencorev1beta1.Project, shoot *gardencorev1beta1.Shoot) *gardencorev1beta1.BackupRetentionPolicy {
+	var projectPolicy, shootPolicy *gardencorev1beta1.BackupRetentionPolicy
+	if project != nil {
+		projectPolicy = project.Spec.BackupRetentionPolicy
+	}
+	if shoot != nil {
+		shootPolicy = shoot.Spec.BackupRetentionPolicy
+	}
+
+	if projectPolicy == nil && shootPolicy == nil {
+		return nil
+	}
+
+	policy := &gardencorev1beta1.BackupRetentionPolicy{}
+	for _, p := range []*gardencorev1beta1.BackupRetentionPolicy{projectPolicy, shootPolicy} {
+		if p == nil {
+			continue
+		}
+		if p.RetentionAfterDeletion != nil && (policy.RetentionAfterDeletion == nil || p.RetentionAfterDeletion.Duration > policy.RetentionAfterDeletion.Duration) {
+			policy.RetentionAfterDeletion = p.RetentionAfterDeletion.DeepCopy()
+		}
+		if ptr.Deref(p.LegalHold, false) {
+			policy.LegalHold = ptr.To(true)
+		}
+		if ptr.Deref(p.FullSnapshotsToKeep, 0) > ptr.Deref(policy.FullSnapshotsToKeep, 0) {
+			policy.FullSnapshotsToKeep = ptr.To(*p.FullSnapshotsToKeep)
+		}
+	}
+
+	return policy
+}
+
 // ShootDNSProviderSecretNamesEqual returns true when all the secretNames in the `.spec.dns.providers[]` list are the
 // same.
 func ShootDNSProviderSecretNamesEqual(oldDNS, newDNS *gardencorev1beta1.DNS) bool {
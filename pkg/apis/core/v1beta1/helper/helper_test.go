@@ -2451,6 +2451,59 @@ var _ = Describe("Helper", func() {
 		Entry("equality", &gardencorev1beta1.SeedBackup{SecretRef: corev1.SecretReference{Name: "foo", Namespace: "bar"}}, &gardencorev1beta1.SeedBackup{SecretRef: corev1.SecretReference{Name: "foo", Namespace: "bar"}}, BeTrue()),
 	)
 
+	DescribeTable("#EffectiveBackupRetentionPolicy",
+		func(projectPolicy, shootPolicy, expected *gardencorev1beta1.BackupRetentionPolicy) {
+			var (
+				project = &gardencorev1beta1.Project{Spec: gardencorev1beta1.ProjectSpec{BackupRetentionPolicy: projectPolicy}}
+				shoot   = &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{BackupRetentionPolicy: shootPolicy}}
+			)
+
+			Expect(EffectiveBackupRetentionPolicy(project, shoot)).To(Equal(expected))
+		},
+
+		Entry("no policies", nil, nil, nil),
+		Entry("only project policy",
+			&gardencorev1beta1.BackupRetentionPolicy{RetentionAfterDeletion: &metav1.Duration{Duration: time.Hour}, LegalHold: ptr.To(true)},
+			nil,
+			&gardencorev1beta1.BackupRetentionPolicy{RetentionAfterDeletion: &metav1.Duration{Duration: time.Hour}, LegalHold: ptr.To(true)},
+		),
+		Entry("only shoot policy",
+			nil,
+			&gardencorev1beta1.BackupRetentionPolicy{RetentionAfterDeletion: &metav1.Duration{Duration: time.Hour}},
+			&gardencorev1beta1.BackupRetentionPolicy{RetentionAfterDeletion: &metav1.Duration{Duration: time.Hour}},
+		),
+		Entry("longer shoot retention applies",
+			&gardencorev1beta1.BackupRetentionPolicy{RetentionAfterDeletion: &metav1.Duration{Duration: time.Hour}},
+			&gardencorev1beta1.BackupRetentionPolicy{RetentionAfterDeletion: &metav1.Duration{Duration: 2 * time.Hour}},
+			&gardencorev1beta1.BackupRetentionPolicy{RetentionAfterDeletion: &metav1.Duration{Duration: 2 * time.Hour}},
+		),
+		Entry("longer project retention cannot be shortened by shoot",
+			&gardencorev1beta1.BackupRetentionPolicy{RetentionAfterDeletion: &metav1.Duration{Duration: 2 * time.Hour}},
+			&gardencorev1beta1.BackupRetentionPolicy{RetentionAfterDeletion: &metav1.Duration{Duration: time.Hour}},
+			&gardencorev1beta1.BackupRetentionPolicy{RetentionAfterDeletion: &metav1.Duration{Duration: 2 * time.Hour}},
+		),
+		Entry("project retention applies if shoot does not configure it",
+			&gardencorev1beta1.BackupRetentionPolicy{RetentionAfterDeletion: &metav1.Duration{Duration: time.Hour}},
+			&gardencorev1beta1.BackupRetentionPolicy{LegalHold: ptr.To(true)},
+			&gardencorev1beta1.BackupRetentionPolicy{RetentionAfterDeletion: &metav1.Duration{Duration: time.Hour}, LegalHold: ptr.To(true)},
+		),
+		Entry("project legal hold cannot be lifted by shoot",
+			&gardencorev1beta1.BackupRetentionPolicy{LegalHold: ptr.To(true)},
+			&gardencorev1beta1.BackupRetentionPolicy{LegalHold: ptr.To(false)},
+			&gardencorev1beta1.BackupRetentionPolicy{LegalHold: ptr.To(true)},
+		),
+		Entry("project number of full snapshots applies if shoot does not configure it",
+			&gardencorev1beta1.BackupRetentionPolicy{FullSnapshotsToKeep: ptr.To[int32](7)},
+			&gardencorev1beta1.BackupRetentionPolicy{LegalHold: ptr.To(true)},
+			&gardencorev1beta1.BackupRetentionPolicy{FullSnapshotsToKeep: ptr.To[int32](7), LegalHold: ptr.To(true)},
+		),
+		Entry("shoot number of full snapshots applies",
+			nil,
+			&gardencorev1beta1.BackupRetentionPolicy{FullSnapshotsToKeep: ptr.To[int32](7)},
+			&gardencorev1beta1.BackupRetentionPolicy{FullSnapshotsToKeep: ptr.To[int32](7)},
+		),
+	)
+
 	DescribeTable("#ShootDNSProviderSecretNamesEqual",
 		func(oldDNS, newDNS *gardencorev1beta1.DNS, matcher gomegatypes.GomegaMatcher) {
 			Expect(ShootDNSProviderSecretNamesEqual(oldDNS, newDNS)).To(matcher)
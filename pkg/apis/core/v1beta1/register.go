@@ -65,6 +65,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&SeedList{},
 		&Shoot{},
 		&ShootList{},
+		&ShootBlueprint{},
+		&ShootBlueprintList{},
 		&ShootState{},
 		&ShootStateList{},
 	)
@@ -48,6 +48,25 @@ type BackupEntrySpec struct {
 	// SeedName holds the name of the seed to which this BackupEntry is scheduled
 	// +optional
 	SeedName *string `json:"seedName,omitempty" protobuf:"bytes,2,opt,name=seedName"`
+	// RetentionPolicy contains the retention and lifecycle settings for this BackupEntry.
+	// +optional
+	RetentionPolicy *BackupRetentionPolicy `json:"retentionPolicy,omitempty" protobuf:"bytes,3,opt,name=retentionPolicy"`
+}
+
+// BackupRetentionPolicy contains the retention and lifecycle settings for the etcd backups of Shoots.
+type BackupRetentionPolicy struct {
+	// RetentionAfterDeletion is the duration for which the backup is kept after the deletion of the Shoot. If not set,
+	// the deletion grace period configured for the responsible gardenlet applies.
+	// +optional
+	RetentionAfterDeletion *metav1.Duration `json:"retentionAfterDeletion,omitempty" protobuf:"bytes,1,opt,name=retentionAfterDeletion"`
+	// LegalHold prevents the deletion of the backup as long as it is set to true, even if the retention period expired
+	// or the deletion was forced.
+	// +optional
+	LegalHold *bool `json:"legalHold,omitempty" protobuf:"varint,2,opt,name=legalHold"`
+	// FullSnapshotsToKeep is the number of full snapshots which are kept for the etcd of the Shoot by the garbage
+	// collection of etcd-backup-restore. If not set, older snapshots are garbage collected exponentially.
+	// +optional
+	FullSnapshotsToKeep *int32 `json:"fullSnapshotsToKeep,omitempty" protobuf:"varint,3,opt,name=fullSnapshotsToKeep"`
 }
 
 // BackupEntryStatus holds the most recently observed status of the Backup Entry.
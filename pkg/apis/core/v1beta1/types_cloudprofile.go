@@ -23,6 +23,9 @@ type CloudProfile struct {
 	// Spec defines the provider environment properties.
 	// +optional
 	Spec CloudProfileSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// Status contains the current status of the CloudProfile.
+	// +optional
+	Status CloudProfileStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -76,6 +79,53 @@ type CloudProfileSpec struct {
 	VolumeTypes []VolumeType `json:"volumeTypes,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,9,rep,name=volumeTypes"`
 }
 
+// CloudProfileStatus contains the status of a CloudProfile.
+type CloudProfileStatus struct {
+	// KubernetesVersions contains the state of the staged rollout of the Kubernetes versions across Shoots. It is
+	// maintained by gardener-controller-manager if rollout waves are configured for the shoot maintenance.
+	// +optional
+	KubernetesVersions []VersionRolloutStatus `json:"kubernetesVersions,omitempty" protobuf:"bytes,1,rep,name=kubernetesVersions"`
+	// MachineImages contains the state of the staged rollout of the machine image versions across Shoots. It is
+	// maintained by gardener-controller-manager if rollout waves are configured for the shoot maintenance.
+	// +optional
+	MachineImages []MachineImageRolloutStatus `json:"machineImages,omitempty" protobuf:"bytes,2,rep,name=machineImages"`
+}
+
+// MachineImageRolloutStatus contains the state of the staged rollout of the versions of a machine image.
+type MachineImageRolloutStatus struct {
+	// Name is the name of the machine image.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Versions contains the state of the staged rollout of the versions of the machine image.
+	// +optional
+	Versions []VersionRolloutStatus `json:"versions,omitempty" protobuf:"bytes,2,rep,name=versions"`
+}
+
+// VersionRolloutStatus contains the state of the staged rollout of a version across Shoots.
+type VersionRolloutStatus struct {
+	// Version is the version identifier.
+	Version string `json:"version" protobuf:"bytes,1,opt,name=version"`
+	// Waves are the rollout waves the version was released to, in rollout order.
+	// +optional
+	Waves []RolloutWaveStatus `json:"waves,omitempty" protobuf:"bytes,2,rep,name=waves"`
+	// Completed is true if the version was released to all Shoots.
+	// +optional
+	Completed bool `json:"completed,omitempty" protobuf:"varint,3,opt,name=completed"`
+	// Message describes why the rollout of the version is currently paused.
+	// +optional
+	Message *string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+}
+
+// RolloutWaveStatus contains the state of the staged rollout of a version in a single rollout wave.
+type RolloutWaveStatus struct {
+	// Name is the name of the rollout wave.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// ReleasedAt is the time when the version was released to the rollout wave.
+	ReleasedAt metav1.Time `json:"releasedAt" protobuf:"bytes,2,opt,name=releasedAt"`
+	// HealthySince is the time since when no Shoot of this or an earlier rollout wave running the version was reported
+	// to be unhealthy.
+	HealthySince metav1.Time `json:"healthySince" protobuf:"bytes,3,opt,name=healthySince"`
+}
+
 // SeedSelector contains constraints for selecting seed to be usable for shoots using a profile
 type SeedSelector struct {
 	// LabelSelector is optional and can be used to select seeds by their label settings
@@ -160,6 +210,9 @@ type MachineType struct {
 	// Architecture is the CPU architecture of this machine type.
 	// +optional
 	Architecture *string `json:"architecture,omitempty" protobuf:"bytes,7,opt,name=architecture"`
+	// Price is the price per hour of a machine of this machine type.
+	// +optional
+	Price *Price `json:"price,omitempty" protobuf:"bytes,8,opt,name=price"`
 }
 
 // MachineTypeStorage is the amount of storage associated with the root volume of this machine type.
@@ -217,6 +270,17 @@ type VolumeType struct {
 	// MinSize is the minimal supported storage size.
 	// +optional
 	MinSize *resource.Quantity `json:"minSize,omitempty" protobuf:"bytes,4,opt,name=minSize"`
+	// Price is the price per GiB and hour of a volume of this volume type.
+	// +optional
+	Price *Price `json:"price,omitempty" protobuf:"bytes,5,opt,name=price"`
+}
+
+// Price is the price of a machine type or volume type. It is used to estimate the cost of Shoots.
+type Price struct {
+	// Currency is the currency of the price, e.g. "USD". All prices of a CloudProfile must use the same currency.
+	Currency string `json:"currency" protobuf:"bytes,1,opt,name=currency"`
+	// Amount is the amount of the price in the given currency.
+	Amount resource.Quantity `json:"amount" protobuf:"bytes,2,opt,name=amount"`
 }
 
 const (
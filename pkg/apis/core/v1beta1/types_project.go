@@ -71,6 +71,16 @@ type ProjectSpec struct {
 	// Tolerations contains the tolerations for taints on seed clusters.
 	// +optional
 	Tolerations *ProjectTolerations `json:"tolerations,omitempty" protobuf:"bytes,7,opt,name=tolerations"`
+	// BackupRetentionPolicy contains the default retention and lifecycle settings for the etcd backups of all Shoots in
+	// this project.
+	// +optional
+	BackupRetentionPolicy *BackupRetentionPolicy `json:"backupRetentionPolicy,omitempty" protobuf:"bytes,8,opt,name=backupRetentionPolicy"`
+	// Maintenance contains the maintenance settings for all Shoots in this project.
+	// +optional
+	Maintenance *ProjectMaintenance `json:"maintenance,omitempty" protobuf:"bytes,9,opt,name=maintenance"`
+	// Hibernation contains the hibernation settings for all Shoots in this project.
+	// +optional
+	Hibernation *ProjectHibernation `json:"hibernation,omitempty" protobuf:"bytes,10,opt,name=hibernation"`
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -90,6 +100,10 @@ type ProjectStatus struct {
 	// LastActivityTimestamp contains the timestamp from the last activity performed in this project.
 	// +optional
 	LastActivityTimestamp *metav1.Time `json:"lastActivityTimestamp,omitempty" protobuf:"bytes,5,opt,name=lastActivityTimestamp"`
+	// EstimatedCosts contains the estimated cost of all Shoots of the project per currency. It is maintained by
+	// gardener-controller-manager.
+	// +optional
+	EstimatedCosts []EstimatedCost `json:"estimatedCosts,omitempty" protobuf:"bytes,6,rep,name=estimatedCosts"`
 }
 
 // ProjectMember is a member of a project.
@@ -146,6 +160,44 @@ const (
 	ProjectMemberExtensionPrefix = "extension:"
 )
 
+// ProjectMaintenance contains the maintenance settings for all Shoots in a project.
+type ProjectMaintenance struct {
+	// FreezePeriods are periods in which automatic updates of the Kubernetes and machine image versions of the Shoots in
+	// this project are suppressed. Forced updates of expired versions are still performed.
+	// +optional
+	FreezePeriods []MaintenanceFreezePeriod `json:"freezePeriods,omitempty" protobuf:"bytes,1,rep,name=freezePeriods"`
+}
+
+// ProjectHibernation contains the hibernation settings for all Shoots in a project.
+type ProjectHibernation struct {
+	// IdleTimeout is the duration without user activity after which the Shoots in this project are hibernated
+	// automatically. If not set, Shoots are not hibernated because of missing user activity.
+	// +optional
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty" protobuf:"bytes,1,opt,name=idleTimeout"`
+}
+
+// MaintenanceFreezePeriod is a period in which automatic maintenance of Shoots is suppressed. It is either specified
+// as an absolute time range via Begin and End, or as a recurring period via Schedule and Duration.
+type MaintenanceFreezePeriod struct {
+	// Name is the name of the freeze period.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Begin is the beginning of an absolute freeze period.
+	// +optional
+	Begin *metav1.Time `json:"begin,omitempty" protobuf:"bytes,2,opt,name=begin"`
+	// End is the end of an absolute freeze period.
+	// +optional
+	End *metav1.Time `json:"end,omitempty" protobuf:"bytes,3,opt,name=end"`
+	// Schedule is a cron expression (standard format) describing the beginnings of a recurring freeze period.
+	// +optional
+	Schedule *string `json:"schedule,omitempty" protobuf:"bytes,4,opt,name=schedule"`
+	// Duration is the duration of a recurring freeze period.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty" protobuf:"bytes,5,opt,name=duration"`
+	// Location is the time zone in which the schedule of a recurring freeze period is evaluated (defaults to UTC).
+	// +optional
+	Location *string `json:"location,omitempty" protobuf:"bytes,6,opt,name=location"`
+}
+
 // ProjectPhase is a label for the condition of a project at the current time.
 type ProjectPhase string
 
@@ -169,4 +221,8 @@ const (
 	ProjectEventNamespaceDeletionFailed = "NamespaceDeletionFailed"
 	// ProjectEventNamespaceMarkedForDeletion indicates that the namespace has been successfully marked for deletion.
 	ProjectEventNamespaceMarkedForDeletion = "NamespaceMarkedForDeletion"
+	// ProjectEventStaleDeletionScheduled indicates that the project is stale and will be deleted automatically.
+	ProjectEventStaleDeletionScheduled = "StaleProjectDeletionScheduled"
+	// ProjectEventStaleDeleted indicates that the project was stale and has been deleted automatically.
+	ProjectEventStaleDeleted = "StaleProjectDeleted"
 )
@@ -21,6 +21,9 @@ type Quota struct {
 	// Spec defines the Quota constraints.
 	// +optional
 	Spec QuotaSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// Status contains the current usage of the Quota.
+	// +optional
+	Status QuotaStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -44,4 +47,30 @@ type QuotaSpec struct {
 	Metrics corev1.ResourceList `json:"metrics" protobuf:"bytes,2,rep,name=metrics,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
 	// Scope is the scope of the Quota object, either 'project' or 'secret'. This field is immutable.
 	Scope corev1.ObjectReference `json:"scope" protobuf:"bytes,3,opt,name=scope"`
+	// SoftLimits is a list of resources whose usage is checked against the given limits. Exceeding a soft limit does
+	// not reject a request but causes a warning and an event.
+	// +optional
+	SoftLimits corev1.ResourceList `json:"softLimits,omitempty" protobuf:"bytes,4,rep,name=softLimits,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+}
+
+// QuotaStatus holds the most recently observed status of the Quota.
+type QuotaStatus struct {
+	// Usage contains the resources allocated by all Shoots referencing the Quota. For Quotas with scope 'project', it is
+	// the total across all projects, see ProjectUsages for the usage per project. It is maintained by
+	// gardener-controller-manager.
+	// +optional
+	Usage corev1.ResourceList `json:"usage,omitempty" protobuf:"bytes,1,rep,name=usage,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// ProjectUsages contains the resources allocated by the Shoots referencing the Quota per project. It is only
+	// maintained for Quotas with scope 'project'.
+	// +optional
+	ProjectUsages []QuotaProjectUsage `json:"projectUsages,omitempty" protobuf:"bytes,2,rep,name=projectUsages"`
+}
+
+// QuotaProjectUsage contains the resources allocated by the Shoots of a project which reference a Quota.
+type QuotaProjectUsage struct {
+	// Namespace is the namespace of the project.
+	Namespace string `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	// Usage contains the resources allocated by the Shoots in the project.
+	// +optional
+	Usage corev1.ResourceList `json:"usage,omitempty" protobuf:"bytes,2,rep,name=usage,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
 }
@@ -130,6 +130,13 @@ type ShootSpec struct {
 	// CloudProfile contains a reference to a CloudProfile or a NamespacedCloudProfile.
 	// +optional
 	CloudProfile *CloudProfileReference `json:"cloudProfile,omitempty" protobuf:"bytes,22,opt,name=cloudProfile"`
+	// Blueprint is a reference to a ShootBlueprint the specification of this Shoot is derived from.
+	// +optional
+	Blueprint *ShootBlueprintReference `json:"blueprint,omitempty" protobuf:"bytes,23,opt,name=blueprint"`
+	// BackupRetentionPolicy contains the retention and lifecycle settings for the etcd backup of this Shoot. If both
+	// the Shoot and its Project configure a retention, the longer one applies.
+	// +optional
+	BackupRetentionPolicy *BackupRetentionPolicy `json:"backupRetentionPolicy,omitempty" protobuf:"bytes,24,opt,name=backupRetentionPolicy"`
 }
 
 // GetProviderType gets the type of the provider.
@@ -204,6 +211,20 @@ type ShootStatus struct {
 	// See https://github.com/gardener/gardener/blob/master/docs/usage/etcd_encryption_config.md for more details.
 	// +optional
 	EncryptedResources []string `json:"encryptedResources,omitempty" protobuf:"bytes,18,rep,name=encryptedResources"`
+	// EstimatedCost is the estimated cost of the Shoot based on the prices of the machine types and volume types defined
+	// in its CloudProfile. It is maintained by gardener-controller-manager.
+	// +optional
+	EstimatedCost *EstimatedCost `json:"estimatedCost,omitempty" protobuf:"bytes,19,opt,name=estimatedCost"`
+}
+
+// EstimatedCost is the estimated cost of a Shoot or of all Shoots of a Project.
+type EstimatedCost struct {
+	// Currency is the currency of the cost.
+	Currency string `json:"currency" protobuf:"bytes,1,opt,name=currency"`
+	// Hourly is the current cost per hour.
+	Hourly resource.Quantity `json:"hourly" protobuf:"bytes,2,opt,name=hourly"`
+	// Monthly is the cost per month taking the hibernation schedules into account.
+	Monthly resource.Quantity `json:"monthly" protobuf:"bytes,3,opt,name=monthly"`
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -1683,10 +1704,15 @@ const (
 	ShootEventImageVersionMaintenance = "MachineImageVersionMaintenance"
 	// ShootEventK8sVersionMaintenance indicates that a maintenance operation regarding the K8s version has been performed.
 	ShootEventK8sVersionMaintenance = "KubernetesVersionMaintenance"
+	// ShootEventMaintenanceSkipped indicates that a maintenance operation has been skipped due to a maintenance freeze period.
+	ShootEventMaintenanceSkipped = "MaintenanceSkipped"
 	// ShootEventHibernationEnabled indicates that hibernation started.
 	ShootEventHibernationEnabled = "Hibernated"
 	// ShootEventHibernationDisabled indicates that hibernation ended.
 	ShootEventHibernationDisabled = "WokenUp"
+	// ShootEventIdleHibernationPending indicates that the shoot is about to be hibernated because no user activity was
+	// observed for a while.
+	ShootEventIdleHibernationPending = "IdleHibernationPending"
 	// ShootEventSchedulingSuccessful indicates that a scheduling decision was taken successfully.
 	ShootEventSchedulingSuccessful = "SchedulingSuccessful"
 	// ShootEventSchedulingFailed indicates that a scheduling decision failed.
@@ -1715,6 +1741,12 @@ const (
 	// ShootCRDsWithProblematicConversionWebhooks is a constant for a condition type indicating that the Shoot cluster has
 	// CRDs with conversion webhooks and multiple stored versions which can break the reconciliation flow of the cluster.
 	ShootCRDsWithProblematicConversionWebhooks ConditionType = "CRDsWithProblematicConversionWebhooks"
+	// ShootRemovedAPIsNotInUse is a constant for a condition type indicating that no APIs which are removed in the next
+	// Kubernetes minor version are still requested in the Shoot cluster.
+	ShootRemovedAPIsNotInUse ConditionType = "RemovedAPIsNotInUse"
+	// ShootBackupRestorable is a constant for a condition type indicating that the latest etcd backup of the Shoot
+	// cluster could be restored successfully.
+	ShootBackupRestorable ConditionType = "BackupRestorable"
 )
 
 // ShootPurpose is a type alias for string.
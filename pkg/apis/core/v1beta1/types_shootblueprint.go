@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootBlueprint is a reusable template for Shoots in the same namespace.
+type ShootBlueprint struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec contains the specification of this ShootBlueprint.
+	// +optional
+	Spec ShootBlueprintSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// Status contains the most recently observed status of the ShootBlueprint.
+	// +optional
+	Status ShootBlueprintStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootBlueprintList is a collection of ShootBlueprints.
+type ShootBlueprintList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Items is the list of ShootBlueprints.
+	Items []ShootBlueprint `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// ShootBlueprintSpec is the specification of a ShootBlueprint.
+type ShootBlueprintSpec struct {
+	// Template describes the Shoot that is derived from this blueprint. Only labels and annotations of its metadata are
+	// taken over into derived Shoots.
+	Template ShootTemplate `json:"template" protobuf:"bytes,1,opt,name=template"`
+}
+
+// ShootBlueprintStatus holds the most recently observed status of the ShootBlueprint.
+type ShootBlueprintStatus struct {
+	// ObservedGeneration is the most recent generation observed for this ShootBlueprint.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,1,opt,name=observedGeneration"`
+	// OutdatedShoots is the list of names of Shoots which are derived from this blueprint but have not yet been
+	// updated to its most recent generation.
+	// +optional
+	OutdatedShoots []string `json:"outdatedShoots,omitempty" protobuf:"bytes,2,rep,name=outdatedShoots"`
+}
+
+// ShootBlueprintReference is a reference to a ShootBlueprint in the namespace of the Shoot.
+type ShootBlueprintReference struct {
+	// Name is the name of the ShootBlueprint.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Overrides is a strategic merge patch which is applied on top of the blueprint's template.
+	// +optional
+	Overrides *runtime.RawExtension `json:"overrides,omitempty" protobuf:"bytes,2,opt,name=overrides"`
+}
@@ -210,6 +210,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BackupRetentionPolicy)(nil), (*core.BackupRetentionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BackupRetentionPolicy_To_core_BackupRetentionPolicy(a.(*BackupRetentionPolicy), b.(*core.BackupRetentionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.BackupRetentionPolicy)(nil), (*BackupRetentionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_BackupRetentionPolicy_To_v1beta1_BackupRetentionPolicy(a.(*core.BackupRetentionPolicy), b.(*BackupRetentionPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CARotation)(nil), (*core.CARotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CARotation_To_core_CARotation(a.(*CARotation), b.(*core.CARotation), scope)
 	}); err != nil {
@@ -270,6 +280,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CloudProfileStatus)(nil), (*core.CloudProfileStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CloudProfileStatus_To_core_CloudProfileStatus(a.(*CloudProfileStatus), b.(*core.CloudProfileStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.CloudProfileStatus)(nil), (*CloudProfileStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_CloudProfileStatus_To_v1beta1_CloudProfileStatus(a.(*core.CloudProfileStatus), b.(*CloudProfileStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ClusterAutoscaler)(nil), (*core.ClusterAutoscaler)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ClusterAutoscaler_To_core_ClusterAutoscaler(a.(*ClusterAutoscaler), b.(*core.ClusterAutoscaler), scope)
 	}); err != nil {
@@ -540,6 +560,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EstimatedCost)(nil), (*core.EstimatedCost)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_EstimatedCost_To_core_EstimatedCost(a.(*EstimatedCost), b.(*core.EstimatedCost), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.EstimatedCost)(nil), (*EstimatedCost)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_EstimatedCost_To_v1beta1_EstimatedCost(a.(*core.EstimatedCost), b.(*EstimatedCost), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ExpirableVersion)(nil), (*core.ExpirableVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ExpirableVersion_To_core_ExpirableVersion(a.(*ExpirableVersion), b.(*core.ExpirableVersion), scope)
 	}); err != nil {
@@ -895,6 +925,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineImageRolloutStatus)(nil), (*core.MachineImageRolloutStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineImageRolloutStatus_To_core_MachineImageRolloutStatus(a.(*MachineImageRolloutStatus), b.(*core.MachineImageRolloutStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MachineImageRolloutStatus)(nil), (*MachineImageRolloutStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MachineImageRolloutStatus_To_v1beta1_MachineImageRolloutStatus(a.(*core.MachineImageRolloutStatus), b.(*MachineImageRolloutStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineImageVersion)(nil), (*core.MachineImageVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MachineImageVersion_To_core_MachineImageVersion(a.(*MachineImageVersion), b.(*core.MachineImageVersion), scope)
 	}); err != nil {
@@ -945,6 +985,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceFreezePeriod)(nil), (*core.MaintenanceFreezePeriod)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceFreezePeriod_To_core_MaintenanceFreezePeriod(a.(*MaintenanceFreezePeriod), b.(*core.MaintenanceFreezePeriod), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceFreezePeriod)(nil), (*MaintenanceFreezePeriod)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceFreezePeriod_To_v1beta1_MaintenanceFreezePeriod(a.(*core.MaintenanceFreezePeriod), b.(*MaintenanceFreezePeriod), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MaintenanceTimeWindow)(nil), (*core.MaintenanceTimeWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(a.(*MaintenanceTimeWindow), b.(*core.MaintenanceTimeWindow), scope)
 	}); err != nil {
@@ -1085,6 +1135,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Price)(nil), (*core.Price)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Price_To_core_Price(a.(*Price), b.(*core.Price), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.Price)(nil), (*Price)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_Price_To_v1beta1_Price(a.(*core.Price), b.(*Price), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Project)(nil), (*core.Project)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Project_To_core_Project(a.(*Project), b.(*core.Project), scope)
 	}); err != nil {
@@ -1095,6 +1155,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectHibernation)(nil), (*core.ProjectHibernation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectHibernation_To_core_ProjectHibernation(a.(*ProjectHibernation), b.(*core.ProjectHibernation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectHibernation)(nil), (*ProjectHibernation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectHibernation_To_v1beta1_ProjectHibernation(a.(*core.ProjectHibernation), b.(*ProjectHibernation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectList)(nil), (*core.ProjectList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ProjectList_To_core_ProjectList(a.(*ProjectList), b.(*core.ProjectList), scope)
 	}); err != nil {
@@ -1105,6 +1175,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectMaintenance)(nil), (*core.ProjectMaintenance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectMaintenance_To_core_ProjectMaintenance(a.(*ProjectMaintenance), b.(*core.ProjectMaintenance), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectMaintenance)(nil), (*ProjectMaintenance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectMaintenance_To_v1beta1_ProjectMaintenance(a.(*core.ProjectMaintenance), b.(*ProjectMaintenance), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectStatus)(nil), (*core.ProjectStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ProjectStatus_To_core_ProjectStatus(a.(*ProjectStatus), b.(*core.ProjectStatus), scope)
 	}); err != nil {
@@ -1155,6 +1235,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*QuotaProjectUsage)(nil), (*core.QuotaProjectUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QuotaProjectUsage_To_core_QuotaProjectUsage(a.(*QuotaProjectUsage), b.(*core.QuotaProjectUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.QuotaProjectUsage)(nil), (*QuotaProjectUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_QuotaProjectUsage_To_v1beta1_QuotaProjectUsage(a.(*core.QuotaProjectUsage), b.(*QuotaProjectUsage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*QuotaSpec)(nil), (*core.QuotaSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_QuotaSpec_To_core_QuotaSpec(a.(*QuotaSpec), b.(*core.QuotaSpec), scope)
 	}); err != nil {
@@ -1165,6 +1255,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*QuotaStatus)(nil), (*core.QuotaStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QuotaStatus_To_core_QuotaStatus(a.(*QuotaStatus), b.(*core.QuotaStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.QuotaStatus)(nil), (*QuotaStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_QuotaStatus_To_v1beta1_QuotaStatus(a.(*core.QuotaStatus), b.(*QuotaStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Region)(nil), (*core.Region)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Region_To_core_Region(a.(*Region), b.(*core.Region), scope)
 	}); err != nil {
@@ -1195,6 +1295,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RolloutWaveStatus)(nil), (*core.RolloutWaveStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RolloutWaveStatus_To_core_RolloutWaveStatus(a.(*RolloutWaveStatus), b.(*core.RolloutWaveStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RolloutWaveStatus)(nil), (*RolloutWaveStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RolloutWaveStatus_To_v1beta1_RolloutWaveStatus(a.(*core.RolloutWaveStatus), b.(*RolloutWaveStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SSHAccess)(nil), (*core.SSHAccess)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SSHAccess_To_core_SSHAccess(a.(*SSHAccess), b.(*core.SSHAccess), scope)
 	}); err != nil {
@@ -1525,6 +1635,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootBlueprint)(nil), (*core.ShootBlueprint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootBlueprint_To_core_ShootBlueprint(a.(*ShootBlueprint), b.(*core.ShootBlueprint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootBlueprint)(nil), (*ShootBlueprint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootBlueprint_To_v1beta1_ShootBlueprint(a.(*core.ShootBlueprint), b.(*ShootBlueprint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootBlueprintList)(nil), (*core.ShootBlueprintList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootBlueprintList_To_core_ShootBlueprintList(a.(*ShootBlueprintList), b.(*core.ShootBlueprintList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootBlueprintList)(nil), (*ShootBlueprintList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootBlueprintList_To_v1beta1_ShootBlueprintList(a.(*core.ShootBlueprintList), b.(*ShootBlueprintList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootBlueprintReference)(nil), (*core.ShootBlueprintReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootBlueprintReference_To_core_ShootBlueprintReference(a.(*ShootBlueprintReference), b.(*core.ShootBlueprintReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootBlueprintReference)(nil), (*ShootBlueprintReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootBlueprintReference_To_v1beta1_ShootBlueprintReference(a.(*core.ShootBlueprintReference), b.(*ShootBlueprintReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootBlueprintSpec)(nil), (*core.ShootBlueprintSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootBlueprintSpec_To_core_ShootBlueprintSpec(a.(*ShootBlueprintSpec), b.(*core.ShootBlueprintSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootBlueprintSpec)(nil), (*ShootBlueprintSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootBlueprintSpec_To_v1beta1_ShootBlueprintSpec(a.(*core.ShootBlueprintSpec), b.(*ShootBlueprintSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootBlueprintStatus)(nil), (*core.ShootBlueprintStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootBlueprintStatus_To_core_ShootBlueprintStatus(a.(*ShootBlueprintStatus), b.(*core.ShootBlueprintStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootBlueprintStatus)(nil), (*ShootBlueprintStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootBlueprintStatus_To_v1beta1_ShootBlueprintStatus(a.(*core.ShootBlueprintStatus), b.(*ShootBlueprintStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootCredentials)(nil), (*core.ShootCredentials)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootCredentials_To_core_ShootCredentials(a.(*ShootCredentials), b.(*core.ShootCredentials), scope)
 	}); err != nil {
@@ -1675,6 +1835,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VersionRolloutStatus)(nil), (*core.VersionRolloutStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VersionRolloutStatus_To_core_VersionRolloutStatus(a.(*VersionRolloutStatus), b.(*core.VersionRolloutStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VersionRolloutStatus)(nil), (*VersionRolloutStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VersionRolloutStatus_To_v1beta1_VersionRolloutStatus(a.(*core.VersionRolloutStatus), b.(*VersionRolloutStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VerticalPodAutoscaler)(nil), (*core.VerticalPodAutoscaler)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_VerticalPodAutoscaler_To_core_VerticalPodAutoscaler(a.(*VerticalPodAutoscaler), b.(*core.VerticalPodAutoscaler), scope)
 	}); err != nil {
@@ -2170,6 +2340,7 @@ func Convert_core_BackupEntryList_To_v1beta1_BackupEntryList(in *core.BackupEntr
 func autoConvert_v1beta1_BackupEntrySpec_To_core_BackupEntrySpec(in *BackupEntrySpec, out *core.BackupEntrySpec, s conversion.Scope) error {
 	out.BucketName = in.BucketName
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
+	out.RetentionPolicy = (*core.BackupRetentionPolicy)(unsafe.Pointer(in.RetentionPolicy))
 	return nil
 }
 
@@ -2181,6 +2352,7 @@ func Convert_v1beta1_BackupEntrySpec_To_core_BackupEntrySpec(in *BackupEntrySpec
 func autoConvert_core_BackupEntrySpec_To_v1beta1_BackupEntrySpec(in *core.BackupEntrySpec, out *BackupEntrySpec, s conversion.Scope) error {
 	out.BucketName = in.BucketName
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
+	out.RetentionPolicy = (*BackupRetentionPolicy)(unsafe.Pointer(in.RetentionPolicy))
 	return nil
 }
 
@@ -2217,6 +2389,30 @@ func Convert_core_BackupEntryStatus_To_v1beta1_BackupEntryStatus(in *core.Backup
 	return autoConvert_core_BackupEntryStatus_To_v1beta1_BackupEntryStatus(in, out, s)
 }
 
+func autoConvert_v1beta1_BackupRetentionPolicy_To_core_BackupRetentionPolicy(in *BackupRetentionPolicy, out *core.BackupRetentionPolicy, s conversion.Scope) error {
+	out.RetentionAfterDeletion = (*metav1.Duration)(unsafe.Pointer(in.RetentionAfterDeletion))
+	out.LegalHold = (*bool)(unsafe.Pointer(in.LegalHold))
+	out.FullSnapshotsToKeep = (*int32)(unsafe.Pointer(in.FullSnapshotsToKeep))
+	return nil
+}
+
+// Convert_v1beta1_BackupRetentionPolicy_To_core_BackupRetentionPolicy is an autogenerated conversion function.
+func Convert_v1beta1_BackupRetentionPolicy_To_core_BackupRetentionPolicy(in *BackupRetentionPolicy, out *core.BackupRetentionPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_BackupRetentionPolicy_To_core_BackupRetentionPolicy(in, out, s)
+}
+
+func autoConvert_core_BackupRetentionPolicy_To_v1beta1_BackupRetentionPolicy(in *core.BackupRetentionPolicy, out *BackupRetentionPolicy, s conversion.Scope) error {
+	out.RetentionAfterDeletion = (*metav1.Duration)(unsafe.Pointer(in.RetentionAfterDeletion))
+	out.LegalHold = (*bool)(unsafe.Pointer(in.LegalHold))
+	out.FullSnapshotsToKeep = (*int32)(unsafe.Pointer(in.FullSnapshotsToKeep))
+	return nil
+}
+
+// Convert_core_BackupRetentionPolicy_To_v1beta1_BackupRetentionPolicy is an autogenerated conversion function.
+func Convert_core_BackupRetentionPolicy_To_v1beta1_BackupRetentionPolicy(in *core.BackupRetentionPolicy, out *BackupRetentionPolicy, s conversion.Scope) error {
+	return autoConvert_core_BackupRetentionPolicy_To_v1beta1_BackupRetentionPolicy(in, out, s)
+}
+
 func autoConvert_v1beta1_CARotation_To_core_CARotation(in *CARotation, out *core.CARotation, s conversion.Scope) error {
 	out.Phase = core.CredentialsRotationPhase(in.Phase)
 	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
@@ -2272,6 +2468,9 @@ func autoConvert_v1beta1_CloudProfile_To_core_CloudProfile(in *CloudProfile, out
 	if err := Convert_v1beta1_CloudProfileSpec_To_core_CloudProfileSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_v1beta1_CloudProfileStatus_To_core_CloudProfileStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2285,6 +2484,9 @@ func autoConvert_core_CloudProfile_To_v1beta1_CloudProfile(in *core.CloudProfile
 	if err := Convert_core_CloudProfileSpec_To_v1beta1_CloudProfileSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_core_CloudProfileStatus_To_v1beta1_CloudProfileStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2377,6 +2579,28 @@ func Convert_core_CloudProfileSpec_To_v1beta1_CloudProfileSpec(in *core.CloudPro
 	return autoConvert_core_CloudProfileSpec_To_v1beta1_CloudProfileSpec(in, out, s)
 }
 
+func autoConvert_v1beta1_CloudProfileStatus_To_core_CloudProfileStatus(in *CloudProfileStatus, out *core.CloudProfileStatus, s conversion.Scope) error {
+	out.KubernetesVersions = *(*[]core.VersionRolloutStatus)(unsafe.Pointer(&in.KubernetesVersions))
+	out.MachineImages = *(*[]core.MachineImageRolloutStatus)(unsafe.Pointer(&in.MachineImages))
+	return nil
+}
+
+// Convert_v1beta1_CloudProfileStatus_To_core_CloudProfileStatus is an autogenerated conversion function.
+func Convert_v1beta1_CloudProfileStatus_To_core_CloudProfileStatus(in *CloudProfileStatus, out *core.CloudProfileStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_CloudProfileStatus_To_core_CloudProfileStatus(in, out, s)
+}
+
+func autoConvert_core_CloudProfileStatus_To_v1beta1_CloudProfileStatus(in *core.CloudProfileStatus, out *CloudProfileStatus, s conversion.Scope) error {
+	out.KubernetesVersions = *(*[]VersionRolloutStatus)(unsafe.Pointer(&in.KubernetesVersions))
+	out.MachineImages = *(*[]MachineImageRolloutStatus)(unsafe.Pointer(&in.MachineImages))
+	return nil
+}
+
+// Convert_core_CloudProfileStatus_To_v1beta1_CloudProfileStatus is an autogenerated conversion function.
+func Convert_core_CloudProfileStatus_To_v1beta1_CloudProfileStatus(in *core.CloudProfileStatus, out *CloudProfileStatus, s conversion.Scope) error {
+	return autoConvert_core_CloudProfileStatus_To_v1beta1_CloudProfileStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_ClusterAutoscaler_To_core_ClusterAutoscaler(in *ClusterAutoscaler, out *core.ClusterAutoscaler, s conversion.Scope) error {
 	out.ScaleDownDelayAfterAdd = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterAdd))
 	out.ScaleDownDelayAfterDelete = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterDelete))
@@ -3073,6 +3297,30 @@ func Convert_core_EncryptionConfig_To_v1beta1_EncryptionConfig(in *core.Encrypti
 	return autoConvert_core_EncryptionConfig_To_v1beta1_EncryptionConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_EstimatedCost_To_core_EstimatedCost(in *EstimatedCost, out *core.EstimatedCost, s conversion.Scope) error {
+	out.Currency = in.Currency
+	out.Hourly = in.Hourly
+	out.Monthly = in.Monthly
+	return nil
+}
+
+// Convert_v1beta1_EstimatedCost_To_core_EstimatedCost is an autogenerated conversion function.
+func Convert_v1beta1_EstimatedCost_To_core_EstimatedCost(in *EstimatedCost, out *core.EstimatedCost, s conversion.Scope) error {
+	return autoConvert_v1beta1_EstimatedCost_To_core_EstimatedCost(in, out, s)
+}
+
+func autoConvert_core_EstimatedCost_To_v1beta1_EstimatedCost(in *core.EstimatedCost, out *EstimatedCost, s conversion.Scope) error {
+	out.Currency = in.Currency
+	out.Hourly = in.Hourly
+	out.Monthly = in.Monthly
+	return nil
+}
+
+// Convert_core_EstimatedCost_To_v1beta1_EstimatedCost is an autogenerated conversion function.
+func Convert_core_EstimatedCost_To_v1beta1_EstimatedCost(in *core.EstimatedCost, out *EstimatedCost, s conversion.Scope) error {
+	return autoConvert_core_EstimatedCost_To_v1beta1_EstimatedCost(in, out, s)
+}
+
 func autoConvert_v1beta1_ExpirableVersion_To_core_ExpirableVersion(in *ExpirableVersion, out *core.ExpirableVersion, s conversion.Scope) error {
 	out.Version = in.Version
 	out.ExpirationDate = (*metav1.Time)(unsafe.Pointer(in.ExpirationDate))
@@ -4145,6 +4393,28 @@ func Convert_core_MachineImage_To_v1beta1_MachineImage(in *core.MachineImage, ou
 	return autoConvert_core_MachineImage_To_v1beta1_MachineImage(in, out, s)
 }
 
+func autoConvert_v1beta1_MachineImageRolloutStatus_To_core_MachineImageRolloutStatus(in *MachineImageRolloutStatus, out *core.MachineImageRolloutStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Versions = *(*[]core.VersionRolloutStatus)(unsafe.Pointer(&in.Versions))
+	return nil
+}
+
+// Convert_v1beta1_MachineImageRolloutStatus_To_core_MachineImageRolloutStatus is an autogenerated conversion function.
+func Convert_v1beta1_MachineImageRolloutStatus_To_core_MachineImageRolloutStatus(in *MachineImageRolloutStatus, out *core.MachineImageRolloutStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineImageRolloutStatus_To_core_MachineImageRolloutStatus(in, out, s)
+}
+
+func autoConvert_core_MachineImageRolloutStatus_To_v1beta1_MachineImageRolloutStatus(in *core.MachineImageRolloutStatus, out *MachineImageRolloutStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Versions = *(*[]VersionRolloutStatus)(unsafe.Pointer(&in.Versions))
+	return nil
+}
+
+// Convert_core_MachineImageRolloutStatus_To_v1beta1_MachineImageRolloutStatus is an autogenerated conversion function.
+func Convert_core_MachineImageRolloutStatus_To_v1beta1_MachineImageRolloutStatus(in *core.MachineImageRolloutStatus, out *MachineImageRolloutStatus, s conversion.Scope) error {
+	return autoConvert_core_MachineImageRolloutStatus_To_v1beta1_MachineImageRolloutStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_MachineImageVersion_To_core_MachineImageVersion(in *MachineImageVersion, out *core.MachineImageVersion, s conversion.Scope) error {
 	if err := Convert_v1beta1_ExpirableVersion_To_core_ExpirableVersion(&in.ExpirableVersion, &out.ExpirableVersion, s); err != nil {
 		return err
@@ -4183,6 +4453,7 @@ func autoConvert_v1beta1_MachineType_To_core_MachineType(in *MachineType, out *c
 	out.Storage = (*core.MachineTypeStorage)(unsafe.Pointer(in.Storage))
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.Price = (*core.Price)(unsafe.Pointer(in.Price))
 	return nil
 }
 
@@ -4199,6 +4470,7 @@ func autoConvert_core_MachineType_To_v1beta1_MachineType(in *core.MachineType, o
 	out.Storage = (*MachineTypeStorage)(unsafe.Pointer(in.Storage))
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.Price = (*Price)(unsafe.Pointer(in.Price))
 	return nil
 }
 
@@ -4279,6 +4551,36 @@ func Convert_core_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate(in *cor
 	return autoConvert_core_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate(in, out, s)
 }
 
+func autoConvert_v1beta1_MaintenanceFreezePeriod_To_core_MaintenanceFreezePeriod(in *MaintenanceFreezePeriod, out *core.MaintenanceFreezePeriod, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Begin = (*metav1.Time)(unsafe.Pointer(in.Begin))
+	out.End = (*metav1.Time)(unsafe.Pointer(in.End))
+	out.Schedule = (*string)(unsafe.Pointer(in.Schedule))
+	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
+	out.Location = (*string)(unsafe.Pointer(in.Location))
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceFreezePeriod_To_core_MaintenanceFreezePeriod is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceFreezePeriod_To_core_MaintenanceFreezePeriod(in *MaintenanceFreezePeriod, out *core.MaintenanceFreezePeriod, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceFreezePeriod_To_core_MaintenanceFreezePeriod(in, out, s)
+}
+
+func autoConvert_core_MaintenanceFreezePeriod_To_v1beta1_MaintenanceFreezePeriod(in *core.MaintenanceFreezePeriod, out *MaintenanceFreezePeriod, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Begin = (*metav1.Time)(unsafe.Pointer(in.Begin))
+	out.End = (*metav1.Time)(unsafe.Pointer(in.End))
+	out.Schedule = (*string)(unsafe.Pointer(in.Schedule))
+	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
+	out.Location = (*string)(unsafe.Pointer(in.Location))
+	return nil
+}
+
+// Convert_core_MaintenanceFreezePeriod_To_v1beta1_MaintenanceFreezePeriod is an autogenerated conversion function.
+func Convert_core_MaintenanceFreezePeriod_To_v1beta1_MaintenanceFreezePeriod(in *core.MaintenanceFreezePeriod, out *MaintenanceFreezePeriod, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceFreezePeriod_To_v1beta1_MaintenanceFreezePeriod(in, out, s)
+}
+
 func autoConvert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(in *MaintenanceTimeWindow, out *core.MaintenanceTimeWindow, s conversion.Scope) error {
 	out.Begin = in.Begin
 	out.End = in.End
@@ -4647,6 +4949,28 @@ func Convert_core_OpenIDConnectClientAuthentication_To_v1beta1_OpenIDConnectClie
 	return autoConvert_core_OpenIDConnectClientAuthentication_To_v1beta1_OpenIDConnectClientAuthentication(in, out, s)
 }
 
+func autoConvert_v1beta1_Price_To_core_Price(in *Price, out *core.Price, s conversion.Scope) error {
+	out.Currency = in.Currency
+	out.Amount = in.Amount
+	return nil
+}
+
+// Convert_v1beta1_Price_To_core_Price is an autogenerated conversion function.
+func Convert_v1beta1_Price_To_core_Price(in *Price, out *core.Price, s conversion.Scope) error {
+	return autoConvert_v1beta1_Price_To_core_Price(in, out, s)
+}
+
+func autoConvert_core_Price_To_v1beta1_Price(in *core.Price, out *Price, s conversion.Scope) error {
+	out.Currency = in.Currency
+	out.Amount = in.Amount
+	return nil
+}
+
+// Convert_core_Price_To_v1beta1_Price is an autogenerated conversion function.
+func Convert_core_Price_To_v1beta1_Price(in *core.Price, out *Price, s conversion.Scope) error {
+	return autoConvert_core_Price_To_v1beta1_Price(in, out, s)
+}
+
 func autoConvert_v1beta1_Project_To_core_Project(in *Project, out *core.Project, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_ProjectSpec_To_core_ProjectSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -4679,6 +5003,26 @@ func Convert_core_Project_To_v1beta1_Project(in *core.Project, out *Project, s c
 	return autoConvert_core_Project_To_v1beta1_Project(in, out, s)
 }
 
+func autoConvert_v1beta1_ProjectHibernation_To_core_ProjectHibernation(in *ProjectHibernation, out *core.ProjectHibernation, s conversion.Scope) error {
+	out.IdleTimeout = (*metav1.Duration)(unsafe.Pointer(in.IdleTimeout))
+	return nil
+}
+
+// Convert_v1beta1_ProjectHibernation_To_core_ProjectHibernation is an autogenerated conversion function.
+func Convert_v1beta1_ProjectHibernation_To_core_ProjectHibernation(in *ProjectHibernation, out *core.ProjectHibernation, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectHibernation_To_core_ProjectHibernation(in, out, s)
+}
+
+func autoConvert_core_ProjectHibernation_To_v1beta1_ProjectHibernation(in *core.ProjectHibernation, out *ProjectHibernation, s conversion.Scope) error {
+	out.IdleTimeout = (*metav1.Duration)(unsafe.Pointer(in.IdleTimeout))
+	return nil
+}
+
+// Convert_core_ProjectHibernation_To_v1beta1_ProjectHibernation is an autogenerated conversion function.
+func Convert_core_ProjectHibernation_To_v1beta1_ProjectHibernation(in *core.ProjectHibernation, out *ProjectHibernation, s conversion.Scope) error {
+	return autoConvert_core_ProjectHibernation_To_v1beta1_ProjectHibernation(in, out, s)
+}
+
 func autoConvert_v1beta1_ProjectList_To_core_ProjectList(in *ProjectList, out *core.ProjectList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -4721,6 +5065,26 @@ func Convert_core_ProjectList_To_v1beta1_ProjectList(in *core.ProjectList, out *
 	return autoConvert_core_ProjectList_To_v1beta1_ProjectList(in, out, s)
 }
 
+func autoConvert_v1beta1_ProjectMaintenance_To_core_ProjectMaintenance(in *ProjectMaintenance, out *core.ProjectMaintenance, s conversion.Scope) error {
+	out.FreezePeriods = *(*[]core.MaintenanceFreezePeriod)(unsafe.Pointer(&in.FreezePeriods))
+	return nil
+}
+
+// Convert_v1beta1_ProjectMaintenance_To_core_ProjectMaintenance is an autogenerated conversion function.
+func Convert_v1beta1_ProjectMaintenance_To_core_ProjectMaintenance(in *ProjectMaintenance, out *core.ProjectMaintenance, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectMaintenance_To_core_ProjectMaintenance(in, out, s)
+}
+
+func autoConvert_core_ProjectMaintenance_To_v1beta1_ProjectMaintenance(in *core.ProjectMaintenance, out *ProjectMaintenance, s conversion.Scope) error {
+	out.FreezePeriods = *(*[]MaintenanceFreezePeriod)(unsafe.Pointer(&in.FreezePeriods))
+	return nil
+}
+
+// Convert_core_ProjectMaintenance_To_v1beta1_ProjectMaintenance is an autogenerated conversion function.
+func Convert_core_ProjectMaintenance_To_v1beta1_ProjectMaintenance(in *core.ProjectMaintenance, out *ProjectMaintenance, s conversion.Scope) error {
+	return autoConvert_core_ProjectMaintenance_To_v1beta1_ProjectMaintenance(in, out, s)
+}
+
 func autoConvert_v1beta1_ProjectMember_To_core_ProjectMember(in *ProjectMember, out *core.ProjectMember, s conversion.Scope) error {
 	out.Subject = in.Subject
 	// WARNING: in.Role requires manual conversion: does not exist in peer-type
@@ -4752,6 +5116,9 @@ func autoConvert_v1beta1_ProjectSpec_To_core_ProjectSpec(in *ProjectSpec, out *c
 	}
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	out.Tolerations = (*core.ProjectTolerations)(unsafe.Pointer(in.Tolerations))
+	out.BackupRetentionPolicy = (*core.BackupRetentionPolicy)(unsafe.Pointer(in.BackupRetentionPolicy))
+	out.Maintenance = (*core.ProjectMaintenance)(unsafe.Pointer(in.Maintenance))
+	out.Hibernation = (*core.ProjectHibernation)(unsafe.Pointer(in.Hibernation))
 	return nil
 }
 
@@ -4773,6 +5140,9 @@ func autoConvert_core_ProjectSpec_To_v1beta1_ProjectSpec(in *core.ProjectSpec, o
 	}
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	out.Tolerations = (*ProjectTolerations)(unsafe.Pointer(in.Tolerations))
+	out.BackupRetentionPolicy = (*BackupRetentionPolicy)(unsafe.Pointer(in.BackupRetentionPolicy))
+	out.Maintenance = (*ProjectMaintenance)(unsafe.Pointer(in.Maintenance))
+	out.Hibernation = (*ProjectHibernation)(unsafe.Pointer(in.Hibernation))
 	return nil
 }
 
@@ -4782,6 +5152,7 @@ func autoConvert_v1beta1_ProjectStatus_To_core_ProjectStatus(in *ProjectStatus,
 	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
 	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
 	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.EstimatedCosts = *(*[]core.EstimatedCost)(unsafe.Pointer(&in.EstimatedCosts))
 	return nil
 }
 
@@ -4796,6 +5167,7 @@ func autoConvert_core_ProjectStatus_To_v1beta1_ProjectStatus(in *core.ProjectSta
 	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
 	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
 	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.EstimatedCosts = *(*[]EstimatedCost)(unsafe.Pointer(&in.EstimatedCosts))
 	return nil
 }
 
@@ -4879,6 +5251,9 @@ func autoConvert_v1beta1_Quota_To_core_Quota(in *Quota, out *core.Quota, s conve
 	if err := Convert_v1beta1_QuotaSpec_To_core_QuotaSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_v1beta1_QuotaStatus_To_core_QuotaStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4892,6 +5267,9 @@ func autoConvert_core_Quota_To_v1beta1_Quota(in *core.Quota, out *Quota, s conve
 	if err := Convert_core_QuotaSpec_To_v1beta1_QuotaSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_core_QuotaStatus_To_v1beta1_QuotaStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4922,10 +5300,33 @@ func Convert_core_QuotaList_To_v1beta1_QuotaList(in *core.QuotaList, out *QuotaL
 	return autoConvert_core_QuotaList_To_v1beta1_QuotaList(in, out, s)
 }
 
+func autoConvert_v1beta1_QuotaProjectUsage_To_core_QuotaProjectUsage(in *QuotaProjectUsage, out *core.QuotaProjectUsage, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Usage = *(*v1.ResourceList)(unsafe.Pointer(&in.Usage))
+	return nil
+}
+
+// Convert_v1beta1_QuotaProjectUsage_To_core_QuotaProjectUsage is an autogenerated conversion function.
+func Convert_v1beta1_QuotaProjectUsage_To_core_QuotaProjectUsage(in *QuotaProjectUsage, out *core.QuotaProjectUsage, s conversion.Scope) error {
+	return autoConvert_v1beta1_QuotaProjectUsage_To_core_QuotaProjectUsage(in, out, s)
+}
+
+func autoConvert_core_QuotaProjectUsage_To_v1beta1_QuotaProjectUsage(in *core.QuotaProjectUsage, out *QuotaProjectUsage, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Usage = *(*v1.ResourceList)(unsafe.Pointer(&in.Usage))
+	return nil
+}
+
+// Convert_core_QuotaProjectUsage_To_v1beta1_QuotaProjectUsage is an autogenerated conversion function.
+func Convert_core_QuotaProjectUsage_To_v1beta1_QuotaProjectUsage(in *core.QuotaProjectUsage, out *QuotaProjectUsage, s conversion.Scope) error {
+	return autoConvert_core_QuotaProjectUsage_To_v1beta1_QuotaProjectUsage(in, out, s)
+}
+
 func autoConvert_v1beta1_QuotaSpec_To_core_QuotaSpec(in *QuotaSpec, out *core.QuotaSpec, s conversion.Scope) error {
 	out.ClusterLifetimeDays = (*int32)(unsafe.Pointer(in.ClusterLifetimeDays))
 	out.Metrics = *(*v1.ResourceList)(unsafe.Pointer(&in.Metrics))
 	out.Scope = in.Scope
+	out.SoftLimits = *(*v1.ResourceList)(unsafe.Pointer(&in.SoftLimits))
 	return nil
 }
 
@@ -4938,6 +5339,7 @@ func autoConvert_core_QuotaSpec_To_v1beta1_QuotaSpec(in *core.QuotaSpec, out *Qu
 	out.ClusterLifetimeDays = (*int32)(unsafe.Pointer(in.ClusterLifetimeDays))
 	out.Metrics = *(*v1.ResourceList)(unsafe.Pointer(&in.Metrics))
 	out.Scope = in.Scope
+	out.SoftLimits = *(*v1.ResourceList)(unsafe.Pointer(&in.SoftLimits))
 	return nil
 }
 
@@ -4946,6 +5348,28 @@ func Convert_core_QuotaSpec_To_v1beta1_QuotaSpec(in *core.QuotaSpec, out *QuotaS
 	return autoConvert_core_QuotaSpec_To_v1beta1_QuotaSpec(in, out, s)
 }
 
+func autoConvert_v1beta1_QuotaStatus_To_core_QuotaStatus(in *QuotaStatus, out *core.QuotaStatus, s conversion.Scope) error {
+	out.Usage = *(*v1.ResourceList)(unsafe.Pointer(&in.Usage))
+	out.ProjectUsages = *(*[]core.QuotaProjectUsage)(unsafe.Pointer(&in.ProjectUsages))
+	return nil
+}
+
+// Convert_v1beta1_QuotaStatus_To_core_QuotaStatus is an autogenerated conversion function.
+func Convert_v1beta1_QuotaStatus_To_core_QuotaStatus(in *QuotaStatus, out *core.QuotaStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_QuotaStatus_To_core_QuotaStatus(in, out, s)
+}
+
+func autoConvert_core_QuotaStatus_To_v1beta1_QuotaStatus(in *core.QuotaStatus, out *QuotaStatus, s conversion.Scope) error {
+	out.Usage = *(*v1.ResourceList)(unsafe.Pointer(&in.Usage))
+	out.ProjectUsages = *(*[]QuotaProjectUsage)(unsafe.Pointer(&in.ProjectUsages))
+	return nil
+}
+
+// Convert_core_QuotaStatus_To_v1beta1_QuotaStatus is an autogenerated conversion function.
+func Convert_core_QuotaStatus_To_v1beta1_QuotaStatus(in *core.QuotaStatus, out *QuotaStatus, s conversion.Scope) error {
+	return autoConvert_core_QuotaStatus_To_v1beta1_QuotaStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_Region_To_core_Region(in *Region, out *core.Region, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Zones = *(*[]core.AvailabilityZone)(unsafe.Pointer(&in.Zones))
@@ -5016,6 +5440,30 @@ func Convert_core_ResourceWatchCacheSize_To_v1beta1_ResourceWatchCacheSize(in *c
 	return autoConvert_core_ResourceWatchCacheSize_To_v1beta1_ResourceWatchCacheSize(in, out, s)
 }
 
+func autoConvert_v1beta1_RolloutWaveStatus_To_core_RolloutWaveStatus(in *RolloutWaveStatus, out *core.RolloutWaveStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ReleasedAt = in.ReleasedAt
+	out.HealthySince = in.HealthySince
+	return nil
+}
+
+// Convert_v1beta1_RolloutWaveStatus_To_core_RolloutWaveStatus is an autogenerated conversion function.
+func Convert_v1beta1_RolloutWaveStatus_To_core_RolloutWaveStatus(in *RolloutWaveStatus, out *core.RolloutWaveStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_RolloutWaveStatus_To_core_RolloutWaveStatus(in, out, s)
+}
+
+func autoConvert_core_RolloutWaveStatus_To_v1beta1_RolloutWaveStatus(in *core.RolloutWaveStatus, out *RolloutWaveStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ReleasedAt = in.ReleasedAt
+	out.HealthySince = in.HealthySince
+	return nil
+}
+
+// Convert_core_RolloutWaveStatus_To_v1beta1_RolloutWaveStatus is an autogenerated conversion function.
+func Convert_core_RolloutWaveStatus_To_v1beta1_RolloutWaveStatus(in *core.RolloutWaveStatus, out *RolloutWaveStatus, s conversion.Scope) error {
+	return autoConvert_core_RolloutWaveStatus_To_v1beta1_RolloutWaveStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_SSHAccess_To_core_SSHAccess(in *SSHAccess, out *core.SSHAccess, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	return nil
@@ -5852,6 +6300,148 @@ func Convert_core_ShootAdvertisedAddress_To_v1beta1_ShootAdvertisedAddress(in *c
 	return autoConvert_core_ShootAdvertisedAddress_To_v1beta1_ShootAdvertisedAddress(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootBlueprint_To_core_ShootBlueprint(in *ShootBlueprint, out *core.ShootBlueprint, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ShootBlueprintSpec_To_core_ShootBlueprintSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_ShootBlueprintStatus_To_core_ShootBlueprintStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ShootBlueprint_To_core_ShootBlueprint is an autogenerated conversion function.
+func Convert_v1beta1_ShootBlueprint_To_core_ShootBlueprint(in *ShootBlueprint, out *core.ShootBlueprint, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootBlueprint_To_core_ShootBlueprint(in, out, s)
+}
+
+func autoConvert_core_ShootBlueprint_To_v1beta1_ShootBlueprint(in *core.ShootBlueprint, out *ShootBlueprint, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_ShootBlueprintSpec_To_v1beta1_ShootBlueprintSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_core_ShootBlueprintStatus_To_v1beta1_ShootBlueprintStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_ShootBlueprint_To_v1beta1_ShootBlueprint is an autogenerated conversion function.
+func Convert_core_ShootBlueprint_To_v1beta1_ShootBlueprint(in *core.ShootBlueprint, out *ShootBlueprint, s conversion.Scope) error {
+	return autoConvert_core_ShootBlueprint_To_v1beta1_ShootBlueprint(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootBlueprintList_To_core_ShootBlueprintList(in *ShootBlueprintList, out *core.ShootBlueprintList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]core.ShootBlueprint, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_ShootBlueprint_To_core_ShootBlueprint(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_ShootBlueprintList_To_core_ShootBlueprintList is an autogenerated conversion function.
+func Convert_v1beta1_ShootBlueprintList_To_core_ShootBlueprintList(in *ShootBlueprintList, out *core.ShootBlueprintList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootBlueprintList_To_core_ShootBlueprintList(in, out, s)
+}
+
+func autoConvert_core_ShootBlueprintList_To_v1beta1_ShootBlueprintList(in *core.ShootBlueprintList, out *ShootBlueprintList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ShootBlueprint, len(*in))
+		for i := range *in {
+			if err := Convert_core_ShootBlueprint_To_v1beta1_ShootBlueprint(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_core_ShootBlueprintList_To_v1beta1_ShootBlueprintList is an autogenerated conversion function.
+func Convert_core_ShootBlueprintList_To_v1beta1_ShootBlueprintList(in *core.ShootBlueprintList, out *ShootBlueprintList, s conversion.Scope) error {
+	return autoConvert_core_ShootBlueprintList_To_v1beta1_ShootBlueprintList(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootBlueprintReference_To_core_ShootBlueprintReference(in *ShootBlueprintReference, out *core.ShootBlueprintReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Overrides = (*runtime.RawExtension)(unsafe.Pointer(in.Overrides))
+	return nil
+}
+
+// Convert_v1beta1_ShootBlueprintReference_To_core_ShootBlueprintReference is an autogenerated conversion function.
+func Convert_v1beta1_ShootBlueprintReference_To_core_ShootBlueprintReference(in *ShootBlueprintReference, out *core.ShootBlueprintReference, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootBlueprintReference_To_core_ShootBlueprintReference(in, out, s)
+}
+
+func autoConvert_core_ShootBlueprintReference_To_v1beta1_ShootBlueprintReference(in *core.ShootBlueprintReference, out *ShootBlueprintReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Overrides = (*runtime.RawExtension)(unsafe.Pointer(in.Overrides))
+	return nil
+}
+
+// Convert_core_ShootBlueprintReference_To_v1beta1_ShootBlueprintReference is an autogenerated conversion function.
+func Convert_core_ShootBlueprintReference_To_v1beta1_ShootBlueprintReference(in *core.ShootBlueprintReference, out *ShootBlueprintReference, s conversion.Scope) error {
+	return autoConvert_core_ShootBlueprintReference_To_v1beta1_ShootBlueprintReference(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootBlueprintSpec_To_core_ShootBlueprintSpec(in *ShootBlueprintSpec, out *core.ShootBlueprintSpec, s conversion.Scope) error {
+	if err := Convert_v1beta1_ShootTemplate_To_core_ShootTemplate(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ShootBlueprintSpec_To_core_ShootBlueprintSpec is an autogenerated conversion function.
+func Convert_v1beta1_ShootBlueprintSpec_To_core_ShootBlueprintSpec(in *ShootBlueprintSpec, out *core.ShootBlueprintSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootBlueprintSpec_To_core_ShootBlueprintSpec(in, out, s)
+}
+
+func autoConvert_core_ShootBlueprintSpec_To_v1beta1_ShootBlueprintSpec(in *core.ShootBlueprintSpec, out *ShootBlueprintSpec, s conversion.Scope) error {
+	if err := Convert_core_ShootTemplate_To_v1beta1_ShootTemplate(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_ShootBlueprintSpec_To_v1beta1_ShootBlueprintSpec is an autogenerated conversion function.
+func Convert_core_ShootBlueprintSpec_To_v1beta1_ShootBlueprintSpec(in *core.ShootBlueprintSpec, out *ShootBlueprintSpec, s conversion.Scope) error {
+	return autoConvert_core_ShootBlueprintSpec_To_v1beta1_ShootBlueprintSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootBlueprintStatus_To_core_ShootBlueprintStatus(in *ShootBlueprintStatus, out *core.ShootBlueprintStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.OutdatedShoots = *(*[]string)(unsafe.Pointer(&in.OutdatedShoots))
+	return nil
+}
+
+// Convert_v1beta1_ShootBlueprintStatus_To_core_ShootBlueprintStatus is an autogenerated conversion function.
+func Convert_v1beta1_ShootBlueprintStatus_To_core_ShootBlueprintStatus(in *ShootBlueprintStatus, out *core.ShootBlueprintStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootBlueprintStatus_To_core_ShootBlueprintStatus(in, out, s)
+}
+
+func autoConvert_core_ShootBlueprintStatus_To_v1beta1_ShootBlueprintStatus(in *core.ShootBlueprintStatus, out *ShootBlueprintStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.OutdatedShoots = *(*[]string)(unsafe.Pointer(&in.OutdatedShoots))
+	return nil
+}
+
+// Convert_core_ShootBlueprintStatus_To_v1beta1_ShootBlueprintStatus is an autogenerated conversion function.
+func Convert_core_ShootBlueprintStatus_To_v1beta1_ShootBlueprintStatus(in *core.ShootBlueprintStatus, out *ShootBlueprintStatus, s conversion.Scope) error {
+	return autoConvert_core_ShootBlueprintStatus_To_v1beta1_ShootBlueprintStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootCredentials_To_core_ShootCredentials(in *ShootCredentials, out *core.ShootCredentials, s conversion.Scope) error {
 	out.Rotation = (*core.ShootCredentialsRotation)(unsafe.Pointer(in.Rotation))
 	return nil
@@ -6065,6 +6655,8 @@ func autoConvert_v1beta1_ShootSpec_To_core_ShootSpec(in *ShootSpec, out *core.Sh
 	out.ControlPlane = (*core.ControlPlane)(unsafe.Pointer(in.ControlPlane))
 	out.SchedulerName = (*string)(unsafe.Pointer(in.SchedulerName))
 	out.CloudProfile = (*core.CloudProfileReference)(unsafe.Pointer(in.CloudProfile))
+	out.Blueprint = (*core.ShootBlueprintReference)(unsafe.Pointer(in.Blueprint))
+	out.BackupRetentionPolicy = (*core.BackupRetentionPolicy)(unsafe.Pointer(in.BackupRetentionPolicy))
 	return nil
 }
 
@@ -6100,6 +6692,8 @@ func autoConvert_core_ShootSpec_To_v1beta1_ShootSpec(in *core.ShootSpec, out *Sh
 	out.ControlPlane = (*ControlPlane)(unsafe.Pointer(in.ControlPlane))
 	out.SchedulerName = (*string)(unsafe.Pointer(in.SchedulerName))
 	out.CloudProfile = (*CloudProfileReference)(unsafe.Pointer(in.CloudProfile))
+	out.Blueprint = (*ShootBlueprintReference)(unsafe.Pointer(in.Blueprint))
+	out.BackupRetentionPolicy = (*BackupRetentionPolicy)(unsafe.Pointer(in.BackupRetentionPolicy))
 	return nil
 }
 
@@ -6201,6 +6795,7 @@ func autoConvert_v1beta1_ShootStatus_To_core_ShootStatus(in *ShootStatus, out *c
 	out.LastHibernationTriggerTime = (*metav1.Time)(unsafe.Pointer(in.LastHibernationTriggerTime))
 	out.LastMaintenance = (*core.LastMaintenance)(unsafe.Pointer(in.LastMaintenance))
 	out.EncryptedResources = *(*[]string)(unsafe.Pointer(&in.EncryptedResources))
+	out.EstimatedCost = (*core.EstimatedCost)(unsafe.Pointer(in.EstimatedCost))
 	return nil
 }
 
@@ -6230,6 +6825,7 @@ func autoConvert_core_ShootStatus_To_v1beta1_ShootStatus(in *core.ShootStatus, o
 	out.Credentials = (*ShootCredentials)(unsafe.Pointer(in.Credentials))
 	out.LastMaintenance = (*LastMaintenance)(unsafe.Pointer(in.LastMaintenance))
 	out.EncryptedResources = *(*[]string)(unsafe.Pointer(&in.EncryptedResources))
+	out.EstimatedCost = (*EstimatedCost)(unsafe.Pointer(in.EstimatedCost))
 	return nil
 }
 
@@ -6308,6 +6904,32 @@ func Convert_core_Toleration_To_v1beta1_Toleration(in *core.Toleration, out *Tol
 	return autoConvert_core_Toleration_To_v1beta1_Toleration(in, out, s)
 }
 
+func autoConvert_v1beta1_VersionRolloutStatus_To_core_VersionRolloutStatus(in *VersionRolloutStatus, out *core.VersionRolloutStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Waves = *(*[]core.RolloutWaveStatus)(unsafe.Pointer(&in.Waves))
+	out.Completed = in.Completed
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	return nil
+}
+
+// Convert_v1beta1_VersionRolloutStatus_To_core_VersionRolloutStatus is an autogenerated conversion function.
+func Convert_v1beta1_VersionRolloutStatus_To_core_VersionRolloutStatus(in *VersionRolloutStatus, out *core.VersionRolloutStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_VersionRolloutStatus_To_core_VersionRolloutStatus(in, out, s)
+}
+
+func autoConvert_core_VersionRolloutStatus_To_v1beta1_VersionRolloutStatus(in *core.VersionRolloutStatus, out *VersionRolloutStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Waves = *(*[]RolloutWaveStatus)(unsafe.Pointer(&in.Waves))
+	out.Completed = in.Completed
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	return nil
+}
+
+// Convert_core_VersionRolloutStatus_To_v1beta1_VersionRolloutStatus is an autogenerated conversion function.
+func Convert_core_VersionRolloutStatus_To_v1beta1_VersionRolloutStatus(in *core.VersionRolloutStatus, out *VersionRolloutStatus, s conversion.Scope) error {
+	return autoConvert_core_VersionRolloutStatus_To_v1beta1_VersionRolloutStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_VerticalPodAutoscaler_To_core_VerticalPodAutoscaler(in *VerticalPodAutoscaler, out *core.VerticalPodAutoscaler, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.EvictAfterOOMThreshold = (*metav1.Duration)(unsafe.Pointer(in.EvictAfterOOMThreshold))
@@ -6375,6 +6997,7 @@ func autoConvert_v1beta1_VolumeType_To_core_VolumeType(in *VolumeType, out *core
 	out.Name = in.Name
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
 	out.MinSize = (*resource.Quantity)(unsafe.Pointer(in.MinSize))
+	out.Price = (*core.Price)(unsafe.Pointer(in.Price))
 	return nil
 }
 
@@ -6388,6 +7011,7 @@ func autoConvert_core_VolumeType_To_v1beta1_VolumeType(in *core.VolumeType, out
 	out.Name = in.Name
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
 	out.MinSize = (*resource.Quantity)(unsafe.Pointer(in.MinSize))
+	out.Price = (*Price)(unsafe.Pointer(in.Price))
 	return nil
 }
 
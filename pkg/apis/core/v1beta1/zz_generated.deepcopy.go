@@ -441,6 +441,11 @@ func (in *BackupEntrySpec) DeepCopyInto(out *BackupEntrySpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RetentionPolicy != nil {
+		in, out := &in.RetentionPolicy, &out.RetentionPolicy
+		*out = new(BackupRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -489,6 +494,37 @@ func (in *BackupEntryStatus) DeepCopy() *BackupEntryStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRetentionPolicy) DeepCopyInto(out *BackupRetentionPolicy) {
+	*out = *in
+	if in.RetentionAfterDeletion != nil {
+		in, out := &in.RetentionAfterDeletion, &out.RetentionAfterDeletion
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.LegalHold != nil {
+		in, out := &in.LegalHold, &out.LegalHold
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FullSnapshotsToKeep != nil {
+		in, out := &in.FullSnapshotsToKeep, &out.FullSnapshotsToKeep
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRetentionPolicy.
+func (in *BackupRetentionPolicy) DeepCopy() *BackupRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CARotation) DeepCopyInto(out *CARotation) {
 	*out = *in
@@ -550,6 +586,7 @@ func (in *CloudProfile) DeepCopyInto(out *CloudProfile) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -680,6 +717,36 @@ func (in *CloudProfileSpec) DeepCopy() *CloudProfileSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudProfileStatus) DeepCopyInto(out *CloudProfileStatus) {
+	*out = *in
+	if in.KubernetesVersions != nil {
+		in, out := &in.KubernetesVersions, &out.KubernetesVersions
+		*out = make([]VersionRolloutStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MachineImages != nil {
+		in, out := &in.MachineImages, &out.MachineImages
+		*out = make([]MachineImageRolloutStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudProfileStatus.
+func (in *CloudProfileStatus) DeepCopy() *CloudProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterAutoscaler) DeepCopyInto(out *ClusterAutoscaler) {
 	*out = *in
@@ -1488,6 +1555,24 @@ func (in *EncryptionConfig) DeepCopy() *EncryptionConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EstimatedCost) DeepCopyInto(out *EstimatedCost) {
+	*out = *in
+	out.Hourly = in.Hourly.DeepCopy()
+	out.Monthly = in.Monthly.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EstimatedCost.
+func (in *EstimatedCost) DeepCopy() *EstimatedCost {
+	if in == nil {
+		return nil
+	}
+	out := new(EstimatedCost)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExpirableVersion) DeepCopyInto(out *ExpirableVersion) {
 	*out = *in
@@ -2730,6 +2815,29 @@ func (in *MachineImage) DeepCopy() *MachineImage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImageRolloutStatus) DeepCopyInto(out *MachineImageRolloutStatus) {
+	*out = *in
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]VersionRolloutStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImageRolloutStatus.
+func (in *MachineImageRolloutStatus) DeepCopy() *MachineImageRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImageRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineImageVersion) DeepCopyInto(out *MachineImageVersion) {
 	*out = *in
@@ -2785,6 +2893,11 @@ func (in *MachineType) DeepCopyInto(out *MachineType) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Price != nil {
+		in, out := &in.Price, &out.Price
+		*out = new(Price)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2876,6 +2989,45 @@ func (in *MaintenanceAutoUpdate) DeepCopy() *MaintenanceAutoUpdate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceFreezePeriod) DeepCopyInto(out *MaintenanceFreezePeriod) {
+	*out = *in
+	if in.Begin != nil {
+		in, out := &in.Begin, &out.Begin
+		*out = (*in).DeepCopy()
+	}
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = (*in).DeepCopy()
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(string)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceFreezePeriod.
+func (in *MaintenanceFreezePeriod) DeepCopy() *MaintenanceFreezePeriod {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceFreezePeriod)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MaintenanceTimeWindow) DeepCopyInto(out *MaintenanceTimeWindow) {
 	*out = *in
@@ -3315,6 +3467,23 @@ func (in *OpenIDConnectClientAuthentication) DeepCopy() *OpenIDConnectClientAuth
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Price) DeepCopyInto(out *Price) {
+	*out = *in
+	out.Amount = in.Amount.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Price.
+func (in *Price) DeepCopy() *Price {
+	if in == nil {
+		return nil
+	}
+	out := new(Price)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Project) DeepCopyInto(out *Project) {
 	*out = *in
@@ -3343,6 +3512,27 @@ func (in *Project) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectHibernation) DeepCopyInto(out *ProjectHibernation) {
+	*out = *in
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectHibernation.
+func (in *ProjectHibernation) DeepCopy() *ProjectHibernation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectHibernation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectList) DeepCopyInto(out *ProjectList) {
 	*out = *in
@@ -3376,6 +3566,29 @@ func (in *ProjectList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectMaintenance) DeepCopyInto(out *ProjectMaintenance) {
+	*out = *in
+	if in.FreezePeriods != nil {
+		in, out := &in.FreezePeriods, &out.FreezePeriods
+		*out = make([]MaintenanceFreezePeriod, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectMaintenance.
+func (in *ProjectMaintenance) DeepCopy() *ProjectMaintenance {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectMaintenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectMember) DeepCopyInto(out *ProjectMember) {
 	*out = *in
@@ -3438,6 +3651,21 @@ func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 		*out = new(ProjectTolerations)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BackupRetentionPolicy != nil {
+		in, out := &in.BackupRetentionPolicy, &out.BackupRetentionPolicy
+		*out = new(BackupRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(ProjectMaintenance)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(ProjectHibernation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3466,6 +3694,13 @@ func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
 		in, out := &in.LastActivityTimestamp, &out.LastActivityTimestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.EstimatedCosts != nil {
+		in, out := &in.EstimatedCosts, &out.EstimatedCosts
+		*out = make([]EstimatedCost, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -3553,6 +3788,7 @@ func (in *Quota) DeepCopyInto(out *Quota) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -3607,6 +3843,29 @@ func (in *QuotaList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaProjectUsage) DeepCopyInto(out *QuotaProjectUsage) {
+	*out = *in
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaProjectUsage.
+func (in *QuotaProjectUsage) DeepCopy() *QuotaProjectUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaProjectUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QuotaSpec) DeepCopyInto(out *QuotaSpec) {
 	*out = *in
@@ -3623,6 +3882,13 @@ func (in *QuotaSpec) DeepCopyInto(out *QuotaSpec) {
 		}
 	}
 	out.Scope = in.Scope
+	if in.SoftLimits != nil {
+		in, out := &in.SoftLimits, &out.SoftLimits
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -3636,6 +3902,36 @@ func (in *QuotaSpec) DeepCopy() *QuotaSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaStatus) DeepCopyInto(out *QuotaStatus) {
+	*out = *in
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.ProjectUsages != nil {
+		in, out := &in.ProjectUsages, &out.ProjectUsages
+		*out = make([]QuotaProjectUsage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaStatus.
+func (in *QuotaStatus) DeepCopy() *QuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Region) DeepCopyInto(out *Region) {
 	*out = *in
@@ -3705,6 +4001,24 @@ func (in *ResourceWatchCacheSize) DeepCopy() *ResourceWatchCacheSize {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutWaveStatus) DeepCopyInto(out *RolloutWaveStatus) {
+	*out = *in
+	in.ReleasedAt.DeepCopyInto(&out.ReleasedAt)
+	in.HealthySince.DeepCopyInto(&out.HealthySince)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutWaveStatus.
+func (in *RolloutWaveStatus) DeepCopy() *RolloutWaveStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutWaveStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SSHAccess) DeepCopyInto(out *SSHAccess) {
 	*out = *in
@@ -4597,6 +4911,126 @@ func (in *ShootAdvertisedAddress) DeepCopy() *ShootAdvertisedAddress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootBlueprint) DeepCopyInto(out *ShootBlueprint) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootBlueprint.
+func (in *ShootBlueprint) DeepCopy() *ShootBlueprint {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootBlueprint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootBlueprint) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootBlueprintList) DeepCopyInto(out *ShootBlueprintList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ShootBlueprint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootBlueprintList.
+func (in *ShootBlueprintList) DeepCopy() *ShootBlueprintList {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootBlueprintList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootBlueprintList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootBlueprintReference) DeepCopyInto(out *ShootBlueprintReference) {
+	*out = *in
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootBlueprintReference.
+func (in *ShootBlueprintReference) DeepCopy() *ShootBlueprintReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootBlueprintReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootBlueprintSpec) DeepCopyInto(out *ShootBlueprintSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootBlueprintSpec.
+func (in *ShootBlueprintSpec) DeepCopy() *ShootBlueprintSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootBlueprintSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootBlueprintStatus) DeepCopyInto(out *ShootBlueprintStatus) {
+	*out = *in
+	if in.OutdatedShoots != nil {
+		in, out := &in.OutdatedShoots, &out.OutdatedShoots
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootBlueprintStatus.
+func (in *ShootBlueprintStatus) DeepCopy() *ShootBlueprintStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootBlueprintStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootCredentials) DeepCopyInto(out *ShootCredentials) {
 	*out = *in
@@ -4896,6 +5330,16 @@ func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
 		*out = new(CloudProfileReference)
 		**out = **in
 	}
+	if in.Blueprint != nil {
+		in, out := &in.Blueprint, &out.Blueprint
+		*out = new(ShootBlueprintReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackupRetentionPolicy != nil {
+		in, out := &in.BackupRetentionPolicy, &out.BackupRetentionPolicy
+		*out = new(BackupRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5078,6 +5522,11 @@ func (in *ShootStatus) DeepCopyInto(out *ShootStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.EstimatedCost != nil {
+		in, out := &in.EstimatedCost, &out.EstimatedCost
+		*out = new(EstimatedCost)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5156,6 +5605,34 @@ func (in *Toleration) DeepCopy() *Toleration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VersionRolloutStatus) DeepCopyInto(out *VersionRolloutStatus) {
+	*out = *in
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]RolloutWaveStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VersionRolloutStatus.
+func (in *VersionRolloutStatus) DeepCopy() *VersionRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VersionRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VerticalPodAutoscaler) DeepCopyInto(out *VerticalPodAutoscaler) {
 	*out = *in
@@ -5256,6 +5733,11 @@ func (in *VolumeType) DeepCopyInto(out *VolumeType) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.Price != nil {
+		in, out := &in.Price, &out.Price
+		*out = new(Price)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -30,6 +30,8 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&Seed{}, func(obj interface{}) { SetObjectDefaults_Seed(obj.(*Seed)) })
 	scheme.AddTypeDefaultingFunc(&SeedList{}, func(obj interface{}) { SetObjectDefaults_SeedList(obj.(*SeedList)) })
 	scheme.AddTypeDefaultingFunc(&Shoot{}, func(obj interface{}) { SetObjectDefaults_Shoot(obj.(*Shoot)) })
+	scheme.AddTypeDefaultingFunc(&ShootBlueprint{}, func(obj interface{}) { SetObjectDefaults_ShootBlueprint(obj.(*ShootBlueprint)) })
+	scheme.AddTypeDefaultingFunc(&ShootBlueprintList{}, func(obj interface{}) { SetObjectDefaults_ShootBlueprintList(obj.(*ShootBlueprintList)) })
 	scheme.AddTypeDefaultingFunc(&ShootList{}, func(obj interface{}) { SetObjectDefaults_ShootList(obj.(*ShootList)) })
 	return nil
 }
@@ -194,6 +196,40 @@ func SetObjectDefaults_Shoot(in *Shoot) {
 	}
 }
 
+func SetObjectDefaults_ShootBlueprint(in *ShootBlueprint) {
+	if in.Spec.Template.Spec.Addons != nil {
+		if in.Spec.Template.Spec.Addons.NginxIngress != nil {
+			SetDefaults_NginxIngress(in.Spec.Template.Spec.Addons.NginxIngress)
+		}
+	}
+	if in.Spec.Template.Spec.Kubernetes.ClusterAutoscaler != nil {
+		SetDefaults_ClusterAutoscaler(in.Spec.Template.Spec.Kubernetes.ClusterAutoscaler)
+	}
+	if in.Spec.Template.Spec.Kubernetes.KubeAPIServer != nil {
+		SetDefaults_KubeAPIServerConfig(in.Spec.Template.Spec.Kubernetes.KubeAPIServer)
+	}
+	if in.Spec.Template.Spec.Kubernetes.VerticalPodAutoscaler != nil {
+		SetDefaults_VerticalPodAutoscaler(in.Spec.Template.Spec.Kubernetes.VerticalPodAutoscaler)
+	}
+	if in.Spec.Template.Spec.Networking != nil {
+		SetDefaults_Networking(in.Spec.Template.Spec.Networking)
+	}
+	if in.Spec.Template.Spec.Maintenance != nil {
+		SetDefaults_Maintenance(in.Spec.Template.Spec.Maintenance)
+	}
+	for i := range in.Spec.Template.Spec.Provider.Workers {
+		a := &in.Spec.Template.Spec.Provider.Workers[i]
+		SetDefaults_Worker(a)
+	}
+}
+
+func SetObjectDefaults_ShootBlueprintList(in *ShootBlueprintList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ShootBlueprint(a)
+	}
+}
+
 func SetObjectDefaults_ShootList(in *ShootList) {
 	for i := range in.Items {
 		a := &in.Items[i]
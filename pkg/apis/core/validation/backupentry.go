@@ -5,10 +5,13 @@
 package validation
 
 import (
+	"strconv"
+
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/gardener/pkg/apis/core"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 )
 
 // ValidateBackupEntry validates a BackupEntry object.
@@ -40,6 +43,28 @@ func ValidateBackupEntrySpec(spec *core.BackupEntrySpec, fldPath *field.Path) fi
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("bucketName"), spec.BucketName, "bucketName must not be empty"))
 	}
 
+	allErrs = append(allErrs, ValidateBackupRetentionPolicy(spec.RetentionPolicy, fldPath.Child("retentionPolicy"))...)
+
+	return allErrs
+}
+
+// ValidateBackupRetentionPolicy validates the retention and lifecycle settings for etcd backups.
+func ValidateBackupRetentionPolicy(policy *core.BackupRetentionPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if policy == nil {
+		return allErrs
+	}
+
+	if retention := policy.RetentionAfterDeletion; retention != nil && retention.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("retentionAfterDeletion"), *retention, "can not be negative"))
+	}
+
+	// etcd-druid only supports a fixed number of full snapshots for its limit-based garbage collection.
+	if fullSnapshotsToKeep := policy.FullSnapshotsToKeep; fullSnapshotsToKeep != nil && *fullSnapshotsToKeep != v1beta1constants.ETCDFullSnapshotsToKeepLimitBased {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("fullSnapshotsToKeep"), *fullSnapshotsToKeep, []string{strconv.Itoa(v1beta1constants.ETCDFullSnapshotsToKeepLimitBased)}))
+	}
+
 	return allErrs
 }
 
@@ -5,11 +5,14 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/apis/core"
 	. "github.com/gardener/gardener/pkg/apis/core/validation"
@@ -65,6 +68,38 @@ var _ = Describe("validation", func() {
 				"Field": Equal("spec.bucketName"),
 			}))))
 		})
+
+		It("should allow a valid retention policy", func() {
+			backupEntry.Spec.RetentionPolicy = &core.BackupRetentionPolicy{
+				RetentionAfterDeletion: &metav1.Duration{Duration: 720 * time.Hour},
+				LegalHold:              ptr.To(true),
+				FullSnapshotsToKeep:    ptr.To[int32](7),
+			}
+
+			Expect(ValidateBackupEntry(backupEntry)).To(BeEmpty())
+		})
+
+		It("should forbid a negative retention after deletion", func() {
+			backupEntry.Spec.RetentionPolicy = &core.BackupRetentionPolicy{
+				RetentionAfterDeletion: &metav1.Duration{Duration: -time.Hour},
+			}
+
+			Expect(ValidateBackupEntry(backupEntry)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.retentionPolicy.retentionAfterDeletion"),
+			}))))
+		})
+
+		It("should forbid a number of full snapshots which is not supported by etcd-druid", func() {
+			backupEntry.Spec.RetentionPolicy = &core.BackupRetentionPolicy{
+				FullSnapshotsToKeep: ptr.To[int32](3),
+			}
+
+			Expect(ValidateBackupEntry(backupEntry)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.retentionPolicy.fullSnapshotsToKeep"),
+			}))))
+		})
 	})
 
 	Context("#ValidateBackupEntryUpdate", func() {
@@ -51,6 +51,54 @@ func ValidateCloudProfileUpdate(newProfile, oldProfile *core.CloudProfile) field
 	return allErrs
 }
 
+// ValidateCloudProfileStatusUpdate validates the status field of a CloudProfile object before an update.
+func ValidateCloudProfileStatusUpdate(newProfile, _ *core.CloudProfile) field.ErrorList {
+	allErrs := field.ErrorList{}
+	fldPath := field.NewPath("status")
+
+	allErrs = append(allErrs, validateVersionRolloutStatuses(newProfile.Status.KubernetesVersions, fldPath.Child("kubernetesVersions"))...)
+
+	names := sets.New[string]()
+	for i, machineImage := range newProfile.Status.MachineImages {
+		idxPath := fldPath.Child("machineImages").Index(i)
+
+		if len(machineImage.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if names.Has(machineImage.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), machineImage.Name))
+		}
+		names.Insert(machineImage.Name)
+
+		allErrs = append(allErrs, validateVersionRolloutStatuses(machineImage.Versions, idxPath.Child("versions"))...)
+	}
+
+	return allErrs
+}
+
+func validateVersionRolloutStatuses(versions []core.VersionRolloutStatus, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	found := sets.New[string]()
+	for i, version := range versions {
+		idxPath := fldPath.Index(i)
+
+		if len(version.Version) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("version"), "must provide a version"))
+		} else if found.Has(version.Version) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("version"), version.Version))
+		}
+		found.Insert(version.Version)
+
+		for j, wave := range version.Waves {
+			if len(wave.Name) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("waves").Index(j).Child("name"), "must provide a name"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateCloudProfileSpecUpdate validates the spec update of a CloudProfile
 func ValidateCloudProfileSpecUpdate(_, _ *core.CloudProfileSpec, _ *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -70,6 +118,7 @@ func ValidateCloudProfileSpec(spec *core.CloudProfileSpec, fldPath *field.Path)
 	allErrs = append(allErrs, validateMachineImages(spec.MachineImages, fldPath.Child("machineImages"))...)
 	allErrs = append(allErrs, validateMachineTypes(spec.MachineTypes, fldPath.Child("machineTypes"))...)
 	allErrs = append(allErrs, validateVolumeTypes(spec.VolumeTypes, fldPath.Child("volumeTypes"))...)
+	allErrs = append(allErrs, validatePriceCurrencies(spec.MachineTypes, spec.VolumeTypes, fldPath)...)
 	allErrs = append(allErrs, validateRegions(spec.Regions, fldPath.Child("regions"))...)
 	if spec.SeedSelector != nil {
 		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&spec.SeedSelector.LabelSelector, metav1validation.LabelSelectorValidationOptions{AllowInvalidLabelValueInSelector: true}, fldPath.Child("seedSelector"))...)
@@ -182,6 +231,10 @@ func validateMachineTypes(machineTypes []core.MachineType, fldPath *field.Path)
 		if machineType.Storage != nil {
 			allErrs = append(allErrs, validateMachineTypeStorage(*machineType.Storage, idxPath.Child("storage"))...)
 		}
+
+		if machineType.Price != nil {
+			allErrs = append(allErrs, validatePrice(*machineType.Price, idxPath.Child("price"))...)
+		}
 	}
 
 	return allErrs
@@ -366,6 +419,54 @@ func validateVolumeTypes(volumeTypes []core.VolumeType, fldPath *field.Path) fie
 		if volumeType.MinSize != nil {
 			allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue("minSize", *volumeType.MinSize, idxPath.Child("minSize"))...)
 		}
+
+		if volumeType.Price != nil {
+			allErrs = append(allErrs, validatePrice(*volumeType.Price, idxPath.Child("price"))...)
+		}
+	}
+
+	return allErrs
+}
+
+// currencyRegex is used to validate ISO 4217 currency codes of prices.
+var currencyRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+
+func validatePrice(price core.Price, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(price.Currency) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("currency"), "must provide a currency"))
+	} else if !currencyRegex.MatchString(price.Currency) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("currency"), price.Currency, "must be an ISO 4217 currency code, e.g. USD"))
+	}
+
+	allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue("amount", price.Amount, fldPath.Child("amount"))...)
+
+	return allErrs
+}
+
+// validatePriceCurrencies ensures that all prices of the machine types and volume types use the same currency so that
+// the cost of Shoots can be estimated.
+func validatePriceCurrencies(machineTypes []core.MachineType, volumeTypes []core.VolumeType, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var currency string
+	checkCurrency := func(price *core.Price, fldPath *field.Path) {
+		if price == nil || len(price.Currency) == 0 {
+			return
+		}
+		if len(currency) == 0 {
+			currency = price.Currency
+		} else if price.Currency != currency {
+			allErrs = append(allErrs, field.Invalid(fldPath, price.Currency, fmt.Sprintf("all prices must use the same currency (%s)", currency)))
+		}
+	}
+
+	for i, machineType := range machineTypes {
+		checkCurrency(machineType.Price, fldPath.Child("machineTypes").Index(i).Child("price", "currency"))
+	}
+	for i, volumeType := range volumeTypes {
+		checkCurrency(volumeType.Price, fldPath.Child("volumeTypes").Index(i).Child("price", "currency"))
 	}
 
 	return allErrs
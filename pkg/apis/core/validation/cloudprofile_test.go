@@ -221,6 +221,58 @@ var _ = Describe("CloudProfile Validation Tests ", func() {
 				Expect(errorList).To(BeEmpty())
 			})
 
+			DescribeTable("prices",
+				func(machineTypePrice, volumeTypePrice *core.Price, matcher gomegatypes.GomegaMatcher) {
+					machineType := machineTypesConstraint[0]
+					machineType.Price = machineTypePrice
+					volumeType := volumeTypesConstraint[0]
+					volumeType.Price = volumeTypePrice
+					cloudProfile.Spec.MachineTypes = []core.MachineType{machineType}
+					cloudProfile.Spec.VolumeTypes = []core.VolumeType{volumeType}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(matcher)
+				},
+
+				Entry("should allow valid prices",
+					&core.Price{Currency: "USD", Amount: resource.MustParse("0.5")},
+					&core.Price{Currency: "USD", Amount: resource.MustParse("0.0001")},
+					BeEmpty(),
+				),
+				Entry("should forbid prices without currency",
+					&core.Price{Amount: resource.MustParse("0.5")},
+					nil,
+					ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.machineTypes[0].price.currency"),
+					}))),
+				),
+				Entry("should forbid invalid currencies",
+					nil,
+					&core.Price{Currency: "dollar", Amount: resource.MustParse("0.5")},
+					ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.volumeTypes[0].price.currency"),
+					}))),
+				),
+				Entry("should forbid negative prices",
+					&core.Price{Currency: "USD", Amount: resource.MustParse("-1")},
+					nil,
+					ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.machineTypes[0].price.amount"),
+					}))),
+				),
+				Entry("should forbid different currencies",
+					&core.Price{Currency: "USD", Amount: resource.MustParse("0.5")},
+					&core.Price{Currency: "EUR", Amount: resource.MustParse("0.0001")},
+					ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.volumeTypes[0].price.currency"),
+						"Detail": Equal("all prices must use the same currency (USD)"),
+					}))),
+				),
+			)
+
 			DescribeTable("CloudProfile metadata",
 				func(objectMeta metav1.ObjectMeta, matcher gomegatypes.GomegaMatcher) {
 					cloudProfile.ObjectMeta = objectMeta
@@ -1111,4 +1163,55 @@ var _ = Describe("CloudProfile Validation Tests ", func() {
 			})
 		})
 	})
+
+	Describe("#ValidateCloudProfileStatusUpdate", func() {
+		var cloudProfile *core.CloudProfile
+
+		BeforeEach(func() {
+			cloudProfile = &core.CloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+				Status: core.CloudProfileStatus{
+					KubernetesVersions: []core.VersionRolloutStatus{
+						{Version: "1.29.1", Completed: true},
+						{Version: "1.29.2", Waves: []core.RolloutWaveStatus{{Name: "canary"}}},
+					},
+					MachineImages: []core.MachineImageRolloutStatus{{
+						Name:     "foo",
+						Versions: []core.VersionRolloutStatus{{Version: "1.0.0", Completed: true}},
+					}},
+				},
+			}
+		})
+
+		It("should allow a valid status", func() {
+			Expect(ValidateCloudProfileStatusUpdate(cloudProfile, cloudProfile)).To(BeEmpty())
+		})
+
+		It("should forbid duplicate and empty versions, machine images and waves", func() {
+			cloudProfile.Status.KubernetesVersions = append(cloudProfile.Status.KubernetesVersions,
+				core.VersionRolloutStatus{Version: "1.29.1"},
+				core.VersionRolloutStatus{Waves: []core.RolloutWaveStatus{{}}},
+			)
+			cloudProfile.Status.MachineImages = append(cloudProfile.Status.MachineImages, core.MachineImageRolloutStatus{Name: "foo"})
+
+			Expect(ValidateCloudProfileStatusUpdate(cloudProfile, cloudProfile)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("status.kubernetesVersions[2].version"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("status.kubernetesVersions[3].version"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("status.kubernetesVersions[3].waves[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("status.machineImages[1].name"),
+				})),
+			))
+		})
+	})
 })
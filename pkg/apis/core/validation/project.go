@@ -7,7 +7,9 @@ package validation
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/robfig/cron"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/api/validation/path"
@@ -16,7 +18,9 @@ import (
 	"k8s.io/apiserver/pkg/authentication/serviceaccount"
 
 	"github.com/gardener/gardener/pkg/apis/core"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/utils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
 // ValidateProject validates a Project object.
@@ -29,6 +33,9 @@ func ValidateProject(project *core.Project) field.ErrorList {
 		allErrs = append(allErrs, field.TooLong(field.NewPath("metadata", "name"), project.Name, maxProjectNameLength))
 	}
 	allErrs = append(allErrs, validateNameConsecutiveHyphens(project.Name, field.NewPath("metadata", "name"))...)
+	if _, err := gardenerutils.GetProjectStaleSnoozeUntil(project.Annotations); err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("metadata", "annotations").Key(v1beta1constants.ProjectStaleSnoozeUntil), project.Annotations[v1beta1constants.ProjectStaleSnoozeUntil], "must be a timestamp in RFC3339 format"))
+	}
 	allErrs = append(allErrs, ValidateProjectSpec(&project.Spec, field.NewPath("spec"))...)
 
 	return allErrs
@@ -112,6 +119,75 @@ func ValidateProjectSpec(projectSpec *core.ProjectSpec, fldPath *field.Path) fie
 		allErrs = append(allErrs, ValidateTolerationsAgainstAllowlist(projectSpec.Tolerations.Defaults, projectSpec.Tolerations.Whitelist, fldPath.Child("tolerations", "defaults"))...)
 	}
 
+	allErrs = append(allErrs, ValidateBackupRetentionPolicy(projectSpec.BackupRetentionPolicy, fldPath.Child("backupRetentionPolicy"))...)
+
+	if projectSpec.Hibernation != nil {
+		if idleTimeout := projectSpec.Hibernation.IdleTimeout; idleTimeout != nil && idleTimeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("hibernation", "idleTimeout"), idleTimeout.Duration.String(), "must be a positive duration"))
+		}
+	}
+
+	if projectSpec.Maintenance != nil {
+		allErrs = append(allErrs, ValidateMaintenanceFreezePeriods(projectSpec.Maintenance.FreezePeriods, fldPath.Child("maintenance", "freezePeriods"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateMaintenanceFreezePeriods validates the given maintenance freeze periods.
+func ValidateMaintenanceFreezePeriods(periods []core.MaintenanceFreezePeriod, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	for i, period := range periods {
+		idxPath := fldPath.Index(i)
+
+		if period.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if names.Has(period.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), period.Name))
+		}
+		names.Insert(period.Name)
+
+		isAbsolute := period.Begin != nil || period.End != nil
+		isRecurring := period.Schedule != nil || period.Duration != nil || period.Location != nil
+
+		switch {
+		case isAbsolute && isRecurring:
+			allErrs = append(allErrs, field.Forbidden(idxPath, "must either specify begin and end or schedule and duration, not both"))
+		case isAbsolute:
+			if period.Begin == nil {
+				allErrs = append(allErrs, field.Required(idxPath.Child("begin"), "must provide the beginning of the freeze period"))
+			}
+			if period.End == nil {
+				allErrs = append(allErrs, field.Required(idxPath.Child("end"), "must provide the end of the freeze period"))
+			}
+			if period.Begin != nil && period.End != nil && !period.End.After(period.Begin.Time) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("end"), period.End, "end must be after begin"))
+			}
+		case isRecurring:
+			if period.Schedule == nil {
+				allErrs = append(allErrs, field.Required(idxPath.Child("schedule"), "must provide the schedule of the freeze period"))
+			} else if _, err := cron.ParseStandard(*period.Schedule); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("schedule"), *period.Schedule, err.Error()))
+			}
+			if period.Duration == nil {
+				allErrs = append(allErrs, field.Required(idxPath.Child("duration"), "must provide the duration of the freeze period"))
+			} else if period.Duration.Duration <= 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("duration"), period.Duration.Duration.String(), "duration must be positive"))
+			}
+			if period.Location != nil {
+				if _, err := time.LoadLocation(*period.Location); err != nil {
+					allErrs = append(allErrs, field.Invalid(idxPath.Child("location"), *period.Location, err.Error()))
+				}
+			}
+		default:
+			allErrs = append(allErrs, field.Required(idxPath, "must either specify begin and end or schedule and duration"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -6,6 +6,7 @@ package validation_test
 
 import (
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -123,6 +124,17 @@ var _ = Describe("Project Validation Tests", func() {
 					"Field": Equal("metadata.name"),
 				}))),
 			),
+			Entry("should allow Project with valid stale snooze timestamp",
+				metav1.ObjectMeta{Name: "project-1", Annotations: map[string]string{"project.gardener.cloud/stale-snooze-until": "2024-12-31T00:00:00Z"}},
+				BeEmpty(),
+			),
+			Entry("should forbid Project with invalid stale snooze timestamp",
+				metav1.ObjectMeta{Name: "project-1", Annotations: map[string]string{"project.gardener.cloud/stale-snooze-until": "2024-12-31"}},
+				ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("metadata.annotations[project.gardener.cloud/stale-snooze-until]"),
+				}))),
+			),
 		)
 
 		It("should forbid Project specification with empty or invalid key for description", func() {
@@ -377,6 +389,91 @@ var _ = Describe("Project Validation Tests", func() {
 			))
 		})
 
+		It("should forbid an invalid backup retention policy", func() {
+			project.Spec.BackupRetentionPolicy = &core.BackupRetentionPolicy{
+				RetentionAfterDeletion: &metav1.Duration{Duration: -time.Hour},
+			}
+
+			errorList := ValidateProject(project)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.backupRetentionPolicy.retentionAfterDeletion"),
+			}))))
+		})
+
+		It("should allow a valid idle hibernation timeout", func() {
+			project.Spec.Hibernation = &core.ProjectHibernation{IdleTimeout: &metav1.Duration{Duration: 4 * time.Hour}}
+
+			Expect(ValidateProject(project)).To(BeEmpty())
+		})
+
+		It("should forbid a non-positive idle hibernation timeout", func() {
+			project.Spec.Hibernation = &core.ProjectHibernation{IdleTimeout: &metav1.Duration{}}
+
+			Expect(ValidateProject(project)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.hibernation.idleTimeout"),
+			}))))
+		})
+
+		It("should allow valid maintenance freeze periods", func() {
+			project.Spec.Maintenance = &core.ProjectMaintenance{
+				FreezePeriods: []core.MaintenanceFreezePeriod{
+					{Name: "absolute", Begin: &metav1.Time{Time: time.Date(2024, 12, 20, 0, 0, 0, 0, time.UTC)}, End: &metav1.Time{Time: time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)}},
+					{Name: "recurring", Schedule: ptr.To("0 8 * * 1"), Duration: &metav1.Duration{Duration: 8 * time.Hour}, Location: ptr.To("Europe/Berlin")},
+				},
+			}
+
+			Expect(ValidateProject(project)).To(BeEmpty())
+		})
+
+		It("should forbid invalid maintenance freeze periods", func() {
+			project.Spec.Maintenance = &core.ProjectMaintenance{
+				FreezePeriods: []core.MaintenanceFreezePeriod{
+					{Name: "both", Begin: &metav1.Time{}, End: &metav1.Time{}, Schedule: ptr.To("0 8 * * 1"), Duration: &metav1.Duration{Duration: time.Hour}},
+					{Name: "both", Begin: &metav1.Time{Time: time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)}, End: &metav1.Time{Time: time.Date(2024, 12, 20, 0, 0, 0, 0, time.UTC)}},
+					{Name: "recurring", Schedule: ptr.To("foo"), Duration: &metav1.Duration{Duration: -time.Hour}, Location: ptr.To("Foo/Bar")},
+					{},
+				},
+			}
+
+			Expect(ValidateProject(project)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.maintenance.freezePeriods[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.maintenance.freezePeriods[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.maintenance.freezePeriods[1].end"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.maintenance.freezePeriods[2].schedule"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.maintenance.freezePeriods[2].duration"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.maintenance.freezePeriods[2].location"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.maintenance.freezePeriods[3].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.maintenance.freezePeriods[3]"),
+				})),
+			))
+		})
+
 		It("should forbid using a default toleration which is not in the whitelist", func() {
 			project.Spec.Tolerations = &core.ProjectTolerations{
 				Defaults: []core.Toleration{{Key: "foo"}},
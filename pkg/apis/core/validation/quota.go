@@ -9,6 +9,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -43,9 +44,43 @@ func ValidateQuotaSpec(quotaSpec *core.QuotaSpec, fldPath *field.Path) field.Err
 		allErrs = append(allErrs, field.NotSupported(fldPath.Child("scope"), scopeRef, []string{"project", "secret"}))
 	}
 
-	metricsFldPath := fldPath.Child("metrics")
-	for k, v := range quotaSpec.Metrics {
-		keyPath := metricsFldPath.Key(string(k))
+	allErrs = append(allErrs, validateQuotaMetrics(quotaSpec.Metrics, fldPath.Child("metrics"))...)
+	allErrs = append(allErrs, validateQuotaMetrics(quotaSpec.SoftLimits, fldPath.Child("softLimits"))...)
+
+	return allErrs
+}
+
+// ValidateQuotaStatusUpdate validates the status field of a Quota object.
+func ValidateQuotaStatusUpdate(newQuota, _ *core.Quota) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		fldPath = field.NewPath("status")
+		names   = sets.New[string]()
+	)
+
+	allErrs = append(allErrs, validateQuotaMetrics(newQuota.Status.Usage, fldPath.Child("usage"))...)
+
+	for i, projectUsage := range newQuota.Status.ProjectUsages {
+		idxPath := fldPath.Child("projectUsages").Index(i)
+
+		if len(projectUsage.Namespace) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("namespace"), "must provide the namespace of the project"))
+		} else if names.Has(projectUsage.Namespace) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("namespace"), projectUsage.Namespace))
+		}
+		names.Insert(projectUsage.Namespace)
+
+		allErrs = append(allErrs, validateQuotaMetrics(projectUsage.Usage, idxPath.Child("usage"))...)
+	}
+
+	return allErrs
+}
+
+func validateQuotaMetrics(resources corev1.ResourceList, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for k, v := range resources {
+		keyPath := fldPath.Key(string(k))
 		if !isValidQuotaMetric(k) {
 			allErrs = append(allErrs, field.Invalid(keyPath, v.String(), fmt.Sprintf("%s is no supported quota metric", string(k))))
 		}
@@ -63,7 +98,10 @@ func isValidQuotaMetric(metric corev1.ResourceName) bool {
 		core.QuotaMetricMemory,
 		core.QuotaMetricStorageStandard,
 		core.QuotaMetricStoragePremium,
-		core.QuotaMetricLoadbalancer:
+		core.QuotaMetricLoadbalancer,
+		core.QuotaMetricShoots,
+		core.QuotaMetricNodes,
+		core.QuotaMetricMaxSurge:
 		return true
 	}
 	return false
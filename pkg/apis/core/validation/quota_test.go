@@ -119,5 +119,84 @@ var _ = Describe("Quota Validation Tests ", func() {
 				})),
 			))
 		})
+
+		It("should allow the new count based metrics", func() {
+			quota.Spec.Metrics[core.QuotaMetricShoots] = resource.MustParse("10")
+			quota.Spec.Metrics[core.QuotaMetricNodes] = resource.MustParse("100")
+			quota.Spec.Metrics[core.QuotaMetricMaxSurge] = resource.MustParse("20")
+
+			Expect(ValidateQuota(quota)).To(BeEmpty())
+		})
+
+		DescribeTable("soft limits",
+			func(softLimits corev1.ResourceList, matcher gomegatypes.GomegaMatcher) {
+				quota.Spec.SoftLimits = softLimits
+
+				Expect(ValidateQuota(quota)).To(matcher)
+			},
+
+			Entry("should allow valid soft limits", corev1.ResourceList{"cpu": resource.MustParse("150"), "shoots": resource.MustParse("5")}, BeEmpty()),
+			Entry("should forbid unsupported metrics", corev1.ResourceList{"key": resource.MustParse("1")}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("spec.softLimits[key]"),
+				"Detail": Equal("key is no supported quota metric"),
+			})))),
+			Entry("should forbid negative soft limits", corev1.ResourceList{"cpu": resource.MustParse("-1")}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.softLimits[cpu]"),
+			})))),
+		)
+	})
+
+	Describe("#ValidateQuotaStatusUpdate", func() {
+		var quota *core.Quota
+
+		BeforeEach(func() {
+			quota = &core.Quota{ObjectMeta: metav1.ObjectMeta{Name: "quota-1", Namespace: "my-namespace"}}
+		})
+
+		It("should allow valid usage", func() {
+			quota.Status.Usage = corev1.ResourceList{"cpu": resource.MustParse("12"), "nodes": resource.MustParse("3")}
+
+			Expect(ValidateQuotaStatusUpdate(quota, quota.DeepCopy())).To(BeEmpty())
+		})
+
+		It("should forbid unsupported metrics and negative values", func() {
+			quota.Status.Usage = corev1.ResourceList{"key": resource.MustParse("1"), "cpu": resource.MustParse("-1")}
+
+			Expect(ValidateQuotaStatusUpdate(quota, quota.DeepCopy())).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.usage[key]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.usage[cpu]"),
+				})),
+			))
+		})
+
+		It("should validate the usage per project", func() {
+			quota.Status.ProjectUsages = []core.QuotaProjectUsage{
+				{Namespace: "garden-foo", Usage: corev1.ResourceList{"cpu": resource.MustParse("2")}},
+				{Namespace: "garden-foo", Usage: corev1.ResourceList{"key": resource.MustParse("1")}},
+				{Usage: corev1.ResourceList{"cpu": resource.MustParse("1")}},
+			}
+
+			Expect(ValidateQuotaStatusUpdate(quota, quota.DeepCopy())).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("status.projectUsages[1].namespace"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.projectUsages[1].usage[key]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("status.projectUsages[2].namespace"),
+				})),
+			))
+		})
 	})
 })
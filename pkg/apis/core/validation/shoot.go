@@ -62,6 +62,7 @@ var (
 	availableShootOperations = sets.New(
 		v1beta1constants.ShootOperationMaintain,
 		v1beta1constants.ShootOperationRetry,
+		v1beta1constants.ShootOperationVerifyBackup,
 	).Union(availableShootMaintenanceOperations)
 	availableShootMaintenanceOperations = sets.New(
 		v1beta1constants.GardenerOperationReconcile,
@@ -146,6 +147,8 @@ func ValidateShoot(shoot *core.Shoot) field.ErrorList {
 	allErrs = append(allErrs, ValidateShootSpec(shoot.ObjectMeta, &shoot.Spec, field.NewPath("spec"), false)...)
 	allErrs = append(allErrs, ValidateShootHAConfig(shoot)...)
 	allErrs = append(allErrs, validateShootManagedIssuer(shoot)...)
+	allErrs = append(allErrs, validateHibernationAnnotations(shoot.Annotations, field.NewPath("metadata", "annotations"))...)
+	allErrs = append(allErrs, validateNodeAgentAnnotations(shoot.Annotations, field.NewPath("metadata", "annotations"))...)
 
 	return allErrs
 }
@@ -258,6 +261,8 @@ func ValidateShootSpec(meta metav1.ObjectMeta, spec *core.ShootSpec, fldPath *fi
 	)
 
 	allErrs = append(allErrs, ValidateCloudProfileReference(spec.CloudProfile, fldPath.Child("cloudProfile"))...)
+	allErrs = append(allErrs, ValidateShootBlueprintReference(spec.Blueprint, fldPath.Child("blueprint"))...)
+	allErrs = append(allErrs, ValidateBackupRetentionPolicy(spec.BackupRetentionPolicy, fldPath.Child("backupRetentionPolicy"))...)
 	allErrs = append(allErrs, validateProvider(spec.Provider, spec.Kubernetes, spec.Networking, workerless, fldPath.Child("provider"), inTemplate)...)
 	allErrs = append(allErrs, validateAddons(spec.Addons, spec.Purpose, workerless, fldPath.Child("addons"))...)
 	allErrs = append(allErrs, validateDNS(spec.DNS, fldPath.Child("dns"))...)
@@ -1997,6 +2002,53 @@ func ValidateHibernation(annotations map[string]string, hibernation *core.Hibern
 	return allErrs
 }
 
+func validateHibernationAnnotations(annotations map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if _, err := gardenerutils.GetShootHibernationKeepAwakeUntil(annotations); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Key(v1beta1constants.AnnotationShootHibernationKeepAwakeUntil), annotations[v1beta1constants.AnnotationShootHibernationKeepAwakeUntil], "must be a time in RFC3339 format"))
+	}
+	if _, err := gardenerutils.GetShootHibernationExcludedDates(annotations); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Key(v1beta1constants.AnnotationShootHibernationExcludedDates), annotations[v1beta1constants.AnnotationShootHibernationExcludedDates], "must be a comma-separated list of dates in format YYYY-MM-DD"))
+	}
+	if _, err := gardenerutils.GetShootHibernationWakeUpIdleTimeout(annotations); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Key(v1beta1constants.AnnotationShootHibernationWakeUpIdleTimeout), annotations[v1beta1constants.AnnotationShootHibernationWakeUpIdleTimeout], "must be a positive duration"))
+	}
+	if _, err := gardenerutils.GetShootHibernationWakeUpRequestedAt(annotations); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Key(v1beta1constants.AnnotationShootHibernationWakeUpRequestedAt), annotations[v1beta1constants.AnnotationShootHibernationWakeUpRequestedAt], "must be a time in RFC3339 format"))
+	}
+	if _, err := gardenerutils.GetShootLastUserActivity(annotations); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Key(v1beta1constants.AnnotationShootLastUserActivity), annotations[v1beta1constants.AnnotationShootLastUserActivity], "must be a time in RFC3339 format"))
+	}
+
+	return allErrs
+}
+
+func validateNodeAgentAnnotations(annotations map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if v, ok := annotations[v1beta1constants.AnnotationShootNodeAgentMaxConcurrentDisruptiveUpdates]; ok {
+		maxConcurrentDisruptiveUpdates := intstr.Parse(v)
+
+		valid := maxConcurrentDisruptiveUpdates.Type == intstr.Int && maxConcurrentDisruptiveUpdates.IntVal >= 1
+		if percent, isPercent := getPercentValue(maxConcurrentDisruptiveUpdates); isPercent {
+			valid = percent >= 1 && percent <= 100
+		}
+
+		if !valid {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(v1beta1constants.AnnotationShootNodeAgentMaxConcurrentDisruptiveUpdates), v, "must be a positive integer or a percentage between 1% and 100%"))
+		}
+	}
+
+	if v, ok := annotations[v1beta1constants.AnnotationShootNodeAgentRollbackUnhealthyThreshold]; ok {
+		if threshold, err := time.ParseDuration(v); err != nil || threshold < time.Minute {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(v1beta1constants.AnnotationShootNodeAgentRollbackUnhealthyThreshold), v, "must be a duration of at least 1m"))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateHibernationSchedules validates a list of hibernation schedules.
 func ValidateHibernationSchedules(schedules []core.HibernationSchedule, fldPath *field.Path) field.ErrorList {
 	var (
@@ -327,6 +327,17 @@ var _ = Describe("Shoot Validation Tests", func() {
 			))
 		})
 
+		It("should validate the blueprint reference", func() {
+			shoot.Spec.Blueprint = &core.ShootBlueprintReference{}
+
+			Expect(ValidateShoot(shoot)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.blueprint.name"),
+				})),
+			))
+		})
+
 		Context("#ValidateShootHAControlPlaneUpdate", func() {
 			It("should pass as Shoot ControlPlane Spec with HA set to zone has not changed", func() {
 				shoot.Spec.ControlPlane = &core.ControlPlane{HighAvailability: &core.HighAvailability{FailureTolerance: core.FailureTolerance{Type: core.FailureToleranceTypeZone}}}
@@ -1053,6 +1064,110 @@ var _ = Describe("Shoot Validation Tests", func() {
 			Expect(errorList).To(BeEmpty())
 		})
 
+		Context("hibernation annotations", func() {
+			It("should allow valid hibernation annotations", func() {
+				shoot.Annotations = map[string]string{
+					"shoot.gardener.cloud/hibernation-keep-awake-until":     "2024-04-12T18:00:00+02:00",
+					"shoot.gardener.cloud/hibernation-excluded-dates":       "2024-12-24,2024-12-25",
+					"shoot.gardener.cloud/hibernation-wake-up-idle-timeout": "2h",
+					"shoot.gardener.cloud/hibernation-wake-up-requested-at": "2024-04-12T10:00:00Z",
+					"shoot.gardener.cloud/last-user-activity":               "2024-04-12T10:00:00Z",
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid invalid hibernation annotations", func() {
+				shoot.Annotations = map[string]string{
+					"shoot.gardener.cloud/hibernation-keep-awake-until":     "friday",
+					"shoot.gardener.cloud/hibernation-excluded-dates":       "24.12.2024",
+					"shoot.gardener.cloud/hibernation-wake-up-idle-timeout": "-1h",
+					"shoot.gardener.cloud/hibernation-wake-up-requested-at": "now",
+					"shoot.gardener.cloud/last-user-activity":               "yesterday",
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("metadata.annotations[shoot.gardener.cloud/hibernation-keep-awake-until]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("metadata.annotations[shoot.gardener.cloud/hibernation-excluded-dates]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("metadata.annotations[shoot.gardener.cloud/hibernation-wake-up-idle-timeout]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("metadata.annotations[shoot.gardener.cloud/hibernation-wake-up-requested-at]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("metadata.annotations[shoot.gardener.cloud/last-user-activity]"),
+					})),
+				))
+			})
+		})
+
+		Context("node agent annotations", func() {
+			DescribeTable("max concurrent disruptive updates",
+				func(value string, matcher gomegatypes.GomegaMatcher) {
+					shoot.Annotations = map[string]string{"shoot.gardener.cloud/node-agent-max-concurrent-disruptive-updates": value}
+
+					Expect(ValidateShoot(shoot)).To(matcher)
+				},
+
+				Entry("positive integer", "2", BeEmpty()),
+				Entry("percentage", "25%", BeEmpty()),
+				Entry("100 percent", "100%", BeEmpty()),
+				Entry("zero", "0", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("metadata.annotations[shoot.gardener.cloud/node-agent-max-concurrent-disruptive-updates]"),
+				})))),
+				Entry("negative integer", "-1", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("metadata.annotations[shoot.gardener.cloud/node-agent-max-concurrent-disruptive-updates]"),
+				})))),
+				Entry("zero percent", "0%", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("metadata.annotations[shoot.gardener.cloud/node-agent-max-concurrent-disruptive-updates]"),
+				})))),
+				Entry("more than 100 percent", "101%", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("metadata.annotations[shoot.gardener.cloud/node-agent-max-concurrent-disruptive-updates]"),
+				})))),
+				Entry("no number", "all", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("metadata.annotations[shoot.gardener.cloud/node-agent-max-concurrent-disruptive-updates]"),
+				})))),
+			)
+
+			DescribeTable("rollback unhealthy threshold",
+				func(value string, matcher gomegatypes.GomegaMatcher) {
+					shoot.Annotations = map[string]string{"shoot.gardener.cloud/node-agent-rollback-unhealthy-threshold": value}
+
+					Expect(ValidateShoot(shoot)).To(matcher)
+				},
+
+				Entry("minimum duration", "1m", BeEmpty()),
+				Entry("longer duration", "1h30m", BeEmpty()),
+				Entry("too short duration", "59s", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("metadata.annotations[shoot.gardener.cloud/node-agent-rollback-unhealthy-threshold]"),
+				})))),
+				Entry("negative duration", "-5m", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("metadata.annotations[shoot.gardener.cloud/node-agent-rollback-unhealthy-threshold]"),
+				})))),
+				Entry("no duration", "5", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("metadata.annotations[shoot.gardener.cloud/node-agent-rollback-unhealthy-threshold]"),
+				})))),
+			)
+		})
+
 		Context("Shoot managed issuer validation", func() {
 			It("should not allow enabling it for shoots with configured issuer", func() {
 				shoot.Annotations = map[string]string{
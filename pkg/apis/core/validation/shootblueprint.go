@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"encoding/json"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+// ValidateShootBlueprint validates a ShootBlueprint object.
+func ValidateShootBlueprint(shootBlueprint *core.ShootBlueprint) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&shootBlueprint.ObjectMeta, true, ValidateName, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateShootBlueprintSpec(&shootBlueprint.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateShootBlueprintUpdate validates a ShootBlueprint object before an update.
+func ValidateShootBlueprintUpdate(newShootBlueprint, oldShootBlueprint *core.ShootBlueprint) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newShootBlueprint.ObjectMeta, &oldShootBlueprint.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateShootBlueprint(newShootBlueprint)...)
+
+	return allErrs
+}
+
+// ValidateShootBlueprintStatusUpdate validates the status field of a ShootBlueprint object before an update.
+func ValidateShootBlueprintStatusUpdate(newShootBlueprint, oldShootBlueprint *core.ShootBlueprint) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newShootBlueprint.ObjectMeta, &oldShootBlueprint.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(newShootBlueprint.Status.ObservedGeneration, field.NewPath("status", "observedGeneration"))...)
+
+	return allErrs
+}
+
+func validateShootBlueprintSpec(spec *core.ShootBlueprintSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, ValidateShootTemplate(&spec.Template, fldPath.Child("template"))...)
+
+	if spec.Template.Spec.Blueprint != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("template", "spec", "blueprint"), "a blueprint must not reference another blueprint"))
+	}
+
+	return allErrs
+}
+
+// ValidateShootBlueprintReference validates the given ShootBlueprintReference.
+func ValidateShootBlueprintReference(reference *core.ShootBlueprintReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if reference == nil {
+		return allErrs
+	}
+
+	if len(reference.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "must specify a ShootBlueprint name"))
+	} else {
+		for _, msg := range ValidateName(reference.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), reference.Name, msg))
+		}
+	}
+
+	if reference.Overrides != nil && len(reference.Overrides.Raw) > 0 {
+		var overrides map[string]interface{}
+		if err := json.Unmarshal(reference.Overrides.Raw, &overrides); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("overrides"), string(reference.Overrides.Raw), "must be a JSON object"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/gardener/gardener/pkg/apis/core/validation"
+)
+
+var _ = Describe("ShootBlueprint Validation Tests", func() {
+	var shootBlueprint *core.ShootBlueprint
+
+	BeforeEach(func() {
+		shootBlueprint = &core.ShootBlueprint{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "blueprint",
+				Namespace: "garden-dev",
+			},
+			Spec: core.ShootBlueprintSpec{
+				Template: core.ShootTemplate{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{"team": "foo"},
+					},
+					Spec: core.ShootSpec{
+						CloudProfileName: "profile",
+						Region:           "region",
+						Provider: core.Provider{
+							Type: "local",
+						},
+						Kubernetes: core.Kubernetes{
+							Version: "1.28.2",
+						},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#ValidateShootBlueprint", func() {
+		It("should allow a valid ShootBlueprint", func() {
+			Expect(ValidateShootBlueprint(shootBlueprint)).To(BeEmpty())
+		})
+
+		It("should forbid empty metadata", func() {
+			shootBlueprint.ObjectMeta = metav1.ObjectMeta{}
+
+			Expect(ValidateShootBlueprint(shootBlueprint)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("metadata.name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("metadata.namespace"),
+				})),
+			))
+		})
+
+		It("should validate the shoot template", func() {
+			shootBlueprint.Spec.Template.Labels = map[string]string{"%invalid": "foo"}
+			shootBlueprint.Spec.Template.Spec.Region = ""
+
+			Expect(ValidateShootBlueprint(shootBlueprint)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.template.metadata.labels"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.template.spec.region"),
+				})),
+			))
+		})
+
+		It("should forbid referencing another blueprint in the template", func() {
+			shootBlueprint.Spec.Template.Spec.Blueprint = &core.ShootBlueprintReference{Name: "other"}
+
+			Expect(ValidateShootBlueprint(shootBlueprint)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.template.spec.blueprint"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateShootBlueprintUpdate", func() {
+		It("should allow changing the template", func() {
+			newShootBlueprint := shootBlueprint.DeepCopy()
+			newShootBlueprint.ResourceVersion = "1"
+			shootBlueprint.ResourceVersion = "1"
+			newShootBlueprint.Spec.Template.Spec.Region = "other-region"
+			newShootBlueprint.Spec.Template.Spec.Kubernetes.Version = "1.27.1"
+
+			Expect(ValidateShootBlueprintUpdate(newShootBlueprint, shootBlueprint)).To(BeEmpty())
+		})
+	})
+
+	Describe("#ValidateShootBlueprintStatusUpdate", func() {
+		It("should forbid a negative observed generation", func() {
+			newShootBlueprint := shootBlueprint.DeepCopy()
+			newShootBlueprint.ResourceVersion = "1"
+			shootBlueprint.ResourceVersion = "1"
+			newShootBlueprint.Status.ObservedGeneration = -1
+
+			Expect(ValidateShootBlueprintStatusUpdate(newShootBlueprint, shootBlueprint)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.observedGeneration"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateShootBlueprintReference", func() {
+		fldPath := field.NewPath("spec", "blueprint")
+
+		It("should allow a nil reference", func() {
+			Expect(ValidateShootBlueprintReference(nil, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow a reference with overrides", func() {
+			Expect(ValidateShootBlueprintReference(&core.ShootBlueprintReference{
+				Name:      "blueprint",
+				Overrides: &runtime.RawExtension{Raw: []byte(`{"spec":{"region":"foo"}}`)},
+			}, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid an empty name", func() {
+			Expect(ValidateShootBlueprintReference(&core.ShootBlueprintReference{}, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.blueprint.name"),
+				})),
+			))
+		})
+
+		It("should forbid an invalid name and overrides which are no JSON object", func() {
+			Expect(ValidateShootBlueprintReference(&core.ShootBlueprintReference{
+				Name:      "Foo_Bar",
+				Overrides: &runtime.RawExtension{Raw: []byte(`["foo"]`)},
+			}, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.blueprint.name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.blueprint.overrides"),
+				})),
+			))
+		})
+	})
+})
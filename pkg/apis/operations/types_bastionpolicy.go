@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operations
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BastionPolicy restricts the usage of Bastions in the selected projects.
+type BastionPolicy struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// Spec contains the specification of the BastionPolicy.
+	Spec BastionPolicySpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BastionPolicyList is a list of BastionPolicy objects.
+type BastionPolicyList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+	// Items is the list of BastionPolicy.
+	Items []BastionPolicy
+}
+
+// BastionPolicySpec is the specification of a BastionPolicy.
+type BastionPolicySpec struct {
+	// ProjectSelector selects the projects to which this policy applies. Defaults to the empty label selector which
+	// matches all projects.
+	ProjectSelector *metav1.LabelSelector
+	// MaxLifetime is the maximum duration a Bastion in one of the selected projects may exist before it is deleted,
+	// regardless of its heartbeats.
+	MaxLifetime *metav1.Duration
+	// AllowedSourceCIDRs is a list of CIDRs. Each IP block in the ingress of a Bastion in one of the selected projects
+	// must be contained in one of them. If empty, all sources are allowed.
+	AllowedSourceCIDRs []string
+	// Subjects is a list of users, groups, or service accounts which are allowed to create Bastions in the selected
+	// projects. If empty, all subjects which are permitted to create Bastions are allowed.
+	Subjects []rbacv1.Subject
+}
@@ -5,9 +5,17 @@
 package v1alpha1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
 func addDefaultingFuncs(scheme *runtime.Scheme) error {
 	return RegisterDefaults(scheme)
 }
+
+// SetDefaults_BastionPolicySpec sets default values for BastionPolicy objects.
+func SetDefaults_BastionPolicySpec(obj *BastionPolicySpec) {
+	if obj.ProjectSelector == nil {
+		obj.ProjectSelector = &metav1.LabelSelector{}
+	}
+}
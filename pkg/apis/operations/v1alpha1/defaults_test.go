@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+)
+
+var _ = Describe("Defaults", func() {
+	Describe("#SetObjectDefaults_BastionPolicy", func() {
+		It("should default the project selector", func() {
+			obj := &BastionPolicy{}
+
+			SetObjectDefaults_BastionPolicy(obj)
+
+			Expect(obj.Spec.ProjectSelector).To(Equal(&metav1.LabelSelector{}))
+		})
+
+		It("should not overwrite the project selector", func() {
+			obj := &BastionPolicy{Spec: BastionPolicySpec{ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}}}
+			expected := obj.DeepCopy()
+
+			SetObjectDefaults_BastionPolicy(obj)
+
+			Expect(obj).To(Equal(expected))
+		})
+	})
+})
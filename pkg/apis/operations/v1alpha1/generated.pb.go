@@ -14,7 +14,8 @@ import (
 	io "io"
 
 	proto "github.com/gogo/protobuf/proto"
-	v12 "k8s.io/api/core/v1"
+	v13 "k8s.io/api/core/v1"
+	v12 "k8s.io/api/rbac/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	math "math"
@@ -118,10 +119,94 @@ func (m *BastionList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BastionList proto.InternalMessageInfo
 
+func (m *BastionPolicy) Reset()      { *m = BastionPolicy{} }
+func (*BastionPolicy) ProtoMessage() {}
+func (*BastionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8b335fad1255a79, []int{3}
+}
+func (m *BastionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BastionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BastionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BastionPolicy.Merge(m, src)
+}
+func (m *BastionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *BastionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_BastionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BastionPolicy proto.InternalMessageInfo
+
+func (m *BastionPolicyList) Reset()      { *m = BastionPolicyList{} }
+func (*BastionPolicyList) ProtoMessage() {}
+func (*BastionPolicyList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8b335fad1255a79, []int{4}
+}
+func (m *BastionPolicyList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BastionPolicyList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BastionPolicyList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BastionPolicyList.Merge(m, src)
+}
+func (m *BastionPolicyList) XXX_Size() int {
+	return m.Size()
+}
+func (m *BastionPolicyList) XXX_DiscardUnknown() {
+	xxx_messageInfo_BastionPolicyList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BastionPolicyList proto.InternalMessageInfo
+
+func (m *BastionPolicySpec) Reset()      { *m = BastionPolicySpec{} }
+func (*BastionPolicySpec) ProtoMessage() {}
+func (*BastionPolicySpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8b335fad1255a79, []int{5}
+}
+func (m *BastionPolicySpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BastionPolicySpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BastionPolicySpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BastionPolicySpec.Merge(m, src)
+}
+func (m *BastionPolicySpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *BastionPolicySpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_BastionPolicySpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BastionPolicySpec proto.InternalMessageInfo
+
 func (m *BastionSpec) Reset()      { *m = BastionSpec{} }
 func (*BastionSpec) ProtoMessage() {}
 func (*BastionSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8b335fad1255a79, []int{3}
+	return fileDescriptor_a8b335fad1255a79, []int{6}
 }
 func (m *BastionSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -149,7 +234,7 @@ var xxx_messageInfo_BastionSpec proto.InternalMessageInfo
 func (m *BastionStatus) Reset()      { *m = BastionStatus{} }
 func (*BastionStatus) ProtoMessage() {}
 func (*BastionStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8b335fad1255a79, []int{4}
+	return fileDescriptor_a8b335fad1255a79, []int{7}
 }
 func (m *BastionStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -178,6 +263,9 @@ func init() {
 	proto.RegisterType((*Bastion)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.Bastion")
 	proto.RegisterType((*BastionIngressPolicy)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionIngressPolicy")
 	proto.RegisterType((*BastionList)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionList")
+	proto.RegisterType((*BastionPolicy)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionPolicy")
+	proto.RegisterType((*BastionPolicyList)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionPolicyList")
+	proto.RegisterType((*BastionPolicySpec)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionPolicySpec")
 	proto.RegisterType((*BastionSpec)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionSpec")
 	proto.RegisterType((*BastionStatus)(nil), "github.com.gardener.gardener.pkg.apis.operations.v1alpha1.BastionStatus")
 }
@@ -187,57 +275,69 @@ func init() {
 }
 
 var fileDescriptor_a8b335fad1255a79 = []byte{
-	// 799 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0xc7, 0xe3, 0xdd, 0x6c, 0x77, 0x3b, 0x9b, 0x42, 0x35, 0xad, 0x96, 0x68, 0x0f, 0xce, 0x92,
-	0x0b, 0x11, 0x12, 0x63, 0x52, 0x55, 0xa8, 0x3d, 0x70, 0x19, 0x44, 0xd9, 0x88, 0xa5, 0x1b, 0x4d,
-	0x2a, 0x0e, 0x08, 0x09, 0x26, 0xf6, 0x5b, 0x67, 0x48, 0xec, 0x31, 0x9e, 0x49, 0x20, 0x1c, 0x10,
-	0x1f, 0x01, 0x3e, 0x15, 0x7b, 0xec, 0x81, 0x43, 0x4f, 0x11, 0x6b, 0x3e, 0x06, 0x17, 0xe4, 0xf1,
-	0x24, 0x76, 0x48, 0x2a, 0x42, 0xdb, 0xdb, 0xcc, 0x9b, 0xf7, 0x7e, 0xff, 0x37, 0xef, 0x3d, 0x8f,
-	0x8c, 0x7a, 0xa1, 0xd0, 0xa3, 0xe9, 0x90, 0xf8, 0x32, 0xf2, 0x42, 0x9e, 0x06, 0x10, 0x43, 0x5a,
-	0x2e, 0x92, 0x71, 0xe8, 0xf1, 0x44, 0x28, 0x4f, 0x26, 0x90, 0x72, 0x2d, 0x64, 0xac, 0xbc, 0x59,
-	0x97, 0x4f, 0x92, 0x11, 0xef, 0x7a, 0x61, 0xee, 0xc2, 0x35, 0x04, 0x24, 0x49, 0xa5, 0x96, 0xf8,
-	0x71, 0x89, 0x22, 0x4b, 0x42, 0xb9, 0x48, 0xc6, 0x21, 0xc9, 0x51, 0xa4, 0x44, 0x91, 0x25, 0xea,
-	0x94, 0xee, 0x96, 0x85, 0x2f, 0x53, 0xf0, 0x66, 0xdd, 0x21, 0xe8, 0x4d, 0xf9, 0xd3, 0x0f, 0xaa,
-	0x0c, 0x19, 0x4a, 0xcf, 0x98, 0x87, 0xd3, 0x2b, 0xb3, 0x33, 0x1b, 0xb3, 0xb2, 0xee, 0xed, 0xf1,
-	0x23, 0x45, 0x84, 0xcc, 0xc1, 0x4b, 0xee, 0x06, 0xb2, 0x53, 0xf1, 0x89, 0x41, 0xff, 0x20, 0xd3,
-	0xb1, 0x88, 0xc3, 0x6d, 0x9e, 0x0f, 0x4b, 0xcf, 0x88, 0xfb, 0x23, 0x11, 0x43, 0x3a, 0x2f, 0xf3,
-	0x8e, 0x40, 0xf3, 0x6d, 0x51, 0xde, 0xcb, 0xa2, 0xd2, 0x69, 0xac, 0x45, 0x04, 0x1b, 0x01, 0x1f,
-	0xfd, 0x57, 0x80, 0xf2, 0x47, 0x10, 0xf1, 0x7f, 0xc7, 0xb5, 0x7f, 0xdf, 0x43, 0x87, 0x94, 0xab,
-	0xbc, 0xea, 0xf8, 0x5b, 0x74, 0x94, 0xe7, 0x13, 0x70, 0xcd, 0x9b, 0xce, 0x99, 0xd3, 0x39, 0x7e,
-	0xf0, 0x21, 0x29, 0xb0, 0xa4, 0x8a, 0x2d, 0x1b, 0x96, 0x7b, 0x93, 0x59, 0x97, 0x5c, 0x0e, 0xbf,
-	0x03, 0x5f, 0x7f, 0x01, 0x9a, 0x53, 0x7c, 0xbd, 0x68, 0xd5, 0xb2, 0x45, 0x0b, 0x95, 0x36, 0xb6,
-	0xa2, 0xe2, 0x11, 0xaa, 0xab, 0x04, 0xfc, 0xe6, 0x9e, 0xa1, 0x3f, 0x21, 0xaf, 0x3c, 0x17, 0xc4,
-	0xe6, 0x3c, 0x48, 0xc0, 0xa7, 0x0d, 0xab, 0x59, 0xcf, 0x77, 0xcc, 0x28, 0xe0, 0x04, 0xdd, 0x52,
-	0x9a, 0xeb, 0xa9, 0x6a, 0xee, 0x1b, 0xad, 0xf3, 0x37, 0xa0, 0x65, 0x78, 0xf4, 0x2d, 0xab, 0x76,
-	0xab, 0xd8, 0x33, 0xab, 0xd3, 0x0e, 0xd0, 0x7d, 0xeb, 0xd8, 0x8b, 0xc3, 0x14, 0x94, 0xea, 0xcb,
-	0x89, 0xf0, 0xe7, 0xf8, 0x02, 0x1d, 0x8a, 0x84, 0x4e, 0xa4, 0x3f, 0xb6, 0x45, 0x7d, 0xb7, 0x52,
-	0x54, 0x52, 0x0e, 0x4f, 0x5e, 0xc8, 0x5e, 0xdf, 0x38, 0xd2, 0xb7, 0xad, 0xc6, 0xa1, 0x35, 0xb0,
-	0x25, 0xa2, 0xfd, 0x87, 0x83, 0x8e, 0xad, 0xcc, 0x85, 0x50, 0x1a, 0x7f, 0xbd, 0xd1, 0x33, 0xb2,
-	0x5b, 0xcf, 0xf2, 0x68, 0xd3, 0xb1, 0xbb, 0x56, 0xeb, 0x68, 0x69, 0xa9, 0xf4, 0x2b, 0x44, 0x07,
-	0x42, 0x43, 0xa4, 0x9a, 0x7b, 0x67, 0xfb, 0x9d, 0xe3, 0x07, 0xf4, 0xf5, 0x8b, 0x48, 0xef, 0x58,
-	0xb9, 0x83, 0x5e, 0x0e, 0x66, 0x05, 0xbf, 0xfd, 0xf7, 0xde, 0xea, 0x5a, 0x79, 0x13, 0xf1, 0x97,
-	0xe8, 0x48, 0x8d, 0xa4, 0xd4, 0x0c, 0xae, 0xec, 0xb5, 0x3a, 0xd5, 0xaa, 0xe5, 0x9f, 0xa5, 0xb9,
-	0x84, 0xf4, 0xf9, 0xa4, 0x98, 0x34, 0x06, 0x57, 0x90, 0x42, 0xec, 0x43, 0x79, 0xa1, 0x81, 0x25,
-	0xb0, 0x15, 0x0b, 0x77, 0xd0, 0x91, 0x02, 0x08, 0x9e, 0xf2, 0x08, 0xcc, 0x10, 0xde, 0xa6, 0x0d,
-	0xe3, 0x69, 0x6d, 0x6c, 0x75, 0x8a, 0x1f, 0xa2, 0x46, 0x92, 0xca, 0x99, 0x08, 0x20, 0x7d, 0x36,
-	0x4f, 0xc0, 0x8c, 0xd1, 0x6d, 0x7a, 0x37, 0x5b, 0xb4, 0x1a, 0xfd, 0x8a, 0x9d, 0xad, 0x79, 0xe1,
-	0x47, 0xa8, 0xa1, 0xd4, 0xa8, 0x3f, 0x1d, 0x4e, 0x84, 0xff, 0x39, 0xcc, 0x9b, 0x75, 0x13, 0x75,
-	0xdf, 0x66, 0xd4, 0x18, 0x0c, 0xce, 0x57, 0x67, 0x6c, 0xcd, 0x13, 0xff, 0x84, 0x0e, 0x45, 0x31,
-	0x37, 0xcd, 0x03, 0x53, 0xec, 0xcb, 0xd7, 0x2f, 0xf6, 0xda, 0x20, 0x56, 0x86, 0xaa, 0x30, 0xb3,
-	0xa5, 0x60, 0xfb, 0xb7, 0x3a, 0xba, 0xb3, 0x36, 0xe4, 0xf8, 0x69, 0x99, 0x4d, 0x51, 0xfe, 0xf7,
-	0xb6, 0x97, 0x9f, 0x07, 0x94, 0x4f, 0x78, 0xec, 0x43, 0x6a, 0xa1, 0xf4, 0x78, 0x9b, 0x02, 0xfe,
-	0x1e, 0x21, 0x5f, 0xc6, 0x81, 0x30, 0x79, 0xda, 0x69, 0xfa, 0x78, 0xc7, 0x0b, 0x5a, 0x35, 0xf3,
-	0xb6, 0x93, 0x4f, 0x96, 0x94, 0xf2, 0xa5, 0x59, 0x99, 0x14, 0xab, 0x88, 0xe0, 0x9f, 0xd1, 0xc9,
-	0x84, 0x2b, 0x7d, 0x0e, 0x3c, 0xd5, 0x43, 0xe0, 0xfa, 0x99, 0x88, 0x40, 0x69, 0x1e, 0x25, 0xf6,
-	0x45, 0x78, 0x7f, 0xb7, 0xef, 0x24, 0x0f, 0xa3, 0xa7, 0xd9, 0xa2, 0x75, 0x72, 0xb1, 0x95, 0xc6,
-	0x5e, 0xa2, 0x82, 0xa7, 0xe8, 0x1e, 0xfc, 0x98, 0x88, 0xa2, 0x37, 0xa5, 0x78, 0xfd, 0x7f, 0x8b,
-	0xbf, 0x93, 0x2d, 0x5a, 0xf7, 0x3e, 0xdd, 0x44, 0xb1, 0x6d, 0x7c, 0xfc, 0x04, 0x61, 0x39, 0x54,
-	0x90, 0xce, 0x20, 0xf8, 0xac, 0x78, 0xeb, 0x85, 0x8c, 0x9b, 0x07, 0x67, 0x4e, 0x67, 0x9f, 0x9e,
-	0x64, 0x8b, 0x16, 0xbe, 0xdc, 0x38, 0x65, 0x5b, 0x22, 0xe8, 0x37, 0xd7, 0x37, 0x6e, 0xed, 0xf9,
-	0x8d, 0x5b, 0x7b, 0x71, 0xe3, 0xd6, 0x7e, 0xc9, 0x5c, 0xe7, 0x3a, 0x73, 0x9d, 0xe7, 0x99, 0xeb,
-	0xbc, 0xc8, 0x5c, 0xe7, 0xcf, 0xcc, 0x75, 0x7e, 0xfd, 0xcb, 0xad, 0x7d, 0xf5, 0xf8, 0x95, 0x7f,
-	0x12, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x0d, 0xea, 0x1f, 0x49, 0x60, 0x08, 0x00, 0x00,
+	// 978 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xce, 0x3a, 0x4e, 0xe3, 0x8c, 0xdd, 0x5f, 0xfa, 0x9b, 0x54, 0xc1, 0x0a, 0x92, 0x1d, 0x7c,
+	0xc1, 0x42, 0x62, 0x4d, 0x4a, 0x85, 0xda, 0x03, 0x07, 0xb6, 0xa5, 0xc4, 0xc2, 0x6d, 0xac, 0x71,
+	0xc5, 0x01, 0x21, 0xc1, 0x78, 0xf7, 0x8d, 0xbd, 0x78, 0x77, 0x67, 0x99, 0x19, 0xbb, 0x0d, 0x07,
+	0xc4, 0x47, 0x80, 0x4f, 0x45, 0x8e, 0x3d, 0x70, 0xe8, 0xc9, 0x6a, 0x96, 0x8f, 0x81, 0x90, 0xd0,
+	0xcc, 0x8e, 0xbd, 0xeb, 0xd8, 0x11, 0x6e, 0x53, 0xb8, 0xed, 0xbc, 0xf3, 0xbc, 0xcf, 0xf3, 0xfe,
+	0x99, 0x79, 0x67, 0x51, 0x7b, 0xe0, 0xcb, 0xe1, 0xb8, 0x6f, 0xbb, 0x2c, 0x6c, 0x0d, 0x28, 0xf7,
+	0x20, 0x02, 0x9e, 0x7d, 0xc4, 0xa3, 0x41, 0x8b, 0xc6, 0xbe, 0x68, 0xb1, 0x18, 0x38, 0x95, 0x3e,
+	0x8b, 0x44, 0x6b, 0x72, 0x44, 0x83, 0x78, 0x48, 0x8f, 0x5a, 0x03, 0x05, 0xa1, 0x12, 0x3c, 0x3b,
+	0xe6, 0x4c, 0x32, 0x7c, 0x3f, 0xa3, 0xb2, 0x67, 0x0c, 0xd9, 0x47, 0x3c, 0x1a, 0xd8, 0x8a, 0xca,
+	0xce, 0xa8, 0xec, 0x19, 0xd5, 0x81, 0xb3, 0x5e, 0x14, 0x2e, 0xe3, 0xd0, 0x9a, 0x1c, 0xf5, 0x41,
+	0x2e, 0xcb, 0x1f, 0x7c, 0x98, 0xe7, 0x60, 0x03, 0xd6, 0xd2, 0xe6, 0xfe, 0xf8, 0x54, 0xaf, 0xf4,
+	0x42, 0x7f, 0x19, 0x78, 0x63, 0x74, 0x4f, 0xd8, 0x3e, 0x53, 0xc4, 0x33, 0xde, 0x25, 0xca, 0x66,
+	0x0e, 0x13, 0x81, 0x7c, 0xc6, 0xf8, 0xc8, 0x8f, 0x06, 0xab, 0x90, 0x79, 0x36, 0xde, 0xa7, 0xee,
+	0x2a, 0xcc, 0xdd, 0x0c, 0x13, 0x52, 0x77, 0xe8, 0x47, 0xc0, 0xcf, 0xb2, 0xdc, 0x42, 0x90, 0x74,
+	0x95, 0x57, 0xeb, 0x2a, 0x2f, 0x3e, 0x8e, 0xa4, 0x1f, 0xc2, 0x92, 0xc3, 0x27, 0xff, 0xe4, 0x20,
+	0xdc, 0x21, 0x84, 0xf4, 0xb2, 0x5f, 0xe3, 0xb7, 0x02, 0xda, 0x76, 0xa8, 0x50, 0x9d, 0xc1, 0xdf,
+	0xa1, 0x92, 0x8a, 0xc7, 0xa3, 0x92, 0x56, 0xad, 0x43, 0xab, 0x59, 0xbe, 0xf3, 0x91, 0x9d, 0xd2,
+	0xda, 0x79, 0xda, 0xac, 0xa9, 0x0a, 0x6d, 0x4f, 0x8e, 0xec, 0x93, 0xfe, 0xf7, 0xe0, 0xca, 0xc7,
+	0x20, 0xa9, 0x83, 0xcf, 0xa7, 0xf5, 0x8d, 0x64, 0x5a, 0x47, 0x99, 0x8d, 0xcc, 0x59, 0xf1, 0x10,
+	0x15, 0x45, 0x0c, 0x6e, 0xb5, 0xa0, 0xd9, 0x1f, 0xd9, 0x6f, 0x7c, 0x76, 0x6c, 0x13, 0x73, 0x2f,
+	0x06, 0xd7, 0xa9, 0x18, 0xcd, 0xa2, 0x5a, 0x11, 0xad, 0x80, 0x63, 0x74, 0x43, 0x48, 0x2a, 0xc7,
+	0xa2, 0xba, 0xa9, 0xb5, 0x8e, 0xdf, 0x82, 0x96, 0xe6, 0x73, 0xfe, 0x67, 0xd4, 0x6e, 0xa4, 0x6b,
+	0x62, 0x74, 0x1a, 0x1e, 0xba, 0x6d, 0x80, 0xed, 0x68, 0xc0, 0x41, 0x88, 0x2e, 0x0b, 0x7c, 0xf7,
+	0x0c, 0x77, 0xd0, 0xb6, 0x1f, 0x3b, 0x01, 0x73, 0x47, 0xa6, 0xa8, 0xef, 0xe5, 0x8a, 0x6a, 0x67,
+	0x07, 0x4c, 0x15, 0xb2, 0xdd, 0xd5, 0x40, 0x67, 0xd7, 0x68, 0x6c, 0x1b, 0x03, 0x99, 0x51, 0x34,
+	0x7e, 0xb7, 0x50, 0xd9, 0xc8, 0x74, 0x7c, 0x21, 0xf1, 0x37, 0x4b, 0x3d, 0xb3, 0xd7, 0xeb, 0x99,
+	0xf2, 0xd6, 0x1d, 0xbb, 0x65, 0xb4, 0x4a, 0x33, 0x4b, 0xae, 0x5f, 0x03, 0xb4, 0xe5, 0x4b, 0x08,
+	0x45, 0xb5, 0x70, 0xb8, 0xd9, 0x2c, 0xdf, 0x71, 0xae, 0x5f, 0x44, 0xe7, 0xa6, 0x91, 0xdb, 0x6a,
+	0x2b, 0x62, 0x92, 0xf2, 0x37, 0x2e, 0x2c, 0x74, 0xd3, 0x20, 0x4c, 0xd9, 0xfe, 0xfd, 0xc3, 0x18,
+	0x2d, 0x1c, 0xc6, 0xce, 0xf5, 0x73, 0x4b, 0x23, 0xbf, 0xea, 0x48, 0x36, 0x5e, 0x59, 0xe8, 0xff,
+	0x0b, 0xc8, 0xff, 0xa0, 0x81, 0xe1, 0x62, 0x03, 0x8f, 0xdf, 0x56, 0x92, 0x57, 0xb4, 0xf1, 0xaf,
+	0xc2, 0xa5, 0x14, 0x55, 0xfa, 0x98, 0xa3, 0xdd, 0x98, 0x33, 0xd5, 0x81, 0x1e, 0x04, 0xe0, 0x4a,
+	0xc6, 0x4d, 0xa6, 0x1f, 0xaf, 0x99, 0x29, 0xed, 0x43, 0x30, 0x73, 0x75, 0xf6, 0x92, 0x69, 0x7d,
+	0xb7, 0xbb, 0xc8, 0x47, 0x2e, 0x0b, 0x60, 0x8a, 0xca, 0x21, 0x7d, 0xde, 0xf1, 0x4f, 0x41, 0x4d,
+	0xbf, 0x6a, 0xe1, 0x75, 0x2a, 0xfb, 0x70, 0x9c, 0x26, 0xef, 0xec, 0x26, 0xd3, 0x7a, 0xf9, 0x71,
+	0x46, 0x43, 0xf2, 0x9c, 0xf8, 0x11, 0xc2, 0x34, 0x08, 0xd8, 0x33, 0xf0, 0x7a, 0x6c, 0xcc, 0x5d,
+	0x78, 0xd0, 0x7e, 0x48, 0xd4, 0xb8, 0xd9, 0x6c, 0xee, 0x38, 0xfb, 0xc9, 0xb4, 0x8e, 0x3f, 0x5b,
+	0xda, 0x25, 0x2b, 0x3c, 0x70, 0x1b, 0x95, 0xc4, 0x58, 0x1f, 0x50, 0x51, 0x2d, 0xea, 0x36, 0xbd,
+	0x9b, 0x9f, 0x10, 0xea, 0x61, 0x51, 0x51, 0xf5, 0x52, 0x4c, 0xd6, 0x6e, 0x63, 0x10, 0x64, 0xee,
+	0xde, 0xf8, 0xb3, 0x30, 0x9f, 0x0e, 0xba, 0xf2, 0x5f, 0xa1, 0x92, 0x18, 0x32, 0x26, 0x09, 0x9c,
+	0x9a, 0x92, 0x37, 0xf3, 0xd4, 0xea, 0x05, 0xd4, 0x05, 0x66, 0x2e, 0x0d, 0xd2, 0x3b, 0x42, 0xe0,
+	0x14, 0x38, 0x44, 0x2e, 0xe4, 0x74, 0x0c, 0x03, 0x99, 0x73, 0xe1, 0x26, 0x2a, 0x09, 0x00, 0xef,
+	0x09, 0x35, 0xa5, 0xdd, 0x71, 0x2a, 0x1a, 0x69, 0x6c, 0x64, 0xbe, 0x8b, 0xef, 0xa2, 0x4a, 0xcc,
+	0xd9, 0xc4, 0xf7, 0x80, 0x3f, 0x3d, 0x8b, 0x41, 0x4f, 0xe3, 0x1d, 0xe7, 0x56, 0x32, 0xad, 0x57,
+	0xba, 0x39, 0x3b, 0x59, 0x40, 0xe1, 0x7b, 0xa8, 0x22, 0xc4, 0xb0, 0x3b, 0xee, 0x07, 0xbe, 0xfb,
+	0x25, 0x9c, 0x55, 0x8b, 0xda, 0xeb, 0xb6, 0x89, 0xa8, 0xd2, 0xeb, 0x1d, 0xcf, 0xf7, 0xc8, 0x02,
+	0x12, 0xff, 0x88, 0xb6, 0xfd, 0x74, 0xfc, 0x56, 0xb7, 0x74, 0x2d, 0x4f, 0xae, 0x7f, 0xe4, 0x17,
+	0xe6, 0x79, 0x6e, 0x36, 0xa7, 0x66, 0x32, 0x13, 0x6c, 0xfc, 0x5a, 0x9c, 0x0f, 0xb1, 0xf4, 0x6d,
+	0xc0, 0x4f, 0xb2, 0x68, 0xd2, 0xf2, 0xbf, 0xbf, 0xba, 0xfc, 0xd4, 0x73, 0x68, 0x40, 0x23, 0x17,
+	0xb8, 0x21, 0x75, 0xca, 0xab, 0x14, 0xf0, 0x0f, 0x08, 0xb9, 0x2c, 0xf2, 0x7c, 0x1d, 0xa7, 0xb9,
+	0xd3, 0x9f, 0xae, 0x99, 0xa0, 0x51, 0xd3, 0xbf, 0x51, 0xf6, 0x83, 0x19, 0x4b, 0x36, 0x23, 0xe7,
+	0x26, 0x41, 0x72, 0x22, 0xf8, 0x27, 0xb4, 0x1f, 0x50, 0x21, 0x8f, 0x81, 0x72, 0xd9, 0x07, 0x2a,
+	0x9f, 0xfa, 0x21, 0x08, 0x49, 0xc3, 0xd8, 0x3c, 0xac, 0x1f, 0xac, 0x77, 0xa7, 0x94, 0x9b, 0x73,
+	0x90, 0x4c, 0xeb, 0xfb, 0x9d, 0x95, 0x6c, 0xe4, 0x0a, 0x15, 0x3c, 0x46, 0x7b, 0xf0, 0x3c, 0xf6,
+	0xd3, 0xde, 0x64, 0xe2, 0xc5, 0xd7, 0x16, 0x7f, 0x27, 0x99, 0xd6, 0xf7, 0x3e, 0x5f, 0xa6, 0x22,
+	0xab, 0xf8, 0xd5, 0xe5, 0x66, 0x7d, 0x01, 0x7c, 0x02, 0xde, 0x17, 0xe9, 0x2f, 0x93, 0xcf, 0xa2,
+	0xea, 0xd6, 0xa1, 0xd5, 0xdc, 0x4c, 0x2f, 0xf7, 0xc9, 0xd2, 0x2e, 0x59, 0xe1, 0xe1, 0x7c, 0x7b,
+	0x7e, 0x51, 0xdb, 0x78, 0x71, 0x51, 0xdb, 0x78, 0x79, 0x51, 0xdb, 0xf8, 0x39, 0xa9, 0x59, 0xe7,
+	0x49, 0xcd, 0x7a, 0x91, 0xd4, 0xac, 0x97, 0x49, 0xcd, 0x7a, 0x95, 0xd4, 0xac, 0x5f, 0xfe, 0xa8,
+	0x6d, 0x7c, 0x7d, 0xff, 0x8d, 0xff, 0xc7, 0xff, 0x1e, 0x00, 0xde, 0x5e, 0x6c, 0xd0, 0xcb, 0x0b,
+	0x00, 0x00,
 }
 
 func (m *Bastion) Marshal() (dAtA []byte, err error) {
@@ -373,6 +473,166 @@ func (m *BastionList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BastionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BastionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BastionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BastionPolicyList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BastionPolicyList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BastionPolicyList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BastionPolicySpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BastionPolicySpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BastionPolicySpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Subjects) > 0 {
+		for iNdEx := len(m.Subjects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AllowedSourceCIDRs) > 0 {
+		for iNdEx := len(m.AllowedSourceCIDRs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSourceCIDRs[iNdEx])
+			copy(dAtA[i:], m.AllowedSourceCIDRs[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.AllowedSourceCIDRs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxLifetime != nil {
+		{
+			size, err := m.MaxLifetime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProjectSelector != nil {
+		{
+			size, err := m.ProjectSelector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BastionSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -571,6 +831,65 @@ func (m *BastionList) Size() (n int) {
 	return n
 }
 
+func (m *BastionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *BastionPolicyList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BastionPolicySpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProjectSelector != nil {
+		l = m.ProjectSelector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.MaxLifetime != nil {
+		l = m.MaxLifetime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.AllowedSourceCIDRs) > 0 {
+		for _, s := range m.AllowedSourceCIDRs {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Subjects) > 0 {
+		for _, e := range m.Subjects {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *BastionSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -672,37 +991,82 @@ func (this *BastionList) String() string {
 	}, "")
 	return s
 }
-func (this *BastionSpec) String() string {
+func (this *BastionPolicy) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForIngress := "[]BastionIngressPolicy{"
-	for _, f := range this.Ingress {
-		repeatedStringForIngress += strings.Replace(strings.Replace(f.String(), "BastionIngressPolicy", "BastionIngressPolicy", 1), `&`, ``, 1) + ","
-	}
-	repeatedStringForIngress += "}"
-	s := strings.Join([]string{`&BastionSpec{`,
-		`ShootRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ShootRef), "LocalObjectReference", "v12.LocalObjectReference", 1), `&`, ``, 1) + `,`,
-		`SeedName:` + valueToStringGenerated(this.SeedName) + `,`,
-		`ProviderType:` + valueToStringGenerated(this.ProviderType) + `,`,
-		`SSHPublicKey:` + fmt.Sprintf("%v", this.SSHPublicKey) + `,`,
-		`Ingress:` + repeatedStringForIngress + `,`,
+	s := strings.Join([]string{`&BastionPolicy{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "BastionPolicySpec", "BastionPolicySpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *BastionStatus) String() string {
+func (this *BastionPolicyList) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForConditions := "[]Condition{"
-	for _, f := range this.Conditions {
-		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
+	repeatedStringForItems := "[]BastionPolicy{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "BastionPolicy", "BastionPolicy", 1), `&`, ``, 1) + ","
 	}
-	repeatedStringForConditions += "}"
-	s := strings.Join([]string{`&BastionStatus{`,
-		`Ingress:` + strings.Replace(fmt.Sprintf("%v", this.Ingress), "LoadBalancerIngress", "v12.LoadBalancerIngress", 1) + `,`,
-		`Conditions:` + repeatedStringForConditions + `,`,
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&BastionPolicyList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BastionPolicySpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSubjects := "[]Subject{"
+	for _, f := range this.Subjects {
+		repeatedStringForSubjects += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForSubjects += "}"
+	s := strings.Join([]string{`&BastionPolicySpec{`,
+		`ProjectSelector:` + strings.Replace(fmt.Sprintf("%v", this.ProjectSelector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`MaxLifetime:` + strings.Replace(fmt.Sprintf("%v", this.MaxLifetime), "Duration", "v1.Duration", 1) + `,`,
+		`AllowedSourceCIDRs:` + fmt.Sprintf("%v", this.AllowedSourceCIDRs) + `,`,
+		`Subjects:` + repeatedStringForSubjects + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BastionSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForIngress := "[]BastionIngressPolicy{"
+	for _, f := range this.Ingress {
+		repeatedStringForIngress += strings.Replace(strings.Replace(f.String(), "BastionIngressPolicy", "BastionIngressPolicy", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForIngress += "}"
+	s := strings.Join([]string{`&BastionSpec{`,
+		`ShootRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ShootRef), "LocalObjectReference", "v13.LocalObjectReference", 1), `&`, ``, 1) + `,`,
+		`SeedName:` + valueToStringGenerated(this.SeedName) + `,`,
+		`ProviderType:` + valueToStringGenerated(this.ProviderType) + `,`,
+		`SSHPublicKey:` + fmt.Sprintf("%v", this.SSHPublicKey) + `,`,
+		`Ingress:` + repeatedStringForIngress + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BastionStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForConditions += "}"
+	s := strings.Join([]string{`&BastionStatus{`,
+		`Ingress:` + strings.Replace(fmt.Sprintf("%v", this.Ingress), "LoadBalancerIngress", "v13.LoadBalancerIngress", 1) + `,`,
+		`Conditions:` + repeatedStringForConditions + `,`,
 		`LastHeartbeatTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatTimestamp), "Time", "v1.Time", 1) + `,`,
 		`ExpirationTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.ExpirationTimestamp), "Time", "v1.Time", 1) + `,`,
 		`ObservedGeneration:` + valueToStringGenerated(this.ObservedGeneration) + `,`,
@@ -1067,6 +1431,427 @@ func (m *BastionList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BastionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BastionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BastionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BastionPolicyList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BastionPolicyList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BastionPolicyList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, BastionPolicy{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BastionPolicySpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BastionPolicySpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BastionPolicySpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProjectSelector == nil {
+				m.ProjectSelector = &v1.LabelSelector{}
+			}
+			if err := m.ProjectSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLifetime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxLifetime == nil {
+				m.MaxLifetime = &v1.Duration{}
+			}
+			if err := m.MaxLifetime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSourceCIDRs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSourceCIDRs = append(m.AllowedSourceCIDRs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subjects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subjects = append(m.Subjects, v12.Subject{})
+			if err := m.Subjects[len(m.Subjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BastionSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1341,7 +2126,7 @@ func (m *BastionStatus) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Ingress == nil {
-				m.Ingress = &v12.LoadBalancerIngress{}
+				m.Ingress = &v13.LoadBalancerIngress{}
 			}
 			if err := m.Ingress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	"net"
+	"slices"
+	"time"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+)
+
+// BastionPoliciesForProject returns those of the given BastionPolicies whose project selector matches the given
+// Project. A policy without project selector applies to all projects.
+func BastionPoliciesForProject(policies []*operationsv1alpha1.BastionPolicy, project *gardencorev1beta1.Project) ([]*operationsv1alpha1.BastionPolicy, error) {
+	var result []*operationsv1alpha1.BastionPolicy
+
+	for _, policy := range policies {
+		selector := labels.Everything()
+		if policy.Spec.ProjectSelector != nil {
+			var err error
+			if selector, err = metav1.LabelSelectorAsSelector(policy.Spec.ProjectSelector); err != nil {
+				return nil, err
+			}
+		}
+
+		if selector.Matches(labels.Set(project.Labels)) {
+			result = append(result, policy)
+		}
+	}
+
+	return result, nil
+}
+
+// EffectiveBastionMaxLifetime returns the smallest maximum lifetime of the given default and the given BastionPolicies.
+func EffectiveBastionMaxLifetime(defaultMaxLifetime time.Duration, policies []*operationsv1alpha1.BastionPolicy) time.Duration {
+	maxLifetime := defaultMaxLifetime
+
+	for _, policy := range policies {
+		if policy.Spec.MaxLifetime != nil && policy.Spec.MaxLifetime.Duration < maxLifetime {
+			maxLifetime = policy.Spec.MaxLifetime.Duration
+		}
+	}
+
+	return maxLifetime
+}
+
+// BastionPolicyAllowsUser returns true if the given BastionPolicy does not restrict the subjects or if one of its
+// subjects matches the user with the given name and groups.
+func BastionPolicyAllowsUser(policy *operationsv1alpha1.BastionPolicy, userName string, groups []string) bool {
+	if len(policy.Spec.Subjects) == 0 {
+		return true
+	}
+
+	for _, subject := range policy.Spec.Subjects {
+		switch subject.Kind {
+		case rbacv1.UserKind:
+			if subject.Name == userName {
+				return true
+			}
+		case rbacv1.GroupKind:
+			if slices.Contains(groups, subject.Name) {
+				return true
+			}
+		case rbacv1.ServiceAccountKind:
+			if serviceaccount.MatchesUsername(subject.Namespace, subject.Name, userName) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// BastionPolicyAllowsSourceCIDR returns true if the given BastionPolicy does not restrict the source CIDRs or if the
+// given CIDR is contained in one of its allowed source CIDRs.
+func BastionPolicyAllowsSourceCIDR(policy *operationsv1alpha1.BastionPolicy, cidr string) bool {
+	if len(policy.Spec.AllowedSourceCIDRs) == 0 {
+		return true
+	}
+
+	_, sourceNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return false
+	}
+	sourceOnes, sourceBits := sourceNet.Mask.Size()
+
+	for _, allowedCIDR := range policy.Spec.AllowedSourceCIDRs {
+		_, allowedNet, err := net.ParseCIDR(allowedCIDR)
+		if err != nil {
+			continue
+		}
+		allowedOnes, allowedBits := allowedNet.Mask.Size()
+
+		if allowedBits == sourceBits && allowedOnes <= sourceOnes && allowedNet.Contains(sourceNet.IP) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	. "github.com/gardener/gardener/pkg/apis/operations/v1alpha1/helper"
+)
+
+var _ = Describe("Helper", func() {
+	Describe("#BastionPoliciesForProject", func() {
+		It("should return the policies selecting the project", func() {
+			var (
+				project = &gardencorev1beta1.Project{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"restricted": "true"}}}
+
+				policyWithoutSelector   = &operationsv1alpha1.BastionPolicy{ObjectMeta: metav1.ObjectMeta{Name: "all"}}
+				policyWithEmptySelector = &operationsv1alpha1.BastionPolicy{ObjectMeta: metav1.ObjectMeta{Name: "empty"}, Spec: operationsv1alpha1.BastionPolicySpec{ProjectSelector: &metav1.LabelSelector{}}}
+				policyMatching          = &operationsv1alpha1.BastionPolicy{ObjectMeta: metav1.ObjectMeta{Name: "matching"}, Spec: operationsv1alpha1.BastionPolicySpec{ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"restricted": "true"}}}}
+				policyNotMatching       = &operationsv1alpha1.BastionPolicy{ObjectMeta: metav1.ObjectMeta{Name: "not-matching"}, Spec: operationsv1alpha1.BastionPolicySpec{ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"restricted": "false"}}}}
+			)
+
+			Expect(BastionPoliciesForProject([]*operationsv1alpha1.BastionPolicy{policyWithoutSelector, policyWithEmptySelector, policyMatching, policyNotMatching}, project)).To(ConsistOf(policyWithoutSelector, policyWithEmptySelector, policyMatching))
+		})
+
+		It("should fail for an invalid selector", func() {
+			policy := &operationsv1alpha1.BastionPolicy{Spec: operationsv1alpha1.BastionPolicySpec{ProjectSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "foo", Operator: "bar"}}}}}
+
+			_, err := BastionPoliciesForProject([]*operationsv1alpha1.BastionPolicy{policy}, &gardencorev1beta1.Project{})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#EffectiveBastionMaxLifetime", func() {
+		It("should return the smallest maximum lifetime", func() {
+			policies := []*operationsv1alpha1.BastionPolicy{
+				{},
+				{Spec: operationsv1alpha1.BastionPolicySpec{MaxLifetime: &metav1.Duration{Duration: 4 * time.Hour}}},
+				{Spec: operationsv1alpha1.BastionPolicySpec{MaxLifetime: &metav1.Duration{Duration: 2 * time.Hour}}},
+			}
+
+			Expect(EffectiveBastionMaxLifetime(24*time.Hour, policies)).To(Equal(2 * time.Hour))
+			Expect(EffectiveBastionMaxLifetime(time.Hour, policies)).To(Equal(time.Hour))
+			Expect(EffectiveBastionMaxLifetime(time.Hour, nil)).To(Equal(time.Hour))
+		})
+	})
+
+	DescribeTable("#BastionPolicyAllowsUser",
+		func(subjects []rbacv1.Subject, userName string, groups []string, expected bool) {
+			policy := &operationsv1alpha1.BastionPolicy{Spec: operationsv1alpha1.BastionPolicySpec{Subjects: subjects}}
+			Expect(BastionPolicyAllowsUser(policy, userName, groups)).To(Equal(expected))
+		},
+
+		Entry("no subjects", nil, "alice", nil, true),
+		Entry("matching user", []rbacv1.Subject{{Kind: rbacv1.UserKind, Name: "alice"}}, "alice", nil, true),
+		Entry("other user", []rbacv1.Subject{{Kind: rbacv1.UserKind, Name: "bob"}}, "alice", nil, false),
+		Entry("matching group", []rbacv1.Subject{{Kind: rbacv1.GroupKind, Name: "operators"}}, "alice", []string{"system:authenticated", "operators"}, true),
+		Entry("other group", []rbacv1.Subject{{Kind: rbacv1.GroupKind, Name: "operators"}}, "alice", []string{"system:authenticated"}, false),
+		Entry("matching service account", []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Namespace: "garden-dev", Name: "robot"}}, "system:serviceaccount:garden-dev:robot", nil, true),
+		Entry("service account in other namespace", []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Namespace: "garden-dev", Name: "robot"}}, "system:serviceaccount:garden-prod:robot", nil, false),
+		Entry("user named like a group", []rbacv1.Subject{{Kind: rbacv1.GroupKind, Name: "alice"}}, "alice", nil, false),
+	)
+
+	DescribeTable("#BastionPolicyAllowsSourceCIDR",
+		func(allowedCIDRs []string, cidr string, expected bool) {
+			policy := &operationsv1alpha1.BastionPolicy{Spec: operationsv1alpha1.BastionPolicySpec{AllowedSourceCIDRs: allowedCIDRs}}
+			Expect(BastionPolicyAllowsSourceCIDR(policy, cidr)).To(Equal(expected))
+		},
+
+		Entry("no allowed CIDRs", nil, "0.0.0.0/0", true),
+		Entry("same CIDR", []string{"10.0.0.0/8"}, "10.0.0.0/8", true),
+		Entry("contained CIDR", []string{"192.168.0.0/16", "10.0.0.0/8"}, "10.1.2.3/32", true),
+		Entry("overlapping but larger CIDR", []string{"10.0.0.0/8"}, "10.0.0.0/7", false),
+		Entry("disjoint CIDR", []string{"10.0.0.0/8"}, "11.0.0.0/8", false),
+		Entry("contained IPv6 CIDR", []string{"2001:db8::/32"}, "2001:db8:1::/48", true),
+		Entry("IPv6 CIDR and IPv4 allowed CIDR", []string{"0.0.0.0/0"}, "::/0", false),
+		Entry("invalid CIDR", []string{"0.0.0.0/0"}, "foo", false),
+	)
+})
@@ -16,6 +16,21 @@ const (
 	// BastionReady is a condition type for indicating whether the bastion has been
 	// successfully reconciled on the seed cluster and is available to be used.
 	BastionReady gardencorev1beta1.ConditionType = "BastionReady"
+
+	// AnnotationLastHeartbeatBy is the key for an annotation of a Bastion whose value contains the name of the user
+	// who extended the Bastion with the last keepalive operation.
+	AnnotationLastHeartbeatBy = "operations.gardener.cloud/last-heartbeat-by"
+	// AnnotationAuditedHeartbeatTimestamp is the key for an annotation of a Bastion whose value contains the last
+	// heartbeat timestamp for which an audit event was recorded.
+	AnnotationAuditedHeartbeatTimestamp = "operations.gardener.cloud/audited-heartbeat-timestamp"
+
+	// EventBastionCreated is an event reason for Bastions which have been created.
+	EventBastionCreated = "BastionCreated"
+	// EventBastionExtended is an event reason for Bastions whose expiration has been extended by a heartbeat.
+	EventBastionExtended = "BastionExtended"
+	// EventBastionExpired is an event reason for Bastions which are deleted because they expired or reached their
+	// maximum lifetime.
+	EventBastionExpired = "BastionExpired"
 )
 
 // +genclient
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BastionPolicy restricts the usage of Bastions in the selected projects.
+type BastionPolicy struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec contains the specification of the BastionPolicy.
+	Spec BastionPolicySpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BastionPolicyList is a list of BastionPolicy objects.
+type BastionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Items is the list of BastionPolicy.
+	Items []BastionPolicy `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// BastionPolicySpec is the specification of a BastionPolicy.
+type BastionPolicySpec struct {
+	// ProjectSelector selects the projects to which this policy applies. Defaults to the empty label selector which
+	// matches all projects.
+	// +optional
+	ProjectSelector *metav1.LabelSelector `json:"projectSelector,omitempty" protobuf:"bytes,1,opt,name=projectSelector"`
+	// MaxLifetime is the maximum duration a Bastion in one of the selected projects may exist before it is deleted,
+	// regardless of its heartbeats.
+	// +optional
+	MaxLifetime *metav1.Duration `json:"maxLifetime,omitempty" protobuf:"bytes,2,opt,name=maxLifetime"`
+	// AllowedSourceCIDRs is a list of CIDRs. Each IP block in the ingress of a Bastion in one of the selected projects
+	// must be contained in one of them. If empty, all sources are allowed.
+	// +optional
+	AllowedSourceCIDRs []string `json:"allowedSourceCIDRs,omitempty" protobuf:"bytes,3,rep,name=allowedSourceCIDRs"`
+	// Subjects is a list of users, groups, or service accounts which are allowed to create Bastions in the selected
+	// projects. If empty, all subjects which are permitted to create Bastions are allowed.
+	// +optional
+	Subjects []rbacv1.Subject `json:"subjects,omitempty" protobuf:"bytes,4,rep,name=subjects"`
+}
@@ -15,8 +15,9 @@ import (
 	core "github.com/gardener/gardener/pkg/apis/core"
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	operations "github.com/gardener/gardener/pkg/apis/operations"
-	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -58,6 +59,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BastionPolicy)(nil), (*operations.BastionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BastionPolicy_To_operations_BastionPolicy(a.(*BastionPolicy), b.(*operations.BastionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.BastionPolicy)(nil), (*BastionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_BastionPolicy_To_v1alpha1_BastionPolicy(a.(*operations.BastionPolicy), b.(*BastionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BastionPolicyList)(nil), (*operations.BastionPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BastionPolicyList_To_operations_BastionPolicyList(a.(*BastionPolicyList), b.(*operations.BastionPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.BastionPolicyList)(nil), (*BastionPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_BastionPolicyList_To_v1alpha1_BastionPolicyList(a.(*operations.BastionPolicyList), b.(*BastionPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BastionPolicySpec)(nil), (*operations.BastionPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BastionPolicySpec_To_operations_BastionPolicySpec(a.(*BastionPolicySpec), b.(*operations.BastionPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.BastionPolicySpec)(nil), (*BastionPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_BastionPolicySpec_To_v1alpha1_BastionPolicySpec(a.(*operations.BastionPolicySpec), b.(*BastionPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BastionSpec)(nil), (*operations.BastionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_BastionSpec_To_operations_BastionSpec(a.(*BastionSpec), b.(*operations.BastionSpec), scope)
 	}); err != nil {
@@ -155,6 +186,80 @@ func Convert_operations_BastionList_To_v1alpha1_BastionList(in *operations.Basti
 	return autoConvert_operations_BastionList_To_v1alpha1_BastionList(in, out, s)
 }
 
+func autoConvert_v1alpha1_BastionPolicy_To_operations_BastionPolicy(in *BastionPolicy, out *operations.BastionPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_BastionPolicySpec_To_operations_BastionPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_BastionPolicy_To_operations_BastionPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_BastionPolicy_To_operations_BastionPolicy(in *BastionPolicy, out *operations.BastionPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BastionPolicy_To_operations_BastionPolicy(in, out, s)
+}
+
+func autoConvert_operations_BastionPolicy_To_v1alpha1_BastionPolicy(in *operations.BastionPolicy, out *BastionPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_operations_BastionPolicySpec_To_v1alpha1_BastionPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_operations_BastionPolicy_To_v1alpha1_BastionPolicy is an autogenerated conversion function.
+func Convert_operations_BastionPolicy_To_v1alpha1_BastionPolicy(in *operations.BastionPolicy, out *BastionPolicy, s conversion.Scope) error {
+	return autoConvert_operations_BastionPolicy_To_v1alpha1_BastionPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_BastionPolicyList_To_operations_BastionPolicyList(in *BastionPolicyList, out *operations.BastionPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]operations.BastionPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_BastionPolicyList_To_operations_BastionPolicyList is an autogenerated conversion function.
+func Convert_v1alpha1_BastionPolicyList_To_operations_BastionPolicyList(in *BastionPolicyList, out *operations.BastionPolicyList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BastionPolicyList_To_operations_BastionPolicyList(in, out, s)
+}
+
+func autoConvert_operations_BastionPolicyList_To_v1alpha1_BastionPolicyList(in *operations.BastionPolicyList, out *BastionPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]BastionPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_operations_BastionPolicyList_To_v1alpha1_BastionPolicyList is an autogenerated conversion function.
+func Convert_operations_BastionPolicyList_To_v1alpha1_BastionPolicyList(in *operations.BastionPolicyList, out *BastionPolicyList, s conversion.Scope) error {
+	return autoConvert_operations_BastionPolicyList_To_v1alpha1_BastionPolicyList(in, out, s)
+}
+
+func autoConvert_v1alpha1_BastionPolicySpec_To_operations_BastionPolicySpec(in *BastionPolicySpec, out *operations.BastionPolicySpec, s conversion.Scope) error {
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	out.MaxLifetime = (*v1.Duration)(unsafe.Pointer(in.MaxLifetime))
+	out.AllowedSourceCIDRs = *(*[]string)(unsafe.Pointer(&in.AllowedSourceCIDRs))
+	out.Subjects = *(*[]rbacv1.Subject)(unsafe.Pointer(&in.Subjects))
+	return nil
+}
+
+// Convert_v1alpha1_BastionPolicySpec_To_operations_BastionPolicySpec is an autogenerated conversion function.
+func Convert_v1alpha1_BastionPolicySpec_To_operations_BastionPolicySpec(in *BastionPolicySpec, out *operations.BastionPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BastionPolicySpec_To_operations_BastionPolicySpec(in, out, s)
+}
+
+func autoConvert_operations_BastionPolicySpec_To_v1alpha1_BastionPolicySpec(in *operations.BastionPolicySpec, out *BastionPolicySpec, s conversion.Scope) error {
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	out.MaxLifetime = (*v1.Duration)(unsafe.Pointer(in.MaxLifetime))
+	out.AllowedSourceCIDRs = *(*[]string)(unsafe.Pointer(&in.AllowedSourceCIDRs))
+	out.Subjects = *(*[]rbacv1.Subject)(unsafe.Pointer(&in.Subjects))
+	return nil
+}
+
+// Convert_operations_BastionPolicySpec_To_v1alpha1_BastionPolicySpec is an autogenerated conversion function.
+func Convert_operations_BastionPolicySpec_To_v1alpha1_BastionPolicySpec(in *operations.BastionPolicySpec, out *BastionPolicySpec, s conversion.Scope) error {
+	return autoConvert_operations_BastionPolicySpec_To_v1alpha1_BastionPolicySpec(in, out, s)
+}
+
 func autoConvert_v1alpha1_BastionSpec_To_operations_BastionSpec(in *BastionSpec, out *operations.BastionSpec, s conversion.Scope) error {
 	out.ShootRef = in.ShootRef
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
@@ -184,10 +289,10 @@ func Convert_operations_BastionSpec_To_v1alpha1_BastionSpec(in *operations.Basti
 }
 
 func autoConvert_v1alpha1_BastionStatus_To_operations_BastionStatus(in *BastionStatus, out *operations.BastionStatus, s conversion.Scope) error {
-	out.Ingress = (*v1.LoadBalancerIngress)(unsafe.Pointer(in.Ingress))
+	out.Ingress = (*corev1.LoadBalancerIngress)(unsafe.Pointer(in.Ingress))
 	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
-	out.LastHeartbeatTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastHeartbeatTimestamp))
-	out.ExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	out.LastHeartbeatTimestamp = (*v1.Time)(unsafe.Pointer(in.LastHeartbeatTimestamp))
+	out.ExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
 	out.ObservedGeneration = (*int64)(unsafe.Pointer(in.ObservedGeneration))
 	return nil
 }
@@ -198,10 +303,10 @@ func Convert_v1alpha1_BastionStatus_To_operations_BastionStatus(in *BastionStatu
 }
 
 func autoConvert_operations_BastionStatus_To_v1alpha1_BastionStatus(in *operations.BastionStatus, out *BastionStatus, s conversion.Scope) error {
-	out.Ingress = (*v1.LoadBalancerIngress)(unsafe.Pointer(in.Ingress))
+	out.Ingress = (*corev1.LoadBalancerIngress)(unsafe.Pointer(in.Ingress))
 	out.Conditions = *(*[]v1beta1.Condition)(unsafe.Pointer(&in.Conditions))
-	out.LastHeartbeatTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastHeartbeatTimestamp))
-	out.ExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	out.LastHeartbeatTimestamp = (*v1.Time)(unsafe.Pointer(in.LastHeartbeatTimestamp))
+	out.ExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
 	out.ObservedGeneration = (*int64)(unsafe.Pointer(in.ObservedGeneration))
 	return nil
 }
@@ -17,5 +17,18 @@ import (
 // Public to allow building arbitrary schemes.
 // All generated defaulters are covering - they call all nested defaulters.
 func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&BastionPolicy{}, func(obj interface{}) { SetObjectDefaults_BastionPolicy(obj.(*BastionPolicy)) })
+	scheme.AddTypeDefaultingFunc(&BastionPolicyList{}, func(obj interface{}) { SetObjectDefaults_BastionPolicyList(obj.(*BastionPolicyList)) })
 	return nil
 }
+
+func SetObjectDefaults_BastionPolicy(in *BastionPolicy) {
+	SetDefaults_BastionPolicySpec(&in.Spec)
+}
+
+func SetObjectDefaults_BastionPolicyList(in *BastionPolicyList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_BastionPolicy(a)
+	}
+}
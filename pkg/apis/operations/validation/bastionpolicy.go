@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"net"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	corevalidation "github.com/gardener/gardener/pkg/apis/core/validation"
+	"github.com/gardener/gardener/pkg/apis/operations"
+)
+
+// ValidateBastionPolicy validates a BastionPolicy object.
+func ValidateBastionPolicy(bastionPolicy *operations.BastionPolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&bastionPolicy.ObjectMeta, false, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateBastionPolicySpec(&bastionPolicy.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateBastionPolicyUpdate validates a BastionPolicy object before an update.
+func ValidateBastionPolicyUpdate(newBastionPolicy, oldBastionPolicy *operations.BastionPolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newBastionPolicy.ObjectMeta, &oldBastionPolicy.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateBastionPolicy(newBastionPolicy)...)
+
+	return allErrs
+}
+
+// ValidateBastionPolicySpec validates the specification of a BastionPolicy object.
+func ValidateBastionPolicySpec(spec *operations.BastionPolicySpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.ProjectSelector, metav1validation.LabelSelectorValidationOptions{AllowInvalidLabelValueInSelector: true}, fldPath.Child("projectSelector"))...)
+
+	if spec.MaxLifetime != nil && spec.MaxLifetime.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxLifetime"), spec.MaxLifetime.Duration.String(), "must be positive"))
+	}
+
+	for i, cidr := range spec.AllowedSourceCIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("allowedSourceCIDRs").Index(i), cidr, "invalid CIDR"))
+		}
+	}
+
+	for i, subject := range spec.Subjects {
+		allErrs = append(allErrs, corevalidation.ValidateSubject(subject, fldPath.Child("subjects").Index(i))...)
+	}
+
+	return allErrs
+}
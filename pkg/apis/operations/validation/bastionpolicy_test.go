@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/operations"
+	. "github.com/gardener/gardener/pkg/apis/operations/validation"
+)
+
+var _ = Describe("BastionPolicy validation", func() {
+	var bastionPolicy *operations.BastionPolicy
+
+	BeforeEach(func() {
+		bastionPolicy = &operations.BastionPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "restricted",
+			},
+			Spec: operations.BastionPolicySpec{
+				ProjectSelector:    &metav1.LabelSelector{MatchLabels: map[string]string{"restricted": "true"}},
+				MaxLifetime:        &metav1.Duration{Duration: 8 * time.Hour},
+				AllowedSourceCIDRs: []string{"10.0.0.0/8", "2001:db8::/32"},
+				Subjects: []rbacv1.Subject{
+					{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "alice"},
+					{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "operators"},
+					{Kind: rbacv1.ServiceAccountKind, Namespace: "garden-dev", Name: "robot"},
+				},
+			},
+		}
+	})
+
+	Describe("#ValidateBastionPolicy", func() {
+		It("should not return any errors", func() {
+			Expect(ValidateBastionPolicy(bastionPolicy)).To(BeEmpty())
+		})
+
+		It("should allow an empty specification", func() {
+			bastionPolicy.Spec = operations.BastionPolicySpec{}
+
+			Expect(ValidateBastionPolicy(bastionPolicy)).To(BeEmpty())
+		})
+
+		It("should forbid BastionPolicy resources with empty metadata", func() {
+			bastionPolicy.ObjectMeta = metav1.ObjectMeta{}
+
+			Expect(ValidateBastionPolicy(bastionPolicy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("metadata.name"),
+			}))))
+		})
+
+		It("should forbid an invalid project selector", func() {
+			bastionPolicy.Spec.ProjectSelector = &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "foo", Operator: "bar"}}}
+
+			Expect(ValidateBastionPolicy(bastionPolicy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.projectSelector.matchExpressions[0].operator"),
+			}))))
+		})
+
+		It("should forbid a non-positive max lifetime", func() {
+			bastionPolicy.Spec.MaxLifetime = &metav1.Duration{}
+
+			Expect(ValidateBastionPolicy(bastionPolicy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.maxLifetime"),
+			}))))
+		})
+
+		It("should forbid invalid source CIDRs", func() {
+			bastionPolicy.Spec.AllowedSourceCIDRs = []string{"10.0.0.0/8", "10.0.0.1"}
+
+			Expect(ValidateBastionPolicy(bastionPolicy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.allowedSourceCIDRs[1]"),
+			}))))
+		})
+
+		It("should forbid invalid subjects", func() {
+			bastionPolicy.Spec.Subjects = []rbacv1.Subject{
+				{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName},
+				{Kind: rbacv1.ServiceAccountKind, Name: "robot"},
+				{Kind: "foo", Name: "bar"},
+			}
+
+			Expect(ValidateBastionPolicy(bastionPolicy)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.subjects[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.subjects[1].namespace"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.subjects[2].kind"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateBastionPolicyUpdate", func() {
+		It("should validate the new object", func() {
+			newBastionPolicy := bastionPolicy.DeepCopy()
+			newBastionPolicy.ResourceVersion = "1"
+			bastionPolicy.ResourceVersion = "1"
+			newBastionPolicy.Spec.AllowedSourceCIDRs = []string{"foo"}
+
+			Expect(ValidateBastionPolicyUpdate(newBastionPolicy, bastionPolicy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.allowedSourceCIDRs[0]"),
+			}))))
+		})
+	})
+})
@@ -252,6 +252,56 @@ type ManagedResourceSpec struct {
 	// resource, should also be deleted when the corresponding StatefulSet is deleted (defaults to false).
 	// +optional
 	DeletePersistentVolumeClaims *bool `json:"deletePersistentVolumeClaims,omitempty"`
+	// ApplyStrategy specifies how the resources are applied to the target cluster. Possible values are 'Update' and
+	// 'ServerSideApply' (defaults to 'Update').
+	// +kubebuilder:validation:Enum=Update;ServerSideApply
+	// +optional
+	ApplyStrategy *ApplyStrategy `json:"applyStrategy,omitempty"`
+	// ServerSideApply contains settings for the 'ServerSideApply' apply strategy.
+	// +optional
+	ServerSideApply *ServerSideApplyConfig `json:"serverSideApply,omitempty"`
+	// Mode specifies whether the desired state of the resources is enforced or whether deviations of the actual state
+	// are only reported. Possible values are 'Enforce' and 'ReportOnly' (defaults to 'Enforce').
+	// +kubebuilder:validation:Enum=Enforce;ReportOnly
+	// +optional
+	Mode *ManagedResourceMode `json:"mode,omitempty"`
+}
+
+// ManagedResourceMode is a mode for handling the resources of a ManagedResource.
+type ManagedResourceMode string
+
+const (
+	// ManagedResourceModeEnforce applies the resources to the target cluster and deletes resources which are no longer
+	// desired.
+	ManagedResourceModeEnforce ManagedResourceMode = "Enforce"
+	// ManagedResourceModeReportOnly does not write anything to the target cluster. Instead, the actual state of the
+	// resources is compared with the desired state and deviations are reported in the 'ResourcesDrifted' condition and
+	// the status of the ManagedResource. When a ManagedResource in this mode is deleted, the resources are kept.
+	ManagedResourceModeReportOnly ManagedResourceMode = "ReportOnly"
+)
+
+// ApplyStrategy is a strategy for applying resources to the target cluster.
+type ApplyStrategy string
+
+const (
+	// ApplyStrategyUpdate merges the desired state into the current state of the resources and updates them. Fields
+	// of the current state which are not part of the desired state are only preserved for selected fields.
+	ApplyStrategyUpdate ApplyStrategy = "Update"
+	// ApplyStrategyServerSideApply applies the resources with server-side apply. Only fields which are part of the
+	// desired state are owned by the resource manager, fields owned by other field managers are preserved.
+	ApplyStrategyServerSideApply ApplyStrategy = "ServerSideApply"
+)
+
+// ServerSideApplyConfig contains settings for the 'ServerSideApply' apply strategy.
+type ServerSideApplyConfig struct {
+	// FieldManager is the name of the field manager used for applying the resources (defaults to
+	// 'gardener-resource-manager').
+	// +optional
+	FieldManager *string `json:"fieldManager,omitempty"`
+	// ForceConflicts specifies whether the ownership of fields which are also managed by other field managers should be
+	// taken over (defaults to true). If false, conflicts are reported in the 'ResourcesApplied' condition.
+	// +optional
+	ForceConflicts *bool `json:"forceConflicts,omitempty"`
 }
 
 // ManagedResourceStatus is the status of a managed resource.
@@ -265,6 +315,30 @@ type ManagedResourceStatus struct {
 	// SecretsDataChecksum is the checksum of referenced secrets data.
 	// +optional
 	SecretsDataChecksum *string `json:"secretsDataChecksum,omitempty"`
+	// Drift is a list of objects whose actual state deviates from their desired state. It is only maintained if the
+	// ManagedResource is in 'ReportOnly' mode.
+	// +optional
+	Drift []ObjectDrift `json:"drift,omitempty"`
+}
+
+// ObjectDrift describes the deviation of an object's actual state from its desired state.
+type ObjectDrift struct {
+	// APIVersion is the API version of the object.
+	APIVersion string `json:"apiVersion"`
+	// Kind is the kind of the object.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the object.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the name of the object.
+	Name string `json:"name"`
+	// Missing is true if the object does not exist in the target cluster.
+	// +optional
+	Missing bool `json:"missing,omitempty"`
+	// Fields is a list of paths of fields whose actual value deviates from the desired value. The list is truncated to
+	// at most 10 entries.
+	// +optional
+	Fields []string `json:"fields,omitempty"`
 }
 
 // ObjectReference is a reference to another object.
@@ -283,6 +357,9 @@ const (
 	ResourcesHealthy gardencorev1beta1.ConditionType = "ResourcesHealthy"
 	// ResourcesProgressing is a condition type that indicates whether some resources are still progressing to be rolled out.
 	ResourcesProgressing gardencorev1beta1.ConditionType = "ResourcesProgressing"
+	// ResourcesDrifted is a condition type that indicates whether the actual state of some resources deviates from their
+	// desired state. It is only maintained if the ManagedResource is in 'ReportOnly' mode.
+	ResourcesDrifted gardencorev1beta1.ConditionType = "ResourcesDrifted"
 )
 
 // These are well-known reasons for Conditions.
@@ -314,4 +391,13 @@ const (
 	// ConditionChecksPending indicates that the `ResourcesProgressing` condition is `Unknown`,
 	// because the condition checks have not been completely executed yet for the current set of resources.
 	ConditionChecksPending = "ChecksPending"
+	// ConditionDriftDetected indicates that the `ResourcesDrifted` condition is `True`,
+	// because the actual state of some resources deviates from their desired state.
+	ConditionDriftDetected = "DriftDetected"
+	// ConditionNoDriftDetected indicates that the `ResourcesDrifted` condition is `False`,
+	// because the actual state of all resources matches their desired state.
+	ConditionNoDriftDetected = "NoDriftDetected"
+	// ConditionDriftDetectionFailed indicates that the `ResourcesDrifted` condition is `Unknown`,
+	// because comparing the actual state of the resources with their desired state failed.
+	ConditionDriftDetectionFailed = "DriftDetectionFailed"
 )
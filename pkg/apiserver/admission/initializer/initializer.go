@@ -14,6 +14,7 @@ import (
 
 	gardencoreclientset "github.com/gardener/gardener/pkg/client/core/clientset/versioned"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	operationsinformers "github.com/gardener/gardener/pkg/client/operations/informers/externalversions"
 	seedmanagementclientset "github.com/gardener/gardener/pkg/client/seedmanagement/clientset/versioned"
 	seedmanagementinformers "github.com/gardener/gardener/pkg/client/seedmanagement/informers/externalversions"
 	settingsinformers "github.com/gardener/gardener/pkg/client/settings/informers/externalversions"
@@ -26,6 +27,7 @@ func New(
 	seedManagementInformers seedmanagementinformers.SharedInformerFactory,
 	seedManagementClient seedmanagementclientset.Interface,
 	settingsInformers settingsinformers.SharedInformerFactory,
+	operationsInformers operationsinformers.SharedInformerFactory,
 	kubeInformers kubeinformers.SharedInformerFactory,
 	kubeClient kubernetes.Interface,
 	dynamicClient dynamic.Interface,
@@ -41,6 +43,8 @@ func New(
 
 		settingsInformers: settingsInformers,
 
+		operationsInformers: operationsInformers,
+
 		kubeInformers: kubeInformers,
 		kubeClient:    kubeClient,
 
@@ -73,6 +77,10 @@ func (i pluginInitializer) Initialize(plugin admission.Interface) {
 		wants.SetSettingsInformerFactory(i.settingsInformers)
 	}
 
+	if wants, ok := plugin.(WantsOperationsInformerFactory); ok {
+		wants.SetOperationsInformerFactory(i.operationsInformers)
+	}
+
 	if wants, ok := plugin.(WantsKubeInformerFactory); ok {
 		wants.SetKubeInformerFactory(i.kubeInformers)
 	}
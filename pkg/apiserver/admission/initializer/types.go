@@ -14,6 +14,7 @@ import (
 
 	gardencoreclientset "github.com/gardener/gardener/pkg/client/core/clientset/versioned"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	operationsinformers "github.com/gardener/gardener/pkg/client/operations/informers/externalversions"
 	seedmanagementclientset "github.com/gardener/gardener/pkg/client/seedmanagement/clientset/versioned"
 	seedmanagementinformers "github.com/gardener/gardener/pkg/client/seedmanagement/informers/externalversions"
 	settingsinformers "github.com/gardener/gardener/pkg/client/settings/informers/externalversions"
@@ -55,6 +56,12 @@ type WantsSettingsInformerFactory interface {
 	admission.InitializationValidator
 }
 
+// WantsOperationsInformerFactory defines a function which sets InformerFactory for admission plugins that need it.
+type WantsOperationsInformerFactory interface {
+	SetOperationsInformerFactory(operationsinformers.SharedInformerFactory)
+	admission.InitializationValidator
+}
+
 // WantsKubeClientset defines a function which sets Kubernetes Clientset for admission plugins that need it.
 type WantsKubeClientset interface {
 	SetKubeClientset(kubernetes.Interface)
@@ -88,6 +95,8 @@ type pluginInitializer struct {
 
 	settingsInformers settingsinformers.SharedInformerFactory
 
+	operationsInformers operationsinformers.SharedInformerFactory
+
 	kubeInformers kubeinformers.SharedInformerFactory
 	kubeClient    kubernetes.Interface
 
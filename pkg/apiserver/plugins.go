@@ -10,6 +10,7 @@ import (
 
 	bastionvalidator "github.com/gardener/gardener/plugin/pkg/bastion/validator"
 	controllerregistrationresources "github.com/gardener/gardener/plugin/pkg/controllerregistration/resources"
+	"github.com/gardener/gardener/plugin/pkg/global/celpolicy"
 	"github.com/gardener/gardener/plugin/pkg/global/customverbauthorizer"
 	"github.com/gardener/gardener/plugin/pkg/global/deletionconfirmation"
 	"github.com/gardener/gardener/plugin/pkg/global/extensionlabels"
@@ -20,6 +21,7 @@ import (
 	namespacedcloudprofilevalidator "github.com/gardener/gardener/plugin/pkg/namespacedcloudprofile/validator"
 	projectvalidator "github.com/gardener/gardener/plugin/pkg/project/validator"
 	seedvalidator "github.com/gardener/gardener/plugin/pkg/seed/validator"
+	shootblueprint "github.com/gardener/gardener/plugin/pkg/shoot/blueprint"
 	shootdns "github.com/gardener/gardener/plugin/pkg/shoot/dns"
 	shootdnsrewriting "github.com/gardener/gardener/plugin/pkg/shoot/dnsrewriting"
 	shootexposureclass "github.com/gardener/gardener/plugin/pkg/shoot/exposureclass"
@@ -40,6 +42,7 @@ func RegisterAllAdmissionPlugins(plugins *admission.Plugins) {
 	deletionconfirmation.Register(plugins)
 	extensionvalidation.Register(plugins)
 	extensionlabels.Register(plugins)
+	shootblueprint.Register(plugins)
 	shoottolerationrestriction.Register(plugins)
 	shootexposureclass.Register(plugins)
 	shootquotavalidator.Register(plugins)
@@ -58,6 +61,7 @@ func RegisterAllAdmissionPlugins(plugins *admission.Plugins) {
 	managedseedvalidator.Register(plugins)
 	managedseedshoot.Register(plugins)
 	bastionvalidator.Register(plugins)
+	celpolicy.Register(plugins)
 	resourcequota.Register(plugins)
 	shootvpa.Register(plugins)
 	shootresourcereservation.Register(plugins)
@@ -5,6 +5,9 @@
 package storage
 
 import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apiserver/pkg/registry/generic"
 	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
@@ -19,22 +22,24 @@ type REST struct {
 	*genericregistry.Store
 }
 
-// CloudProfileStorage implements the storage for CloudProfiles.
+// CloudProfileStorage implements the storage for CloudProfiles and their status subresource.
 type CloudProfileStorage struct {
 	CloudProfile *REST
+	Status       *StatusREST
 }
 
 // NewStorage creates a new CloudProfileStorage object.
 func NewStorage(optsGetter generic.RESTOptionsGetter) CloudProfileStorage {
-	cloudProfileRest := NewREST(optsGetter)
+	cloudProfileRest, cloudProfileStatusRest := NewREST(optsGetter)
 
 	return CloudProfileStorage{
 		CloudProfile: cloudProfileRest,
+		Status:       cloudProfileStatusRest,
 	}
 }
 
 // NewREST returns a RESTStorage object that will work with CloudProfile objects.
-func NewREST(optsGetter generic.RESTOptionsGetter) *REST {
+func NewREST(optsGetter generic.RESTOptionsGetter) (*REST, *StatusREST) {
 	store := &genericregistry.Store{
 		NewFunc:                   func() runtime.Object { return &core.CloudProfile{} },
 		NewListFunc:               func() runtime.Object { return &core.CloudProfileList{} },
@@ -52,7 +57,42 @@ func NewREST(optsGetter generic.RESTOptionsGetter) *REST {
 	if err := store.CompleteWithOptions(options); err != nil {
 		panic(err)
 	}
-	return &REST{store}
+
+	statusStore := *store
+	statusStore.UpdateStrategy = cloudprofile.StatusStrategy
+	return &REST{store}, &StatusREST{store: &statusStore}
+}
+
+// StatusREST implements the REST endpoint for changing the status of a CloudProfile.
+type StatusREST struct {
+	store *genericregistry.Store
+}
+
+var (
+	_ rest.Storage = &StatusREST{}
+	_ rest.Getter  = &StatusREST{}
+	_ rest.Updater = &StatusREST{}
+)
+
+// New creates a new (empty) internal CloudProfile object.
+func (r *StatusREST) New() runtime.Object {
+	return &core.CloudProfile{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *StatusREST) Destroy() {
+	// Given that underlying store is shared with REST,
+	// we don't destroy it here explicitly.
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *StatusREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return r.store.Get(ctx, name, options)
+}
+
+// Update alters the status subset of an object.
+func (r *StatusREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, forceAllowCreate, options)
 }
 
 // Implement ShortNamesProvider
@@ -31,6 +31,7 @@ func (cloudProfileStrategy) NamespaceScoped() bool {
 
 func (cloudProfileStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
 	cloudprofile := obj.(*core.CloudProfile)
+	cloudprofile.Status = core.CloudProfileStatus{}
 
 	dropExpiredVersions(cloudprofile)
 }
@@ -48,8 +49,9 @@ func (cloudProfileStrategy) AllowCreateOnUpdate() bool {
 }
 
 func (cloudProfileStrategy) PrepareForUpdate(_ context.Context, newObj, oldObj runtime.Object) {
-	_ = oldObj.(*core.CloudProfile)
-	_ = newObj.(*core.CloudProfile)
+	newCloudProfile := newObj.(*core.CloudProfile)
+	oldCloudProfile := oldObj.(*core.CloudProfile)
+	newCloudProfile.Status = oldCloudProfile.Status
 }
 
 func (cloudProfileStrategy) AllowUnconditionalUpdate() bool {
@@ -71,6 +73,23 @@ func (cloudProfileStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Obj
 	return nil
 }
 
+type cloudProfileStatusStrategy struct {
+	cloudProfileStrategy
+}
+
+// StatusStrategy defines the storage strategy for the status subresource of CloudProfiles.
+var StatusStrategy = cloudProfileStatusStrategy{Strategy}
+
+func (cloudProfileStatusStrategy) PrepareForUpdate(_ context.Context, newObj, oldObj runtime.Object) {
+	newCloudProfile := newObj.(*core.CloudProfile)
+	oldCloudProfile := oldObj.(*core.CloudProfile)
+	newCloudProfile.Spec = oldCloudProfile.Spec
+}
+
+func (cloudProfileStatusStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	return validation.ValidateCloudProfileStatusUpdate(newObj.(*core.CloudProfile), oldObj.(*core.CloudProfile))
+}
+
 func dropExpiredVersions(cloudProfile *core.CloudProfile) {
 	var validKubernetesVersions []core.ExpirableVersion
 
@@ -140,4 +140,43 @@ var _ = Describe("PrepareForCreate", func() {
 			}),
 		))
 	})
+
+	It("should drop the status", func() {
+		cloudProfile = &core.CloudProfile{
+			Status: core.CloudProfileStatus{KubernetesVersions: []core.VersionRolloutStatus{{Version: "1.27.3", Completed: true}}},
+		}
+
+		cloudprofileregistry.Strategy.PrepareForCreate(context.TODO(), cloudProfile)
+
+		Expect(cloudProfile.Status).To(Equal(core.CloudProfileStatus{}))
+	})
+})
+
+var _ = Describe("PrepareForUpdate", func() {
+	var oldCloudProfile, newCloudProfile *core.CloudProfile
+
+	BeforeEach(func() {
+		oldCloudProfile = &core.CloudProfile{
+			Spec:   core.CloudProfileSpec{Type: "old"},
+			Status: core.CloudProfileStatus{KubernetesVersions: []core.VersionRolloutStatus{{Version: "1.27.3"}}},
+		}
+		newCloudProfile = &core.CloudProfile{
+			Spec:   core.CloudProfileSpec{Type: "new"},
+			Status: core.CloudProfileStatus{KubernetesVersions: []core.VersionRolloutStatus{{Version: "1.27.3", Completed: true}}},
+		}
+	})
+
+	It("should keep the status when updating the main resource", func() {
+		cloudprofileregistry.Strategy.PrepareForUpdate(context.TODO(), newCloudProfile, oldCloudProfile)
+
+		Expect(newCloudProfile.Spec.Type).To(Equal("new"))
+		Expect(newCloudProfile.Status).To(Equal(oldCloudProfile.Status))
+	})
+
+	It("should keep the spec when updating the status subresource", func() {
+		cloudprofileregistry.StatusStrategy.PrepareForUpdate(context.TODO(), newCloudProfile, oldCloudProfile)
+
+		Expect(newCloudProfile.Spec).To(Equal(oldCloudProfile.Spec))
+		Expect(newCloudProfile.Status.KubernetesVersions[0].Completed).To(BeTrue())
+	})
 })
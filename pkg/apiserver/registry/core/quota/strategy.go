@@ -28,7 +28,9 @@ func (quotaStrategy) NamespaceScoped() bool {
 	return true
 }
 
-func (quotaStrategy) PrepareForCreate(_ context.Context, _ runtime.Object) {
+func (quotaStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
+	quota := obj.(*core.Quota)
+	quota.Status = core.QuotaStatus{}
 }
 
 func (quotaStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
@@ -44,8 +46,9 @@ func (quotaStrategy) AllowCreateOnUpdate() bool {
 }
 
 func (quotaStrategy) PrepareForUpdate(_ context.Context, newObj, oldObj runtime.Object) {
-	_ = oldObj.(*core.Quota)
-	_ = newObj.(*core.Quota)
+	newQuota := newObj.(*core.Quota)
+	oldQuota := oldObj.(*core.Quota)
+	newQuota.Status = oldQuota.Status
 }
 
 func (quotaStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
@@ -66,3 +69,20 @@ func (quotaStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []str
 func (quotaStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
 	return nil
 }
+
+type quotaStatusStrategy struct {
+	quotaStrategy
+}
+
+// StatusStrategy defines the storage strategy for the status subresource of Quotas.
+var StatusStrategy = quotaStatusStrategy{Strategy}
+
+func (quotaStatusStrategy) PrepareForUpdate(_ context.Context, newObj, oldObj runtime.Object) {
+	newQuota := newObj.(*core.Quota)
+	oldQuota := oldObj.(*core.Quota)
+	newQuota.Spec = oldQuota.Spec
+}
+
+func (quotaStatusStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	return validation.ValidateQuotaStatusUpdate(newObj.(*core.Quota), oldObj.(*core.Quota))
+}
@@ -30,6 +30,7 @@ import (
 	secretbindingstore "github.com/gardener/gardener/pkg/apiserver/registry/core/secretbinding/storage"
 	seedstore "github.com/gardener/gardener/pkg/apiserver/registry/core/seed/storage"
 	shootstore "github.com/gardener/gardener/pkg/apiserver/registry/core/shoot/storage"
+	shootblueprintstore "github.com/gardener/gardener/pkg/apiserver/registry/core/shootblueprint/storage"
 	shootstatestore "github.com/gardener/gardener/pkg/apiserver/registry/core/shootstate/storage"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 )
@@ -68,6 +69,7 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 
 	cloudprofileStorage := cloudprofilestore.NewStorage(restOptionsGetter)
 	storage["cloudprofiles"] = cloudprofileStorage.CloudProfile
+	storage["cloudprofiles/status"] = cloudprofileStorage.Status
 
 	namespacedcloudprofileStorage := namespacedcloudprofilestore.NewStorage(restOptionsGetter)
 	storage["namespacedcloudprofiles"] = namespacedcloudprofileStorage.NamespacedCloudProfile
@@ -94,6 +96,7 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 
 	quotaStorage := quotastore.NewStorage(restOptionsGetter)
 	storage["quotas"] = quotaStorage.Quota
+	storage["quotas/status"] = quotaStorage.Status
 
 	secretBindingStorage := secretbindingstore.NewStorage(restOptionsGetter)
 	storage["secretbindings"] = secretBindingStorage.SecretBinding
@@ -102,6 +105,10 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 	storage["seeds"] = seedStorage.Seed
 	storage["seeds/status"] = seedStorage.Status
 
+	shootBlueprintStorage := shootblueprintstore.NewStorage(restOptionsGetter)
+	storage["shootblueprints"] = shootBlueprintStorage.ShootBlueprint
+	storage["shootblueprints/status"] = shootBlueprintStorage.Status
+
 	shootStateStorage := shootstatestore.NewStorage(restOptionsGetter)
 	storage["shootstates"] = shootStateStorage.ShootState
 
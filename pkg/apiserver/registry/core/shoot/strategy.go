@@ -58,6 +58,10 @@ func (shootStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
 	if !utilfeature.DefaultFeatureGate.Enabled(features.UseNamespacedCloudProfile) {
 		shoot.Spec.CloudProfile = nil
 	}
+
+	if !utilfeature.DefaultFeatureGate.Enabled(features.ShootBlueprints) {
+		shoot.Spec.Blueprint = nil
+	}
 }
 
 func (shootStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
@@ -74,6 +78,10 @@ func (shootStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object
 	if oldShoot.Spec.CloudProfile == nil && !utilfeature.DefaultFeatureGate.Enabled(features.UseNamespacedCloudProfile) {
 		newShoot.Spec.CloudProfile = nil
 	}
+
+	if oldShoot.Spec.Blueprint == nil && !utilfeature.DefaultFeatureGate.Enabled(features.ShootBlueprints) {
+		newShoot.Spec.Blueprint = nil
+	}
 }
 
 func mustIncreaseGeneration(oldShoot, newShoot *core.Shoot) bool {
@@ -90,6 +90,36 @@ var _ = Describe("Strategy", func() {
 				Expect(shoot.Spec.CloudProfile).To(Equal(cloudProfileReference))
 			})
 		})
+
+		Context("blueprint field removal", func() {
+			var (
+				shoot              *core.Shoot
+				blueprintReference *core.ShootBlueprintReference
+			)
+
+			BeforeEach(func() {
+				shoot = &core.Shoot{}
+				blueprintReference = &core.ShootBlueprintReference{Name: "blueprint"}
+			})
+
+			It("should remove blueprint field if ShootBlueprints feature gate is disabled", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.ShootBlueprints, false))
+
+				shoot.Spec.Blueprint = blueprintReference
+				strategy.PrepareForCreate(context.TODO(), shoot)
+
+				Expect(shoot.Spec.Blueprint).To(BeNil())
+			})
+
+			It("should not remove blueprint field if ShootBlueprints feature gate is enabled", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.ShootBlueprints, true))
+
+				shoot.Spec.Blueprint = blueprintReference
+				strategy.PrepareForCreate(context.TODO(), shoot)
+
+				Expect(shoot.Spec.Blueprint).To(Equal(blueprintReference))
+			})
+		})
 	})
 
 	Describe("#PrepareForUpdate", func() {
@@ -138,6 +168,39 @@ var _ = Describe("Strategy", func() {
 			})
 		})
 
+		Context("blueprint field removal", func() {
+			var (
+				oldShoot           *core.Shoot
+				newShoot           *core.Shoot
+				blueprintReference *core.ShootBlueprintReference
+			)
+
+			BeforeEach(func() {
+				oldShoot = &core.Shoot{}
+				newShoot = oldShoot.DeepCopy()
+				blueprintReference = &core.ShootBlueprintReference{Name: "blueprint"}
+			})
+
+			It("should remove blueprint field if ShootBlueprints feature gate is disabled", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.ShootBlueprints, false))
+
+				newShoot.Spec.Blueprint = blueprintReference
+				strategy.PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+
+				Expect(newShoot.Spec.Blueprint).To(BeNil())
+			})
+
+			It("should not remove blueprint field if ShootBlueprints feature gate is disabled but the blueprint field is present in the old Shoot", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.ShootBlueprints, false))
+
+				oldShoot.Spec.Blueprint = blueprintReference
+				newShoot.Spec.Blueprint = blueprintReference
+				strategy.PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+
+				Expect(newShoot.Spec.Blueprint).To(Equal(blueprintReference))
+			})
+		})
+
 		Context("seedName change", func() {
 			var (
 				oldShoot *core.Shoot
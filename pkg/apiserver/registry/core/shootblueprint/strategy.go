@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootblueprint
+
+import (
+	"context"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/core/validation"
+)
+
+type shootBlueprintStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy defines the storage strategy for ShootBlueprints.
+var Strategy = shootBlueprintStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (shootBlueprintStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (shootBlueprintStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
+	shootBlueprint := obj.(*core.ShootBlueprint)
+
+	shootBlueprint.Generation = 1
+	shootBlueprint.Status = core.ShootBlueprintStatus{}
+}
+
+func (shootBlueprintStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newShootBlueprint := obj.(*core.ShootBlueprint)
+	oldShootBlueprint := old.(*core.ShootBlueprint)
+	newShootBlueprint.Status = oldShootBlueprint.Status
+
+	if mustIncreaseGeneration(oldShootBlueprint, newShootBlueprint) {
+		newShootBlueprint.Generation = oldShootBlueprint.Generation + 1
+	}
+}
+
+func mustIncreaseGeneration(oldShootBlueprint, newShootBlueprint *core.ShootBlueprint) bool {
+	// The ShootBlueprint specification changes.
+	if !apiequality.Semantic.DeepEqual(oldShootBlueprint.Spec, newShootBlueprint.Spec) {
+		return true
+	}
+
+	// The deletion timestamp was set.
+	if oldShootBlueprint.DeletionTimestamp == nil && newShootBlueprint.DeletionTimestamp != nil {
+		return true
+	}
+
+	return false
+}
+
+func (shootBlueprintStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	shootBlueprint := obj.(*core.ShootBlueprint)
+	return validation.ValidateShootBlueprint(shootBlueprint)
+}
+
+func (shootBlueprintStrategy) Canonicalize(_ runtime.Object) {
+}
+
+func (shootBlueprintStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (shootBlueprintStrategy) AllowUnconditionalUpdate() bool {
+	return true
+}
+
+func (shootBlueprintStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldShootBlueprint, newShootBlueprint := oldObj.(*core.ShootBlueprint), newObj.(*core.ShootBlueprint)
+	return validation.ValidateShootBlueprintUpdate(newShootBlueprint, oldShootBlueprint)
+}
+
+// WarningsOnCreate returns warnings to the client performing the create.
+func (shootBlueprintStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
+	return nil
+}
+
+// WarningsOnUpdate returns warnings to the client performing the update.
+func (shootBlueprintStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
+
+type shootBlueprintStatusStrategy struct {
+	shootBlueprintStrategy
+}
+
+// StatusStrategy defines the storage strategy for the status subresource of ShootBlueprints.
+var StatusStrategy = shootBlueprintStatusStrategy{Strategy}
+
+func (shootBlueprintStatusStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newShootBlueprint := obj.(*core.ShootBlueprint)
+	oldShootBlueprint := old.(*core.ShootBlueprint)
+	newShootBlueprint.Spec = oldShootBlueprint.Spec
+}
+
+func (shootBlueprintStatusStrategy) ValidateUpdate(_ context.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateShootBlueprintStatusUpdate(obj.(*core.ShootBlueprint), old.(*core.ShootBlueprint))
+}
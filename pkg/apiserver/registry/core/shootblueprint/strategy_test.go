@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootblueprint_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	shootblueprintregistry "github.com/gardener/gardener/pkg/apiserver/registry/core/shootblueprint"
+)
+
+var _ = Describe("Strategy", func() {
+	var (
+		ctx = context.TODO()
+
+		shootBlueprint *core.ShootBlueprint
+	)
+
+	BeforeEach(func() {
+		shootBlueprint = &core.ShootBlueprint{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "blueprint",
+				Namespace: "garden-dev",
+			},
+			Spec: core.ShootBlueprintSpec{
+				Template: core.ShootTemplate{
+					Spec: core.ShootSpec{
+						Region: "region",
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#PrepareForCreate", func() {
+		It("should set the generation and reset the status", func() {
+			shootBlueprint.Status.OutdatedShoots = []string{"foo"}
+
+			shootblueprintregistry.Strategy.PrepareForCreate(ctx, shootBlueprint)
+
+			Expect(shootBlueprint.Generation).To(Equal(int64(1)))
+			Expect(shootBlueprint.Status).To(Equal(core.ShootBlueprintStatus{}))
+		})
+	})
+
+	Describe("#PrepareForUpdate", func() {
+		var oldShootBlueprint *core.ShootBlueprint
+
+		BeforeEach(func() {
+			shootBlueprint.Generation = 1
+			shootBlueprint.Status = core.ShootBlueprintStatus{ObservedGeneration: 1, OutdatedShoots: []string{"foo"}}
+			oldShootBlueprint = shootBlueprint.DeepCopy()
+		})
+
+		It("should keep the status and generation if the spec does not change", func() {
+			shootBlueprint.Status = core.ShootBlueprintStatus{}
+			shootBlueprint.Labels = map[string]string{"foo": "bar"}
+
+			shootblueprintregistry.Strategy.PrepareForUpdate(ctx, shootBlueprint, oldShootBlueprint)
+
+			Expect(shootBlueprint.Generation).To(Equal(int64(1)))
+			Expect(shootBlueprint.Status).To(Equal(oldShootBlueprint.Status))
+		})
+
+		It("should increase the generation if the spec changes", func() {
+			shootBlueprint.Spec.Template.Spec.Region = "other-region"
+
+			shootblueprintregistry.Strategy.PrepareForUpdate(ctx, shootBlueprint, oldShootBlueprint)
+
+			Expect(shootBlueprint.Generation).To(Equal(int64(2)))
+		})
+
+		It("should increase the generation if the deletion timestamp is set", func() {
+			shootBlueprint.DeletionTimestamp = &metav1.Time{}
+
+			shootblueprintregistry.Strategy.PrepareForUpdate(ctx, shootBlueprint, oldShootBlueprint)
+
+			Expect(shootBlueprint.Generation).To(Equal(int64(2)))
+		})
+	})
+
+	Describe("#StatusStrategy.PrepareForUpdate", func() {
+		It("should not allow changing the spec", func() {
+			oldShootBlueprint := shootBlueprint.DeepCopy()
+			shootBlueprint.Spec.Template.Spec.Region = "other-region"
+			shootBlueprint.Status.ObservedGeneration = 1
+
+			shootblueprintregistry.StatusStrategy.PrepareForUpdate(ctx, shootBlueprint, oldShootBlueprint)
+
+			Expect(shootBlueprint.Spec).To(Equal(oldShootBlueprint.Spec))
+			Expect(shootBlueprint.Status.ObservedGeneration).To(Equal(int64(1)))
+		})
+	})
+})
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/gardener/gardener/pkg/apis/operations"
+	"github.com/gardener/gardener/pkg/apiserver/registry/operations/bastionpolicy"
+)
+
+// REST implements a RESTStorage for BastionPolicies against etcd.
+type REST struct {
+	*genericregistry.Store
+}
+
+// BastionPolicyStorage implements the storage for BastionPolicies.
+type BastionPolicyStorage struct {
+	BastionPolicy *REST
+}
+
+// NewStorage creates a new BastionPolicyStorage object.
+func NewStorage(optsGetter generic.RESTOptionsGetter) BastionPolicyStorage {
+	return BastionPolicyStorage{
+		BastionPolicy: NewREST(optsGetter),
+	}
+}
+
+// NewREST returns a RESTStorage object that will work against BastionPolicies.
+func NewREST(optsGetter generic.RESTOptionsGetter) *REST {
+	store := &genericregistry.Store{
+		NewFunc:                   func() runtime.Object { return &operations.BastionPolicy{} },
+		NewListFunc:               func() runtime.Object { return &operations.BastionPolicyList{} },
+		DefaultQualifiedResource:  operations.Resource("bastionpolicies"),
+		SingularQualifiedResource: operations.Resource("bastionpolicy"),
+		EnableGarbageCollection:   true,
+
+		CreateStrategy: bastionpolicy.Strategy,
+		UpdateStrategy: bastionpolicy.Strategy,
+		DeleteStrategy: bastionpolicy.Strategy,
+
+		TableConvertor: newTableConvertor(),
+	}
+	options := &generic.StoreOptions{RESTOptions: optsGetter}
+	if err := store.CompleteWithOptions(options); err != nil {
+		panic(err)
+	}
+
+	return &REST{store}
+}
+
+// Implement CategoriesProvider
+var _ rest.CategoriesProvider = &REST{}
+
+// Categories implements the CategoriesProvider interface. Returns a list of categories a resource is part of.
+func (r *REST) Categories() []string {
+	return []string{"all"}
+}
+
+// Implement ShortNamesProvider
+var _ rest.ShortNamesProvider = &REST{}
+
+// ShortNames implements the ShortNamesProvider interface. Returns a list of short names for a resource.
+func (r *REST) ShortNames() []string {
+	return []string{}
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastionpolicy
+
+import (
+	"context"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/operations"
+	operationsvalidation "github.com/gardener/gardener/pkg/apis/operations/validation"
+)
+
+type bastionPolicyStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy defines the storage strategy for BastionPolicies.
+var Strategy = bastionPolicyStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (bastionPolicyStrategy) NamespaceScoped() bool {
+	return false
+}
+
+func (bastionPolicyStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
+	bastionPolicy := obj.(*operations.BastionPolicy)
+	bastionPolicy.Generation = 1
+}
+
+func (bastionPolicyStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newBastionPolicy := obj.(*operations.BastionPolicy)
+	oldBastionPolicy := old.(*operations.BastionPolicy)
+
+	if !apiequality.Semantic.DeepEqual(oldBastionPolicy.Spec, newBastionPolicy.Spec) {
+		newBastionPolicy.Generation = oldBastionPolicy.Generation + 1
+	}
+}
+
+func (bastionPolicyStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	bastionPolicy := obj.(*operations.BastionPolicy)
+	return operationsvalidation.ValidateBastionPolicy(bastionPolicy)
+}
+
+func (bastionPolicyStrategy) Canonicalize(_ runtime.Object) {
+}
+
+func (bastionPolicyStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (bastionPolicyStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldBastionPolicy, newBastionPolicy := oldObj.(*operations.BastionPolicy), newObj.(*operations.BastionPolicy)
+	return operationsvalidation.ValidateBastionPolicyUpdate(newBastionPolicy, oldBastionPolicy)
+}
+
+func (bastionPolicyStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings to the client performing a create.
+func (bastionPolicyStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
+	return nil
+}
+
+// WarningsOnUpdate returns warnings to the client performing the update.
+func (bastionPolicyStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
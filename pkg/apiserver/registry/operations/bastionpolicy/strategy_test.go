@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastionpolicy_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener/pkg/apis/operations"
+	. "github.com/gardener/gardener/pkg/apiserver/registry/operations/bastionpolicy"
+)
+
+var _ = Describe("Strategy", func() {
+	var (
+		ctx = context.TODO()
+
+		bastionPolicy *operations.BastionPolicy
+	)
+
+	BeforeEach(func() {
+		bastionPolicy = &operations.BastionPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "restricted"},
+			Spec: operations.BastionPolicySpec{
+				MaxLifetime: &metav1.Duration{Duration: time.Hour},
+			},
+		}
+	})
+
+	Describe("#PrepareForCreate", func() {
+		It("should set the generation", func() {
+			Strategy.PrepareForCreate(ctx, bastionPolicy)
+
+			Expect(bastionPolicy.Generation).To(Equal(int64(1)))
+		})
+	})
+
+	Describe("#PrepareForUpdate", func() {
+		var oldBastionPolicy *operations.BastionPolicy
+
+		BeforeEach(func() {
+			bastionPolicy.Generation = 1
+			oldBastionPolicy = bastionPolicy.DeepCopy()
+		})
+
+		It("should not increase the generation if the spec did not change", func() {
+			bastionPolicy.Labels = map[string]string{"foo": "bar"}
+
+			Strategy.PrepareForUpdate(ctx, bastionPolicy, oldBastionPolicy)
+
+			Expect(bastionPolicy.Generation).To(Equal(int64(1)))
+		})
+
+		It("should increase the generation if the spec changed", func() {
+			bastionPolicy.Spec.MaxLifetime = &metav1.Duration{Duration: 2 * time.Hour}
+
+			Strategy.PrepareForUpdate(ctx, bastionPolicy, oldBastionPolicy)
+
+			Expect(bastionPolicy.Generation).To(Equal(int64(2)))
+		})
+	})
+})
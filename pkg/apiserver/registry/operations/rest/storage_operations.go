@@ -14,6 +14,7 @@ import (
 	"github.com/gardener/gardener/pkg/apis/operations"
 	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
 	bastionstore "github.com/gardener/gardener/pkg/apiserver/registry/operations/bastion/storage"
+	bastionpolicystore "github.com/gardener/gardener/pkg/apiserver/registry/operations/bastionpolicy/storage"
 )
 
 // StorageProvider is an empty struct.
@@ -38,5 +39,8 @@ func (p StorageProvider) v1alpha1Storage(restOptionsGetter generic.RESTOptionsGe
 	storage["bastions"] = bastionStorage.Bastion
 	storage["bastions/status"] = bastionStorage.Status
 
+	bastionPolicyStorage := bastionpolicystore.NewStorage(restOptionsGetter)
+	storage["bastionpolicies"] = bastionPolicyStorage.BastionPolicy
+
 	return storage
 }
@@ -28,6 +28,7 @@ type CloudProfilesGetter interface {
 type CloudProfileInterface interface {
 	Create(ctx context.Context, cloudProfile *v1beta1.CloudProfile, opts v1.CreateOptions) (*v1beta1.CloudProfile, error)
 	Update(ctx context.Context, cloudProfile *v1beta1.CloudProfile, opts v1.UpdateOptions) (*v1beta1.CloudProfile, error)
+	UpdateStatus(ctx context.Context, cloudProfile *v1beta1.CloudProfile, opts v1.UpdateOptions) (*v1beta1.CloudProfile, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
 	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.CloudProfile, error)
@@ -116,6 +117,21 @@ func (c *cloudProfiles) Update(ctx context.Context, cloudProfile *v1beta1.CloudP
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *cloudProfiles) UpdateStatus(ctx context.Context, cloudProfile *v1beta1.CloudProfile, opts v1.UpdateOptions) (result *v1beta1.CloudProfile, err error) {
+	result = &v1beta1.CloudProfile{}
+	err = c.client.Put().
+		Resource("cloudprofiles").
+		Name(cloudProfile.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(cloudProfile).
+		Do(ctx).
+		Into(result)
+	return
+}
+
 // Delete takes name of the cloudProfile and deletes it. Returns an error if one occurs.
 func (c *cloudProfiles) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return c.client.Delete().
@@ -30,6 +30,7 @@ type CoreV1beta1Interface interface {
 	SecretBindingsGetter
 	SeedsGetter
 	ShootsGetter
+	ShootBlueprintsGetter
 	ShootStatesGetter
 }
 
@@ -94,6 +95,10 @@ func (c *CoreV1beta1Client) Shoots(namespace string) ShootInterface {
 	return newShoots(c, namespace)
 }
 
+func (c *CoreV1beta1Client) ShootBlueprints(namespace string) ShootBlueprintInterface {
+	return newShootBlueprints(c, namespace)
+}
+
 func (c *CoreV1beta1Client) ShootStates(namespace string) ShootStateInterface {
 	return newShootStates(c, namespace)
 }
@@ -83,6 +83,17 @@ func (c *FakeCloudProfiles) Update(ctx context.Context, cloudProfile *v1beta1.Cl
 	return obj.(*v1beta1.CloudProfile), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeCloudProfiles) UpdateStatus(ctx context.Context, cloudProfile *v1beta1.CloudProfile, opts v1.UpdateOptions) (*v1beta1.CloudProfile, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(cloudprofilesResource, "status", cloudProfile), &v1beta1.CloudProfile{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.CloudProfile), err
+}
+
 // Delete takes name of the cloudProfile and deletes it. Returns an error if one occurs.
 func (c *FakeCloudProfiles) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
@@ -72,6 +72,10 @@ func (c *FakeCoreV1beta1) Shoots(namespace string) v1beta1.ShootInterface {
 	return &FakeShoots{c, namespace}
 }
 
+func (c *FakeCoreV1beta1) ShootBlueprints(namespace string) v1beta1.ShootBlueprintInterface {
+	return &FakeShootBlueprints{c, namespace}
+}
+
 func (c *FakeCoreV1beta1) ShootStates(namespace string) v1beta1.ShootStateInterface {
 	return &FakeShootStates{c, namespace}
 }
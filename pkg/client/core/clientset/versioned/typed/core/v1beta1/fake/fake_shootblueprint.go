@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeShootBlueprints implements ShootBlueprintInterface
+type FakeShootBlueprints struct {
+	Fake *FakeCoreV1beta1
+	ns   string
+}
+
+var shootblueprintsResource = v1beta1.SchemeGroupVersion.WithResource("shootblueprints")
+
+var shootblueprintsKind = v1beta1.SchemeGroupVersion.WithKind("ShootBlueprint")
+
+// Get takes name of the shootBlueprint, and returns the corresponding shootBlueprint object, and an error if there is any.
+func (c *FakeShootBlueprints) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.ShootBlueprint, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(shootblueprintsResource, c.ns, name), &v1beta1.ShootBlueprint{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ShootBlueprint), err
+}
+
+// List takes label and field selectors, and returns the list of ShootBlueprints that match those selectors.
+func (c *FakeShootBlueprints) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.ShootBlueprintList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(shootblueprintsResource, shootblueprintsKind, c.ns, opts), &v1beta1.ShootBlueprintList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.ShootBlueprintList{ListMeta: obj.(*v1beta1.ShootBlueprintList).ListMeta}
+	for _, item := range obj.(*v1beta1.ShootBlueprintList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested shootBlueprints.
+func (c *FakeShootBlueprints) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(shootblueprintsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a shootBlueprint and creates it.  Returns the server's representation of the shootBlueprint, and an error, if there is any.
+func (c *FakeShootBlueprints) Create(ctx context.Context, shootBlueprint *v1beta1.ShootBlueprint, opts v1.CreateOptions) (result *v1beta1.ShootBlueprint, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(shootblueprintsResource, c.ns, shootBlueprint), &v1beta1.ShootBlueprint{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ShootBlueprint), err
+}
+
+// Update takes the representation of a shootBlueprint and updates it. Returns the server's representation of the shootBlueprint, and an error, if there is any.
+func (c *FakeShootBlueprints) Update(ctx context.Context, shootBlueprint *v1beta1.ShootBlueprint, opts v1.UpdateOptions) (result *v1beta1.ShootBlueprint, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(shootblueprintsResource, c.ns, shootBlueprint), &v1beta1.ShootBlueprint{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ShootBlueprint), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeShootBlueprints) UpdateStatus(ctx context.Context, shootBlueprint *v1beta1.ShootBlueprint, opts v1.UpdateOptions) (*v1beta1.ShootBlueprint, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(shootblueprintsResource, "status", c.ns, shootBlueprint), &v1beta1.ShootBlueprint{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ShootBlueprint), err
+}
+
+// Delete takes name of the shootBlueprint and deletes it. Returns an error if one occurs.
+func (c *FakeShootBlueprints) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(shootblueprintsResource, c.ns, name, opts), &v1beta1.ShootBlueprint{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeShootBlueprints) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(shootblueprintsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.ShootBlueprintList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched shootBlueprint.
+func (c *FakeShootBlueprints) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.ShootBlueprint, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(shootblueprintsResource, c.ns, name, pt, data, subresources...), &v1beta1.ShootBlueprint{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ShootBlueprint), err
+}
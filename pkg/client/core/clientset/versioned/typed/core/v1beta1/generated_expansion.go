@@ -34,4 +34,6 @@ type SeedExpansion interface{}
 
 type ShootExpansion interface{}
 
+type ShootBlueprintExpansion interface{}
+
 type ShootStateExpansion interface{}
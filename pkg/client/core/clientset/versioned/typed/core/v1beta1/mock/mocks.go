@@ -241,6 +241,20 @@ func (mr *MockCoreV1beta1InterfaceMockRecorder) Seeds() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seeds", reflect.TypeOf((*MockCoreV1beta1Interface)(nil).Seeds))
 }
 
+// ShootBlueprints mocks base method.
+func (m *MockCoreV1beta1Interface) ShootBlueprints(arg0 string) v1beta10.ShootBlueprintInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShootBlueprints", arg0)
+	ret0, _ := ret[0].(v1beta10.ShootBlueprintInterface)
+	return ret0
+}
+
+// ShootBlueprints indicates an expected call of ShootBlueprints.
+func (mr *MockCoreV1beta1InterfaceMockRecorder) ShootBlueprints(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShootBlueprints", reflect.TypeOf((*MockCoreV1beta1Interface)(nil).ShootBlueprints), arg0)
+}
+
 // ShootStates mocks base method.
 func (m *MockCoreV1beta1Interface) ShootStates(arg0 string) v1beta10.ShootStateInterface {
 	m.ctrl.T.Helper()
@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	scheme "github.com/gardener/gardener/pkg/client/core/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ShootBlueprintsGetter has a method to return a ShootBlueprintInterface.
+// A group's client should implement this interface.
+type ShootBlueprintsGetter interface {
+	ShootBlueprints(namespace string) ShootBlueprintInterface
+}
+
+// ShootBlueprintInterface has methods to work with ShootBlueprint resources.
+type ShootBlueprintInterface interface {
+	Create(ctx context.Context, shootBlueprint *v1beta1.ShootBlueprint, opts v1.CreateOptions) (*v1beta1.ShootBlueprint, error)
+	Update(ctx context.Context, shootBlueprint *v1beta1.ShootBlueprint, opts v1.UpdateOptions) (*v1beta1.ShootBlueprint, error)
+	UpdateStatus(ctx context.Context, shootBlueprint *v1beta1.ShootBlueprint, opts v1.UpdateOptions) (*v1beta1.ShootBlueprint, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.ShootBlueprint, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.ShootBlueprintList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.ShootBlueprint, err error)
+	ShootBlueprintExpansion
+}
+
+// shootBlueprints implements ShootBlueprintInterface
+type shootBlueprints struct {
+	client rest.Interface
+	ns     string
+}
+
+// newShootBlueprints returns a ShootBlueprints
+func newShootBlueprints(c *CoreV1beta1Client, namespace string) *shootBlueprints {
+	return &shootBlueprints{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the shootBlueprint, and returns the corresponding shootBlueprint object, and an error if there is any.
+func (c *shootBlueprints) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.ShootBlueprint, err error) {
+	result = &v1beta1.ShootBlueprint{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("shootblueprints").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ShootBlueprints that match those selectors.
+func (c *shootBlueprints) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.ShootBlueprintList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.ShootBlueprintList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("shootblueprints").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested shootBlueprints.
+func (c *shootBlueprints) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("shootblueprints").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a shootBlueprint and creates it.  Returns the server's representation of the shootBlueprint, and an error, if there is any.
+func (c *shootBlueprints) Create(ctx context.Context, shootBlueprint *v1beta1.ShootBlueprint, opts v1.CreateOptions) (result *v1beta1.ShootBlueprint, err error) {
+	result = &v1beta1.ShootBlueprint{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("shootblueprints").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(shootBlueprint).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a shootBlueprint and updates it. Returns the server's representation of the shootBlueprint, and an error, if there is any.
+func (c *shootBlueprints) Update(ctx context.Context, shootBlueprint *v1beta1.ShootBlueprint, opts v1.UpdateOptions) (result *v1beta1.ShootBlueprint, err error) {
+	result = &v1beta1.ShootBlueprint{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("shootblueprints").
+		Name(shootBlueprint.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(shootBlueprint).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *shootBlueprints) UpdateStatus(ctx context.Context, shootBlueprint *v1beta1.ShootBlueprint, opts v1.UpdateOptions) (result *v1beta1.ShootBlueprint, err error) {
+	result = &v1beta1.ShootBlueprint{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("shootblueprints").
+		Name(shootBlueprint.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(shootBlueprint).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the shootBlueprint and deletes it. Returns an error if one occurs.
+func (c *shootBlueprints) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("shootblueprints").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *shootBlueprints) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("shootblueprints").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched shootBlueprint.
+func (c *shootBlueprints) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.ShootBlueprint, err error) {
+	result = &v1beta1.ShootBlueprint{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("shootblueprints").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -40,6 +40,8 @@ type Interface interface {
 	Seeds() SeedInformer
 	// Shoots returns a ShootInformer.
 	Shoots() ShootInformer
+	// ShootBlueprints returns a ShootBlueprintInformer.
+	ShootBlueprints() ShootBlueprintInformer
 	// ShootStates returns a ShootStateInformer.
 	ShootStates() ShootStateInformer
 }
@@ -125,6 +127,11 @@ func (v *version) Shoots() ShootInformer {
 	return &shootInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ShootBlueprints returns a ShootBlueprintInformer.
+func (v *version) ShootBlueprints() ShootBlueprintInformer {
+	return &shootBlueprintInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ShootStates returns a ShootStateInformer.
 func (v *version) ShootStates() ShootStateInformer {
 	return &shootStateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	time "time"
+
+	corev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	versioned "github.com/gardener/gardener/pkg/client/core/clientset/versioned"
+	internalinterfaces "github.com/gardener/gardener/pkg/client/core/informers/externalversions/internalinterfaces"
+	v1beta1 "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ShootBlueprintInformer provides access to a shared informer and lister for
+// ShootBlueprints.
+type ShootBlueprintInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1beta1.ShootBlueprintLister
+}
+
+type shootBlueprintInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewShootBlueprintInformer constructs a new informer for ShootBlueprint type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewShootBlueprintInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredShootBlueprintInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredShootBlueprintInformer constructs a new informer for ShootBlueprint type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredShootBlueprintInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.CoreV1beta1().ShootBlueprints(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.CoreV1beta1().ShootBlueprints(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&corev1beta1.ShootBlueprint{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *shootBlueprintInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredShootBlueprintInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *shootBlueprintInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&corev1beta1.ShootBlueprint{}, f.defaultInformer)
+}
+
+func (f *shootBlueprintInformer) Lister() v1beta1.ShootBlueprintLister {
+	return v1beta1.NewShootBlueprintLister(f.Informer().GetIndexer())
+}
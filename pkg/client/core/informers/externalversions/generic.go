@@ -69,6 +69,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().Seeds().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("shoots"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().Shoots().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("shootblueprints"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().ShootBlueprints().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("shootstates"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().ShootStates().Informer()}, nil
 
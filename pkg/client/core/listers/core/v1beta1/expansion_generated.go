@@ -86,6 +86,14 @@ type ShootListerExpansion interface{}
 // ShootNamespaceLister.
 type ShootNamespaceListerExpansion interface{}
 
+// ShootBlueprintListerExpansion allows custom methods to be added to
+// ShootBlueprintLister.
+type ShootBlueprintListerExpansion interface{}
+
+// ShootBlueprintNamespaceListerExpansion allows custom methods to be added to
+// ShootBlueprintNamespaceLister.
+type ShootBlueprintNamespaceListerExpansion interface{}
+
 // ShootStateListerExpansion allows custom methods to be added to
 // ShootStateLister.
 type ShootStateListerExpansion interface{}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ShootBlueprintLister helps list ShootBlueprints.
+// All objects returned here must be treated as read-only.
+type ShootBlueprintLister interface {
+	// List lists all ShootBlueprints in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.ShootBlueprint, err error)
+	// ShootBlueprints returns an object that can list and get ShootBlueprints.
+	ShootBlueprints(namespace string) ShootBlueprintNamespaceLister
+	ShootBlueprintListerExpansion
+}
+
+// shootBlueprintLister implements the ShootBlueprintLister interface.
+type shootBlueprintLister struct {
+	indexer cache.Indexer
+}
+
+// NewShootBlueprintLister returns a new ShootBlueprintLister.
+func NewShootBlueprintLister(indexer cache.Indexer) ShootBlueprintLister {
+	return &shootBlueprintLister{indexer: indexer}
+}
+
+// List lists all ShootBlueprints in the indexer.
+func (s *shootBlueprintLister) List(selector labels.Selector) (ret []*v1beta1.ShootBlueprint, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.ShootBlueprint))
+	})
+	return ret, err
+}
+
+// ShootBlueprints returns an object that can list and get ShootBlueprints.
+func (s *shootBlueprintLister) ShootBlueprints(namespace string) ShootBlueprintNamespaceLister {
+	return shootBlueprintNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// ShootBlueprintNamespaceLister helps list and get ShootBlueprints.
+// All objects returned here must be treated as read-only.
+type ShootBlueprintNamespaceLister interface {
+	// List lists all ShootBlueprints in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.ShootBlueprint, err error)
+	// Get retrieves the ShootBlueprint from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.ShootBlueprint, error)
+	ShootBlueprintNamespaceListerExpansion
+}
+
+// shootBlueprintNamespaceLister implements the ShootBlueprintNamespaceLister
+// interface.
+type shootBlueprintNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all ShootBlueprints in the indexer for a given namespace.
+func (s shootBlueprintNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.ShootBlueprint, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.ShootBlueprint))
+	})
+	return ret, err
+}
+
+// Get retrieves the ShootBlueprint from the indexer for a given namespace and name.
+func (s shootBlueprintNamespaceLister) Get(name string) (*v1beta1.ShootBlueprint, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("shootblueprint"), name)
+	}
+	return obj.(*v1beta1.ShootBlueprint), nil
+}
@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	scheme "github.com/gardener/gardener/pkg/client/operations/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// BastionsGetter has a method to return a BastionInterface.
+// A group's client should implement this interface.
+type BastionsGetter interface {
+	Bastions(namespace string) BastionInterface
+}
+
+// BastionInterface has methods to work with Bastion resources.
+type BastionInterface interface {
+	Create(ctx context.Context, bastion *v1alpha1.Bastion, opts v1.CreateOptions) (*v1alpha1.Bastion, error)
+	Update(ctx context.Context, bastion *v1alpha1.Bastion, opts v1.UpdateOptions) (*v1alpha1.Bastion, error)
+	UpdateStatus(ctx context.Context, bastion *v1alpha1.Bastion, opts v1.UpdateOptions) (*v1alpha1.Bastion, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.Bastion, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.BastionList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.Bastion, err error)
+	BastionExpansion
+}
+
+// bastions implements BastionInterface
+type bastions struct {
+	client rest.Interface
+	ns     string
+}
+
+// newBastions returns a Bastions
+func newBastions(c *OperationsV1alpha1Client, namespace string) *bastions {
+	return &bastions{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the bastion, and returns the corresponding bastion object, and an error if there is any.
+func (c *bastions) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.Bastion, err error) {
+	result = &v1alpha1.Bastion{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("bastions").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Bastions that match those selectors.
+func (c *bastions) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.BastionList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.BastionList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("bastions").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested bastions.
+func (c *bastions) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("bastions").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a bastion and creates it.  Returns the server's representation of the bastion, and an error, if there is any.
+func (c *bastions) Create(ctx context.Context, bastion *v1alpha1.Bastion, opts v1.CreateOptions) (result *v1alpha1.Bastion, err error) {
+	result = &v1alpha1.Bastion{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("bastions").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(bastion).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a bastion and updates it. Returns the server's representation of the bastion, and an error, if there is any.
+func (c *bastions) Update(ctx context.Context, bastion *v1alpha1.Bastion, opts v1.UpdateOptions) (result *v1alpha1.Bastion, err error) {
+	result = &v1alpha1.Bastion{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("bastions").
+		Name(bastion.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(bastion).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *bastions) UpdateStatus(ctx context.Context, bastion *v1alpha1.Bastion, opts v1.UpdateOptions) (result *v1alpha1.Bastion, err error) {
+	result = &v1alpha1.Bastion{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("bastions").
+		Name(bastion.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(bastion).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the bastion and deletes it. Returns an error if one occurs.
+func (c *bastions) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("bastions").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *bastions) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("bastions").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched bastion.
+func (c *bastions) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.Bastion, err error) {
+	result = &v1alpha1.Bastion{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("bastions").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	scheme "github.com/gardener/gardener/pkg/client/operations/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// BastionPoliciesGetter has a method to return a BastionPolicyInterface.
+// A group's client should implement this interface.
+type BastionPoliciesGetter interface {
+	BastionPolicies() BastionPolicyInterface
+}
+
+// BastionPolicyInterface has methods to work with BastionPolicy resources.
+type BastionPolicyInterface interface {
+	Create(ctx context.Context, bastionPolicy *v1alpha1.BastionPolicy, opts v1.CreateOptions) (*v1alpha1.BastionPolicy, error)
+	Update(ctx context.Context, bastionPolicy *v1alpha1.BastionPolicy, opts v1.UpdateOptions) (*v1alpha1.BastionPolicy, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.BastionPolicy, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.BastionPolicyList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.BastionPolicy, err error)
+	BastionPolicyExpansion
+}
+
+// bastionPolicies implements BastionPolicyInterface
+type bastionPolicies struct {
+	client rest.Interface
+}
+
+// newBastionPolicies returns a BastionPolicies
+func newBastionPolicies(c *OperationsV1alpha1Client) *bastionPolicies {
+	return &bastionPolicies{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the bastionPolicy, and returns the corresponding bastionPolicy object, and an error if there is any.
+func (c *bastionPolicies) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.BastionPolicy, err error) {
+	result = &v1alpha1.BastionPolicy{}
+	err = c.client.Get().
+		Resource("bastionpolicies").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of BastionPolicies that match those selectors.
+func (c *bastionPolicies) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.BastionPolicyList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.BastionPolicyList{}
+	err = c.client.Get().
+		Resource("bastionpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested bastionPolicies.
+func (c *bastionPolicies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("bastionpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a bastionPolicy and creates it.  Returns the server's representation of the bastionPolicy, and an error, if there is any.
+func (c *bastionPolicies) Create(ctx context.Context, bastionPolicy *v1alpha1.BastionPolicy, opts v1.CreateOptions) (result *v1alpha1.BastionPolicy, err error) {
+	result = &v1alpha1.BastionPolicy{}
+	err = c.client.Post().
+		Resource("bastionpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(bastionPolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a bastionPolicy and updates it. Returns the server's representation of the bastionPolicy, and an error, if there is any.
+func (c *bastionPolicies) Update(ctx context.Context, bastionPolicy *v1alpha1.BastionPolicy, opts v1.UpdateOptions) (result *v1alpha1.BastionPolicy, err error) {
+	result = &v1alpha1.BastionPolicy{}
+	err = c.client.Put().
+		Resource("bastionpolicies").
+		Name(bastionPolicy.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(bastionPolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the bastionPolicy and deletes it. Returns an error if one occurs.
+func (c *bastionPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("bastionpolicies").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *bastionPolicies) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("bastionpolicies").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched bastionPolicy.
+func (c *bastionPolicies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.BastionPolicy, err error) {
+	result = &v1alpha1.BastionPolicy{}
+	err = c.client.Patch(pt).
+		Resource("bastionpolicies").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
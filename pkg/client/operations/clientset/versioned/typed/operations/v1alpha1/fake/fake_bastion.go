@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeBastions implements BastionInterface
+type FakeBastions struct {
+	Fake *FakeOperationsV1alpha1
+	ns   string
+}
+
+var bastionsResource = v1alpha1.SchemeGroupVersion.WithResource("bastions")
+
+var bastionsKind = v1alpha1.SchemeGroupVersion.WithKind("Bastion")
+
+// Get takes name of the bastion, and returns the corresponding bastion object, and an error if there is any.
+func (c *FakeBastions) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.Bastion, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(bastionsResource, c.ns, name), &v1alpha1.Bastion{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Bastion), err
+}
+
+// List takes label and field selectors, and returns the list of Bastions that match those selectors.
+func (c *FakeBastions) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.BastionList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(bastionsResource, bastionsKind, c.ns, opts), &v1alpha1.BastionList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.BastionList{ListMeta: obj.(*v1alpha1.BastionList).ListMeta}
+	for _, item := range obj.(*v1alpha1.BastionList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested bastions.
+func (c *FakeBastions) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(bastionsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a bastion and creates it.  Returns the server's representation of the bastion, and an error, if there is any.
+func (c *FakeBastions) Create(ctx context.Context, bastion *v1alpha1.Bastion, opts v1.CreateOptions) (result *v1alpha1.Bastion, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(bastionsResource, c.ns, bastion), &v1alpha1.Bastion{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Bastion), err
+}
+
+// Update takes the representation of a bastion and updates it. Returns the server's representation of the bastion, and an error, if there is any.
+func (c *FakeBastions) Update(ctx context.Context, bastion *v1alpha1.Bastion, opts v1.UpdateOptions) (result *v1alpha1.Bastion, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(bastionsResource, c.ns, bastion), &v1alpha1.Bastion{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Bastion), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeBastions) UpdateStatus(ctx context.Context, bastion *v1alpha1.Bastion, opts v1.UpdateOptions) (*v1alpha1.Bastion, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(bastionsResource, "status", c.ns, bastion), &v1alpha1.Bastion{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Bastion), err
+}
+
+// Delete takes name of the bastion and deletes it. Returns an error if one occurs.
+func (c *FakeBastions) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(bastionsResource, c.ns, name, opts), &v1alpha1.Bastion{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeBastions) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(bastionsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.BastionList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched bastion.
+func (c *FakeBastions) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.Bastion, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(bastionsResource, c.ns, name, pt, data, subresources...), &v1alpha1.Bastion{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Bastion), err
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeBastionPolicies implements BastionPolicyInterface
+type FakeBastionPolicies struct {
+	Fake *FakeOperationsV1alpha1
+}
+
+var bastionpoliciesResource = v1alpha1.SchemeGroupVersion.WithResource("bastionpolicies")
+
+var bastionpoliciesKind = v1alpha1.SchemeGroupVersion.WithKind("BastionPolicy")
+
+// Get takes name of the bastionPolicy, and returns the corresponding bastionPolicy object, and an error if there is any.
+func (c *FakeBastionPolicies) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.BastionPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(bastionpoliciesResource, name), &v1alpha1.BastionPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.BastionPolicy), err
+}
+
+// List takes label and field selectors, and returns the list of BastionPolicies that match those selectors.
+func (c *FakeBastionPolicies) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.BastionPolicyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(bastionpoliciesResource, bastionpoliciesKind, opts), &v1alpha1.BastionPolicyList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.BastionPolicyList{ListMeta: obj.(*v1alpha1.BastionPolicyList).ListMeta}
+	for _, item := range obj.(*v1alpha1.BastionPolicyList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested bastionPolicies.
+func (c *FakeBastionPolicies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(bastionpoliciesResource, opts))
+}
+
+// Create takes the representation of a bastionPolicy and creates it.  Returns the server's representation of the bastionPolicy, and an error, if there is any.
+func (c *FakeBastionPolicies) Create(ctx context.Context, bastionPolicy *v1alpha1.BastionPolicy, opts v1.CreateOptions) (result *v1alpha1.BastionPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(bastionpoliciesResource, bastionPolicy), &v1alpha1.BastionPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.BastionPolicy), err
+}
+
+// Update takes the representation of a bastionPolicy and updates it. Returns the server's representation of the bastionPolicy, and an error, if there is any.
+func (c *FakeBastionPolicies) Update(ctx context.Context, bastionPolicy *v1alpha1.BastionPolicy, opts v1.UpdateOptions) (result *v1alpha1.BastionPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(bastionpoliciesResource, bastionPolicy), &v1alpha1.BastionPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.BastionPolicy), err
+}
+
+// Delete takes name of the bastionPolicy and deletes it. Returns an error if one occurs.
+func (c *FakeBastionPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(bastionpoliciesResource, name, opts), &v1alpha1.BastionPolicy{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeBastionPolicies) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(bastionpoliciesResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.BastionPolicyList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched bastionPolicy.
+func (c *FakeBastionPolicies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.BastionPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(bastionpoliciesResource, name, pt, data, subresources...), &v1alpha1.BastionPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.BastionPolicy), err
+}
@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/gardener/gardener/pkg/client/operations/clientset/versioned/typed/operations/v1alpha1"
+	rest "k8s.io/client-go/rest"
+	testing "k8s.io/client-go/testing"
+)
+
+type FakeOperationsV1alpha1 struct {
+	*testing.Fake
+}
+
+func (c *FakeOperationsV1alpha1) Bastions(namespace string) v1alpha1.BastionInterface {
+	return &FakeBastions{c, namespace}
+}
+
+func (c *FakeOperationsV1alpha1) BastionPolicies() v1alpha1.BastionPolicyInterface {
+	return &FakeBastionPolicies{c}
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *FakeOperationsV1alpha1) RESTClient() rest.Interface {
+	var ret *rest.RESTClient
+	return ret
+}
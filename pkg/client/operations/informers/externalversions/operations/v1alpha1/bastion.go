@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	time "time"
+
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	versioned "github.com/gardener/gardener/pkg/client/operations/clientset/versioned"
+	internalinterfaces "github.com/gardener/gardener/pkg/client/operations/informers/externalversions/internalinterfaces"
+	v1alpha1 "github.com/gardener/gardener/pkg/client/operations/listers/operations/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// BastionInformer provides access to a shared informer and lister for
+// Bastions.
+type BastionInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.BastionLister
+}
+
+type bastionInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewBastionInformer constructs a new informer for Bastion type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewBastionInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredBastionInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredBastionInformer constructs a new informer for Bastion type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredBastionInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.OperationsV1alpha1().Bastions(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.OperationsV1alpha1().Bastions(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&operationsv1alpha1.Bastion{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *bastionInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredBastionInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *bastionInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&operationsv1alpha1.Bastion{}, f.defaultInformer)
+}
+
+func (f *bastionInformer) Lister() v1alpha1.BastionLister {
+	return v1alpha1.NewBastionLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	time "time"
+
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	versioned "github.com/gardener/gardener/pkg/client/operations/clientset/versioned"
+	internalinterfaces "github.com/gardener/gardener/pkg/client/operations/informers/externalversions/internalinterfaces"
+	v1alpha1 "github.com/gardener/gardener/pkg/client/operations/listers/operations/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// BastionPolicyInformer provides access to a shared informer and lister for
+// BastionPolicies.
+type BastionPolicyInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.BastionPolicyLister
+}
+
+type bastionPolicyInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// NewBastionPolicyInformer constructs a new informer for BastionPolicy type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewBastionPolicyInformer(client versioned.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredBastionPolicyInformer(client, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredBastionPolicyInformer constructs a new informer for BastionPolicy type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredBastionPolicyInformer(client versioned.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.OperationsV1alpha1().BastionPolicies().List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.OperationsV1alpha1().BastionPolicies().Watch(context.TODO(), options)
+			},
+		},
+		&operationsv1alpha1.BastionPolicy{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *bastionPolicyInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredBastionPolicyInformer(client, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *bastionPolicyInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&operationsv1alpha1.BastionPolicy{}, f.defaultInformer)
+}
+
+func (f *bastionPolicyInformer) Lister() v1alpha1.BastionPolicyLister {
+	return v1alpha1.NewBastionPolicyLister(f.Informer().GetIndexer())
+}
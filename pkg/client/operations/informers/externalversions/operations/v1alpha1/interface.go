@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	internalinterfaces "github.com/gardener/gardener/pkg/client/operations/informers/externalversions/internalinterfaces"
+)
+
+// Interface provides access to all the informers in this group version.
+type Interface interface {
+	// Bastions returns a BastionInformer.
+	Bastions() BastionInformer
+	// BastionPolicies returns a BastionPolicyInformer.
+	BastionPolicies() BastionPolicyInformer
+}
+
+type version struct {
+	factory          internalinterfaces.SharedInformerFactory
+	namespace        string
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// New returns a new Interface.
+func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakListOptions internalinterfaces.TweakListOptionsFunc) Interface {
+	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
+}
+
+// Bastions returns a BastionInformer.
+func (v *version) Bastions() BastionInformer {
+	return &bastionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// BastionPolicies returns a BastionPolicyInformer.
+func (v *version) BastionPolicies() BastionPolicyInformer {
+	return &bastionPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
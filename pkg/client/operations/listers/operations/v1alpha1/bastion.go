@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// BastionLister helps list Bastions.
+// All objects returned here must be treated as read-only.
+type BastionLister interface {
+	// List lists all Bastions in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.Bastion, err error)
+	// Bastions returns an object that can list and get Bastions.
+	Bastions(namespace string) BastionNamespaceLister
+	BastionListerExpansion
+}
+
+// bastionLister implements the BastionLister interface.
+type bastionLister struct {
+	indexer cache.Indexer
+}
+
+// NewBastionLister returns a new BastionLister.
+func NewBastionLister(indexer cache.Indexer) BastionLister {
+	return &bastionLister{indexer: indexer}
+}
+
+// List lists all Bastions in the indexer.
+func (s *bastionLister) List(selector labels.Selector) (ret []*v1alpha1.Bastion, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.Bastion))
+	})
+	return ret, err
+}
+
+// Bastions returns an object that can list and get Bastions.
+func (s *bastionLister) Bastions(namespace string) BastionNamespaceLister {
+	return bastionNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// BastionNamespaceLister helps list and get Bastions.
+// All objects returned here must be treated as read-only.
+type BastionNamespaceLister interface {
+	// List lists all Bastions in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.Bastion, err error)
+	// Get retrieves the Bastion from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.Bastion, error)
+	BastionNamespaceListerExpansion
+}
+
+// bastionNamespaceLister implements the BastionNamespaceLister
+// interface.
+type bastionNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all Bastions in the indexer for a given namespace.
+func (s bastionNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.Bastion, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.Bastion))
+	})
+	return ret, err
+}
+
+// Get retrieves the Bastion from the indexer for a given namespace and name.
+func (s bastionNamespaceLister) Get(name string) (*v1alpha1.Bastion, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("bastion"), name)
+	}
+	return obj.(*v1alpha1.Bastion), nil
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// BastionPolicyLister helps list BastionPolicies.
+// All objects returned here must be treated as read-only.
+type BastionPolicyLister interface {
+	// List lists all BastionPolicies in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.BastionPolicy, err error)
+	// Get retrieves the BastionPolicy from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.BastionPolicy, error)
+	BastionPolicyListerExpansion
+}
+
+// bastionPolicyLister implements the BastionPolicyLister interface.
+type bastionPolicyLister struct {
+	indexer cache.Indexer
+}
+
+// NewBastionPolicyLister returns a new BastionPolicyLister.
+func NewBastionPolicyLister(indexer cache.Indexer) BastionPolicyLister {
+	return &bastionPolicyLister{indexer: indexer}
+}
+
+// List lists all BastionPolicies in the indexer.
+func (s *bastionPolicyLister) List(selector labels.Selector) (ret []*v1alpha1.BastionPolicy, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.BastionPolicy))
+	})
+	return ret, err
+}
+
+// Get retrieves the BastionPolicy from the index for a given name.
+func (s *bastionPolicyLister) Get(name string) (*v1alpha1.BastionPolicy, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("bastionpolicy"), name)
+	}
+	return obj.(*v1alpha1.BastionPolicy), nil
+}
@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// BastionListerExpansion allows custom methods to be added to
+// BastionLister.
+type BastionListerExpansion interface{}
+
+// BastionNamespaceListerExpansion allows custom methods to be added to
+// BastionNamespaceLister.
+type BastionNamespaceListerExpansion interface{}
+
+// BastionPolicyListerExpansion allows custom methods to be added to
+// BastionPolicyLister.
+type BastionPolicyListerExpansion interface{}
@@ -392,6 +392,10 @@ func (e *etcd) Deploy(ctx context.Context) error {
 			e.etcd.Spec.Backup.DeltaSnapshotMemoryLimit = ptr.To(resource.MustParse("100Mi"))
 			e.etcd.Spec.Backup.DeltaSnapshotRetentionPeriod = e.values.BackupConfig.DeltaSnapshotRetentionPeriod
 
+			if e.values.BackupConfig.FullSnapshotsToKeep != nil {
+				e.etcd.Spec.Backup.GarbageCollectionPolicy = ptr.To(druidv1alpha1.GarbageCollectionPolicy(druidv1alpha1.GarbageCollectionPolicyLimitBased))
+			}
+
 			if e.values.BackupConfig.LeaderElection != nil {
 				e.etcd.Spec.Backup.LeaderElection = &druidv1alpha1.LeaderElectionSpec{
 					EtcdConnectionTimeout: e.values.BackupConfig.LeaderElection.EtcdConnectionTimeout,
@@ -945,6 +949,10 @@ type BackupConfig struct {
 	LeaderElection *gardenletconfig.ETCDBackupLeaderElection
 	// DeltaSnapshotRetentionPeriod defines the duration for which delta snapshots will be retained, excluding the latest snapshot set.
 	DeltaSnapshotRetentionPeriod *metav1.Duration
+	// FullSnapshotsToKeep is the number of full snapshots which shall be kept. If set, the limit-based garbage collection
+	// is used which keeps v1beta1constants.ETCDFullSnapshotsToKeepLimitBased full snapshots. Otherwise, older snapshots
+	// are garbage collected exponentially.
+	FullSnapshotsToKeep *int32
 }
 
 // HVPAConfig contains information for configuring the HVPA object for the etcd.
@@ -317,6 +317,10 @@ var _ = Describe("Etcd", func() {
 				obj.Spec.Backup.DeltaSnapshotRetentionPeriod = &metav1.Duration{Duration: 15 * 24 * time.Hour}
 				obj.Spec.Backup.DeltaSnapshotMemoryLimit = &deltaSnapshotMemoryLimit
 
+				if backupConfig.FullSnapshotsToKeep != nil {
+					obj.Spec.Backup.GarbageCollectionPolicy = ptr.To(druidv1alpha1.GarbageCollectionPolicy(druidv1alpha1.GarbageCollectionPolicyLimitBased))
+				}
+
 				if backupConfig.LeaderElection != nil {
 					obj.Spec.Backup.LeaderElection = &druidv1alpha1.LeaderElectionSpec{
 						EtcdConnectionTimeout: backupLeaderElectionEtcdConnectionTimeout,
@@ -1152,6 +1156,45 @@ var _ = Describe("Etcd", func() {
 				Expect(etcd.Deploy(ctx)).To(Succeed())
 			})
 
+			It("should successfully deploy (with backup) and use the limit-based garbage collection if full snapshots to keep are configured", func() {
+				oldTimeNow := TimeNow
+				defer func() { TimeNow = oldTimeNow }()
+				TimeNow = func() time.Time { return now }
+
+				limitBasedBackupConfig := *backupConfig
+				limitBasedBackupConfig.FullSnapshotsToKeep = ptr.To[int32](7)
+				etcd.SetBackupConfig(&limitBasedBackupConfig)
+
+				gomock.InOrder(
+					c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, etcdName), gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})).Return(apierrors.NewNotFound(schema.GroupResource{}, "")),
+					c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, etcdName), gomock.AssignableToTypeOf(&appsv1.StatefulSet{})).Return(apierrors.NewNotFound(schema.GroupResource{}, "")),
+					c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, etcdName), gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{}), gomock.Any()).Do(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+						Expect(obj.(*druidv1alpha1.Etcd).Spec.Backup.GarbageCollectionPolicy).To(Equal(ptr.To(druidv1alpha1.GarbageCollectionPolicy(druidv1alpha1.GarbageCollectionPolicyLimitBased))))
+						Expect(obj).To(DeepEqual(etcdObjFor(
+							class,
+							1,
+							&limitBasedBackupConfig,
+							"",
+							"",
+							nil,
+							nil,
+							secretNameCA,
+							secretNameClient,
+							secretNameServer,
+							nil,
+							nil,
+							false)))
+					}),
+					c.EXPECT().Get(ctx, kubernetesutils.Key(testNamespace, hvpaName), gomock.AssignableToTypeOf(&hvpav1alpha1.Hvpa{})),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&hvpav1alpha1.Hvpa{}), gomock.Any()).Do(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+						Expect(obj).To(DeepEqual(hvpaFor(class, 1, scaleDownUpdateMode)))
+					}),
+				)
+
+				Expect(etcd.Deploy(ctx)).To(Succeed())
+			})
+
 			It("should successfully deploy (with backup) and keep the existing backup schedule", func() {
 				oldTimeNow := TimeNow
 				defer func() { TimeNow = oldTimeNow }()
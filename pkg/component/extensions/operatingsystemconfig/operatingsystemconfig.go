@@ -15,6 +15,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -123,6 +124,12 @@ type OriginalValues struct {
 	NodeLocalDNSEnabled bool
 	// SyncJitterPeriod is the duration of how the operating system config sync will be jittered on updates.
 	SyncJitterPeriod *metav1.Duration
+	// MaxConcurrentDisruptiveUpdates is the maximum number or percentage of nodes per worker pool which may apply
+	// disruptive operating system config changes at the same time. If nil, such changes are not coordinated.
+	MaxConcurrentDisruptiveUpdates *intstr.IntOrString
+	// RollbackUnhealthyThreshold is the duration for which kubelet or containerd may stay unhealthy after an update of
+	// the operating system config before the last-known-good config is restored. If nil, no rollback is performed.
+	RollbackUnhealthyThreshold *metav1.Duration
 	// PrimaryIPFamily represents the preferred IP family (IPv4 or IPv6) to be used.
 	PrimaryIPFamily gardencorev1beta1.IPFamily
 }
@@ -507,31 +514,33 @@ func (o *operatingSystemConfig) newDeployer(osc *extensionsv1alpha1.OperatingSys
 	}
 
 	return deployer{
-		client:                  o.client,
-		osc:                     osc,
-		worker:                  worker,
-		purpose:                 purpose,
-		key:                     Key(worker.Name, kubernetesVersion, worker.CRI),
-		apiServerURL:            o.values.APIServerURL,
-		caBundle:                caBundle,
-		clusterCASecretName:     clusterCASecret.Name,
-		clusterCABundle:         clusterCASecret.Data[secretsutils.DataKeyCertificateBundle],
-		clusterDNSAddress:       o.values.ClusterDNSAddress,
-		clusterDomain:           o.values.ClusterDomain,
-		criName:                 criName,
-		images:                  images,
-		kubeletCABundle:         kubeletCASecret.Data[secretsutils.DataKeyCertificateBundle],
-		kubeletConfigParameters: kubeletConfigParameters,
-		kubeletCLIFlags:         kubeletCLIFlags,
-		kubeletDataVolumeName:   worker.KubeletDataVolumeName,
-		kubernetesVersion:       kubernetesVersion,
-		sshPublicKeys:           o.values.SSHPublicKeys,
-		sshAccessEnabled:        o.values.SSHAccessEnabled,
-		valiIngressHostName:     o.values.ValiIngressHostName,
-		valitailEnabled:         o.values.ValitailEnabled,
-		nodeLocalDNSEnabled:     o.values.NodeLocalDNSEnabled,
-		oscSyncJitterPeriod:     o.values.SyncJitterPeriod,
-		primaryIPFamily:         o.values.PrimaryIPFamily,
+		client:                            o.client,
+		osc:                               osc,
+		worker:                            worker,
+		purpose:                           purpose,
+		key:                               Key(worker.Name, kubernetesVersion, worker.CRI),
+		apiServerURL:                      o.values.APIServerURL,
+		caBundle:                          caBundle,
+		clusterCASecretName:               clusterCASecret.Name,
+		clusterCABundle:                   clusterCASecret.Data[secretsutils.DataKeyCertificateBundle],
+		clusterDNSAddress:                 o.values.ClusterDNSAddress,
+		clusterDomain:                     o.values.ClusterDomain,
+		criName:                           criName,
+		images:                            images,
+		kubeletCABundle:                   kubeletCASecret.Data[secretsutils.DataKeyCertificateBundle],
+		kubeletConfigParameters:           kubeletConfigParameters,
+		kubeletCLIFlags:                   kubeletCLIFlags,
+		kubeletDataVolumeName:             worker.KubeletDataVolumeName,
+		kubernetesVersion:                 kubernetesVersion,
+		sshPublicKeys:                     o.values.SSHPublicKeys,
+		sshAccessEnabled:                  o.values.SSHAccessEnabled,
+		valiIngressHostName:               o.values.ValiIngressHostName,
+		valitailEnabled:                   o.values.ValitailEnabled,
+		nodeLocalDNSEnabled:               o.values.NodeLocalDNSEnabled,
+		oscSyncJitterPeriod:               o.values.SyncJitterPeriod,
+		oscMaxConcurrentDisruptiveUpdates: o.values.MaxConcurrentDisruptiveUpdates,
+		oscRollbackUnhealthyThreshold:     o.values.RollbackUnhealthyThreshold,
+		primaryIPFamily:                   o.values.PrimaryIPFamily,
 	}, nil
 }
 
@@ -577,25 +586,27 @@ type deployer struct {
 	apiServerURL string
 
 	// original values
-	caBundle                *string
-	clusterCASecretName     string
-	clusterCABundle         []byte
-	clusterDNSAddress       string
-	clusterDomain           string
-	criName                 extensionsv1alpha1.CRIName
-	images                  map[string]*imagevectorutils.Image
-	kubeletCABundle         []byte
-	kubeletConfigParameters components.ConfigurableKubeletConfigParameters
-	kubeletCLIFlags         components.ConfigurableKubeletCLIFlags
-	kubeletDataVolumeName   *string
-	kubernetesVersion       *semver.Version
-	sshPublicKeys           []string
-	sshAccessEnabled        bool
-	valiIngressHostName     string
-	valitailEnabled         bool
-	nodeLocalDNSEnabled     bool
-	oscSyncJitterPeriod     *metav1.Duration
-	primaryIPFamily         gardencorev1beta1.IPFamily
+	caBundle                          *string
+	clusterCASecretName               string
+	clusterCABundle                   []byte
+	clusterDNSAddress                 string
+	clusterDomain                     string
+	criName                           extensionsv1alpha1.CRIName
+	images                            map[string]*imagevectorutils.Image
+	kubeletCABundle                   []byte
+	kubeletConfigParameters           components.ConfigurableKubeletConfigParameters
+	kubeletCLIFlags                   components.ConfigurableKubeletCLIFlags
+	kubeletDataVolumeName             *string
+	kubernetesVersion                 *semver.Version
+	sshPublicKeys                     []string
+	sshAccessEnabled                  bool
+	valiIngressHostName               string
+	valitailEnabled                   bool
+	nodeLocalDNSEnabled               bool
+	oscSyncJitterPeriod               *metav1.Duration
+	oscMaxConcurrentDisruptiveUpdates *intstr.IntOrString
+	oscRollbackUnhealthyThreshold     *metav1.Duration
+	primaryIPFamily                   gardencorev1beta1.IPFamily
 }
 
 // exposed for testing
@@ -614,26 +625,28 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 	)
 
 	componentsContext := components.Context{
-		Key:                     d.key,
-		CABundle:                d.caBundle,
-		ClusterDNSAddress:       d.clusterDNSAddress,
-		ClusterDomain:           d.clusterDomain,
-		CRIName:                 d.criName,
-		Images:                  d.images,
-		NodeLabels:              gardenerutils.NodeLabelsForWorkerPool(d.worker, d.nodeLocalDNSEnabled),
-		KubeletCABundle:         d.kubeletCABundle,
-		KubeletConfigParameters: d.kubeletConfigParameters,
-		KubeletCLIFlags:         d.kubeletCLIFlags,
-		KubeletDataVolumeName:   d.kubeletDataVolumeName,
-		KubernetesVersion:       d.kubernetesVersion,
-		SSHPublicKeys:           d.sshPublicKeys,
-		SSHAccessEnabled:        d.sshAccessEnabled,
-		ValitailEnabled:         d.valitailEnabled,
-		ValiIngress:             d.valiIngressHostName,
-		APIServerURL:            d.apiServerURL,
-		Sysctls:                 d.worker.Sysctls,
-		OSCSyncJitterPeriod:     d.oscSyncJitterPeriod,
-		PreferIPv6:              d.primaryIPFamily == gardencorev1beta1.IPFamilyIPv6,
+		Key:                               d.key,
+		CABundle:                          d.caBundle,
+		ClusterDNSAddress:                 d.clusterDNSAddress,
+		ClusterDomain:                     d.clusterDomain,
+		CRIName:                           d.criName,
+		Images:                            d.images,
+		NodeLabels:                        gardenerutils.NodeLabelsForWorkerPool(d.worker, d.nodeLocalDNSEnabled),
+		KubeletCABundle:                   d.kubeletCABundle,
+		KubeletConfigParameters:           d.kubeletConfigParameters,
+		KubeletCLIFlags:                   d.kubeletCLIFlags,
+		KubeletDataVolumeName:             d.kubeletDataVolumeName,
+		KubernetesVersion:                 d.kubernetesVersion,
+		SSHPublicKeys:                     d.sshPublicKeys,
+		SSHAccessEnabled:                  d.sshAccessEnabled,
+		ValitailEnabled:                   d.valitailEnabled,
+		ValiIngress:                       d.valiIngressHostName,
+		APIServerURL:                      d.apiServerURL,
+		Sysctls:                           d.worker.Sysctls,
+		OSCSyncJitterPeriod:               d.oscSyncJitterPeriod,
+		OSCMaxConcurrentDisruptiveUpdates: d.oscMaxConcurrentDisruptiveUpdates,
+		OSCRollbackUnhealthyThreshold:     d.oscRollbackUnhealthyThreshold,
+		PreferIPv6:                        d.primaryIPFamily == gardencorev1beta1.IPFamilyIPv6,
 	}
 
 	switch d.purpose {
@@ -641,7 +654,7 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 		units, files, err = InitConfigFn(
 			d.worker,
 			d.images[imagevector.ImageNameGardenerNodeAgent].String(),
-			nodeagent.ComponentConfig(d.key, d.kubernetesVersion, d.apiServerURL, d.clusterCABundle, d.oscSyncJitterPeriod, nil),
+			nodeagent.ComponentConfig(d.key, d.kubernetesVersion, d.apiServerURL, d.clusterCABundle, d.oscSyncJitterPeriod, d.oscMaxConcurrentDisruptiveUpdates, d.oscRollbackUnhealthyThreshold, nil),
 		)
 		if err != nil {
 			return nil, err
@@ -7,6 +7,7 @@ package components
 import (
 	"github.com/Masterminds/semver/v3"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
@@ -22,24 +23,26 @@ type Component interface {
 
 // Context contains configuration for the components.
 type Context struct {
-	Key                     string
-	CABundle                *string
-	ClusterDNSAddress       string
-	ClusterDomain           string
-	CRIName                 extensionsv1alpha1.CRIName
-	Images                  map[string]*imagevector.Image
-	NodeLabels              map[string]string
-	KubeletCABundle         []byte
-	KubeletCLIFlags         ConfigurableKubeletCLIFlags
-	KubeletConfigParameters ConfigurableKubeletConfigParameters
-	KubeletDataVolumeName   *string
-	KubernetesVersion       *semver.Version
-	SSHPublicKeys           []string
-	SSHAccessEnabled        bool
-	ValiIngress             string
-	ValitailEnabled         bool
-	APIServerURL            string
-	Sysctls                 map[string]string
-	OSCSyncJitterPeriod     *metav1.Duration
-	PreferIPv6              bool
+	Key                               string
+	CABundle                          *string
+	ClusterDNSAddress                 string
+	ClusterDomain                     string
+	CRIName                           extensionsv1alpha1.CRIName
+	Images                            map[string]*imagevector.Image
+	NodeLabels                        map[string]string
+	KubeletCABundle                   []byte
+	KubeletCLIFlags                   ConfigurableKubeletCLIFlags
+	KubeletConfigParameters           ConfigurableKubeletConfigParameters
+	KubeletDataVolumeName             *string
+	KubernetesVersion                 *semver.Version
+	SSHPublicKeys                     []string
+	SSHAccessEnabled                  bool
+	ValiIngress                       string
+	ValitailEnabled                   bool
+	APIServerURL                      string
+	Sysctls                           map[string]string
+	OSCSyncJitterPeriod               *metav1.Duration
+	OSCMaxConcurrentDisruptiveUpdates *intstr.IntOrString
+	OSCRollbackUnhealthyThreshold     *metav1.Duration
+	PreferIPv6                        bool
 }
@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/utils/ptr"
 
@@ -68,7 +69,7 @@ func (component) Config(ctx components.Context) ([]extensionsv1alpha1.Unit, []ex
 		})
 	}
 
-	files, err := Files(ComponentConfig(ctx.Key, ctx.KubernetesVersion, ctx.APIServerURL, caBundle, ctx.OSCSyncJitterPeriod, additionalTokenSyncConfigs))
+	files, err := Files(ComponentConfig(ctx.Key, ctx.KubernetesVersion, ctx.APIServerURL, caBundle, ctx.OSCSyncJitterPeriod, ctx.OSCMaxConcurrentDisruptiveUpdates, ctx.OSCRollbackUnhealthyThreshold, additionalTokenSyncConfigs))
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed generating files: %w", err)
 	}
@@ -117,8 +118,20 @@ func ComponentConfig(
 	apiServerURL string,
 	caBundle []byte,
 	syncJitterPeriod *metav1.Duration,
+	maxConcurrentDisruptiveUpdates *intstr.IntOrString,
+	rollbackUnhealthyThreshold *metav1.Duration,
 	additionalTokenSyncConfigs []nodeagentv1alpha1.TokenSecretSyncConfig,
 ) *nodeagentv1alpha1.NodeAgentConfiguration {
+	var disruptiveUpdates *nodeagentv1alpha1.DisruptiveUpdatesConfig
+	if maxConcurrentDisruptiveUpdates != nil {
+		disruptiveUpdates = &nodeagentv1alpha1.DisruptiveUpdatesConfig{MaxConcurrentNodes: maxConcurrentDisruptiveUpdates}
+	}
+
+	var rollback *nodeagentv1alpha1.RollbackConfig
+	if rollbackUnhealthyThreshold != nil {
+		rollback = &nodeagentv1alpha1.RollbackConfig{UnhealthyThreshold: rollbackUnhealthyThreshold}
+	}
+
 	return &nodeagentv1alpha1.NodeAgentConfiguration{
 		APIServer: nodeagentv1alpha1.APIServer{
 			Server:   apiServerURL,
@@ -129,6 +142,8 @@ func ComponentConfig(
 				SecretName:        oscSecretName,
 				KubernetesVersion: kubernetesVersion,
 				SyncJitterPeriod:  syncJitterPeriod,
+				DisruptiveUpdates: disruptiveUpdates,
+				Rollback:          rollback,
 			},
 			Token: nodeagentv1alpha1.TokenControllerConfig{
 				SyncConfigs: append([]nodeagentv1alpha1.TokenSecretSyncConfig{{
@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -44,7 +45,7 @@ var _ = Describe("Component", func() {
 		It("should return the expected units and files", func() {
 			key := "key"
 
-			expectedFiles, err := Files(ComponentConfig(key, kubernetesVersion, apiServerURL, caBundle, syncJitterPeriod, nil))
+			expectedFiles, err := Files(ComponentConfig(key, kubernetesVersion, apiServerURL, caBundle, syncJitterPeriod, nil, nil, nil))
 			Expect(err).NotTo(HaveOccurred())
 
 			units, files, err := component.Config(components.Context{
@@ -110,7 +111,7 @@ WantedBy=multi-user.target`))
 
 	Describe("#ComponentConfig", func() {
 		It("should return the expected result", func() {
-			Expect(ComponentConfig(oscSecretName, kubernetesVersion, apiServerURL, caBundle, syncJitterPeriod, additionalTokenSyncConfigs)).To(Equal(&nodeagentv1alpha1.NodeAgentConfiguration{
+			Expect(ComponentConfig(oscSecretName, kubernetesVersion, apiServerURL, caBundle, syncJitterPeriod, nil, nil, additionalTokenSyncConfigs)).To(Equal(&nodeagentv1alpha1.NodeAgentConfiguration{
 				APIServer: nodeagentv1alpha1.APIServer{
 					Server:   apiServerURL,
 					CABundle: caBundle,
@@ -137,11 +138,29 @@ WantedBy=multi-user.target`))
 				},
 			}))
 		})
+
+		It("should configure the coordination of disruptive updates", func() {
+			maxConcurrentDisruptiveUpdates := intstr.FromString("25%")
+
+			config := ComponentConfig(oscSecretName, kubernetesVersion, apiServerURL, caBundle, syncJitterPeriod, &maxConcurrentDisruptiveUpdates, nil, nil)
+			Expect(config.Controllers.OperatingSystemConfig.DisruptiveUpdates).To(Equal(&nodeagentv1alpha1.DisruptiveUpdatesConfig{
+				MaxConcurrentNodes: &maxConcurrentDisruptiveUpdates,
+			}))
+		})
+
+		It("should configure the rollback of operating system configs", func() {
+			rollbackUnhealthyThreshold := &metav1.Duration{Duration: 5 * time.Minute}
+
+			config := ComponentConfig(oscSecretName, kubernetesVersion, apiServerURL, caBundle, syncJitterPeriod, nil, rollbackUnhealthyThreshold, nil)
+			Expect(config.Controllers.OperatingSystemConfig.Rollback).To(Equal(&nodeagentv1alpha1.RollbackConfig{
+				UnhealthyThreshold: rollbackUnhealthyThreshold,
+			}))
+		})
 	})
 
 	Describe("#Files", func() {
 		It("should return the expected files", func() {
-			config := ComponentConfig(oscSecretName, nil, apiServerURL, caBundle, syncJitterPeriod, additionalTokenSyncConfigs)
+			config := ComponentConfig(oscSecretName, nil, apiServerURL, caBundle, syncJitterPeriod, nil, nil, additionalTokenSyncConfigs)
 
 			Expect(Files(config)).To(ConsistOf(extensionsv1alpha1.File{
 				Path:        "/var/lib/gardener-node-agent/config.yaml",
@@ -49,6 +49,8 @@ type Values struct {
 	// BackupEntry does not exist yet. Otherwise, the existing `.spec.bucketName` will be kept even if the BucketName in
 	// these values differs.
 	BucketName string
+	// RetentionPolicy contains the retention and lifecycle settings for the BackupEntry.
+	RetentionPolicy *gardencorev1beta1.BackupRetentionPolicy
 }
 
 // New creates a new instance of DeployWaiter for a BackupEntry.
@@ -167,6 +169,7 @@ func (b *backupEntry) reconcile(ctx context.Context, backupEntry *gardencorev1be
 
 		backupEntry.Spec.BucketName = bucketName
 		backupEntry.Spec.SeedName = seedName
+		backupEntry.Spec.RetentionPolicy = b.values.RetentionPolicy
 
 		return nil
 	})
@@ -15,6 +15,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -137,6 +138,28 @@ var _ = Describe("BackupEntry", func() {
 
 			Expect(actual).To(DeepEqual(expected))
 		})
+
+		It("should update the retention policy of an existing BackupEntry", func() {
+			defer test.WithVars(&TimeNow, mockNow.Do)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			existing := expected.DeepCopy()
+			existing.ResourceVersion = ""
+			existing.Spec.RetentionPolicy = &gardencorev1beta1.BackupRetentionPolicy{LegalHold: ptr.To(true)}
+			Expect(c.Create(ctx, existing)).To(Succeed(), "creating BackupEntry succeeds")
+
+			values.RetentionPolicy = &gardencorev1beta1.BackupRetentionPolicy{RetentionAfterDeletion: &metav1.Duration{Duration: 24 * time.Hour}}
+			Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+			actual := &gardencorev1beta1.BackupEntry{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, actual)).To(Succeed())
+
+			expected.Spec.RetentionPolicy = &gardencorev1beta1.BackupRetentionPolicy{RetentionAfterDeletion: &metav1.Duration{Duration: 24 * time.Hour}}
+			expected.ResourceVersion = "2"
+			expected.Annotations[v1beta1constants.GardenerOperation] = v1beta1constants.GardenerOperationReconcile
+
+			Expect(actual).To(DeepEqual(expected))
+		})
 	})
 
 	Describe("#Wait", func() {
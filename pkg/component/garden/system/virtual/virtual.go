@@ -237,6 +237,7 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 						"projects",
 						"seeds",
 						"shoots",
+						"shootblueprints",
 					},
 					Verbs: []string{"get", "list", "watch"},
 				},
@@ -302,15 +303,22 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 			ObjectMeta: metav1.ObjectMeta{
 				Name: "gardener.cloud:system:read-global-resources",
 			},
-			Rules: []rbacv1.PolicyRule{{
-				APIGroups: []string{gardencorev1beta1.GroupName},
-				Resources: []string{
-					"cloudprofiles",
-					"exposureclasses",
-					"seeds",
+			Rules: []rbacv1.PolicyRule{
+				{
+					APIGroups: []string{gardencorev1beta1.GroupName},
+					Resources: []string{
+						"cloudprofiles",
+						"exposureclasses",
+						"seeds",
+					},
+					Verbs: []string{"get", "list", "watch"},
 				},
-				Verbs: []string{"get", "list", "watch"},
-			}},
+				{
+					APIGroups: []string{operationsv1alpha1.GroupName},
+					Resources: []string{"bastionpolicies"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
+			},
 		}
 		clusterRoleBindingReadGlobalResources = &rbacv1.ClusterRoleBinding{
 			ObjectMeta: metav1.ObjectMeta{
@@ -401,6 +409,7 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 					APIGroups: []string{gardencorev1beta1.GroupName},
 					Resources: []string{
 						"shoots",
+						"shootblueprints",
 						"secretbindings",
 						"quotas",
 					},
@@ -429,6 +438,7 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 					Resources: []string{
 						"shoots/adminkubeconfig",
 						"shoots/viewerkubeconfig",
+						"shoots/wakeup",
 					},
 					Verbs: []string{"create"},
 				},
@@ -501,6 +511,7 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 					APIGroups: []string{gardencorev1beta1.GroupName},
 					Resources: []string{
 						"shoots",
+						"shootblueprints",
 						"secretbindings",
 						"quotas",
 					},
@@ -258,6 +258,7 @@ var _ = Describe("Virtual", func() {
 						"projects",
 						"seeds",
 						"shoots",
+						"shootblueprints",
 					},
 					Verbs: []string{"get", "list", "watch"},
 				},
@@ -323,15 +324,22 @@ var _ = Describe("Virtual", func() {
 			ObjectMeta: metav1.ObjectMeta{
 				Name: "gardener.cloud:system:read-global-resources",
 			},
-			Rules: []rbacv1.PolicyRule{{
-				APIGroups: []string{"core.gardener.cloud"},
-				Resources: []string{
-					"cloudprofiles",
-					"exposureclasses",
-					"seeds",
+			Rules: []rbacv1.PolicyRule{
+				{
+					APIGroups: []string{"core.gardener.cloud"},
+					Resources: []string{
+						"cloudprofiles",
+						"exposureclasses",
+						"seeds",
+					},
+					Verbs: []string{"get", "list", "watch"},
 				},
-				Verbs: []string{"get", "list", "watch"},
-			}},
+				{
+					APIGroups: []string{"operations.gardener.cloud"},
+					Resources: []string{"bastionpolicies"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
+			},
 		}
 		clusterRoleBindingReadGlobalResources = &rbacv1.ClusterRoleBinding{
 			ObjectMeta: metav1.ObjectMeta{
@@ -422,6 +430,7 @@ var _ = Describe("Virtual", func() {
 					APIGroups: []string{"core.gardener.cloud"},
 					Resources: []string{
 						"shoots",
+						"shootblueprints",
 						"secretbindings",
 						"quotas",
 					},
@@ -450,6 +459,7 @@ var _ = Describe("Virtual", func() {
 					Resources: []string{
 						"shoots/adminkubeconfig",
 						"shoots/viewerkubeconfig",
+						"shoots/wakeup",
 					},
 					Verbs: []string{"create"},
 				},
@@ -520,6 +530,7 @@ var _ = Describe("Virtual", func() {
 					APIGroups: []string{"core.gardener.cloud"},
 					Resources: []string{
 						"shoots",
+						"shootblueprints",
 						"secretbindings",
 						"quotas",
 					},
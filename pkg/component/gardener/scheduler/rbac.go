@@ -5,6 +5,8 @@
 package scheduler
 
 import (
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	coordinationv1beta1 "k8s.io/api/coordination/v1beta1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,6 +60,16 @@ func (g *gardenerScheduler) clusterRole() *rbacv1.ClusterRole {
 				},
 				Verbs: []string{"update"},
 			},
+			{
+				APIGroups: []string{authenticationv1.GroupName},
+				Resources: []string{"tokenreviews"},
+				Verbs:     []string{"create"},
+			},
+			{
+				APIGroups: []string{authorizationv1.GroupName},
+				Resources: []string{"subjectaccessreviews"},
+				Verbs:     []string{"create"},
+			},
 			{
 				APIGroups: []string{coordinationv1beta1.GroupName},
 				Resources: []string{
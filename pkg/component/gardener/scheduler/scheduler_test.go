@@ -262,6 +262,16 @@ var _ = Describe("GardenerScheduler", func() {
 					},
 					Verbs: []string{"update"},
 				},
+				{
+					APIGroups: []string{"authentication.k8s.io"},
+					Resources: []string{"tokenreviews"},
+					Verbs:     []string{"create"},
+				},
+				{
+					APIGroups: []string{"authorization.k8s.io"},
+					Resources: []string{"subjectaccessreviews"},
+					Verbs:     []string{"create"},
+				},
 				{
 					APIGroups: []string{coordinationv1beta1.GroupName},
 					Resources: []string{
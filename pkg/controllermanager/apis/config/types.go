@@ -55,6 +55,9 @@ type ControllerManagerControllerConfiguration struct {
 	ExposureClass *ExposureClassControllerConfiguration
 	// Project defines the configuration of the Project controller.
 	Project *ProjectControllerConfiguration
+	// ProjectCost defines the configuration of the ProjectCost controller. If unspecified, the cost of Shoots and
+	// Projects is not estimated.
+	ProjectCost *ProjectCostControllerConfiguration
 	// Quota defines the configuration of the Quota controller.
 	Quota *QuotaControllerConfiguration
 	// SecretBinding defines the configuration of the SecretBinding controller.
@@ -65,12 +68,17 @@ type ControllerManagerControllerConfiguration struct {
 	SeedExtensionsCheck *SeedExtensionsCheckControllerConfiguration
 	// SeedBackupBucketsCheck defines the configuration of the SeedBackupBucketsCheck controller.
 	SeedBackupBucketsCheck *SeedBackupBucketsCheckControllerConfiguration
+	// ShootBlueprint defines the configuration of the ShootBlueprint controller.
+	ShootBlueprint *ShootBlueprintControllerConfiguration
 	// ShootMaintenance defines the configuration of the ShootMaintenance controller.
 	ShootMaintenance ShootMaintenanceControllerConfiguration
 	// ShootQuota defines the configuration of the ShootQuota controller.
 	ShootQuota *ShootQuotaControllerConfiguration
 	// ShootHibernation defines the configuration of the ShootHibernation controller.
 	ShootHibernation ShootHibernationControllerConfiguration
+	// ShootIdleHibernation defines the configuration of the ShootIdleHibernation controller. If unspecified, idle Shoots
+	// are not hibernated automatically.
+	ShootIdleHibernation *ShootIdleHibernationControllerConfiguration
 	// ShootReference defines the configuration of the ShootReference controller. If unspecified, it is defaulted with `concurrentSyncs=5`.
 	ShootReference *ShootReferenceControllerConfiguration
 	// ShootRetry defines the configuration of the ShootRetry controller. If unspecified, it is defaulted with `concurrentSyncs=5`.
@@ -162,6 +170,44 @@ type ProjectControllerConfiguration struct {
 	StaleExpirationTimeDays *int
 	// StaleSyncPeriod is the duration how often the reconciliation loop for stale Projects is executed.
 	StaleSyncPeriod *metav1.Duration
+	// StaleNotifications configures the notifications sent to the owners of stale Projects before they get
+	// auto-deleted. If not set, no notifications are sent.
+	StaleNotifications *ProjectStaleNotificationConfiguration
+	// StaleSnoozeMaxDays is the maximum number of days the stale check of a `Project` can be snoozed via the
+	// `project.gardener.cloud/stale-snooze-until` annotation. Later timestamps are reduced to this limit.
+	StaleSnoozeMaxDays *int
+	// DeletionRecordRetentionDays is the number of days the audit records of automatically deleted stale `Project`s
+	// are kept in the garden namespace.
+	DeletionRecordRetentionDays *int
+}
+
+// ProjectStaleNotificationConfiguration defines the notifications sent to the owners of stale Projects.
+type ProjectStaleNotificationConfiguration struct {
+	// DaysBeforeDeletion are the numbers of days before the auto-deletion of a stale Project at which its owner
+	// is notified.
+	DaysBeforeDeletion []int
+	// Webhook configures a webhook which receives the notifications. If not set, the notifications are
+	// recorded as events for the Project.
+	Webhook *ProjectStaleNotificationWebhook
+}
+
+// ProjectStaleNotificationWebhook defines a webhook which receives the notifications for stale Projects.
+type ProjectStaleNotificationWebhook struct {
+	// URL is the URL of the webhook to which the notifications are posted.
+	URL string
+	// Timeout is the timeout for requests to the webhook.
+	Timeout *metav1.Duration
+}
+
+// ProjectCostControllerConfiguration defines the configuration of the
+// ProjectCost controller.
+type ProjectCostControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often the estimated cost of the Shoots and Projects
+	// is recomputed.
+	SyncPeriod *metav1.Duration
 }
 
 // QuotaConfiguration defines quota configurations.
@@ -232,6 +278,14 @@ type SeedBackupBucketsCheckControllerConfiguration struct {
 	ConditionThresholds []ConditionThreshold
 }
 
+// ShootBlueprintControllerConfiguration defines the configuration of the
+// ShootBlueprint controller.
+type ShootBlueprintControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+}
+
 // ShootMaintenanceControllerConfiguration defines the configuration of the
 // ShootMaintenance controller.
 type ShootMaintenanceControllerConfiguration struct {
@@ -242,6 +296,55 @@ type ShootMaintenanceControllerConfiguration struct {
 	EnableShootControlPlaneRestarter *bool
 	// EnableShootCoreAddonRestarter configures whether some core addons to be restarted during maintenance.
 	EnableShootCoreAddonRestarter *bool
+	// FreezePeriods is a list of landscape-wide periods in which automatic updates of Kubernetes and machine image
+	// versions as well as rollouts of confined spec updates are suppressed.
+	FreezePeriods []MaintenanceFreezePeriod
+	// RolloutWaves configures the staged rollout of new Kubernetes and machine image versions across Shoots. If not
+	// specified, the versions of all Shoots are updated independently of each other.
+	RolloutWaves *MaintenanceRolloutWavesConfiguration
+}
+
+// MaintenanceRolloutWavesConfiguration configures the staged rollout of new Kubernetes and machine image versions
+// across Shoots.
+type MaintenanceRolloutWavesConfiguration struct {
+	// Waves is the ordered list of rollout waves. Shoots not matching any wave belong to an implicit last wave.
+	Waves []MaintenanceRolloutWave
+	// SyncPeriod is the duration how often the rollout state of the versions in the CloudProfiles is updated.
+	SyncPeriod *metav1.Duration
+}
+
+// RemainingShootsRolloutWave is the name of the implicit last rollout wave containing all Shoots which do not match any
+// configured wave.
+const RemainingShootsRolloutWave = "remaining"
+
+// MaintenanceRolloutWave is a group of Shoots which receive new versions at the same time.
+type MaintenanceRolloutWave struct {
+	// Name is the name of the wave.
+	Name string
+	// ShootSelector selects the Shoots belonging to this wave based on their labels.
+	ShootSelector *metav1.LabelSelector
+	// ProjectSelector selects the Shoots belonging to this wave based on the labels of their Projects.
+	ProjectSelector *metav1.LabelSelector
+	// SoakDuration is the duration for which the Shoots of this wave must run a new version without control plane
+	// health regressions before the version is released to the next wave.
+	SoakDuration metav1.Duration
+}
+
+// MaintenanceFreezePeriod is a period in which automatic maintenance of Shoots is suppressed. It is either specified
+// as an absolute time range via Begin and End, or as a recurring period via Schedule and Duration.
+type MaintenanceFreezePeriod struct {
+	// Name is the name of the freeze period.
+	Name string
+	// Begin is the beginning of an absolute freeze period.
+	Begin *metav1.Time
+	// End is the end of an absolute freeze period.
+	End *metav1.Time
+	// Schedule is a cron expression (standard format) describing the beginnings of a recurring freeze period.
+	Schedule *string
+	// Duration is the duration of a recurring freeze period.
+	Duration *metav1.Duration
+	// Location is the time zone in which the schedule of a recurring freeze period is evaluated (defaults to UTC).
+	Location *string
 }
 
 // ShootQuotaControllerConfiguration defines the configuration of the
@@ -266,6 +369,17 @@ type ShootHibernationControllerConfiguration struct {
 	TriggerDeadlineDuration *metav1.Duration
 }
 
+// ShootIdleHibernationControllerConfiguration defines the configuration of the
+// ShootIdleHibernation controller.
+type ShootIdleHibernationControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+	// WarningPeriod is the duration before the hibernation of an idle Shoot in which
+	// a warning event is emitted (defaults to '30m').
+	WarningPeriod *metav1.Duration
+}
+
 // ShootReferenceControllerConfiguration defines the configuration of the
 // ShootReference controller.
 type ShootReferenceControllerConfiguration struct {
@@ -330,6 +444,9 @@ type ServerConfiguration struct {
 	HealthProbes *Server
 	// Metrics is the configuration for serving the metrics endpoint.
 	Metrics *Server
+	// ShootWakeUp is the configuration for serving the endpoint which allows waking up hibernated shoots on demand. If
+	// unspecified, the endpoint is not served. The port defaults to 2720.
+	ShootWakeUp *HTTPSServer
 }
 
 // Server contains information for HTTP(S) server configuration.
@@ -339,3 +456,18 @@ type Server struct {
 	// Port is the port on which to serve requests.
 	Port int
 }
+
+// HTTPSServer is the configuration for the HTTPSServer server.
+type HTTPSServer struct {
+	// Server is the configuration for the bind address and the port.
+	Server
+	// TLSServer contains information about the TLS configuration for a HTTPS server.
+	TLS TLSServer
+}
+
+// TLSServer contains information about the TLS configuration for a HTTPS server.
+type TLSServer struct {
+	// ServerCertDir is the path to a directory containing the server's TLS certificate and key (the files must be
+	// named tls.crt and tls.key respectively).
+	ServerCertDir string
+}
@@ -102,6 +102,12 @@ func SetDefaults_ProjectControllerConfiguration(obj *ProjectControllerConfigurat
 			Duration: 12 * time.Hour,
 		}
 	}
+	if obj.StaleSnoozeMaxDays == nil {
+		obj.StaleSnoozeMaxDays = ptr.To(90)
+	}
+	if obj.DeletionRecordRetentionDays == nil {
+		obj.DeletionRecordRetentionDays = ptr.To(365)
+	}
 
 	for i, quota := range obj.Quotas {
 		if quota.ProjectSelector == nil {
@@ -110,6 +116,30 @@ func SetDefaults_ProjectControllerConfiguration(obj *ProjectControllerConfigurat
 	}
 }
 
+// SetDefaults_ProjectStaleNotificationConfiguration sets defaults for the ProjectStaleNotificationConfiguration.
+func SetDefaults_ProjectStaleNotificationConfiguration(obj *ProjectStaleNotificationConfiguration) {
+	if len(obj.DaysBeforeDeletion) == 0 {
+		obj.DaysBeforeDeletion = []int{7, 1}
+	}
+}
+
+// SetDefaults_ProjectStaleNotificationWebhook sets defaults for the ProjectStaleNotificationWebhook.
+func SetDefaults_ProjectStaleNotificationWebhook(obj *ProjectStaleNotificationWebhook) {
+	if obj.Timeout == nil {
+		obj.Timeout = &metav1.Duration{Duration: 10 * time.Second}
+	}
+}
+
+// SetDefaults_ProjectCostControllerConfiguration sets defaults for the ProjectCostControllerConfiguration.
+func SetDefaults_ProjectCostControllerConfiguration(obj *ProjectCostControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+}
+
 // SetDefaults_ServerConfiguration sets defaults for the ServerConfiguration.
 func SetDefaults_ServerConfiguration(obj *ServerConfiguration) {
 	if obj.HealthProbes == nil {
@@ -125,6 +155,10 @@ func SetDefaults_ServerConfiguration(obj *ServerConfiguration) {
 	if obj.Metrics.Port == 0 {
 		obj.Metrics.Port = 2719
 	}
+
+	if obj.ShootWakeUp != nil && obj.ShootWakeUp.Port == 0 {
+		obj.ShootWakeUp.Port = 2720
+	}
 }
 
 // SetDefaults_BastionControllerConfiguration sets defaults for the BastionControllerConfiguration.
@@ -206,6 +240,13 @@ func SetDefaults_SeedBackupBucketsCheckControllerConfiguration(obj *SeedBackupBu
 	}
 }
 
+// SetDefaults_ShootBlueprintControllerConfiguration sets defaults for the ShootBlueprintControllerConfiguration.
+func SetDefaults_ShootBlueprintControllerConfiguration(obj *ShootBlueprintControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+}
+
 // SetDefaults_ShootHibernationControllerConfiguration sets defaults for the ShootHibernationControllerConfiguration.
 func SetDefaults_ShootHibernationControllerConfiguration(obj *ShootHibernationControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -216,6 +257,16 @@ func SetDefaults_ShootHibernationControllerConfiguration(obj *ShootHibernationCo
 	}
 }
 
+// SetDefaults_ShootIdleHibernationControllerConfiguration sets defaults for the ShootIdleHibernationControllerConfiguration.
+func SetDefaults_ShootIdleHibernationControllerConfiguration(obj *ShootIdleHibernationControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.WarningPeriod == nil {
+		obj.WarningPeriod = &metav1.Duration{Duration: 30 * time.Minute}
+	}
+}
+
 // SetDefaults_ShootMaintenanceControllerConfiguration sets defaults for the ShootMaintenanceControllerConfiguration.
 func SetDefaults_ShootMaintenanceControllerConfiguration(obj *ShootMaintenanceControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -226,6 +277,13 @@ func SetDefaults_ShootMaintenanceControllerConfiguration(obj *ShootMaintenanceCo
 	}
 }
 
+// SetDefaults_MaintenanceRolloutWavesConfiguration sets defaults for the MaintenanceRolloutWavesConfiguration.
+func SetDefaults_MaintenanceRolloutWavesConfiguration(obj *MaintenanceRolloutWavesConfiguration) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Minute}
+	}
+}
+
 // SetDefaults_ShootQuotaControllerConfiguration sets defaults for the ShootQuotaControllerConfiguration.
 func SetDefaults_ShootQuotaControllerConfiguration(obj *ShootQuotaControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -317,6 +375,9 @@ func SetDefaults_ControllerManagerControllerConfiguration(obj *ControllerManager
 	if obj.SeedBackupBucketsCheck == nil {
 		obj.SeedBackupBucketsCheck = &SeedBackupBucketsCheckControllerConfiguration{}
 	}
+	if obj.ShootBlueprint == nil {
+		obj.ShootBlueprint = &ShootBlueprintControllerConfiguration{}
+	}
 	if obj.ShootQuota == nil {
 		obj.ShootQuota = &ShootQuotaControllerConfiguration{}
 	}
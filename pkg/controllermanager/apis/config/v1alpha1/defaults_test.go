@@ -183,6 +183,8 @@ var _ = Describe("Defaults", func() {
 				StaleSyncPeriod: &metav1.Duration{
 					Duration: 12 * time.Hour,
 				},
+				StaleSnoozeMaxDays:          ptr.To(90),
+				DeletionRecordRetentionDays: ptr.To(365),
 			}
 			SetObjectDefaults_ControllerManagerConfiguration(obj)
 
@@ -224,6 +226,8 @@ var _ = Describe("Defaults", func() {
 						StaleSyncPeriod: &metav1.Duration{
 							Duration: 12 * time.Hour,
 						},
+						StaleSnoozeMaxDays:          ptr.To(30),
+						DeletionRecordRetentionDays: ptr.To(30),
 					},
 				},
 			}
@@ -232,6 +236,47 @@ var _ = Describe("Defaults", func() {
 
 			Expect(obj.Controllers.Project).To(Equal(expected))
 		})
+
+		It("should default the stale notification configuration correctly", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					Project: &ProjectControllerConfiguration{
+						StaleNotifications: &ProjectStaleNotificationConfiguration{
+							Webhook: &ProjectStaleNotificationWebhook{URL: "https://example.com"},
+						},
+					},
+				},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.Project.StaleNotifications).To(Equal(&ProjectStaleNotificationConfiguration{
+				DaysBeforeDeletion: []int{7, 1},
+				Webhook: &ProjectStaleNotificationWebhook{
+					URL:     "https://example.com",
+					Timeout: &metav1.Duration{Duration: 10 * time.Second},
+				},
+			}))
+		})
+
+		It("should not default stale notification fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					Project: &ProjectControllerConfiguration{
+						StaleNotifications: &ProjectStaleNotificationConfiguration{
+							DaysBeforeDeletion: []int{14},
+							Webhook: &ProjectStaleNotificationWebhook{
+								URL:     "https://example.com",
+								Timeout: &metav1.Duration{Duration: time.Minute},
+							},
+						},
+					},
+				},
+			}
+			expected := obj.Controllers.Project.StaleNotifications.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.Project.StaleNotifications).To(Equal(expected))
+		})
 	})
 
 	Describe("ServerConfiguration defaulting", func() {
@@ -265,6 +310,20 @@ var _ = Describe("Defaults", func() {
 
 			Expect(&obj.Server).To(Equal(expected))
 		})
+
+		It("should default the port of the shoot wake-up server", func() {
+			obj.Server.ShootWakeUp = &HTTPSServer{TLS: TLSServer{ServerCertDir: "/foo"}}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Server.ShootWakeUp).To(Equal(&HTTPSServer{Server: Server{Port: 2720}, TLS: TLSServer{ServerCertDir: "/foo"}}))
+		})
+
+		It("should not default the port of the shoot wake-up server if it is set", func() {
+			obj.Server.ShootWakeUp = &HTTPSServer{Server: Server{Port: 5000}, TLS: TLSServer{ServerCertDir: "/foo"}}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Server.ShootWakeUp.Port).To(Equal(5000))
+		})
 	})
 
 	Describe("BastionControllerConfiguration defaulting", func() {
@@ -523,6 +582,31 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShootBlueprintControllerConfiguration defaulting", func() {
+		It("should default ShootBlueprintControllerConfiguration correctly", func() {
+			expected := &ShootBlueprintControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootBlueprint).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootBlueprint: &ShootBlueprintControllerConfiguration{
+						ConcurrentSyncs: ptr.To(10),
+					},
+				},
+			}
+			expected := obj.Controllers.ShootBlueprint.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootBlueprint).To(Equal(expected))
+		})
+	})
+
 	Describe("ShootHibernationControllerConfiguration defaulting", func() {
 		It("should default ShootHibernationControllerConfiguration correctly", func() {
 			expected := &ShootHibernationControllerConfiguration{
@@ -550,6 +634,74 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ProjectCostControllerConfiguration defaulting", func() {
+		It("should not default the configuration if it is not set", func() {
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ProjectCost).To(BeNil())
+		})
+
+		It("should default ProjectCostControllerConfiguration correctly", func() {
+			obj.Controllers.ProjectCost = &ProjectCostControllerConfiguration{}
+			expected := &ProjectCostControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+				SyncPeriod:      &metav1.Duration{Duration: time.Hour},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ProjectCost).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ProjectCost: &ProjectCostControllerConfiguration{
+						ConcurrentSyncs: ptr.To(10),
+						SyncPeriod:      &metav1.Duration{Duration: 6 * time.Hour},
+					},
+				},
+			}
+			expected := obj.Controllers.ProjectCost.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ProjectCost).To(Equal(expected))
+		})
+	})
+
+	Describe("ShootIdleHibernationControllerConfiguration defaulting", func() {
+		It("should not default the configuration if it is not set", func() {
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootIdleHibernation).To(BeNil())
+		})
+
+		It("should default ShootIdleHibernationControllerConfiguration correctly", func() {
+			obj.Controllers.ShootIdleHibernation = &ShootIdleHibernationControllerConfiguration{}
+			expected := &ShootIdleHibernationControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+				WarningPeriod:   &metav1.Duration{Duration: 30 * time.Minute},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootIdleHibernation).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootIdleHibernation: &ShootIdleHibernationControllerConfiguration{
+						ConcurrentSyncs: ptr.To(10),
+						WarningPeriod:   &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			}
+			expected := obj.Controllers.ShootIdleHibernation.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootIdleHibernation).To(Equal(expected))
+		})
+	})
+
 	Describe("ShootMaintenanceControllerConfiguration defaulting", func() {
 		It("should default ShootMaintenanceControllerConfiguration correctly", func() {
 			expected := &ShootMaintenanceControllerConfiguration{
@@ -575,6 +727,13 @@ var _ = Describe("Defaults", func() {
 
 			Expect(&obj.Controllers.ShootMaintenance).To(Equal(expected))
 		})
+
+		It("should default the rollout waves configuration", func() {
+			obj.Controllers.ShootMaintenance.RolloutWaves = &MaintenanceRolloutWavesConfiguration{}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootMaintenance.RolloutWaves.SyncPeriod).To(Equal(&metav1.Duration{Duration: time.Minute}))
+		})
 	})
 
 	Describe("ShootQuotaControllerConfiguration defaulting", func() {
@@ -66,6 +66,10 @@ type ControllerManagerControllerConfiguration struct {
 	// Project defines the configuration of the Project controller.
 	// +optional
 	Project *ProjectControllerConfiguration `json:"project,omitempty"`
+	// ProjectCost defines the configuration of the ProjectCost controller. If unspecified, the cost of Shoots and
+	// Projects is not estimated.
+	// +optional
+	ProjectCost *ProjectCostControllerConfiguration `json:"projectCost,omitempty"`
 	// Quota defines the configuration of the Quota controller.
 	// +optional
 	Quota *QuotaControllerConfiguration `json:"quota,omitempty"`
@@ -81,6 +85,9 @@ type ControllerManagerControllerConfiguration struct {
 	// SeedBackupBucketsCheck defines the configuration of the SeedBackupBucketsCheck controller.
 	// +optional
 	SeedBackupBucketsCheck *SeedBackupBucketsCheckControllerConfiguration `json:"seedBackupBucketsCheck,omitempty"`
+	// ShootBlueprint defines the configuration of the ShootBlueprint controller.
+	// +optional
+	ShootBlueprint *ShootBlueprintControllerConfiguration `json:"shootBlueprint,omitempty"`
 	// ShootMaintenance defines the configuration of the ShootMaintenance controller.
 	ShootMaintenance ShootMaintenanceControllerConfiguration `json:"shootMaintenance"`
 	// ShootQuota defines the configuration of the ShootQuota controller.
@@ -88,6 +95,10 @@ type ControllerManagerControllerConfiguration struct {
 	ShootQuota *ShootQuotaControllerConfiguration `json:"shootQuota,omitempty"`
 	// ShootHibernation defines the configuration of the ShootHibernation controller.
 	ShootHibernation ShootHibernationControllerConfiguration `json:"shootHibernation"`
+	// ShootIdleHibernation defines the configuration of the ShootIdleHibernation controller. If unspecified, idle Shoots
+	// are not hibernated automatically.
+	// +optional
+	ShootIdleHibernation *ShootIdleHibernationControllerConfiguration `json:"shootIdleHibernation,omitempty"`
 	// ShootReference defines the configuration of the ShootReference controller. If unspecified, it is defaulted with `concurrentSyncs=5`.
 	// +optional
 	ShootReference *ShootReferenceControllerConfiguration `json:"shootReference,omitempty"`
@@ -199,6 +210,53 @@ type ProjectControllerConfiguration struct {
 	// StaleSyncPeriod is the duration how often the reconciliation loop for stale Projects is executed.
 	// +optional
 	StaleSyncPeriod *metav1.Duration `json:"staleSyncPeriod,omitempty"`
+	// StaleNotifications configures the notifications sent to the owners of stale Projects before they get
+	// auto-deleted. If not set, no notifications are sent.
+	// +optional
+	StaleNotifications *ProjectStaleNotificationConfiguration `json:"staleNotifications,omitempty"`
+	// StaleSnoozeMaxDays is the maximum number of days the stale check of a `Project` can be snoozed via the
+	// `project.gardener.cloud/stale-snooze-until` annotation (defaults to '90'). Later timestamps are reduced to this
+	// limit.
+	// +optional
+	StaleSnoozeMaxDays *int `json:"staleSnoozeMaxDays,omitempty"`
+	// DeletionRecordRetentionDays is the number of days the audit records of automatically deleted stale `Project`s
+	// are kept in the garden namespace (defaults to '365').
+	// +optional
+	DeletionRecordRetentionDays *int `json:"deletionRecordRetentionDays,omitempty"`
+}
+
+// ProjectStaleNotificationConfiguration defines the notifications sent to the owners of stale Projects.
+type ProjectStaleNotificationConfiguration struct {
+	// DaysBeforeDeletion are the numbers of days before the auto-deletion of a stale Project at which its owner
+	// is notified (defaults to '[7, 1]').
+	// +optional
+	DaysBeforeDeletion []int `json:"daysBeforeDeletion,omitempty"`
+	// Webhook configures a webhook which receives the notifications. If not set, the notifications are
+	// recorded as events for the Project.
+	// +optional
+	Webhook *ProjectStaleNotificationWebhook `json:"webhook,omitempty"`
+}
+
+// ProjectStaleNotificationWebhook defines a webhook which receives the notifications for stale Projects.
+type ProjectStaleNotificationWebhook struct {
+	// URL is the URL of the webhook to which the notifications are posted.
+	URL string `json:"url"`
+	// Timeout is the timeout for requests to the webhook (defaults to '10s').
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// ProjectCostControllerConfiguration defines the configuration of the
+// ProjectCost controller.
+type ProjectCostControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the estimated cost of the Shoots and Projects
+	// is recomputed (defaults to '1h').
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
 }
 
 // QuotaConfiguration defines quota configurations.
@@ -282,6 +340,15 @@ type SeedBackupBucketsCheckControllerConfiguration struct {
 	ConditionThresholds []ConditionThreshold `json:"conditionThresholds,omitempty"`
 }
 
+// ShootBlueprintControllerConfiguration defines the configuration of the
+// ShootBlueprint controller.
+type ShootBlueprintControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+}
+
 // ShootMaintenanceControllerConfiguration defines the configuration of the
 // ShootMaintenance controller.
 type ShootMaintenanceControllerConfiguration struct {
@@ -295,6 +362,61 @@ type ShootMaintenanceControllerConfiguration struct {
 	// EnableShootCoreAddonRestarter configures whether some core addons to be restarted during maintenance.
 	// +optional
 	EnableShootCoreAddonRestarter *bool `json:"enableShootCoreAddonRestarter"`
+	// FreezePeriods is a list of landscape-wide periods in which automatic updates of Kubernetes and machine image
+	// versions as well as rollouts of confined spec updates are suppressed.
+	// +optional
+	FreezePeriods []MaintenanceFreezePeriod `json:"freezePeriods,omitempty"`
+	// RolloutWaves configures the staged rollout of new Kubernetes and machine image versions across Shoots. If not
+	// specified, the versions of all Shoots are updated independently of each other.
+	// +optional
+	RolloutWaves *MaintenanceRolloutWavesConfiguration `json:"rolloutWaves,omitempty"`
+}
+
+// MaintenanceRolloutWavesConfiguration configures the staged rollout of new Kubernetes and machine image versions
+// across Shoots.
+type MaintenanceRolloutWavesConfiguration struct {
+	// Waves is the ordered list of rollout waves. Shoots not matching any wave belong to an implicit last wave.
+	Waves []MaintenanceRolloutWave `json:"waves"`
+	// SyncPeriod is the duration how often the rollout state of the versions in the CloudProfiles is updated.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+}
+
+// MaintenanceRolloutWave is a group of Shoots which receive new versions at the same time.
+type MaintenanceRolloutWave struct {
+	// Name is the name of the wave.
+	Name string `json:"name"`
+	// ShootSelector selects the Shoots belonging to this wave based on their labels.
+	// +optional
+	ShootSelector *metav1.LabelSelector `json:"shootSelector,omitempty"`
+	// ProjectSelector selects the Shoots belonging to this wave based on the labels of their Projects.
+	// +optional
+	ProjectSelector *metav1.LabelSelector `json:"projectSelector,omitempty"`
+	// SoakDuration is the duration for which the Shoots of this wave must run a new version without control plane
+	// health regressions before the version is released to the next wave.
+	SoakDuration metav1.Duration `json:"soakDuration"`
+}
+
+// MaintenanceFreezePeriod is a period in which automatic maintenance of Shoots is suppressed. It is either specified
+// as an absolute time range via Begin and End, or as a recurring period via Schedule and Duration.
+type MaintenanceFreezePeriod struct {
+	// Name is the name of the freeze period.
+	Name string `json:"name"`
+	// Begin is the beginning of an absolute freeze period.
+	// +optional
+	Begin *metav1.Time `json:"begin,omitempty"`
+	// End is the end of an absolute freeze period.
+	// +optional
+	End *metav1.Time `json:"end,omitempty"`
+	// Schedule is a cron expression (standard format) describing the beginnings of a recurring freeze period.
+	// +optional
+	Schedule *string `json:"schedule,omitempty"`
+	// Duration is the duration of a recurring freeze period.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+	// Location is the time zone in which the schedule of a recurring freeze period is evaluated (defaults to UTC).
+	// +optional
+	Location *string `json:"location,omitempty"`
 }
 
 // ShootQuotaControllerConfiguration defines the configuration of the
@@ -323,6 +445,19 @@ type ShootHibernationControllerConfiguration struct {
 	TriggerDeadlineDuration *metav1.Duration `json:"triggerDeadlineDuration,omitempty"`
 }
 
+// ShootIdleHibernationControllerConfiguration defines the configuration of the
+// ShootIdleHibernation controller.
+type ShootIdleHibernationControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// WarningPeriod is the duration before the hibernation of an idle Shoot in which
+	// a warning event is emitted (defaults to '30m').
+	// +optional
+	WarningPeriod *metav1.Duration `json:"warningPeriod,omitempty"`
+}
+
 // ShootReferenceControllerConfiguration defines the configuration of the
 // ShootReference controller.
 type ShootReferenceControllerConfiguration struct {
@@ -398,6 +533,10 @@ type ServerConfiguration struct {
 	// Metrics is the configuration for serving the metrics endpoint.
 	// +optional
 	Metrics *Server `json:"metrics,omitempty"`
+	// ShootWakeUp is the configuration for serving the endpoint which allows waking up hibernated shoots on demand. If
+	// unspecified, the endpoint is not served. The port defaults to 2720.
+	// +optional
+	ShootWakeUp *HTTPSServer `json:"shootWakeUp,omitempty"`
 }
 
 // Server contains information for HTTP(S) server configuration.
@@ -408,6 +547,21 @@ type Server struct {
 	Port int `json:"port"`
 }
 
+// HTTPSServer is the configuration for the HTTPSServer server.
+type HTTPSServer struct {
+	// Server is the configuration for the bind address and the port.
+	Server `json:",inline"`
+	// TLSServer contains information about the TLS configuration for a HTTPS server.
+	TLS TLSServer `json:"tls"`
+}
+
+// TLSServer contains information about the TLS configuration for a HTTPS server.
+type TLSServer struct {
+	// ServerCertDir is the path to a directory containing the server's TLS certificate and key (the files must be
+	// named tls.crt and tls.key respectively).
+	ServerCertDir string `json:"serverCertDir"`
+}
+
 const (
 	// ControllerManagerDefaultLockObjectNamespace is the default lock namespace for leader election.
 	ControllerManagerDefaultLockObjectNamespace = "garden"
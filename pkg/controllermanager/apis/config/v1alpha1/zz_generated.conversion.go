@@ -127,6 +127,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HTTPSServer)(nil), (*config.HTTPSServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HTTPSServer_To_config_HTTPSServer(a.(*HTTPSServer), b.(*config.HTTPSServer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.HTTPSServer)(nil), (*HTTPSServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_HTTPSServer_To_v1alpha1_HTTPSServer(a.(*config.HTTPSServer), b.(*HTTPSServer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceFreezePeriod)(nil), (*config.MaintenanceFreezePeriod)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MaintenanceFreezePeriod_To_config_MaintenanceFreezePeriod(a.(*MaintenanceFreezePeriod), b.(*config.MaintenanceFreezePeriod), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.MaintenanceFreezePeriod)(nil), (*MaintenanceFreezePeriod)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_MaintenanceFreezePeriod_To_v1alpha1_MaintenanceFreezePeriod(a.(*config.MaintenanceFreezePeriod), b.(*MaintenanceFreezePeriod), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceRolloutWave)(nil), (*config.MaintenanceRolloutWave)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MaintenanceRolloutWave_To_config_MaintenanceRolloutWave(a.(*MaintenanceRolloutWave), b.(*config.MaintenanceRolloutWave), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.MaintenanceRolloutWave)(nil), (*MaintenanceRolloutWave)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_MaintenanceRolloutWave_To_v1alpha1_MaintenanceRolloutWave(a.(*config.MaintenanceRolloutWave), b.(*MaintenanceRolloutWave), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceRolloutWavesConfiguration)(nil), (*config.MaintenanceRolloutWavesConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MaintenanceRolloutWavesConfiguration_To_config_MaintenanceRolloutWavesConfiguration(a.(*MaintenanceRolloutWavesConfiguration), b.(*config.MaintenanceRolloutWavesConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.MaintenanceRolloutWavesConfiguration)(nil), (*MaintenanceRolloutWavesConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_MaintenanceRolloutWavesConfiguration_To_v1alpha1_MaintenanceRolloutWavesConfiguration(a.(*config.MaintenanceRolloutWavesConfiguration), b.(*MaintenanceRolloutWavesConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ManagedSeedSetControllerConfiguration)(nil), (*config.ManagedSeedSetControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ManagedSeedSetControllerConfiguration_To_config_ManagedSeedSetControllerConfiguration(a.(*ManagedSeedSetControllerConfiguration), b.(*config.ManagedSeedSetControllerConfiguration), scope)
 	}); err != nil {
@@ -147,6 +187,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectCostControllerConfiguration)(nil), (*config.ProjectCostControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ProjectCostControllerConfiguration_To_config_ProjectCostControllerConfiguration(a.(*ProjectCostControllerConfiguration), b.(*config.ProjectCostControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ProjectCostControllerConfiguration)(nil), (*ProjectCostControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ProjectCostControllerConfiguration_To_v1alpha1_ProjectCostControllerConfiguration(a.(*config.ProjectCostControllerConfiguration), b.(*ProjectCostControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectStaleNotificationConfiguration)(nil), (*config.ProjectStaleNotificationConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ProjectStaleNotificationConfiguration_To_config_ProjectStaleNotificationConfiguration(a.(*ProjectStaleNotificationConfiguration), b.(*config.ProjectStaleNotificationConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ProjectStaleNotificationConfiguration)(nil), (*ProjectStaleNotificationConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ProjectStaleNotificationConfiguration_To_v1alpha1_ProjectStaleNotificationConfiguration(a.(*config.ProjectStaleNotificationConfiguration), b.(*ProjectStaleNotificationConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectStaleNotificationWebhook)(nil), (*config.ProjectStaleNotificationWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ProjectStaleNotificationWebhook_To_config_ProjectStaleNotificationWebhook(a.(*ProjectStaleNotificationWebhook), b.(*config.ProjectStaleNotificationWebhook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ProjectStaleNotificationWebhook)(nil), (*ProjectStaleNotificationWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ProjectStaleNotificationWebhook_To_v1alpha1_ProjectStaleNotificationWebhook(a.(*config.ProjectStaleNotificationWebhook), b.(*ProjectStaleNotificationWebhook), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*config.QuotaConfiguration)(nil), (*QuotaConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_config_QuotaConfiguration_To_v1alpha1_QuotaConfiguration(a.(*config.QuotaConfiguration), b.(*QuotaConfiguration), scope)
 	}); err != nil {
@@ -222,6 +292,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootBlueprintControllerConfiguration)(nil), (*config.ShootBlueprintControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootBlueprintControllerConfiguration_To_config_ShootBlueprintControllerConfiguration(a.(*ShootBlueprintControllerConfiguration), b.(*config.ShootBlueprintControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootBlueprintControllerConfiguration)(nil), (*ShootBlueprintControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootBlueprintControllerConfiguration_To_v1alpha1_ShootBlueprintControllerConfiguration(a.(*config.ShootBlueprintControllerConfiguration), b.(*ShootBlueprintControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootConditionsControllerConfiguration)(nil), (*config.ShootConditionsControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootConditionsControllerConfiguration_To_config_ShootConditionsControllerConfiguration(a.(*ShootConditionsControllerConfiguration), b.(*config.ShootConditionsControllerConfiguration), scope)
 	}); err != nil {
@@ -242,6 +322,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootIdleHibernationControllerConfiguration)(nil), (*config.ShootIdleHibernationControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootIdleHibernationControllerConfiguration_To_config_ShootIdleHibernationControllerConfiguration(a.(*ShootIdleHibernationControllerConfiguration), b.(*config.ShootIdleHibernationControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootIdleHibernationControllerConfiguration)(nil), (*ShootIdleHibernationControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootIdleHibernationControllerConfiguration_To_v1alpha1_ShootIdleHibernationControllerConfiguration(a.(*config.ShootIdleHibernationControllerConfiguration), b.(*ShootIdleHibernationControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootMaintenanceControllerConfiguration)(nil), (*config.ShootMaintenanceControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootMaintenanceControllerConfiguration_To_config_ShootMaintenanceControllerConfiguration(a.(*ShootMaintenanceControllerConfiguration), b.(*config.ShootMaintenanceControllerConfiguration), scope)
 	}); err != nil {
@@ -292,6 +382,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TLSServer)(nil), (*config.TLSServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TLSServer_To_config_TLSServer(a.(*TLSServer), b.(*config.TLSServer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TLSServer)(nil), (*TLSServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TLSServer_To_v1alpha1_TLSServer(a.(*config.TLSServer), b.(*TLSServer), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*QuotaConfiguration)(nil), (*config.QuotaConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_QuotaConfiguration_To_config_QuotaConfiguration(a.(*QuotaConfiguration), b.(*config.QuotaConfiguration), scope)
 	}); err != nil {
@@ -499,11 +599,13 @@ func autoConvert_v1alpha1_ControllerManagerControllerConfiguration_To_config_Con
 	} else {
 		out.Project = nil
 	}
+	out.ProjectCost = (*config.ProjectCostControllerConfiguration)(unsafe.Pointer(in.ProjectCost))
 	out.Quota = (*config.QuotaControllerConfiguration)(unsafe.Pointer(in.Quota))
 	out.SecretBinding = (*config.SecretBindingControllerConfiguration)(unsafe.Pointer(in.SecretBinding))
 	out.Seed = (*config.SeedControllerConfiguration)(unsafe.Pointer(in.Seed))
 	out.SeedExtensionsCheck = (*config.SeedExtensionsCheckControllerConfiguration)(unsafe.Pointer(in.SeedExtensionsCheck))
 	out.SeedBackupBucketsCheck = (*config.SeedBackupBucketsCheckControllerConfiguration)(unsafe.Pointer(in.SeedBackupBucketsCheck))
+	out.ShootBlueprint = (*config.ShootBlueprintControllerConfiguration)(unsafe.Pointer(in.ShootBlueprint))
 	if err := Convert_v1alpha1_ShootMaintenanceControllerConfiguration_To_config_ShootMaintenanceControllerConfiguration(&in.ShootMaintenance, &out.ShootMaintenance, s); err != nil {
 		return err
 	}
@@ -511,6 +613,7 @@ func autoConvert_v1alpha1_ControllerManagerControllerConfiguration_To_config_Con
 	if err := Convert_v1alpha1_ShootHibernationControllerConfiguration_To_config_ShootHibernationControllerConfiguration(&in.ShootHibernation, &out.ShootHibernation, s); err != nil {
 		return err
 	}
+	out.ShootIdleHibernation = (*config.ShootIdleHibernationControllerConfiguration)(unsafe.Pointer(in.ShootIdleHibernation))
 	out.ShootReference = (*config.ShootReferenceControllerConfiguration)(unsafe.Pointer(in.ShootReference))
 	out.ShootRetry = (*config.ShootRetryControllerConfiguration)(unsafe.Pointer(in.ShootRetry))
 	out.ShootConditions = (*config.ShootConditionsControllerConfiguration)(unsafe.Pointer(in.ShootConditions))
@@ -541,11 +644,13 @@ func autoConvert_config_ControllerManagerControllerConfiguration_To_v1alpha1_Con
 	} else {
 		out.Project = nil
 	}
+	out.ProjectCost = (*ProjectCostControllerConfiguration)(unsafe.Pointer(in.ProjectCost))
 	out.Quota = (*QuotaControllerConfiguration)(unsafe.Pointer(in.Quota))
 	out.SecretBinding = (*SecretBindingControllerConfiguration)(unsafe.Pointer(in.SecretBinding))
 	out.Seed = (*SeedControllerConfiguration)(unsafe.Pointer(in.Seed))
 	out.SeedExtensionsCheck = (*SeedExtensionsCheckControllerConfiguration)(unsafe.Pointer(in.SeedExtensionsCheck))
 	out.SeedBackupBucketsCheck = (*SeedBackupBucketsCheckControllerConfiguration)(unsafe.Pointer(in.SeedBackupBucketsCheck))
+	out.ShootBlueprint = (*ShootBlueprintControllerConfiguration)(unsafe.Pointer(in.ShootBlueprint))
 	if err := Convert_config_ShootMaintenanceControllerConfiguration_To_v1alpha1_ShootMaintenanceControllerConfiguration(&in.ShootMaintenance, &out.ShootMaintenance, s); err != nil {
 		return err
 	}
@@ -553,6 +658,7 @@ func autoConvert_config_ControllerManagerControllerConfiguration_To_v1alpha1_Con
 	if err := Convert_config_ShootHibernationControllerConfiguration_To_v1alpha1_ShootHibernationControllerConfiguration(&in.ShootHibernation, &out.ShootHibernation, s); err != nil {
 		return err
 	}
+	out.ShootIdleHibernation = (*ShootIdleHibernationControllerConfiguration)(unsafe.Pointer(in.ShootIdleHibernation))
 	out.ShootReference = (*ShootReferenceControllerConfiguration)(unsafe.Pointer(in.ShootReference))
 	out.ShootRetry = (*ShootRetryControllerConfiguration)(unsafe.Pointer(in.ShootRetry))
 	out.ShootConditions = (*ShootConditionsControllerConfiguration)(unsafe.Pointer(in.ShootConditions))
@@ -628,6 +734,114 @@ func Convert_config_ExposureClassControllerConfiguration_To_v1alpha1_ExposureCla
 	return autoConvert_config_ExposureClassControllerConfiguration_To_v1alpha1_ExposureClassControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_HTTPSServer_To_config_HTTPSServer(in *HTTPSServer, out *config.HTTPSServer, s conversion.Scope) error {
+	if err := Convert_v1alpha1_Server_To_config_Server(&in.Server, &out.Server, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_TLSServer_To_config_TLSServer(&in.TLS, &out.TLS, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_HTTPSServer_To_config_HTTPSServer is an autogenerated conversion function.
+func Convert_v1alpha1_HTTPSServer_To_config_HTTPSServer(in *HTTPSServer, out *config.HTTPSServer, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HTTPSServer_To_config_HTTPSServer(in, out, s)
+}
+
+func autoConvert_config_HTTPSServer_To_v1alpha1_HTTPSServer(in *config.HTTPSServer, out *HTTPSServer, s conversion.Scope) error {
+	if err := Convert_config_Server_To_v1alpha1_Server(&in.Server, &out.Server, s); err != nil {
+		return err
+	}
+	if err := Convert_config_TLSServer_To_v1alpha1_TLSServer(&in.TLS, &out.TLS, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_HTTPSServer_To_v1alpha1_HTTPSServer is an autogenerated conversion function.
+func Convert_config_HTTPSServer_To_v1alpha1_HTTPSServer(in *config.HTTPSServer, out *HTTPSServer, s conversion.Scope) error {
+	return autoConvert_config_HTTPSServer_To_v1alpha1_HTTPSServer(in, out, s)
+}
+
+func autoConvert_v1alpha1_MaintenanceFreezePeriod_To_config_MaintenanceFreezePeriod(in *MaintenanceFreezePeriod, out *config.MaintenanceFreezePeriod, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Begin = (*v1.Time)(unsafe.Pointer(in.Begin))
+	out.End = (*v1.Time)(unsafe.Pointer(in.End))
+	out.Schedule = (*string)(unsafe.Pointer(in.Schedule))
+	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
+	out.Location = (*string)(unsafe.Pointer(in.Location))
+	return nil
+}
+
+// Convert_v1alpha1_MaintenanceFreezePeriod_To_config_MaintenanceFreezePeriod is an autogenerated conversion function.
+func Convert_v1alpha1_MaintenanceFreezePeriod_To_config_MaintenanceFreezePeriod(in *MaintenanceFreezePeriod, out *config.MaintenanceFreezePeriod, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MaintenanceFreezePeriod_To_config_MaintenanceFreezePeriod(in, out, s)
+}
+
+func autoConvert_config_MaintenanceFreezePeriod_To_v1alpha1_MaintenanceFreezePeriod(in *config.MaintenanceFreezePeriod, out *MaintenanceFreezePeriod, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Begin = (*v1.Time)(unsafe.Pointer(in.Begin))
+	out.End = (*v1.Time)(unsafe.Pointer(in.End))
+	out.Schedule = (*string)(unsafe.Pointer(in.Schedule))
+	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
+	out.Location = (*string)(unsafe.Pointer(in.Location))
+	return nil
+}
+
+// Convert_config_MaintenanceFreezePeriod_To_v1alpha1_MaintenanceFreezePeriod is an autogenerated conversion function.
+func Convert_config_MaintenanceFreezePeriod_To_v1alpha1_MaintenanceFreezePeriod(in *config.MaintenanceFreezePeriod, out *MaintenanceFreezePeriod, s conversion.Scope) error {
+	return autoConvert_config_MaintenanceFreezePeriod_To_v1alpha1_MaintenanceFreezePeriod(in, out, s)
+}
+
+func autoConvert_v1alpha1_MaintenanceRolloutWave_To_config_MaintenanceRolloutWave(in *MaintenanceRolloutWave, out *config.MaintenanceRolloutWave, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ShootSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	out.SoakDuration = in.SoakDuration
+	return nil
+}
+
+// Convert_v1alpha1_MaintenanceRolloutWave_To_config_MaintenanceRolloutWave is an autogenerated conversion function.
+func Convert_v1alpha1_MaintenanceRolloutWave_To_config_MaintenanceRolloutWave(in *MaintenanceRolloutWave, out *config.MaintenanceRolloutWave, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MaintenanceRolloutWave_To_config_MaintenanceRolloutWave(in, out, s)
+}
+
+func autoConvert_config_MaintenanceRolloutWave_To_v1alpha1_MaintenanceRolloutWave(in *config.MaintenanceRolloutWave, out *MaintenanceRolloutWave, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ShootSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	out.SoakDuration = in.SoakDuration
+	return nil
+}
+
+// Convert_config_MaintenanceRolloutWave_To_v1alpha1_MaintenanceRolloutWave is an autogenerated conversion function.
+func Convert_config_MaintenanceRolloutWave_To_v1alpha1_MaintenanceRolloutWave(in *config.MaintenanceRolloutWave, out *MaintenanceRolloutWave, s conversion.Scope) error {
+	return autoConvert_config_MaintenanceRolloutWave_To_v1alpha1_MaintenanceRolloutWave(in, out, s)
+}
+
+func autoConvert_v1alpha1_MaintenanceRolloutWavesConfiguration_To_config_MaintenanceRolloutWavesConfiguration(in *MaintenanceRolloutWavesConfiguration, out *config.MaintenanceRolloutWavesConfiguration, s conversion.Scope) error {
+	out.Waves = *(*[]config.MaintenanceRolloutWave)(unsafe.Pointer(&in.Waves))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_MaintenanceRolloutWavesConfiguration_To_config_MaintenanceRolloutWavesConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_MaintenanceRolloutWavesConfiguration_To_config_MaintenanceRolloutWavesConfiguration(in *MaintenanceRolloutWavesConfiguration, out *config.MaintenanceRolloutWavesConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MaintenanceRolloutWavesConfiguration_To_config_MaintenanceRolloutWavesConfiguration(in, out, s)
+}
+
+func autoConvert_config_MaintenanceRolloutWavesConfiguration_To_v1alpha1_MaintenanceRolloutWavesConfiguration(in *config.MaintenanceRolloutWavesConfiguration, out *MaintenanceRolloutWavesConfiguration, s conversion.Scope) error {
+	out.Waves = *(*[]MaintenanceRolloutWave)(unsafe.Pointer(&in.Waves))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_config_MaintenanceRolloutWavesConfiguration_To_v1alpha1_MaintenanceRolloutWavesConfiguration is an autogenerated conversion function.
+func Convert_config_MaintenanceRolloutWavesConfiguration_To_v1alpha1_MaintenanceRolloutWavesConfiguration(in *config.MaintenanceRolloutWavesConfiguration, out *MaintenanceRolloutWavesConfiguration, s conversion.Scope) error {
+	return autoConvert_config_MaintenanceRolloutWavesConfiguration_To_v1alpha1_MaintenanceRolloutWavesConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ManagedSeedSetControllerConfiguration_To_config_ManagedSeedSetControllerConfiguration(in *ManagedSeedSetControllerConfiguration, out *config.ManagedSeedSetControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.MaxShootRetries = (*int)(unsafe.Pointer(in.MaxShootRetries))
@@ -669,6 +883,9 @@ func autoConvert_v1alpha1_ProjectControllerConfiguration_To_config_ProjectContro
 	out.StaleGracePeriodDays = (*int)(unsafe.Pointer(in.StaleGracePeriodDays))
 	out.StaleExpirationTimeDays = (*int)(unsafe.Pointer(in.StaleExpirationTimeDays))
 	out.StaleSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.StaleSyncPeriod))
+	out.StaleNotifications = (*config.ProjectStaleNotificationConfiguration)(unsafe.Pointer(in.StaleNotifications))
+	out.StaleSnoozeMaxDays = (*int)(unsafe.Pointer(in.StaleSnoozeMaxDays))
+	out.DeletionRecordRetentionDays = (*int)(unsafe.Pointer(in.DeletionRecordRetentionDays))
 	return nil
 }
 
@@ -694,6 +911,9 @@ func autoConvert_config_ProjectControllerConfiguration_To_v1alpha1_ProjectContro
 	out.StaleGracePeriodDays = (*int)(unsafe.Pointer(in.StaleGracePeriodDays))
 	out.StaleExpirationTimeDays = (*int)(unsafe.Pointer(in.StaleExpirationTimeDays))
 	out.StaleSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.StaleSyncPeriod))
+	out.StaleNotifications = (*ProjectStaleNotificationConfiguration)(unsafe.Pointer(in.StaleNotifications))
+	out.StaleSnoozeMaxDays = (*int)(unsafe.Pointer(in.StaleSnoozeMaxDays))
+	out.DeletionRecordRetentionDays = (*int)(unsafe.Pointer(in.DeletionRecordRetentionDays))
 	return nil
 }
 
@@ -702,6 +922,72 @@ func Convert_config_ProjectControllerConfiguration_To_v1alpha1_ProjectController
 	return autoConvert_config_ProjectControllerConfiguration_To_v1alpha1_ProjectControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ProjectCostControllerConfiguration_To_config_ProjectCostControllerConfiguration(in *ProjectCostControllerConfiguration, out *config.ProjectCostControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_ProjectCostControllerConfiguration_To_config_ProjectCostControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ProjectCostControllerConfiguration_To_config_ProjectCostControllerConfiguration(in *ProjectCostControllerConfiguration, out *config.ProjectCostControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ProjectCostControllerConfiguration_To_config_ProjectCostControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ProjectCostControllerConfiguration_To_v1alpha1_ProjectCostControllerConfiguration(in *config.ProjectCostControllerConfiguration, out *ProjectCostControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_config_ProjectCostControllerConfiguration_To_v1alpha1_ProjectCostControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ProjectCostControllerConfiguration_To_v1alpha1_ProjectCostControllerConfiguration(in *config.ProjectCostControllerConfiguration, out *ProjectCostControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ProjectCostControllerConfiguration_To_v1alpha1_ProjectCostControllerConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_ProjectStaleNotificationConfiguration_To_config_ProjectStaleNotificationConfiguration(in *ProjectStaleNotificationConfiguration, out *config.ProjectStaleNotificationConfiguration, s conversion.Scope) error {
+	out.DaysBeforeDeletion = *(*[]int)(unsafe.Pointer(&in.DaysBeforeDeletion))
+	out.Webhook = (*config.ProjectStaleNotificationWebhook)(unsafe.Pointer(in.Webhook))
+	return nil
+}
+
+// Convert_v1alpha1_ProjectStaleNotificationConfiguration_To_config_ProjectStaleNotificationConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ProjectStaleNotificationConfiguration_To_config_ProjectStaleNotificationConfiguration(in *ProjectStaleNotificationConfiguration, out *config.ProjectStaleNotificationConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ProjectStaleNotificationConfiguration_To_config_ProjectStaleNotificationConfiguration(in, out, s)
+}
+
+func autoConvert_config_ProjectStaleNotificationConfiguration_To_v1alpha1_ProjectStaleNotificationConfiguration(in *config.ProjectStaleNotificationConfiguration, out *ProjectStaleNotificationConfiguration, s conversion.Scope) error {
+	out.DaysBeforeDeletion = *(*[]int)(unsafe.Pointer(&in.DaysBeforeDeletion))
+	out.Webhook = (*ProjectStaleNotificationWebhook)(unsafe.Pointer(in.Webhook))
+	return nil
+}
+
+// Convert_config_ProjectStaleNotificationConfiguration_To_v1alpha1_ProjectStaleNotificationConfiguration is an autogenerated conversion function.
+func Convert_config_ProjectStaleNotificationConfiguration_To_v1alpha1_ProjectStaleNotificationConfiguration(in *config.ProjectStaleNotificationConfiguration, out *ProjectStaleNotificationConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ProjectStaleNotificationConfiguration_To_v1alpha1_ProjectStaleNotificationConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_ProjectStaleNotificationWebhook_To_config_ProjectStaleNotificationWebhook(in *ProjectStaleNotificationWebhook, out *config.ProjectStaleNotificationWebhook, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1alpha1_ProjectStaleNotificationWebhook_To_config_ProjectStaleNotificationWebhook is an autogenerated conversion function.
+func Convert_v1alpha1_ProjectStaleNotificationWebhook_To_config_ProjectStaleNotificationWebhook(in *ProjectStaleNotificationWebhook, out *config.ProjectStaleNotificationWebhook, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ProjectStaleNotificationWebhook_To_config_ProjectStaleNotificationWebhook(in, out, s)
+}
+
+func autoConvert_config_ProjectStaleNotificationWebhook_To_v1alpha1_ProjectStaleNotificationWebhook(in *config.ProjectStaleNotificationWebhook, out *ProjectStaleNotificationWebhook, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_config_ProjectStaleNotificationWebhook_To_v1alpha1_ProjectStaleNotificationWebhook is an autogenerated conversion function.
+func Convert_config_ProjectStaleNotificationWebhook_To_v1alpha1_ProjectStaleNotificationWebhook(in *config.ProjectStaleNotificationWebhook, out *ProjectStaleNotificationWebhook, s conversion.Scope) error {
+	return autoConvert_config_ProjectStaleNotificationWebhook_To_v1alpha1_ProjectStaleNotificationWebhook(in, out, s)
+}
+
 func autoConvert_v1alpha1_QuotaConfiguration_To_config_QuotaConfiguration(in *QuotaConfiguration, out *config.QuotaConfiguration, s conversion.Scope) error {
 	if err := runtime.Convert_runtime_RawExtension_To_runtime_Object(&in.Config, &out.Config, s); err != nil {
 		return err
@@ -862,6 +1148,7 @@ func Convert_config_Server_To_v1alpha1_Server(in *config.Server, out *Server, s
 func autoConvert_v1alpha1_ServerConfiguration_To_config_ServerConfiguration(in *ServerConfiguration, out *config.ServerConfiguration, s conversion.Scope) error {
 	out.HealthProbes = (*config.Server)(unsafe.Pointer(in.HealthProbes))
 	out.Metrics = (*config.Server)(unsafe.Pointer(in.Metrics))
+	out.ShootWakeUp = (*config.HTTPSServer)(unsafe.Pointer(in.ShootWakeUp))
 	return nil
 }
 
@@ -873,6 +1160,7 @@ func Convert_v1alpha1_ServerConfiguration_To_config_ServerConfiguration(in *Serv
 func autoConvert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in *config.ServerConfiguration, out *ServerConfiguration, s conversion.Scope) error {
 	out.HealthProbes = (*Server)(unsafe.Pointer(in.HealthProbes))
 	out.Metrics = (*Server)(unsafe.Pointer(in.Metrics))
+	out.ShootWakeUp = (*HTTPSServer)(unsafe.Pointer(in.ShootWakeUp))
 	return nil
 }
 
@@ -881,6 +1169,26 @@ func Convert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in *conf
 	return autoConvert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootBlueprintControllerConfiguration_To_config_ShootBlueprintControllerConfiguration(in *ShootBlueprintControllerConfiguration, out *config.ShootBlueprintControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_v1alpha1_ShootBlueprintControllerConfiguration_To_config_ShootBlueprintControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootBlueprintControllerConfiguration_To_config_ShootBlueprintControllerConfiguration(in *ShootBlueprintControllerConfiguration, out *config.ShootBlueprintControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootBlueprintControllerConfiguration_To_config_ShootBlueprintControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShootBlueprintControllerConfiguration_To_v1alpha1_ShootBlueprintControllerConfiguration(in *config.ShootBlueprintControllerConfiguration, out *ShootBlueprintControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_config_ShootBlueprintControllerConfiguration_To_v1alpha1_ShootBlueprintControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ShootBlueprintControllerConfiguration_To_v1alpha1_ShootBlueprintControllerConfiguration(in *config.ShootBlueprintControllerConfiguration, out *ShootBlueprintControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShootBlueprintControllerConfiguration_To_v1alpha1_ShootBlueprintControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootConditionsControllerConfiguration_To_config_ShootConditionsControllerConfiguration(in *ShootConditionsControllerConfiguration, out *config.ShootConditionsControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	return nil
@@ -923,10 +1231,34 @@ func Convert_config_ShootHibernationControllerConfiguration_To_v1alpha1_ShootHib
 	return autoConvert_config_ShootHibernationControllerConfiguration_To_v1alpha1_ShootHibernationControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootIdleHibernationControllerConfiguration_To_config_ShootIdleHibernationControllerConfiguration(in *ShootIdleHibernationControllerConfiguration, out *config.ShootIdleHibernationControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.WarningPeriod = (*v1.Duration)(unsafe.Pointer(in.WarningPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_ShootIdleHibernationControllerConfiguration_To_config_ShootIdleHibernationControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootIdleHibernationControllerConfiguration_To_config_ShootIdleHibernationControllerConfiguration(in *ShootIdleHibernationControllerConfiguration, out *config.ShootIdleHibernationControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootIdleHibernationControllerConfiguration_To_config_ShootIdleHibernationControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShootIdleHibernationControllerConfiguration_To_v1alpha1_ShootIdleHibernationControllerConfiguration(in *config.ShootIdleHibernationControllerConfiguration, out *ShootIdleHibernationControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.WarningPeriod = (*v1.Duration)(unsafe.Pointer(in.WarningPeriod))
+	return nil
+}
+
+// Convert_config_ShootIdleHibernationControllerConfiguration_To_v1alpha1_ShootIdleHibernationControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ShootIdleHibernationControllerConfiguration_To_v1alpha1_ShootIdleHibernationControllerConfiguration(in *config.ShootIdleHibernationControllerConfiguration, out *ShootIdleHibernationControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShootIdleHibernationControllerConfiguration_To_v1alpha1_ShootIdleHibernationControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootMaintenanceControllerConfiguration_To_config_ShootMaintenanceControllerConfiguration(in *ShootMaintenanceControllerConfiguration, out *config.ShootMaintenanceControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.EnableShootControlPlaneRestarter = (*bool)(unsafe.Pointer(in.EnableShootControlPlaneRestarter))
 	out.EnableShootCoreAddonRestarter = (*bool)(unsafe.Pointer(in.EnableShootCoreAddonRestarter))
+	out.FreezePeriods = *(*[]config.MaintenanceFreezePeriod)(unsafe.Pointer(&in.FreezePeriods))
+	out.RolloutWaves = (*config.MaintenanceRolloutWavesConfiguration)(unsafe.Pointer(in.RolloutWaves))
 	return nil
 }
 
@@ -939,6 +1271,8 @@ func autoConvert_config_ShootMaintenanceControllerConfiguration_To_v1alpha1_Shoo
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.EnableShootControlPlaneRestarter = (*bool)(unsafe.Pointer(in.EnableShootControlPlaneRestarter))
 	out.EnableShootCoreAddonRestarter = (*bool)(unsafe.Pointer(in.EnableShootCoreAddonRestarter))
+	out.FreezePeriods = *(*[]MaintenanceFreezePeriod)(unsafe.Pointer(&in.FreezePeriods))
+	out.RolloutWaves = (*MaintenanceRolloutWavesConfiguration)(unsafe.Pointer(in.RolloutWaves))
 	return nil
 }
 
@@ -1032,3 +1366,23 @@ func autoConvert_config_ShootStatusLabelControllerConfiguration_To_v1alpha1_Shoo
 func Convert_config_ShootStatusLabelControllerConfiguration_To_v1alpha1_ShootStatusLabelControllerConfiguration(in *config.ShootStatusLabelControllerConfiguration, out *ShootStatusLabelControllerConfiguration, s conversion.Scope) error {
 	return autoConvert_config_ShootStatusLabelControllerConfiguration_To_v1alpha1_ShootStatusLabelControllerConfiguration(in, out, s)
 }
+
+func autoConvert_v1alpha1_TLSServer_To_config_TLSServer(in *TLSServer, out *config.TLSServer, s conversion.Scope) error {
+	out.ServerCertDir = in.ServerCertDir
+	return nil
+}
+
+// Convert_v1alpha1_TLSServer_To_config_TLSServer is an autogenerated conversion function.
+func Convert_v1alpha1_TLSServer_To_config_TLSServer(in *TLSServer, out *config.TLSServer, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TLSServer_To_config_TLSServer(in, out, s)
+}
+
+func autoConvert_config_TLSServer_To_v1alpha1_TLSServer(in *config.TLSServer, out *TLSServer, s conversion.Scope) error {
+	out.ServerCertDir = in.ServerCertDir
+	return nil
+}
+
+// Convert_config_TLSServer_To_v1alpha1_TLSServer is an autogenerated conversion function.
+func Convert_config_TLSServer_To_v1alpha1_TLSServer(in *config.TLSServer, out *TLSServer, s conversion.Scope) error {
+	return autoConvert_config_TLSServer_To_v1alpha1_TLSServer(in, out, s)
+}
@@ -209,6 +209,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(ProjectControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ProjectCost != nil {
+		in, out := &in.ProjectCost, &out.ProjectCost
+		*out = new(ProjectCostControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Quota != nil {
 		in, out := &in.Quota, &out.Quota
 		*out = new(QuotaControllerConfiguration)
@@ -234,6 +239,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(SeedBackupBucketsCheckControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootBlueprint != nil {
+		in, out := &in.ShootBlueprint, &out.ShootBlueprint
+		*out = new(ShootBlueprintControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	in.ShootMaintenance.DeepCopyInto(&out.ShootMaintenance)
 	if in.ShootQuota != nil {
 		in, out := &in.ShootQuota, &out.ShootQuota
@@ -241,6 +251,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		(*in).DeepCopyInto(*out)
 	}
 	in.ShootHibernation.DeepCopyInto(&out.ShootHibernation)
+	if in.ShootIdleHibernation != nil {
+		in, out := &in.ShootIdleHibernation, &out.ShootIdleHibernation
+		*out = new(ShootIdleHibernationControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ShootReference != nil {
 		in, out := &in.ShootReference, &out.ShootReference
 		*out = new(ShootReferenceControllerConfiguration)
@@ -347,6 +362,118 @@ func (in *ExposureClassControllerConfiguration) DeepCopy() *ExposureClassControl
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPSServer) DeepCopyInto(out *HTTPSServer) {
+	*out = *in
+	out.Server = in.Server
+	out.TLS = in.TLS
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPSServer.
+func (in *HTTPSServer) DeepCopy() *HTTPSServer {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPSServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceFreezePeriod) DeepCopyInto(out *MaintenanceFreezePeriod) {
+	*out = *in
+	if in.Begin != nil {
+		in, out := &in.Begin, &out.Begin
+		*out = (*in).DeepCopy()
+	}
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = (*in).DeepCopy()
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(string)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceFreezePeriod.
+func (in *MaintenanceFreezePeriod) DeepCopy() *MaintenanceFreezePeriod {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceFreezePeriod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceRolloutWave) DeepCopyInto(out *MaintenanceRolloutWave) {
+	*out = *in
+	if in.ShootSelector != nil {
+		in, out := &in.ShootSelector, &out.ShootSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectSelector != nil {
+		in, out := &in.ProjectSelector, &out.ProjectSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	out.SoakDuration = in.SoakDuration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceRolloutWave.
+func (in *MaintenanceRolloutWave) DeepCopy() *MaintenanceRolloutWave {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceRolloutWave)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceRolloutWavesConfiguration) DeepCopyInto(out *MaintenanceRolloutWavesConfiguration) {
+	*out = *in
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]MaintenanceRolloutWave, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceRolloutWavesConfiguration.
+func (in *MaintenanceRolloutWavesConfiguration) DeepCopy() *MaintenanceRolloutWavesConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceRolloutWavesConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedSeedSetControllerConfiguration) DeepCopyInto(out *ManagedSeedSetControllerConfiguration) {
 	*out = *in
@@ -409,6 +536,21 @@ func (in *ProjectControllerConfiguration) DeepCopyInto(out *ProjectControllerCon
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.StaleNotifications != nil {
+		in, out := &in.StaleNotifications, &out.StaleNotifications
+		*out = new(ProjectStaleNotificationConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StaleSnoozeMaxDays != nil {
+		in, out := &in.StaleSnoozeMaxDays, &out.StaleSnoozeMaxDays
+		*out = new(int)
+		**out = **in
+	}
+	if in.DeletionRecordRetentionDays != nil {
+		in, out := &in.DeletionRecordRetentionDays, &out.DeletionRecordRetentionDays
+		*out = new(int)
+		**out = **in
+	}
 	return
 }
 
@@ -422,6 +564,79 @@ func (in *ProjectControllerConfiguration) DeepCopy() *ProjectControllerConfigura
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectCostControllerConfiguration) DeepCopyInto(out *ProjectCostControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectCostControllerConfiguration.
+func (in *ProjectCostControllerConfiguration) DeepCopy() *ProjectCostControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectCostControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectStaleNotificationConfiguration) DeepCopyInto(out *ProjectStaleNotificationConfiguration) {
+	*out = *in
+	if in.DaysBeforeDeletion != nil {
+		in, out := &in.DaysBeforeDeletion, &out.DaysBeforeDeletion
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(ProjectStaleNotificationWebhook)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStaleNotificationConfiguration.
+func (in *ProjectStaleNotificationConfiguration) DeepCopy() *ProjectStaleNotificationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectStaleNotificationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectStaleNotificationWebhook) DeepCopyInto(out *ProjectStaleNotificationWebhook) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStaleNotificationWebhook.
+func (in *ProjectStaleNotificationWebhook) DeepCopy() *ProjectStaleNotificationWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectStaleNotificationWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QuotaConfiguration) DeepCopyInto(out *QuotaConfiguration) {
 	*out = *in
@@ -613,6 +828,11 @@ func (in *ServerConfiguration) DeepCopyInto(out *ServerConfiguration) {
 		*out = new(Server)
 		**out = **in
 	}
+	if in.ShootWakeUp != nil {
+		in, out := &in.ShootWakeUp, &out.ShootWakeUp
+		*out = new(HTTPSServer)
+		**out = **in
+	}
 	return
 }
 
@@ -626,6 +846,27 @@ func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootBlueprintControllerConfiguration) DeepCopyInto(out *ShootBlueprintControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootBlueprintControllerConfiguration.
+func (in *ShootBlueprintControllerConfiguration) DeepCopy() *ShootBlueprintControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootBlueprintControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootConditionsControllerConfiguration) DeepCopyInto(out *ShootConditionsControllerConfiguration) {
 	*out = *in
@@ -673,6 +914,32 @@ func (in *ShootHibernationControllerConfiguration) DeepCopy() *ShootHibernationC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootIdleHibernationControllerConfiguration) DeepCopyInto(out *ShootIdleHibernationControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.WarningPeriod != nil {
+		in, out := &in.WarningPeriod, &out.WarningPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootIdleHibernationControllerConfiguration.
+func (in *ShootIdleHibernationControllerConfiguration) DeepCopy() *ShootIdleHibernationControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootIdleHibernationControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootMaintenanceControllerConfiguration) DeepCopyInto(out *ShootMaintenanceControllerConfiguration) {
 	*out = *in
@@ -691,6 +958,18 @@ func (in *ShootMaintenanceControllerConfiguration) DeepCopyInto(out *ShootMainte
 		*out = new(bool)
 		**out = **in
 	}
+	if in.FreezePeriods != nil {
+		in, out := &in.FreezePeriods, &out.FreezePeriods
+		*out = make([]MaintenanceFreezePeriod, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RolloutWaves != nil {
+		in, out := &in.RolloutWaves, &out.RolloutWaves
+		*out = new(MaintenanceRolloutWavesConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -802,3 +1081,19 @@ func (in *ShootStatusLabelControllerConfiguration) DeepCopy() *ShootStatusLabelC
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSServer) DeepCopyInto(out *TLSServer) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSServer.
+func (in *TLSServer) DeepCopy() *TLSServer {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSServer)
+	in.DeepCopyInto(out)
+	return out
+}
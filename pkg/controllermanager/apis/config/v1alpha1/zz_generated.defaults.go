@@ -50,6 +50,15 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	}
 	if in.Controllers.Project != nil {
 		SetDefaults_ProjectControllerConfiguration(in.Controllers.Project)
+		if in.Controllers.Project.StaleNotifications != nil {
+			SetDefaults_ProjectStaleNotificationConfiguration(in.Controllers.Project.StaleNotifications)
+			if in.Controllers.Project.StaleNotifications.Webhook != nil {
+				SetDefaults_ProjectStaleNotificationWebhook(in.Controllers.Project.StaleNotifications.Webhook)
+			}
+		}
+	}
+	if in.Controllers.ProjectCost != nil {
+		SetDefaults_ProjectCostControllerConfiguration(in.Controllers.ProjectCost)
 	}
 	if in.Controllers.Quota != nil {
 		SetDefaults_QuotaControllerConfiguration(in.Controllers.Quota)
@@ -66,11 +75,20 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.SeedBackupBucketsCheck != nil {
 		SetDefaults_SeedBackupBucketsCheckControllerConfiguration(in.Controllers.SeedBackupBucketsCheck)
 	}
+	if in.Controllers.ShootBlueprint != nil {
+		SetDefaults_ShootBlueprintControllerConfiguration(in.Controllers.ShootBlueprint)
+	}
 	SetDefaults_ShootMaintenanceControllerConfiguration(&in.Controllers.ShootMaintenance)
+	if in.Controllers.ShootMaintenance.RolloutWaves != nil {
+		SetDefaults_MaintenanceRolloutWavesConfiguration(in.Controllers.ShootMaintenance.RolloutWaves)
+	}
 	if in.Controllers.ShootQuota != nil {
 		SetDefaults_ShootQuotaControllerConfiguration(in.Controllers.ShootQuota)
 	}
 	SetDefaults_ShootHibernationControllerConfiguration(&in.Controllers.ShootHibernation)
+	if in.Controllers.ShootIdleHibernation != nil {
+		SetDefaults_ShootIdleHibernationControllerConfiguration(in.Controllers.ShootIdleHibernation)
+	}
 	if in.Controllers.ShootReference != nil {
 		SetDefaults_ShootReferenceControllerConfiguration(in.Controllers.ShootReference)
 	}
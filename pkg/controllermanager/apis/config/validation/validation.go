@@ -5,8 +5,14 @@
 package validation
 
 import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/robfig/cron"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
@@ -30,6 +36,31 @@ func ValidateControllerManagerConfiguration(conf *config.ControllerManagerConfig
 	}
 
 	allErrs = append(allErrs, validateControllerManagerControllerConfiguration(conf.Controllers, field.NewPath("controllers"))...)
+	allErrs = append(allErrs, validateServerConfiguration(conf.Server, field.NewPath("server"))...)
+	return allErrs
+}
+
+func validateServerConfiguration(conf config.ServerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.ShootWakeUp != nil {
+		shootWakeUpPath := fldPath.Child("shootWakeUp")
+
+		for _, msg := range validation.IsValidPortNum(conf.ShootWakeUp.Port) {
+			allErrs = append(allErrs, field.Invalid(shootWakeUpPath.Child("port"), conf.ShootWakeUp.Port, msg))
+		}
+		if conf.HealthProbes != nil && conf.HealthProbes.Port == conf.ShootWakeUp.Port {
+			allErrs = append(allErrs, field.Invalid(shootWakeUpPath.Child("port"), conf.ShootWakeUp.Port, "must not be the same as the port of the health probes server"))
+		}
+		if conf.Metrics != nil && conf.Metrics.Port == conf.ShootWakeUp.Port {
+			allErrs = append(allErrs, field.Invalid(shootWakeUpPath.Child("port"), conf.ShootWakeUp.Port, "must not be the same as the port of the metrics server"))
+		}
+
+		if len(conf.ShootWakeUp.TLS.ServerCertDir) == 0 {
+			allErrs = append(allErrs, field.Required(shootWakeUpPath.Child("tls", "serverCertDir"), "must provide the directory containing the server certificate"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -41,6 +72,11 @@ func validateControllerManagerControllerConfiguration(conf config.ControllerMana
 		allErrs = append(allErrs, validateProjectControllerConfiguration(conf.Project, projectFldPath)...)
 	}
 
+	allErrs = append(allErrs, ValidateMaintenanceFreezePeriods(conf.ShootMaintenance.FreezePeriods, fldPath.Child("shootMaintenance", "freezePeriods"))...)
+	if conf.ShootMaintenance.RolloutWaves != nil {
+		allErrs = append(allErrs, validateMaintenanceRolloutWavesConfiguration(conf.ShootMaintenance.RolloutWaves, fldPath.Child("shootMaintenance", "rolloutWaves"))...)
+	}
+
 	return allErrs
 }
 
@@ -49,6 +85,43 @@ func validateProjectControllerConfiguration(conf *config.ProjectControllerConfig
 	for i, quotaConfig := range conf.Quotas {
 		allErrs = append(allErrs, validateProjectQuotaConfiguration(quotaConfig, fldPath.Child("quotas").Index(i))...)
 	}
+	if conf.StaleNotifications != nil {
+		allErrs = append(allErrs, validateProjectStaleNotificationConfiguration(conf.StaleNotifications, fldPath.Child("staleNotifications"))...)
+	}
+	if conf.StaleSnoozeMaxDays != nil && *conf.StaleSnoozeMaxDays <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("staleSnoozeMaxDays"), *conf.StaleSnoozeMaxDays, "must be positive"))
+	}
+	if conf.DeletionRecordRetentionDays != nil && *conf.DeletionRecordRetentionDays <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("deletionRecordRetentionDays"), *conf.DeletionRecordRetentionDays, "must be positive"))
+	}
+	return allErrs
+}
+
+func validateProjectStaleNotificationConfiguration(conf *config.ProjectStaleNotificationConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	days := sets.New[int]()
+	for i, d := range conf.DaysBeforeDeletion {
+		idxPath := fldPath.Child("daysBeforeDeletion").Index(i)
+		if d <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath, d, "must be positive"))
+		}
+		if days.Has(d) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, d))
+		}
+		days.Insert(d)
+	}
+
+	if conf.Webhook != nil {
+		webhookPath := fldPath.Child("webhook")
+		if u, err := url.Parse(conf.Webhook.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(webhookPath.Child("url"), conf.Webhook.URL, "must be a valid http or https URL"))
+		}
+		if conf.Webhook.Timeout != nil && conf.Webhook.Timeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(webhookPath.Child("timeout"), conf.Webhook.Timeout.Duration.String(), "must be positive"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -63,3 +136,101 @@ func validateProjectQuotaConfiguration(conf config.QuotaConfiguration, fldPath *
 
 	return allErrs
 }
+
+// ValidateMaintenanceFreezePeriods validates the given list of `MaintenanceFreezePeriod`s.
+func ValidateMaintenanceFreezePeriods(periods []config.MaintenanceFreezePeriod, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	for i, period := range periods {
+		idxPath := fldPath.Index(i)
+
+		if period.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if names.Has(period.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), period.Name))
+		}
+		names.Insert(period.Name)
+
+		isAbsolute := period.Begin != nil || period.End != nil
+		isRecurring := period.Schedule != nil || period.Duration != nil || period.Location != nil
+
+		switch {
+		case isAbsolute && isRecurring:
+			allErrs = append(allErrs, field.Forbidden(idxPath, "must either specify begin and end or schedule and duration, not both"))
+		case isAbsolute:
+			if period.Begin == nil {
+				allErrs = append(allErrs, field.Required(idxPath.Child("begin"), "must provide the beginning of the freeze period"))
+			}
+			if period.End == nil {
+				allErrs = append(allErrs, field.Required(idxPath.Child("end"), "must provide the end of the freeze period"))
+			}
+			if period.Begin != nil && period.End != nil && !period.End.After(period.Begin.Time) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("end"), period.End, "end must be after begin"))
+			}
+		case isRecurring:
+			if period.Schedule == nil {
+				allErrs = append(allErrs, field.Required(idxPath.Child("schedule"), "must provide the schedule of the freeze period"))
+			} else if _, err := cron.ParseStandard(*period.Schedule); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("schedule"), *period.Schedule, err.Error()))
+			}
+			if period.Duration == nil {
+				allErrs = append(allErrs, field.Required(idxPath.Child("duration"), "must provide the duration of the freeze period"))
+			} else if period.Duration.Duration <= 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("duration"), period.Duration.Duration.String(), "duration must be positive"))
+			}
+			if period.Location != nil {
+				if _, err := time.LoadLocation(*period.Location); err != nil {
+					allErrs = append(allErrs, field.Invalid(idxPath.Child("location"), *period.Location, err.Error()))
+				}
+			}
+		default:
+			allErrs = append(allErrs, field.Required(idxPath, "must either specify begin and end or schedule and duration"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateMaintenanceRolloutWavesConfiguration(conf *config.MaintenanceRolloutWavesConfiguration, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	if len(conf.Waves) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("waves"), "must provide at least one rollout wave"))
+	}
+
+	for i, wave := range conf.Waves {
+		idxPath := fldPath.Child("waves").Index(i)
+
+		switch {
+		case wave.Name == "":
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		case wave.Name == config.RemainingShootsRolloutWave:
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("name"), fmt.Sprintf("name %q is reserved for the Shoots not matching any wave", config.RemainingShootsRolloutWave)))
+		case names.Has(wave.Name):
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), wave.Name))
+		}
+		names.Insert(wave.Name)
+
+		if wave.ShootSelector == nil && wave.ProjectSelector == nil {
+			allErrs = append(allErrs, field.Required(idxPath, "must provide a shoot or project selector"))
+		}
+		if wave.ShootSelector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(wave.ShootSelector, metav1validation.LabelSelectorValidationOptions{}, idxPath.Child("shootSelector"))...)
+		}
+		if wave.ProjectSelector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(wave.ProjectSelector, metav1validation.LabelSelectorValidationOptions{}, idxPath.Child("projectSelector"))...)
+		}
+
+		if wave.SoakDuration.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("soakDuration"), wave.SoakDuration.Duration.String(), "soak duration must be positive"))
+		}
+	}
+
+	return allErrs
+}
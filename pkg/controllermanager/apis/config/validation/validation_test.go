@@ -5,12 +5,15 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	. "github.com/gardener/gardener/pkg/controllermanager/apis/config/validation"
@@ -104,5 +107,260 @@ var _ = Describe("#ValidateControllerManagerConfiguration", func() {
 				))
 			})
 		})
+
+		Context("ProjectStaleNotificationConfiguration", func() {
+			BeforeEach(func() {
+				conf.Controllers.Project = &config.ProjectControllerConfiguration{
+					StaleNotifications: &config.ProjectStaleNotificationConfiguration{
+						DaysBeforeDeletion: []int{7, 1},
+						Webhook: &config.ProjectStaleNotificationWebhook{
+							URL:     "https://example.com/notify",
+							Timeout: &metav1.Duration{Duration: 10 * time.Second},
+						},
+					},
+				}
+			})
+
+			It("should pass for a valid configuration", func() {
+				Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+			})
+
+			It("should fail for an invalid configuration", func() {
+				conf.Controllers.Project.StaleNotifications.DaysBeforeDeletion = []int{7, 0, 7}
+				conf.Controllers.Project.StaleNotifications.Webhook.URL = "example.com"
+				conf.Controllers.Project.StaleNotifications.Webhook.Timeout = &metav1.Duration{}
+
+				Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.project.staleNotifications.daysBeforeDeletion[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("controllers.project.staleNotifications.daysBeforeDeletion[2]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.project.staleNotifications.webhook.url"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.project.staleNotifications.webhook.timeout"),
+					})),
+				))
+			})
+		})
+
+		Context("stale snooze and deletion records", func() {
+			BeforeEach(func() {
+				conf.Controllers.Project = &config.ProjectControllerConfiguration{
+					StaleSnoozeMaxDays:          ptr.To(90),
+					DeletionRecordRetentionDays: ptr.To(365),
+				}
+			})
+
+			It("should pass for a valid configuration", func() {
+				Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+			})
+
+			It("should fail for non-positive values", func() {
+				conf.Controllers.Project.StaleSnoozeMaxDays = ptr.To(0)
+				conf.Controllers.Project.DeletionRecordRetentionDays = ptr.To(-1)
+
+				Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.project.staleSnoozeMaxDays"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.project.deletionRecordRetentionDays"),
+					})),
+				))
+			})
+		})
+	})
+
+	Context("ShootMaintenanceControllerConfiguration", func() {
+		var (
+			begin = metav1.Date(2024, 3, 25, 0, 0, 0, 0, time.UTC)
+			end   = metav1.Date(2024, 4, 2, 0, 0, 0, 0, time.UTC)
+		)
+
+		It("should pass for valid freeze periods", func() {
+			conf.Controllers.ShootMaintenance.FreezePeriods = []config.MaintenanceFreezePeriod{
+				{Name: "end-of-quarter", Begin: &begin, End: &end},
+				{Name: "weekend", Schedule: ptr.To("0 0 * * 6"), Duration: &metav1.Duration{Duration: 48 * time.Hour}, Location: ptr.To("Europe/Berlin")},
+			}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail for invalid freeze periods", func() {
+			conf.Controllers.ShootMaintenance.FreezePeriods = []config.MaintenanceFreezePeriod{
+				{Begin: &end, End: &begin},
+				{Name: "foo", Begin: &begin},
+				{Name: "foo", Schedule: ptr.To("invalid"), Duration: &metav1.Duration{}, Location: ptr.To("invalid")},
+				{Name: "bar", Begin: &begin, End: &end, Schedule: ptr.To("0 0 * * 6")},
+				{Name: "baz"},
+			}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.shootMaintenance.freezePeriods[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootMaintenance.freezePeriods[0].end"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.shootMaintenance.freezePeriods[1].end"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("controllers.shootMaintenance.freezePeriods[2].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootMaintenance.freezePeriods[2].schedule"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootMaintenance.freezePeriods[2].duration"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootMaintenance.freezePeriods[2].location"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("controllers.shootMaintenance.freezePeriods[3]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.shootMaintenance.freezePeriods[4]"),
+				})),
+			))
+		})
+
+		It("should pass for valid rollout waves", func() {
+			conf.Controllers.ShootMaintenance.RolloutWaves = &config.MaintenanceRolloutWavesConfiguration{
+				Waves: []config.MaintenanceRolloutWave{
+					{Name: "canary", ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}}, SoakDuration: metav1.Duration{Duration: 24 * time.Hour}},
+					{Name: "internal", ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"internal": "true"}}, SoakDuration: metav1.Duration{Duration: 48 * time.Hour}},
+				},
+			}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail if no rollout waves are configured", func() {
+			conf.Controllers.ShootMaintenance.RolloutWaves = &config.MaintenanceRolloutWavesConfiguration{}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.shootMaintenance.rolloutWaves.waves"),
+				})),
+			))
+		})
+
+		It("should fail for invalid rollout waves", func() {
+			conf.Controllers.ShootMaintenance.RolloutWaves = &config.MaintenanceRolloutWavesConfiguration{
+				Waves: []config.MaintenanceRolloutWave{
+					{ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"-": "-"}}, SoakDuration: metav1.Duration{Duration: time.Hour}},
+					{Name: "remaining", ProjectSelector: &metav1.LabelSelector{}, SoakDuration: metav1.Duration{Duration: time.Hour}},
+					{Name: "foo", ShootSelector: &metav1.LabelSelector{}, SoakDuration: metav1.Duration{Duration: time.Hour}},
+					{Name: "foo"},
+				},
+			}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.shootMaintenance.rolloutWaves.waves[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootMaintenance.rolloutWaves.waves[0].shootSelector.matchLabels"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootMaintenance.rolloutWaves.waves[0].shootSelector.matchLabels"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("controllers.shootMaintenance.rolloutWaves.waves[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("controllers.shootMaintenance.rolloutWaves.waves[3].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.shootMaintenance.rolloutWaves.waves[3]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootMaintenance.rolloutWaves.waves[3].soakDuration"),
+				})),
+			))
+		})
+	})
+
+	Context("ServerConfiguration", func() {
+		BeforeEach(func() {
+			conf.Server = config.ServerConfiguration{
+				HealthProbes: &config.Server{Port: 2718},
+				Metrics:      &config.Server{Port: 2719},
+			}
+		})
+
+		It("should pass because the shoot wake-up server is not configured", func() {
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should pass because the shoot wake-up server is configured correctly", func() {
+			conf.Server.ShootWakeUp = &config.HTTPSServer{
+				Server: config.Server{Port: 2720},
+				TLS:    config.TLSServer{ServerCertDir: "/etc/gardener-controller-manager/srv"},
+			}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail because the shoot wake-up server has an invalid port and no certificate directory", func() {
+			conf.Server.ShootWakeUp = &config.HTTPSServer{
+				Server: config.Server{Port: 70000},
+			}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("server.shootWakeUp.port"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("server.shootWakeUp.tls.serverCertDir"),
+				})),
+			))
+		})
+
+		It("should fail because the shoot wake-up server uses the port of another server", func() {
+			conf.Server.ShootWakeUp = &config.HTTPSServer{
+				Server: config.Server{Port: 2719},
+				TLS:    config.TLSServer{ServerCertDir: "/etc/gardener-controller-manager/srv"},
+			}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("server.shootWakeUp.port"),
+					"Detail": Equal("must not be the same as the port of the metrics server"),
+				})),
+			))
+		})
 	})
 })
@@ -25,6 +25,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/controller/secretbinding"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shootblueprint"
 )
 
 // AddToManager adds all controller-manager controllers to the given manager.
@@ -89,7 +90,7 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg *config.Controll
 
 	if err := (&quota.Reconciler{
 		Config: *cfg.Controllers.Quota,
-	}).AddToManager(mgr); err != nil {
+	}).AddToManager(ctx, mgr); err != nil {
 		return fmt.Errorf("failed adding Quota controller: %w", err)
 	}
 
@@ -107,5 +108,11 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg *config.Controll
 		return fmt.Errorf("failed adding Shoot controller: %w", err)
 	}
 
+	if err := (&shootblueprint.Reconciler{
+		Config: *cfg.Controllers.ShootBlueprint,
+	}).AddToManager(ctx, mgr); err != nil {
+		return fmt.Errorf("failed adding ShootBlueprint controller: %w", err)
+	}
+
 	return nil
 }
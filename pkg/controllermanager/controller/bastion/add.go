@@ -38,11 +38,14 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) erro
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor(ControllerName + "-controller")
+	}
 
 	c, err := builder.
 		ControllerManagedBy(mgr).
 		Named(ControllerName).
-		For(&operationsv1alpha1.Bastion{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		For(&operationsv1alpha1.Bastion{}, builder.WithPredicates(r.BastionPredicate())).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
 		}).
@@ -51,10 +54,43 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) erro
 		return err
 	}
 
-	return c.Watch(
+	if err := c.Watch(
 		source.Kind(mgr.GetCache(), &gardencorev1beta1.Shoot{}),
 		mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapShootToBastions), mapper.UpdateWithNew, c.GetLogger()),
 		r.ShootPredicate(),
+	); err != nil {
+		return err
+	}
+
+	return c.Watch(
+		source.Kind(mgr.GetCache(), &operationsv1alpha1.BastionPolicy{}),
+		mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapBastionPolicyToBastions), mapper.UpdateWithNew, c.GetLogger()),
+		predicate.GenerationChangedPredicate{},
+	)
+}
+
+// BastionPredicate returns the predicate for Bastion events. Besides generation changes, Bastions are reconciled when
+// their heartbeat changes so that the extension of their lifetime can be audited.
+func (r *Reconciler) BastionPredicate() predicate.Predicate {
+	return predicate.Or(
+		predicate.GenerationChangedPredicate{},
+		predicate.Funcs{
+			CreateFunc:  func(_ event.CreateEvent) bool { return false },
+			DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
+			GenericFunc: func(_ event.GenericEvent) bool { return false },
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				oldBastion, ok := e.ObjectOld.(*operationsv1alpha1.Bastion)
+				if !ok {
+					return false
+				}
+				newBastion, ok := e.ObjectNew.(*operationsv1alpha1.Bastion)
+				if !ok {
+					return false
+				}
+
+				return !apiequality.Semantic.DeepEqual(oldBastion.Status.LastHeartbeatTimestamp, newBastion.Status.LastHeartbeatTimestamp)
+			},
+		},
 	)
 }
 
@@ -101,3 +137,15 @@ func (r *Reconciler) MapShootToBastions(ctx context.Context, log logr.Logger, re
 
 	return mapper.ObjectListToRequests(bastionList)
 }
+
+// MapBastionPolicyToBastions is a mapper.MapFunc for mapping BastionPolicies to all Bastions since the policy might
+// change their maximum lifetime.
+func (r *Reconciler) MapBastionPolicyToBastions(ctx context.Context, log logr.Logger, reader client.Reader, obj client.Object) []reconcile.Request {
+	bastionList := &operationsv1alpha1.BastionList{}
+	if err := reader.List(ctx, bastionList); err != nil {
+		log.Error(err, "Failed to list Bastions for BastionPolicy", "bastionPolicy", client.ObjectKeyFromObject(obj))
+		return nil
+	}
+
+	return mapper.ObjectListToRequests(bastionList)
+}
@@ -6,6 +6,7 @@ package bastion_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
@@ -133,6 +134,46 @@ var _ = Describe("Add", func() {
 		})
 	})
 
+	Describe("BastionPredicate", func() {
+		var (
+			p   predicate.Predicate
+			obj *operationsv1alpha1.Bastion
+		)
+
+		BeforeEach(func() {
+			p = reconciler.BastionPredicate()
+			obj = &operationsv1alpha1.Bastion{}
+		})
+
+		It("should return true for create, delete and generic events", func() {
+			Expect(p.Create(event.CreateEvent{Object: obj})).To(BeTrue())
+			Expect(p.Delete(event.DeleteEvent{Object: obj})).To(BeTrue())
+			Expect(p.Generic(event.GenericEvent{Object: obj})).To(BeTrue())
+		})
+
+		Describe("#Update", func() {
+			var objNew *operationsv1alpha1.Bastion
+
+			BeforeEach(func() {
+				objNew = obj.DeepCopy()
+			})
+
+			It("should return false if neither generation nor heartbeat changed", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectOld: obj, ObjectNew: objNew})).To(BeFalse())
+			})
+
+			It("should return true if the generation changed", func() {
+				objNew.Generation++
+				Expect(p.Update(event.UpdateEvent{ObjectOld: obj, ObjectNew: objNew})).To(BeTrue())
+			})
+
+			It("should return true if the heartbeat changed", func() {
+				objNew.Status.LastHeartbeatTimestamp = &metav1.Time{Time: time.Now()}
+				Expect(p.Update(event.UpdateEvent{ObjectOld: obj, ObjectNew: objNew})).To(BeTrue())
+			})
+		})
+	})
+
 	Describe("MapShootToBastions", func() {
 		var (
 			ctx        = context.TODO()
@@ -207,4 +248,32 @@ var _ = Describe("Add", func() {
 			))
 		})
 	})
+
+	Describe("MapBastionPolicyToBastions", func() {
+		var (
+			ctx        = context.TODO()
+			log        logr.Logger
+			fakeClient client.Client
+		)
+
+		BeforeEach(func() {
+			log = logr.Discard()
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		})
+
+		It("should map the bastion policy to all bastions", func() {
+			var (
+				bastion1 = &operationsv1alpha1.Bastion{ObjectMeta: metav1.ObjectMeta{Name: "bastion1", Namespace: "garden-dev"}}
+				bastion2 = &operationsv1alpha1.Bastion{ObjectMeta: metav1.ObjectMeta{Name: "bastion2", Namespace: "garden-prod"}}
+			)
+
+			Expect(fakeClient.Create(ctx, bastion1)).To(Succeed())
+			Expect(fakeClient.Create(ctx, bastion2)).To(Succeed())
+
+			Expect(reconciler.MapBastionPolicyToBastions(ctx, log, fakeClient, &operationsv1alpha1.BastionPolicy{})).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: bastion1.Name, Namespace: bastion1.Namespace}},
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: bastion2.Name, Namespace: bastion2.Namespace}},
+			))
+		})
+	})
 })
@@ -7,26 +7,35 @@ package bastion
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	operationsv1alpha1helper "github.com/gardener/gardener/pkg/apis/operations/v1alpha1/helper"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
 // Reconciler reconciles Bastions.
 type Reconciler struct {
-	Client client.Client
-	Config config.BastionControllerConfiguration
-	Clock  clock.Clock
+	Client   client.Client
+	Config   config.BastionControllerConfiguration
+	Clock    clock.Clock
+	Recorder record.EventRecorder
 }
 
 // Reconcile reacts to updates on Bastion resources and cleans up expired Bastions.
@@ -85,20 +94,31 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, client.IgnoreNotFound(r.Client.Delete(ctx, bastion))
 	}
 
+	if err := r.recordHeartbeat(ctx, bastion); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	maxLifetime, err := r.maxLifetime(ctx, bastion)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	// delete the bastion once it has expired
 	if bastion.Status.ExpirationTimestamp != nil && r.Clock.Now().After(bastion.Status.ExpirationTimestamp.Time) {
 		log.Info("Deleting expired bastion", "expirationTimestamp", bastion.Status.ExpirationTimestamp.Time)
+		r.Recorder.Eventf(bastion, corev1.EventTypeNormal, operationsv1alpha1.EventBastionExpired, "Deleting bastion because it expired at %s", bastion.Status.ExpirationTimestamp.UTC().Format(time.RFC3339))
 		return reconcile.Result{}, client.IgnoreNotFound(r.Client.Delete(ctx, bastion))
 	}
 
 	// delete the bastion once it has reached its maximum lifetime
-	if r.Clock.Since(bastion.CreationTimestamp.Time) > r.Config.MaxLifetime.Duration {
-		log.Info("Deleting bastion because it reached its maximum lifetime", "creationTimestamp", bastion.CreationTimestamp.Time, "maxLifetime", r.Config.MaxLifetime.Duration)
+	if r.Clock.Since(bastion.CreationTimestamp.Time) > maxLifetime {
+		log.Info("Deleting bastion because it reached its maximum lifetime", "creationTimestamp", bastion.CreationTimestamp.Time, "maxLifetime", maxLifetime)
+		r.Recorder.Eventf(bastion, corev1.EventTypeNormal, operationsv1alpha1.EventBastionExpired, "Deleting bastion because it reached its maximum lifetime of %s", maxLifetime)
 		return reconcile.Result{}, client.IgnoreNotFound(r.Client.Delete(ctx, bastion))
 	}
 
 	// requeue when the Bastion expires or reaches its lifetime, whichever is sooner
-	requeueAfter := bastion.CreationTimestamp.Add(r.Config.MaxLifetime.Duration).Sub(r.Clock.Now())
+	requeueAfter := bastion.CreationTimestamp.Add(maxLifetime).Sub(r.Clock.Now())
 	if bastion.Status.ExpirationTimestamp != nil {
 		expiresIn := bastion.Status.ExpirationTimestamp.Sub(r.Clock.Now())
 		if expiresIn < requeueAfter {
@@ -113,3 +133,75 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	log.V(1).Info("Requeuing Bastion", "requeueAfter", requeueAfter)
 	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
+
+// maxLifetime returns the maximum lifetime of the given Bastion, i.e., the smallest maximum lifetime of the controller
+// configuration and the BastionPolicies selecting the project of the Bastion.
+func (r *Reconciler) maxLifetime(ctx context.Context, bastion *operationsv1alpha1.Bastion) (time.Duration, error) {
+	project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, bastion.Namespace)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return r.Config.MaxLifetime.Duration, nil
+		}
+		return 0, fmt.Errorf("could not get project for namespace %s: %w", bastion.Namespace, err)
+	}
+
+	bastionPolicyList := &operationsv1alpha1.BastionPolicyList{}
+	if err := r.Client.List(ctx, bastionPolicyList); err != nil {
+		return 0, fmt.Errorf("could not list bastion policies: %w", err)
+	}
+
+	bastionPolicies := make([]*operationsv1alpha1.BastionPolicy, 0, len(bastionPolicyList.Items))
+	for i := range bastionPolicyList.Items {
+		bastionPolicies = append(bastionPolicies, &bastionPolicyList.Items[i])
+	}
+
+	bastionPolicies, err = operationsv1alpha1helper.BastionPoliciesForProject(bastionPolicies, project)
+	if err != nil {
+		return 0, fmt.Errorf("could not determine bastion policies for project %s: %w", project.Name, err)
+	}
+
+	return operationsv1alpha1helper.EffectiveBastionMaxLifetime(r.Config.MaxLifetime.Duration, bastionPolicies), nil
+}
+
+// recordHeartbeat records an event for the creation of the given Bastion and for every heartbeat extending it. The last
+// heartbeat for which an event was recorded is remembered in an annotation.
+func (r *Reconciler) recordHeartbeat(ctx context.Context, bastion *operationsv1alpha1.Bastion) error {
+	if bastion.Status.LastHeartbeatTimestamp == nil {
+		return nil
+	}
+
+	heartbeat := bastion.Status.LastHeartbeatTimestamp.UTC().Format(time.RFC3339)
+	auditedHeartbeat, ok := bastion.Annotations[operationsv1alpha1.AnnotationAuditedHeartbeatTimestamp]
+	if auditedHeartbeat == heartbeat {
+		return nil
+	}
+
+	if !ok {
+		var ingress []string
+		for _, policy := range bastion.Spec.Ingress {
+			ingress = append(ingress, policy.IPBlock.CIDR)
+		}
+
+		r.Recorder.Eventf(bastion, corev1.EventTypeNormal, operationsv1alpha1.EventBastionCreated, "Bastion for shoot %q created by %q, allowing SSH access from %s",
+			bastion.Spec.ShootRef.Name, userOrUnknown(bastion.Annotations[v1beta1constants.GardenCreatedBy]), strings.Join(ingress, ", "))
+	} else {
+		var expiration string
+		if bastion.Status.ExpirationTimestamp != nil {
+			expiration = bastion.Status.ExpirationTimestamp.UTC().Format(time.RFC3339)
+		}
+
+		r.Recorder.Eventf(bastion, corev1.EventTypeNormal, operationsv1alpha1.EventBastionExtended, "Bastion for shoot %q extended by %q until %s",
+			bastion.Spec.ShootRef.Name, userOrUnknown(bastion.Annotations[operationsv1alpha1.AnnotationLastHeartbeatBy]), expiration)
+	}
+
+	patch := client.MergeFrom(bastion.DeepCopy())
+	metav1.SetMetaDataAnnotation(&bastion.ObjectMeta, operationsv1alpha1.AnnotationAuditedHeartbeatTimestamp, heartbeat)
+	return r.Client.Patch(ctx, bastion, patch)
+}
+
+func userOrUnknown(user string) string {
+	if user == "" {
+		return "<unknown>"
+	}
+	return user
+}
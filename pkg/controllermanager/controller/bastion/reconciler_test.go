@@ -6,24 +6,34 @@ package bastion_test
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
+	testclock "k8s.io/utils/clock/testing"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	. "github.com/gardener/gardener/pkg/controllermanager/controller/bastion"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
 )
 
@@ -31,6 +41,7 @@ var _ = Describe("Controller", func() {
 	var (
 		mockCtrl   *gomock.Controller
 		mockClient *mockclient.MockClient
+		recorder   *record.FakeRecorder
 		reconciler reconcile.Reconciler
 
 		namespace   = "garden-dev"
@@ -44,13 +55,18 @@ var _ = Describe("Controller", func() {
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockClient = mockclient.NewMockClient(mockCtrl)
+		recorder = record.NewFakeRecorder(10)
 		reconciler = &Reconciler{
 			Client: mockClient,
 			Config: config.BastionControllerConfiguration{
 				MaxLifetime: &metav1.Duration{Duration: maxLifetime},
 			},
-			Clock: clock.RealClock{},
+			Clock:    clock.RealClock{},
+			Recorder: recorder,
 		}
+
+		// the namespace does not belong to any project, hence no bastion policies apply
+		mockClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&gardencorev1beta1.ProjectList{}), gomock.Any()).AnyTimes()
 	})
 
 	AfterEach(func() {
@@ -184,6 +200,7 @@ var _ = Describe("Controller", func() {
 			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: kubernetesutils.Key(namespace, bastionName)})
 			Expect(result).To(Equal(reconcile.Result{}))
 			Expect(err).NotTo(HaveOccurred())
+			Expect(recorder.Events).To(Receive(HavePrefix("Normal BastionExpired Deleting bastion because it expired")))
 		})
 
 		It("should delete Bastions that have reached their TTL", func() {
@@ -204,6 +221,7 @@ var _ = Describe("Controller", func() {
 			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: kubernetesutils.Key(namespace, bastionName)})
 			Expect(result).To(Equal(reconcile.Result{}))
 			Expect(err).NotTo(HaveOccurred())
+			Expect(recorder.Events).To(Receive(Equal("Normal BastionExpired Deleting bastion because it reached its maximum lifetime of 12h0m0s")))
 		})
 
 		It("should delete Bastions with outdated seed information", func() {
@@ -250,6 +268,135 @@ var _ = Describe("Controller", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 	})
+
+	Describe("Reconciler with BastionPolicies and heartbeats", func() {
+		var (
+			fakeClient client.Client
+			fakeClock  *testclock.FakeClock
+
+			project *gardencorev1beta1.Project
+			shoot   gardencorev1beta1.Shoot
+			bastion operationsv1alpha1.Bastion
+			request reconcile.Request
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+				Build()
+			fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+
+			reconciler = &Reconciler{
+				Client: fakeClient,
+				Config: config.BastionControllerConfiguration{
+					MaxLifetime: &metav1.Duration{Duration: maxLifetime},
+				},
+				Clock:    fakeClock,
+				Recorder: recorder,
+			}
+
+			project = &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "dev",
+					Labels: map[string]string{"restricted": "true"},
+				},
+				Spec: gardencorev1beta1.ProjectSpec{
+					Namespace: &namespace,
+				},
+			}
+			shoot = newShoot(namespace, shootName, &seedName)
+
+			created := fakeClock.Now().Add(-2 * time.Hour)
+			bastion = newBastion(namespace, bastionName, shootName, &seedName, &created, nil)
+			bastion.Annotations = map[string]string{v1beta1constants.GardenCreatedBy: "alice"}
+			bastion.Spec.Ingress = []operationsv1alpha1.BastionIngressPolicy{{IPBlock: networkingv1.IPBlock{CIDR: "10.0.0.0/8"}}}
+			request = reconcile.Request{NamespacedName: kubernetesutils.Key(namespace, bastionName)}
+
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeClient.Create(ctx, &shoot)).To(Succeed())
+		})
+
+		Context("max lifetime", func() {
+			BeforeEach(func() {
+				Expect(fakeClient.Create(ctx, &operationsv1alpha1.BastionPolicy{
+					ObjectMeta: metav1.ObjectMeta{Name: "restricted"},
+					Spec: operationsv1alpha1.BastionPolicySpec{
+						ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"restricted": "true"}},
+						MaxLifetime:     &metav1.Duration{Duration: 3 * time.Hour},
+					},
+				})).To(Succeed())
+				Expect(fakeClient.Create(ctx, &operationsv1alpha1.BastionPolicy{
+					ObjectMeta: metav1.ObjectMeta{Name: "other"},
+					Spec: operationsv1alpha1.BastionPolicySpec{
+						ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"restricted": "false"}},
+						MaxLifetime:     &metav1.Duration{Duration: time.Hour},
+					},
+				})).To(Succeed())
+			})
+
+			It("should requeue the Bastion when it reaches the maximum lifetime of the selecting policies", func() {
+				Expect(fakeClient.Create(ctx, &bastion)).To(Succeed())
+
+				result, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.RequeueAfter).To(Equal(time.Hour))
+			})
+
+			It("should delete the Bastion when it reached the maximum lifetime of the selecting policies", func() {
+				Expect(fakeClient.Create(ctx, &bastion)).To(Succeed())
+				fakeClock.Step(2 * time.Hour)
+
+				result, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(reconcile.Result{}))
+				Expect(fakeClient.Get(ctx, request.NamespacedName, &operationsv1alpha1.Bastion{})).To(BeNotFoundError())
+				Expect(recorder.Events).To(Receive(Equal("Normal BastionExpired Deleting bastion because it reached its maximum lifetime of 3h0m0s")))
+			})
+		})
+
+		Context("heartbeats", func() {
+			It("should not record any event if the Bastion has no heartbeat yet", func() {
+				Expect(fakeClient.Create(ctx, &bastion)).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(recorder.Events).NotTo(Receive())
+			})
+
+			It("should record the creation of the Bastion on its first heartbeat", func() {
+				bastion.Status.LastHeartbeatTimestamp = &metav1.Time{Time: fakeClock.Now()}
+				Expect(fakeClient.Create(ctx, &bastion)).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(recorder.Events).To(Receive(Equal(`Normal BastionCreated Bastion for shoot "myshoot" created by "alice", allowing SSH access from 10.0.0.0/8`)))
+
+				Expect(fakeClient.Get(ctx, request.NamespacedName, &bastion)).To(Succeed())
+				Expect(bastion.Annotations).To(HaveKeyWithValue(operationsv1alpha1.AnnotationAuditedHeartbeatTimestamp, fakeClock.Now().UTC().Format(time.RFC3339)))
+
+				By("Reconcile again without a new heartbeat")
+				_, err = reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(recorder.Events).NotTo(Receive())
+			})
+
+			It("should record the extension of the Bastion on further heartbeats", func() {
+				bastion.Annotations[operationsv1alpha1.AnnotationAuditedHeartbeatTimestamp] = fakeClock.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+				bastion.Annotations[operationsv1alpha1.AnnotationLastHeartbeatBy] = "bob"
+				bastion.Status.LastHeartbeatTimestamp = &metav1.Time{Time: fakeClock.Now()}
+				bastion.Status.ExpirationTimestamp = &metav1.Time{Time: fakeClock.Now().Add(time.Hour)}
+				Expect(fakeClient.Create(ctx, &bastion)).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(recorder.Events).To(Receive(Equal(fmt.Sprintf(`Normal BastionExtended Bastion for shoot "myshoot" extended by "bob" until %s`, fakeClock.Now().Add(time.Hour).UTC().Format(time.RFC3339)))))
+
+				Expect(fakeClient.Get(ctx, request.NamespacedName, &bastion)).To(Succeed())
+				Expect(bastion.Annotations).To(HaveKeyWithValue(operationsv1alpha1.AnnotationAuditedHeartbeatTimestamp, fakeClock.Now().UTC().Format(time.RFC3339)))
+			})
+		})
+	})
 })
 
 func newBastion(namespace string, name string, shootName string, seedName *string, createdAt *time.Time, expiresAt *time.Time) operationsv1alpha1.Bastion {
@@ -12,6 +12,8 @@ import (
 
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/activity"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/project/cost"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/project/deletionrecord"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/project"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/stale"
 )
@@ -24,6 +26,20 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg config.Controlle
 		return fmt.Errorf("failed adding activity reconciler: %w", err)
 	}
 
+	if cfg.Controllers.ProjectCost != nil {
+		if err := (&cost.Reconciler{
+			Config: *cfg.Controllers.ProjectCost,
+		}).AddToManager(ctx, mgr); err != nil {
+			return fmt.Errorf("failed adding cost reconciler: %w", err)
+		}
+	}
+
+	if err := (&deletionrecord.Reconciler{
+		Config: *cfg.Controllers.Project,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding deletion record reconciler: %w", err)
+	}
+
 	if err := (&project.Reconciler{
 		Config: *cfg.Controllers.Project,
 	}).AddToManager(mgr); err != nil {
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cost_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/cost"
+)
+
+var _ = Describe("Add", func() {
+	var (
+		reconciler *Reconciler
+		shoot      *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+		shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"}}
+	})
+
+	Describe("#ShootPredicate", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.ShootPredicate()
+		})
+
+		It("should return true for create and delete events", func() {
+			Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+			Expect(p.Delete(event.DeleteEvent{Object: shoot})).To(BeTrue())
+		})
+
+		It("should return false for update events if nothing relevant changed", func() {
+			newShoot := shoot.DeepCopy()
+			newShoot.Labels = map[string]string{"foo": "bar"}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: newShoot})).To(BeFalse())
+		})
+
+		It("should return true if the workers changed", func() {
+			newShoot := shoot.DeepCopy()
+			newShoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{{Name: "worker", Maximum: 2}}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: newShoot})).To(BeTrue())
+		})
+
+		It("should return true if the hibernation settings changed", func() {
+			newShoot := shoot.DeepCopy()
+			newShoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: newShoot})).To(BeTrue())
+		})
+
+		It("should return true if the excluded hibernation dates changed", func() {
+			newShoot := shoot.DeepCopy()
+			newShoot.Annotations = map[string]string{"shoot.gardener.cloud/hibernation-excluded-dates": "2024-12-24"}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: newShoot})).To(BeTrue())
+		})
+	})
+
+	Describe("#MapShootToProject", func() {
+		It("should map the shoot to its project", func() {
+			fakeClient := fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+				Build()
+			Expect(fakeClient.Create(context.Background(), &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+				Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-foo")},
+			})).To(Succeed())
+
+			Expect(reconciler.MapShootToProject(context.Background(), logr.Discard(), fakeClient, shoot)).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: "foo"}},
+			))
+		})
+
+		It("should return nothing if the project does not exist", func() {
+			fakeClient := fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+				Build()
+
+			Expect(reconciler.MapShootToProject(context.Background(), logr.Discard(), fakeClient, shoot)).To(BeEmpty())
+		})
+	})
+})
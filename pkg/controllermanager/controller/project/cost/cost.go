@@ -0,0 +1,224 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cost
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/robfig/cron"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+const (
+	// hoursPerMonth is the average number of hours per month.
+	hoursPerMonth = 365 * 24 / 12
+	// hibernationWindow is the period for which the hibernation schedules are evaluated to determine the share of time
+	// in which a Shoot is awake.
+	hibernationWindow = 7 * 24 * time.Hour
+)
+
+type cost struct {
+	hourly, monthly float64
+}
+
+func (c cost) add(other cost) cost {
+	return cost{hourly: c.hourly + other.hourly, monthly: c.monthly + other.monthly}
+}
+
+func (c cost) estimatedCost(currency string) gardencorev1beta1.EstimatedCost {
+	return gardencorev1beta1.EstimatedCost{
+		Currency: currency,
+		Hourly:   roundToCents(c.hourly),
+		Monthly:  roundToCents(c.monthly),
+	}
+}
+
+func roundToCents(value float64) resource.Quantity {
+	return *resource.NewScaledQuantity(int64(math.Round(value*100)), -2)
+}
+
+// cloudProfilePrices contains the prices of the machine types and volume types of a CloudProfile.
+type cloudProfilePrices struct {
+	currency     string
+	machineTypes map[string]float64
+	volumeTypes  map[string]float64
+}
+
+// pricesOf returns the prices defined for the machine types and volume types of the given CloudProfile. It returns nil
+// if no prices are defined. The validation of CloudProfiles ensures that all prices use the same currency.
+func pricesOf(cloudProfile *gardencorev1beta1.CloudProfile) *cloudProfilePrices {
+	p := &cloudProfilePrices{
+		machineTypes: make(map[string]float64),
+		volumeTypes:  make(map[string]float64),
+	}
+
+	for _, machineType := range cloudProfile.Spec.MachineTypes {
+		if machineType.Price != nil {
+			p.currency = machineType.Price.Currency
+			p.machineTypes[machineType.Name] = machineType.Price.Amount.AsApproximateFloat64()
+		}
+	}
+	for _, volumeType := range cloudProfile.Spec.VolumeTypes {
+		if volumeType.Price != nil {
+			p.currency = volumeType.Price.Currency
+			p.volumeTypes[volumeType.Name] = volumeType.Price.Amount.AsApproximateFloat64()
+		}
+	}
+
+	if len(p.currency) == 0 {
+		return nil
+	}
+	return p
+}
+
+// estimateShootCost estimates the cost of the given Shoot. The hourly cost is the cost of the Shoot in its current
+// hibernation state, while the monthly cost takes the hibernation schedules into account.
+func estimateShootCost(shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile, prices *cloudProfilePrices, now time.Time) (cost, error) {
+	awakeHourly, err := awakeHourlyCost(shoot, cloudProfile, prices)
+	if err != nil {
+		return cost{}, err
+	}
+
+	awakeShare, err := awakeShare(shoot, now)
+	if err != nil {
+		return cost{}, err
+	}
+
+	c := cost{monthly: awakeHourly * hoursPerMonth * awakeShare}
+	if !v1beta1helper.HibernationIsEnabled(shoot) {
+		c.hourly = awakeHourly
+	}
+	return c, nil
+}
+
+// awakeHourlyCost computes the hourly cost of the worker nodes and their volumes of the given Shoot when it is awake.
+// As the actual number of nodes depends on the cluster autoscaler, the average of the minimum and maximum of the
+// worker pools is used.
+func awakeHourlyCost(shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile, prices *cloudProfilePrices) (float64, error) {
+	var hourly float64
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		machinePrice, ok := prices.machineTypes[worker.Machine.Type]
+		if !ok {
+			return 0, fmt.Errorf("no price defined for machine type %s", worker.Machine.Type)
+		}
+
+		var (
+			volumeType string
+			volumeSize *resource.Quantity
+		)
+
+		if worker.Volume != nil {
+			volumeType = ptr.Deref(worker.Volume.Type, "")
+			size, err := resource.ParseQuantity(worker.Volume.VolumeSize)
+			if err != nil {
+				return 0, fmt.Errorf("invalid volume size of worker pool %s: %w", worker.Name, err)
+			}
+			volumeSize = &size
+		} else if machineType := v1beta1helper.FindMachineTypeByName(cloudProfile.Spec.MachineTypes, worker.Machine.Type); machineType != nil && machineType.Storage != nil && machineType.Storage.StorageSize != nil {
+			volumeType, volumeSize = machineType.Storage.Type, machineType.Storage.StorageSize
+		}
+
+		var volumePrice float64
+		if volumeSize != nil {
+			pricePerGiB, ok := prices.volumeTypes[volumeType]
+			if !ok {
+				return 0, fmt.Errorf("no price defined for volume type %s", volumeType)
+			}
+			volumePrice = pricePerGiB * volumeSize.AsApproximateFloat64() / (1 << 30)
+		}
+
+		nodes := float64(worker.Minimum+worker.Maximum) / 2
+		hourly += nodes * (machinePrice + volumePrice)
+	}
+
+	return hourly, nil
+}
+
+type hibernationTransition struct {
+	at    time.Time
+	awake bool
+}
+
+// awakeShare returns the share of time in which the given Shoot is awake according to its current hibernation state
+// and its hibernation schedules.
+func awakeShare(shoot *gardencorev1beta1.Shoot, now time.Time) (float64, error) {
+	awake := !v1beta1helper.HibernationIsEnabled(shoot)
+
+	var schedules []gardencorev1beta1.HibernationSchedule
+	if shoot.Spec.Hibernation != nil {
+		schedules = shoot.Spec.Hibernation.Schedules
+	}
+
+	excludedDates, err := gardenerutils.GetShootHibernationExcludedDates(shoot.Annotations)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		end         = now.Add(hibernationWindow)
+		transitions []hibernationTransition
+	)
+
+	for _, schedule := range schedules {
+		location := time.UTC
+		if schedule.Location != nil {
+			location, err = time.LoadLocation(*schedule.Location)
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		for _, s := range []struct {
+			spec  *string
+			awake bool
+		}{
+			{spec: schedule.Start, awake: false},
+			{spec: schedule.End, awake: true},
+		} {
+			if s.spec == nil {
+				continue
+			}
+
+			parsed, err := cron.ParseStandard(*s.spec)
+			if err != nil {
+				return 0, err
+			}
+
+			for t := parsed.Next(now.In(location)); t.Before(end); t = parsed.Next(t) {
+				if !excludedDates.Has(t.Format(gardenerutils.HibernationExcludedDateFormat)) {
+					transitions = append(transitions, hibernationTransition{at: t, awake: s.awake})
+				}
+			}
+		}
+	}
+
+	slices.SortStableFunc(transitions, func(a, b hibernationTransition) int { return a.at.Compare(b.at) })
+
+	var (
+		awakeDuration time.Duration
+		last          = now
+	)
+
+	for _, transition := range transitions {
+		if awake {
+			awakeDuration += transition.at.Sub(last)
+		}
+		last, awake = transition.at, transition.awake
+	}
+	if awake {
+		awakeDuration += end.Sub(last)
+	}
+
+	return float64(awakeDuration) / float64(hibernationWindow), nil
+}
@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cost
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/metrics"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+var (
+	metricShootEstimatedCost = metrics.Factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metrics.Namespace,
+			Name:      "shoot_estimated_cost",
+			Help:      "Estimated cost of a Shoot per period (hourly or monthly) based on the prices defined in its CloudProfile.",
+		},
+		[]string{"project", "name", "currency", "period"},
+	)
+	metricProjectEstimatedCost = metrics.Factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metrics.Namespace,
+			Name:      "project_estimated_cost",
+			Help:      "Estimated cost of all Shoots of a Project per period (hourly or monthly) and currency.",
+		},
+		[]string{"project", "currency", "period"},
+	)
+)
+
+// Reconciler reconciles Projects and estimates the cost of their Shoots based on the prices of the machine types and
+// volume types defined in the CloudProfiles.
+type Reconciler struct {
+	Client client.Client
+	Config config.ProjectCostControllerConfiguration
+	Clock  clock.Clock
+
+	// exportedSeries contains the metric series which were last exported per project (keyed by series.key). It is used
+	// to remove series which are not part of a recomputation anymore without deleting all series of the project upfront.
+	exportedSeries     map[string]map[string]series
+	exportedSeriesLock sync.Mutex
+}
+
+// series is a single sample of one of the cost metrics.
+type series struct {
+	vec         *prometheus.GaugeVec
+	labelValues []string
+	value       float64
+}
+
+func (s series) key() string {
+	return fmt.Sprintf("%p/%q", s.vec, s.labelValues)
+}
+
+// Reconcile estimates the cost of the Shoots of the Project and aggregates them per Project.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	project := &gardencorev1beta1.Project{}
+	if err := r.Client.Get(ctx, request.NamespacedName, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			r.deleteMetrics(request.Name)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if project.DeletionTimestamp != nil || project.Spec.Namespace == nil {
+		log.V(1).Info("Project is being deleted or has no namespace yet, stopping reconciliation")
+		r.deleteMetrics(project.Name)
+		return reconcile.Result{}, nil
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList, client.InNamespace(*project.Spec.Namespace)); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	var (
+		now           = r.Clock.Now()
+		projectCost   = make(map[string]cost)
+		cloudProfiles = make(map[string]*gardencorev1beta1.CloudProfile)
+		newSeries     []series
+	)
+
+	for _, shoot := range shootList.Items {
+		shootLog := log.WithValues("shoot", client.ObjectKeyFromObject(&shoot))
+
+		profile, ok := cloudProfiles[shoot.Spec.CloudProfileName]
+		if !ok {
+			profile = &gardencorev1beta1.CloudProfile{}
+			if err := r.Client.Get(ctx, client.ObjectKey{Name: shoot.Spec.CloudProfileName}, profile); err != nil {
+				return reconcile.Result{}, fmt.Errorf("failed reading CloudProfile %s: %w", shoot.Spec.CloudProfileName, err)
+			}
+			cloudProfiles[shoot.Spec.CloudProfileName] = profile
+		}
+
+		var estimatedCost *gardencorev1beta1.EstimatedCost
+
+		if prices := pricesOf(profile); prices != nil {
+			shootCost, err := estimateShootCost(&shoot, profile, prices, now)
+			if err != nil {
+				shootLog.Info("Cost of shoot cannot be estimated", "reason", err.Error())
+			} else {
+				estimatedCost = ptr.To(shootCost.estimatedCost(prices.currency))
+				projectCost[prices.currency] = projectCost[prices.currency].add(shootCost)
+				newSeries = append(newSeries,
+					series{metricShootEstimatedCost, []string{project.Name, shoot.Name, prices.currency, "hourly"}, shootCost.hourly},
+					series{metricShootEstimatedCost, []string{project.Name, shoot.Name, prices.currency, "monthly"}, shootCost.monthly},
+				)
+			}
+		}
+
+		if !apiequality.Semantic.DeepEqual(shoot.Status.EstimatedCost, estimatedCost) {
+			patch := client.MergeFrom(shoot.DeepCopy())
+			shoot.Status.EstimatedCost = estimatedCost
+			if err := r.Client.Status().Patch(ctx, &shoot, patch); err != nil {
+				return reconcile.Result{}, fmt.Errorf("failed updating estimated cost of shoot %s: %w", client.ObjectKeyFromObject(&shoot), err)
+			}
+		}
+	}
+
+	var estimatedProjectCosts []gardencorev1beta1.EstimatedCost
+	for _, currency := range sets.List(sets.KeySet(projectCost)) {
+		c := projectCost[currency]
+		estimatedProjectCosts = append(estimatedProjectCosts, c.estimatedCost(currency))
+		newSeries = append(newSeries,
+			series{metricProjectEstimatedCost, []string{project.Name, currency, "hourly"}, c.hourly},
+			series{metricProjectEstimatedCost, []string{project.Name, currency, "monthly"}, c.monthly},
+		)
+	}
+
+	r.swapMetrics(project.Name, newSeries)
+
+	if !apiequality.Semantic.DeepEqual(project.Status.EstimatedCosts, estimatedProjectCosts) {
+		patch := client.MergeFrom(project.DeepCopy())
+		project.Status.EstimatedCosts = estimatedProjectCosts
+		if err := r.Client.Status().Patch(ctx, project, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed updating estimated cost of project: %w", err)
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// swapMetrics sets the given series of the project and removes the previously exported ones which are not part of
+// them anymore. This way, the metrics of a project never disappear while they are recomputed.
+func (r *Reconciler) swapMetrics(projectName string, newSeries []series) {
+	r.exportedSeriesLock.Lock()
+	defer r.exportedSeriesLock.Unlock()
+
+	exported := make(map[string]series, len(newSeries))
+	for _, s := range newSeries {
+		s.vec.WithLabelValues(s.labelValues...).Set(s.value)
+		exported[s.key()] = s
+	}
+
+	for key, s := range r.exportedSeries[projectName] {
+		if _, ok := exported[key]; !ok {
+			s.vec.DeleteLabelValues(s.labelValues...)
+		}
+	}
+
+	if r.exportedSeries == nil {
+		r.exportedSeries = make(map[string]map[string]series)
+	}
+	r.exportedSeries[projectName] = exported
+}
+
+func (r *Reconciler) deleteMetrics(projectName string) {
+	r.exportedSeriesLock.Lock()
+	defer r.exportedSeriesLock.Unlock()
+
+	metricShootEstimatedCost.DeletePartialMatch(prometheus.Labels{"project": projectName})
+	metricProjectEstimatedCost.DeletePartialMatch(prometheus.Labels{"project": projectName})
+	delete(r.exportedSeries, projectName)
+}
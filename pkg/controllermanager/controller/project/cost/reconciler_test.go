@@ -0,0 +1,218 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cost_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/cost"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		reconciler *Reconciler
+
+		cloudProfile *gardencorev1beta1.CloudProfile
+		project      *gardencorev1beta1.Project
+		shoot        *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Project{}, &gardencorev1beta1.Shoot{}).Build()
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: config.ProjectCostControllerConfiguration{SyncPeriod: &metav1.Duration{Duration: time.Hour}},
+			Clock:  testclock.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+		}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				MachineTypes: []gardencorev1beta1.MachineType{{
+					Name:  "small",
+					Price: &gardencorev1beta1.Price{Currency: "EUR", Amount: resource.MustParse("1")},
+				}},
+				VolumeTypes: []gardencorev1beta1.VolumeType{{
+					Name:  "ssd",
+					Price: &gardencorev1beta1.Price{Currency: "EUR", Amount: resource.MustParse("0.01")},
+				}},
+			},
+		}
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-foo")},
+		}
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: "profile",
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{{
+						Name:    "worker",
+						Machine: gardencorev1beta1.Machine{Type: "small"},
+						Minimum: 1,
+						Maximum: 3,
+						Volume:  &gardencorev1beta1.Volume{Type: ptr.To("ssd"), VolumeSize: "10Gi"},
+					}},
+				},
+			},
+		}
+
+		Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		// Reconciling the deleted project removes its metrics, so that they don't leak into other specs.
+		Expect(fakeClient.Delete(ctx, project)).To(Succeed())
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	reconcileProject := func() {
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+	}
+
+	It("should do nothing if the project does not exist", func() {
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKey{Name: "unknown"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+	})
+
+	It("should estimate the cost of an awake shoot", func() {
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		reconcileProject()
+
+		Expect(shoot.Status.EstimatedCost).To(PointTo(matchEstimatedCost("EUR", "2.20", "1606")))
+		Expect(project.Status.EstimatedCosts).To(ConsistOf(matchEstimatedCost("EUR", "2.20", "1606")))
+	})
+
+	It("should take the hibernation state and schedules into account", func() {
+		shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{
+			Enabled:   ptr.To(true),
+			Schedules: []gardencorev1beta1.HibernationSchedule{{Start: ptr.To("0 0 * * *"), End: ptr.To("0 12 * * *")}},
+		}
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		reconcileProject()
+
+		Expect(shoot.Status.EstimatedCost).To(PointTo(matchEstimatedCost("EUR", "0", "803")))
+	})
+
+	It("should take the excluded hibernation dates into account", func() {
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/hibernation-excluded-dates", "2024-01-02")
+		shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{
+			Enabled:   ptr.To(true),
+			Schedules: []gardencorev1beta1.HibernationSchedule{{Start: ptr.To("0 0 * * *"), End: ptr.To("0 12 * * *")}},
+		}
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		reconcileProject()
+
+		Expect(shoot.Status.EstimatedCost).To(PointTo(matchEstimatedCost("EUR", "0", "917.71")))
+	})
+
+	It("should aggregate the cost of all shoots of the project", func() {
+		hibernatedShoot := shoot.DeepCopy()
+		hibernatedShoot.Name = "hibernated"
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		hibernatedShoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+		Expect(fakeClient.Create(ctx, hibernatedShoot)).To(Succeed())
+
+		reconcileProject()
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(hibernatedShoot), hibernatedShoot)).To(Succeed())
+		Expect(hibernatedShoot.Status.EstimatedCost).To(PointTo(matchEstimatedCost("EUR", "0", "0")))
+		Expect(project.Status.EstimatedCosts).To(ConsistOf(matchEstimatedCost("EUR", "2.20", "1606")))
+	})
+
+	It("should remove the estimations if the cost cannot be estimated", func() {
+		estimatedCost := gardencorev1beta1.EstimatedCost{Currency: "EUR", Hourly: resource.MustParse("1"), Monthly: resource.MustParse("730")}
+		project.Status.EstimatedCosts = []gardencorev1beta1.EstimatedCost{estimatedCost}
+		Expect(fakeClient.Status().Update(ctx, project)).To(Succeed())
+		shoot.Spec.Provider.Workers[0].Machine.Type = "large"
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		shoot.Status.EstimatedCost = &estimatedCost
+		Expect(fakeClient.Status().Update(ctx, shoot)).To(Succeed())
+
+		reconcileProject()
+
+		Expect(shoot.Status.EstimatedCost).To(BeNil())
+		Expect(project.Status.EstimatedCosts).To(BeEmpty())
+	})
+
+	It("should not estimate the cost if the cloud profile has no prices", func() {
+		cloudProfile.Spec.MachineTypes[0].Price = nil
+		cloudProfile.Spec.VolumeTypes[0].Price = nil
+		Expect(fakeClient.Update(ctx, cloudProfile)).To(Succeed())
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		reconcileProject()
+
+		Expect(shoot.Status.EstimatedCost).To(BeNil())
+		Expect(project.Status.EstimatedCosts).To(BeEmpty())
+	})
+
+	It("should keep the metrics if the recomputation fails and remove stale series afterwards", func() {
+		const shootMetric, projectMetric = "gardener_controller_manager_shoot_estimated_cost", "gardener_controller_manager_project_estimated_cost"
+
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		reconcileProject()
+		Expect(testutil.GatherAndCount(runtimemetrics.Registry, shootMetric)).To(Equal(2))
+		Expect(testutil.GatherAndCount(runtimemetrics.Registry, projectMetric)).To(Equal(2))
+
+		otherShoot := shoot.DeepCopy()
+		otherShoot.ResourceVersion = ""
+		otherShoot.Name = "other"
+		otherShoot.Spec.CloudProfileName = "unknown"
+		Expect(fakeClient.Create(ctx, otherShoot)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)})
+		Expect(err).To(MatchError(ContainSubstring("failed reading CloudProfile unknown")))
+		Expect(testutil.GatherAndCount(runtimemetrics.Registry, shootMetric)).To(Equal(2))
+		Expect(testutil.GatherAndCount(runtimemetrics.Registry, projectMetric)).To(Equal(2))
+
+		Expect(fakeClient.Delete(ctx, otherShoot)).To(Succeed())
+		shoot.Spec.Provider.Workers[0].Machine.Type = "large"
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+		reconcileProject()
+		Expect(testutil.GatherAndCount(runtimemetrics.Registry, shootMetric)).To(BeZero())
+		Expect(testutil.GatherAndCount(runtimemetrics.Registry, projectMetric)).To(BeZero())
+	})
+})
+
+func matchEstimatedCost(currency, hourly, monthly string) gomegatypes.GomegaMatcher {
+	return MatchFields(IgnoreExtras, Fields{
+		"Currency": Equal(currency),
+		"Hourly":   BeComparableTo(resource.MustParse(hourly)),
+		"Monthly":  BeComparableTo(resource.MustParse(monthly)),
+	})
+}
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deletionrecord_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/deletionrecord"
+)
+
+var _ = Describe("Add", func() {
+	Describe("#IsDeletionRecord", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = IsDeletionRecord()
+		})
+
+		DescribeTable("should filter config maps",
+			func(namespace string, labels map[string]string, matcher OmegaMatcher) {
+				configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Labels: labels}}
+				Expect(p.Create(event.CreateEvent{Object: configMap})).To(matcher)
+			},
+
+			Entry("deletion record in garden namespace", "garden", map[string]string{"project.gardener.cloud/deletion-audit": "true"}, BeTrue()),
+			Entry("deletion record in other namespace", "garden-foo", map[string]string{"project.gardener.cloud/deletion-audit": "true"}, BeFalse()),
+			Entry("other config map in garden namespace", "garden", map[string]string{"foo": "bar"}, BeFalse()),
+		)
+	})
+})
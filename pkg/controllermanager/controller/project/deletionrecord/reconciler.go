@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deletionrecord
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// Reconciler deletes the audit records of automatically deleted stale Projects once their retention has passed.
+type Reconciler struct {
+	Client client.Client
+	Config config.ProjectControllerConfiguration
+	Clock  clock.Clock
+}
+
+// Reconcile deletes the audit records of automatically deleted stale Projects once their retention has passed.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	configMap := &corev1.ConfigMap{}
+	if err := r.Client.Get(ctx, req.NamespacedName, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if r.Config.DeletionRecordRetentionDays == nil {
+		return reconcile.Result{}, nil
+	}
+
+	deleteAt := configMap.CreationTimestamp.Add(time.Hour * 24 * time.Duration(*r.Config.DeletionRecordRetentionDays))
+	if timeUntilDeletion := deleteAt.Sub(r.Clock.Now()); timeUntilDeletion > 0 {
+		return reconcile.Result{RequeueAfter: timeUntilDeletion}, nil
+	}
+
+	log.Info("Deleting audit record of deleted Project because its retention has passed", "deletionRecordRetentionDays", *r.Config.DeletionRecordRetentionDays)
+	return reconcile.Result{}, client.IgnoreNotFound(r.Client.Delete(ctx, configMap))
+}
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deletionrecord_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/deletionrecord"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+
+		reconciler *Reconciler
+		configMap  *corev1.ConfigMap
+		request    reconcile.Request
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "project-deletion-foo-1717200000",
+				Namespace:         "garden",
+				Labels:            map[string]string{"project.gardener.cloud/deletion-audit": "true"},
+				CreationTimestamp: metav1.Time{Time: fakeClock.Now().Add(-24 * time.Hour)},
+			},
+		}
+		Expect(fakeClient.Create(ctx, configMap)).To(Succeed())
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(configMap)}
+
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: config.ProjectControllerConfiguration{DeletionRecordRetentionDays: ptr.To(30)},
+			Clock:  fakeClock,
+		}
+	})
+
+	It("should do nothing if the record is gone", func() {
+		Expect(fakeClient.Delete(ctx, configMap)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should requeue the record until its retention has passed", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 29 * 24 * time.Hour}))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+	})
+
+	It("should delete the record once its retention has passed", func() {
+		fakeClock.Step(29 * 24 * time.Hour)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(BeNotFoundError())
+	})
+
+	It("should keep the record if no retention is configured", func() {
+		reconciler.Config.DeletionRecordRetentionDays = nil
+		fakeClock.Step(365 * 24 * time.Hour)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+	})
+})
@@ -29,6 +29,9 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
+	if r.Notifier == nil && r.Config.StaleNotifications != nil {
+		r.Notifier = NewNotifier(r.Config.StaleNotifications, mgr.GetEventRecorderFor(ControllerName+"-controller"))
+	}
 
 	return builder.
 		ControllerManagedBy(mgr).
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package stale
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+)
+
+// NotificationType is the type of a notification about a stale Project.
+type NotificationType string
+
+const (
+	// NotificationTypeDeletionScheduled is the type of notifications sent before a stale Project gets auto-deleted.
+	NotificationTypeDeletionScheduled NotificationType = "DeletionScheduled"
+	// NotificationTypeDeleted is the type of notifications sent when a stale Project got auto-deleted.
+	NotificationTypeDeleted NotificationType = "Deleted"
+)
+
+// Notification is a notification for the owner of a stale Project.
+type Notification struct {
+	// Type is the type of the notification.
+	Type NotificationType `json:"type"`
+	// Project is the name of the Project.
+	Project string `json:"project"`
+	// Namespace is the namespace of the Project.
+	Namespace string `json:"namespace,omitempty"`
+	// Owner is the owner of the Project.
+	Owner *rbacv1.Subject `json:"owner,omitempty"`
+	// StaleSinceTimestamp is the time since when the Project is stale.
+	StaleSinceTimestamp *metav1.Time `json:"staleSinceTimestamp,omitempty"`
+	// StaleAutoDeleteTimestamp is the time when the Project gets auto-deleted.
+	StaleAutoDeleteTimestamp *metav1.Time `json:"staleAutoDeleteTimestamp,omitempty"`
+	// DaysBeforeDeletion is the number of days left until the Project gets auto-deleted.
+	DaysBeforeDeletion int `json:"daysBeforeDeletion,omitempty"`
+}
+
+// Notifier notifies the owners of stale Projects.
+type Notifier interface {
+	// Notify sends the given notification about the given Project.
+	Notify(ctx context.Context, project *gardencorev1beta1.Project, notification Notification) error
+}
+
+// NewNotifier returns the Notifier for the given configuration. Notifications are posted to the configured webhook or
+// recorded as events for the Project if no webhook is configured.
+func NewNotifier(conf *config.ProjectStaleNotificationConfiguration, recorder record.EventRecorder) Notifier {
+	if conf.Webhook != nil {
+		client := &http.Client{}
+		if conf.Webhook.Timeout != nil {
+			client.Timeout = conf.Webhook.Timeout.Duration
+		}
+		return &WebhookNotifier{URL: conf.Webhook.URL, Client: client}
+	}
+	return &EventNotifier{Recorder: recorder}
+}
+
+// EventNotifier records notifications as events for the Project. It serves as a stand-in for notification channels like
+// email which are not available in every landscape.
+type EventNotifier struct {
+	Recorder record.EventRecorder
+}
+
+// Notify records the given notification as event for the given Project.
+func (n *EventNotifier) Notify(_ context.Context, project *gardencorev1beta1.Project, notification Notification) error {
+	switch notification.Type {
+	case NotificationTypeDeletionScheduled:
+		n.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventStaleDeletionScheduled,
+			"Project is stale and will be deleted automatically at %s (in %d day(s)) unless it is used again or the stale check is snoozed via the %s annotation",
+			notification.StaleAutoDeleteTimestamp.UTC().Format(time.RFC3339), notification.DaysBeforeDeletion, v1beta1constants.ProjectStaleSnoozeUntil)
+	case NotificationTypeDeleted:
+		n.Recorder.Event(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventStaleDeleted, "Project was stale and got deleted automatically")
+	default:
+		return fmt.Errorf("unknown notification type %q", notification.Type)
+	}
+	return nil
+}
+
+// WebhookNotifier posts notifications as JSON to a webhook.
+type WebhookNotifier struct {
+	URL    string
+	Client *http.Client
+}
+
+// Notify posts the given notification to the webhook.
+func (n *WebhookNotifier) Notify(ctx context.Context, _ *gardencorev1beta1.Project, notification Notification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed posting notification to webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook responded with unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
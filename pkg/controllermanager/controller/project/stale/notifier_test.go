@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package stale_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/stale"
+)
+
+var _ = Describe("Notifier", func() {
+	var (
+		ctx          = context.Background()
+		project      *gardencorev1beta1.Project
+		notification Notification
+	)
+
+	BeforeEach(func() {
+		project = &gardencorev1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}
+		notification = Notification{
+			Type:                     NotificationTypeDeletionScheduled,
+			Project:                  "foo",
+			Namespace:                "garden-foo",
+			StaleAutoDeleteTimestamp: &metav1.Time{Time: time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)},
+			DaysBeforeDeletion:       7,
+		}
+	})
+
+	Describe("#NewNotifier", func() {
+		It("should return an event notifier if no webhook is configured", func() {
+			Expect(NewNotifier(&config.ProjectStaleNotificationConfiguration{}, record.NewFakeRecorder(1))).To(BeAssignableToTypeOf(&EventNotifier{}))
+		})
+
+		It("should return a webhook notifier if a webhook is configured", func() {
+			notifier := NewNotifier(&config.ProjectStaleNotificationConfiguration{
+				Webhook: &config.ProjectStaleNotificationWebhook{URL: "https://example.com", Timeout: &metav1.Duration{Duration: time.Second}},
+			}, nil)
+
+			Expect(notifier).To(BeAssignableToTypeOf(&WebhookNotifier{}))
+			Expect(notifier.(*WebhookNotifier).Client.Timeout).To(Equal(time.Second))
+		})
+	})
+
+	Describe("EventNotifier", func() {
+		It("should record an event for the project", func() {
+			recorder := record.NewFakeRecorder(1)
+
+			Expect((&EventNotifier{Recorder: recorder}).Notify(ctx, project, notification)).To(Succeed())
+			Expect(recorder.Events).To(Receive(Equal("Warning StaleProjectDeletionScheduled Project is stale and will be deleted automatically at 2024-01-08T00:00:00Z (in 7 day(s)) unless it is used again or the stale check is snoozed via the project.gardener.cloud/stale-snooze-until annotation")))
+		})
+	})
+
+	Describe("WebhookNotifier", func() {
+		It("should post the notification to the webhook", func() {
+			var received Notification
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				Expect(r.Method).To(Equal(http.MethodPost))
+				Expect(json.NewDecoder(r.Body).Decode(&received)).To(Succeed())
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			Expect((&WebhookNotifier{URL: server.URL, Client: server.Client()}).Notify(ctx, project, notification)).To(Succeed())
+			Expect(received.Type).To(Equal(NotificationTypeDeletionScheduled))
+			Expect(received.Project).To(Equal("foo"))
+			Expect(received.DaysBeforeDeletion).To(Equal(7))
+		})
+
+		It("should fail if the webhook responds with an error", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			}))
+			defer server.Close()
+
+			Expect((&WebhookNotifier{URL: server.URL, Client: server.Client()}).Notify(ctx, project, notification)).To(MatchError("webhook responded with unexpected status code 500"))
+		})
+	})
+})
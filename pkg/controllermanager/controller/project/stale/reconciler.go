@@ -7,6 +7,7 @@ package stale
 import (
 	"context"
 	"fmt"
+	"math"
 	"strconv"
 	"time"
 
@@ -31,9 +32,10 @@ import (
 
 // Reconciler reconciles Projects, marks them as stale and auto-deletes them after a certain time if not in-use.
 type Reconciler struct {
-	Client client.Client
-	Config config.ProjectControllerConfiguration
-	Clock  clock.Clock
+	Client   client.Client
+	Config   config.ProjectControllerConfiguration
+	Clock    clock.Clock
+	Notifier Notifier
 }
 
 // Reconcile reconciles Projects, marks them as stale and auto-deletes them after a certain time if not in-use.
@@ -82,6 +84,25 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 		return r.markProjectAsNotStale(ctx, project)
 	}
 
+	// Skip projects whose owners snoozed the stale check.
+	snoozeUntil, err := gardenerutils.GetProjectStaleSnoozeUntil(project.Annotations)
+	if err != nil {
+		return err
+	}
+	if snoozeUntil != nil && r.Config.StaleSnoozeMaxDays != nil {
+		if maxSnoozeUntil := r.Clock.Now().UTC().Add(time.Hour * 24 * time.Duration(*r.Config.StaleSnoozeMaxDays)); snoozeUntil.After(maxSnoozeUntil) {
+			log.Info("Stale check of Project is snoozed for longer than allowed, reducing snooze", "snoozeUntil", snoozeUntil.UTC(), "staleSnoozeMaxDays", *r.Config.StaleSnoozeMaxDays)
+			if err := r.patchSnoozeUntil(ctx, project, maxSnoozeUntil); err != nil {
+				return err
+			}
+			snoozeUntil = &maxSnoozeUntil
+		}
+	}
+	if snoozeUntil != nil && snoozeUntil.After(r.Clock.Now().UTC()) {
+		log.Info("Stale check of Project is snoozed, marking Project as not stale", "snoozeUntil", snoozeUntil.UTC())
+		return r.markProjectAsNotStale(ctx, project)
+	}
+
 	// Skip projects that are not older than the configured minimum lifetime in days. This allows having Projects for a
 	// certain period of time until they are checked whether they got stale.
 	if project.CreationTimestamp.UTC().Add(time.Hour * 24 * time.Duration(*r.Config.MinimumLifetimeDays)).After(r.Clock.Now().UTC()) {
@@ -126,10 +147,14 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 
 	if project.Status.StaleAutoDeleteTimestamp == nil || r.Clock.Now().UTC().Before(project.Status.StaleAutoDeleteTimestamp.UTC()) {
 		log.Info("Project is stale, but will not be deleted now")
-		return nil
+		return r.notifyOwnerAboutScheduledDeletion(ctx, log, project)
 	}
 
 	log.Info("Deleting Project now because its auto-delete timestamp is exceeded")
+	if err := r.recordDeletion(ctx, project); err != nil {
+		return fmt.Errorf("failed recording the deletion of the project: %w", err)
+	}
+
 	if err := gardenerutils.ConfirmDeletion(ctx, r.Client, project); err != nil {
 		if apierrors.IsNotFound(err) {
 			log.Info("Project already gone")
@@ -137,7 +162,125 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 		}
 		return err
 	}
-	return client.IgnoreNotFound(r.Client.Delete(ctx, project))
+	if err := r.Client.Delete(ctx, project); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if r.Notifier != nil {
+		// The Project is already being deleted, hence, it won't be reconciled again and retrying is not possible.
+		if err := r.Notifier.Notify(ctx, project, r.notification(project, NotificationTypeDeleted, 0)); err != nil {
+			log.Error(err, "Failed notifying owner about the deletion of the Project")
+		}
+	}
+	return nil
+}
+
+// notifyOwnerAboutScheduledDeletion notifies the owner of the given stale Project once per configured stage before its
+// auto-deletion. The last notified stage is remembered in an annotation of the Project.
+func (r *Reconciler) notifyOwnerAboutScheduledDeletion(ctx context.Context, log logr.Logger, project *gardencorev1beta1.Project) error {
+	if r.Notifier == nil || r.Config.StaleNotifications == nil || project.Status.StaleAutoDeleteTimestamp == nil {
+		return nil
+	}
+
+	daysBeforeDeletion := int(math.Ceil(project.Status.StaleAutoDeleteTimestamp.Sub(r.Clock.Now()).Hours() / 24))
+
+	// Find the closest stage which has been reached.
+	stage := -1
+	for _, days := range r.Config.StaleNotifications.DaysBeforeDeletion {
+		if daysBeforeDeletion <= days && (stage == -1 || days < stage) {
+			stage = days
+		}
+	}
+
+	notifiedStage, notified := project.Annotations[v1beta1constants.ProjectStaleNotifiedDaysBeforeDeletion]
+	if stage == -1 {
+		// The auto-delete timestamp might have been postponed, hence the notifications have to start over.
+		if notified {
+			return r.patchNotifiedStage(ctx, project, nil)
+		}
+		return nil
+	}
+
+	if notified && notifiedStage == strconv.Itoa(stage) {
+		return nil
+	}
+
+	log.Info("Notifying owner about scheduled deletion of stale Project", "daysBeforeDeletion", daysBeforeDeletion)
+	if err := r.Notifier.Notify(ctx, project, r.notification(project, NotificationTypeDeletionScheduled, daysBeforeDeletion)); err != nil {
+		return fmt.Errorf("failed notifying owner about scheduled deletion of the project: %w", err)
+	}
+
+	return r.patchNotifiedStage(ctx, project, ptr.To(strconv.Itoa(stage)))
+}
+
+func (r *Reconciler) patchSnoozeUntil(ctx context.Context, project *gardencorev1beta1.Project, snoozeUntil time.Time) error {
+	patch := client.MergeFrom(project.DeepCopy())
+	metav1.SetMetaDataAnnotation(&project.ObjectMeta, v1beta1constants.ProjectStaleSnoozeUntil, snoozeUntil.UTC().Format(time.RFC3339))
+	return r.Client.Patch(ctx, project, patch)
+}
+
+func (r *Reconciler) patchNotifiedStage(ctx context.Context, project *gardencorev1beta1.Project, stage *string) error {
+	patch := client.MergeFrom(project.DeepCopy())
+	if stage == nil {
+		delete(project.Annotations, v1beta1constants.ProjectStaleNotifiedDaysBeforeDeletion)
+	} else {
+		metav1.SetMetaDataAnnotation(&project.ObjectMeta, v1beta1constants.ProjectStaleNotifiedDaysBeforeDeletion, *stage)
+	}
+	return r.Client.Patch(ctx, project, patch)
+}
+
+func (r *Reconciler) notification(project *gardencorev1beta1.Project, notificationType NotificationType, daysBeforeDeletion int) Notification {
+	return Notification{
+		Type:                     notificationType,
+		Project:                  project.Name,
+		Namespace:                ptr.Deref(project.Spec.Namespace, ""),
+		Owner:                    project.Spec.Owner,
+		StaleSinceTimestamp:      project.Status.StaleSinceTimestamp,
+		StaleAutoDeleteTimestamp: project.Status.StaleAutoDeleteTimestamp,
+		DaysBeforeDeletion:       daysBeforeDeletion,
+	}
+}
+
+// recordDeletion creates a ConfigMap in the garden namespace which serves as audit record for the automatic deletion of
+// the given stale Project.
+func (r *Reconciler) recordDeletion(ctx context.Context, project *gardencorev1beta1.Project) error {
+	formatTime := func(t *metav1.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.UTC().Format(time.RFC3339)
+	}
+
+	var owner string
+	if project.Spec.Owner != nil {
+		owner = project.Spec.Owner.Name
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("project-deletion-%s-%d", project.Name, project.Status.StaleAutoDeleteTimestamp.Unix()),
+			Namespace: v1beta1constants.GardenNamespace,
+			Labels: map[string]string{
+				v1beta1constants.LabelProjectDeletionAudit: "true",
+				v1beta1constants.ProjectName:               project.Name,
+			},
+		},
+		Data: map[string]string{
+			"project":                  project.Name,
+			"namespace":                ptr.Deref(project.Spec.Namespace, ""),
+			"owner":                    owner,
+			"creationTimestamp":        formatTime(&project.CreationTimestamp),
+			"lastActivityTimestamp":    formatTime(project.Status.LastActivityTimestamp),
+			"staleSinceTimestamp":      formatTime(project.Status.StaleSinceTimestamp),
+			"staleAutoDeleteTimestamp": formatTime(project.Status.StaleAutoDeleteTimestamp),
+			"deletionTimestamp":        r.Clock.Now().UTC().Format(time.RFC3339),
+		},
+	}
+
+	if err := r.Client.Create(ctx, configMap); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
 }
 
 func (r *Reconciler) projectInUseDueToShoots(ctx context.Context, namespace string) (bool, error) {
@@ -216,6 +359,12 @@ func (r *Reconciler) relevantSecretBindingsInUse(ctx context.Context, isSecretBi
 }
 
 func (r *Reconciler) markProjectAsNotStale(ctx context.Context, project *gardencorev1beta1.Project) error {
+	if _, ok := project.Annotations[v1beta1constants.ProjectStaleNotifiedDaysBeforeDeletion]; ok {
+		if err := r.patchNotifiedStage(ctx, project, nil); err != nil {
+			return err
+		}
+	}
+
 	patch := client.MergeFrom(project.DeepCopy())
 	project.Status.StaleSinceTimestamp = nil
 	project.Status.StaleAutoDeleteTimestamp = nil
@@ -6,10 +6,12 @@ package stale_test
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -147,6 +149,61 @@ var _ = Describe("Reconciler", func() {
 			Expect(result).To(Succeed())
 		})
 
+		It("should mark the project as 'not stale' because the stale check is snoozed", func() {
+			fakeClock.SetTime(time.Date(100, 1, 1, 0, 0, 0, 0, time.UTC))
+
+			project.Annotations = map[string]string{v1beta1constants.ProjectStaleSnoozeUntil: "0100-01-02T00:00:00Z"}
+
+			expectNonStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project)
+
+			_, result := reconciler.Reconcile(ctx, request)
+			Expect(result).To(Succeed())
+		})
+
+		It("should reduce the snooze to the configured maximum and mark the project as 'not stale'", func() {
+			fakeClock.SetTime(time.Date(100, 1, 1, 0, 0, 0, 0, time.UTC))
+			cfg.StaleSnoozeMaxDays = ptr.To(30)
+			reconciler = &Reconciler{Client: k8sGardenRuntimeClient, Config: cfg, Clock: fakeClock}
+
+			project.Annotations = map[string]string{v1beta1constants.ProjectStaleSnoozeUntil: "0200-01-01T00:00:00Z"}
+
+			projectPatched := project.DeepCopy()
+			projectPatched.Annotations = map[string]string{v1beta1constants.ProjectStaleSnoozeUntil: "0100-01-31T00:00:00Z"}
+			test.EXPECTPatch(gomock.Any(), k8sGardenRuntimeClient, projectPatched, project, types.MergePatchType)
+			expectNonStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, projectPatched)
+
+			_, result := reconciler.Reconcile(ctx, request)
+			Expect(result).To(Succeed())
+		})
+
+		It("should not change a snooze within the configured maximum", func() {
+			fakeClock.SetTime(time.Date(100, 1, 1, 0, 0, 0, 0, time.UTC))
+			cfg.StaleSnoozeMaxDays = ptr.To(30)
+			reconciler = &Reconciler{Client: k8sGardenRuntimeClient, Config: cfg, Clock: fakeClock}
+
+			project.Annotations = map[string]string{v1beta1constants.ProjectStaleSnoozeUntil: "0100-01-02T00:00:00Z"}
+
+			expectNonStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project)
+
+			_, result := reconciler.Reconcile(ctx, request)
+			Expect(result).To(Succeed())
+		})
+
+		It("should reset the notified stage when marking the project as 'not stale'", func() {
+			fakeClock.SetTime(time.Date(100, 1, 1, 0, 0, 0, 0, time.UTC))
+
+			namespace.Annotations = map[string]string{v1beta1constants.ProjectSkipStaleCheck: "true"}
+			project.Annotations = map[string]string{v1beta1constants.ProjectStaleNotifiedDaysBeforeDeletion: "7"}
+
+			projectPatched := project.DeepCopy()
+			projectPatched.Annotations = map[string]string{}
+			test.EXPECTPatch(gomock.Any(), k8sGardenRuntimeClient, projectPatched, project, types.MergePatchType)
+			expectNonStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, projectPatched)
+
+			_, result := reconciler.Reconcile(ctx, request)
+			Expect(result).To(Succeed())
+		})
+
 		It("should mark the project as 'not stale' because it is younger than the configured MinimumLifetimeDays", func() {
 			fakeClock.SetTime(time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC))
 
@@ -446,12 +503,94 @@ var _ = Describe("Reconciler", func() {
 					Expect(result).To(Succeed())
 				})
 
+				It("should mark the project as stale because the snooze expired", func() {
+					project.Annotations = map[string]string{v1beta1constants.ProjectStaleSnoozeUntil: "0001-01-02T00:00:00Z"}
+
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialShootMetaList, client.InNamespace(namespaceName), client.Limit(1))
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialBackupEntryMetaList, client.InNamespace(namespaceName), client.Limit(1))
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&corev1.SecretList{}), client.InNamespace(namespaceName))
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialQuotaMetaList, client.InNamespace(namespaceName))
+
+					expectStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project, nil, nil, fakeClock)
+
+					_, result := reconciler.Reconcile(ctx, request)
+					Expect(result).To(Succeed())
+				})
+
+				Context("notifications", func() {
+					var (
+						notifier *fakeNotifier
+
+						staleSinceTimestamp      metav1.Time
+						staleAutoDeleteTimestamp metav1.Time
+					)
+
+					BeforeEach(func() {
+						notifier = &fakeNotifier{}
+						cfg.StaleNotifications = &config.ProjectStaleNotificationConfiguration{DaysBeforeDeletion: []int{7, 1}}
+						reconciler = &Reconciler{Client: k8sGardenRuntimeClient, Config: cfg, Clock: fakeClock, Notifier: notifier}
+
+						staleSinceTimestamp = metav1.Time{Time: fakeClock.Now().Add(-24 * time.Hour * time.Duration(staleExpirationTimeDays-5))}
+						staleAutoDeleteTimestamp = metav1.Time{Time: staleSinceTimestamp.Add(24 * time.Hour * time.Duration(staleExpirationTimeDays))}
+						project.Status.StaleSinceTimestamp = &staleSinceTimestamp
+
+						k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialShootMetaList, client.InNamespace(namespaceName), client.Limit(1))
+						k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialBackupEntryMetaList, client.InNamespace(namespaceName), client.Limit(1))
+						k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&corev1.SecretList{}), client.InNamespace(namespaceName))
+						k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialQuotaMetaList, client.InNamespace(namespaceName))
+					})
+
+					It("should notify the owner when a stage is reached", func() {
+						expectStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project, &staleSinceTimestamp, &staleAutoDeleteTimestamp, fakeClock)
+
+						projectStale := project.DeepCopy()
+						projectStale.Status.StaleAutoDeleteTimestamp = &staleAutoDeleteTimestamp
+						projectPatched := projectStale.DeepCopy()
+						projectPatched.Annotations = map[string]string{v1beta1constants.ProjectStaleNotifiedDaysBeforeDeletion: "7"}
+						test.EXPECTPatch(gomock.Any(), k8sGardenRuntimeClient, projectPatched, projectStale, types.MergePatchType)
+
+						_, result := reconciler.Reconcile(ctx, request)
+						Expect(result).To(Succeed())
+						Expect(notifier.notifications).To(ConsistOf(Notification{
+							Type:                     NotificationTypeDeletionScheduled,
+							Project:                  projectName,
+							Namespace:                namespaceName,
+							StaleSinceTimestamp:      &staleSinceTimestamp,
+							StaleAutoDeleteTimestamp: &staleAutoDeleteTimestamp,
+							DaysBeforeDeletion:       5,
+						}))
+					})
+
+					It("should not notify the owner again for the same stage", func() {
+						project.Annotations = map[string]string{v1beta1constants.ProjectStaleNotifiedDaysBeforeDeletion: "7"}
+						expectStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project, &staleSinceTimestamp, &staleAutoDeleteTimestamp, fakeClock)
+
+						_, result := reconciler.Reconcile(ctx, request)
+						Expect(result).To(Succeed())
+						Expect(notifier.notifications).To(BeEmpty())
+					})
+
+					It("should not notify the owner if no stage is reached", func() {
+						cfg.StaleNotifications.DaysBeforeDeletion = []int{3}
+						reconciler = &Reconciler{Client: k8sGardenRuntimeClient, Config: cfg, Clock: fakeClock, Notifier: notifier}
+						expectStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project, &staleSinceTimestamp, &staleAutoDeleteTimestamp, fakeClock)
+
+						_, result := reconciler.Reconcile(ctx, request)
+						Expect(result).To(Succeed())
+						Expect(notifier.notifications).To(BeEmpty())
+					})
+				})
+
 				It("should delete the project if the auto delete timestamp is exceeded", func() {
 					var (
 						staleSinceTimestamp      = metav1.Time{Time: fakeClock.Now().Add(-24 * time.Hour * 3 * time.Duration(staleExpirationTimeDays))}
 						staleAutoDeleteTimestamp = metav1.Time{Time: fakeClock.Now()}
+						notifier                 = &fakeNotifier{}
 					)
 
+					cfg.StaleNotifications = &config.ProjectStaleNotificationConfiguration{DaysBeforeDeletion: []int{7, 1}}
+					reconciler = &Reconciler{Client: k8sGardenRuntimeClient, Config: cfg, Clock: fakeClock, Notifier: notifier}
+
 					project.Status.StaleSinceTimestamp = &staleSinceTimestamp
 					project.Status.StaleAutoDeleteTimestamp = &staleAutoDeleteTimestamp
 
@@ -471,11 +610,35 @@ var _ = Describe("Reconciler", func() {
 						gardenerutils.ConfirmationDeletion: "true",
 						v1beta1constants.GardenerTimestamp: gardenerutils.TimeNow().UTC().Format(time.RFC3339Nano),
 					}
+					k8sGardenRuntimeClient.EXPECT().Create(gomock.Any(), &corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      fmt.Sprintf("project-deletion-%s-%d", projectName, staleAutoDeleteTimestamp.Unix()),
+							Namespace: "garden",
+							Labels: map[string]string{
+								"project.gardener.cloud/deletion-audit": "true",
+								"project.gardener.cloud/name":           projectName,
+							},
+						},
+						Data: map[string]string{
+							"project":                  projectName,
+							"namespace":                namespaceName,
+							"owner":                    "",
+							"creationTimestamp":        project.CreationTimestamp.UTC().Format(time.RFC3339),
+							"lastActivityTimestamp":    "",
+							"staleSinceTimestamp":      staleSinceTimestamp.UTC().Format(time.RFC3339),
+							"staleAutoDeleteTimestamp": staleAutoDeleteTimestamp.UTC().Format(time.RFC3339),
+							"deletionTimestamp":        fakeClock.Now().UTC().Format(time.RFC3339),
+						},
+					})
 					k8sGardenRuntimeClient.EXPECT().Patch(gomock.Any(), projectCopy, gomock.Any())
 					k8sGardenRuntimeClient.EXPECT().Delete(gomock.Any(), projectCopy)
 
 					_, result := reconciler.Reconcile(ctx, request)
 					Expect(result).To(Succeed())
+					Expect(notifier.notifications).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+						"Type":    Equal(NotificationTypeDeleted),
+						"Project": Equal(projectName),
+					})))
 				})
 			})
 		})
@@ -505,3 +668,12 @@ func expectStaleMarking(k8sGardenRuntimeClient *mockclient.MockClient, mockStatu
 
 	test.EXPECTStatusPatch(gomock.Any(), mockStatusWriter, projectPatched, project, types.MergePatchType)
 }
+
+type fakeNotifier struct {
+	notifications []Notification
+}
+
+func (n *fakeNotifier) Notify(_ context.Context, _ *gardencorev1beta1.Project, notification Notification) error {
+	n.notifications = append(n.notifications, notification)
+	return nil
+}
@@ -5,19 +5,30 @@
 package quota
 
 import (
+	"context"
+
+	"github.com/go-logr/logr"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllerutils/mapper"
 )
 
 // ControllerName is the name of this controller.
 const ControllerName = "quota"
 
 // AddToManager adds Reconciler to the given manager.
-func (r *Reconciler) AddToManager(mgr manager.Manager) error {
+func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) error {
 	if r.Client == nil {
 		r.Client = mgr.GetClient()
 	}
@@ -25,12 +36,107 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 		r.Recorder = mgr.GetEventRecorderFor(ControllerName + "-controller")
 	}
 
-	return builder.
+	c, err := builder.
 		ControllerManagedBy(mgr).
 		Named(ControllerName).
 		For(&gardencorev1beta1.Quota{}).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
 		}).
-		Complete(r)
+		Build(r)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &gardencorev1beta1.Shoot{}),
+		mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapShootToQuotas), mapper.UpdateWithOldAndNew, c.GetLogger()),
+		r.ShootPredicate(),
+	); err != nil {
+		return err
+	}
+
+	return c.Watch(
+		source.Kind(mgr.GetCache(), &gardencorev1beta1.SecretBinding{}),
+		mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapSecretBindingToQuotas), mapper.UpdateWithOldAndNew, c.GetLogger()),
+		r.SecretBindingPredicate(),
+	)
+}
+
+// ShootPredicate returns the predicates for the core.gardener.cloud/v1beta1.Shoot watch. It reacts on creations and
+// deletions as well as on changes of the fields which are relevant for the quota usage.
+func (r *Reconciler) ShootPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool { return true },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			shoot, ok := e.ObjectNew.(*gardencorev1beta1.Shoot)
+			if !ok {
+				return false
+			}
+
+			oldShoot, ok := e.ObjectOld.(*gardencorev1beta1.Shoot)
+			if !ok {
+				return false
+			}
+
+			return !apiequality.Semantic.DeepEqual(oldShoot.Spec.Provider.Workers, shoot.Spec.Provider.Workers) ||
+				!apiequality.Semantic.DeepEqual(oldShoot.Spec.Addons, shoot.Spec.Addons) ||
+				!apiequality.Semantic.DeepEqual(oldShoot.Spec.SecretBindingName, shoot.Spec.SecretBindingName)
+		},
+		DeleteFunc:  func(event.DeleteEvent) bool { return true },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
+// SecretBindingPredicate returns the predicates for the core.gardener.cloud/v1beta1.SecretBinding watch. It reacts on
+// creations and deletions as well as on changes of the referenced quotas.
+func (r *Reconciler) SecretBindingPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool { return true },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			secretBinding, ok := e.ObjectNew.(*gardencorev1beta1.SecretBinding)
+			if !ok {
+				return false
+			}
+
+			oldSecretBinding, ok := e.ObjectOld.(*gardencorev1beta1.SecretBinding)
+			if !ok {
+				return false
+			}
+
+			return !apiequality.Semantic.DeepEqual(oldSecretBinding.Quotas, secretBinding.Quotas)
+		},
+		DeleteFunc:  func(event.DeleteEvent) bool { return true },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
+// MapShootToQuotas is a mapper.MapFunc for mapping a Shoot to the Quotas referenced by its SecretBinding.
+func (r *Reconciler) MapShootToQuotas(ctx context.Context, log logr.Logger, reader client.Reader, obj client.Object) []reconcile.Request {
+	shoot, ok := obj.(*gardencorev1beta1.Shoot)
+	if !ok || shoot.Spec.SecretBindingName == nil {
+		return nil
+	}
+
+	secretBinding := &gardencorev1beta1.SecretBinding{}
+	if err := reader.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: *shoot.Spec.SecretBindingName}, secretBinding); err != nil {
+		log.Error(err, "Failed to get SecretBinding for shoot", "shoot", client.ObjectKeyFromObject(shoot))
+		return nil
+	}
+
+	return r.MapSecretBindingToQuotas(ctx, log, reader, secretBinding)
+}
+
+// MapSecretBindingToQuotas is a mapper.MapFunc for mapping a SecretBinding to the Quotas it references.
+func (r *Reconciler) MapSecretBindingToQuotas(_ context.Context, _ logr.Logger, _ client.Reader, obj client.Object) []reconcile.Request {
+	secretBinding, ok := obj.(*gardencorev1beta1.SecretBinding)
+	if !ok {
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(secretBinding.Quotas))
+	for _, quotaRef := range secretBinding.Quotas {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: quotaRef.Namespace, Name: quotaRef.Name}})
+	}
+	return requests
 }
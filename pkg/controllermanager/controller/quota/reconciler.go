@@ -8,19 +8,24 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/gardener/gardener/pkg/apis/core/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
 // Reconciler reconciles Quota.
@@ -84,5 +89,153 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		}
 	}
 
+	if err := r.updateUsage(ctx, log, quota); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to update usage: %w", err)
+	}
+
 	return reconcile.Result{}, nil
 }
+
+// updateUsage computes the resources allocated by all Shoots referencing the Quota via their SecretBindings and records
+// them in the status of the Quota. For Quotas with scope 'project', the usage is additionally recorded per project.
+// An event is emitted when the usage starts exceeding a soft limit of the Quota.
+func (r *Reconciler) updateUsage(ctx context.Context, log logr.Logger, quota *gardencorev1beta1.Quota) error {
+	scope, err := helper.QuotaScope(quota.Spec.Scope)
+	if err != nil {
+		return err
+	}
+
+	shoots, err := r.findShootsReferringQuota(ctx, quota)
+	if err != nil {
+		return err
+	}
+
+	var (
+		cloudProfiles   = make(map[string]*gardencorev1beta1.CloudProfile)
+		namespacedUsage = make(map[string]corev1.ResourceList)
+		resourceLists   = make([]corev1.ResourceList, 0, len(shoots))
+	)
+
+	for _, shoot := range shoots {
+		cloudProfile, ok := cloudProfiles[shoot.Spec.CloudProfileName]
+		if !ok {
+			cloudProfile = &gardencorev1beta1.CloudProfile{}
+			if err := r.Client.Get(ctx, client.ObjectKey{Name: shoot.Spec.CloudProfileName}, cloudProfile); err != nil {
+				return fmt.Errorf("failed reading CloudProfile %s of shoot %s: %w", shoot.Spec.CloudProfileName, client.ObjectKeyFromObject(&shoot), err)
+			}
+			cloudProfiles[shoot.Spec.CloudProfileName] = cloudProfile
+		}
+
+		resources, err := gardenerutils.ComputeShootQuotaResources(&shoot, cloudProfile)
+		if err != nil {
+			return fmt.Errorf("failed computing resources of shoot %s: %w", client.ObjectKeyFromObject(&shoot), err)
+		}
+
+		resourceLists = append(resourceLists, resources)
+		namespacedUsage[shoot.Namespace] = gardenerutils.SumQuotaResources(namespacedUsage[shoot.Namespace], resources)
+	}
+
+	var (
+		oldStatus     = quota.Status.DeepCopy()
+		usage         = gardenerutils.SumQuotaResources(resourceLists...)
+		projectUsages []gardencorev1beta1.QuotaProjectUsage
+	)
+
+	// Quotas with scope 'project' limit the resources per project, hence the usage is recorded per namespace.
+	if scope == "project" {
+		for _, namespace := range sets.List(sets.KeySet(namespacedUsage)) {
+			projectUsages = append(projectUsages, gardencorev1beta1.QuotaProjectUsage{Namespace: namespace, Usage: namespacedUsage[namespace]})
+		}
+	}
+
+	if !apiequality.Semantic.DeepEqual(quota.Status.Usage, usage) || !apiequality.Semantic.DeepEqual(quota.Status.ProjectUsages, projectUsages) {
+		log.Info("Updating usage", "usage", usage)
+		patch := client.MergeFrom(quota.DeepCopy())
+		quota.Status.Usage = usage
+		quota.Status.ProjectUsages = projectUsages
+		if err := r.Client.Status().Patch(ctx, quota, patch); err != nil {
+			return err
+		}
+	}
+
+	if len(quota.Spec.SoftLimits) == 0 {
+		return nil
+	}
+
+	if scope != "project" {
+		if exceededMetrics := newlyExceededQuotaMetrics(quota.Spec.SoftLimits, oldStatus.Usage, usage); len(exceededMetrics) > 0 {
+			r.Recorder.Eventf(quota, corev1.EventTypeWarning, v1beta1constants.EventQuotaSoftLimitExceeded, "Soft limits are exceeded for %v", exceededMetrics)
+		}
+		return nil
+	}
+
+	for _, projectUsage := range projectUsages {
+		if exceededMetrics := newlyExceededQuotaMetrics(quota.Spec.SoftLimits, projectUsageOf(oldStatus.ProjectUsages, projectUsage.Namespace), projectUsage.Usage); len(exceededMetrics) > 0 {
+			r.Recorder.Eventf(quota, corev1.EventTypeWarning, v1beta1constants.EventQuotaSoftLimitExceeded, "Soft limits are exceeded in namespace %s for %v", projectUsage.Namespace, exceededMetrics)
+		}
+	}
+
+	return nil
+}
+
+// newlyExceededQuotaMetrics returns the metrics whose soft limits are exceeded by the given usage but were not exceeded
+// by the given previous usage.
+func newlyExceededQuotaMetrics(softLimits, oldUsage, usage corev1.ResourceList) []corev1.ResourceName {
+	var (
+		previouslyExceeded = sets.New(gardenerutils.ExceededQuotaMetrics(softLimits, oldUsage)...)
+		exceededMetrics    []corev1.ResourceName
+	)
+
+	for _, metric := range gardenerutils.ExceededQuotaMetrics(softLimits, usage) {
+		if !previouslyExceeded.Has(metric) {
+			exceededMetrics = append(exceededMetrics, metric)
+		}
+	}
+
+	return exceededMetrics
+}
+
+func projectUsageOf(projectUsages []gardencorev1beta1.QuotaProjectUsage, namespace string) corev1.ResourceList {
+	for _, projectUsage := range projectUsages {
+		if projectUsage.Namespace == namespace {
+			return projectUsage.Usage
+		}
+	}
+	return nil
+}
+
+func (r *Reconciler) findShootsReferringQuota(ctx context.Context, quota *gardencorev1beta1.Quota) ([]gardencorev1beta1.Shoot, error) {
+	secretBindingList := &gardencorev1beta1.SecretBindingList{}
+	if err := r.Client.List(ctx, secretBindingList); err != nil {
+		return nil, err
+	}
+
+	var shoots []gardencorev1beta1.Shoot
+	for _, secretBinding := range secretBindingList.Items {
+		if !referencesQuota(&secretBinding, quota) {
+			continue
+		}
+
+		shootList := &gardencorev1beta1.ShootList{}
+		if err := r.Client.List(ctx, shootList, client.InNamespace(secretBinding.Namespace)); err != nil {
+			return nil, err
+		}
+
+		for _, shoot := range shootList.Items {
+			if ptr.Deref(shoot.Spec.SecretBindingName, "") == secretBinding.Name {
+				shoots = append(shoots, shoot)
+			}
+		}
+	}
+
+	return shoots, nil
+}
+
+func referencesQuota(secretBinding *gardencorev1beta1.SecretBinding, quota *gardencorev1beta1.Quota) bool {
+	for _, quotaRef := range secretBinding.Quotas {
+		if quotaRef.Name == quota.Name && quotaRef.Namespace == quota.Namespace {
+			return true
+		}
+	}
+	return false
+}
@@ -10,9 +10,12 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -36,7 +39,7 @@ var _ = Describe("Reconciler", func() {
 	)
 
 	BeforeEach(func() {
-		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Quota{}).Build()
 
 		quotaName = "test-quota"
 		reconciler = &Reconciler{Client: fakeClient, Recorder: &record.FakeRecorder{}}
@@ -44,6 +47,9 @@ var _ = Describe("Reconciler", func() {
 			ObjectMeta: metav1.ObjectMeta{
 				Name: quotaName,
 			},
+			Spec: gardencorev1beta1.QuotaSpec{
+				Scope: corev1.ObjectReference{APIVersion: "v1", Kind: "Secret"},
+			},
 		}
 
 		secretBinding = &gardencorev1beta1.SecretBinding{
@@ -78,6 +84,174 @@ var _ = Describe("Reconciler", func() {
 		})
 	})
 
+	Context("usage", func() {
+		var (
+			recorder     *record.FakeRecorder
+			cloudProfile *gardencorev1beta1.CloudProfile
+			shoot        *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			recorder = record.NewFakeRecorder(2)
+			reconciler = &Reconciler{Client: fakeClient, Recorder: recorder}
+
+			cloudProfile = &gardencorev1beta1.CloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					MachineTypes: []gardencorev1beta1.MachineType{{
+						Name:   "large",
+						CPU:    resource.MustParse("4"),
+						GPU:    resource.MustParse("0"),
+						Memory: resource.MustParse("16Gi"),
+						Storage: &gardencorev1beta1.MachineTypeStorage{
+							Class:       gardencorev1beta1.VolumeClassStandard,
+							StorageSize: ptr.To(resource.MustParse("50Gi")),
+						},
+					}},
+				},
+			}
+			shoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: secretBinding.Namespace},
+				Spec: gardencorev1beta1.ShootSpec{
+					CloudProfileName:  cloudProfile.Name,
+					SecretBindingName: ptr.To(secretBinding.Name),
+					Provider: gardencorev1beta1.Provider{
+						Workers: []gardencorev1beta1.Worker{{
+							Name:     "worker",
+							Machine:  gardencorev1beta1.Machine{Type: "large"},
+							Maximum:  3,
+							MaxSurge: ptr.To(intstr.FromInt32(2)),
+						}},
+					},
+				},
+			}
+
+			Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeClient.Create(ctx, secretBinding)).To(Succeed())
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		})
+
+		reconcileUsage := func() map[string]string {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: quotaName}})
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(quota), quota)).To(Succeed())
+
+			usage := make(map[string]string, len(quota.Status.Usage))
+			for name, quantity := range quota.Status.Usage {
+				usage[string(name)] = quantity.String()
+			}
+			return usage
+		}
+
+		It("should record the usage of the shoots referencing the quota", func() {
+			Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+
+			Expect(reconcileUsage()).To(Equal(map[string]string{
+				"cpu":              "12",
+				"gpu":              "0",
+				"memory":           "48Gi",
+				"storage.standard": "150Gi",
+				"storage.premium":  "0",
+				"loadbalancer":     "1",
+				"shoots":           "1",
+				"nodes":            "3",
+				"maxsurge":         "2",
+			}))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should not consider shoots using other secret bindings", func() {
+			otherShoot := shoot.DeepCopy()
+			otherShoot.ResourceVersion = ""
+			otherShoot.Name = "other"
+			otherShoot.Spec.SecretBindingName = ptr.To("other")
+			Expect(fakeClient.Create(ctx, otherShoot)).To(Succeed())
+			Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+
+			Expect(reconcileUsage()).To(HaveKeyWithValue("shoots", "1"))
+		})
+
+		It("should emit an event if a soft limit is exceeded", func() {
+			quota.Spec.SoftLimits = corev1.ResourceList{"cpu": resource.MustParse("10"), "nodes": resource.MustParse("5")}
+			Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+
+			Expect(reconcileUsage()).To(HaveKeyWithValue("cpu", "12"))
+			Expect(recorder.Events).To(Receive(Equal("Warning QuotaSoftLimitExceeded Soft limits are exceeded for [cpu]")))
+		})
+
+		It("should emit the event only when the soft limit is crossed", func() {
+			quota.Spec.SoftLimits = corev1.ResourceList{"cpu": resource.MustParse("10")}
+			Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+
+			Expect(reconcileUsage()).To(HaveKeyWithValue("cpu", "12"))
+			Expect(recorder.Events).To(Receive(Equal("Warning QuotaSoftLimitExceeded Soft limits are exceeded for [cpu]")))
+
+			By("Reconcile again with unchanged usage")
+			Expect(reconcileUsage()).To(HaveKeyWithValue("cpu", "12"))
+			Expect(recorder.Events).To(BeEmpty())
+
+			By("Drop below the soft limit")
+			shoot.Spec.Provider.Workers[0].Maximum = 2
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+			Expect(reconcileUsage()).To(HaveKeyWithValue("cpu", "8"))
+			Expect(recorder.Events).To(BeEmpty())
+
+			By("Exceed the soft limit again")
+			shoot.Spec.Provider.Workers[0].Maximum = 3
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+			Expect(reconcileUsage()).To(HaveKeyWithValue("cpu", "12"))
+			Expect(recorder.Events).To(Receive(Equal("Warning QuotaSoftLimitExceeded Soft limits are exceeded for [cpu]")))
+		})
+
+		Context("project scope", func() {
+			var otherShoot *gardencorev1beta1.Shoot
+
+			BeforeEach(func() {
+				quota.Spec.Scope = corev1.ObjectReference{APIVersion: "core.gardener.cloud/v1beta1", Kind: "Project"}
+
+				otherSecretBinding := secretBinding.DeepCopy()
+				otherSecretBinding.ResourceVersion = ""
+				otherSecretBinding.Namespace = "other-namespace"
+				Expect(fakeClient.Create(ctx, otherSecretBinding)).To(Succeed())
+				otherShoot = shoot.DeepCopy()
+				otherShoot.ResourceVersion = ""
+				otherShoot.Namespace = "other-namespace"
+				Expect(fakeClient.Create(ctx, otherShoot)).To(Succeed())
+			})
+
+			It("should record the total usage and the usage per project", func() {
+				Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+
+				Expect(reconcileUsage()).To(HaveKeyWithValue("shoots", "2"))
+				Expect(quota.Status.ProjectUsages).To(HaveLen(2))
+				Expect(quota.Status.ProjectUsages[0].Namespace).To(Equal(otherShoot.Namespace))
+				Expect(quota.Status.ProjectUsages[0].Usage).To(HaveKeyWithValue(corev1.ResourceName("shoots"), resource.MustParse("1")))
+				Expect(quota.Status.ProjectUsages[1].Namespace).To(Equal(shoot.Namespace))
+				Expect(quota.Status.ProjectUsages[1].Usage).To(HaveKeyWithValue(corev1.ResourceName("shoots"), resource.MustParse("1")))
+			})
+
+			It("should check the soft limits per project", func() {
+				quota.Spec.SoftLimits = corev1.ResourceList{"shoots": resource.MustParse("1")}
+				Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+
+				Expect(reconcileUsage()).To(HaveKeyWithValue("shoots", "2"))
+				Expect(recorder.Events).To(BeEmpty())
+
+				By("Exceed the soft limit in one project")
+				thirdShoot := otherShoot.DeepCopy()
+				thirdShoot.ResourceVersion = ""
+				thirdShoot.Name = "third"
+				Expect(fakeClient.Create(ctx, thirdShoot)).To(Succeed())
+
+				Expect(reconcileUsage()).To(HaveKeyWithValue("shoots", "3"))
+				Expect(recorder.Events).To(Receive(Equal("Warning QuotaSoftLimitExceeded Soft limits are exceeded in namespace other-namespace for [shoots]")))
+
+				Expect(reconcileUsage()).To(HaveKeyWithValue("shoots", "3"))
+				Expect(recorder.Events).To(BeEmpty())
+			})
+		})
+	})
+
 	Context("when deletion timestamp set", func() {
 		BeforeEach(func() {
 			quota.Finalizers = []string{finalizerName}
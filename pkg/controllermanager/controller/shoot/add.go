@@ -13,10 +13,12 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/conditions"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/hibernation"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/idlehibernation"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/maintenance"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/quota"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/reference"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/retry"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/rolloutwave"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/statuslabel"
 )
 
@@ -34,6 +36,22 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg config.Controlle
 		return fmt.Errorf("failed adding hibernation reconciler: %w", err)
 	}
 
+	if cfg.Server.ShootWakeUp != nil {
+		if err := (&hibernation.WakeUpHandler{
+			Logger: mgr.GetLogger().WithName("webhook").WithName(hibernation.WakeUpHandlerName),
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding wake-up handler: %w", err)
+		}
+	}
+
+	if cfg.Controllers.ShootIdleHibernation != nil {
+		if err := (&idlehibernation.Reconciler{
+			Config: *cfg.Controllers.ShootIdleHibernation,
+		}).AddToManager(ctx, mgr); err != nil {
+			return fmt.Errorf("failed adding idle hibernation reconciler: %w", err)
+		}
+	}
+
 	if err := (&maintenance.Reconciler{
 		Config: cfg.Controllers.ShootMaintenance,
 	}).AddToManager(mgr); err != nil {
@@ -56,6 +74,14 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg config.Controlle
 		return fmt.Errorf("failed adding retry reconciler: %w", err)
 	}
 
+	if cfg.Controllers.ShootMaintenance.RolloutWaves != nil {
+		if err := (&rolloutwave.Reconciler{
+			Config: *cfg.Controllers.ShootMaintenance.RolloutWaves,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding rollout wave reconciler: %w", err)
+		}
+	}
+
 	if err := (&statuslabel.Reconciler{
 		Config: *cfg.Controllers.ShootStatusLabel,
 	}).AddToManager(mgr); err != nil {
@@ -16,6 +16,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 )
 
 // ControllerName is the name of this controller.
@@ -51,7 +52,7 @@ func (r *Reconciler) ShootPredicate() predicate.Predicate {
 			if !ok {
 				return false
 			}
-			return len(getShootHibernationSchedules(shoot.Spec.Hibernation)) > 0
+			return len(getShootHibernationSchedules(shoot.Spec.Hibernation)) > 0 || hasHibernationAnnotations(shoot)
 		},
 		UpdateFunc: func(e event.UpdateEvent) bool {
 			shoot, ok := e.ObjectNew.(*gardencorev1beta1.Shoot)
@@ -69,7 +70,33 @@ func (r *Reconciler) ShootPredicate() predicate.Predicate {
 				newSchedules = getShootHibernationSchedules(shoot.Spec.Hibernation)
 			)
 
-			return !reflect.DeepEqual(oldSchedules, newSchedules) && len(newSchedules) > 0
+			return (!reflect.DeepEqual(oldSchedules, newSchedules) && len(newSchedules) > 0) ||
+				hibernationAnnotationsChanged(oldShoot, shoot)
 		},
 	}
 }
+
+var hibernationAnnotations = []string{
+	v1beta1constants.AnnotationShootHibernationKeepAwakeUntil,
+	v1beta1constants.AnnotationShootHibernationExcludedDates,
+	v1beta1constants.AnnotationShootHibernationWakeUpIdleTimeout,
+	v1beta1constants.AnnotationShootHibernationWakeUpRequestedAt,
+}
+
+func hasHibernationAnnotations(shoot *gardencorev1beta1.Shoot) bool {
+	for _, key := range hibernationAnnotations {
+		if _, ok := shoot.Annotations[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func hibernationAnnotationsChanged(oldShoot, shoot *gardencorev1beta1.Shoot) bool {
+	for _, key := range hibernationAnnotations {
+		if oldShoot.Annotations[key] != shoot.Annotations[key] {
+			return true
+		}
+	}
+	return false
+}
@@ -59,6 +59,12 @@ var _ = Describe("Add", func() {
 			It("should return true because shoot has hibernation schedules", func() {
 				Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
 			})
+
+			It("should return true because shoot has hibernation annotations", func() {
+				shoot.Spec.Hibernation = nil
+				shoot.Annotations = map[string]string{"shoot.gardener.cloud/hibernation-wake-up-idle-timeout": "1h"}
+				Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+			})
 		})
 
 		Describe("#Update", func() {
@@ -85,6 +91,18 @@ var _ = Describe("Add", func() {
 				shoot.Spec.Hibernation.Schedules[0].Start = ptr.To("00 20 * * 1,2,3,4,5,6,7")
 				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
 			})
+
+			It("should return true because hibernation annotations have changed", func() {
+				oldShoot := shoot.DeepCopy()
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/hibernation-keep-awake-until", "2024-04-12T18:00:00Z")
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+			})
+
+			It("should return false because other annotations have changed", func() {
+				oldShoot := shoot.DeepCopy()
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "foo", "bar")
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeFalse())
+			})
 		})
 	})
 })
@@ -14,6 +14,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
@@ -22,6 +23,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
@@ -39,12 +42,13 @@ const (
 	wakeUp
 )
 
-// parsedHibernationSchedule holds the loaded location, parsed cron schedule and information whether
-// the cluster should be hibernated or woken up.
+// parsedHibernationSchedule holds the loaded location, parsed cron schedule, the dates on which the schedule must
+// not be executed and information whether the cluster should be hibernated or woken up.
 type parsedHibernationSchedule struct {
-	location  time.Location
-	schedule  cron.Schedule
-	operation operation
+	location      time.Location
+	schedule      cron.Schedule
+	excludedDates sets.Set[string]
+	operation     operation
 }
 
 // next returns the time in UTC from the schedule, that is immediately after the input time 't'.
@@ -54,13 +58,16 @@ func (s *parsedHibernationSchedule) next(t time.Time) time.Time {
 }
 
 // previous returns the time in UTC from the schedule that is immediately before 'to' and after 'from'.
-// Nil is returned if no such time can be found.
+// Activation times on excluded dates are skipped. Nil is returned if no such time can be found.
 // The input times - 'to' and 'from' are converted in the schedule's location before any calculation is done.
 func (s *parsedHibernationSchedule) previous(from, to time.Time) *time.Time {
 	// To get the time that is immediately before `to`, iterate over every activation time in the cron schedule
 	// that is after "from" until the one that is immediately after `to` is reached.
 	var previousActivationTime *time.Time
 	for t := s.schedule.Next(from.In(&s.location)); !t.UTC().After(to.UTC()); t = s.schedule.Next(t) {
+		if s.excludedDates.Has(t.Format(gardenerutils.HibernationExcludedDateFormat)) {
+			continue
+		}
 		inUTC := t.UTC()
 		previousActivationTime = &inUTC
 	}
@@ -76,6 +83,60 @@ type Reconciler struct {
 	Recorder record.EventRecorder
 }
 
+// hibernationSettings contains the settings of a Shoot's hibernation which are configured via annotations.
+type hibernationSettings struct {
+	keepAwakeUntil    *time.Time
+	excludedDates     sets.Set[string]
+	idleTimeout       *time.Duration
+	wakeUpRequestedAt *time.Time
+	lastUserActivity  *time.Time
+}
+
+func parseHibernationSettings(annotations map[string]string) (*hibernationSettings, error) {
+	var (
+		settings = &hibernationSettings{}
+		err      error
+	)
+
+	if settings.keepAwakeUntil, err = gardenerutils.GetShootHibernationKeepAwakeUntil(annotations); err != nil {
+		return nil, err
+	}
+	if settings.excludedDates, err = gardenerutils.GetShootHibernationExcludedDates(annotations); err != nil {
+		return nil, err
+	}
+	if settings.idleTimeout, err = gardenerutils.GetShootHibernationWakeUpIdleTimeout(annotations); err != nil {
+		return nil, err
+	}
+	if settings.wakeUpRequestedAt, err = gardenerutils.GetShootHibernationWakeUpRequestedAt(annotations); err != nil {
+		return nil, err
+	}
+	if settings.lastUserActivity, err = gardenerutils.GetShootLastUserActivity(annotations); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// keepAwake returns whether the hibernation schedules must not hibernate the Shoot at the given time.
+func (s *hibernationSettings) keepAwake(now time.Time) bool {
+	return s.keepAwakeUntil != nil && now.Before(*s.keepAwakeUntil)
+}
+
+// idleDeadline returns the time at which a Shoot which was woken up on demand must be hibernated again. The idle
+// time is measured from the wake-up request or from the last user activity recorded by gardenlet, whichever is later.
+// Nil is returned if the Shoot was not woken up on demand.
+func (s *hibernationSettings) idleDeadline() *time.Time {
+	if s.idleTimeout == nil || s.wakeUpRequestedAt == nil {
+		return nil
+	}
+	idleSince := *s.wakeUpRequestedAt
+	if s.lastUserActivity != nil && s.lastUserActivity.After(idleSince) {
+		idleSince = *s.lastUserActivity
+	}
+	deadline := idleSince.Add(*s.idleTimeout)
+	return &deadline
+}
+
 // Reconcile reconciles Shoots and hibernates or wakes them up according to their hibernation schedules.
 func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
 	log := logf.FromContext(ctx)
@@ -97,13 +158,19 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, nil
 	}
 
+	settings, err := parseHibernationSettings(shoot.Annotations)
+	if err != nil {
+		log.Error(err, "Invalid hibernation annotations, stopping reconciliation")
+		return reconcile.Result{}, nil
+	}
+
 	schedules := getShootHibernationSchedules(shoot.Spec.Hibernation)
-	if len(schedules) == 0 {
+	if len(schedules) == 0 && settings.idleDeadline() == nil {
 		log.Info("Hibernation schedules have been removed from shoot, stopping reconciliation")
 		return reconcile.Result{}, nil
 	}
 
-	parsedSchedules, err := parseHibernationSchedules(schedules)
+	parsedSchedules, err := parseHibernationSchedules(schedules, settings.excludedDates)
 	if err != nil {
 		log.Error(err, "Invalid hibernation schedules, stopping reconciliation")
 		return reconcile.Result{}, nil
@@ -111,29 +178,110 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	now := r.Clock.Now()
 	if gardenerutils.IsShootFailedAndUpToDate(shoot) {
-		requeueAfter := nextHibernationTimeDuration(parsedSchedules, now)
+		requeueAfter := nextHibernationTimeDuration(parsedSchedules, settings, now)
 		log.Info("Shoot is in Failed state, requeuing shoot hibernation", "requeueAfter", requeueAfter)
 		return reconcile.Result{RequeueAfter: requeueAfter}, nil
 	}
 
-	// Get the schedule which caused the current reconciliation and check whether the shoot should be hibernated or woken up.
-	// If no such schedule is found, the hibernation schedules were changed mid-air and the shoot must be
-	// hibernated or wakeup the at a later time.
-	mostRecentSchedule := getScheduleWithMostRecentTime(parsedSchedules, r.Config.TriggerDeadlineDuration, shoot, now)
-	if mostRecentSchedule != nil {
-		if err := r.hibernateOrWakeUpShootBasedOnSchedule(ctx, shoot, mostRecentSchedule, now); err != nil {
+	var (
+		mostRecentSchedule *parsedHibernationSchedule
+		removeAnnotations  []string
+	)
+
+	switch {
+	case settings.keepAwake(now):
+		// While the shoot is kept awake, only schedules which wake up the shoot are executed.
+		if schedule := getScheduleWithMostRecentTime(parsedSchedules, r.Config.TriggerDeadlineDuration, shoot, now); schedule != nil && schedule.operation == wakeUp {
+			mostRecentSchedule = schedule
+		}
+	case settings.keepAwakeUntil != nil:
+		// The shoot was kept awake until now, hence the schedule with the most recent time must be executed even if its
+		// trigger deadline has passed in the meantime.
+		log.Info("Shoot is no longer kept awake, removing annotation", "keepAwakeUntil", settings.keepAwakeUntil)
+		mostRecentSchedule = getScheduleWithMostRecentTimeBetween(parsedSchedules, now.Add(-sevenDays), now)
+		removeAnnotations = append(removeAnnotations, v1beta1constants.AnnotationShootHibernationKeepAwakeUntil)
+	default:
+		// Get the schedule which caused the current reconciliation and check whether the shoot should be hibernated or woken up.
+		// If no such schedule is found, the hibernation schedules were changed mid-air and the shoot must be
+		// hibernated or wakeup the at a later time.
+		mostRecentSchedule = getScheduleWithMostRecentTime(parsedSchedules, r.Config.TriggerDeadlineDuration, shoot, now)
+	}
+
+	switch {
+	case mostRecentSchedule != nil:
+		// Executing a schedule ends a wake-up on demand, i.e., the schedules take over again.
+		if settings.wakeUpRequestedAt != nil {
+			removeAnnotations = append(removeAnnotations, v1beta1constants.AnnotationShootHibernationWakeUpRequestedAt)
+		}
+		if err := r.hibernateOrWakeUpShootBasedOnSchedule(ctx, shoot, mostRecentSchedule, now, removeAnnotations...); err != nil {
 			return reconcile.Result{}, err
 		}
 		log.Info("Successfully set hibernation.enabled", "enabled", *shoot.Spec.Hibernation.Enabled)
+
+	case settings.idleDeadline() != nil && !now.Before(*settings.idleDeadline()):
+		removeAnnotations = append(removeAnnotations, v1beta1constants.AnnotationShootHibernationWakeUpRequestedAt)
+		if err := r.hibernateIdleShoot(ctx, shoot, settings.keepAwake(now), now, removeAnnotations...); err != nil {
+			return reconcile.Result{}, err
+		}
+		log.Info("Shoot was woken up on demand and has been idle for too long", "wakeUpRequestedAt", settings.wakeUpRequestedAt, "lastUserActivity", settings.lastUserActivity, "idleTimeout", settings.idleTimeout)
+
+	case len(removeAnnotations) > 0:
+		patch := client.MergeFrom(shoot.DeepCopy())
+		for _, key := range removeAnnotations {
+			delete(shoot.Annotations, key)
+		}
+		if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	if settings, err = parseHibernationSettings(shoot.Annotations); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	requeueAfter := nextHibernationTimeDuration(parsedSchedules, settings, now)
+	if requeueAfter == 0 {
+		log.Info("No further hibernation or wake-up is scheduled, stopping reconciliation")
+		return reconcile.Result{}, nil
 	}
 
-	requeueAfter := nextHibernationTimeDuration(parsedSchedules, now)
 	log.Info("Requeuing shoot hibernation", "requeueAfter", requeueAfter)
 	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
 
-func (r *Reconciler) hibernateOrWakeUpShootBasedOnSchedule(ctx context.Context, shoot *gardencorev1beta1.Shoot, schedule *parsedHibernationSchedule, now time.Time) error {
+// hibernateIdleShoot hibernates a Shoot which was woken up on demand and has been idle for longer than its idle timeout.
+// If the Shoot is kept awake, it is not hibernated.
+func (r *Reconciler) hibernateIdleShoot(ctx context.Context, shoot *gardencorev1beta1.Shoot, keepAwake bool, now time.Time, removeAnnotations ...string) error {
+	patch := client.MergeFrom(shoot.DeepCopy())
+	for _, key := range removeAnnotations {
+		delete(shoot.Annotations, key)
+	}
+	hibernate := !keepAwake && !v1beta1helper.HibernationIsEnabled(shoot)
+	if hibernate {
+		if shoot.Spec.Hibernation == nil {
+			shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{}
+		}
+		shoot.Spec.Hibernation.Enabled = ptr.To(true)
+		r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventHibernationEnabled, "Hibernating cluster due to wake-up idle timeout")
+	}
+	if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+		return err
+	}
+
+	if !hibernate {
+		return nil
+	}
+
+	patch = client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.LastHibernationTriggerTime = &metav1.Time{Time: now}
+	return r.Client.Status().Patch(ctx, shoot, patch)
+}
+
+func (r *Reconciler) hibernateOrWakeUpShootBasedOnSchedule(ctx context.Context, shoot *gardencorev1beta1.Shoot, schedule *parsedHibernationSchedule, now time.Time, removeAnnotations ...string) error {
 	patch := client.MergeFrom(shoot.DeepCopy())
+	for _, key := range removeAnnotations {
+		delete(shoot.Annotations, key)
+	}
 	switch schedule.operation {
 	case hibernate:
 		shoot.Spec.Hibernation.Enabled = ptr.To(true)
@@ -153,7 +301,7 @@ func (r *Reconciler) hibernateOrWakeUpShootBasedOnSchedule(ctx context.Context,
 
 // parseHibernationSchedules parses the given HibernationSchedules and returns an array of ParsedHibernationSchedules
 // If the Location of a HibernationSchedule is `nil`, it is defaulted to UTC.
-func parseHibernationSchedules(schedules []gardencorev1beta1.HibernationSchedule) ([]parsedHibernationSchedule, error) {
+func parseHibernationSchedules(schedules []gardencorev1beta1.HibernationSchedule, excludedDates sets.Set[string]) ([]parsedHibernationSchedule, error) {
 	var parsedHibernationSchedules []parsedHibernationSchedule
 
 	for _, schedule := range schedules {
@@ -173,7 +321,7 @@ func parseHibernationSchedules(schedules []gardencorev1beta1.HibernationSchedule
 				return nil, err
 			}
 			parsedHibernationSchedules = append(parsedHibernationSchedules,
-				parsedHibernationSchedule{location: *location, schedule: parsed, operation: hibernate},
+				parsedHibernationSchedule{location: *location, schedule: parsed, excludedDates: excludedDates, operation: hibernate},
 			)
 		}
 
@@ -183,7 +331,7 @@ func parseHibernationSchedules(schedules []gardencorev1beta1.HibernationSchedule
 				return nil, err
 			}
 			parsedHibernationSchedules = append(parsedHibernationSchedules,
-				parsedHibernationSchedule{location: *location, schedule: parsed, operation: wakeUp},
+				parsedHibernationSchedule{location: *location, schedule: parsed, excludedDates: excludedDates, operation: wakeUp},
 			)
 		}
 	}
@@ -191,15 +339,26 @@ func parseHibernationSchedules(schedules []gardencorev1beta1.HibernationSchedule
 	return parsedHibernationSchedules, nil
 }
 
-// nextHibernationTimeDuration returns the time duration after which to requeue the shoot based on the hibernation schedules and current time.
+// nextHibernationTimeDuration returns the time duration after which to requeue the shoot based on the hibernation schedules,
+// the hibernation settings and current time. Zero is returned if the shoot does not need to be requeued.
 // It adds a 100ms padding to the next requeue to account for Network Time Protocol(NTP) time skews.
 // If the time drifts are adjusted which in most realistic cases would be around 100ms, scheduled hibernation
 // will still be executed without missing the schedule.
-func nextHibernationTimeDuration(schedules []parsedHibernationSchedule, now time.Time) time.Duration {
-	timeStamps := make([]time.Time, 0, len(schedules))
+func nextHibernationTimeDuration(schedules []parsedHibernationSchedule, settings *hibernationSettings, now time.Time) time.Duration {
+	timeStamps := make([]time.Time, 0, len(schedules)+2)
 	for _, schedule := range schedules {
 		timeStamps = append(timeStamps, schedule.next(now))
 	}
+	if settings.keepAwake(now) {
+		timeStamps = append(timeStamps, *settings.keepAwakeUntil)
+	}
+	if idleDeadline := settings.idleDeadline(); idleDeadline != nil && idleDeadline.After(now) {
+		timeStamps = append(timeStamps, *idleDeadline)
+	}
+
+	if len(timeStamps) == 0 {
+		return 0
+	}
 
 	slices.SortFunc(timeStamps, func(a, b time.Time) int {
 		return a.Compare(b)
@@ -228,6 +387,12 @@ func getScheduleWithMostRecentTime(schedules []parsedHibernationSchedule, trigge
 		earliestTime = sevenDaysAgo
 	}
 
+	return getScheduleWithMostRecentTimeBetween(schedules, earliestTime, now)
+}
+
+// getScheduleWithMostRecentTimeBetween returns the ParsedHibernationSchedule that contains the schedule with the most recent
+// execution time between `earliestTime` and `now`.
+func getScheduleWithMostRecentTimeBetween(schedules []parsedHibernationSchedule, earliestTime, now time.Time) *parsedHibernationSchedule {
 	// Iterate over all schedules that were parsed from the shoot specification until we find one that contains
 	// a time entry between `earliestTime` and `now`` and that time entry is the latest one (most recent) with respect to `now`
 	var scheduleWithMostRecentTime *parsedHibernationSchedule
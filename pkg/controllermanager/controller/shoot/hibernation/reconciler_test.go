@@ -13,6 +13,7 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 	"github.com/robfig/cron"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/component-base/version"
 	testclock "k8s.io/utils/clock/testing"
@@ -129,6 +130,21 @@ var _ = Describe("Shoot Hibernation", func() {
 				prev := parsedSchedule.previous(from, now)
 				Expect(prev).To(BeNil())
 			})
+
+			It("should skip scheduling times on excluded dates", func() {
+				now := mustParseRFC3339Time(weekDayAt2)
+				from := now.Add(-2 * 24 * time.Hour)
+
+				expected := mustParseRFC3339Time(weekDayAt0).Add(-24 * time.Hour)
+				parsedSchedule := parsedHibernationSchedule{
+					location:      mustLoadLocation(locationEUBerlin),
+					schedule:      mustParseStandard(everyDayAt2),
+					excludedDates: sets.New("2022-04-12"),
+				}
+				prev := parsedSchedule.previous(from, now)
+				Expect(prev).NotTo(BeNil())
+				Expect(*prev).To(Equal(expected))
+			})
 		})
 	})
 
@@ -418,5 +434,170 @@ var _ = Describe("Shoot Hibernation", func() {
 				}),
 			)
 		})
+
+		Describe("#Reconcile with hibernation annotations", func() {
+			var (
+				ctx        = context.TODO()
+				c          client.Client
+				fakeClock  *testclock.FakeClock
+				reconciler *Reconciler
+				shoot      *gardencorev1beta1.Shoot
+			)
+
+			BeforeEach(func() {
+				c = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+				fakeClock = testclock.NewFakeClock(mustParseRFC3339Time(weekDayAt2))
+
+				reconciler = &Reconciler{
+					Client:   c,
+					Config:   config.ShootHibernationControllerConfiguration{TriggerDeadlineDuration: &metav1.Duration{Duration: shortDeadline}},
+					Recorder: record.NewFakeRecorder(1),
+					Clock:    fakeClock,
+				}
+
+				shoot = &gardencorev1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "bar",
+						Namespace:         "garden-foo",
+						CreationTimestamp: metav1.Time{Time: mustParseRFC3339Time(weekDayAt2).Add(-24 * time.Hour)},
+					},
+					Spec: gardencorev1beta1.ShootSpec{
+						Hibernation: &gardencorev1beta1.Hibernation{
+							Schedules: []gardencorev1beta1.HibernationSchedule{{Start: &everyDayAt7, End: &everyDayAt2}},
+						},
+					},
+				}
+			})
+
+			reconcileShoot := func(now time.Time) (reconcile.Result, *gardencorev1beta1.Shoot) {
+				fakeClock.SetTime(now)
+				Expect(c.Create(ctx, shoot)).To(Succeed())
+
+				result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+				Expect(err).NotTo(HaveOccurred())
+
+				reconciledShoot := &gardencorev1beta1.Shoot{}
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), reconciledShoot)).To(Succeed())
+				return result, reconciledShoot
+			}
+
+			It("should not execute schedules on excluded dates", func() {
+				shoot.Annotations = map[string]string{"shoot.gardener.cloud/hibernation-excluded-dates": "2022-04-11,2022-04-12"}
+				now := mustParseRFC3339Time(weekDayAt2).Add(time.Second)
+
+				result, reconciledShoot := reconcileShoot(now)
+				Expect(result).To(Equal(reconcile.Result{RequeueAfter: requeueAfterBasedOnSchedule(everyDayAt7, "UTC")(now)}))
+				Expect(reconciledShoot.Spec.Hibernation.Enabled).To(BeNil())
+			})
+
+			It("should not hibernate the shoot while it is kept awake", func() {
+				keepAwakeUntil := mustParseRFC3339Time(weekDayAt7).Add(time.Hour)
+				shoot.Annotations = map[string]string{"shoot.gardener.cloud/hibernation-keep-awake-until": keepAwakeUntil.Format(time.RFC3339)}
+				shoot.Status.LastHibernationTriggerTime = &metav1.Time{Time: mustParseRFC3339Time(weekDayAt2)}
+				now := mustParseRFC3339Time(weekDayAt7).Add(time.Second)
+
+				result, reconciledShoot := reconcileShoot(now)
+				Expect(result).To(Equal(reconcile.Result{RequeueAfter: keepAwakeUntil.Add(nextScheduleDelta).Sub(now)}))
+				Expect(reconciledShoot.Spec.Hibernation.Enabled).To(BeNil())
+				Expect(reconciledShoot.Annotations).To(HaveKey("shoot.gardener.cloud/hibernation-keep-awake-until"))
+			})
+
+			It("should wake up the shoot while it is kept awake", func() {
+				shoot.Annotations = map[string]string{"shoot.gardener.cloud/hibernation-keep-awake-until": mustParseRFC3339Time(weekDayAt7).Add(time.Hour).Format(time.RFC3339)}
+				now := mustParseRFC3339Time(weekDayAt2).Add(time.Second)
+
+				_, reconciledShoot := reconcileShoot(now)
+				Expect(reconciledShoot.Spec.Hibernation.Enabled).To(PointTo(BeFalse()))
+			})
+
+			It("should execute the most recent schedule and remove the annotation once the shoot is no longer kept awake", func() {
+				keepAwakeUntil := mustParseRFC3339Time(weekDayAt7).Add(time.Hour)
+				shoot.Annotations = map[string]string{"shoot.gardener.cloud/hibernation-keep-awake-until": keepAwakeUntil.Format(time.RFC3339)}
+				shoot.Status.LastHibernationTriggerTime = &metav1.Time{Time: mustParseRFC3339Time(weekDayAt2)}
+				now := keepAwakeUntil.Add(time.Second)
+
+				result, reconciledShoot := reconcileShoot(now)
+				Expect(result).To(Equal(reconcile.Result{RequeueAfter: requeueAfterBasedOnSchedule(everyDayAt2, "UTC")(now)}))
+				Expect(reconciledShoot.Spec.Hibernation.Enabled).To(PointTo(BeTrue()))
+				Expect(reconciledShoot.Status.LastHibernationTriggerTime.Time.UTC()).To(Equal(now))
+				Expect(reconciledShoot.Annotations).NotTo(HaveKey("shoot.gardener.cloud/hibernation-keep-awake-until"))
+			})
+
+			Context("wake-up on demand", func() {
+				BeforeEach(func() {
+					shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(false)}
+					shoot.Annotations = map[string]string{"shoot.gardener.cloud/hibernation-wake-up-idle-timeout": "1h"}
+				})
+
+				It("should stop reconciling if the shoot was not woken up on demand", func() {
+					result, reconciledShoot := reconcileShoot(mustParseRFC3339Time(weekDayAt2))
+					Expect(result).To(Equal(reconcile.Result{}))
+					Expect(reconciledShoot.Spec.Hibernation.Enabled).To(PointTo(BeFalse()))
+				})
+
+				It("should requeue the shoot until the idle timeout has passed", func() {
+					now := mustParseRFC3339Time(weekDayAt2)
+					shoot.Annotations["shoot.gardener.cloud/hibernation-wake-up-requested-at"] = now.Add(-30 * time.Minute).Format(time.RFC3339)
+
+					result, reconciledShoot := reconcileShoot(now)
+					Expect(result).To(Equal(reconcile.Result{RequeueAfter: 30*time.Minute + nextScheduleDelta}))
+					Expect(reconciledShoot.Spec.Hibernation.Enabled).To(PointTo(BeFalse()))
+				})
+
+				It("should hibernate the shoot once the idle timeout has passed", func() {
+					now := mustParseRFC3339Time(weekDayAt2)
+					shoot.Annotations["shoot.gardener.cloud/hibernation-wake-up-requested-at"] = now.Add(-time.Hour).Format(time.RFC3339)
+
+					result, reconciledShoot := reconcileShoot(now)
+					Expect(result).To(Equal(reconcile.Result{}))
+					Expect(reconciledShoot.Spec.Hibernation.Enabled).To(PointTo(BeTrue()))
+					Expect(reconciledShoot.Status.LastHibernationTriggerTime.Time.UTC()).To(Equal(now))
+					Expect(reconciledShoot.Annotations).NotTo(HaveKey("shoot.gardener.cloud/hibernation-wake-up-requested-at"))
+				})
+
+				It("should requeue the shoot until the idle timeout has passed since the last user activity", func() {
+					now := mustParseRFC3339Time(weekDayAt2)
+					shoot.Annotations["shoot.gardener.cloud/hibernation-wake-up-requested-at"] = now.Add(-2 * time.Hour).Format(time.RFC3339)
+					shoot.Annotations["shoot.gardener.cloud/last-user-activity"] = now.Add(-20 * time.Minute).Format(time.RFC3339)
+
+					result, reconciledShoot := reconcileShoot(now)
+					Expect(result).To(Equal(reconcile.Result{RequeueAfter: 40*time.Minute + nextScheduleDelta}))
+					Expect(reconciledShoot.Spec.Hibernation.Enabled).To(PointTo(BeFalse()))
+					Expect(reconciledShoot.Annotations).To(HaveKey("shoot.gardener.cloud/hibernation-wake-up-requested-at"))
+				})
+
+				It("should ignore user activity before the wake-up request", func() {
+					now := mustParseRFC3339Time(weekDayAt2)
+					shoot.Annotations["shoot.gardener.cloud/hibernation-wake-up-requested-at"] = now.Add(-time.Hour).Format(time.RFC3339)
+					shoot.Annotations["shoot.gardener.cloud/last-user-activity"] = now.Add(-3 * time.Hour).Format(time.RFC3339)
+
+					result, reconciledShoot := reconcileShoot(now)
+					Expect(result).To(Equal(reconcile.Result{}))
+					Expect(reconciledShoot.Spec.Hibernation.Enabled).To(PointTo(BeTrue()))
+				})
+
+				It("should not hibernate the shoot once the idle timeout has passed if it is kept awake", func() {
+					now := mustParseRFC3339Time(weekDayAt2)
+					shoot.Annotations["shoot.gardener.cloud/hibernation-wake-up-requested-at"] = now.Add(-time.Hour).Format(time.RFC3339)
+					shoot.Annotations["shoot.gardener.cloud/hibernation-keep-awake-until"] = now.Add(time.Hour).Format(time.RFC3339)
+
+					_, reconciledShoot := reconcileShoot(now)
+					Expect(reconciledShoot.Spec.Hibernation.Enabled).To(PointTo(BeFalse()))
+					Expect(reconciledShoot.Annotations).NotTo(HaveKey("shoot.gardener.cloud/hibernation-wake-up-requested-at"))
+				})
+
+				It("should end the wake-up on demand when a schedule is executed", func() {
+					now := mustParseRFC3339Time(weekDayAt7).Add(time.Second)
+					shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{Start: &everyDayAt7, End: &everyDayAt2}}
+					shoot.Status.LastHibernationTriggerTime = &metav1.Time{Time: mustParseRFC3339Time(weekDayAt2)}
+					shoot.Annotations["shoot.gardener.cloud/hibernation-wake-up-requested-at"] = now.Add(-time.Minute).Format(time.RFC3339)
+
+					result, reconciledShoot := reconcileShoot(now)
+					Expect(result).To(Equal(reconcile.Result{RequeueAfter: requeueAfterBasedOnSchedule(everyDayAt2, "UTC")(now)}))
+					Expect(reconciledShoot.Spec.Hibernation.Enabled).To(PointTo(BeTrue()))
+					Expect(reconciledShoot.Annotations).NotTo(HaveKey("shoot.gardener.cloud/hibernation-wake-up-requested-at"))
+				})
+			})
+		})
 	})
 })
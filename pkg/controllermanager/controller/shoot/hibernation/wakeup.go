@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hibernation
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+const (
+	// WakeUpHandlerName is the name of the handler for waking up Shoots on demand.
+	WakeUpHandlerName = "shoot-wakeup"
+	// WakeUpPath is the path of the endpoint for waking up Shoots on demand.
+	WakeUpPath = "/namespaces/{namespace}/shoots/{name}/wakeup"
+	// WakeUpSubresource is the (virtual) subresource of Shoots for which users must be authorized with the `create`
+	// verb in order to wake up a Shoot on demand.
+	WakeUpSubresource = "wakeup"
+)
+
+// WakeUpHandler wakes up hibernated Shoots on demand. Requests must be authenticated with a bearer token which is
+// verified via a TokenReview. The user must be allowed to `create` the `shoots/wakeup` subresource of the Shoot.
+type WakeUpHandler struct {
+	Logger   logr.Logger
+	Client   client.Client
+	Clock    clock.Clock
+	Recorder record.EventRecorder
+}
+
+// AddToManager registers the handler at the webhook server of the given manager.
+func (h *WakeUpHandler) AddToManager(mgr manager.Manager) error {
+	if h.Client == nil {
+		h.Client = mgr.GetClient()
+	}
+	if h.Clock == nil {
+		h.Clock = clock.RealClock{}
+	}
+	if h.Recorder == nil {
+		h.Recorder = mgr.GetEventRecorderFor(WakeUpHandlerName)
+	}
+
+	mgr.GetWebhookServer().Register(WakeUpPath, h)
+	return nil
+}
+
+// ServeHTTP handles requests for waking up Shoots on demand.
+func (h *WakeUpHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var (
+		ctx       = r.Context()
+		namespace = r.PathValue("namespace")
+		name      = r.PathValue("name")
+		log       = h.Logger.WithValues("shoot", client.ObjectKey{Namespace: namespace, Name: name})
+	)
+
+	if r.Method != http.MethodPost {
+		http.Error(w, fmt.Sprintf("method %s is not allowed", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		http.Error(w, "missing bearer token", http.StatusUnauthorized)
+		return
+	}
+
+	tokenReview := &authenticationv1.TokenReview{Spec: authenticationv1.TokenReviewSpec{Token: token}}
+	if err := h.Client.Create(ctx, tokenReview); err != nil {
+		log.Error(err, "Failed reviewing token")
+		http.Error(w, "failed reviewing token", http.StatusInternalServerError)
+		return
+	}
+	if !tokenReview.Status.Authenticated {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	user := tokenReview.Status.User
+	subjectAccessReview := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   user.Username,
+			UID:    user.UID,
+			Groups: user.Groups,
+			Extra:  convertExtra(user.Extra),
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Group:       gardencorev1beta1.SchemeGroupVersion.Group,
+				Resource:    "shoots",
+				Subresource: WakeUpSubresource,
+				Verb:        "create",
+				Namespace:   namespace,
+				Name:        name,
+			},
+		},
+	}
+	if err := h.Client.Create(ctx, subjectAccessReview); err != nil {
+		log.Error(err, "Failed reviewing access")
+		http.Error(w, "failed reviewing access", http.StatusInternalServerError)
+		return
+	}
+	if !subjectAccessReview.Status.Allowed {
+		http.Error(w, fmt.Sprintf("user %q is not allowed to wake up shoot %s/%s", user.Username, namespace, name), http.StatusForbidden)
+		return
+	}
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := h.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			http.Error(w, fmt.Sprintf("shoot %s/%s not found", namespace, name), http.StatusNotFound)
+			return
+		}
+		log.Error(err, "Failed reading shoot")
+		http.Error(w, "failed reading shoot", http.StatusInternalServerError)
+		return
+	}
+
+	if shoot.DeletionTimestamp != nil {
+		http.Error(w, "shoot is being deleted", http.StatusConflict)
+		return
+	}
+	if idleTimeout, err := gardenerutils.GetShootHibernationWakeUpIdleTimeout(shoot.Annotations); err != nil || idleTimeout == nil {
+		http.Error(w, fmt.Sprintf("waking up shoot on demand is not enabled, annotation %s is missing or invalid", v1beta1constants.AnnotationShootHibernationWakeUpIdleTimeout), http.StatusBadRequest)
+		return
+	}
+
+	// Shoots which are awake because of their hibernation schedules must not be hibernated after the idle timeout,
+	// hence only record the wake-up request if the shoot is hibernated or was already woken up on demand.
+	if _, wokenUpOnDemand := shoot.Annotations[v1beta1constants.AnnotationShootHibernationWakeUpRequestedAt]; !v1beta1helper.HibernationIsEnabled(shoot) && !wokenUpOnDemand {
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintln(w, "shoot is not hibernated")
+		return
+	}
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootHibernationWakeUpRequestedAt, h.Clock.Now().UTC().Format(time.RFC3339))
+	if v1beta1helper.HibernationIsEnabled(shoot) {
+		shoot.Spec.Hibernation.Enabled = ptr.To(false)
+		h.Recorder.Eventf(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventHibernationDisabled, "Waking up cluster on demand of user %q", user.Username)
+	}
+	if err := h.Client.Patch(ctx, shoot, patch); err != nil {
+		log.Error(err, "Failed waking up shoot")
+		http.Error(w, "failed waking up shoot", http.StatusInternalServerError)
+		return
+	}
+
+	log.Info("Shoot was woken up on demand", "user", user.Username)
+	w.WriteHeader(http.StatusAccepted)
+	fmt.Fprintln(w, "shoot is being woken up")
+}
+
+func convertExtra(extra map[string]authenticationv1.ExtraValue) map[string]authorizationv1.ExtraValue {
+	if extra == nil {
+		return nil
+	}
+
+	out := make(map[string]authorizationv1.ExtraValue, len(extra))
+	for k, v := range extra {
+		out[k] = authorizationv1.ExtraValue(v)
+	}
+	return out
+}
@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hibernation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+var _ = Describe("WakeUpHandler", func() {
+	var (
+		ctx = context.TODO()
+		now = time.Date(2024, 4, 12, 10, 0, 0, 0, time.UTC)
+
+		fakeClient client.Client
+		mux        *http.ServeMux
+		shoot      *gardencorev1beta1.Shoot
+
+		authenticated      bool
+		allowed            bool
+		accessReviewSpec   authorizationv1.SubjectAccessReviewSpec
+		reviewedTokenValue string
+	)
+
+	BeforeEach(func() {
+		authenticated, allowed = true, true
+
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				switch review := obj.(type) {
+				case *authenticationv1.TokenReview:
+					reviewedTokenValue = review.Spec.Token
+					review.Status = authenticationv1.TokenReviewStatus{Authenticated: authenticated, User: authenticationv1.UserInfo{Username: "foo", Groups: []string{"bar"}}}
+					return nil
+				case *authorizationv1.SubjectAccessReview:
+					accessReviewSpec = review.Spec
+					review.Status = authorizationv1.SubjectAccessReviewStatus{Allowed: allowed}
+					return nil
+				}
+				return c.Create(ctx, obj, opts...)
+			},
+		}).Build()
+
+		mux = http.NewServeMux()
+		mux.Handle(WakeUpPath, &WakeUpHandler{
+			Logger:   logr.Discard(),
+			Client:   fakeClient,
+			Clock:    testclock.NewFakeClock(now),
+			Recorder: record.NewFakeRecorder(1),
+		})
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "bar",
+				Namespace:   "garden-foo",
+				Annotations: map[string]string{"shoot.gardener.cloud/hibernation-wake-up-idle-timeout": "1h"},
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				Hibernation: &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)},
+			},
+		}
+	})
+
+	request := func(method, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/namespaces/garden-foo/shoots/bar/wakeup", nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	It("should reject other methods than POST", func() {
+		Expect(request(http.MethodGet, "token").Code).To(Equal(http.StatusMethodNotAllowed))
+	})
+
+	It("should reject requests without bearer token", func() {
+		Expect(request(http.MethodPost, "").Code).To(Equal(http.StatusUnauthorized))
+	})
+
+	It("should reject requests with invalid token", func() {
+		authenticated = false
+
+		Expect(request(http.MethodPost, "token").Code).To(Equal(http.StatusUnauthorized))
+		Expect(reviewedTokenValue).To(Equal("token"))
+	})
+
+	It("should reject requests of users which are not allowed to wake up the shoot", func() {
+		allowed = false
+
+		Expect(request(http.MethodPost, "token").Code).To(Equal(http.StatusForbidden))
+		Expect(accessReviewSpec.User).To(Equal("foo"))
+		Expect(accessReviewSpec.Groups).To(ConsistOf("bar"))
+		Expect(accessReviewSpec.ResourceAttributes).To(Equal(&authorizationv1.ResourceAttributes{
+			Group:       "core.gardener.cloud",
+			Resource:    "shoots",
+			Subresource: "wakeup",
+			Verb:        "create",
+			Namespace:   "garden-foo",
+			Name:        "bar",
+		}))
+	})
+
+	It("should return not found if the shoot does not exist", func() {
+		Expect(request(http.MethodPost, "token").Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("should reject requests if waking up the shoot on demand is not enabled", func() {
+		delete(shoot.Annotations, "shoot.gardener.cloud/hibernation-wake-up-idle-timeout")
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		Expect(request(http.MethodPost, "token").Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should wake up the shoot and record the request", func() {
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		Expect(request(http.MethodPost, "token").Code).To(Equal(http.StatusAccepted))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Spec.Hibernation.Enabled).To(HaveValue(BeFalse()))
+		Expect(shoot.Annotations).To(HaveKeyWithValue("shoot.gardener.cloud/hibernation-wake-up-requested-at", "2024-04-12T10:00:00Z"))
+	})
+
+	It("should refresh the request time if the shoot was already woken up on demand", func() {
+		shoot.Spec.Hibernation.Enabled = ptr.To(false)
+		shoot.Annotations["shoot.gardener.cloud/hibernation-wake-up-requested-at"] = "2024-04-12T09:30:00Z"
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		Expect(request(http.MethodPost, "token").Code).To(Equal(http.StatusAccepted))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Annotations).To(HaveKeyWithValue("shoot.gardener.cloud/hibernation-wake-up-requested-at", "2024-04-12T10:00:00Z"))
+	})
+
+	It("should not record the request if the shoot is awake because of its schedules", func() {
+		shoot.Spec.Hibernation.Enabled = ptr.To(false)
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		Expect(request(http.MethodPost, "token").Code).To(Equal(http.StatusOK))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Annotations).NotTo(HaveKey("shoot.gardener.cloud/hibernation-wake-up-requested-at"))
+	})
+})
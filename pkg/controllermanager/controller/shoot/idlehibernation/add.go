@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package idlehibernation
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllerutils/mapper"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "shoot-idle-hibernation"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor(ControllerName + "-controller")
+	}
+
+	c, err := builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&gardencorev1beta1.Shoot{}, builder.WithPredicates(r.ShootPredicate())).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+		}).
+		Build(r)
+	if err != nil {
+		return err
+	}
+
+	return c.Watch(
+		source.Kind(mgr.GetCache(), &gardencorev1beta1.Project{}),
+		mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapProjectToShoots), mapper.UpdateWithNew, c.GetLogger()),
+		r.ProjectPredicate(),
+	)
+}
+
+// ShootPredicate returns the predicates for the core.gardener.cloud/v1beta1.Shoot watch. It reacts on changes of the
+// last user activity, the keep-awake annotation and the hibernation state.
+func (r *Reconciler) ShootPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool { return true },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			shoot, ok := e.ObjectNew.(*gardencorev1beta1.Shoot)
+			if !ok {
+				return false
+			}
+
+			oldShoot, ok := e.ObjectOld.(*gardencorev1beta1.Shoot)
+			if !ok {
+				return false
+			}
+
+			return oldShoot.Annotations[v1beta1constants.AnnotationShootLastUserActivity] != shoot.Annotations[v1beta1constants.AnnotationShootLastUserActivity] ||
+				oldShoot.Annotations[v1beta1constants.AnnotationShootHibernationKeepAwakeUntil] != shoot.Annotations[v1beta1constants.AnnotationShootHibernationKeepAwakeUntil] ||
+				v1beta1helper.HibernationIsEnabled(oldShoot) != v1beta1helper.HibernationIsEnabled(shoot) ||
+				oldShoot.Status.IsHibernated != shoot.Status.IsHibernated
+		},
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
+// ProjectPredicate returns the predicates for the core.gardener.cloud/v1beta1.Project watch. It reacts on changes of
+// the hibernation settings.
+func (r *Reconciler) ProjectPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			project, ok := e.ObjectNew.(*gardencorev1beta1.Project)
+			if !ok {
+				return false
+			}
+
+			oldProject, ok := e.ObjectOld.(*gardencorev1beta1.Project)
+			if !ok {
+				return false
+			}
+
+			return !apiequality.Semantic.DeepEqual(oldProject.Spec.Hibernation, project.Spec.Hibernation)
+		},
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
+// MapProjectToShoots is a mapper.MapFunc for mapping a Project to all Shoots in its namespace.
+func (r *Reconciler) MapProjectToShoots(ctx context.Context, log logr.Logger, reader client.Reader, obj client.Object) []reconcile.Request {
+	project, ok := obj.(*gardencorev1beta1.Project)
+	if !ok || project.Spec.Namespace == nil {
+		return nil
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := reader.List(ctx, shootList, client.InNamespace(*project.Spec.Namespace)); err != nil {
+		log.Error(err, "Failed to list shoots for project", "project", client.ObjectKeyFromObject(project))
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(shootList.Items))
+	for _, shoot := range shootList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: shoot.Namespace, Name: shoot.Name}})
+	}
+	return requests
+}
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package idlehibernation_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/idlehibernation"
+)
+
+var _ = Describe("Add", func() {
+	var (
+		reconciler *Reconciler
+		shoot      *gardencorev1beta1.Shoot
+		project    *gardencorev1beta1.Project
+	)
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+		shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"}}
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-foo")},
+		}
+	})
+
+	Describe("#ShootPredicate", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.ShootPredicate()
+		})
+
+		It("should return true for create events", func() {
+			Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+		})
+
+		It("should return false for update events if nothing relevant changed", func() {
+			newShoot := shoot.DeepCopy()
+			newShoot.Labels = map[string]string{"foo": "bar"}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: newShoot})).To(BeFalse())
+		})
+
+		It("should return true if the last user activity changed", func() {
+			newShoot := shoot.DeepCopy()
+			newShoot.Annotations = map[string]string{"shoot.gardener.cloud/last-user-activity": "2024-04-12T10:00:00Z"}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: newShoot})).To(BeTrue())
+		})
+
+		It("should return true if the keep-awake annotation changed", func() {
+			newShoot := shoot.DeepCopy()
+			newShoot.Annotations = map[string]string{"shoot.gardener.cloud/hibernation-keep-awake-until": "2024-04-12T18:00:00Z"}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: newShoot})).To(BeTrue())
+		})
+
+		It("should return true if the shoot was woken up", func() {
+			shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+			shoot.Status.IsHibernated = true
+			newShoot := shoot.DeepCopy()
+			newShoot.Spec.Hibernation.Enabled = ptr.To(false)
+			Expect(p.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: newShoot})).To(BeTrue())
+		})
+
+		It("should return true if the hibernation status changed", func() {
+			newShoot := shoot.DeepCopy()
+			newShoot.Status.IsHibernated = true
+			Expect(p.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: newShoot})).To(BeTrue())
+		})
+
+		It("should return false for delete and generic events", func() {
+			Expect(p.Delete(event.DeleteEvent{Object: shoot})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: shoot})).To(BeFalse())
+		})
+	})
+
+	Describe("#ProjectPredicate", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.ProjectPredicate()
+		})
+
+		It("should return false for create events", func() {
+			Expect(p.Create(event.CreateEvent{Object: project})).To(BeFalse())
+		})
+
+		It("should return false if the hibernation settings did not change", func() {
+			newProject := project.DeepCopy()
+			newProject.Labels = map[string]string{"foo": "bar"}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: project, ObjectNew: newProject})).To(BeFalse())
+		})
+
+		It("should return true if the hibernation settings changed", func() {
+			newProject := project.DeepCopy()
+			newProject.Spec.Hibernation = &gardencorev1beta1.ProjectHibernation{IdleTimeout: &metav1.Duration{Duration: 4 * time.Hour}}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: project, ObjectNew: newProject})).To(BeTrue())
+		})
+	})
+
+	Describe("#MapProjectToShoots", func() {
+		It("should map the project to all shoots in its namespace", func() {
+			ctx := context.Background()
+			fakeClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "baz", Namespace: "garden-foo"}})).To(Succeed())
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-other"}})).To(Succeed())
+
+			Expect(reconciler.MapProjectToShoots(ctx, logr.Discard(), fakeClient, project)).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "garden-foo", Name: "bar"}},
+				reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "garden-foo", Name: "baz"}},
+			))
+		})
+
+		It("should return nil if the project has no namespace", func() {
+			project.Spec.Namespace = nil
+			Expect(reconciler.MapProjectToShoots(context.Background(), logr.Discard(), nil, project)).To(BeNil())
+		})
+	})
+})
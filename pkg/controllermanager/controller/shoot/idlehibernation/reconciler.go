@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package idlehibernation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Reconciler reconciles Shoots and hibernates them if no user activity was observed for longer than the idle
+// hibernation timeout configured for their project.
+type Reconciler struct {
+	Client   client.Client
+	Config   config.ShootIdleHibernationControllerConfiguration
+	Clock    clock.Clock
+	Recorder record.EventRecorder
+}
+
+// Reconcile reconciles Shoots and hibernates them if they are idle.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if shoot.DeletionTimestamp != nil {
+		log.V(1).Info("Shoot is currently being deleted, stopping reconciliation")
+		return reconcile.Result{}, nil
+	}
+
+	if v1beta1helper.HibernationIsEnabled(shoot) || shoot.Status.IsHibernated {
+		log.V(1).Info("Shoot is hibernated, stopping reconciliation")
+		return reconcile.Result{}, nil
+	}
+
+	if gardenerutils.IsShootFailedAndUpToDate(shoot) {
+		log.V(1).Info("Shoot is in Failed state, stopping reconciliation")
+		return reconcile.Result{}, nil
+	}
+
+	lastUserActivity, err := gardenerutils.GetShootLastUserActivity(shoot.Annotations)
+	if err != nil {
+		log.Error(err, "Invalid last user activity, stopping reconciliation")
+		return reconcile.Result{}, nil
+	}
+	if lastUserActivity == nil {
+		log.V(1).Info("No user activity was observed yet, stopping reconciliation")
+		return reconcile.Result{}, nil
+	}
+
+	project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, shoot.Namespace)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Project for shoot not found, stopping reconciliation")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("failed getting project for shoot: %w", err)
+	}
+
+	if project.Spec.Hibernation == nil || project.Spec.Hibernation.IdleTimeout == nil {
+		log.V(1).Info("Idle hibernation is not enabled for project, stopping reconciliation", "project", client.ObjectKeyFromObject(project))
+		return reconcile.Result{}, nil
+	}
+	idleTimeout := project.Spec.Hibernation.IdleTimeout.Duration
+
+	hibernateAt := lastUserActivity.Add(idleTimeout)
+	if keepAwakeUntil, err := gardenerutils.GetShootHibernationKeepAwakeUntil(shoot.Annotations); err == nil && keepAwakeUntil != nil && keepAwakeUntil.After(hibernateAt) {
+		hibernateAt = *keepAwakeUntil
+	}
+
+	now := r.Clock.Now()
+	if warnAt := hibernateAt.Add(-r.Config.WarningPeriod.Duration); now.Before(warnAt) {
+		requeueAfter := warnAt.Sub(now)
+		log.V(1).Info("Shoot is not idle yet, requeuing", "lastUserActivity", lastUserActivity, "requeueAfter", requeueAfter)
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	if now.Before(hibernateAt) {
+		requeueAfter := hibernateAt.Sub(now)
+		if err := r.notifyPendingHibernation(ctx, shoot, *lastUserActivity, hibernateAt); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed announcing pending idle hibernation: %w", err)
+		}
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	log.Info("Hibernating idle shoot", "lastUserActivity", lastUserActivity, "idleTimeout", idleTimeout)
+	if err := r.hibernateShoot(ctx, shoot, *lastUserActivity, now); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed hibernating idle shoot: %w", err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// notifyPendingHibernation emits an event announcing the pending hibernation of the given Shoot. The announced
+// hibernation time is remembered in an annotation, so that the event is emitted only once per idle period.
+func (r *Reconciler) notifyPendingHibernation(ctx context.Context, shoot *gardencorev1beta1.Shoot, lastUserActivity, hibernateAt time.Time) error {
+	notifiedHibernateAt := hibernateAt.UTC().Format(time.RFC3339)
+	if shoot.Annotations[v1beta1constants.AnnotationShootIdleHibernationNotified] == notifiedHibernateAt {
+		return nil
+	}
+
+	logf.FromContext(ctx).Info("Shoot will be hibernated soon because it is idle", "lastUserActivity", lastUserActivity, "hibernateAt", hibernateAt)
+	r.Recorder.Eventf(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventIdleHibernationPending, "Cluster will be hibernated at %s because no user activity was observed since %s", notifiedHibernateAt, lastUserActivity.UTC().Format(time.RFC3339))
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootIdleHibernationNotified, notifiedHibernateAt)
+	return r.Client.Patch(ctx, shoot, patch)
+}
+
+func (r *Reconciler) hibernateShoot(ctx context.Context, shoot *gardencorev1beta1.Shoot, lastUserActivity, now time.Time) error {
+	patch := client.MergeFrom(shoot.DeepCopy())
+	delete(shoot.Annotations, v1beta1constants.AnnotationShootIdleHibernationNotified)
+	if shoot.Spec.Hibernation == nil {
+		shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{}
+	}
+	shoot.Spec.Hibernation.Enabled = ptr.To(true)
+	if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+		return err
+	}
+	r.Recorder.Eventf(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventHibernationEnabled, "Hibernating cluster because no user activity was observed since %s", lastUserActivity.UTC().Format(time.RFC3339))
+
+	patch = client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.LastHibernationTriggerTime = &metav1.Time{Time: now}
+	return r.Client.Status().Patch(ctx, shoot, patch)
+}
@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package idlehibernation_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/idlehibernation"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx = context.Background()
+		now = time.Date(2024, 4, 12, 10, 0, 0, 0, time.UTC)
+
+		fakeClient client.Client
+		recorder   *record.FakeRecorder
+		reconciler *Reconciler
+
+		project *gardencorev1beta1.Project
+		shoot   *gardencorev1beta1.Shoot
+		request reconcile.Request
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+			WithStatusSubresource(&gardencorev1beta1.Shoot{}).
+			Build()
+		recorder = record.NewFakeRecorder(1)
+
+		reconciler = &Reconciler{
+			Client:   fakeClient,
+			Config:   config.ShootIdleHibernationControllerConfiguration{WarningPeriod: &metav1.Duration{Duration: 30 * time.Minute}},
+			Clock:    testclock.NewFakeClock(now),
+			Recorder: recorder,
+		}
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec: gardencorev1beta1.ProjectSpec{
+				Namespace:   ptr.To("garden-foo"),
+				Hibernation: &gardencorev1beta1.ProjectHibernation{IdleTimeout: &metav1.Duration{Duration: 4 * time.Hour}},
+			},
+		}
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "bar",
+				Namespace:   "garden-foo",
+				Annotations: map[string]string{"shoot.gardener.cloud/last-user-activity": "2024-04-12T05:00:00Z"},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+	})
+
+	JustBeforeEach(func() {
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+	})
+
+	expectHibernated := func(hibernated bool) {
+		ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		if hibernated {
+			ExpectWithOffset(1, shoot.Spec.Hibernation).NotTo(BeNil())
+			ExpectWithOffset(1, shoot.Spec.Hibernation.Enabled).To(HaveValue(BeTrue()))
+			ExpectWithOffset(1, shoot.Status.LastHibernationTriggerTime).NotTo(BeNil())
+		} else {
+			ExpectWithOffset(1, shoot.Spec.Hibernation).To(BeNil())
+		}
+	}
+
+	It("should hibernate the shoot if it has been idle for longer than the timeout", func() {
+		shoot.Annotations["shoot.gardener.cloud/idle-hibernation-notified"] = "2024-04-12T09:00:00Z"
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		expectHibernated(true)
+		Expect(shoot.Annotations).NotTo(HaveKey("shoot.gardener.cloud/idle-hibernation-notified"))
+		Expect(recorder.Events).To(Receive(ContainSubstring("Hibernating cluster because no user activity was observed since 2024-04-12T05:00:00Z")))
+	})
+
+	Context("warning period", func() {
+		BeforeEach(func() {
+			shoot.Annotations["shoot.gardener.cloud/last-user-activity"] = "2024-04-12T06:10:00Z"
+		})
+
+		It("should emit a warning and requeue until the hibernation time", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Minute}))
+
+			expectHibernated(false)
+			Expect(recorder.Events).To(Receive(And(ContainSubstring("Warning IdleHibernationPending"), ContainSubstring("Cluster will be hibernated at 2024-04-12T10:10:00Z"))))
+			Expect(shoot.Annotations).To(HaveKeyWithValue("shoot.gardener.cloud/idle-hibernation-notified", "2024-04-12T10:10:00Z"))
+		})
+
+		It("should emit the warning only once per idle period", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Minute}))
+			Expect(recorder.Events).To(Receive(ContainSubstring("Warning IdleHibernationPending")))
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Minute}))
+			Expect(recorder.Events).To(BeEmpty())
+
+			By("New idle period after user activity")
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			shoot.Annotations["shoot.gardener.cloud/last-user-activity"] = "2024-04-12T06:15:00Z"
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 15 * time.Minute}))
+			Expect(recorder.Events).To(Receive(ContainSubstring("Cluster will be hibernated at 2024-04-12T10:15:00Z")))
+		})
+	})
+
+	Context("shoot is not idle", func() {
+		BeforeEach(func() {
+			shoot.Annotations["shoot.gardener.cloud/last-user-activity"] = "2024-04-12T09:00:00Z"
+		})
+
+		It("should requeue until the warning period begins", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 150 * time.Minute}))
+
+			expectHibernated(false)
+			Expect(recorder.Events).To(BeEmpty())
+		})
+	})
+
+	Context("shoot is kept awake", func() {
+		BeforeEach(func() {
+			shoot.Annotations["shoot.gardener.cloud/hibernation-keep-awake-until"] = "2024-04-12T18:00:00Z"
+		})
+
+		It("should not hibernate the shoot before the keep-awake time", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 7*time.Hour + 30*time.Minute}))
+
+			expectHibernated(false)
+		})
+	})
+
+	Context("idle hibernation is not enabled for the project", func() {
+		BeforeEach(func() {
+			project.Spec.Hibernation = nil
+		})
+
+		It("should not hibernate the shoot", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			expectHibernated(false)
+		})
+	})
+
+	Context("no user activity was observed", func() {
+		BeforeEach(func() {
+			shoot.Annotations = nil
+		})
+
+		It("should not hibernate the shoot", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			expectHibernated(false)
+		})
+	})
+
+	Context("shoot is already hibernated", func() {
+		BeforeEach(func() {
+			shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+		})
+
+		It("should do nothing", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.LastHibernationTriggerTime).To(BeNil())
+			Expect(recorder.Events).To(BeEmpty())
+		})
+	})
+
+	Context("shoot is gone", func() {
+		JustBeforeEach(func() {
+			Expect(fakeClient.Delete(ctx, shoot)).To(Succeed())
+		})
+
+		It("should stop reconciling", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		})
+	})
+})
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/robfig/cron"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// activeFreezePeriods returns the names of the landscape-wide and project-specific maintenance freeze periods which
+// are active at the current time.
+func (r *Reconciler) activeFreezePeriods(ctx context.Context, shoot *gardencorev1beta1.Shoot) ([]string, error) {
+	projectPeriods, err := r.projectFreezePeriods(ctx, shoot)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading maintenance freeze periods of project: %w", err)
+	}
+
+	var (
+		periods = append(append([]config.MaintenanceFreezePeriod{}, r.Config.FreezePeriods...), projectPeriods...)
+		now     = r.Clock.Now()
+		active  []string
+	)
+
+	for _, period := range periods {
+		isActive, err := isFreezePeriodActive(period, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed checking maintenance freeze period %q: %w", period.Name, err)
+		}
+		if isActive {
+			active = append(active, period.Name)
+		}
+	}
+
+	return active, nil
+}
+
+func (r *Reconciler) projectFreezePeriods(ctx context.Context, shoot *gardencorev1beta1.Shoot) ([]config.MaintenanceFreezePeriod, error) {
+	project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, shoot.Namespace)
+	if err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+
+	if project.Spec.Maintenance == nil {
+		return nil, nil
+	}
+
+	periods := make([]config.MaintenanceFreezePeriod, 0, len(project.Spec.Maintenance.FreezePeriods))
+	for _, period := range project.Spec.Maintenance.FreezePeriods {
+		periods = append(periods, config.MaintenanceFreezePeriod{
+			Name:     period.Name,
+			Begin:    period.Begin,
+			End:      period.End,
+			Schedule: period.Schedule,
+			Duration: period.Duration,
+			Location: period.Location,
+		})
+	}
+
+	return periods, nil
+}
+
+// isFreezePeriodActive returns true if the given time is within the given maintenance freeze period.
+func isFreezePeriodActive(period config.MaintenanceFreezePeriod, now time.Time) (bool, error) {
+	if period.Begin != nil && period.End != nil {
+		return !now.Before(period.Begin.Time) && now.Before(period.End.Time), nil
+	}
+
+	if period.Schedule == nil || period.Duration == nil {
+		return false, nil
+	}
+
+	location := time.UTC
+	if period.Location != nil {
+		var err error
+		if location, err = time.LoadLocation(*period.Location); err != nil {
+			return false, err
+		}
+	}
+
+	schedule, err := cron.ParseStandard(*period.Schedule)
+	if err != nil {
+		return false, err
+	}
+
+	// The period is active if it began within the last <duration>, i.e., if the first beginning after now-<duration>
+	// is not in the future.
+	begin := schedule.Next(now.In(location).Add(-period.Duration.Duration))
+	return !begin.After(now), nil
+}
+
+// skipMaintenance reports that the maintenance of the given Shoot was skipped because of the given active freeze
+// periods. The maintenance is caught up in the next maintenance time window outside of freeze periods.
+func (r *Reconciler) skipMaintenance(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, freezePeriods []string) error {
+	description := fmt.Sprintf("Maintenance skipped due to active maintenance freeze period(s) %s, it will be performed in the next maintenance time window", quoteAll(freezePeriods))
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.LastMaintenance = &gardencorev1beta1.LastMaintenance{
+		Description:   description,
+		TriggeredTime: metav1.Time{Time: r.Clock.Now()},
+		State:         gardencorev1beta1.LastOperationStatePending,
+	}
+	if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+		return err
+	}
+
+	r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventMaintenanceSkipped, description)
+	log.Info("Skipped Shoot maintenance due to active maintenance freeze periods", "freezePeriods", freezePeriods)
+	return nil
+}
+
+func freezePeriodsOperation(freezePeriods []string) string {
+	return fmt.Sprintf("Automatic updates of Kubernetes and machine image versions skipped due to active maintenance freeze period(s) %s", quoteAll(freezePeriods))
+}
+
+func quoteAll(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, v := range values {
+		quoted = append(quoted, fmt.Sprintf("%q", v))
+	}
+	return strings.Join(quoted, ", ")
+}
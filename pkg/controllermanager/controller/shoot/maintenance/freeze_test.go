@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+)
+
+var _ = Describe("Freeze periods", func() {
+	var now = time.Date(2024, 3, 29, 12, 0, 0, 0, time.UTC) // Friday
+
+	Describe("#isFreezePeriodActive", func() {
+		DescribeTable("absolute periods",
+			func(begin, end time.Time, expected bool) {
+				period := config.MaintenanceFreezePeriod{Name: "foo", Begin: &metav1.Time{Time: begin}, End: &metav1.Time{Time: end}}
+
+				Expect(isFreezePeriodActive(period, now)).To(Equal(expected))
+			},
+
+			Entry("now is within the period", now.Add(-time.Hour), now.Add(time.Hour), true),
+			Entry("period begins now", now, now.Add(time.Hour), true),
+			Entry("period ends now", now.Add(-time.Hour), now, false),
+			Entry("period is in the past", now.Add(-2*time.Hour), now.Add(-time.Hour), false),
+			Entry("period is in the future", now.Add(time.Hour), now.Add(2*time.Hour), false),
+		)
+
+		DescribeTable("recurring periods",
+			func(schedule string, duration time.Duration, location *string, expected bool) {
+				period := config.MaintenanceFreezePeriod{Name: "foo", Schedule: &schedule, Duration: &metav1.Duration{Duration: duration}, Location: location}
+
+				Expect(isFreezePeriodActive(period, now)).To(Equal(expected))
+			},
+
+			Entry("period began today", "0 8 * * *", 8*time.Hour, nil, true),
+			Entry("period ended today", "0 8 * * *", 4*time.Hour, nil, false),
+			Entry("period begins later today", "0 13 * * *", 8*time.Hour, nil, false),
+			Entry("weekend period which began last weekend", "0 0 * * 6", 48*time.Hour, nil, false),
+			Entry("period which began yesterday", "0 18 * * 4", 24*time.Hour, nil, true),
+			Entry("period in different location", "0 13 * * *", time.Hour, ptr.To("Europe/Berlin"), true),
+		)
+
+		It("should fail for an invalid location", func() {
+			period := config.MaintenanceFreezePeriod{Name: "foo", Schedule: ptr.To("0 8 * * *"), Duration: &metav1.Duration{Duration: time.Hour}, Location: ptr.To("foo")}
+
+			_, err := isFreezePeriodActive(period, now)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#activeFreezePeriods", func() {
+		var (
+			ctx = context.Background()
+
+			fakeClient client.Client
+			recorder   *record.FakeRecorder
+			r          *Reconciler
+
+			project *gardencorev1beta1.Project
+			shoot   *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+				Build()
+			recorder = record.NewFakeRecorder(1)
+
+			r = &Reconciler{
+				Client:   fakeClient,
+				Clock:    testclock.NewFakeClock(now),
+				Recorder: recorder,
+				Config: config.ShootMaintenanceControllerConfiguration{
+					FreezePeriods: []config.MaintenanceFreezePeriod{
+						{Name: "landscape-active", Begin: &metav1.Time{Time: now.Add(-time.Hour)}, End: &metav1.Time{Time: now.Add(time.Hour)}},
+						{Name: "landscape-inactive", Begin: &metav1.Time{Time: now.Add(time.Hour)}, End: &metav1.Time{Time: now.Add(2 * time.Hour)}},
+					},
+				},
+			}
+
+			project = &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+				Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-foo")},
+			}
+			shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-foo"}}
+		})
+
+		It("should return the active landscape-wide freeze periods if the project does not exist", func() {
+			Expect(r.activeFreezePeriods(ctx, shoot)).To(ConsistOf("landscape-active"))
+		})
+
+		It("should return the active landscape-wide and project freeze periods", func() {
+			project.Spec.Maintenance = &gardencorev1beta1.ProjectMaintenance{
+				FreezePeriods: []gardencorev1beta1.MaintenanceFreezePeriod{
+					{Name: "project-active", Schedule: ptr.To("0 8 * * *"), Duration: &metav1.Duration{Duration: 8 * time.Hour}},
+					{Name: "project-inactive", Schedule: ptr.To("0 13 * * *"), Duration: &metav1.Duration{Duration: 8 * time.Hour}},
+				},
+			}
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			Expect(r.activeFreezePeriods(ctx, shoot)).To(ConsistOf("landscape-active", "project-active"))
+		})
+
+		It("should fail if a project freeze period cannot be checked", func() {
+			project.Spec.Maintenance = &gardencorev1beta1.ProjectMaintenance{
+				FreezePeriods: []gardencorev1beta1.MaintenanceFreezePeriod{
+					{Name: "project-invalid", Schedule: ptr.To("0 8 * * *"), Duration: &metav1.Duration{Duration: 8 * time.Hour}, Location: ptr.To("foo")},
+				},
+			}
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			_, err := r.activeFreezePeriods(ctx, shoot)
+			Expect(err).To(MatchError(ContainSubstring(`failed checking maintenance freeze period "project-invalid"`)))
+		})
+	})
+})
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+)
+
+var (
+	// healthConditionTypes are the conditions of a Shoot which must not be `False` or `Unknown` for automatic updates
+	// of its Kubernetes version.
+	healthConditionTypes = []gardencorev1beta1.ConditionType{
+		gardencorev1beta1.ShootAPIServerAvailable,
+		gardencorev1beta1.ShootControlPlaneHealthy,
+		gardencorev1beta1.ShootEveryNodeReady,
+		gardencorev1beta1.ShootSystemComponentsHealthy,
+	}
+	// constraintTypes are the constraints of a Shoot which must not be `False` for automatic updates of its Kubernetes
+	// version.
+	constraintTypes = []gardencorev1beta1.ConditionType{
+		gardencorev1beta1.ShootMaintenancePreconditionsSatisfied,
+		gardencorev1beta1.ShootCRDsWithProblematicConversionWebhooks,
+	}
+)
+
+// checkKubernetesUpdatePreconditions checks whether the Kubernetes version of the given Shoot can safely be updated
+// from the given version to the target version. The update is blocked if the Shoot is not healthy or if the constraints
+// computed by the care controller of gardenlet are not satisfied. Updates of the control plane to a new minor version
+// are additionally blocked if APIs which are removed in this version are still in use. Forced updates of expired
+// versions are not subject to these checks.
+func checkKubernetesUpdatePreconditions(shoot *gardencorev1beta1.Shoot, version, targetVersion string, controlPlane bool) error {
+	var reasons []string
+
+	for _, conditionType := range healthConditionTypes {
+		if condition := v1beta1helper.GetCondition(shoot.Status.Conditions, conditionType); condition != nil &&
+			(condition.Status == gardencorev1beta1.ConditionFalse || condition.Status == gardencorev1beta1.ConditionUnknown) {
+			reasons = append(reasons, fmt.Sprintf("condition %q has status %q: %s", condition.Type, condition.Status, condition.Message))
+		}
+	}
+
+	types := constraintTypes
+	if controlPlane {
+		isMinorVersionUpdate, err := isMinorVersionUpdate(version, targetVersion)
+		if err != nil {
+			return err
+		}
+		if isMinorVersionUpdate {
+			types = append(append([]gardencorev1beta1.ConditionType{}, constraintTypes...), gardencorev1beta1.ShootRemovedAPIsNotInUse)
+		}
+	}
+
+	for _, constraintType := range types {
+		if constraint := v1beta1helper.GetCondition(shoot.Status.Constraints, constraintType); constraint != nil && constraint.Status == gardencorev1beta1.ConditionFalse {
+			reasons = append(reasons, fmt.Sprintf("constraint %q has status %q: %s", constraint.Type, constraint.Status, constraint.Message))
+		}
+	}
+
+	if len(reasons) > 0 {
+		return fmt.Errorf("update to version %q blocked by failed pre-update checks: %s", targetVersion, strings.Join(reasons, "; "))
+	}
+
+	return nil
+}
+
+func isMinorVersionUpdate(version, targetVersion string) (bool, error) {
+	current, err := semver.NewVersion(version)
+	if err != nil {
+		return false, err
+	}
+
+	target, err := semver.NewVersion(targetVersion)
+	if err != nil {
+		return false, err
+	}
+
+	return target.Major() != current.Major() || target.Minor() != current.Minor(), nil
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+var _ = Describe("Preconditions", func() {
+	Describe("#checkKubernetesUpdatePreconditions", func() {
+		var shoot *gardencorev1beta1.Shoot
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{
+				Status: gardencorev1beta1.ShootStatus{
+					Conditions: []gardencorev1beta1.Condition{
+						{Type: gardencorev1beta1.ShootAPIServerAvailable, Status: gardencorev1beta1.ConditionTrue},
+						{Type: gardencorev1beta1.ShootControlPlaneHealthy, Status: gardencorev1beta1.ConditionProgressing},
+						{Type: gardencorev1beta1.ShootEveryNodeReady, Status: gardencorev1beta1.ConditionTrue},
+						{Type: gardencorev1beta1.ShootSystemComponentsHealthy, Status: gardencorev1beta1.ConditionTrue},
+						{Type: gardencorev1beta1.ShootObservabilityComponentsHealthy, Status: gardencorev1beta1.ConditionFalse},
+					},
+					Constraints: []gardencorev1beta1.Condition{
+						{Type: gardencorev1beta1.ShootHibernationPossible, Status: gardencorev1beta1.ConditionFalse},
+						{Type: gardencorev1beta1.ShootMaintenancePreconditionsSatisfied, Status: gardencorev1beta1.ConditionUnknown},
+					},
+				},
+			}
+		})
+
+		It("should succeed if the shoot is healthy and all relevant constraints are satisfied", func() {
+			Expect(checkKubernetesUpdatePreconditions(shoot, "1.29.1", "1.30.0", true)).To(Succeed())
+		})
+
+		It("should succeed if the shoot does not have conditions yet", func() {
+			Expect(checkKubernetesUpdatePreconditions(&gardencorev1beta1.Shoot{}, "1.29.1", "1.30.0", true)).To(Succeed())
+		})
+
+		It("should fail if the shoot is not healthy", func() {
+			shoot.Status.Conditions[1] = gardencorev1beta1.Condition{Type: gardencorev1beta1.ShootControlPlaneHealthy, Status: gardencorev1beta1.ConditionFalse, Message: "etcd is down"}
+			shoot.Status.Conditions[2] = gardencorev1beta1.Condition{Type: gardencorev1beta1.ShootEveryNodeReady, Status: gardencorev1beta1.ConditionUnknown, Message: "unknown"}
+
+			Expect(checkKubernetesUpdatePreconditions(shoot, "1.29.1", "1.29.2", true)).To(MatchError(`update to version "1.29.2" blocked by failed pre-update checks: ` +
+				`condition "ControlPlaneHealthy" has status "False": etcd is down; condition "EveryNodeReady" has status "Unknown": unknown`))
+		})
+
+		It("should fail if constraints are not satisfied", func() {
+			shoot.Status.Constraints = append(shoot.Status.Constraints,
+				gardencorev1beta1.Condition{Type: gardencorev1beta1.ShootCRDsWithProblematicConversionWebhooks, Status: gardencorev1beta1.ConditionFalse, Message: "some CRDs"},
+			)
+			shoot.Status.Constraints[1].Status = gardencorev1beta1.ConditionFalse
+			shoot.Status.Constraints[1].Message = "problematic webhooks"
+
+			Expect(checkKubernetesUpdatePreconditions(shoot, "1.29.1", "1.29.2", false)).To(MatchError(`update to version "1.29.2" blocked by failed pre-update checks: ` +
+				`constraint "MaintenancePreconditionsSatisfied" has status "False": problematic webhooks; constraint "CRDsWithProblematicConversionWebhooks" has status "False": some CRDs`))
+		})
+
+		Context("removed APIs in use", func() {
+			BeforeEach(func() {
+				shoot.Status.Constraints = append(shoot.Status.Constraints,
+					gardencorev1beta1.Condition{Type: gardencorev1beta1.ShootRemovedAPIsNotInUse, Status: gardencorev1beta1.ConditionFalse, Message: "removed APIs"},
+				)
+			})
+
+			It("should fail for minor version updates of the control plane", func() {
+				Expect(checkKubernetesUpdatePreconditions(shoot, "1.29.1", "1.30.0", true)).To(MatchError(`update to version "1.30.0" blocked by failed pre-update checks: ` +
+					`constraint "RemovedAPIsNotInUse" has status "False": removed APIs`))
+			})
+
+			It("should succeed for patch version updates of the control plane", func() {
+				Expect(checkKubernetesUpdatePreconditions(shoot, "1.29.1", "1.29.2", true)).To(Succeed())
+			})
+
+			It("should succeed for minor version updates of worker pools", func() {
+				Expect(checkKubernetesUpdatePreconditions(shoot, "1.29.1", "1.30.0", false)).To(Succeed())
+			})
+		})
+
+		It("should fail for invalid versions", func() {
+			Expect(checkKubernetesUpdatePreconditions(shoot, "foo", "1.30.0", true)).To(MatchError(ContainSubstring("Invalid Semantic Version")))
+		})
+	})
+})
@@ -102,15 +102,32 @@ type updateResult struct {
 }
 
 func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) error {
-	log.Info("Maintaining Shoot")
-
 	var (
 		maintainedShoot = shoot.DeepCopy()
 		// for maintenance operations unrelated to machine images and Kubernetes versions
-		operations []string
-		err        error
+		operations    []string
+		freezePeriods []string
+		err           error
 	)
 
+	// Freeze periods only suppress automatic maintenance, i.e., an explicitly requested maintenance is always performed.
+	if !hasMaintainNowAnnotation(shoot) {
+		freezePeriods, err = r.activeFreezePeriods(ctx, shoot)
+		if err != nil {
+			return err
+		}
+	}
+
+	// For Shoots confining spec update rollouts, the maintenance would roll out all pending spec changes, hence, it is
+	// skipped entirely during freeze periods. This includes forced updates of expired versions which are caught up in
+	// the next maintenance time window outside of freeze periods. Owners of such Shoots explicitly decided to only roll
+	// out changes in their maintenance time window and can trigger the maintenance at any time.
+	if len(freezePeriods) > 0 && v1beta1helper.ShootConfinesSpecUpdateRollout(shoot.Spec.Maintenance) {
+		return r.skipMaintenance(ctx, log, shoot, freezePeriods)
+	}
+
+	log.Info("Maintaining Shoot")
+
 	workerToKubernetesUpdate := make(map[string]updateResult)
 	workerToMachineImageUpdate := make(map[string]updateResult)
 
@@ -119,15 +136,38 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		return err
 	}
 
+	// Versions which were not yet released to the rollout wave of the Shoot are not considered for automatic updates.
+	// Forced updates of expired versions are not held back by rollout waves and consider all versions.
+	releasedCloudProfile, err := r.releasedVersions(ctx, log, shoot, cloudProfile)
+	if err != nil {
+		return fmt.Errorf("failed determining versions released to the rollout wave of the Shoot: %w", err)
+	}
+
+	// Freeze periods only suppress automatic updates. Forced updates of expired versions are still performed, otherwise
+	// the Shoot would keep running a version which is no longer supported.
+	var (
+		autoUpdateKubernetesVersion   = maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion
+		autoUpdateMachineImageVersion = ptr.Deref(maintainedShoot.Spec.Maintenance.AutoUpdate.MachineImageVersion, false)
+	)
+	if len(freezePeriods) > 0 {
+		operations = append(operations, freezePeriodsOperation(freezePeriods))
+		autoUpdateKubernetesVersion, autoUpdateMachineImageVersion = false, false
+	}
+
 	if !v1beta1helper.IsWorkerless(shoot) {
-		workerToMachineImageUpdate, err = maintainMachineImages(log, maintainedShoot, cloudProfile)
+		workerToMachineImageUpdate, err = maintainMachineImages(log, maintainedShoot, cloudProfile, releasedCloudProfile, autoUpdateMachineImageVersion)
 		if err != nil {
 			// continue execution to allow the kubernetes version update
 			log.Error(err, "Failed to maintain Shoot machine images")
 		}
 	}
 
-	kubernetesControlPlaneUpdate, err := maintainKubernetesVersion(log, maintainedShoot.Spec.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+	kubernetesControlPlaneUpdate, err := maintainKubernetesVersion(log, maintainedShoot.Spec.Kubernetes.Version, autoUpdateKubernetesVersion, cloudProfile, releasedCloudProfile, func(v string, forceUpdate bool) (string, error) {
+		if !forceUpdate {
+			if err := checkKubernetesUpdatePreconditions(shoot, maintainedShoot.Spec.Kubernetes.Version, v, true); err != nil {
+				return "", err
+			}
+		}
 		maintainedShoot.Spec.Kubernetes.Version = v
 		return v, nil
 	})
@@ -166,7 +206,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		}
 
 		workerLog := log.WithValues("worker", pool.Name)
-		workerKubernetesUpdate, err := maintainKubernetesVersion(workerLog, *pool.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+		workerKubernetesUpdate, err := maintainKubernetesVersion(workerLog, *pool.Kubernetes.Version, autoUpdateKubernetesVersion, cloudProfile, releasedCloudProfile, func(v string, forceUpdate bool) (string, error) {
 			workerPoolSemver, err := semver.NewVersion(v)
 			if err != nil {
 				return "", err
@@ -176,6 +216,11 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 				workerPoolSemver = shootKubernetesVersion
 			}
 			v = workerPoolSemver.String()
+			if !forceUpdate {
+				if err := checkKubernetesUpdatePreconditions(shoot, *pool.Kubernetes.Version, v, false); err != nil {
+					return "", err
+				}
+			}
 			maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Version = &v
 			return v, nil
 		})
@@ -415,8 +460,10 @@ func maintainTasks(shoot *gardencorev1beta1.Shoot, config config.ShootMaintenanc
 	}
 }
 
-// maintainMachineImages updates the machine images of a Shoot's worker pools if necessary
-func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) (map[string]updateResult, error) {
+// maintainMachineImages updates the machine images of a Shoot's worker pools if necessary. Automatic updates only
+// consider the versions of the releasedCloudProfile, while forced updates of expired versions consider all versions of
+// the cloudProfile.
+func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, cloudProfile, releasedCloudProfile *gardencorev1beta1.CloudProfile, autoUpdate bool) (map[string]updateResult, error) {
 	maintenanceResults := make(map[string]updateResult)
 
 	controlPlaneVersion, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
@@ -428,26 +475,40 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 		workerImage := worker.Machine.Image
 		workerLog := log.WithValues("worker", worker.Name, "image", workerImage.Name, "version", workerImage.Version)
 
-		machineImageFromCloudProfile, err := determineMachineImage(cloudProfile, workerImage)
+		kubeletVersion, err := v1beta1helper.CalculateEffectiveKubernetesVersion(controlPlaneVersion, worker.Kubernetes)
 		if err != nil {
 			return nil, err
 		}
 
-		kubeletVersion, err := v1beta1helper.CalculateEffectiveKubernetesVersion(controlPlaneVersion, worker.Kubernetes)
+		filterMachineImageVersions := func(profile *gardencorev1beta1.CloudProfile) (*gardencorev1beta1.MachineImage, error) {
+			machineImageFromCloudProfile, err := determineMachineImage(profile, workerImage)
+			if err != nil {
+				return nil, err
+			}
+
+			filteredMachineImageVersionsFromCloudProfile := filterForArchitecture(&machineImageFromCloudProfile, worker.Machine.Architecture)
+			filteredMachineImageVersionsFromCloudProfile = filterForCRI(filteredMachineImageVersionsFromCloudProfile, worker.CRI)
+			return filterForKubeleteVersionConstraint(filteredMachineImageVersionsFromCloudProfile, kubeletVersion), nil
+		}
+
+		filteredMachineImageVersionsFromCloudProfile, err := filterMachineImageVersions(releasedCloudProfile)
 		if err != nil {
 			return nil, err
 		}
 
-		filteredMachineImageVersionsFromCloudProfile := filterForArchitecture(&machineImageFromCloudProfile, worker.Machine.Architecture)
-		filteredMachineImageVersionsFromCloudProfile = filterForCRI(filteredMachineImageVersionsFromCloudProfile, worker.CRI)
-		filteredMachineImageVersionsFromCloudProfile = filterForKubeleteVersionConstraint(filteredMachineImageVersionsFromCloudProfile, kubeletVersion)
-
 		// first check if the machine image version should be updated
-		shouldBeUpdated, reason, isExpired := shouldMachineImageVersionBeUpdated(workerImage, filteredMachineImageVersionsFromCloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
+		shouldBeUpdated, reason, isExpired := shouldMachineImageVersionBeUpdated(workerImage, filteredMachineImageVersionsFromCloudProfile, autoUpdate)
 		if !shouldBeUpdated {
 			continue
 		}
 
+		// forced updates of expired versions are not held back by rollout waves
+		if isExpired {
+			if filteredMachineImageVersionsFromCloudProfile, err = filterMachineImageVersions(cloudProfile); err != nil {
+				return nil, err
+			}
+		}
+
 		updatedMachineImageVersion, err := determineMachineImageVersion(workerImage, filteredMachineImageVersionsFromCloudProfile, isExpired)
 		if err != nil {
 			log.Error(err, "Maintenance of machine image failed", "workerPool", worker.Name, "machineImage", workerImage.Name)
@@ -477,8 +538,10 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 	return maintenanceResults, nil
 }
 
-// maintainKubernetesVersion updates the Kubernetes version if necessary and returns the reason why an update was done
-func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUpdate bool, profile *gardencorev1beta1.CloudProfile, updateFunc func(string) (string, error)) (*updateResult, error) {
+// maintainKubernetesVersion updates the Kubernetes version if necessary and returns the reason why an update was done.
+// Automatic updates only consider the versions of the releasedProfile, while forced updates of expired versions
+// consider all versions of the profile. The updateFunc is told whether the update is forced.
+func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUpdate bool, profile, releasedProfile *gardencorev1beta1.CloudProfile, updateFunc func(version string, forceUpdate bool) (string, error)) (*updateResult, error) {
 	shouldBeUpdated, reason, isExpired, err := shouldKubernetesVersionBeUpdated(kubernetesVersion, autoUpdate, profile)
 	if err != nil {
 		return nil, err
@@ -487,7 +550,12 @@ func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUp
 		return nil, nil
 	}
 
-	updatedKubernetesVersion, err := determineKubernetesVersion(kubernetesVersion, profile, isExpired)
+	candidateProfile := releasedProfile
+	if isExpired {
+		candidateProfile = profile
+	}
+
+	updatedKubernetesVersion, err := determineKubernetesVersion(kubernetesVersion, candidateProfile, isExpired)
 	if err != nil {
 		return &updateResult{
 			description:  fmt.Sprintf("could not determine higher suitable version than %q: %v", kubernetesVersion, err),
@@ -501,7 +569,7 @@ func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUp
 	}
 
 	// In case the updatedKubernetesVersion for workerpool is higher than the controlplane version, actualUpdatedKubernetesVersion is set to controlplane version
-	actualUpdatedKubernetesVersion, err := updateFunc(updatedKubernetesVersion)
+	actualUpdatedKubernetesVersion, err := updateFunc(updatedKubernetesVersion, isExpired)
 	if err != nil {
 		return &updateResult{
 			description:  err.Error(),
@@ -134,7 +134,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			})
 
 			It("should update machine image version to overall latest. Auto update: already on latest patch for minor, and there is an overall higher version available", func() {
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
@@ -170,7 +170,7 @@ var _ = Describe("Shoot Maintenance", func() {
 
 				shoot.Spec.Provider.Workers[0].Machine.Architecture = ptr.To("arm64")
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
 			})
@@ -213,7 +213,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 
 				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, otherWorker)
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 
@@ -233,7 +233,7 @@ var _ = Describe("Shoot Maintenance", func() {
 
 				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, highestForMinor)
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
@@ -243,7 +243,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = ptr.To(false)
 				cloudProfile.Spec.MachineImages[0].Versions[0].ExpirationDate = &expirationDateInThePast
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
@@ -278,7 +278,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
@@ -294,7 +294,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, highestExpiredVersion)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestExpiredVersion.Version
-				results, err := maintainMachineImages(log, shoot, cloudProfile)
+				results, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(results[shoot.Spec.Provider.Workers[0].Name].isSuccessful).To(BeFalse())
 				Expect(err).ToNot(HaveOccurred())
@@ -302,7 +302,28 @@ var _ = Describe("Shoot Maintenance", func() {
 
 			It("should not change version: already on highest version.", func() {
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &overallLatestVersion
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
+
+				Expect(err).NotTo(HaveOccurred())
+				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
+			})
+
+			It("should not update to versions which were not released to the rollout wave of the Shoot", func() {
+				releasedCloudProfile := cloudProfile.DeepCopy()
+				releasedCloudProfile.Spec.MachineImages[0].Versions = releasedCloudProfile.Spec.MachineImages[0].Versions[:1]
+
+				_, err := maintainMachineImages(log, shoot, cloudProfile, releasedCloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
+
+				Expect(err).NotTo(HaveOccurred())
+				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", shootCurrentImageVersion)
+			})
+
+			It("should force update an expired version to versions which were not released to the rollout wave of the Shoot", func() {
+				cloudProfile.Spec.MachineImages[0].Versions[0].ExpirationDate = &expirationDateInThePast
+				releasedCloudProfile := cloudProfile.DeepCopy()
+				releasedCloudProfile.Spec.MachineImages[0].Versions = releasedCloudProfile.Spec.MachineImages[0].Versions[:1]
+
+				_, err := maintainMachineImages(log, shoot, cloudProfile, releasedCloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
@@ -337,7 +358,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				cloudProfile.Spec.MachineImages[0].Versions = versions
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
@@ -393,7 +414,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
@@ -436,7 +457,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestPatchNextMinor)
@@ -472,7 +493,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				cloudProfile.Spec.MachineImages[0].Versions = versions
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestNonPreviewPatchVersionNplusTwoMinor.Version)
@@ -517,7 +538,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				cloudProfile.Spec.MachineImages[0].Versions = versions
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expiredPatchVersionNextMinor.Version)
@@ -551,7 +572,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestVersionForMinor
 				expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
 			})
@@ -566,7 +587,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, highestExpiredVersion)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestExpiredVersion.Version
-				results, err := maintainMachineImages(log, shoot, cloudProfile)
+				results, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(results[shoot.Spec.Provider.Workers[0].Name].isSuccessful).To(BeFalse())
 				Expect(err).ToNot(HaveOccurred())
@@ -618,7 +639,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestPatchCurrentMinor)
@@ -667,7 +688,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestVersionForCurrentMajor)
@@ -717,7 +738,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestVersionForCurrentMajor)
@@ -763,7 +784,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &latestVersionForCurrentMajor
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", latestVersionNextMajor)
@@ -799,7 +820,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				cloudProfile.Spec.MachineImages[0].Versions = versions
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestNonPreviewVersionNplusTwoMajor.Version)
@@ -815,7 +836,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, highestExpiredVersion)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestExpiredVersion.Version
-				results, err := maintainMachineImages(log, shoot, cloudProfile)
+				results, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(results[shoot.Spec.Provider.Workers[0].Name].isSuccessful).To(BeFalse())
 				Expect(err).ToNot(HaveOccurred())
@@ -851,7 +872,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestVersionForMajor
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestVersionForMajor)
@@ -861,7 +882,7 @@ var _ = Describe("Shoot Maintenance", func() {
 		It("should treat workers with `cri: nil` like `cri.name: containerd` and not update if `containerd` is not explicitly supported by the machine image", func() {
 			cloudProfile.Spec.MachineImages[0].Versions[1].CRI = []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRIName("other")}}
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 			Expect(err).NotTo(HaveOccurred())
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.0")
 		})
@@ -870,7 +891,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = ptr.To(false)
 
 			expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
@@ -881,7 +902,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Provider.Workers[0].CRI = &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD}
 
 			expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
 		})
@@ -894,7 +915,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			// add another pool without CRI constraints -> should be updated via auto-update
 			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-without-cri-config", Machine: gardencorev1beta1.Machine{Image: shootCurrentImage.DeepCopy(), Architecture: ptr.To("amd64")}})
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 			Expect(err).NotTo(HaveOccurred())
 
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.0")
@@ -910,7 +931,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			// add another pool without CRI constraints -> should be updated via auto-update to the highest patch version of the same minor
 			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-without-containerruntime", CRI: &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD}, Machine: gardencorev1beta1.Machine{Image: shootCurrentImage.DeepCopy(), Architecture: ptr.To("amd64")}})
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 			Expect(err).NotTo(HaveOccurred())
 
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.0")
@@ -925,7 +946,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-with-gvisor-and-kata", CRI: &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD, ContainerRuntimes: []gardencorev1beta1.ContainerRuntime{{Type: "gvisor"}, {Type: "kata-container"}}}, Machine: gardencorev1beta1.Machine{Image: shootCurrentImage.DeepCopy(), Architecture: ptr.To("amd64")}})
 			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-with-gvisor", CRI: &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD, ContainerRuntimes: []gardencorev1beta1.ContainerRuntime{{Type: "gvisor"}}}, Machine: gardencorev1beta1.Machine{Image: shootCurrentImage.DeepCopy(), Architecture: ptr.To("amd64")}})
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 			Expect(err).NotTo(HaveOccurred())
 
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.0")
@@ -938,7 +959,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Kubernetes.Version = "1.26.0"
 
 			expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
 		})
@@ -947,7 +968,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.MachineImages[0].Versions[1].KubeletVersionConstraint = ptr.To("< 1.26")
 			shoot.Spec.Kubernetes.Version = "1.25.1"
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 			Expect(err).NotTo(HaveOccurred())
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
 		})
@@ -960,7 +981,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			}
 
 			expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
 		})
@@ -973,7 +994,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				Version: ptr.To("1.26.0"),
 			}
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 			Expect(err).NotTo(HaveOccurred())
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", cloudProfile.Spec.MachineImages[0].Versions[1].Version)
 		})
@@ -981,7 +1002,7 @@ var _ = Describe("Shoot Maintenance", func() {
 		It("should return an error - cloud profile has no matching (machineImage.name) machine image defined", func() {
 			cloudProfile.Spec.MachineImages = cloudProfile.Spec.MachineImages[1:]
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 			Expect(err).To(HaveOccurred())
 		})
@@ -1050,7 +1071,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[4].ExpirationDate = &expirationDateInThePast
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.1"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, cloudProfile, func(v string, _ bool) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1068,7 +1089,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			// mark latest version 1.02 as preview
 			cloudProfile.Spec.Kubernetes.Versions[3].Classification = &previewClassification
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, cloudProfile, func(v string, _ bool) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1082,7 +1103,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[3].ExpirationDate = &expirationDateInThePast
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.2"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, cloudProfile, func(v string, _ bool) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1096,7 +1117,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[3].ExpirationDate = &expirationDateInThePast
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.2"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, cloudProfile, func(v string, _ bool) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1117,7 +1138,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[1].ExpirationDate = &expirationDateInThePast
 			cloudProfile.Spec.Kubernetes.Versions[2].ExpirationDate = &expirationDateInThePast
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, cloudProfile, func(v string, _ bool) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1135,7 +1156,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[3].ExpirationDate = &expirationDateInThePast
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.2"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, cloudProfile, func(v string, _ bool) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1147,7 +1168,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = true
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.1"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, cloudProfile, func(v string, _ bool) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1161,7 +1182,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[4].ExpirationDate = &expirationDateInTheFuture
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.1"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, cloudProfile, func(v string, _ bool) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1175,7 +1196,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = true
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.0"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, cloudProfile, func(v string, _ bool) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1189,7 +1210,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = true
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.2"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, cloudProfile, func(v string, _ bool) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1203,7 +1224,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[3].ExpirationDate = &expirationDateInThePast
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.1.2"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, cloudProfile, func(v string, _ bool) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1211,6 +1232,50 @@ var _ = Describe("Shoot Maintenance", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.1.2"))
 		})
+
+		It("should not update to versions which were not released to the rollout wave of the Shoot", func() {
+			releasedCloudProfile := cloudProfile.DeepCopy()
+			releasedCloudProfile.Spec.Kubernetes.Versions = releasedCloudProfile.Spec.Kubernetes.Versions[5:6]
+
+			result, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, releasedCloudProfile, func(v string, _ bool) (string, error) {
+				shoot.Spec.Kubernetes.Version = v
+				return v, nil
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(BeNil())
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.0.0"))
+		})
+
+		It("should force update an expired version to versions which were not released to the rollout wave of the Shoot", func() {
+			cloudProfile.Spec.Kubernetes.Versions[5].ExpirationDate = &expirationDateInThePast
+			releasedCloudProfile := cloudProfile.DeepCopy()
+			releasedCloudProfile.Spec.Kubernetes.Versions = releasedCloudProfile.Spec.Kubernetes.Versions[5:6]
+
+			var forced bool
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, releasedCloudProfile, func(v string, forceUpdate bool) (string, error) {
+				forced = forceUpdate
+				shoot.Spec.Kubernetes.Version = v
+				return v, nil
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(forced).To(BeTrue())
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.0.2"))
+		})
+
+		It("should tell the update function that an automatic update is not forced", func() {
+			forced := true
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, cloudProfile, func(v string, forceUpdate bool) (string, error) {
+				forced = forceUpdate
+				shoot.Spec.Kubernetes.Version = v
+				return v, nil
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(forced).To(BeFalse())
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.0.2"))
+		})
 	})
 
 	Describe("#EnsureSufficientMaxWorkers", func() {
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/rolloutwave"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// releasedVersions returns a copy of the given CloudProfile which only contains the Kubernetes and machine image
+// versions which were already released to the rollout wave of the given Shoot. The versions currently used by the
+// Shoot are always kept. If rollout waves are not configured, the CloudProfile is returned unchanged. The result is only
+// used for automatic updates, forced updates of expired versions consider all versions of the CloudProfile.
+func (r *Reconciler) releasedVersions(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) (*gardencorev1beta1.CloudProfile, error) {
+	if r.Config.RolloutWaves == nil {
+		return cloudProfile, nil
+	}
+
+	status := rolloutwave.ReadStatus(cloudProfile)
+	if status == nil {
+		// The rollout of the versions was not tracked yet, hence, all versions are considered to be released.
+		return cloudProfile, nil
+	}
+
+	var (
+		project *gardencorev1beta1.Project
+		err     error
+	)
+	if rolloutwave.NeedsProjects(r.Config.RolloutWaves.Waves) {
+		if project, err = gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, shoot.Namespace); client.IgnoreNotFound(err) != nil {
+			return nil, err
+		}
+	}
+
+	wave, err := rolloutwave.WaveForShoot(r.Config.RolloutWaves.Waves, shoot, project)
+	if err != nil {
+		return nil, err
+	}
+	log.V(1).Info("Determined rollout wave of Shoot", "rolloutWave", wave)
+
+	usedKubernetesVersions, usedMachineImageVersions := sets.New(shoot.Spec.Kubernetes.Version), map[string]sets.Set[string]{}
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if worker.Kubernetes != nil && worker.Kubernetes.Version != nil {
+			usedKubernetesVersions.Insert(*worker.Kubernetes.Version)
+		}
+		if image := worker.Machine.Image; image != nil && image.Version != nil {
+			if usedMachineImageVersions[image.Name] == nil {
+				usedMachineImageVersions[image.Name] = sets.New[string]()
+			}
+			usedMachineImageVersions[image.Name].Insert(*image.Version)
+		}
+	}
+
+	filtered := cloudProfile.DeepCopy()
+
+	filtered.Spec.Kubernetes.Versions = nil
+	for _, version := range cloudProfile.Spec.Kubernetes.Versions {
+		if usedKubernetesVersions.Has(version.Version) || status.IsKubernetesVersionReleased(version.Version, wave) {
+			filtered.Spec.Kubernetes.Versions = append(filtered.Spec.Kubernetes.Versions, version)
+		}
+	}
+
+	for i, image := range cloudProfile.Spec.MachineImages {
+		filtered.Spec.MachineImages[i].Versions = nil
+		for _, version := range image.Versions {
+			if usedMachineImageVersions[image.Name].Has(version.Version) || status.IsMachineImageVersionReleased(image.Name, version.Version, wave) {
+				filtered.Spec.MachineImages[i].Versions = append(filtered.Spec.MachineImages[i].Versions, version)
+			}
+		}
+	}
+
+	return filtered, nil
+}
@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+)
+
+var _ = Describe("Rollout waves", func() {
+	Describe("#releasedVersions", func() {
+		var (
+			ctx = context.Background()
+			log = logr.Discard()
+
+			r            *Reconciler
+			cloudProfile *gardencorev1beta1.CloudProfile
+			shoot        *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			fakeClient := fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+				Build()
+
+			r = &Reconciler{
+				Client: fakeClient,
+				Config: config.ShootMaintenanceControllerConfiguration{
+					RolloutWaves: &config.MaintenanceRolloutWavesConfiguration{
+						Waves: []config.MaintenanceRolloutWave{
+							{Name: "canary", ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}}},
+						},
+					},
+				},
+			}
+
+			cloudProfile = &gardencorev1beta1.CloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					Kubernetes: gardencorev1beta1.KubernetesSettings{
+						Versions: []gardencorev1beta1.ExpirableVersion{{Version: "1.29.0"}, {Version: "1.29.1"}, {Version: "1.29.2"}, {Version: "1.29.3"}},
+					},
+					MachineImages: []gardencorev1beta1.MachineImage{{
+						Name: "foo",
+						Versions: []gardencorev1beta1.MachineImageVersion{
+							{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "0.9.0"}},
+							{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0"}},
+							{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.1"}},
+						},
+					}},
+				},
+				Status: gardencorev1beta1.CloudProfileStatus{
+					KubernetesVersions: []gardencorev1beta1.VersionRolloutStatus{
+						{Version: "1.29.1", Completed: true},
+						{Version: "1.29.2", Waves: []gardencorev1beta1.RolloutWaveStatus{{Name: "canary"}}},
+					},
+					MachineImages: []gardencorev1beta1.MachineImageRolloutStatus{{
+						Name: "foo",
+						Versions: []gardencorev1beta1.VersionRolloutStatus{
+							{Version: "1.0.0", Completed: true},
+							{Version: "1.0.1", Waves: []gardencorev1beta1.RolloutWaveStatus{{Name: "canary"}}},
+						},
+					}},
+				},
+			}
+
+			shoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-foo"},
+				Spec: gardencorev1beta1.ShootSpec{
+					Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.29.0"},
+					Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{{
+						Name:    "worker",
+						Machine: gardencorev1beta1.Machine{Image: &gardencorev1beta1.ShootMachineImage{Name: "foo", Version: ptr.To("0.9.0")}},
+					}}},
+				},
+			}
+		})
+
+		versionsOf := func(cloudProfile *gardencorev1beta1.CloudProfile) ([]string, []string) {
+			var kubernetesVersions, machineImageVersions []string
+			for _, v := range cloudProfile.Spec.Kubernetes.Versions {
+				kubernetesVersions = append(kubernetesVersions, v.Version)
+			}
+			for _, v := range cloudProfile.Spec.MachineImages[0].Versions {
+				machineImageVersions = append(machineImageVersions, v.Version)
+			}
+			return kubernetesVersions, machineImageVersions
+		}
+
+		It("should return the CloudProfile unchanged if rollout waves are not configured", func() {
+			r.Config.RolloutWaves = nil
+
+			Expect(r.releasedVersions(ctx, log, shoot, cloudProfile)).To(BeIdenticalTo(cloudProfile))
+		})
+
+		It("should return the CloudProfile unchanged if the rollout is not tracked yet", func() {
+			cloudProfile.Status = gardencorev1beta1.CloudProfileStatus{}
+
+			Expect(r.releasedVersions(ctx, log, shoot, cloudProfile)).To(BeIdenticalTo(cloudProfile))
+		})
+
+		It("should only keep released and currently used versions for shoots of the remaining wave", func() {
+			filtered, err := r.releasedVersions(ctx, log, shoot, cloudProfile)
+			Expect(err).NotTo(HaveOccurred())
+
+			kubernetesVersions, machineImageVersions := versionsOf(filtered)
+			Expect(kubernetesVersions).To(Equal([]string{"1.29.0", "1.29.1"}))
+			Expect(machineImageVersions).To(Equal([]string{"0.9.0", "1.0.0"}))
+
+			kubernetesVersions, machineImageVersions = versionsOf(cloudProfile)
+			Expect(kubernetesVersions).To(HaveLen(4))
+			Expect(machineImageVersions).To(HaveLen(3))
+		})
+
+		It("should keep versions released to the wave of the shoot's project", func() {
+			Expect(r.Client.Create(ctx, &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Labels: map[string]string{"canary": "true"}},
+				Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-foo")},
+			})).To(Succeed())
+
+			filtered, err := r.releasedVersions(ctx, log, shoot, cloudProfile)
+			Expect(err).NotTo(HaveOccurred())
+
+			kubernetesVersions, machineImageVersions := versionsOf(filtered)
+			Expect(kubernetesVersions).To(Equal([]string{"1.29.0", "1.29.1", "1.29.2"}))
+			Expect(machineImageVersions).To(Equal([]string{"0.9.0", "1.0.0", "1.0.1"}))
+		})
+	})
+})
@@ -0,0 +1,298 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rolloutwave
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// Reconciler reconciles CloudProfiles and tracks the staged rollout of their Kubernetes and machine image versions
+// across the rollout waves of Shoots.
+type Reconciler struct {
+	Client client.Client
+	Config config.MaintenanceRolloutWavesConfiguration
+	Clock  clock.Clock
+}
+
+// shootInWave is a Shoot together with the index of the rollout wave it belongs to.
+type shootInWave struct {
+	shoot *gardencorev1beta1.Shoot
+	wave  int
+}
+
+// Reconcile reconciles CloudProfiles and tracks the staged rollout of their Kubernetes and machine image versions
+// across the rollout waves of Shoots.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	cloudProfile := &gardencorev1beta1.CloudProfile{}
+	if err := r.Client.Get(ctx, request.NamespacedName, cloudProfile); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if cloudProfile.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	status := ReadStatus(cloudProfile)
+	if status == nil {
+		// Versions which exist when the rollout is tracked for the first time are considered to be released to all
+		// Shoots already.
+		log.Info("Initializing rollout status")
+		status = initialStatus(cloudProfile)
+	} else {
+		shoots, err := r.shootsInWaves(ctx, cloudProfile.Name)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		r.updateStatus(status, cloudProfile, shoots)
+	}
+
+	if cloudProfileStatus := status.CloudProfileStatus(cloudProfile); !apiequality.Semantic.DeepEqual(cloudProfile.Status, cloudProfileStatus) {
+		patch := client.MergeFrom(cloudProfile.DeepCopy())
+		cloudProfile.Status = cloudProfileStatus
+		if err := r.Client.Status().Patch(ctx, cloudProfile, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed updating rollout status: %w", err)
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func initialStatus(cloudProfile *gardencorev1beta1.CloudProfile) *Status {
+	status := &Status{
+		Kubernetes:    map[string]*VersionStatus{},
+		MachineImages: map[string]map[string]*VersionStatus{},
+	}
+
+	for _, version := range cloudProfile.Spec.Kubernetes.Versions {
+		status.Kubernetes[version.Version] = &VersionStatus{Completed: true}
+	}
+
+	for _, image := range cloudProfile.Spec.MachineImages {
+		status.MachineImages[image.Name] = map[string]*VersionStatus{}
+		for _, version := range image.Versions {
+			status.MachineImages[image.Name][version.Version] = &VersionStatus{Completed: true}
+		}
+	}
+
+	return status
+}
+
+func (r *Reconciler) shootsInWaves(ctx context.Context, cloudProfileName string) ([]shootInWave, error) {
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList); err != nil {
+		return nil, err
+	}
+
+	projectsByNamespace := map[string]*gardencorev1beta1.Project{}
+	if NeedsProjects(r.Config.Waves) {
+		projectList := &gardencorev1beta1.ProjectList{}
+		if err := r.Client.List(ctx, projectList); err != nil {
+			return nil, err
+		}
+		for i, project := range projectList.Items {
+			if project.Spec.Namespace != nil {
+				projectsByNamespace[*project.Spec.Namespace] = &projectList.Items[i]
+			}
+		}
+	}
+
+	var shoots []shootInWave
+	for i, shoot := range shootList.Items {
+		if shoot.Spec.CloudProfileName != cloudProfileName {
+			continue
+		}
+
+		waveName, err := WaveForShoot(r.Config.Waves, &shootList.Items[i], projectsByNamespace[shoot.Namespace])
+		if err != nil {
+			return nil, err
+		}
+		shoots = append(shoots, shootInWave{shoot: &shootList.Items[i], wave: r.waveIndex(waveName)})
+	}
+
+	return shoots, nil
+}
+
+func (r *Reconciler) updateStatus(status *Status, cloudProfile *gardencorev1beta1.CloudProfile, shoots []shootInWave) {
+	kubernetesVersions := sets.New[string]()
+	if status.Kubernetes == nil {
+		status.Kubernetes = map[string]*VersionStatus{}
+	}
+
+	for _, version := range cloudProfile.Spec.Kubernetes.Versions {
+		kubernetesVersions.Insert(version.Version)
+		status.Kubernetes[version.Version] = r.progress(status.Kubernetes[version.Version], shoots, func(shoot *gardencorev1beta1.Shoot) bool {
+			return runsKubernetesVersion(shoot, version.Version)
+		})
+	}
+
+	for version := range status.Kubernetes {
+		if !kubernetesVersions.Has(version) {
+			delete(status.Kubernetes, version)
+		}
+	}
+
+	machineImageNames := sets.New[string]()
+	if status.MachineImages == nil {
+		status.MachineImages = map[string]map[string]*VersionStatus{}
+	}
+
+	for _, image := range cloudProfile.Spec.MachineImages {
+		machineImageNames.Insert(image.Name)
+		if status.MachineImages[image.Name] == nil {
+			status.MachineImages[image.Name] = map[string]*VersionStatus{}
+		}
+
+		versions := sets.New[string]()
+		for _, version := range image.Versions {
+			versions.Insert(version.Version)
+			status.MachineImages[image.Name][version.Version] = r.progress(status.MachineImages[image.Name][version.Version], shoots, func(shoot *gardencorev1beta1.Shoot) bool {
+				return runsMachineImageVersion(shoot, image.Name, version.Version)
+			})
+		}
+
+		for version := range status.MachineImages[image.Name] {
+			if !versions.Has(version) {
+				delete(status.MachineImages[image.Name], version)
+			}
+		}
+	}
+
+	for name := range status.MachineImages {
+		if !machineImageNames.Has(name) {
+			delete(status.MachineImages, name)
+		}
+	}
+}
+
+// progress releases the version to the next wave once all Shoots of the previous waves running the version were
+// healthy for the soak duration of the last wave. Any unhealthy Shoot restarts the soak period.
+func (r *Reconciler) progress(versionStatus *VersionStatus, shoots []shootInWave, runsVersion func(*gardencorev1beta1.Shoot) bool) *VersionStatus {
+	now := metav1.NewTime(r.Clock.Now())
+
+	if versionStatus == nil {
+		return &VersionStatus{Waves: []WaveStatus{{Name: r.waveName(0), ReleasedAt: now, HealthySince: now}}}
+	}
+
+	if versionStatus.Completed {
+		return versionStatus
+	}
+
+	if len(versionStatus.Waves) == 0 {
+		versionStatus.Waves = []WaveStatus{{Name: r.waveName(0), ReleasedAt: now, HealthySince: now}}
+	}
+
+	current := &versionStatus.Waves[len(versionStatus.Waves)-1]
+	currentIndex := r.waveIndex(current.Name)
+	if currentIndex < 0 || currentIndex >= len(r.Config.Waves) {
+		// The version was already released to the remaining Shoots, or the wave was removed from the configuration.
+		return &VersionStatus{Completed: true}
+	}
+
+	if unhealthy := unhealthyShoot(shoots, currentIndex, runsVersion); unhealthy != nil {
+		current.HealthySince = now
+		versionStatus.Message = fmt.Sprintf("Rollout is paused because Shoot %s is not healthy", client.ObjectKeyFromObject(unhealthy))
+		return versionStatus
+	}
+	versionStatus.Message = ""
+
+	if r.Clock.Since(current.HealthySince.Time) < r.Config.Waves[currentIndex].SoakDuration.Duration {
+		return versionStatus
+	}
+
+	versionStatus.Waves = append(versionStatus.Waves, WaveStatus{Name: r.waveName(currentIndex + 1), ReleasedAt: now, HealthySince: now})
+	if currentIndex+1 == len(r.Config.Waves) {
+		versionStatus.Completed = true
+	}
+
+	return versionStatus
+}
+
+// unhealthyShoot returns a Shoot of a wave up to the given index which runs the version and which is not healthy, i.e.,
+// its control plane, its nodes or its system components are reported to be unhealthy, or its last operation failed.
+func unhealthyShoot(shoots []shootInWave, maxWave int, runsVersion func(*gardencorev1beta1.Shoot) bool) *gardencorev1beta1.Shoot {
+	for _, s := range shoots {
+		if s.wave > maxWave || !runsVersion(s.shoot) {
+			continue
+		}
+
+		if isUnhealthy(s.shoot) {
+			return s.shoot
+		}
+	}
+	return nil
+}
+
+var healthConditionTypes = []gardencorev1beta1.ConditionType{
+	gardencorev1beta1.ShootControlPlaneHealthy,
+	gardencorev1beta1.ShootEveryNodeReady,
+	gardencorev1beta1.ShootSystemComponentsHealthy,
+}
+
+func isUnhealthy(shoot *gardencorev1beta1.Shoot) bool {
+	for _, conditionType := range healthConditionTypes {
+		if condition := v1beta1helper.GetCondition(shoot.Status.Conditions, conditionType); condition != nil && condition.Status == gardencorev1beta1.ConditionFalse {
+			return true
+		}
+	}
+
+	lastOperation := shoot.Status.LastOperation
+	return lastOperation != nil && lastOperation.State == gardencorev1beta1.LastOperationStateFailed
+}
+
+func (r *Reconciler) waveName(index int) string {
+	if index < len(r.Config.Waves) {
+		return r.Config.Waves[index].Name
+	}
+	return config.RemainingShootsRolloutWave
+}
+
+func (r *Reconciler) waveIndex(name string) int {
+	if name == config.RemainingShootsRolloutWave {
+		return len(r.Config.Waves)
+	}
+	return slices.IndexFunc(r.Config.Waves, func(wave config.MaintenanceRolloutWave) bool { return wave.Name == name })
+}
+
+func runsKubernetesVersion(shoot *gardencorev1beta1.Shoot, version string) bool {
+	if shoot.Spec.Kubernetes.Version == version {
+		return true
+	}
+
+	return slices.ContainsFunc(shoot.Spec.Provider.Workers, func(worker gardencorev1beta1.Worker) bool {
+		return worker.Kubernetes != nil && worker.Kubernetes.Version != nil && *worker.Kubernetes.Version == version
+	})
+}
+
+func runsMachineImageVersion(shoot *gardencorev1beta1.Shoot, name, version string) bool {
+	return slices.ContainsFunc(shoot.Spec.Provider.Workers, func(worker gardencorev1beta1.Worker) bool {
+		image := worker.Machine.Image
+		return image != nil && image.Name == name && image.Version != nil && *image.Version == version
+	})
+}
@@ -0,0 +1,249 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rolloutwave_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/rolloutwave"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx = context.Background()
+		now = time.Date(2024, 4, 2, 10, 0, 0, 0, time.UTC)
+
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		r          *Reconciler
+
+		cloudProfile *gardencorev1beta1.CloudProfile
+		request      reconcile.Request
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.CloudProfile{}).Build()
+		fakeClock = testclock.NewFakeClock(now)
+
+		r = &Reconciler{
+			Client: fakeClient,
+			Clock:  fakeClock,
+			Config: config.MaintenanceRolloutWavesConfiguration{
+				Waves: []config.MaintenanceRolloutWave{
+					{Name: "canary", ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}}, SoakDuration: metav1.Duration{Duration: time.Hour}},
+					{Name: "early", ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"early": "true"}}, SoakDuration: metav1.Duration{Duration: 2 * time.Hour}},
+				},
+				SyncPeriod: &metav1.Duration{Duration: time.Minute},
+			},
+		}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				Kubernetes: gardencorev1beta1.KubernetesSettings{
+					Versions: []gardencorev1beta1.ExpirableVersion{{Version: "1.29.1"}, {Version: "1.29.2"}},
+				},
+				MachineImages: []gardencorev1beta1.MachineImage{{
+					Name:     "foo",
+					Versions: []gardencorev1beta1.MachineImageVersion{{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0"}}},
+				}},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(cloudProfile)}
+	})
+
+	createWithStatus := func(status gardencorev1beta1.CloudProfileStatus) {
+		Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+		cloudProfile.Status = status
+		Expect(fakeClient.Status().Update(ctx, cloudProfile)).To(Succeed())
+	}
+
+	reconcileAndReadStatus := func() *Status {
+		result, err := r.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, cloudProfile)).To(Succeed())
+		return ReadStatus(cloudProfile)
+	}
+
+	newShoot := func(name, version string, labels map[string]string, controlPlaneHealthy gardencorev1beta1.ConditionStatus) *gardencorev1beta1.Shoot {
+		return &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-foo", Labels: labels},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: "profile",
+				Kubernetes:       gardencorev1beta1.Kubernetes{Version: version},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				Conditions: []gardencorev1beta1.Condition{{Type: gardencorev1beta1.ShootControlPlaneHealthy, Status: controlPlaneHealthy}},
+			},
+		}
+	}
+
+	It("should do nothing if the CloudProfile does not exist", func() {
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should consider all existing versions released when the rollout is tracked for the first time", func() {
+		Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+
+		Expect(reconcileAndReadStatus()).To(Equal(&Status{
+			Kubernetes:    map[string]*VersionStatus{"1.29.1": {Completed: true}, "1.29.2": {Completed: true}},
+			MachineImages: map[string]map[string]*VersionStatus{"foo": {"1.0.0": {Completed: true}}},
+		}))
+		Expect(cloudProfile.Status).To(Equal(gardencorev1beta1.CloudProfileStatus{
+			KubernetesVersions: []gardencorev1beta1.VersionRolloutStatus{{Version: "1.29.1", Completed: true}, {Version: "1.29.2", Completed: true}},
+			MachineImages: []gardencorev1beta1.MachineImageRolloutStatus{{
+				Name:     "foo",
+				Versions: []gardencorev1beta1.VersionRolloutStatus{{Version: "1.0.0", Completed: true}},
+			}},
+		}))
+	})
+
+	Context("new versions", func() {
+		BeforeEach(func() {
+			createWithStatus(gardencorev1beta1.CloudProfileStatus{
+				KubernetesVersions: []gardencorev1beta1.VersionRolloutStatus{{Version: "1.29.1", Completed: true}, {Version: "1.28.9", Completed: true}},
+				MachineImages: []gardencorev1beta1.MachineImageRolloutStatus{{
+					Name:     "bar",
+					Versions: []gardencorev1beta1.VersionRolloutStatus{{Version: "2.0.0", Completed: true}},
+				}},
+			})
+		})
+
+		It("should release new versions to the first wave and prune removed versions", func() {
+			status := reconcileAndReadStatus()
+
+			Expect(status.Kubernetes).To(HaveLen(2))
+			Expect(status.Kubernetes["1.29.1"]).To(Equal(&VersionStatus{Completed: true}))
+			Expect(status.Kubernetes["1.29.2"].Completed).To(BeFalse())
+			Expect(status.Kubernetes["1.29.2"].Waves).To(HaveLen(1))
+			Expect(status.Kubernetes["1.29.2"].Waves[0].Name).To(Equal("canary"))
+			Expect(status.Kubernetes["1.29.2"].Waves[0].ReleasedAt.Time.Equal(now)).To(BeTrue())
+
+			Expect(status.MachineImages).To(HaveLen(1))
+			Expect(status.MachineImages["foo"]["1.0.0"].Waves[0].Name).To(Equal("canary"))
+		})
+
+		It("should release the version to the next waves after their soak durations", func() {
+			Expect(fakeClient.Create(ctx, newShoot("canary", "1.29.2", map[string]string{"canary": "true"}, gardencorev1beta1.ConditionTrue))).To(Succeed())
+
+			Expect(reconcileAndReadStatus().Kubernetes["1.29.2"].Waves).To(HaveLen(1))
+
+			fakeClock.Step(59 * time.Minute)
+			Expect(reconcileAndReadStatus().Kubernetes["1.29.2"].Waves).To(HaveLen(1))
+
+			fakeClock.Step(time.Minute)
+			status := reconcileAndReadStatus()
+			Expect(status.Kubernetes["1.29.2"].Waves).To(HaveLen(2))
+			Expect(status.Kubernetes["1.29.2"].Waves[1].Name).To(Equal("early"))
+			Expect(status.Kubernetes["1.29.2"].Completed).To(BeFalse())
+			Expect(status.IsKubernetesVersionReleased("1.29.2", "early")).To(BeTrue())
+			Expect(status.IsKubernetesVersionReleased("1.29.2", config.RemainingShootsRolloutWave)).To(BeFalse())
+
+			fakeClock.Step(2 * time.Hour)
+			status = reconcileAndReadStatus()
+			Expect(status.Kubernetes["1.29.2"].Waves).To(HaveLen(3))
+			Expect(status.Kubernetes["1.29.2"].Waves[2].Name).To(Equal(config.RemainingShootsRolloutWave))
+			Expect(status.Kubernetes["1.29.2"].Completed).To(BeTrue())
+		})
+
+		It("should pause the rollout while a shoot of an earlier wave running the version is unhealthy", func() {
+			shoot := newShoot("canary", "1.29.2", map[string]string{"canary": "true"}, gardencorev1beta1.ConditionFalse)
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+			Expect(fakeClient.Create(ctx, newShoot("other", "1.29.1", map[string]string{"canary": "true"}, gardencorev1beta1.ConditionFalse))).To(Succeed())
+			reconcileAndReadStatus()
+
+			fakeClock.Step(time.Hour)
+			status := reconcileAndReadStatus()
+			Expect(status.Kubernetes["1.29.2"].Waves).To(HaveLen(1))
+			Expect(status.Kubernetes["1.29.2"].Waves[0].HealthySince.Time.Equal(fakeClock.Now())).To(BeTrue())
+			Expect(status.Kubernetes["1.29.2"].Message).To(ContainSubstring("Shoot garden-foo/canary is not healthy"))
+			Expect(cloudProfile.Status.KubernetesVersions[1].Message).To(PointTo(ContainSubstring("Shoot garden-foo/canary is not healthy")))
+			// The machine image is not used by the unhealthy shoot, hence, its rollout continues.
+			Expect(status.MachineImages["foo"]["1.0.0"].Waves).To(HaveLen(2))
+
+			shoot.Status.Conditions[0].Status = gardencorev1beta1.ConditionTrue
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+			fakeClock.Step(time.Hour)
+			status = reconcileAndReadStatus()
+			Expect(status.Kubernetes["1.29.2"].Waves).To(HaveLen(2))
+			Expect(status.Kubernetes["1.29.2"].Message).To(BeEmpty())
+		})
+
+		DescribeTable("should pause the rollout while a shoot of an earlier wave running the version is unhealthy",
+			func(mutate func(*gardencorev1beta1.Shoot)) {
+				shoot := newShoot("canary", "1.29.2", map[string]string{"canary": "true"}, gardencorev1beta1.ConditionTrue)
+				mutate(shoot)
+				Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+				reconcileAndReadStatus()
+
+				fakeClock.Step(time.Hour)
+				status := reconcileAndReadStatus()
+				Expect(status.Kubernetes["1.29.2"].Waves).To(HaveLen(1))
+				Expect(status.Kubernetes["1.29.2"].Message).To(ContainSubstring("Shoot garden-foo/canary is not healthy"))
+			},
+
+			Entry("nodes are not ready", func(shoot *gardencorev1beta1.Shoot) {
+				shoot.Status.Conditions = append(shoot.Status.Conditions, gardencorev1beta1.Condition{Type: gardencorev1beta1.ShootEveryNodeReady, Status: gardencorev1beta1.ConditionFalse})
+			}),
+			Entry("system components are not healthy", func(shoot *gardencorev1beta1.Shoot) {
+				shoot.Status.Conditions = append(shoot.Status.Conditions, gardencorev1beta1.Condition{Type: gardencorev1beta1.ShootSystemComponentsHealthy, Status: gardencorev1beta1.ConditionFalse})
+			}),
+			Entry("last operation failed", func(shoot *gardencorev1beta1.Shoot) {
+				shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeReconcile, State: gardencorev1beta1.LastOperationStateFailed}
+			}),
+		)
+
+		It("should ignore unhealthy shoots of later waves", func() {
+			Expect(fakeClient.Create(ctx, newShoot("other", "1.29.2", nil, gardencorev1beta1.ConditionFalse))).To(Succeed())
+			reconcileAndReadStatus()
+
+			fakeClock.Step(time.Hour)
+			Expect(reconcileAndReadStatus().Kubernetes["1.29.2"].Waves).To(HaveLen(2))
+		})
+	})
+
+	It("should complete the rollout of versions whose wave was removed from the configuration", func() {
+		createWithStatus(gardencorev1beta1.CloudProfileStatus{
+			KubernetesVersions: []gardencorev1beta1.VersionRolloutStatus{{Version: "1.29.1", Completed: true}, {Version: "1.29.2", Waves: []gardencorev1beta1.RolloutWaveStatus{{Name: "removed"}}}},
+		})
+
+		Expect(reconcileAndReadStatus().Kubernetes["1.29.2"]).To(Equal(&VersionStatus{Completed: true}))
+	})
+
+	It("should select shoots based on their project", func() {
+		createWithStatus(gardencorev1beta1.CloudProfileStatus{
+			KubernetesVersions: []gardencorev1beta1.VersionRolloutStatus{
+				{Version: "1.29.1", Completed: true},
+				{Version: "1.29.2", Waves: []gardencorev1beta1.RolloutWaveStatus{{Name: "canary"}, {Name: "early", HealthySince: metav1.Time{Time: now.Add(-3 * time.Hour)}}}},
+			},
+		})
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Labels: map[string]string{"early": "true"}},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-foo")},
+		})).To(Succeed())
+		Expect(fakeClient.Create(ctx, newShoot("early", "1.29.2", nil, gardencorev1beta1.ConditionFalse))).To(Succeed())
+
+		status := reconcileAndReadStatus()
+		Expect(status.Kubernetes["1.29.2"].Waves).To(HaveLen(2))
+		Expect(status.Kubernetes["1.29.2"].Message).To(ContainSubstring("garden-foo/early"))
+	})
+})
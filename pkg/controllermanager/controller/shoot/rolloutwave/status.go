@@ -0,0 +1,206 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rolloutwave
+
+import (
+	"fmt"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+)
+
+// Status is the state of the staged rollout of the versions of a CloudProfile. It is an index of the `status` of the
+// CloudProfile for looking up the rollout state of versions by their names.
+type Status struct {
+	// Kubernetes maps Kubernetes versions to their rollout state.
+	Kubernetes map[string]*VersionStatus
+	// MachineImages maps machine image names and versions to their rollout state.
+	MachineImages map[string]map[string]*VersionStatus
+}
+
+// VersionStatus is the rollout state of a single version.
+type VersionStatus struct {
+	// Waves are the waves the version was released to, in rollout order.
+	Waves []WaveStatus
+	// Completed is true if the version was released to all Shoots.
+	Completed bool
+	// Message describes why the rollout of the version is currently paused.
+	Message string
+}
+
+// WaveStatus is the rollout state of a version in a single wave.
+type WaveStatus struct {
+	// Name is the name of the wave.
+	Name string
+	// ReleasedAt is the time when the version was released to the wave.
+	ReleasedAt metav1.Time
+	// HealthySince is the time since when no Shoot of this or an earlier wave running the version was reported to be
+	// unhealthy.
+	HealthySince metav1.Time
+}
+
+// ReadStatus returns the rollout status stored in the given CloudProfile. It returns nil if the rollout was not
+// tracked yet.
+func ReadStatus(cloudProfile *gardencorev1beta1.CloudProfile) *Status {
+	if len(cloudProfile.Status.KubernetesVersions) == 0 && len(cloudProfile.Status.MachineImages) == 0 {
+		return nil
+	}
+
+	status := &Status{
+		Kubernetes:    make(map[string]*VersionStatus, len(cloudProfile.Status.KubernetesVersions)),
+		MachineImages: make(map[string]map[string]*VersionStatus, len(cloudProfile.Status.MachineImages)),
+	}
+
+	for _, version := range cloudProfile.Status.KubernetesVersions {
+		status.Kubernetes[version.Version] = fromVersionRolloutStatus(version)
+	}
+
+	for _, image := range cloudProfile.Status.MachineImages {
+		status.MachineImages[image.Name] = make(map[string]*VersionStatus, len(image.Versions))
+		for _, version := range image.Versions {
+			status.MachineImages[image.Name][version.Version] = fromVersionRolloutStatus(version)
+		}
+	}
+
+	return status
+}
+
+func fromVersionRolloutStatus(version gardencorev1beta1.VersionRolloutStatus) *VersionStatus {
+	versionStatus := &VersionStatus{
+		Completed: version.Completed,
+		Message:   ptr.Deref(version.Message, ""),
+	}
+
+	for _, wave := range version.Waves {
+		versionStatus.Waves = append(versionStatus.Waves, WaveStatus{Name: wave.Name, ReleasedAt: wave.ReleasedAt, HealthySince: wave.HealthySince})
+	}
+
+	return versionStatus
+}
+
+// CloudProfileStatus returns the status of the given CloudProfile which reflects the rollout status. The versions are
+// ordered like in the spec of the CloudProfile.
+func (s *Status) CloudProfileStatus(cloudProfile *gardencorev1beta1.CloudProfile) gardencorev1beta1.CloudProfileStatus {
+	status := gardencorev1beta1.CloudProfileStatus{}
+
+	for _, version := range cloudProfile.Spec.Kubernetes.Versions {
+		if versionStatus, ok := s.Kubernetes[version.Version]; ok {
+			status.KubernetesVersions = append(status.KubernetesVersions, versionStatus.toVersionRolloutStatus(version.Version))
+		}
+	}
+
+	for _, image := range cloudProfile.Spec.MachineImages {
+		versions, ok := s.MachineImages[image.Name]
+		if !ok {
+			continue
+		}
+
+		imageStatus := gardencorev1beta1.MachineImageRolloutStatus{Name: image.Name}
+		for _, version := range image.Versions {
+			if versionStatus, ok := versions[version.Version]; ok {
+				imageStatus.Versions = append(imageStatus.Versions, versionStatus.toVersionRolloutStatus(version.Version))
+			}
+		}
+		status.MachineImages = append(status.MachineImages, imageStatus)
+	}
+
+	return status
+}
+
+func (v *VersionStatus) toVersionRolloutStatus(version string) gardencorev1beta1.VersionRolloutStatus {
+	versionStatus := gardencorev1beta1.VersionRolloutStatus{
+		Version:   version,
+		Completed: v.Completed,
+	}
+
+	if len(v.Message) > 0 {
+		versionStatus.Message = ptr.To(v.Message)
+	}
+
+	for _, wave := range v.Waves {
+		versionStatus.Waves = append(versionStatus.Waves, gardencorev1beta1.RolloutWaveStatus{Name: wave.Name, ReleasedAt: wave.ReleasedAt, HealthySince: wave.HealthySince})
+	}
+
+	return versionStatus
+}
+
+// IsKubernetesVersionReleased returns true if the given Kubernetes version was released to the given wave.
+func (s *Status) IsKubernetesVersionReleased(version, wave string) bool {
+	if s == nil {
+		return true
+	}
+	return s.Kubernetes[version].isReleased(wave)
+}
+
+// IsMachineImageVersionReleased returns true if the given machine image version was released to the given wave.
+func (s *Status) IsMachineImageVersionReleased(name, version, wave string) bool {
+	if s == nil {
+		return true
+	}
+	return s.MachineImages[name][version].isReleased(wave)
+}
+
+func (v *VersionStatus) isReleased(wave string) bool {
+	if v == nil {
+		// The version was added to the CloudProfile after the last sync, hence it is not released to any wave yet.
+		return false
+	}
+
+	return v.Completed || slices.ContainsFunc(v.Waves, func(w WaveStatus) bool { return w.Name == wave })
+}
+
+// WaveForShoot returns the name of the wave the given Shoot belongs to, i.e., the first wave whose selectors match the
+// labels of the Shoot and its Project. If no wave matches, config.RemainingShootsRolloutWave is returned.
+func WaveForShoot(waves []config.MaintenanceRolloutWave, shoot *gardencorev1beta1.Shoot, project *gardencorev1beta1.Project) (string, error) {
+	for _, wave := range waves {
+		matches, err := waveMatches(wave, shoot, project)
+		if err != nil {
+			return "", err
+		}
+		if matches {
+			return wave.Name, nil
+		}
+	}
+
+	return config.RemainingShootsRolloutWave, nil
+}
+
+// NeedsProjects returns true if any of the given waves selects Shoots based on their Projects.
+func NeedsProjects(waves []config.MaintenanceRolloutWave) bool {
+	return slices.ContainsFunc(waves, func(wave config.MaintenanceRolloutWave) bool { return wave.ProjectSelector != nil })
+}
+
+func waveMatches(wave config.MaintenanceRolloutWave, shoot *gardencorev1beta1.Shoot, project *gardencorev1beta1.Project) (bool, error) {
+	if wave.ShootSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(wave.ShootSelector)
+		if err != nil {
+			return false, fmt.Errorf("invalid shoot selector of rollout wave %q: %w", wave.Name, err)
+		}
+		if !selector.Matches(labels.Set(shoot.Labels)) {
+			return false, nil
+		}
+	}
+
+	if wave.ProjectSelector != nil {
+		if project == nil {
+			return false, nil
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(wave.ProjectSelector)
+		if err != nil {
+			return false, fmt.Errorf("invalid project selector of rollout wave %q: %w", wave.Name, err)
+		}
+		if !selector.Matches(labels.Set(project.Labels)) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rolloutwave_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/rolloutwave"
+)
+
+var _ = Describe("Status", func() {
+	Describe("#ReadStatus", func() {
+		var cloudProfile *gardencorev1beta1.CloudProfile
+
+		BeforeEach(func() {
+			cloudProfile = &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "profile"}}
+		})
+
+		It("should return nil if the rollout was not tracked yet", func() {
+			Expect(ReadStatus(cloudProfile)).To(BeNil())
+		})
+
+		It("should index the status of the CloudProfile", func() {
+			cloudProfile.Status = gardencorev1beta1.CloudProfileStatus{
+				KubernetesVersions: []gardencorev1beta1.VersionRolloutStatus{{Version: "1.29.1", Completed: true}},
+				MachineImages: []gardencorev1beta1.MachineImageRolloutStatus{{
+					Name:     "foo",
+					Versions: []gardencorev1beta1.VersionRolloutStatus{{Version: "1.0.0", Waves: []gardencorev1beta1.RolloutWaveStatus{{Name: "canary"}}, Message: ptr.To("paused")}},
+				}},
+			}
+
+			Expect(ReadStatus(cloudProfile)).To(Equal(&Status{
+				Kubernetes:    map[string]*VersionStatus{"1.29.1": {Completed: true}},
+				MachineImages: map[string]map[string]*VersionStatus{"foo": {"1.0.0": {Waves: []WaveStatus{{Name: "canary"}}, Message: "paused"}}},
+			}))
+		})
+	})
+
+	Describe("#CloudProfileStatus", func() {
+		It("should return the status ordered like the versions in the spec", func() {
+			cloudProfile := &gardencorev1beta1.CloudProfile{
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					Kubernetes: gardencorev1beta1.KubernetesSettings{
+						Versions: []gardencorev1beta1.ExpirableVersion{{Version: "1.29.2"}, {Version: "1.29.1"}},
+					},
+					MachineImages: []gardencorev1beta1.MachineImage{{
+						Name:     "foo",
+						Versions: []gardencorev1beta1.MachineImageVersion{{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0"}}},
+					}},
+				},
+			}
+			status := &Status{
+				Kubernetes: map[string]*VersionStatus{
+					"1.29.1": {Completed: true},
+					"1.29.2": {Waves: []WaveStatus{{Name: "canary"}}, Message: "paused"},
+				},
+				MachineImages: map[string]map[string]*VersionStatus{"foo": {"1.0.0": {Completed: true}}},
+			}
+
+			Expect(status.CloudProfileStatus(cloudProfile)).To(Equal(gardencorev1beta1.CloudProfileStatus{
+				KubernetesVersions: []gardencorev1beta1.VersionRolloutStatus{
+					{Version: "1.29.2", Waves: []gardencorev1beta1.RolloutWaveStatus{{Name: "canary"}}, Message: ptr.To("paused")},
+					{Version: "1.29.1", Completed: true},
+				},
+				MachineImages: []gardencorev1beta1.MachineImageRolloutStatus{{
+					Name:     "foo",
+					Versions: []gardencorev1beta1.VersionRolloutStatus{{Version: "1.0.0", Completed: true}},
+				}},
+			}))
+		})
+	})
+
+	Describe("#IsKubernetesVersionReleased, #IsMachineImageVersionReleased", func() {
+		status := &Status{
+			Kubernetes: map[string]*VersionStatus{
+				"1.29.1": {Completed: true},
+				"1.29.2": {Waves: []WaveStatus{{Name: "canary"}}},
+			},
+			MachineImages: map[string]map[string]*VersionStatus{
+				"foo": {
+					"1.0.0": {Completed: true},
+					"1.0.1": {Waves: []WaveStatus{{Name: "canary"}, {Name: "early"}}},
+				},
+			},
+		}
+
+		It("should consider all versions released if the rollout is not tracked", func() {
+			var nilStatus *Status
+			Expect(nilStatus.IsKubernetesVersionReleased("1.29.3", "canary")).To(BeTrue())
+			Expect(nilStatus.IsMachineImageVersionReleased("foo", "1.0.2", "canary")).To(BeTrue())
+		})
+
+		It("should consider completed versions released to all waves", func() {
+			Expect(status.IsKubernetesVersionReleased("1.29.1", config.RemainingShootsRolloutWave)).To(BeTrue())
+			Expect(status.IsMachineImageVersionReleased("foo", "1.0.0", "early")).To(BeTrue())
+		})
+
+		It("should consider versions released only to the waves they were rolled out to", func() {
+			Expect(status.IsKubernetesVersionReleased("1.29.2", "canary")).To(BeTrue())
+			Expect(status.IsKubernetesVersionReleased("1.29.2", config.RemainingShootsRolloutWave)).To(BeFalse())
+			Expect(status.IsMachineImageVersionReleased("foo", "1.0.1", "early")).To(BeTrue())
+			Expect(status.IsMachineImageVersionReleased("foo", "1.0.1", config.RemainingShootsRolloutWave)).To(BeFalse())
+		})
+
+		It("should consider unknown versions not released", func() {
+			Expect(status.IsKubernetesVersionReleased("1.29.3", "canary")).To(BeFalse())
+			Expect(status.IsMachineImageVersionReleased("bar", "1.0.0", "canary")).To(BeFalse())
+		})
+	})
+
+	Describe("#WaveForShoot", func() {
+		var (
+			waves   []config.MaintenanceRolloutWave
+			shoot   *gardencorev1beta1.Shoot
+			project *gardencorev1beta1.Project
+		)
+
+		BeforeEach(func() {
+			waves = []config.MaintenanceRolloutWave{
+				{Name: "canary", ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}}},
+				{Name: "internal", ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"internal": "true"}}},
+			}
+			shoot = &gardencorev1beta1.Shoot{}
+			project = &gardencorev1beta1.Project{}
+		})
+
+		It("should return the first matching wave", func() {
+			shoot.Labels = map[string]string{"canary": "true"}
+			project.Labels = map[string]string{"internal": "true"}
+
+			Expect(WaveForShoot(waves, shoot, project)).To(Equal("canary"))
+		})
+
+		It("should match waves based on the project", func() {
+			project.Labels = map[string]string{"internal": "true"}
+
+			Expect(WaveForShoot(waves, shoot, project)).To(Equal("internal"))
+		})
+
+		It("should not match project selectors if there is no project", func() {
+			Expect(WaveForShoot(waves, shoot, nil)).To(Equal(config.RemainingShootsRolloutWave))
+		})
+
+		It("should return the remaining wave if no wave matches", func() {
+			Expect(WaveForShoot(waves, shoot, project)).To(Equal(config.RemainingShootsRolloutWave))
+		})
+
+		It("should fail for invalid selectors", func() {
+			waves[0].ShootSelector.MatchLabels = map[string]string{"-": "-"}
+
+			_, err := WaveForShoot(waves, shoot, project)
+			Expect(err).To(MatchError(ContainSubstring("invalid shoot selector")))
+		})
+	})
+
+	Describe("#NeedsProjects", func() {
+		It("should return whether any wave has a project selector", func() {
+			Expect(NeedsProjects([]config.MaintenanceRolloutWave{{ShootSelector: &metav1.LabelSelector{}}})).To(BeFalse())
+			Expect(NeedsProjects([]config.MaintenanceRolloutWave{{ShootSelector: &metav1.LabelSelector{}}, {ProjectSelector: &metav1.LabelSelector{}}})).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootblueprint_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shootblueprint"
+)
+
+var _ = Describe("Add", func() {
+	var (
+		reconciler *Reconciler
+		shoot      *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+			Spec:       gardencorev1beta1.ShootSpec{Blueprint: &gardencorev1beta1.ShootBlueprintReference{Name: "small"}},
+		}
+	})
+
+	Describe("#ShootPredicate", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.ShootPredicate()
+		})
+
+		It("should return true for create and delete events of shoots referencing a blueprint", func() {
+			Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+			Expect(p.Delete(event.DeleteEvent{Object: shoot})).To(BeTrue())
+		})
+
+		It("should return false for create and delete events of shoots not referencing a blueprint", func() {
+			shoot.Spec.Blueprint = nil
+			Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeFalse())
+			Expect(p.Delete(event.DeleteEvent{Object: shoot})).To(BeFalse())
+		})
+
+		It("should return false for update events if nothing relevant changed", func() {
+			newShoot := shoot.DeepCopy()
+			newShoot.Spec.Region = "eu-west-1"
+			Expect(p.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: newShoot})).To(BeFalse())
+		})
+
+		It("should return true if the blueprint reference changed", func() {
+			newShoot := shoot.DeepCopy()
+			newShoot.Spec.Blueprint.Name = "large"
+			Expect(p.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: newShoot})).To(BeTrue())
+		})
+
+		It("should return true if the applied blueprint generation changed", func() {
+			newShoot := shoot.DeepCopy()
+			newShoot.Annotations = map[string]string{"shoot.gardener.cloud/blueprint-generation": "2"}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: newShoot})).To(BeTrue())
+		})
+	})
+
+	Describe("#MapShootToShootBlueprint", func() {
+		It("should map the shoot to its blueprint", func() {
+			Expect(reconciler.MapShootToShootBlueprint(context.TODO(), logr.Discard(), nil, shoot)).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "garden-foo", Name: "small"}},
+			))
+		})
+
+		It("should return nothing if the shoot does not reference a blueprint", func() {
+			shoot.Spec.Blueprint = nil
+			Expect(reconciler.MapShootToShootBlueprint(context.TODO(), logr.Discard(), nil, shoot)).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootblueprint
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// Reconciler reconciles ShootBlueprints and maintains the list of Shoots which have not yet applied the latest
+// generation of the blueprint in the status.
+type Reconciler struct {
+	Client client.Client
+	Config config.ShootBlueprintControllerConfiguration
+}
+
+// Reconcile performs the main reconciliation logic.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	shootBlueprint := &gardencorev1beta1.ShootBlueprint{}
+	if err := r.Client.Get(ctx, request.NamespacedName, shootBlueprint); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList, client.InNamespace(shootBlueprint.Namespace)); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	var (
+		generation     = strconv.FormatInt(shootBlueprint.Generation, 10)
+		outdatedShoots []string
+	)
+
+	for _, shoot := range shootList.Items {
+		if shoot.Spec.Blueprint == nil || shoot.Spec.Blueprint.Name != shootBlueprint.Name || shoot.DeletionTimestamp != nil {
+			continue
+		}
+
+		if shoot.Annotations[v1beta1constants.AnnotationShootBlueprintGeneration] != generation {
+			outdatedShoots = append(outdatedShoots, shoot.Name)
+		}
+	}
+	slices.Sort(outdatedShoots)
+
+	if shootBlueprint.Status.ObservedGeneration == shootBlueprint.Generation && slices.Equal(shootBlueprint.Status.OutdatedShoots, outdatedShoots) {
+		return reconcile.Result{}, nil
+	}
+
+	log.Info("Updating status", "outdatedShoots", len(outdatedShoots))
+	patch := client.MergeFrom(shootBlueprint.DeepCopy())
+	shootBlueprint.Status.ObservedGeneration = shootBlueprint.Generation
+	shootBlueprint.Status.OutdatedShoots = outdatedShoots
+	if err := r.Client.Status().Patch(ctx, shootBlueprint, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating status: %w", err)
+	}
+
+	return reconcile.Result{}, nil
+}
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootblueprint_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shootblueprint"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		reconciler reconcile.Reconciler
+
+		shootBlueprint *gardencorev1beta1.ShootBlueprint
+		request        reconcile.Request
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.ShootBlueprint{}).
+			Build()
+		reconciler = &Reconciler{Client: fakeClient}
+
+		shootBlueprint = &gardencorev1beta1.ShootBlueprint{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "small",
+				Namespace:  "garden-dev",
+				Generation: 2,
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shootBlueprint)}
+	})
+
+	newShoot := func(name, blueprintName, generation string) *gardencorev1beta1.Shoot {
+		shoot := &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-dev"}}
+		if blueprintName != "" {
+			shoot.Spec.Blueprint = &gardencorev1beta1.ShootBlueprintReference{Name: blueprintName}
+		}
+		if generation != "" {
+			shoot.Annotations = map[string]string{"shoot.gardener.cloud/blueprint-generation": generation}
+		}
+		return shoot
+	}
+
+	It("should do nothing because the object is gone", func() {
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+	})
+
+	It("should list the shoots which have not applied the latest generation of the blueprint", func() {
+		Expect(fakeClient.Create(ctx, shootBlueprint)).To(Succeed())
+		for _, shoot := range []*gardencorev1beta1.Shoot{
+			newShoot("up-to-date", "small", "2"),
+			newShoot("outdated-2", "small", "1"),
+			newShoot("outdated-1", "small", ""),
+			newShoot("other-blueprint", "large", "1"),
+			newShoot("no-blueprint", "", ""),
+		} {
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		}
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shootBlueprint), shootBlueprint)).To(Succeed())
+		Expect(shootBlueprint.Status.ObservedGeneration).To(Equal(int64(2)))
+		Expect(shootBlueprint.Status.OutdatedShoots).To(Equal([]string{"outdated-1", "outdated-2"}))
+	})
+
+	It("should clear the list of outdated shoots once all shoots are up-to-date", func() {
+		shootBlueprint.Status.OutdatedShoots = []string{"foo"}
+		Expect(fakeClient.Create(ctx, shootBlueprint)).To(Succeed())
+		Expect(fakeClient.Create(ctx, newShoot("foo", "small", "2"))).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shootBlueprint), shootBlueprint)).To(Succeed())
+		Expect(shootBlueprint.Status.ObservedGeneration).To(Equal(int64(2)))
+		Expect(shootBlueprint.Status.OutdatedShoots).To(BeEmpty())
+	})
+})
@@ -66,6 +66,11 @@ const (
 	// owner: @dimityrmirchev
 	// alpha: v1.93.0
 	ShootManagedIssuer featuregate.Feature = "ShootManagedIssuer"
+
+	// ShootBlueprints enables the usage of the ShootBlueprint API object in Shoots.
+	// owner: @timuthy
+	// alpha: v1.94.0
+	ShootBlueprints featuregate.Feature = "ShootBlueprints"
 )
 
 // DefaultFeatureGate is the central feature gate map used by all gardener components.
@@ -102,6 +107,7 @@ var AllFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	ShootManagedIssuer:              {Default: false, PreRelease: featuregate.Alpha},
 	ShootForceDeletion:              {Default: true, PreRelease: featuregate.Beta},
 	UseNamespacedCloudProfile:       {Default: false, PreRelease: featuregate.Alpha},
+	ShootBlueprints:                 {Default: false, PreRelease: featuregate.Alpha},
 }
 
 // GetFeatures returns a feature gate map with the respective specifications. Non-existing feature gates are ignored.
@@ -63,6 +63,8 @@ type GardenletConfiguration struct {
 	Monitoring *MonitoringConfig
 	// NodeToleration contains optional settings for default tolerations.
 	NodeToleration *NodeToleration
+	// SecretsManager contains optional settings for the management of the secrets of shoot control planes.
+	SecretsManager *SecretsManagerConfiguration
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -142,6 +144,8 @@ type GardenletControllerConfiguration struct {
 	ShootCare *ShootCareControllerConfiguration
 	// ShootState defines the configuration of the ShootState controller.
 	ShootState *ShootStateControllerConfiguration
+	// ShootBackupVerification defines the configuration of the ShootBackupVerification controller.
+	ShootBackupVerification *ShootBackupVerificationControllerConfiguration
 	// NetworkPolicy defines the configuration of the NetworkPolicy controller.
 	NetworkPolicy *NetworkPolicyControllerConfiguration
 	// ManagedSeed defines the configuration of the ManagedSeed controller.
@@ -266,6 +270,11 @@ type ShootCareControllerConfiguration struct {
 	// practices (https://kubernetes.io/docs/reference/access-authn-authz/extensible-admission-controllers/#best-practices-and-warnings)
 	// is enabled.
 	WebhookRemediatorEnabled *bool
+	// UserActivityFlowSchemas are the names of the API Priority and Fairness flow schemas of the shoots' kube-apiservers
+	// whose requests are considered user activity (e.g., `global-default` for requests of users which are not matched by
+	// more specific flow schemas). If set, the time of the last user activity is recorded in the
+	// `shoot.gardener.cloud/last-user-activity` annotation of the shoots.
+	UserActivityFlowSchemas []string
 }
 
 // SeedCareControllerConfiguration defines the configuration of the SeedCare
@@ -287,6 +296,16 @@ type ShootStateControllerConfiguration struct {
 	SyncPeriod *metav1.Duration
 }
 
+// ShootBackupVerificationControllerConfiguration defines the configuration of the ShootBackupVerification
+// controller.
+type ShootBackupVerificationControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often the backups of the Shoot clusters are verified by restoring the latest
+	// snapshot into a temporary etcd.
+	SyncPeriod *metav1.Duration
+}
+
 // StaleExtensionHealthChecks defines the configuration of the check for stale extension health checks.
 type StaleExtensionHealthChecks struct {
 	// Enabled specifies whether the check for stale extensions health checks is enabled.
@@ -551,3 +570,18 @@ type NodeToleration struct {
 	// should be added to pods not already tolerating this taint.
 	DefaultUnreachableTolerationSeconds *int64
 }
+
+// SecretsManagerConfiguration contains settings for the management of the secrets of shoot control planes.
+type SecretsManagerConfiguration struct {
+	// CAPrivateKeyStore configures a storage backend for the private keys of the certificate authorities of shoots. If
+	// it is set, the private keys of newly generated CAs are not stored in the secrets in the seed cluster.
+	CAPrivateKeyStore *CAPrivateKeyStore
+}
+
+// CAPrivateKeyStore contains settings for the storage backend of the private keys of certificate authorities.
+type CAPrivateKeyStore struct {
+	// Directory is the directory in which the private keys are stored as files, e.g., a volume backed by an external
+	// secret store. It must be shared by all gardenlets which might take over the control planes of the shoots (e.g.,
+	// during control plane migration).
+	Directory string
+}
@@ -45,6 +45,7 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.ShootCare).NotTo(BeNil())
 			Expect(obj.Controllers.SeedCare).NotTo(BeNil())
 			Expect(obj.Controllers.ShootState).NotTo(BeNil())
+			Expect(obj.Controllers.ShootBackupVerification).NotTo(BeNil())
 			Expect(obj.Controllers.ManagedSeed).NotTo(BeNil())
 			Expect(obj.LeaderElection).NotTo(BeNil())
 			Expect(obj.LogLevel).To(Equal(logger.InfoLevel))
@@ -442,6 +443,30 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShootBackupVerificationControllerConfiguration defaulting", func() {
+		It("should default the shoot backup verification controller configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootBackupVerification.ConcurrentSyncs).To(PointTo(Equal(1)))
+			Expect(obj.Controllers.ShootBackupVerification.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 168 * time.Hour})))
+		})
+
+		It("should not overwrite already set values for the shoot backup verification controller configuration", func() {
+			syncPeriod := metav1.Duration{Duration: 24 * time.Hour}
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootBackupVerification: &ShootBackupVerificationControllerConfiguration{
+					SyncPeriod:      &syncPeriod,
+					ConcurrentSyncs: ptr.To(0),
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootBackupVerification.ConcurrentSyncs).To(PointTo(Equal(0)))
+			Expect(obj.Controllers.ShootBackupVerification.SyncPeriod).To(PointTo(Equal(syncPeriod)))
+		})
+	})
+
 	Describe("NetworkPolicyControllerConfiguration defaulting", func() {
 		It("should default the network policy controller configuration", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -80,6 +80,9 @@ type GardenletConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeToleration `json:"nodeToleration,omitempty"`
+	// SecretsManager contains optional settings for the management of the secrets of shoot control planes.
+	// +optional
+	SecretsManager *SecretsManagerConfiguration `json:"secretsManager,omitempty"`
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -178,6 +181,9 @@ type GardenletControllerConfiguration struct {
 	// ShootState defines the configuration of the ShootState controller.
 	// +optional
 	ShootState *ShootStateControllerConfiguration `json:"shootState,omitempty"`
+	// ShootBackupVerification defines the configuration of the ShootBackupVerification controller.
+	// +optional
+	ShootBackupVerification *ShootBackupVerificationControllerConfiguration `json:"shootBackupVerification,omitempty"`
 	// NetworkPolicy defines the configuration of the NetworkPolicy controller
 	// +optional
 	NetworkPolicy *NetworkPolicyControllerConfiguration `json:"networkPolicy,omitempty"`
@@ -330,6 +336,12 @@ type ShootCareControllerConfiguration struct {
 	// is enabled.
 	// +optional
 	WebhookRemediatorEnabled *bool `json:"webhookRemediatorEnabled,omitempty"`
+	// UserActivityFlowSchemas are the names of the API Priority and Fairness flow schemas of the shoots' kube-apiservers
+	// whose requests are considered user activity (e.g., `global-default` for requests of users which are not matched by
+	// more specific flow schemas). If set, the time of the last user activity is recorded in the
+	// `shoot.gardener.cloud/last-user-activity` annotation of the shoots.
+	// +optional
+	UserActivityFlowSchemas []string `json:"userActivityFlowSchemas,omitempty"`
 }
 
 // SeedCareControllerConfiguration defines the configuration of the SeedCare
@@ -355,6 +367,18 @@ type ShootStateControllerConfiguration struct {
 	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
 }
 
+// ShootBackupVerificationControllerConfiguration defines the configuration of the ShootBackupVerification
+// controller.
+type ShootBackupVerificationControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the backups of the Shoot clusters are verified by restoring the latest
+	// snapshot into a temporary etcd.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+}
+
 // StaleExtensionHealthChecks defines the configuration of the check for stale extension health checks.
 type StaleExtensionHealthChecks struct {
 	// Enabled specifies whether the check for stale extensions health checks is enabled.
@@ -714,3 +738,19 @@ type NodeToleration struct {
 	// +optional
 	DefaultUnreachableTolerationSeconds *int64 `json:"defaultUnreachableTolerationSeconds,omitempty"`
 }
+
+// SecretsManagerConfiguration contains settings for the management of the secrets of shoot control planes.
+type SecretsManagerConfiguration struct {
+	// CAPrivateKeyStore configures a storage backend for the private keys of the certificate authorities of shoots. If
+	// it is set, the private keys of newly generated CAs are not stored in the secrets in the seed cluster.
+	// +optional
+	CAPrivateKeyStore *CAPrivateKeyStore `json:"caPrivateKeyStore,omitempty"`
+}
+
+// CAPrivateKeyStore contains settings for the storage backend of the private keys of certificate authorities.
+type CAPrivateKeyStore struct {
+	// Directory is the directory in which the private keys are stored as files, e.g., a volume backed by an external
+	// secret store. It must be shared by all gardenlets which might take over the control planes of the shoots (e.g.,
+	// during control plane migration).
+	Directory string `json:"directory"`
+}
@@ -71,6 +71,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CAPrivateKeyStore)(nil), (*config.CAPrivateKeyStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CAPrivateKeyStore_To_config_CAPrivateKeyStore(a.(*CAPrivateKeyStore), b.(*config.CAPrivateKeyStore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.CAPrivateKeyStore)(nil), (*CAPrivateKeyStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CAPrivateKeyStore_To_v1alpha1_CAPrivateKeyStore(a.(*config.CAPrivateKeyStore), b.(*CAPrivateKeyStore), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ConditionThreshold)(nil), (*config.ConditionThreshold)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ConditionThreshold_To_config_ConditionThreshold(a.(*ConditionThreshold), b.(*config.ConditionThreshold), scope)
 	}); err != nil {
@@ -311,6 +321,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SecretsManagerConfiguration)(nil), (*config.SecretsManagerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecretsManagerConfiguration_To_config_SecretsManagerConfiguration(a.(*SecretsManagerConfiguration), b.(*config.SecretsManagerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SecretsManagerConfiguration)(nil), (*SecretsManagerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SecretsManagerConfiguration_To_v1alpha1_SecretsManagerConfiguration(a.(*config.SecretsManagerConfiguration), b.(*SecretsManagerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedCareControllerConfiguration)(nil), (*config.SeedCareControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SeedCareControllerConfiguration_To_config_SeedCareControllerConfiguration(a.(*SeedCareControllerConfiguration), b.(*config.SeedCareControllerConfiguration), scope)
 	}); err != nil {
@@ -371,6 +391,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootBackupVerificationControllerConfiguration)(nil), (*config.ShootBackupVerificationControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootBackupVerificationControllerConfiguration_To_config_ShootBackupVerificationControllerConfiguration(a.(*ShootBackupVerificationControllerConfiguration), b.(*config.ShootBackupVerificationControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootBackupVerificationControllerConfiguration)(nil), (*ShootBackupVerificationControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootBackupVerificationControllerConfiguration_To_v1alpha1_ShootBackupVerificationControllerConfiguration(a.(*config.ShootBackupVerificationControllerConfiguration), b.(*ShootBackupVerificationControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootCareControllerConfiguration)(nil), (*config.ShootCareControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootCareControllerConfiguration_To_config_ShootCareControllerConfiguration(a.(*ShootCareControllerConfiguration), b.(*config.ShootCareControllerConfiguration), scope)
 	}); err != nil {
@@ -576,6 +606,26 @@ func Convert_config_BastionControllerConfiguration_To_v1alpha1_BastionController
 	return autoConvert_config_BastionControllerConfiguration_To_v1alpha1_BastionControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_CAPrivateKeyStore_To_config_CAPrivateKeyStore(in *CAPrivateKeyStore, out *config.CAPrivateKeyStore, s conversion.Scope) error {
+	out.Directory = in.Directory
+	return nil
+}
+
+// Convert_v1alpha1_CAPrivateKeyStore_To_config_CAPrivateKeyStore is an autogenerated conversion function.
+func Convert_v1alpha1_CAPrivateKeyStore_To_config_CAPrivateKeyStore(in *CAPrivateKeyStore, out *config.CAPrivateKeyStore, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CAPrivateKeyStore_To_config_CAPrivateKeyStore(in, out, s)
+}
+
+func autoConvert_config_CAPrivateKeyStore_To_v1alpha1_CAPrivateKeyStore(in *config.CAPrivateKeyStore, out *CAPrivateKeyStore, s conversion.Scope) error {
+	out.Directory = in.Directory
+	return nil
+}
+
+// Convert_config_CAPrivateKeyStore_To_v1alpha1_CAPrivateKeyStore is an autogenerated conversion function.
+func Convert_config_CAPrivateKeyStore_To_v1alpha1_CAPrivateKeyStore(in *config.CAPrivateKeyStore, out *CAPrivateKeyStore, s conversion.Scope) error {
+	return autoConvert_config_CAPrivateKeyStore_To_v1alpha1_CAPrivateKeyStore(in, out, s)
+}
+
 func autoConvert_v1alpha1_ConditionThreshold_To_config_ConditionThreshold(in *ConditionThreshold, out *config.ConditionThreshold, s conversion.Scope) error {
 	out.Type = in.Type
 	out.Duration = in.Duration
@@ -903,6 +953,7 @@ func autoConvert_v1alpha1_GardenletConfiguration_To_config_GardenletConfiguratio
 	out.ExposureClassHandlers = *(*[]config.ExposureClassHandler)(unsafe.Pointer(&in.ExposureClassHandlers))
 	out.Monitoring = (*config.MonitoringConfig)(unsafe.Pointer(in.Monitoring))
 	out.NodeToleration = (*config.NodeToleration)(unsafe.Pointer(in.NodeToleration))
+	out.SecretsManager = (*config.SecretsManagerConfiguration)(unsafe.Pointer(in.SecretsManager))
 	return nil
 }
 
@@ -980,6 +1031,7 @@ func autoConvert_config_GardenletConfiguration_To_v1alpha1_GardenletConfiguratio
 	out.ExposureClassHandlers = *(*[]ExposureClassHandler)(unsafe.Pointer(&in.ExposureClassHandlers))
 	out.Monitoring = (*MonitoringConfig)(unsafe.Pointer(in.Monitoring))
 	out.NodeToleration = (*NodeToleration)(unsafe.Pointer(in.NodeToleration))
+	out.SecretsManager = (*SecretsManagerConfiguration)(unsafe.Pointer(in.SecretsManager))
 	return nil
 }
 
@@ -1000,6 +1052,7 @@ func autoConvert_v1alpha1_GardenletControllerConfiguration_To_config_GardenletCo
 	out.Shoot = (*config.ShootControllerConfiguration)(unsafe.Pointer(in.Shoot))
 	out.ShootCare = (*config.ShootCareControllerConfiguration)(unsafe.Pointer(in.ShootCare))
 	out.ShootState = (*config.ShootStateControllerConfiguration)(unsafe.Pointer(in.ShootState))
+	out.ShootBackupVerification = (*config.ShootBackupVerificationControllerConfiguration)(unsafe.Pointer(in.ShootBackupVerification))
 	out.NetworkPolicy = (*config.NetworkPolicyControllerConfiguration)(unsafe.Pointer(in.NetworkPolicy))
 	out.ManagedSeed = (*config.ManagedSeedControllerConfiguration)(unsafe.Pointer(in.ManagedSeed))
 	out.TokenRequestor = (*config.TokenRequestorControllerConfiguration)(unsafe.Pointer(in.TokenRequestor))
@@ -1023,6 +1076,7 @@ func autoConvert_config_GardenletControllerConfiguration_To_v1alpha1_GardenletCo
 	out.Shoot = (*ShootControllerConfiguration)(unsafe.Pointer(in.Shoot))
 	out.ShootCare = (*ShootCareControllerConfiguration)(unsafe.Pointer(in.ShootCare))
 	out.ShootState = (*ShootStateControllerConfiguration)(unsafe.Pointer(in.ShootState))
+	out.ShootBackupVerification = (*ShootBackupVerificationControllerConfiguration)(unsafe.Pointer(in.ShootBackupVerification))
 	out.NetworkPolicy = (*NetworkPolicyControllerConfiguration)(unsafe.Pointer(in.NetworkPolicy))
 	out.ManagedSeed = (*ManagedSeedControllerConfiguration)(unsafe.Pointer(in.ManagedSeed))
 	out.TokenRequestor = (*TokenRequestorControllerConfiguration)(unsafe.Pointer(in.TokenRequestor))
@@ -1288,6 +1342,26 @@ func Convert_config_SNIIngress_To_v1alpha1_SNIIngress(in *config.SNIIngress, out
 	return autoConvert_config_SNIIngress_To_v1alpha1_SNIIngress(in, out, s)
 }
 
+func autoConvert_v1alpha1_SecretsManagerConfiguration_To_config_SecretsManagerConfiguration(in *SecretsManagerConfiguration, out *config.SecretsManagerConfiguration, s conversion.Scope) error {
+	out.CAPrivateKeyStore = (*config.CAPrivateKeyStore)(unsafe.Pointer(in.CAPrivateKeyStore))
+	return nil
+}
+
+// Convert_v1alpha1_SecretsManagerConfiguration_To_config_SecretsManagerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SecretsManagerConfiguration_To_config_SecretsManagerConfiguration(in *SecretsManagerConfiguration, out *config.SecretsManagerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecretsManagerConfiguration_To_config_SecretsManagerConfiguration(in, out, s)
+}
+
+func autoConvert_config_SecretsManagerConfiguration_To_v1alpha1_SecretsManagerConfiguration(in *config.SecretsManagerConfiguration, out *SecretsManagerConfiguration, s conversion.Scope) error {
+	out.CAPrivateKeyStore = (*CAPrivateKeyStore)(unsafe.Pointer(in.CAPrivateKeyStore))
+	return nil
+}
+
+// Convert_config_SecretsManagerConfiguration_To_v1alpha1_SecretsManagerConfiguration is an autogenerated conversion function.
+func Convert_config_SecretsManagerConfiguration_To_v1alpha1_SecretsManagerConfiguration(in *config.SecretsManagerConfiguration, out *SecretsManagerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SecretsManagerConfiguration_To_v1alpha1_SecretsManagerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_SeedCareControllerConfiguration_To_config_SeedCareControllerConfiguration(in *SeedCareControllerConfiguration, out *config.SeedCareControllerConfiguration, s conversion.Scope) error {
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.ConditionThresholds = *(*[]config.ConditionThreshold)(unsafe.Pointer(&in.ConditionThresholds))
@@ -1426,6 +1500,28 @@ func Convert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in *conf
 	return autoConvert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootBackupVerificationControllerConfiguration_To_config_ShootBackupVerificationControllerConfiguration(in *ShootBackupVerificationControllerConfiguration, out *config.ShootBackupVerificationControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_ShootBackupVerificationControllerConfiguration_To_config_ShootBackupVerificationControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootBackupVerificationControllerConfiguration_To_config_ShootBackupVerificationControllerConfiguration(in *ShootBackupVerificationControllerConfiguration, out *config.ShootBackupVerificationControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootBackupVerificationControllerConfiguration_To_config_ShootBackupVerificationControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShootBackupVerificationControllerConfiguration_To_v1alpha1_ShootBackupVerificationControllerConfiguration(in *config.ShootBackupVerificationControllerConfiguration, out *ShootBackupVerificationControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_config_ShootBackupVerificationControllerConfiguration_To_v1alpha1_ShootBackupVerificationControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ShootBackupVerificationControllerConfiguration_To_v1alpha1_ShootBackupVerificationControllerConfiguration(in *config.ShootBackupVerificationControllerConfiguration, out *ShootBackupVerificationControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShootBackupVerificationControllerConfiguration_To_v1alpha1_ShootBackupVerificationControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootCareControllerConfiguration_To_config_ShootCareControllerConfiguration(in *ShootCareControllerConfiguration, out *config.ShootCareControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
@@ -1433,6 +1529,7 @@ func autoConvert_v1alpha1_ShootCareControllerConfiguration_To_config_ShootCareCo
 	out.ManagedResourceProgressingThreshold = (*v1.Duration)(unsafe.Pointer(in.ManagedResourceProgressingThreshold))
 	out.ConditionThresholds = *(*[]config.ConditionThreshold)(unsafe.Pointer(&in.ConditionThresholds))
 	out.WebhookRemediatorEnabled = (*bool)(unsafe.Pointer(in.WebhookRemediatorEnabled))
+	out.UserActivityFlowSchemas = *(*[]string)(unsafe.Pointer(&in.UserActivityFlowSchemas))
 	return nil
 }
 
@@ -1448,6 +1545,7 @@ func autoConvert_config_ShootCareControllerConfiguration_To_v1alpha1_ShootCareCo
 	out.ManagedResourceProgressingThreshold = (*v1.Duration)(unsafe.Pointer(in.ManagedResourceProgressingThreshold))
 	out.ConditionThresholds = *(*[]ConditionThreshold)(unsafe.Pointer(&in.ConditionThresholds))
 	out.WebhookRemediatorEnabled = (*bool)(unsafe.Pointer(in.WebhookRemediatorEnabled))
+	out.UserActivityFlowSchemas = *(*[]string)(unsafe.Pointer(&in.UserActivityFlowSchemas))
 	return nil
 }
 
@@ -131,6 +131,22 @@ func (in *BastionControllerConfiguration) DeepCopy() *BastionControllerConfigura
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CAPrivateKeyStore) DeepCopyInto(out *CAPrivateKeyStore) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CAPrivateKeyStore.
+func (in *CAPrivateKeyStore) DeepCopy() *CAPrivateKeyStore {
+	if in == nil {
+		return nil
+	}
+	out := new(CAPrivateKeyStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConditionThreshold) DeepCopyInto(out *ConditionThreshold) {
 	*out = *in
@@ -501,6 +517,11 @@ func (in *GardenletConfiguration) DeepCopyInto(out *GardenletConfiguration) {
 		*out = new(NodeToleration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SecretsManager != nil {
+		in, out := &in.SecretsManager, &out.SecretsManager
+		*out = new(SecretsManagerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -580,6 +601,11 @@ func (in *GardenletControllerConfiguration) DeepCopyInto(out *GardenletControlle
 		*out = new(ShootStateControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootBackupVerification != nil {
+		in, out := &in.ShootBackupVerification, &out.ShootBackupVerification
+		*out = new(ShootBackupVerificationControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NetworkPolicy != nil {
 		in, out := &in.NetworkPolicy, &out.NetworkPolicy
 		*out = new(NetworkPolicyControllerConfiguration)
@@ -929,6 +955,27 @@ func (in *SNIIngress) DeepCopy() *SNIIngress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretsManagerConfiguration) DeepCopyInto(out *SecretsManagerConfiguration) {
+	*out = *in
+	if in.CAPrivateKeyStore != nil {
+		in, out := &in.CAPrivateKeyStore, &out.CAPrivateKeyStore
+		*out = new(CAPrivateKeyStore)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsManagerConfiguration.
+func (in *SecretsManagerConfiguration) DeepCopy() *SecretsManagerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretsManagerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedCareControllerConfiguration) DeepCopyInto(out *SeedCareControllerConfiguration) {
 	*out = *in
@@ -1062,6 +1109,32 @@ func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootBackupVerificationControllerConfiguration) DeepCopyInto(out *ShootBackupVerificationControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootBackupVerificationControllerConfiguration.
+func (in *ShootBackupVerificationControllerConfiguration) DeepCopy() *ShootBackupVerificationControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootBackupVerificationControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootCareControllerConfiguration) DeepCopyInto(out *ShootCareControllerConfiguration) {
 	*out = *in
@@ -1095,6 +1168,11 @@ func (in *ShootCareControllerConfiguration) DeepCopyInto(out *ShootCareControlle
 		*out = new(bool)
 		**out = **in
 	}
+	if in.UserActivityFlowSchemas != nil {
+		in, out := &in.UserActivityFlowSchemas, &out.UserActivityFlowSchemas
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
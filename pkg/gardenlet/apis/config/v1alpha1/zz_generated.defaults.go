@@ -74,6 +74,9 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 		if in.Controllers.ShootState != nil {
 			SetDefaults_ShootStateControllerConfiguration(in.Controllers.ShootState)
 		}
+		if in.Controllers.ShootBackupVerification != nil {
+			SetDefaults_ShootBackupVerificationControllerConfiguration(in.Controllers.ShootBackupVerification)
+		}
 		if in.Controllers.NetworkPolicy != nil {
 			SetDefaults_NetworkPolicyControllerConfiguration(in.Controllers.NetworkPolicy)
 		}
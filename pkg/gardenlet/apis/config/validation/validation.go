@@ -130,6 +130,10 @@ func ValidateGardenletConfiguration(cfg *config.GardenletConfiguration, fldPath
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(ptr.Deref(nodeTolerationCfg.DefaultUnreachableTolerationSeconds, 0), nodeTolerationConfigPath.Child("defaultUnreachableTolerationSeconds"))...)
 	}
 
+	if cfg.SecretsManager != nil && cfg.SecretsManager.CAPrivateKeyStore != nil && len(cfg.SecretsManager.CAPrivateKeyStore.Directory) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("secretsManager", "caPrivateKeyStore", "directory"), "must provide a directory for the CA private key store"))
+	}
+
 	return allErrs
 }
 
@@ -632,6 +632,29 @@ var _ = Describe("GardenletConfiguration", func() {
 				)
 			})
 		})
+
+		Context("secretsManager", func() {
+			It("should pass with a valid CA private key store", func() {
+				cfg.SecretsManager = &config.SecretsManagerConfiguration{
+					CAPrivateKeyStore: &config.CAPrivateKeyStore{Directory: "/var/run/secrets/ca-private-keys"},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail if the directory of the CA private key store is empty", func() {
+				cfg.SecretsManager = &config.SecretsManagerConfiguration{
+					CAPrivateKeyStore: &config.CAPrivateKeyStore{},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("secretsManager.caPrivateKeyStore.directory"),
+					})),
+				))
+			})
+		})
 	})
 
 	Describe("#ValidateGardenletConfigurationUpdate", func() {
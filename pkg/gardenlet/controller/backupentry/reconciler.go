@@ -19,6 +19,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -293,7 +294,16 @@ func (r *Reconciler) deleteBackupEntry(
 		return reconcile.Result{}, nil
 	}
 
-	gracePeriod := computeGracePeriod(*r.Config.DeletionGracePeriodHours, r.Config.DeletionGracePeriodShootPurposes, gardencore.ShootPurpose(backupEntry.Annotations[v1beta1constants.ShootPurpose]))
+	if retentionPolicy := backupEntry.Spec.RetentionPolicy; retentionPolicy != nil && ptr.Deref(retentionPolicy.LegalHold, false) {
+		log.Info("Deletion of BackupEntry is blocked by legal hold")
+		if updateErr := r.updateBackupEntryStatusPending(gardenCtx, backupEntry, "Deletion of backup entry is blocked by legal hold"); updateErr != nil {
+			return reconcile.Result{}, fmt.Errorf("could not update status after deletion was blocked: %w", updateErr)
+		}
+		// the backupEntry will be requeued when the legal hold is lifted since this changes its generation
+		return reconcile.Result{}, nil
+	}
+
+	gracePeriod := r.deletionGracePeriod(backupEntry)
 	present, _ := strconv.ParseBool(backupEntry.ObjectMeta.Annotations[gardencorev1beta1.BackupEntryForceDeletion])
 	if present || r.Clock.Since(backupEntry.DeletionTimestamp.Local()) > gracePeriod {
 		operationType := v1beta1helper.ComputeOperationType(backupEntry.ObjectMeta, backupEntry.Status.LastOperation)
@@ -699,6 +709,15 @@ func isRestorePhase(backupEntry *gardencorev1beta1.BackupEntry) bool {
 	return backupEntry.Status.LastOperation != nil && backupEntry.Status.LastOperation.Type == gardencorev1beta1.LastOperationTypeRestore
 }
 
+func (r *Reconciler) deletionGracePeriod(backupEntry *gardencorev1beta1.BackupEntry) time.Duration {
+	// A retention configured for the BackupEntry takes precedence over the grace period configured for gardenlet.
+	if retentionPolicy := backupEntry.Spec.RetentionPolicy; retentionPolicy != nil && retentionPolicy.RetentionAfterDeletion != nil {
+		return retentionPolicy.RetentionAfterDeletion.Duration
+	}
+
+	return computeGracePeriod(*r.Config.DeletionGracePeriodHours, r.Config.DeletionGracePeriodShootPurposes, gardencore.ShootPurpose(backupEntry.Annotations[v1beta1constants.ShootPurpose]))
+}
+
 func computeGracePeriod(deletionGracePeriodHours int, deletionGracePeriodShootPurposes []gardencore.ShootPurpose, shootPurpose gardencore.ShootPurpose) time.Duration {
 	// If no dedicated list of purposes is provided then the grace period applies for all purposes. If the shoot purpose
 	// is empty then it was not yet updated with the purpose annotation or the corresponding `Shoot` is already deleted
@@ -264,4 +264,50 @@ var _ = Describe("Controller", func() {
 		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionBackupEntry), extensionBackupEntry)).To(Succeed())
 		Expect(extensionBackupEntry.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
 	})
+
+	Context("deletion", func() {
+		BeforeEach(func() {
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(backupEntry), backupEntry)).To(Succeed())
+			backupEntry.Finalizers = []string{gardencorev1beta1.GardenerName}
+			Expect(gardenClient.Update(ctx, backupEntry)).To(Succeed())
+		})
+
+		It("should not delete the BackupEntry before the configured deletion grace period has passed", func() {
+			Expect(gardenClient.Delete(ctx, backupEntry)).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically("~", time.Duration(deletionGracePeriodHours)*time.Hour, time.Minute))
+		})
+
+		It("should use the retention period of the BackupEntry instead of the configured deletion grace period", func() {
+			backupEntry.Spec.RetentionPolicy = &gardencorev1beta1.BackupRetentionPolicy{RetentionAfterDeletion: &metav1.Duration{Duration: 720 * time.Hour}}
+			Expect(gardenClient.Update(ctx, backupEntry)).To(Succeed())
+			Expect(gardenClient.Delete(ctx, backupEntry)).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically("~", 720*time.Hour, time.Minute))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(backupEntry), backupEntry)).To(Succeed())
+			Expect(backupEntry.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStatePending))
+		})
+
+		It("should not delete the BackupEntry while it is under legal hold even if its deletion is forced", func() {
+			metav1.SetMetaDataAnnotation(&backupEntry.ObjectMeta, gardencorev1beta1.BackupEntryForceDeletion, "true")
+			backupEntry.Spec.RetentionPolicy = &gardencorev1beta1.BackupRetentionPolicy{LegalHold: ptr.To(true)}
+			Expect(gardenClient.Update(ctx, backupEntry)).To(Succeed())
+			Expect(gardenClient.Delete(ctx, backupEntry)).To(Succeed())
+			fakeClock.Step(time.Duration(deletionGracePeriodHours)*time.Hour + time.Minute)
+
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(backupEntry), backupEntry)).To(Succeed())
+			Expect(backupEntry.Finalizers).To(ConsistOf(gardencorev1beta1.GardenerName))
+			Expect(backupEntry.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStatePending))
+			Expect(backupEntry.Status.LastOperation.Description).To(Equal("Deletion of backup entry is blocked by legal hold"))
+		})
+	})
 })
@@ -208,7 +208,7 @@ func getGardenletClusterRole(labels map[string]string) *rbacv1.ClusterRole {
 			{
 				APIGroups:     []string{""},
 				Resources:     []string{"persistentvolumeclaims"},
-				ResourceNames: []string{"alertmanager-db-alertmanager-0", "vali-vali-0", "prometheus-db-prometheus-0", "prometheus-db-seed-prometheus-0", "prometheus-db-aggregate-prometheus-0"},
+				ResourceNames: []string{"alertmanager-db-alertmanager-0", "vali-vali-0", "prometheus-db-prometheus-0", "prometheus-db-seed-prometheus-0", "prometheus-db-aggregate-prometheus-0", "backup-verification-etcd-etcd-backup-verification-0"},
 				Verbs:         []string{"delete"},
 			},
 			{
@@ -780,6 +780,10 @@ func ComputeExpectedGardenletConfiguration(
 				ConcurrentSyncs: &five,
 				SyncPeriod:      &metav1.Duration{Duration: 6 * time.Hour},
 			},
+			ShootBackupVerification: &gardenletv1alpha1.ShootBackupVerificationControllerConfiguration{
+				ConcurrentSyncs: ptr.To(1),
+				SyncPeriod:      &metav1.Duration{Duration: 168 * time.Hour},
+			},
 			TokenRequestor: &gardenletv1alpha1.TokenRequestorControllerConfiguration{
 				ConcurrentSyncs: &five,
 			},
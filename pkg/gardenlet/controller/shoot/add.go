@@ -20,6 +20,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/backupverification"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/state"
@@ -82,5 +83,14 @@ func AddToManager(
 		}
 	}
 
+	if cfg.Controllers.ShootBackupVerification != nil && ptr.Deref(cfg.Controllers.ShootBackupVerification.ConcurrentSyncs, 0) > 0 {
+		if err := (&backupverification.Reconciler{
+			Config:   *cfg.Controllers.ShootBackupVerification,
+			SeedName: cfg.SeedConfig.Name,
+		}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
+			return fmt.Errorf("failed adding backup verification reconciler: %w", err)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupverification_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/backupverification"
+)
+
+var _ = Describe("Add", func() {
+	var (
+		reconciler *Reconciler
+		shoot      *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{SeedName: "seed"}
+		shoot = &gardencorev1beta1.Shoot{}
+	})
+
+	Describe("#ShootPredicate", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.ShootPredicate()
+		})
+
+		Describe("#Create", func() {
+			It("should return true", func() {
+				Expect(p.Create(event.CreateEvent{})).To(BeTrue())
+			})
+		})
+
+		Describe("#Update", func() {
+			It("should return false because new object is no shoot", func() {
+				Expect(p.Update(event.UpdateEvent{})).To(BeFalse())
+			})
+
+			It("should return false because old object is no shoot", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot})).To(BeFalse())
+			})
+
+			It("should return false because nothing relevant changed", func() {
+				newShoot := shoot.DeepCopy()
+				newShoot.Labels = map[string]string{"foo": "bar"}
+
+				Expect(p.Update(event.UpdateEvent{ObjectNew: newShoot, ObjectOld: shoot})).To(BeFalse())
+			})
+
+			It("should return true because seed name changed", func() {
+				newShoot := shoot.DeepCopy()
+				newShoot.Spec.SeedName = ptr.To("new-seed")
+
+				Expect(p.Update(event.UpdateEvent{ObjectNew: newShoot, ObjectOld: shoot})).To(BeTrue())
+			})
+
+			It("should return true because the verification was requested", func() {
+				newShoot := shoot.DeepCopy()
+				newShoot.Annotations = map[string]string{v1beta1constants.GardenerOperation: v1beta1constants.ShootOperationVerifyBackup}
+
+				Expect(p.Update(event.UpdateEvent{ObjectNew: newShoot, ObjectOld: shoot})).To(BeTrue())
+			})
+
+			It("should return false because the verification was already requested before", func() {
+				shoot.Annotations = map[string]string{v1beta1constants.GardenerOperation: v1beta1constants.ShootOperationVerifyBackup}
+				newShoot := shoot.DeepCopy()
+				newShoot.Labels = map[string]string{"foo": "bar"}
+
+				Expect(p.Update(event.UpdateEvent{ObjectNew: newShoot, ObjectOld: shoot})).To(BeFalse())
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should return false", func() {
+				Expect(p.Delete(event.DeleteEvent{})).To(BeFalse())
+			})
+		})
+
+		Describe("#Generic", func() {
+			It("should return false", func() {
+				Expect(p.Generic(event.GenericEvent{})).To(BeFalse())
+			})
+		})
+	})
+})
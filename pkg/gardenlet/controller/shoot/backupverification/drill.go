@@ -0,0 +1,361 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupverification
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
+	"github.com/go-logr/logr"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/component/etcd/copybackupstask"
+	"github.com/gardener/gardener/pkg/component/etcd/etcd"
+	etcdconstants "github.com/gardener/gardener/pkg/component/etcd/etcd/constants"
+	"github.com/gardener/gardener/pkg/extensions"
+	"github.com/gardener/gardener/pkg/utils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+const (
+	// EtcdName is the name of the temporary etcd into which the latest snapshot is restored. It is also used for the
+	// EtcdCopyBackupsTask and as prefix for the copied snapshots in the backup bucket.
+	EtcdName = "etcd-backup-verification"
+	// VolumeClaimTemplateName is the name of the volume claim template of the temporary etcd.
+	VolumeClaimTemplateName = "backup-verification-etcd"
+
+	roleBackupVerification = "backup-verification"
+	// secretsManagerIdentity is the identity of the secrets manager generating the certificates of the temporary etcd.
+	// It differs from the identity used by gardenlet for the control plane so that the certificates can be cleaned up
+	// independently.
+	secretsManagerIdentity = "backup-verification"
+	secretNameCA           = "ca-" + EtcdName
+	secretNameServer       = EtcdName + "-server"
+	secretNameClient       = EtcdName + "-client"
+)
+
+var (
+	// WaitInterval is the interval for checking the status of the resources created for the verification.
+	WaitInterval = 5 * time.Second
+	// WaitSevereThreshold is the threshold until an error reported by etcd-druid is treated as 'severe'.
+	WaitSevereThreshold = 3 * time.Minute
+	// WaitTimeout is the timeout for waiting until the resources created for the verification are ready or deleted.
+	WaitTimeout = 5 * time.Minute
+)
+
+// drill restores the latest snapshot of a shoot's etcd backup into a temporary etcd and checks its content.
+type drill struct {
+	log                    logr.Logger
+	client                 client.Client
+	clock                  clock.Clock
+	getKeyCountAndRevision func(ctx context.Context, endpoint string, tlsConfig *tls.Config) (int64, int64, error)
+	namespace              string
+	backupEntry            *extensionsv1alpha1.BackupEntry
+
+	etcd *druidv1alpha1.Etcd
+	pvc  *corev1.PersistentVolumeClaim
+}
+
+func newDrill(
+	log logr.Logger,
+	c client.Client,
+	clock clock.Clock,
+	getKeyCountAndRevision func(ctx context.Context, endpoint string, tlsConfig *tls.Config) (int64, int64, error),
+	namespace string,
+	backupEntry *extensionsv1alpha1.BackupEntry,
+) *drill {
+	return &drill{
+		log:                    log,
+		client:                 c,
+		clock:                  clock,
+		getKeyCountAndRevision: getKeyCountAndRevision,
+		namespace:              namespace,
+		backupEntry:            backupEntry,
+
+		etcd: &druidv1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: EtcdName, Namespace: namespace}},
+		pvc:  &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: VolumeClaimTemplateName + "-" + EtcdName + "-0", Namespace: namespace}},
+	}
+}
+
+// Run copies the latest snapshot to a dedicated prefix in the backup bucket, restores it into a temporary etcd and
+// returns the number of keys and the revision of the restored etcd. All resources created for the verification are
+// deleted afterwards.
+func (d *drill) Run(ctx context.Context) (int64, int64, error) {
+	secret := &corev1.Secret{}
+	if err := d.client.Get(ctx, client.ObjectKey{Name: v1beta1constants.BackupSecretName, Namespace: d.namespace}, secret); err != nil {
+		return 0, 0, fmt.Errorf("failed reading backup secret: %w", err)
+	}
+
+	var (
+		provider  = druidv1alpha1.StorageProvider(d.backupEntry.Spec.Type)
+		container = string(secret.Data[v1beta1constants.DataKeyBackupBucketName])
+
+		sourceStore = druidv1alpha1.StoreSpec{
+			Provider:  &provider,
+			SecretRef: &corev1.SecretReference{Name: secret.Name},
+			Prefix:    fmt.Sprintf("%s/etcd-%s", d.backupEntry.Name, v1beta1constants.ETCDRoleMain),
+			Container: &container,
+		}
+		targetStore = druidv1alpha1.StoreSpec{
+			Provider:  &provider,
+			SecretRef: &corev1.SecretReference{Name: secret.Name},
+			Prefix:    fmt.Sprintf("%s/%s", d.backupEntry.Name, EtcdName),
+			Container: &container,
+		}
+
+		copyBackupsTask = copybackupstask.New(
+			d.log,
+			d.client,
+			&copybackupstask.Values{
+				Name:        EtcdName,
+				Namespace:   d.namespace,
+				SourceStore: sourceStore,
+				TargetStore: targetStore,
+				MaxBackups:  ptr.To[uint32](1),
+			},
+			WaitInterval,
+			WaitSevereThreshold,
+			WaitTimeout,
+		)
+	)
+
+	// Resources of a previous verification might still exist, e.g., if gardenlet was restarted in the meantime. They must
+	// be removed first since the temporary etcd would not restore the snapshot if its volume already contained data.
+	if err := d.cleanup(ctx, copyBackupsTask); err != nil {
+		return 0, 0, fmt.Errorf("failed cleaning up resources of previous verification: %w", err)
+	}
+
+	keyCount, revision, err := d.restore(ctx, copyBackupsTask, targetStore)
+
+	// Use a fresh context for the cleanup so that the resources are removed even if the verification timed out.
+	cleanupCtx, cancel := context.WithTimeout(context.Background(), WaitTimeout)
+	defer cancel()
+
+	return keyCount, revision, errors.Join(err, d.cleanup(cleanupCtx, copyBackupsTask))
+}
+
+func (d *drill) restore(ctx context.Context, copyBackupsTask copybackupstask.Interface, store druidv1alpha1.StoreSpec) (int64, int64, error) {
+	d.log.Info("Copying latest etcd snapshot", "prefix", store.Prefix)
+	if err := copyBackupsTask.Deploy(ctx); err != nil {
+		return 0, 0, fmt.Errorf("failed deploying EtcdCopyBackupsTask: %w", err)
+	}
+	if err := copyBackupsTask.Wait(ctx); err != nil {
+		return 0, 0, fmt.Errorf("failed copying latest etcd snapshot: %w", err)
+	}
+
+	clientTLS, tlsConfig, err := d.generateCertificates(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed generating certificates for temporary etcd: %w", err)
+	}
+
+	d.log.Info("Restoring latest etcd snapshot into temporary etcd", "etcd", client.ObjectKeyFromObject(d.etcd))
+	if err := d.deployEtcd(ctx, store, clientTLS); err != nil {
+		return 0, 0, fmt.Errorf("failed deploying temporary etcd: %w", err)
+	}
+	if err := extensions.WaitUntilObjectReadyWithHealthFunction(ctx, d.client, d.log, etcd.CheckEtcdObject, d.etcd, "Etcd", WaitInterval, WaitSevereThreshold, WaitTimeout, nil); err != nil {
+		return 0, 0, fmt.Errorf("failed restoring latest etcd snapshot: %w", err)
+	}
+
+	keyCount, revision, err := d.getKeyCountAndRevision(ctx, fmt.Sprintf("https://%s.%s.svc:%d", d.etcd.GetClientServiceName(), d.namespace, etcdconstants.PortEtcdClient), tlsConfig)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed reading key count and revision of restored etcd: %w", err)
+	}
+
+	// Every Shoot cluster contains at least a few keys, and each creation of a key increases the revision.
+	if keyCount <= 0 {
+		return keyCount, revision, fmt.Errorf("restored etcd does not contain any keys")
+	}
+	if revision < keyCount {
+		return keyCount, revision, fmt.Errorf("revision %d of restored etcd is lower than its number of keys %d", revision, keyCount)
+	}
+
+	return keyCount, revision, nil
+}
+
+func (d *drill) newSecretsManager(ctx context.Context) (secretsmanager.Interface, error) {
+	return secretsmanager.New(ctx, d.log.WithName("secretsmanager"), d.clock, d.client, d.namespace, secretsManagerIdentity, secretsmanager.Config{})
+}
+
+// generateCertificates generates a dedicated CA as well as server and client certificates for the temporary etcd. It
+// returns the TLS configuration for the Etcd resource and the TLS configuration for clients of the temporary etcd.
+func (d *drill) generateCertificates(ctx context.Context) (*druidv1alpha1.TLSConfig, *tls.Config, error) {
+	secretsManager, err := d.newSecretsManager(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := secretsManager.Generate(ctx, &secretsutils.CertificateSecretConfig{
+		Name:       secretNameCA,
+		CommonName: secretNameCA,
+		CertType:   secretsutils.CACert,
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	caBundleSecret, found := secretsManager.Get(secretNameCA)
+	if !found {
+		return nil, nil, fmt.Errorf("secret %q not found", secretNameCA)
+	}
+
+	serverSecret, err := secretsManager.Generate(ctx, &secretsutils.CertificateSecretConfig{
+		Name:                        secretNameServer,
+		CommonName:                  "etcd-server",
+		DNSNames:                    kubernetesutils.DNSNamesForService(d.etcd.GetClientServiceName(), d.namespace),
+		CertType:                    secretsutils.ServerClientCert,
+		SkipPublishingCACertificate: true,
+	}, secretsmanager.SignedByCA(secretNameCA))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientSecret, err := secretsManager.Generate(ctx, &secretsutils.CertificateSecretConfig{
+		Name:                        secretNameClient,
+		CommonName:                  "etcd-client",
+		CertType:                    secretsutils.ClientCert,
+		SkipPublishingCACertificate: true,
+	}, secretsmanager.SignedByCA(secretNameCA))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientCertificate, err := tls.X509KeyPair(clientSecret.Data[secretsutils.DataKeyCertificate], clientSecret.Data[secretsutils.DataKeyPrivateKey])
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed parsing client certificate: %w", err)
+	}
+
+	caCerts := x509.NewCertPool()
+	if !caCerts.AppendCertsFromPEM(caBundleSecret.Data[secretsutils.DataKeyCertificateBundle]) {
+		return nil, nil, fmt.Errorf("failed parsing CA bundle of secret %q", caBundleSecret.Name)
+	}
+
+	return &druidv1alpha1.TLSConfig{
+		TLSCASecretRef: druidv1alpha1.SecretReference{
+			SecretReference: corev1.SecretReference{Name: caBundleSecret.Name, Namespace: caBundleSecret.Namespace},
+			DataKey:         ptr.To(secretsutils.DataKeyCertificateBundle),
+		},
+		ServerTLSSecretRef: corev1.SecretReference{Name: serverSecret.Name, Namespace: serverSecret.Namespace},
+		ClientTLSSecretRef: corev1.SecretReference{Name: clientSecret.Name, Namespace: clientSecret.Namespace},
+	}, &tls.Config{
+		RootCAs:      caCerts,
+		Certificates: []tls.Certificate{clientCertificate},
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+func (d *drill) deployEtcd(ctx context.Context, store druidv1alpha1.StoreSpec, clientTLS *druidv1alpha1.TLSConfig) error {
+	labels := map[string]string{
+		v1beta1constants.LabelApp:  etcd.LabelAppValue,
+		v1beta1constants.LabelRole: roleBackupVerification,
+	}
+
+	clientService := &corev1.Service{}
+	if err := gardenerutils.InjectNetworkPolicyNamespaceSelectors(clientService, metav1.LabelSelector{MatchLabels: map[string]string{corev1.LabelMetadataName: v1beta1constants.GardenNamespace}}); err != nil {
+		return err
+	}
+	metav1.SetMetaDataAnnotation(&clientService.ObjectMeta, resourcesv1alpha1.NetworkingPodLabelSelectorNamespaceAlias, v1beta1constants.LabelNetworkPolicyShootNamespaceAlias)
+
+	// The Etcd is deliberately not labeled as control plane component so that it is not considered by the health checks
+	// of the Shoot.
+	d.etcd.Labels = map[string]string{v1beta1constants.LabelRole: roleBackupVerification}
+	d.etcd.Spec = druidv1alpha1.EtcdSpec{
+		Selector: &metav1.LabelSelector{MatchLabels: labels},
+		Labels: utils.MergeStringMaps(labels, map[string]string{
+			v1beta1constants.LabelNetworkPolicyToDNS: v1beta1constants.LabelNetworkPolicyAllowed,
+			// backup-restore must reach the object store to fetch the snapshot which is restored.
+			v1beta1constants.LabelNetworkPolicyToPublicNetworks: v1beta1constants.LabelNetworkPolicyAllowed,
+		}),
+		Replicas:            1,
+		PriorityClassName:   ptr.To(v1beta1constants.PriorityClassNameShootControlPlane100),
+		StorageCapacity:     ptr.To(resource.MustParse("10Gi")),
+		VolumeClaimTemplate: ptr.To(VolumeClaimTemplateName),
+		Etcd: druidv1alpha1.EtcdConfig{
+			ClientUrlTLS: clientTLS,
+			ServerPort:   ptr.To(etcdconstants.PortEtcdPeer),
+			ClientPort:   ptr.To(etcdconstants.PortEtcdClient),
+			Quota:        ptr.To(resource.MustParse("8Gi")),
+			ClientService: &druidv1alpha1.ClientService{
+				Annotations: clientService.Annotations,
+			},
+		},
+		Backup: druidv1alpha1.BackupSpec{
+			Port:  ptr.To(etcdconstants.PortBackupRestore),
+			Store: &store,
+			// Delta snapshots are disabled since the temporary etcd does not receive any writes.
+			DeltaSnapshotPeriod: &metav1.Duration{},
+		},
+	}
+
+	return d.client.Create(ctx, d.etcd)
+}
+
+func (d *drill) cleanup(ctx context.Context, copyBackupsTask copybackupstask.Interface) error {
+	if err := copyBackupsTask.Destroy(ctx); err != nil {
+		return err
+	}
+	if err := kubernetesutils.DeleteObjects(ctx, d.client, d.etcd, d.pvc); err != nil {
+		return err
+	}
+
+	if err := copyBackupsTask.WaitCleanup(ctx); err != nil {
+		return err
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, WaitTimeout)
+	defer cancel()
+	for _, obj := range []client.Object{d.etcd, d.pvc} {
+		if err := kubernetesutils.WaitUntilResourceDeleted(timeoutCtx, d.client, obj, WaitInterval); err != nil {
+			return err
+		}
+	}
+
+	// A secrets manager without any prior Generate calls deletes all secrets it manages, i.e., the certificates of the
+	// temporary etcd. This must happen after the etcd is gone since it still mounts them.
+	secretsManager, err := d.newSecretsManager(ctx)
+	if err != nil {
+		return err
+	}
+	return secretsManager.Cleanup(ctx)
+}
+
+// GetKeyCountAndRevision returns the number of keys and the current revision of the etcd reachable via the given
+// endpoint using the given TLS configuration.
+func GetKeyCountAndRevision(ctx context.Context, endpoint string, tlsConfig *tls.Config) (int64, int64, error) {
+	etcdClient, err := clientv3.New(clientv3.Config{
+		Endpoints:   []string{endpoint},
+		TLS:         tlsConfig,
+		DialTimeout: 30 * time.Second,
+		Context:     ctx,
+		Logger:      zap.NewNop(),
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	defer etcdClient.Close()
+
+	resp, err := etcdClient.Get(ctx, "", clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return resp.Count, resp.Header.Revision, nil
+}
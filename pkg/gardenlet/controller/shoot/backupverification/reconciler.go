@@ -0,0 +1,190 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupverification
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	"github.com/gardener/gardener/pkg/utils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Reconciler periodically verifies the etcd backups of Shoots by restoring the latest snapshot into a temporary etcd.
+type Reconciler struct {
+	GardenClient client.Client
+	SeedClient   client.Client
+	Config       config.ShootBackupVerificationControllerConfiguration
+	Clock        clock.Clock
+	SeedName     string
+	// GetKeyCountAndRevision returns the number of keys and the current revision of the etcd reachable via the given
+	// endpoint using the given TLS configuration.
+	GetKeyCountAndRevision func(ctx context.Context, endpoint string, tlsConfig *tls.Config) (int64, int64, error)
+}
+
+var (
+	// RequeueWhenShootIsNotReadyForVerification is the duration for the requeueing when a shoot is not yet ready for a
+	// verification of its backup.
+	RequeueWhenShootIsNotReadyForVerification = 10 * time.Minute
+	// JitterDuration is the duration for jittering when scheduling the next periodic verification.
+	JitterDuration = 1 * time.Hour
+	// VerificationTimeout is the maximum duration a single verification of a backup may take.
+	VerificationTimeout = 20 * time.Minute
+)
+
+const (
+	// ConditionReasonRestoreSucceeded is the reason of the BackupRestorable condition if the latest backup could be
+	// restored successfully.
+	ConditionReasonRestoreSucceeded = "RestoreSucceeded"
+	// ConditionReasonRestoreFailed is the reason of the BackupRestorable condition if the latest backup could not be
+	// restored.
+	ConditionReasonRestoreFailed = "RestoreFailed"
+)
+
+// Reconcile verifies the etcd backups of Shoots and reports the result via the BackupRestorable condition.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, VerificationTimeout)
+	defer cancel()
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.GardenClient.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	// if shoot got deleted or is no longer managed by this gardenlet (e.g., due to migration to another seed) then don't requeue
+	if shoot.DeletionTimestamp != nil || ptr.Deref(shoot.Spec.SeedName, "") != r.SeedName {
+		return reconcile.Result{}, nil
+	}
+
+	if !shootReadyForVerification(shoot.Status) {
+		log.Info("Requeuing because shoot was not yet successfully created or is currently in migration", "requeueAfter", RequeueWhenShootIsNotReadyForVerification)
+		return reconcile.Result{RequeueAfter: RequeueWhenShootIsNotReadyForVerification}, nil
+	}
+
+	backupEntryName, err := gardenerutils.GenerateBackupEntryName(shoot.Status.TechnicalID, shoot.UID)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	backupEntry := &extensionsv1alpha1.BackupEntry{}
+	if err := r.SeedClient.Get(ctx, client.ObjectKey{Name: backupEntryName}, backupEntry); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return reconcile.Result{}, fmt.Errorf("failed reading BackupEntry %s: %w", backupEntryName, err)
+		}
+
+		log.Info("Shoot has no backup which could be verified")
+		if err := r.removeOperationAnnotation(ctx, shoot); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	lastVerification := shoot.CreationTimestamp.UTC()
+	if condition := v1beta1helper.GetCondition(shoot.Status.Conditions, gardencorev1beta1.ShootBackupRestorable); condition != nil {
+		lastVerification = condition.LastUpdateTime.UTC()
+	}
+
+	if nextVerificationDue := lastVerification.Add(r.Config.SyncPeriod.Duration); verificationRequested(shoot) || nextVerificationDue.Before(r.Clock.Now().UTC()) {
+		log.Info("Verifying etcd backup by restoring the latest snapshot", "lastVerification", lastVerification.Round(time.Minute), "nextVerificationDue", nextVerificationDue.Round(time.Minute))
+		if err := r.verify(ctx, log, shoot, backupEntry); err != nil {
+			return reconcile.Result{}, err
+		}
+		lastVerification = r.Clock.Now()
+	} else {
+		log.Info("No need to verify etcd backup yet", "lastVerification", lastVerification.Round(time.Minute), "syncPeriod", r.Config.SyncPeriod.Duration)
+	}
+
+	requeueAfter, nextVerification := r.requeueAfter(lastVerification)
+	log.Info("Scheduled next etcd backup verification for Shoot", "duration", requeueAfter.Round(time.Minute), "nextVerification", nextVerification.Round(time.Minute))
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *Reconciler) verify(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, backupEntry *extensionsv1alpha1.BackupEntry) error {
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, shoot.Status.Conditions, gardencorev1beta1.ShootBackupRestorable)
+
+	keyCount, revision, err := newDrill(log, r.SeedClient, r.Clock, r.GetKeyCountAndRevision, shoot.Status.TechnicalID, backupEntry).Run(ctx)
+	if err != nil {
+		log.Error(err, "Verification of etcd backup failed")
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, ConditionReasonRestoreFailed, err.Error())
+	} else {
+		log.Info("Verification of etcd backup succeeded", "keyCount", keyCount, "revision", revision)
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionTrue, ConditionReasonRestoreSucceeded,
+			fmt.Sprintf("The latest etcd backup was restored successfully (keys: %d, revision: %d).", keyCount, revision))
+	}
+	// The last update time is used to determine when the next verification is due, hence it must reflect the time of the
+	// last verification even if the result did not change.
+	condition.LastUpdateTime = metav1.NewTime(r.Clock.Now())
+
+	patch := client.StrategicMergeFrom(shoot.DeepCopy())
+	shoot.Status.Conditions = v1beta1helper.MergeConditions(shoot.Status.Conditions, condition)
+	if err := r.GardenClient.Status().Patch(ctx, shoot, patch); err != nil {
+		return fmt.Errorf("failed updating %s condition: %w", gardencorev1beta1.ShootBackupRestorable, err)
+	}
+
+	return r.removeOperationAnnotation(ctx, shoot)
+}
+
+func (r *Reconciler) removeOperationAnnotation(ctx context.Context, shoot *gardencorev1beta1.Shoot) error {
+	if !verificationRequested(shoot) {
+		return nil
+	}
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	delete(shoot.Annotations, v1beta1constants.GardenerOperation)
+	if err := r.GardenClient.Patch(ctx, shoot, patch); err != nil {
+		return fmt.Errorf("failed removing operation annotation: %w", err)
+	}
+	return nil
+}
+
+func (r *Reconciler) requeueAfter(lastVerification time.Time) (time.Duration, time.Time) {
+	var (
+		nextRegularVerification = lastVerification.Add(r.Config.SyncPeriod.Duration)
+		randomDuration          = utils.RandomDuration(JitterDuration)
+
+		nextVerification              = nextRegularVerification.Add(-JitterDuration / 2).Add(randomDuration)
+		durationUntilNextVerification = nextVerification.UTC().Sub(r.Clock.Now().UTC())
+	)
+
+	return durationUntilNextVerification, nextVerification
+}
+
+func shootReadyForVerification(status gardencorev1beta1.ShootStatus) bool {
+	if status.LastOperation == nil || status.TechnicalID == "" {
+		return false
+	}
+
+	switch status.LastOperation.Type {
+	case gardencorev1beta1.LastOperationTypeCreate, gardencorev1beta1.LastOperationTypeRestore:
+		return status.LastOperation.State == gardencorev1beta1.LastOperationStateSucceeded
+	case gardencorev1beta1.LastOperationTypeMigrate:
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,327 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupverification_test
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/backupverification"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.Background()
+		fakeClock  *testclock.FakeClock
+		syncPeriod = 24 * time.Hour
+
+		gardenClient client.Client
+		seedClient   client.Client
+		reconciler   *Reconciler
+
+		shoot       *gardencorev1beta1.Shoot
+		backupEntry *extensionsv1alpha1.BackupEntry
+		secret      *corev1.Secret
+		request     reconcile.Request
+
+		endpoint           string
+		tlsConfig          *tls.Config
+		keyCount, revision int64
+		statsErr           error
+		createdObjects     []string
+		createdTask        *druidv1alpha1.EtcdCopyBackupsTask
+		createdEtcd        *druidv1alpha1.Etcd
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+
+		DeferCleanup(test.WithVars(
+			&WaitInterval, time.Millisecond,
+			&WaitTimeout, time.Second,
+			&JitterDuration, time.Duration(0),
+		))
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "bar",
+				Namespace:         "garden-foo",
+				UID:               "1234",
+				CreationTimestamp: metav1.NewTime(fakeClock.Now().Add(-2 * syncPeriod)),
+			},
+			Spec: gardencorev1beta1.ShootSpec{SeedName: ptr.To("seed")},
+			Status: gardencorev1beta1.ShootStatus{
+				TechnicalID: "shoot--foo--bar",
+				LastOperation: &gardencorev1beta1.LastOperation{
+					Type:  gardencorev1beta1.LastOperationTypeReconcile,
+					State: gardencorev1beta1.LastOperationStateSucceeded,
+				},
+			},
+		}
+		backupEntry = &extensionsv1alpha1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar--1234"},
+			Spec:       extensionsv1alpha1.BackupEntrySpec{DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "local"}},
+		}
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "etcd-backup", Namespace: shoot.Status.TechnicalID},
+			Data:       map[string][]byte{"bucketName": []byte("bucket")},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+
+		endpoint, tlsConfig, keyCount, revision, statsErr = "", nil, 42, 1337, nil
+		createdObjects, createdTask, createdEtcd = nil, nil, nil
+
+		gardenClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.Shoot{}).
+			Build()
+		seedClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.SeedScheme).
+			WithInterceptorFuncs(interceptor.Funcs{
+				// Simulate etcd-druid which reconciles the created resources successfully.
+				Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					if err := c.Create(ctx, obj, opts...); err != nil {
+						return err
+					}
+
+					switch o := obj.(type) {
+					case *druidv1alpha1.EtcdCopyBackupsTask:
+						createdObjects = append(createdObjects, "EtcdCopyBackupsTask")
+						createdTask = o.DeepCopy()
+						o.Status.ObservedGeneration = ptr.To(o.Generation)
+						o.Status.Conditions = []druidv1alpha1.Condition{{Type: druidv1alpha1.EtcdCopyBackupsTaskSucceeded, Status: druidv1alpha1.ConditionTrue}}
+						return c.Update(ctx, o)
+					case *druidv1alpha1.Etcd:
+						createdObjects = append(createdObjects, "Etcd")
+						createdEtcd = o.DeepCopy()
+						o.Status.ObservedGeneration = ptr.To(o.Generation)
+						o.Status.Ready = ptr.To(true)
+						return c.Update(ctx, o)
+					}
+					return nil
+				},
+			}).
+			Build()
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			SeedClient:   seedClient,
+			Config: config.ShootBackupVerificationControllerConfiguration{
+				ConcurrentSyncs: ptr.To(1),
+				SyncPeriod:      &metav1.Duration{Duration: syncPeriod},
+			},
+			Clock:    fakeClock,
+			SeedName: "seed",
+			GetKeyCountAndRevision: func(_ context.Context, e string, t *tls.Config) (int64, int64, error) {
+				endpoint, tlsConfig = e, t
+				return keyCount, revision, statsErr
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+	})
+
+	It("should do nothing if the shoot is gone", func() {
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKey{Name: "other", Namespace: "garden-foo"}})).To(Equal(reconcile.Result{}))
+	})
+
+	It("should do nothing if the shoot is managed by another seed", func() {
+		reconciler.SeedName = "other-seed"
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	Context("shoot is not ready for verification", func() {
+		BeforeEach(func() {
+			shoot.Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeCreate
+			shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateProcessing
+		})
+
+		It("should requeue", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: RequeueWhenShootIsNotReadyForVerification}))
+		})
+	})
+
+	Context("shoot has no backup", func() {
+		BeforeEach(func() {
+			shoot.Annotations = map[string]string{v1beta1constants.GardenerOperation: v1beta1constants.ShootOperationVerifyBackup}
+		})
+
+		It("should remove the operation annotation and requeue", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+			Expect(shoot.Status.Conditions).To(BeEmpty())
+			Expect(createdObjects).To(BeEmpty())
+		})
+	})
+
+	Context("shoot has a backup", func() {
+		BeforeEach(func() {
+			Expect(seedClient.Create(ctx, backupEntry)).To(Succeed())
+			Expect(seedClient.Create(ctx, secret)).To(Succeed())
+		})
+
+		expectResourcesCleanedUp := func() {
+			ExpectWithOffset(1, seedClient.Get(ctx, client.ObjectKey{Name: "etcd-backup-verification", Namespace: secret.Namespace}, &druidv1alpha1.Etcd{})).To(BeNotFoundError())
+			ExpectWithOffset(1, seedClient.Get(ctx, client.ObjectKey{Name: "etcd-backup-verification", Namespace: secret.Namespace}, &druidv1alpha1.EtcdCopyBackupsTask{})).To(BeNotFoundError())
+			ExpectWithOffset(1, seedClient.Get(ctx, client.ObjectKey{Name: "backup-verification-etcd-etcd-backup-verification-0", Namespace: secret.Namespace}, &corev1.PersistentVolumeClaim{})).To(BeNotFoundError())
+
+			secretList := &corev1.SecretList{}
+			ExpectWithOffset(1, seedClient.List(ctx, secretList, client.InNamespace(secret.Namespace), client.MatchingLabels{"manager-identity": "backup-verification"})).To(Succeed())
+			ExpectWithOffset(1, secretList.Items).To(BeEmpty())
+		}
+
+		It("should not verify the backup if it is not yet due", func() {
+			shoot.Status.Conditions = []gardencorev1beta1.Condition{{
+				Type:           gardencorev1beta1.ShootBackupRestorable,
+				Status:         gardencorev1beta1.ConditionTrue,
+				LastUpdateTime: metav1.NewTime(fakeClock.Now().Add(-time.Hour)),
+			}}
+			Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod - time.Hour}))
+			Expect(createdObjects).To(BeEmpty())
+		})
+
+		It("should verify the backup if it is due and report success", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(createdObjects).To(Equal([]string{"EtcdCopyBackupsTask", "Etcd"}))
+			Expect(endpoint).To(Equal("https://etcd-backup-verification-client.shoot--foo--bar.svc:2379"))
+			Expect(tlsConfig).NotTo(BeNil())
+			Expect(tlsConfig.RootCAs).NotTo(BeNil())
+			Expect(tlsConfig.Certificates).To(HaveLen(1))
+			expectResourcesCleanedUp()
+
+			Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			condition := v1beta1helper.GetCondition(shoot.Status.Conditions, gardencorev1beta1.ShootBackupRestorable)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+			Expect(condition.Reason).To(Equal("RestoreSucceeded"))
+			Expect(condition.Message).To(Equal("The latest etcd backup was restored successfully (keys: 42, revision: 1337)."))
+			Expect(condition.LastUpdateTime.Time.UTC()).To(Equal(fakeClock.Now()))
+		})
+
+		It("should copy the latest snapshot to a dedicated prefix and restore it from there", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(createdTask.Spec.SourceStore.Prefix).To(Equal("shoot--foo--bar--1234/etcd-main"))
+			Expect(createdTask.Spec.SourceStore.Container).To(PointTo(Equal("bucket")))
+			Expect(createdTask.Spec.TargetStore.Prefix).To(Equal("shoot--foo--bar--1234/etcd-backup-verification"))
+			Expect(createdTask.Spec.TargetStore.Container).To(PointTo(Equal("bucket")))
+			Expect(createdTask.Spec.MaxBackups).To(PointTo(Equal(uint32(1))))
+
+			Expect(createdEtcd.Labels).NotTo(HaveKey(v1beta1constants.GardenRole))
+			Expect(createdEtcd.Spec.Replicas).To(Equal(int32(1)))
+			Expect(createdEtcd.Spec.Backup.Store).To(PointTo(Equal(createdTask.Spec.TargetStore)))
+		})
+
+		It("should configure client TLS for the temporary etcd and only allow the required egress traffic", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(createdEtcd.Spec.Etcd.ClientUrlTLS).NotTo(BeNil())
+			Expect(createdEtcd.Spec.Etcd.ClientUrlTLS.TLSCASecretRef.Name).To(HavePrefix("ca-etcd-backup-verification-bundle-"))
+			Expect(createdEtcd.Spec.Etcd.ClientUrlTLS.TLSCASecretRef.DataKey).To(PointTo(Equal("bundle.crt")))
+			Expect(createdEtcd.Spec.Etcd.ClientUrlTLS.ServerTLSSecretRef.Name).To(HavePrefix("etcd-backup-verification-server-"))
+			Expect(createdEtcd.Spec.Etcd.ClientUrlTLS.ClientTLSSecretRef.Name).To(HavePrefix("etcd-backup-verification-client-"))
+
+			Expect(createdEtcd.Spec.Labels).To(HaveKeyWithValue("networking.gardener.cloud/to-dns", "allowed"))
+			Expect(createdEtcd.Spec.Labels).To(HaveKeyWithValue("networking.gardener.cloud/to-public-networks", "allowed"))
+			Expect(createdEtcd.Spec.Labels).NotTo(HaveKey("networking.gardener.cloud/to-private-networks"))
+		})
+
+		It("should verify the backup if it was requested", func() {
+			shoot.Annotations = map[string]string{v1beta1constants.GardenerOperation: v1beta1constants.ShootOperationVerifyBackup}
+			shoot.Status.Conditions = []gardencorev1beta1.Condition{{
+				Type:           gardencorev1beta1.ShootBackupRestorable,
+				Status:         gardencorev1beta1.ConditionTrue,
+				LastUpdateTime: metav1.NewTime(fakeClock.Now().Add(-time.Hour)),
+			}}
+			Expect(gardenClient.Update(ctx, shoot)).To(Succeed())
+			Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(createdObjects).To(Equal([]string{"EtcdCopyBackupsTask", "Etcd"}))
+			Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+			Expect(v1beta1helper.GetCondition(shoot.Status.Conditions, gardencorev1beta1.ShootBackupRestorable).LastUpdateTime.Time.UTC()).To(Equal(fakeClock.Now()))
+		})
+
+		It("should report failure if the restored etcd does not contain any keys", func() {
+			keyCount, revision = 0, 0
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			expectResourcesCleanedUp()
+
+			Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			condition := v1beta1helper.GetCondition(shoot.Status.Conditions, gardencorev1beta1.ShootBackupRestorable)
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("RestoreFailed"))
+			Expect(condition.Message).To(Equal("restored etcd does not contain any keys"))
+		})
+
+		It("should report failure if the revision of the restored etcd is not sane", func() {
+			keyCount, revision = 42, 10
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			condition := v1beta1helper.GetCondition(shoot.Status.Conditions, gardencorev1beta1.ShootBackupRestorable)
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Message).To(Equal("revision 10 of restored etcd is lower than its number of keys 42"))
+		})
+
+		It("should report failure if the restored etcd cannot be read", func() {
+			statsErr = fmt.Errorf("fake")
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			expectResourcesCleanedUp()
+
+			Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			condition := v1beta1helper.GetCondition(shoot.Status.Conditions, gardencorev1beta1.ShootBackupRestorable)
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Message).To(Equal("failed reading key count and revision of restored etcd: fake"))
+		})
+
+		It("should remove leftovers of a previous verification before restoring the snapshot", func() {
+			pvc := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "backup-verification-etcd-etcd-backup-verification-0", Namespace: secret.Namespace}}
+			Expect(seedClient.Create(ctx, pvc)).To(Succeed())
+			createdObjects = nil
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(createdObjects).To(Equal([]string{"EtcdCopyBackupsTask", "Etcd"}))
+			expectResourcesCleanedUp()
+		})
+	})
+})
@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+const (
+	metricProcessStartTimeSeconds            = "process_start_time_seconds"
+	metricFlowControlDispatchedRequestsTotal = "apiserver_flowcontrol_dispatched_requests_total"
+	labelFlowSchema                          = "flow_schema"
+
+	// userActivityUpdateInterval is the minimum interval in which the last user activity annotation of a Shoot is
+	// updated. This prevents patching the Shoot on every care reconciliation of an actively used cluster.
+	userActivityUpdateInterval = 5 * time.Minute
+	// userActivityObservationRetention is the duration after which observations of kube-apiserver instances which were
+	// not seen anymore are forgotten.
+	userActivityObservationRetention = time.Hour
+)
+
+// UserActivityTracker determines the time of the last user activity of Shoots based on the number of requests
+// dispatched by their kube-apiservers for the configured API Priority and Fairness flow schemas. The result is
+// recorded in the `shoot.gardener.cloud/last-user-activity` annotation of the Shoots.
+type UserActivityTracker struct {
+	clock       clock.Clock
+	flowSchemas sets.Set[string]
+
+	lock sync.Mutex
+	// observations contains the last observed request counters per Shoot and kube-apiserver instance. The instances
+	// are identified by their process start time because the metrics of a random instance are returned by the
+	// kube-apiserver service.
+	observations map[types.UID]map[float64]*activityObservation
+}
+
+type activityObservation struct {
+	dispatchedRequests float64
+	lastSeen           time.Time
+}
+
+// NewUserActivityTracker creates a new instance for tracking the user activity of Shoots.
+func NewUserActivityTracker(clock clock.Clock, flowSchemas []string) *UserActivityTracker {
+	return &UserActivityTracker{
+		clock:        clock,
+		flowSchemas:  sets.New(flowSchemas...),
+		observations: make(map[types.UID]map[float64]*activityObservation),
+	}
+}
+
+// Track evaluates the metrics of the Shoot's kube-apiserver and updates the last user activity annotation of the Shoot
+// if new user requests were dispatched since the last observation.
+func (t *UserActivityTracker) Track(ctx context.Context, gardenClient client.Client, shoot *gardencorev1beta1.Shoot, initializeShootMetrics ShootMetricsInit) error {
+	metricFamilies, err := initializeShootMetrics()
+	if err != nil {
+		return err
+	}
+	if metricFamilies == nil {
+		return nil
+	}
+
+	processStartTime, dispatchedRequests, err := t.evaluateMetrics(metricFamilies)
+	if err != nil {
+		return err
+	}
+
+	lastActivity, err := gardenerutils.GetShootLastUserActivity(shoot.Annotations)
+	if err != nil {
+		// The annotation is maintained by gardenlet, so simply overwrite invalid values.
+		lastActivity = nil
+	}
+
+	activity := t.observe(shoot.UID, processStartTime, dispatchedRequests, lastActivity)
+	if activity == nil || (lastActivity != nil && activity.Sub(*lastActivity) < userActivityUpdateInterval) {
+		return nil
+	}
+
+	shoot = shoot.DeepCopy()
+	patch := client.MergeFrom(shoot.DeepCopy())
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootLastUserActivity, activity.UTC().Format(time.RFC3339))
+	if err := gardenClient.Patch(ctx, shoot, patch); err != nil {
+		return fmt.Errorf("could not update annotation %s: %w", v1beta1constants.AnnotationShootLastUserActivity, err)
+	}
+
+	return nil
+}
+
+func (t *UserActivityTracker) evaluateMetrics(metricFamilies map[string]*dto.MetricFamily) (float64, float64, error) {
+	processStartTimeFamily, ok := metricFamilies[metricProcessStartTimeSeconds]
+	if !ok || len(processStartTimeFamily.GetMetric()) == 0 {
+		return 0, 0, fmt.Errorf("metric %s is missing in the metrics of the shoot's kube-apiserver", metricProcessStartTimeSeconds)
+	}
+	processStartTime := processStartTimeFamily.GetMetric()[0].GetGauge().GetValue() + processStartTimeFamily.GetMetric()[0].GetUntyped().GetValue()
+
+	var dispatchedRequests float64
+	if metricFamily, ok := metricFamilies[metricFlowControlDispatchedRequestsTotal]; ok {
+		for _, metric := range metricFamily.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == labelFlowSchema && t.flowSchemas.Has(label.GetValue()) {
+					dispatchedRequests += metric.GetCounter().GetValue() + metric.GetUntyped().GetValue()
+				}
+			}
+		}
+	}
+
+	return processStartTime, dispatchedRequests, nil
+}
+
+// observe records the given request counter of the kube-apiserver instance and returns the time of the detected user
+// activity, if any.
+func (t *UserActivityTracker) observe(uid types.UID, processStartTime, dispatchedRequests float64, lastActivity *time.Time) *time.Time {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := t.clock.Now()
+
+	for shootUID, instances := range t.observations {
+		for startTime, observation := range instances {
+			if now.Sub(observation.lastSeen) > userActivityObservationRetention {
+				delete(instances, startTime)
+			}
+		}
+		if len(instances) == 0 {
+			delete(t.observations, shootUID)
+		}
+	}
+
+	instances, ok := t.observations[uid]
+	if !ok {
+		instances = make(map[float64]*activityObservation)
+		t.observations[uid] = instances
+	}
+
+	var activity *time.Time
+	if observation, ok := instances[processStartTime]; ok {
+		if dispatchedRequests > observation.dispatchedRequests {
+			activity = &now
+		}
+	} else {
+		seconds, fraction := math.Modf(processStartTime)
+		startedAt := time.Unix(int64(seconds), int64(fraction*1e9))
+
+		switch {
+		case lastActivity == nil:
+			// Start observing the Shoot, its idle time is measured from now on.
+			activity = &now
+		case startedAt.After(*lastActivity):
+			// A (re)start of the kube-apiserver, e.g. when waking up the Shoot, is considered as user activity.
+			activity = &startedAt
+		}
+	}
+
+	instances[processStartTime] = &activityObservation{dispatchedRequests: dispatchedRequests, lastSeen: now}
+	return activity
+}
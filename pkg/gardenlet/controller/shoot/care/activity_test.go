@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	fakerest "k8s.io/client-go/rest/fake"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+)
+
+var _ = Describe("UserActivityTracker", func() {
+	var (
+		ctx          = context.Background()
+		gardenClient client.Client
+		fakeClock    *testclock.FakeClock
+		tracker      *UserActivityTracker
+		shoot        *gardencorev1beta1.Shoot
+
+		apiServerMetrics       string
+		apiServerRunning       bool
+		initializeShootClients ShootClientInit
+
+		metrics = func(startTime, globalDefault, system string) string {
+			return `# TYPE process_start_time_seconds gauge
+process_start_time_seconds ` + startTime + `
+# TYPE apiserver_flowcontrol_dispatched_requests_total counter
+apiserver_flowcontrol_dispatched_requests_total{flow_schema="global-default",priority_level="global-default"} ` + globalDefault + `
+apiserver_flowcontrol_dispatched_requests_total{flow_schema="system-nodes",priority_level="system"} ` + system + `
+`
+		}
+		track = func() error {
+			ExpectWithOffset(1, gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			return tracker.Track(ctx, gardenClient, shoot, NewShootMetricsInit(ctx, initializeShootClients))
+		}
+		lastUserActivity = func() string {
+			ExpectWithOffset(1, gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			return shoot.Annotations["shoot.gardener.cloud/last-user-activity"]
+		}
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 4, 12, 10, 0, 0, 0, time.UTC))
+		tracker = NewUserActivityTracker(fakeClock, []string{"global-default"})
+
+		shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo", UID: "uid"}}
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+		// the kube-apiserver instance was started at 2024-04-12T08:00:00Z
+		apiServerMetrics = metrics("1.7129088e+09", "10", "100")
+		apiServerRunning = true
+		shootRESTClient := &fakerest.RESTClient{
+			NegotiatedSerializer: scheme.Codecs,
+			Client: fakerest.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+				if req.URL.Path == "/metrics" {
+					return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader([]byte(apiServerMetrics)))}, nil
+				}
+				return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(&bytes.Buffer{})}, nil
+			}),
+		}
+		initializeShootClients = func() (kubernetes.Interface, bool, error) {
+			return kubernetesfake.NewClientSetBuilder().WithRESTClient(shootRESTClient).Build(), apiServerRunning, nil
+		}
+	})
+
+	Describe("#Track", func() {
+		It("should do nothing if the API server is not running", func() {
+			apiServerRunning = false
+
+			Expect(track()).To(Succeed())
+			Expect(lastUserActivity()).To(BeEmpty())
+		})
+
+		It("should start measuring the idle time if the shoot was not observed yet", func() {
+			Expect(track()).To(Succeed())
+			Expect(lastUserActivity()).To(Equal("2024-04-12T10:00:00Z"))
+		})
+
+		It("should record user activity if requests were dispatched for the configured flow schemas", func() {
+			Expect(track()).To(Succeed())
+
+			fakeClock.Step(10 * time.Minute)
+			apiServerMetrics = metrics("1.7129088e+09", "11", "100")
+			Expect(track()).To(Succeed())
+			Expect(lastUserActivity()).To(Equal("2024-04-12T10:10:00Z"))
+		})
+
+		It("should not record user activity if only requests of other flow schemas were dispatched", func() {
+			Expect(track()).To(Succeed())
+
+			fakeClock.Step(10 * time.Minute)
+			apiServerMetrics = metrics("1.7129088e+09", "10", "200")
+			Expect(track()).To(Succeed())
+			Expect(lastUserActivity()).To(Equal("2024-04-12T10:00:00Z"))
+		})
+
+		It("should not update the annotation more often than every five minutes", func() {
+			Expect(track()).To(Succeed())
+
+			fakeClock.Step(time.Minute)
+			apiServerMetrics = metrics("1.7129088e+09", "11", "100")
+			Expect(track()).To(Succeed())
+			Expect(lastUserActivity()).To(Equal("2024-04-12T10:00:00Z"))
+		})
+
+		It("should not record user activity for already running instances after a restart of gardenlet", func() {
+			shoot.Annotations = map[string]string{"shoot.gardener.cloud/last-user-activity": "2024-04-12T09:00:00Z"}
+			Expect(gardenClient.Update(ctx, shoot)).To(Succeed())
+
+			Expect(track()).To(Succeed())
+			Expect(lastUserActivity()).To(Equal("2024-04-12T09:00:00Z"))
+		})
+
+		It("should consider the start of a new instance as user activity", func() {
+			shoot.Annotations = map[string]string{"shoot.gardener.cloud/last-user-activity": "2024-04-12T07:00:00Z"}
+			Expect(gardenClient.Update(ctx, shoot)).To(Succeed())
+
+			Expect(track()).To(Succeed())
+			Expect(lastUserActivity()).To(Equal("2024-04-12T08:00:00Z"))
+		})
+
+		It("should return an error if the metrics cannot be parsed", func() {
+			apiServerMetrics = `process_start_time_seconds{`
+
+			Expect(track()).To(MatchError(ContainSubstring("could not parse metrics")))
+		})
+
+		It("should return an error if the process start time is missing", func() {
+			apiServerMetrics = `apiserver_flowcontrol_dispatched_requests_total{flow_schema="global-default"} 1` + "\n"
+
+			Expect(track()).To(MatchError(ContainSubstring("metric process_start_time_seconds is missing")))
+		})
+	})
+})
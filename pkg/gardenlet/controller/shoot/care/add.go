@@ -37,6 +37,9 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster cluster.Clu
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
+	if r.UserActivityTracker == nil && len(r.Config.Controllers.ShootCare.UserActivityFlowSchemas) > 0 {
+		r.UserActivityTracker = NewUserActivityTracker(r.Clock, r.Config.Controllers.ShootCare.UserActivityFlowSchemas)
+	}
 
 	return builder.
 		ControllerManagedBy(mgr).
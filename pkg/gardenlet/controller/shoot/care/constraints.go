@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/go-logr/logr"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -68,6 +69,7 @@ type Constraint struct {
 
 	seedClient             client.Client
 	initializeShootClients ShootClientInit
+	initializeShootMetrics ShootMetricsInit
 	shootClient            client.Client
 
 	log   logr.Logger
@@ -80,6 +82,7 @@ func NewConstraint(
 	shoot *shoot.Shoot,
 	seedClient client.Client,
 	shootClientInit ShootClientInit,
+	shootMetricsInit ShootMetricsInit,
 	clock clock.Clock,
 ) *Constraint {
 	return &Constraint{
@@ -87,6 +90,7 @@ func NewConstraint(
 		shoot:                  shoot,
 		seedClient:             seedClient,
 		initializeShootClients: shootClientInit,
+		initializeShootMetrics: shootMetricsInit,
 		log:                    log,
 	}
 }
@@ -157,9 +161,16 @@ func (c *Constraint) constraintsChecks(
 		constraints.crdsWithProblematicConversionWebhooks = v1beta1helper.UpdatedConditionWithClock(c.clock, constraints.crdsWithProblematicConversionWebhooks, status, reason, message)
 	}
 
+	status, reason, message, err = c.CheckIfRemovedAPIsInUse()
+	if err != nil {
+		constraints.removedAPIsNotInUse = v1beta1helper.UpdatedConditionUnknownErrorWithClock(c.clock, constraints.removedAPIsNotInUse, err)
+	} else {
+		constraints.removedAPIsNotInUse = v1beta1helper.UpdatedConditionWithClock(c.clock, constraints.removedAPIsNotInUse, status, reason, message)
+	}
+
 	return filterOptionalConstraints(
 		[]gardencorev1beta1.Condition{constraints.hibernationPossible, constraints.maintenancePreconditionsSatisfied},
-		[]gardencorev1beta1.Condition{constraints.caCertificateValiditiesAcceptable, constraints.crdsWithProblematicConversionWebhooks},
+		[]gardencorev1beta1.Condition{constraints.caCertificateValiditiesAcceptable, constraints.crdsWithProblematicConversionWebhooks, constraints.removedAPIsNotInUse},
 	)
 }
 
@@ -203,7 +214,9 @@ func (c *Constraint) CheckIfCACertificateValiditiesAcceptable(ctx context.Contex
 
 	expiringCACertificates := make(map[string]time.Time, len(secretList.Items))
 	for _, secret := range secretList.Items {
-		if secret.Data[secretsutils.DataKeyCertificateCA] == nil || secret.Data[secretsutils.DataKeyPrivateKeyCA] == nil {
+		// The private key of the CA might be stored in a key store instead of the secret, see the secrets manager config.
+		if secret.Data[secretsutils.DataKeyCertificateCA] == nil ||
+			(secret.Data[secretsutils.DataKeyPrivateKeyCA] == nil && secret.Data[secretsmanager.DataKeyPrivateKeyCARef] == nil) {
 			continue
 		}
 
@@ -270,6 +283,93 @@ func (c *Constraint) checkIfCRDsWithProblematicConversionWebhooksPresent(ctx con
 		nil
 }
 
+// metricRequestedDeprecatedAPIs is the name of the kube-apiserver metric which reports the deprecated APIs that were
+// requested since the start of the kube-apiserver.
+const metricRequestedDeprecatedAPIs = "apiserver_requested_deprecated_apis"
+
+// CheckIfRemovedAPIsInUse checks whether APIs which are removed in the next Kubernetes minor version were requested
+// from the kube-apiserver of the Shoot. This is determined based on the `apiserver_requested_deprecated_apis` metric,
+// i.e., only requests since the last start of the kube-apiserver instance serving the metrics request are considered.
+// Requests served by other kube-apiserver replicas are not visible. As scraping all metrics of the kube-apiserver is
+// not for free, the check is only performed if the CloudProfile offers a version of the next minor version, i.e., if
+// an update to this minor version can be pending at all.
+func (c *Constraint) CheckIfRemovedAPIsInUse() (gardencorev1beta1.ConditionStatus, string, string, error) {
+	nextMinorVersion := semver.New(c.shoot.KubernetesVersion.Major(), c.shoot.KubernetesVersion.Minor()+1, 0, "", "")
+
+	if c.shoot.CloudProfile != nil {
+		nextMinorVersionAvailable, _, err := v1beta1helper.GetVersionForForcefulUpdateToConsecutiveMinor(c.shoot.CloudProfile.Spec.Kubernetes.Versions, c.shoot.KubernetesVersion.String())
+		if err != nil {
+			return "", "", "", fmt.Errorf("could not determine whether an update to Kubernetes version %d.%d is available: %w", nextMinorVersion.Major(), nextMinorVersion.Minor(), err)
+		}
+		if !nextMinorVersionAvailable {
+			return gardencorev1beta1.ConditionTrue,
+				"NoMinorVersionUpdateAvailable",
+				fmt.Sprintf("Kubernetes version %d.%d is not offered by the CloudProfile, hence removed APIs are not checked", nextMinorVersion.Major(), nextMinorVersion.Minor()),
+				nil
+		}
+	}
+
+	metricFamilies, err := c.initializeShootMetrics()
+	if err != nil {
+		return "", "", "", err
+	}
+	if metricFamilies == nil {
+		return gardencorev1beta1.ConditionUnknown,
+			"MetricsNotAvailable",
+			"Metrics of the kube-apiserver are not available, hence it cannot be determined whether removed APIs are in use",
+			nil
+	}
+
+	removedAPIs := sets.New[string]()
+	if metricFamily, ok := metricFamilies[metricRequestedDeprecatedAPIs]; ok {
+		for _, metric := range metricFamily.GetMetric() {
+			if metric.GetGauge().GetValue() == 0 && metric.GetUntyped().GetValue() == 0 {
+				continue
+			}
+
+			labels := make(map[string]string, len(metric.GetLabel()))
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+
+			if labels["removed_release"] == "" {
+				continue
+			}
+
+			removedRelease, err := semver.NewVersion(labels["removed_release"])
+			if err != nil {
+				return "", "", "", fmt.Errorf("could not parse removed release %q of deprecated API: %w", labels["removed_release"], err)
+			}
+			if removedRelease.GreaterThan(nextMinorVersion) {
+				continue
+			}
+
+			api := labels["resource"]
+			if labels["subresource"] != "" {
+				api += "/" + labels["subresource"]
+			}
+			api += "." + labels["version"]
+			if labels["group"] != "" {
+				api += "." + labels["group"]
+			}
+			removedAPIs.Insert(fmt.Sprintf("%s (removed in %s)", api, labels["removed_release"]))
+		}
+	}
+
+	if removedAPIs.Len() > 0 {
+		return gardencorev1beta1.ConditionFalse,
+			"RemovedAPIsInUse",
+			fmt.Sprintf("Some APIs which are removed in Kubernetes version %d.%d are still in use: %s. Please migrate to their successor APIs, otherwise automatic updates to Kubernetes version %d.%d are blocked.",
+				nextMinorVersion.Major(), nextMinorVersion.Minor(), strings.Join(sets.List(removedAPIs), ", "), nextMinorVersion.Major(), nextMinorVersion.Minor()),
+			nil
+	}
+
+	return gardencorev1beta1.ConditionTrue,
+		"NoRemovedAPIsInUse",
+		fmt.Sprintf("No APIs which are removed in Kubernetes version %d.%d are in use", nextMinorVersion.Major(), nextMinorVersion.Minor()),
+		nil
+}
+
 // CheckForProblematicWebhooks checks the Shoot for problematic webhooks which could prevent shoot worker nodes from
 // joining the cluster.
 func (c *Constraint) CheckForProblematicWebhooks(ctx context.Context) (gardencorev1beta1.ConditionStatus, string, string, []gardencorev1beta1.ErrorCode, error) {
@@ -425,6 +525,7 @@ type ShootConstraints struct {
 	maintenancePreconditionsSatisfied     gardencorev1beta1.Condition
 	caCertificateValiditiesAcceptable     gardencorev1beta1.Condition
 	crdsWithProblematicConversionWebhooks gardencorev1beta1.Condition
+	removedAPIsNotInUse                   gardencorev1beta1.Condition
 }
 
 // ConvertToSlice returns the shoot constraints as a slice.
@@ -434,6 +535,7 @@ func (g ShootConstraints) ConvertToSlice() []gardencorev1beta1.Condition {
 		g.maintenancePreconditionsSatisfied,
 		g.caCertificateValiditiesAcceptable,
 		g.crdsWithProblematicConversionWebhooks,
+		g.removedAPIsNotInUse,
 	}
 }
 
@@ -444,6 +546,7 @@ func (g ShootConstraints) ConstraintTypes() []gardencorev1beta1.ConditionType {
 		g.maintenancePreconditionsSatisfied.Type,
 		g.caCertificateValiditiesAcceptable.Type,
 		g.crdsWithProblematicConversionWebhooks.Type,
+		g.removedAPIsNotInUse.Type,
 	}
 }
 
@@ -455,5 +558,6 @@ func NewShootConstraints(clock clock.Clock, shoot *gardencorev1beta1.Shoot) Shoo
 		maintenancePreconditionsSatisfied:     v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootMaintenancePreconditionsSatisfied),
 		caCertificateValiditiesAcceptable:     v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootCACertificateValiditiesAcceptable),
 		crdsWithProblematicConversionWebhooks: v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootCRDsWithProblematicConversionWebhooks),
+		removedAPIsNotInUse:                   v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootRemovedAPIsNotInUse),
 	}
 }
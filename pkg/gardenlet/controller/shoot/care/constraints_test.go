@@ -5,14 +5,19 @@
 package care_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"net/http"
 	"strconv"
 	"time"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	dto "github.com/prometheus/client_model/go"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	appsv1beta1 "k8s.io/api/apps/v1beta1"
@@ -34,6 +39,8 @@ import (
 	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+	fakerest "k8s.io/client-go/rest/fake"
 	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	apiregistrationv1beta1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1beta1"
 	"k8s.io/utils/clock"
@@ -447,7 +454,9 @@ var _ = Describe("Constraints", func() {
 			seedClient    client.Client
 			shootClient   client.Client
 
-			constraint *Constraint
+			apiServerMetrics string
+			shoot            *shootpkg.Shoot
+			constraint       *Constraint
 
 			newCASecret = func(validUntilTime time.Time) *corev1.Secret {
 				return &corev1.Secret{
@@ -469,18 +478,42 @@ var _ = Describe("Constraints", func() {
 		BeforeEach(func() {
 			seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
 			shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+			apiServerMetrics = ""
+			shootRESTClient := &fakerest.RESTClient{
+				NegotiatedSerializer: scheme.Codecs,
+				Client: fakerest.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+					if req.URL.Path == "/metrics" {
+						return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader([]byte(apiServerMetrics)))}, nil
+					}
+					return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(&bytes.Buffer{})}, nil
+				}),
+			}
 
-			shoot := &shootpkg.Shoot{
-				SeedNamespace: seedNamespace,
+			shoot = &shootpkg.Shoot{
+				SeedNamespace:     seedNamespace,
+				KubernetesVersion: semver.MustParse("1.30.2"),
+				CloudProfile: &gardencorev1beta1.CloudProfile{
+					Spec: gardencorev1beta1.CloudProfileSpec{
+						Kubernetes: gardencorev1beta1.KubernetesSettings{
+							Versions: []gardencorev1beta1.ExpirableVersion{{Version: "1.30.2"}, {Version: "1.31.0"}},
+						},
+					},
+				},
 			}
 			shoot.SetInfo(&gardencorev1beta1.Shoot{})
 
+			shootClientInit := func() (kubernetes.Interface, bool, error) {
+				return kubernetesfake.NewClientSetBuilder().WithClient(shootClient).WithRESTClient(shootRESTClient).Build(), true, nil
+			}
+
 			constraint = NewConstraint(
 				logr.Discard(),
 				shoot,
 				seedClient,
-				func() (kubernetes.Interface, bool, error) {
-					return kubernetesfake.NewClientSetBuilder().WithClient(shootClient).Build(), true, nil
+				shootClientInit,
+				// scrape the metrics on every call so that tests can change them between checks
+				func() (map[string]*dto.MetricFamily, error) {
+					return NewShootMetricsInit(ctx, shootClientInit)()
 				},
 				clock,
 			)
@@ -494,6 +527,7 @@ var _ = Describe("Constraints", func() {
 							{Type: gardencorev1beta1.ShootHibernationPossible},
 							{Type: gardencorev1beta1.ShootMaintenancePreconditionsSatisfied},
 							{Type: gardencorev1beta1.ShootCRDsWithProblematicConversionWebhooks},
+							{Type: gardencorev1beta1.ShootRemovedAPIsNotInUse},
 						},
 					},
 				}
@@ -560,6 +594,96 @@ var _ = Describe("Constraints", func() {
 					WithMessage(fmt.Sprintf("Some CRDs in your cluster have multiple stored versions present and have a conversion webhook configured: %s.", crd1.Name)),
 				))
 			})
+
+			It("should not keep the `RemovedAPIsNotInUse` condition when it's true", func() {
+				Expect(constraint.Check(ctx, constraints)).NotTo(ContainCondition(
+					OfType(gardencorev1beta1.ShootRemovedAPIsNotInUse),
+				))
+			})
+
+			It("should keep the `RemovedAPIsNotInUse` condition when it's false", func() {
+				apiServerMetrics = `apiserver_requested_deprecated_apis{group="flowcontrol.apiserver.k8s.io",removed_release="1.31",resource="flowschemas",subresource="",version="v1beta3"} 1` + "\n"
+
+				Expect(constraint.Check(ctx, constraints)).To(ContainCondition(
+					OfType(gardencorev1beta1.ShootRemovedAPIsNotInUse),
+					WithStatus(gardencorev1beta1.ConditionProgressing),
+					WithReason("RemovedAPIsInUse"),
+				))
+			})
+		})
+
+		Describe("#CheckIfRemovedAPIsInUse", func() {
+			BeforeEach(func() {
+				// The shoot clients are initialized during the first check.
+				constraint.Check(ctx, NewShootConstraints(clock, &gardencorev1beta1.Shoot{}))
+			})
+
+			It("should return a 'true' condition when no deprecated APIs were requested", func() {
+				apiServerMetrics = `apiserver_request_total{code="200"} 42` + "\n"
+
+				status, reason, message, err := constraint.CheckIfRemovedAPIsInUse()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal(gardencorev1beta1.ConditionTrue))
+				Expect(reason).To(Equal("NoRemovedAPIsInUse"))
+				Expect(message).To(Equal("No APIs which are removed in Kubernetes version 1.31 are in use"))
+			})
+
+			It("should return a 'true' condition when only APIs removed in later versions were requested", func() {
+				apiServerMetrics = `apiserver_requested_deprecated_apis{group="flowcontrol.apiserver.k8s.io",removed_release="1.32",resource="flowschemas",subresource="",version="v1beta3"} 1` + "\n"
+
+				status, _, _, err := constraint.CheckIfRemovedAPIsInUse()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal(gardencorev1beta1.ConditionTrue))
+			})
+
+			It("should return a 'false' condition when APIs removed in the next minor version were requested", func() {
+				apiServerMetrics = `# HELP apiserver_requested_deprecated_apis [STABLE] Gauge of deprecated APIs that have been requested, broken out by API group, version, resource, subresource, and removed_release.
+# TYPE apiserver_requested_deprecated_apis gauge
+apiserver_requested_deprecated_apis{group="flowcontrol.apiserver.k8s.io",removed_release="1.29",resource="flowschemas",subresource="status",version="v1beta2"} 1
+apiserver_requested_deprecated_apis{group="flowcontrol.apiserver.k8s.io",removed_release="1.32",resource="prioritylevelconfigurations",subresource="",version="v1beta3"} 1
+apiserver_requested_deprecated_apis{group="",removed_release="1.31",resource="foos",subresource="",version="v1beta1"} 1
+`
+
+				status, reason, message, err := constraint.CheckIfRemovedAPIsInUse()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal(gardencorev1beta1.ConditionFalse))
+				Expect(reason).To(Equal("RemovedAPIsInUse"))
+				Expect(message).To(Equal("Some APIs which are removed in Kubernetes version 1.31 are still in use: flowschemas/status.v1beta2.flowcontrol.apiserver.k8s.io (removed in 1.29), foos.v1beta1 (removed in 1.31). Please migrate to their successor APIs, otherwise automatic updates to Kubernetes version 1.31 are blocked."))
+			})
+
+			It("should return an error when the metrics cannot be parsed", func() {
+				apiServerMetrics = `apiserver_requested_deprecated_apis{`
+
+				_, _, _, err := constraint.CheckIfRemovedAPIsInUse()
+				Expect(err).To(MatchError(ContainSubstring("could not parse metrics")))
+			})
+
+			It("should return a 'true' condition without scraping the metrics when the next minor version is not offered", func() {
+				shoot.CloudProfile.Spec.Kubernetes.Versions = []gardencorev1beta1.ExpirableVersion{{Version: "1.30.2"}, {Version: "1.32.0"}}
+				apiServerMetrics = `apiserver_requested_deprecated_apis{`
+
+				status, reason, message, err := constraint.CheckIfRemovedAPIsInUse()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal(gardencorev1beta1.ConditionTrue))
+				Expect(reason).To(Equal("NoMinorVersionUpdateAvailable"))
+				Expect(message).To(Equal("Kubernetes version 1.31 is not offered by the CloudProfile, hence removed APIs are not checked"))
+			})
+
+			It("should return an 'unknown' condition when no metrics are available", func() {
+				constraint = NewConstraint(
+					logr.Discard(),
+					shoot,
+					seedClient,
+					func() (kubernetes.Interface, bool, error) { return nil, false, nil },
+					func() (map[string]*dto.MetricFamily, error) { return nil, nil },
+					clock,
+				)
+
+				status, reason, _, err := constraint.CheckIfRemovedAPIsInUse()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal(gardencorev1beta1.ConditionUnknown))
+				Expect(reason).To(Equal("MetricsNotAvailable"))
+			})
 		})
 
 		Describe("#CheckIfCACertificateValiditiesAcceptable", func() {
@@ -616,6 +740,17 @@ var _ = Describe("Constraints", func() {
 				expectFalseCondition(status, reason, message, errorCodes, fmt.Sprintf(`"" (expiring at %s)`, now.String()))
 			})
 
+			It("should consider CA secrets whose private keys are stored in a key store", func() {
+				secret := newCASecret(now)
+				delete(secret.Data, "ca.key")
+				secret.Data["ca.key-ref"] = []byte("shoot--foo--bar/ca-123")
+				Expect(seedClient.Create(ctx, secret)).To(Succeed())
+
+				status, reason, message, errorCodes, err := constraint.CheckIfCACertificateValiditiesAcceptable(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				expectFalseCondition(status, reason, message, errorCodes, fmt.Sprintf(`"" (expiring at %s)`, now.String()))
+			})
+
 			It("should return an error when the valid-until-time label cannot be parsed", func() {
 				secret := newCASecret(now)
 				secret.Labels["valid-until-time"] = "unparseable"
@@ -641,6 +776,7 @@ var _ = Describe("Constraints", func() {
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 				))
 			})
 
@@ -660,6 +796,7 @@ var _ = Describe("Constraints", func() {
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 				))
 			})
 		})
@@ -673,6 +810,7 @@ var _ = Describe("Constraints", func() {
 					OfType("MaintenancePreconditionsSatisfied"),
 					OfType("CACertificateValiditiesAcceptable"),
 					OfType("CRDsWithProblematicConversionWebhooks"),
+					OfType("RemovedAPIsNotInUse"),
 				))
 			})
 		})
@@ -686,6 +824,7 @@ var _ = Describe("Constraints", func() {
 					gardencorev1beta1.ConditionType("MaintenancePreconditionsSatisfied"),
 					gardencorev1beta1.ConditionType("CACertificateValiditiesAcceptable"),
 					gardencorev1beta1.ConditionType("CRDsWithProblematicConversionWebhooks"),
+					gardencorev1beta1.ConditionType("RemovedAPIsNotInUse"),
 				))
 			})
 		})
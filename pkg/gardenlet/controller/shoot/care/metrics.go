@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+// ShootMetricsInit is a function that returns the parsed metric families of a Shoot's kube-apiserver. If the
+// kube-apiserver is not running, no metric families are returned.
+type ShootMetricsInit func() (map[string]*dto.MetricFamily, error)
+
+// NewShootMetricsInit returns a function which scrapes the metrics of the Shoot's kube-apiserver. The metrics are
+// scraped at most once, i.e., all checks of a care run share the same result.
+func NewShootMetricsInit(ctx context.Context, initializeShootClients ShootClientInit) ShootMetricsInit {
+	var (
+		once           sync.Once
+		metricFamilies map[string]*dto.MetricFamily
+		err            error
+	)
+	return func() (map[string]*dto.MetricFamily, error) {
+		once.Do(func() {
+			shootClient, apiServerRunning, initErr := initializeShootClients()
+			if initErr != nil || !apiServerRunning {
+				err = initErr
+				return
+			}
+
+			data, fetchErr := shootClient.RESTClient().Get().AbsPath("/metrics").DoRaw(ctx)
+			if fetchErr != nil {
+				err = fmt.Errorf("could not fetch metrics of the shoot's kube-apiserver: %w", fetchErr)
+				return
+			}
+
+			var parser expfmt.TextParser
+			metricFamilies, err = parser.TextToMetricFamilies(bytes.NewReader(data))
+			if err != nil {
+				metricFamilies, err = nil, fmt.Errorf("could not parse metrics of the shoot's kube-apiserver: %w", err)
+			}
+		})
+		return metricFamilies, err
+	}
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/kubernetes/scheme"
+	fakerest "k8s.io/client-go/rest/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+)
+
+var _ = Describe("ShootMetricsInit", func() {
+	var (
+		ctx = context.Background()
+
+		apiServerMetrics       string
+		apiServerRunning       bool
+		scrapes                int
+		initializeShootClients ShootClientInit
+	)
+
+	BeforeEach(func() {
+		apiServerMetrics = "# TYPE process_start_time_seconds gauge\nprocess_start_time_seconds 1.7129088e+09\n"
+		apiServerRunning = true
+		scrapes = 0
+
+		shootRESTClient := &fakerest.RESTClient{
+			NegotiatedSerializer: scheme.Codecs,
+			Client: fakerest.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+				if req.URL.Path == "/metrics" {
+					scrapes++
+					return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader([]byte(apiServerMetrics)))}, nil
+				}
+				return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(&bytes.Buffer{})}, nil
+			}),
+		}
+		initializeShootClients = func() (kubernetes.Interface, bool, error) {
+			return kubernetesfake.NewClientSetBuilder().WithRESTClient(shootRESTClient).Build(), apiServerRunning, nil
+		}
+	})
+
+	It("should scrape the metrics only once", func() {
+		initializeShootMetrics := NewShootMetricsInit(ctx, initializeShootClients)
+
+		metricFamilies, err := initializeShootMetrics()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(metricFamilies).To(HaveKey("process_start_time_seconds"))
+
+		metricFamilies, err = initializeShootMetrics()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(metricFamilies).To(HaveKey("process_start_time_seconds"))
+		Expect(scrapes).To(Equal(1))
+	})
+
+	It("should return no metrics if the API server is not running", func() {
+		apiServerRunning = false
+
+		metricFamilies, err := NewShootMetricsInit(ctx, initializeShootClients)()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(metricFamilies).To(BeNil())
+		Expect(scrapes).To(BeZero())
+	})
+
+	It("should return an error if the shoot clients cannot be initialized", func() {
+		metricFamilies, err := NewShootMetricsInit(ctx, func() (kubernetes.Interface, bool, error) {
+			return nil, false, fmt.Errorf("fake")
+		})()
+		Expect(err).To(MatchError("fake"))
+		Expect(metricFamilies).To(BeNil())
+	})
+
+	It("should return an error if the metrics cannot be parsed", func() {
+		apiServerMetrics = "process_start_time_seconds{"
+
+		metricFamilies, err := NewShootMetricsInit(ctx, initializeShootClients)()
+		Expect(err).To(MatchError(ContainSubstring("could not parse metrics of the shoot's kube-apiserver")))
+		Expect(metricFamilies).To(BeNil())
+	})
+})
@@ -54,6 +54,7 @@ type Reconciler struct {
 	Identity              *gardencorev1beta1.Gardener
 	GardenClusterIdentity string
 	SeedName              string
+	UserActivityTracker   *UserActivityTracker
 
 	gardenSecrets map[string]*corev1.Secret
 }
@@ -128,6 +129,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	var (
 		staleExtensionHealthCheckThreshold    = gardenlethelper.StaleExtensionHealthChecksThreshold(r.Config.Controllers.ShootCare.StaleExtensionHealthChecks)
 		initializeShootClients                = shootClientInitializer(careCtx, o)
+		initializeShootMetrics                = NewShootMetricsInit(careCtx, initializeShootClients)
 		updatedConditions, updatedConstraints []gardencorev1beta1.Condition
 	)
 
@@ -158,6 +160,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 				o.Shoot,
 				r.SeedClientSet.Client(),
 				initializeShootClients,
+				initializeShootMetrics,
 				clock.RealClock{},
 			).Check(
 				ctx,
@@ -179,6 +182,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			}
 			return nil
 		},
+		// Track user activity
+		func(ctx context.Context) error {
+			if r.UserActivityTracker != nil && !v1beta1helper.HibernationIsEnabled(shoot) {
+				if err := r.UserActivityTracker.Track(ctx, r.GardenClient, shoot, initializeShootMetrics); err != nil {
+					// errors during user activity tracking are only being logged and do not cause the care operation to fail
+					log.Error(err, "Failed tracking user activity")
+				}
+			}
+			return nil
+		},
 	)(careCtx); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -536,6 +536,7 @@ func constraintCheckFunc(fn resultingConstraintFunc) NewConstraintCheckFunc {
 		_ *shootpkg.Shoot,
 		_ client.Client,
 		_ ShootClientInit,
+		_ ShootMetricsInit,
 		_ clock.Clock,
 	) ConstraintCheck {
 		return fn
@@ -629,5 +630,10 @@ func consistOfConstraintsInUnknownStatus(message string) types.GomegaMatcher {
 			"Status":  Equal(gardencorev1beta1.ConditionUnknown),
 			"Message": Equal(message),
 		}),
+		MatchFields(IgnoreExtras, Fields{
+			"Type":    Equal(gardencorev1beta1.ShootRemovedAPIsNotInUse),
+			"Status":  Equal(gardencorev1beta1.ConditionUnknown),
+			"Message": Equal(message),
+		}),
 	)
 }
@@ -77,6 +77,7 @@ type NewConstraintCheckFunc func(
 	shoot *shoot.Shoot,
 	seedClient client.Client,
 	shootClientInit ShootClientInit,
+	shootMetricsInit ShootMetricsInit,
 	clock clock.Clock,
 ) ConstraintCheck
 
@@ -86,6 +87,7 @@ var defaultNewConstraintCheck = func(
 	shoot *shoot.Shoot,
 	seedClient client.Client,
 	shootClientInit ShootClientInit,
+	shootMetricsInit ShootMetricsInit,
 	clock clock.Clock,
 ) ConstraintCheck {
 	return NewConstraint(
@@ -93,6 +95,7 @@ var defaultNewConstraintCheck = func(
 		shoot,
 		seedClient,
 		shootClientInit,
+		shootMetricsInit,
 		clock,
 	)
 }
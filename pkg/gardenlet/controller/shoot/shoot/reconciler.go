@@ -500,6 +500,34 @@ func (r *Reconciler) newProgressReporter(reporterFn flow.ProgressReporterFn) flo
 	return flow.NewImmediateProgressReporter(reporterFn)
 }
 
+// flowTracer returns a tracer which records the traces of all tasks of a flow execution in the given recorder and
+// additionally logs them on debug level. It also logs the graph of the flow in the DOT and Mermaid format on debug
+// level so that the traces can be related to the dependencies of the tasks.
+func flowTracer(log logr.Logger, f *flow.Flow, recorder *flow.TraceRecorder) flow.Tracer {
+	if debugLog := log.V(1); debugLog.Enabled() {
+		debugLog.Info("Graph of flow", "flow", f.Name(), "dot", f.DOT(), "mermaid", f.Mermaid())
+		return flow.Tracers{recorder, flow.NewLogTracer(debugLog)}
+	}
+	return recorder
+}
+
+// logCriticalPath logs the chain of tasks which determined the duration of the flow execution in order to make slow
+// steps visible.
+func logCriticalPath(log logr.Logger, f *flow.Flow, tracer *flow.TraceRecorder) {
+	criticalPath := f.CriticalPath(tracer.Traces())
+	if len(criticalPath) == 0 {
+		return
+	}
+
+	tasks := make([]string, 0, len(criticalPath))
+	for _, trace := range criticalPath {
+		tasks = append(tasks, trace.String())
+	}
+
+	first, last := criticalPath[0], criticalPath[len(criticalPath)-1]
+	log.Info("Critical path of flow", "flow", f.Name(), "duration", last.End().Sub(first.Start).Round(time.Millisecond), "tasks", strings.Join(tasks, " -> "))
+}
+
 func (r *Reconciler) updateShootStatusOperationStart(
 	ctx context.Context,
 	shoot *gardencorev1beta1.Shoot,
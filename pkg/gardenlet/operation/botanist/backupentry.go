@@ -14,6 +14,7 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	corebackupentry "github.com/gardener/gardener/pkg/component/garden/backupentry"
 )
 
@@ -26,12 +27,13 @@ func (b *Botanist) DefaultCoreBackupEntry() corebackupentry.Interface {
 		b.Logger,
 		b.GardenClient,
 		&corebackupentry.Values{
-			Namespace:      b.Shoot.GetInfo().Namespace,
-			Name:           b.Shoot.BackupEntryName,
-			ShootPurpose:   b.Shoot.GetInfo().Spec.Purpose,
-			OwnerReference: ownerRef,
-			SeedName:       b.Shoot.GetInfo().Spec.SeedName,
-			BucketName:     string(b.Seed.GetInfo().UID),
+			Namespace:       b.Shoot.GetInfo().Namespace,
+			Name:            b.Shoot.BackupEntryName,
+			ShootPurpose:    b.Shoot.GetInfo().Spec.Purpose,
+			OwnerReference:  ownerRef,
+			SeedName:        b.Shoot.GetInfo().Spec.SeedName,
+			BucketName:      string(b.Seed.GetInfo().UID),
+			RetentionPolicy: v1beta1helper.EffectiveBackupRetentionPolicy(b.Garden.Project, b.Shoot.GetInfo()),
 		},
 		corebackupentry.DefaultInterval,
 		corebackupentry.DefaultTimeout,
@@ -64,6 +64,7 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 		secretsmanager.Config{
 			CASecretAutoRotation: false,
 			SecretNamesToTimes:   b.lastSecretRotationStartTimes(),
+			KeyStore:             b.caPrivateKeyStore(),
 		},
 	)
 	if err != nil {
@@ -101,11 +101,15 @@ func (b *Botanist) DeployEtcd(ctx context.Context) error {
 		var (
 			backupLeaderElection         *config.ETCDBackupLeaderElection
 			deltaSnapshotRetentionPeriod *metav1.Duration
+			fullSnapshotsToKeep          *int32
 		)
 		if b.Config != nil && b.Config.ETCDConfig != nil {
 			backupLeaderElection = b.Config.ETCDConfig.BackupLeaderElection
 			deltaSnapshotRetentionPeriod = b.Config.ETCDConfig.DeltaSnapshotRetentionPeriod
 		}
+		if policy := v1beta1helper.EffectiveBackupRetentionPolicy(b.Garden.Project, b.Shoot.GetInfo()); policy != nil {
+			fullSnapshotsToKeep = policy.FullSnapshotsToKeep
+		}
 
 		b.Shoot.Components.ControlPlane.EtcdMain.SetBackupConfig(&etcd.BackupConfig{
 			Provider:                     b.Seed.GetInfo().Spec.Backup.Provider,
@@ -115,6 +119,7 @@ func (b *Botanist) DeployEtcd(ctx context.Context) error {
 			FullSnapshotSchedule:         snapshotSchedule,
 			LeaderElection:               backupLeaderElection,
 			DeltaSnapshotRetentionPeriod: deltaSnapshotRetentionPeriod,
+			FullSnapshotsToKeep:          fullSnapshotsToKeep,
 		})
 	}
 
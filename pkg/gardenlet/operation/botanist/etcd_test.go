@@ -40,6 +40,7 @@ import (
 	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/garden"
 	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -340,7 +341,7 @@ var _ = Describe("Etcd", func() {
 						},
 					)
 				}
-				expectSetBackupConfig = func() {
+				expectSetBackupConfigWithFullSnapshotsToKeep = func(fullSnapshotsToKeep *int32) {
 					etcdMain.EXPECT().SetBackupConfig(&etcd.BackupConfig{
 						Provider:             backupProvider,
 						SecretRefName:        "etcd-backup",
@@ -348,8 +349,12 @@ var _ = Describe("Etcd", func() {
 						Container:            bucketName,
 						FullSnapshotSchedule: "1 12 * * *",
 						LeaderElection:       backupLeaderElectionConfig,
+						FullSnapshotsToKeep:  fullSnapshotsToKeep,
 					})
 				}
+				expectSetBackupConfig = func() {
+					expectSetBackupConfigWithFullSnapshotsToKeep(nil)
+				}
 			)
 
 			BeforeEach(func() {
@@ -361,6 +366,7 @@ var _ = Describe("Etcd", func() {
 						BackupLeaderElection: backupLeaderElectionConfig,
 					},
 				}
+				botanist.Garden = &garden.Garden{Project: &gardencorev1beta1.Project{}}
 			})
 
 			It("should set secrets and deploy", func() {
@@ -380,6 +386,17 @@ var _ = Describe("Etcd", func() {
 				Expect(botanist.DeployEtcd(ctx)).To(Succeed())
 			})
 
+			It("should configure the number of full snapshots to keep from the backup retention policy", func() {
+				botanist.Garden.Project.Spec.BackupRetentionPolicy = &gardencorev1beta1.BackupRetentionPolicy{FullSnapshotsToKeep: ptr.To[int32](7)}
+
+				expectGetBackupSecret()
+				expectSetBackupConfigWithFullSnapshotsToKeep(ptr.To[int32](7))
+				etcdMain.EXPECT().Deploy(ctx)
+				etcdEvents.EXPECT().Deploy(ctx)
+
+				Expect(botanist.DeployEtcd(ctx)).To(Succeed())
+			})
+
 			It("should fail when reading the backup secret fails", func() {
 				c.EXPECT().Get(ctx, kubernetesutils.Key(namespace, "etcd-backup"), gomock.AssignableToTypeOf(&corev1.Secret{})).Return(fakeErr)
 
@@ -74,17 +74,19 @@ func (b *Botanist) DefaultOperatingSystemConfig() (operatingsystemconfig.Interfa
 			KubernetesVersion: b.Shoot.KubernetesVersion,
 			Workers:           b.Shoot.GetInfo().Spec.Provider.Workers,
 			OriginalValues: operatingsystemconfig.OriginalValues{
-				ClusterDNSAddress:   clusterDNSAddress,
-				ClusterDomain:       gardencorev1beta1.DefaultDomain,
-				Images:              oscImages,
-				KubeletConfig:       b.Shoot.GetInfo().Spec.Kubernetes.Kubelet,
-				MachineTypes:        b.Shoot.CloudProfile.Spec.MachineTypes,
-				SSHAccessEnabled:    v1beta1helper.ShootEnablesSSHAccess(b.Shoot.GetInfo()),
-				ValitailEnabled:     valitailEnabled,
-				ValiIngressHostName: valiIngressHost,
-				NodeLocalDNSEnabled: v1beta1helper.IsNodeLocalDNSEnabled(b.Shoot.GetInfo().Spec.SystemComponents),
-				SyncJitterPeriod:    b.Shoot.OSCSyncJitterPeriod,
-				PrimaryIPFamily:     b.Shoot.GetInfo().Spec.Networking.IPFamilies[0],
+				ClusterDNSAddress:              clusterDNSAddress,
+				ClusterDomain:                  gardencorev1beta1.DefaultDomain,
+				Images:                         oscImages,
+				KubeletConfig:                  b.Shoot.GetInfo().Spec.Kubernetes.Kubelet,
+				MachineTypes:                   b.Shoot.CloudProfile.Spec.MachineTypes,
+				SSHAccessEnabled:               v1beta1helper.ShootEnablesSSHAccess(b.Shoot.GetInfo()),
+				ValitailEnabled:                valitailEnabled,
+				ValiIngressHostName:            valiIngressHost,
+				NodeLocalDNSEnabled:            v1beta1helper.IsNodeLocalDNSEnabled(b.Shoot.GetInfo().Spec.SystemComponents),
+				SyncJitterPeriod:               b.Shoot.OSCSyncJitterPeriod,
+				MaxConcurrentDisruptiveUpdates: b.Shoot.OSCMaxConcurrentDisruptiveUpdates,
+				RollbackUnhealthyThreshold:     b.Shoot.OSCRollbackUnhealthyThreshold,
+				PrimaryIPFamily:                b.Shoot.GetInfo().Spec.Networking.IPFamilies[0],
 			},
 		},
 		operatingsystemconfig.DefaultInterval,
@@ -66,6 +66,13 @@ func (b *Botanist) InitializeSecretsManagement(ctx context.Context) error {
 	return flow.Sequential(taskFns...)(ctx)
 }
 
+func (b *Botanist) caPrivateKeyStore() secretsmanager.KeyStore {
+	if b.Config == nil || b.Config.SecretsManager == nil || b.Config.SecretsManager.CAPrivateKeyStore == nil {
+		return nil
+	}
+	return &secretsmanager.FileKeyStore{Directory: b.Config.SecretsManager.CAPrivateKeyStore.Directory}
+}
+
 func (b *Botanist) lastSecretRotationStartTimes() map[string]time.Time {
 	rotation := make(map[string]time.Time)
 
@@ -175,6 +182,13 @@ func (b *Botanist) caCertGenerateOptionsFor(configName string) []secretsmanager.
 		options = append(options, secretsmanager.IgnoreOldSecrets())
 	}
 
+	// The private keys of the client and kubelet CAs are read from the secrets by kube-controller-manager for signing
+	// certificates. The private key of the client CA is also read by gardener-apiserver for issuing admin and viewer
+	// kubeconfigs. Hence, they must be kept in the secrets even if a key store is configured.
+	if configName == v1beta1constants.SecretNameCAClient || configName == v1beta1constants.SecretNameCAKubelet {
+		options = append(options, secretsmanager.KeepPrivateKeyInSecret())
+	}
+
 	if configName == v1beta1constants.SecretNameCAClient {
 		return options
 	}
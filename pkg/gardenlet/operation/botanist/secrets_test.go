@@ -15,6 +15,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -135,6 +136,32 @@ var _ = Describe("Secrets", func() {
 				Expect(internalSecret.Data).To(And(HaveKey("ca.crt"), HaveKey("ca.key")))
 			})
 
+			It("should keep the private keys of the client and kubelet CAs in the secrets if a key store is configured", func() {
+				var err error
+				botanist.SecretsManager, err = secretsmanager.New(ctx, logr.Discard(), clock.RealClock{}, seedClient, seedNamespace, "gardenlet", secretsmanager.Config{
+					KeyStore: &secretsmanager.FileKeyStore{Directory: GinkgoT().TempDir()},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(botanist.InitializeSecretsManagement(ctx)).To(Succeed())
+
+				for _, name := range caSecretNames {
+					secretList := &corev1.SecretList{}
+					Expect(seedClient.List(ctx, secretList, client.InNamespace(seedNamespace), client.MatchingLabels{"name": name})).To(Succeed())
+					Expect(secretList.Items).To(HaveLen(1), name)
+
+					if name == "ca-client" || name == "ca-kubelet" {
+						Expect(secretList.Items[0].Data).To(And(HaveKey("ca.key"), Not(HaveKey("ca.key-ref"))), name)
+					} else {
+						Expect(secretList.Items[0].Data).To(And(Not(HaveKey("ca.key")), HaveKey("ca.key-ref")), name)
+					}
+				}
+
+				internalSecret := &gardencorev1beta1.InternalSecret{}
+				Expect(gardenClient.Get(ctx, kubernetesutils.Key(gardenNamespace, shootName+".ca-client"), internalSecret)).To(Succeed())
+				Expect(internalSecret.Data).To(And(HaveKey("ca.crt"), HaveKey("ca.key")))
+			})
+
 			It("should generate the generic token kubeconfig", func() {
 				Expect(botanist.InitializeSecretsManagement(ctx)).To(Succeed())
 
@@ -16,6 +16,7 @@ import (
 	"github.com/Masterminds/semver/v3"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -295,6 +296,22 @@ func (b *Builder) Build(ctx context.Context, c client.Reader) (*Shoot, error) {
 	}
 	shoot.OSCSyncJitterPeriod = &metav1.Duration{Duration: time.Duration(oscSyncJitterPeriod) * time.Second}
 
+	if v, ok := shootObject.Annotations[v1beta1constants.AnnotationShootNodeAgentMaxConcurrentDisruptiveUpdates]; ok {
+		maxConcurrentDisruptiveUpdates := intstr.Parse(v)
+		if _, err := intstr.GetScaledValueFromIntOrPercent(&maxConcurrentDisruptiveUpdates, 1, false); err != nil {
+			return nil, fmt.Errorf("invalid value for annotation %s: %w", v1beta1constants.AnnotationShootNodeAgentMaxConcurrentDisruptiveUpdates, err)
+		}
+		shoot.OSCMaxConcurrentDisruptiveUpdates = &maxConcurrentDisruptiveUpdates
+	}
+
+	if v, ok := shootObject.Annotations[v1beta1constants.AnnotationShootNodeAgentRollbackUnhealthyThreshold]; ok {
+		rollbackUnhealthyThreshold, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for annotation %s: %w", v1beta1constants.AnnotationShootNodeAgentRollbackUnhealthyThreshold, err)
+		}
+		shoot.OSCRollbackUnhealthyThreshold = &metav1.Duration{Duration: rollbackUnhealthyThreshold}
+	}
+
 	if lastOperation := shootObject.Status.LastOperation; lastOperation != nil &&
 		lastOperation.Type == gardencorev1beta1.LastOperationTypeRestore &&
 		lastOperation.State != gardencorev1beta1.LastOperationStateSucceeded {
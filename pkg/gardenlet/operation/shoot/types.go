@@ -13,6 +13,7 @@ import (
 	"github.com/Masterminds/semver/v3"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/component"
@@ -99,6 +100,8 @@ type Shoot struct {
 	Networks                                *Networks
 	BackupEntryName                         string
 	OSCSyncJitterPeriod                     *metav1.Duration
+	OSCMaxConcurrentDisruptiveUpdates       *intstr.IntOrString
+	OSCRollbackUnhealthyThreshold           *metav1.Duration
 	ResourcesToEncrypt                      []string
 	EncryptedResources                      []string
 	ServiceAccountIssuerHostname            *string
@@ -7,6 +7,7 @@ package config
 import (
 	"github.com/Masterminds/semver/v3"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	componentbaseconfig "k8s.io/component-base/config"
 )
 
@@ -75,6 +76,31 @@ type OperatingSystemConfigControllerConfig struct {
 	// KubernetesVersion contains the Kubernetes version of the kubelet, used for annotating the corresponding node
 	// resource with a kubernetes version annotation.
 	KubernetesVersion *semver.Version
+	// DisruptiveUpdates contains the configuration for coordinating disruptive updates (i.e., changes which require
+	// the restart or removal of systemd units) across the nodes of a worker pool. If not set, each node applies such
+	// changes as soon as it observes them.
+	DisruptiveUpdates *DisruptiveUpdatesConfig
+	// Rollback contains the configuration for restoring the last-known-good operating system config in case the node
+	// does not become healthy after an update. If not set, no rollback is performed.
+	Rollback *RollbackConfig
+}
+
+// RollbackConfig contains the configuration for restoring the last-known-good operating system config.
+type RollbackConfig struct {
+	// UnhealthyThreshold is the duration for which kubelet or containerd must stay unhealthy after an update of the
+	// operating system config before the last-known-good operating system config is restored. Defaults to 5m.
+	UnhealthyThreshold *metav1.Duration
+}
+
+// DisruptiveUpdatesConfig contains the configuration for coordinating disruptive updates across the nodes of a worker
+// pool.
+type DisruptiveUpdatesConfig struct {
+	// MaxConcurrentNodes is the maximum number (or percentage) of nodes of a worker pool which may apply disruptive
+	// updates at the same time. Percentages are rounded down, but at least one node is always allowed. Defaults to 1.
+	MaxConcurrentNodes *intstr.IntOrString
+	// SlotTimeout is the duration after which a rollout slot which was not renewed by its holder is considered stale
+	// and can be taken over by another node. Defaults to 10m.
+	SlotTimeout *metav1.Duration
 }
 
 // TokenControllerConfig defines the configuration of the access token controller.
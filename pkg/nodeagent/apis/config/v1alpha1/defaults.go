@@ -9,7 +9,9 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/logger"
 )
@@ -39,6 +41,24 @@ func SetDefaults_OperatingSystemConfigControllerConfig(obj *OperatingSystemConfi
 	}
 }
 
+// SetDefaults_DisruptiveUpdatesConfig sets defaults for the DisruptiveUpdatesConfig object.
+func SetDefaults_DisruptiveUpdatesConfig(obj *DisruptiveUpdatesConfig) {
+	if obj.MaxConcurrentNodes == nil {
+		obj.MaxConcurrentNodes = ptr.To(intstr.FromInt32(1))
+	}
+
+	if obj.SlotTimeout == nil {
+		obj.SlotTimeout = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+}
+
+// SetDefaults_RollbackConfig sets defaults for the RollbackConfig object.
+func SetDefaults_RollbackConfig(obj *RollbackConfig) {
+	if obj.UnhealthyThreshold == nil {
+		obj.UnhealthyThreshold = &metav1.Duration{Duration: 5 * time.Minute}
+	}
+}
+
 // SetDefaults_TokenControllerConfig sets defaults for the TokenControllerConfig object.
 func SetDefaults_TokenControllerConfig(obj *TokenControllerConfig) {
 	if obj.SyncPeriod == nil {
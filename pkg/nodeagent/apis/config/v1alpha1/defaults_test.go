@@ -11,6 +11,8 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/logger"
 	. "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
@@ -68,6 +70,47 @@ var _ = Describe("Defaults", func() {
 					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
 					Expect(obj.SyncJitterPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
 				})
+
+				Describe("Disruptive updates", func() {
+					It("should default the object", func() {
+						obj := &DisruptiveUpdatesConfig{}
+
+						SetDefaults_DisruptiveUpdatesConfig(obj)
+
+						Expect(obj.MaxConcurrentNodes).To(PointTo(Equal(intstr.FromInt32(1))))
+						Expect(obj.SlotTimeout).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Minute})))
+					})
+
+					It("should not overwrite existing values", func() {
+						obj := &DisruptiveUpdatesConfig{
+							MaxConcurrentNodes: ptr.To(intstr.FromString("25%")),
+							SlotTimeout:        &metav1.Duration{Duration: time.Hour},
+						}
+
+						SetDefaults_DisruptiveUpdatesConfig(obj)
+
+						Expect(obj.MaxConcurrentNodes).To(PointTo(Equal(intstr.FromString("25%"))))
+						Expect(obj.SlotTimeout).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+					})
+				})
+
+				Describe("Rollback", func() {
+					It("should default the object", func() {
+						obj := &RollbackConfig{}
+
+						SetDefaults_RollbackConfig(obj)
+
+						Expect(obj.UnhealthyThreshold).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Minute})))
+					})
+
+					It("should not overwrite existing values", func() {
+						obj := &RollbackConfig{UnhealthyThreshold: &metav1.Duration{Duration: time.Hour}}
+
+						SetDefaults_RollbackConfig(obj)
+
+						Expect(obj.UnhealthyThreshold).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+					})
+				})
 			})
 
 			Describe("Token controller", func() {
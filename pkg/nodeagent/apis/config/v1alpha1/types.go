@@ -7,6 +7,7 @@ package v1alpha1
 import (
 	"github.com/Masterminds/semver/v3"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
 
@@ -43,6 +44,12 @@ const (
 	// AnnotationKeyChecksumAppliedOperatingSystemConfig is a constant for an annotation key on a Node describing the
 	// checksum of the last applied operating system configuration.
 	AnnotationKeyChecksumAppliedOperatingSystemConfig = "checksum/cloud-config-data"
+	// AnnotationKeyLastDisruptiveUpdate is a constant for an annotation key on a Node describing the time (RFC3339Nano
+	// format) of the last disruptive update of the operating system configuration.
+	AnnotationKeyLastDisruptiveUpdate = "node-agent.gardener.cloud/last-disruptive-update"
+	// NodeConditionOperatingSystemConfigRolledBack is a constant for a condition type on a Node describing whether the
+	// last applied operating system configuration was rolled back because the node did not become healthy.
+	NodeConditionOperatingSystemConfigRolledBack = "OperatingSystemConfigRolledBack"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -116,6 +123,36 @@ type OperatingSystemConfigControllerConfig struct {
 	// KubernetesVersion contains the Kubernetes version of the kubelet, used for annotating the corresponding node
 	// resource with a kubernetes version annotation.
 	KubernetesVersion *semver.Version `json:"kubernetesVersion"`
+	// DisruptiveUpdates contains the configuration for coordinating disruptive updates (i.e., changes which require
+	// the restart or removal of systemd units) across the nodes of a worker pool. If not set, each node applies such
+	// changes as soon as it observes them.
+	// +optional
+	DisruptiveUpdates *DisruptiveUpdatesConfig `json:"disruptiveUpdates,omitempty"`
+	// Rollback contains the configuration for restoring the last-known-good operating system config in case the node
+	// does not become healthy after an update. If not set, no rollback is performed.
+	// +optional
+	Rollback *RollbackConfig `json:"rollback,omitempty"`
+}
+
+// RollbackConfig contains the configuration for restoring the last-known-good operating system config.
+type RollbackConfig struct {
+	// UnhealthyThreshold is the duration for which kubelet or containerd must stay unhealthy after an update of the
+	// operating system config before the last-known-good operating system config is restored. Defaults to 5m.
+	// +optional
+	UnhealthyThreshold *metav1.Duration `json:"unhealthyThreshold,omitempty"`
+}
+
+// DisruptiveUpdatesConfig contains the configuration for coordinating disruptive updates across the nodes of a worker
+// pool.
+type DisruptiveUpdatesConfig struct {
+	// MaxConcurrentNodes is the maximum number (or percentage) of nodes of a worker pool which may apply disruptive
+	// updates at the same time. Percentages are rounded down, but at least one node is always allowed. Defaults to 1.
+	// +optional
+	MaxConcurrentNodes *intstr.IntOrString `json:"maxConcurrentNodes,omitempty"`
+	// SlotTimeout is the duration after which a rollout slot which was not renewed by its holder is considered stale
+	// and can be taken over by another node. Defaults to 10m.
+	// +optional
+	SlotTimeout *metav1.Duration `json:"slotTimeout,omitempty"`
 }
 
 // TokenControllerConfig defines the configuration of the access token controller.
@@ -17,6 +17,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 	componentbaseconfig "k8s.io/component-base/config"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
@@ -58,6 +59,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DisruptiveUpdatesConfig)(nil), (*config.DisruptiveUpdatesConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DisruptiveUpdatesConfig_To_config_DisruptiveUpdatesConfig(a.(*DisruptiveUpdatesConfig), b.(*config.DisruptiveUpdatesConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.DisruptiveUpdatesConfig)(nil), (*DisruptiveUpdatesConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_DisruptiveUpdatesConfig_To_v1alpha1_DisruptiveUpdatesConfig(a.(*config.DisruptiveUpdatesConfig), b.(*DisruptiveUpdatesConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NodeAgentConfiguration)(nil), (*config.NodeAgentConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NodeAgentConfiguration_To_config_NodeAgentConfiguration(a.(*NodeAgentConfiguration), b.(*config.NodeAgentConfiguration), scope)
 	}); err != nil {
@@ -78,6 +89,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RollbackConfig)(nil), (*config.RollbackConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RollbackConfig_To_config_RollbackConfig(a.(*RollbackConfig), b.(*config.RollbackConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.RollbackConfig)(nil), (*RollbackConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_RollbackConfig_To_v1alpha1_RollbackConfig(a.(*config.RollbackConfig), b.(*RollbackConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Server)(nil), (*config.Server)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Server_To_config_Server(a.(*Server), b.(*config.Server), scope)
 	}); err != nil {
@@ -193,6 +214,28 @@ func Convert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(
 	return autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_DisruptiveUpdatesConfig_To_config_DisruptiveUpdatesConfig(in *DisruptiveUpdatesConfig, out *config.DisruptiveUpdatesConfig, s conversion.Scope) error {
+	out.MaxConcurrentNodes = (*intstr.IntOrString)(unsafe.Pointer(in.MaxConcurrentNodes))
+	out.SlotTimeout = (*v1.Duration)(unsafe.Pointer(in.SlotTimeout))
+	return nil
+}
+
+// Convert_v1alpha1_DisruptiveUpdatesConfig_To_config_DisruptiveUpdatesConfig is an autogenerated conversion function.
+func Convert_v1alpha1_DisruptiveUpdatesConfig_To_config_DisruptiveUpdatesConfig(in *DisruptiveUpdatesConfig, out *config.DisruptiveUpdatesConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DisruptiveUpdatesConfig_To_config_DisruptiveUpdatesConfig(in, out, s)
+}
+
+func autoConvert_config_DisruptiveUpdatesConfig_To_v1alpha1_DisruptiveUpdatesConfig(in *config.DisruptiveUpdatesConfig, out *DisruptiveUpdatesConfig, s conversion.Scope) error {
+	out.MaxConcurrentNodes = (*intstr.IntOrString)(unsafe.Pointer(in.MaxConcurrentNodes))
+	out.SlotTimeout = (*v1.Duration)(unsafe.Pointer(in.SlotTimeout))
+	return nil
+}
+
+// Convert_config_DisruptiveUpdatesConfig_To_v1alpha1_DisruptiveUpdatesConfig is an autogenerated conversion function.
+func Convert_config_DisruptiveUpdatesConfig_To_v1alpha1_DisruptiveUpdatesConfig(in *config.DisruptiveUpdatesConfig, out *DisruptiveUpdatesConfig, s conversion.Scope) error {
+	return autoConvert_config_DisruptiveUpdatesConfig_To_v1alpha1_DisruptiveUpdatesConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_NodeAgentConfiguration_To_config_NodeAgentConfiguration(in *NodeAgentConfiguration, out *config.NodeAgentConfiguration, s conversion.Scope) error {
 	if err := configv1alpha1.Convert_v1alpha1_ClientConnectionConfiguration_To_config_ClientConnectionConfiguration(&in.ClientConnection, &out.ClientConnection, s); err != nil {
 		return err
@@ -266,6 +309,8 @@ func autoConvert_v1alpha1_OperatingSystemConfigControllerConfig_To_config_Operat
 	out.SyncJitterPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncJitterPeriod))
 	out.SecretName = in.SecretName
 	out.KubernetesVersion = (*v3.Version)(unsafe.Pointer(in.KubernetesVersion))
+	out.DisruptiveUpdates = (*config.DisruptiveUpdatesConfig)(unsafe.Pointer(in.DisruptiveUpdates))
+	out.Rollback = (*config.RollbackConfig)(unsafe.Pointer(in.Rollback))
 	return nil
 }
 
@@ -279,6 +324,8 @@ func autoConvert_config_OperatingSystemConfigControllerConfig_To_v1alpha1_Operat
 	out.SyncJitterPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncJitterPeriod))
 	out.SecretName = in.SecretName
 	out.KubernetesVersion = (*v3.Version)(unsafe.Pointer(in.KubernetesVersion))
+	out.DisruptiveUpdates = (*DisruptiveUpdatesConfig)(unsafe.Pointer(in.DisruptiveUpdates))
+	out.Rollback = (*RollbackConfig)(unsafe.Pointer(in.Rollback))
 	return nil
 }
 
@@ -287,6 +334,26 @@ func Convert_config_OperatingSystemConfigControllerConfig_To_v1alpha1_OperatingS
 	return autoConvert_config_OperatingSystemConfigControllerConfig_To_v1alpha1_OperatingSystemConfigControllerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_RollbackConfig_To_config_RollbackConfig(in *RollbackConfig, out *config.RollbackConfig, s conversion.Scope) error {
+	out.UnhealthyThreshold = (*v1.Duration)(unsafe.Pointer(in.UnhealthyThreshold))
+	return nil
+}
+
+// Convert_v1alpha1_RollbackConfig_To_config_RollbackConfig is an autogenerated conversion function.
+func Convert_v1alpha1_RollbackConfig_To_config_RollbackConfig(in *RollbackConfig, out *config.RollbackConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RollbackConfig_To_config_RollbackConfig(in, out, s)
+}
+
+func autoConvert_config_RollbackConfig_To_v1alpha1_RollbackConfig(in *config.RollbackConfig, out *RollbackConfig, s conversion.Scope) error {
+	out.UnhealthyThreshold = (*v1.Duration)(unsafe.Pointer(in.UnhealthyThreshold))
+	return nil
+}
+
+// Convert_config_RollbackConfig_To_v1alpha1_RollbackConfig is an autogenerated conversion function.
+func Convert_config_RollbackConfig_To_v1alpha1_RollbackConfig(in *config.RollbackConfig, out *RollbackConfig, s conversion.Scope) error {
+	return autoConvert_config_RollbackConfig_To_v1alpha1_RollbackConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_Server_To_config_Server(in *Server, out *config.Server, s conversion.Scope) error {
 	out.BindAddress = in.BindAddress
 	out.Port = in.Port
@@ -26,5 +26,11 @@ func SetObjectDefaults_NodeAgentConfiguration(in *NodeAgentConfiguration) {
 	SetDefaults_ClientConnectionConfiguration(&in.ClientConnection)
 	SetDefaults_ServerConfiguration(&in.Server)
 	SetDefaults_OperatingSystemConfigControllerConfig(&in.Controllers.OperatingSystemConfig)
+	if in.Controllers.OperatingSystemConfig.DisruptiveUpdates != nil {
+		SetDefaults_DisruptiveUpdatesConfig(in.Controllers.OperatingSystemConfig.DisruptiveUpdates)
+	}
+	if in.Controllers.OperatingSystemConfig.Rollback != nil {
+		SetDefaults_RollbackConfig(in.Controllers.OperatingSystemConfig.Rollback)
+	}
 	SetDefaults_TokenControllerConfig(&in.Controllers.Token)
 }
@@ -5,9 +5,12 @@
 package validation
 
 import (
+	"strconv"
+	"strings"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
@@ -65,6 +68,52 @@ func validateOperatingSystemConfigControllerConfiguration(conf config.OperatingS
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("kubernetesVersion"), conf.KubernetesVersion, err.Error()))
 	}
 
+	if conf.DisruptiveUpdates != nil {
+		allErrs = append(allErrs, validateDisruptiveUpdatesConfig(conf.DisruptiveUpdates, fldPath.Child("disruptiveUpdates"))...)
+	}
+
+	if conf.Rollback != nil {
+		if threshold := conf.Rollback.UnhealthyThreshold; threshold == nil || threshold.Duration < time.Minute {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("rollback", "unhealthyThreshold"), threshold, "must be at least 1m"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateDisruptiveUpdatesConfig(conf *config.DisruptiveUpdatesConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.MaxConcurrentNodes == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("maxConcurrentNodes"), "must provide the maximum number of concurrent nodes"))
+	} else {
+		allErrs = append(allErrs, validatePositiveIntOrPercent(*conf.MaxConcurrentNodes, fldPath.Child("maxConcurrentNodes"))...)
+	}
+
+	if conf.SlotTimeout == nil || conf.SlotTimeout.Duration < time.Minute {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("slotTimeout"), conf.SlotTimeout, "must be at least 1m"))
+	}
+
+	return allErrs
+}
+
+func validatePositiveIntOrPercent(value intstr.IntOrString, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch value.Type {
+	case intstr.Int:
+		if value.IntValue() <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath, value.String(), "must be greater than 0"))
+		}
+	case intstr.String:
+		percent, err := strconv.Atoi(strings.TrimSuffix(value.StrVal, "%"))
+		if err != nil || !strings.HasSuffix(value.StrVal, "%") {
+			allErrs = append(allErrs, field.Invalid(fldPath, value.StrVal, "must be an integer or a percentage (e.g. '25%')"))
+		} else if percent <= 0 || percent > 100 {
+			allErrs = append(allErrs, field.Invalid(fldPath, value.StrVal, "must be a percentage between 1% and 100%"))
+		}
+	}
+
 	return allErrs
 }
 
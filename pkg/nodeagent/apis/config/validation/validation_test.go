@@ -12,7 +12,9 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	. "github.com/gardener/gardener/pkg/nodeagent/apis/config"
 	. "github.com/gardener/gardener/pkg/nodeagent/apis/config/validation"
@@ -77,6 +79,78 @@ var _ = Describe("#ValidateNodeAgentConfiguration", func() {
 				})),
 			))
 		})
+
+		Context("disruptive updates", func() {
+			BeforeEach(func() {
+				config.Controllers.OperatingSystemConfig.DisruptiveUpdates = &DisruptiveUpdatesConfig{
+					MaxConcurrentNodes: ptr.To(intstr.FromInt32(1)),
+					SlotTimeout:        &metav1.Duration{Duration: 10 * time.Minute},
+				}
+			})
+
+			It("should pass for a valid configuration", func() {
+				Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+			})
+
+			It("should pass for a valid percentage", func() {
+				config.Controllers.OperatingSystemConfig.DisruptiveUpdates.MaxConcurrentNodes = ptr.To(intstr.FromString("25%"))
+
+				Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+			})
+
+			DescribeTable("should fail because the maximum number of concurrent nodes is invalid",
+				func(value intstr.IntOrString) {
+					config.Controllers.OperatingSystemConfig.DisruptiveUpdates.MaxConcurrentNodes = &value
+
+					Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("controllers.operatingSystemConfig.disruptiveUpdates.maxConcurrentNodes"),
+						})),
+					))
+				},
+
+				Entry("zero", intstr.FromInt32(0)),
+				Entry("negative number", intstr.FromInt32(-1)),
+				Entry("zero percent", intstr.FromString("0%")),
+				Entry("more than 100 percent", intstr.FromString("101%")),
+				Entry("no percentage", intstr.FromString("foo")),
+			)
+
+			It("should fail because the slot timeout is too small", func() {
+				config.Controllers.OperatingSystemConfig.DisruptiveUpdates.SlotTimeout = &metav1.Duration{Duration: time.Second}
+
+				Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.operatingSystemConfig.disruptiveUpdates.slotTimeout"),
+					})),
+				))
+			})
+		})
+
+		Context("rollback", func() {
+			BeforeEach(func() {
+				config.Controllers.OperatingSystemConfig.Rollback = &RollbackConfig{
+					UnhealthyThreshold: &metav1.Duration{Duration: 5 * time.Minute},
+				}
+			})
+
+			It("should pass for a valid configuration", func() {
+				Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+			})
+
+			It("should fail because the unhealthy threshold is too small", func() {
+				config.Controllers.OperatingSystemConfig.Rollback.UnhealthyThreshold = &metav1.Duration{Duration: 30 * time.Second}
+
+				Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.operatingSystemConfig.rollback.unhealthyThreshold"),
+					})),
+				))
+			})
+		})
 	})
 
 	Context("Token Controller", func() {
@@ -13,6 +13,7 @@ import (
 	v3 "github.com/Masterminds/semver/v3"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 	componentbaseconfig "k8s.io/component-base/config"
 )
 
@@ -76,6 +77,32 @@ func (in *ControllerConfiguration) DeepCopy() *ControllerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DisruptiveUpdatesConfig) DeepCopyInto(out *DisruptiveUpdatesConfig) {
+	*out = *in
+	if in.MaxConcurrentNodes != nil {
+		in, out := &in.MaxConcurrentNodes, &out.MaxConcurrentNodes
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.SlotTimeout != nil {
+		in, out := &in.SlotTimeout, &out.SlotTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DisruptiveUpdatesConfig.
+func (in *DisruptiveUpdatesConfig) DeepCopy() *DisruptiveUpdatesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DisruptiveUpdatesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeAgentConfiguration) DeepCopyInto(out *NodeAgentConfiguration) {
 	*out = *in
@@ -140,6 +167,16 @@ func (in *OperatingSystemConfigControllerConfig) DeepCopyInto(out *OperatingSyst
 		*out = new(v3.Version)
 		**out = **in
 	}
+	if in.DisruptiveUpdates != nil {
+		in, out := &in.DisruptiveUpdates, &out.DisruptiveUpdates
+		*out = new(DisruptiveUpdatesConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -153,6 +190,27 @@ func (in *OperatingSystemConfigControllerConfig) DeepCopy() *OperatingSystemConf
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollbackConfig) DeepCopyInto(out *RollbackConfig) {
+	*out = *in
+	if in.UnhealthyThreshold != nil {
+		in, out := &in.UnhealthyThreshold, &out.UnhealthyThreshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollbackConfig.
+func (in *RollbackConfig) DeepCopy() *RollbackConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RollbackConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
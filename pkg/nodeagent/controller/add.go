@@ -33,10 +33,13 @@ func AddToManager(ctx context.Context, cancel context.CancelFunc, mgr manager.Ma
 		return fmt.Errorf("failed adding node controller: %w", err)
 	}
 
+	healthStatus := &healthcheck.Status{}
+
 	if err := (&operatingsystemconfig.Reconciler{
 		Config:        cfg.Controllers.OperatingSystemConfig,
 		HostName:      hostName,
 		CancelContext: cancel,
+		HealthStatus:  healthStatus,
 	}).AddToManager(ctx, mgr); err != nil {
 		return fmt.Errorf("failed adding operating system config controller: %w", err)
 	}
@@ -51,7 +54,9 @@ func AddToManager(ctx context.Context, cancel context.CancelFunc, mgr manager.Ma
 		return fmt.Errorf("failed adding lease controller: %w", err)
 	}
 
-	if err := (&healthcheck.Reconciler{}).AddToManager(mgr, nodePredicate); err != nil {
+	if err := (&healthcheck.Reconciler{
+		Status: healthStatus,
+	}).AddToManager(mgr, nodePredicate); err != nil {
 		return fmt.Errorf("failed adding health-check controller: %w", err)
 	}
 
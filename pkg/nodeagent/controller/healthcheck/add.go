@@ -44,6 +44,10 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, nodePredicate predicate.P
 		r.DBus = dbus.New(mgr.GetLogger().WithValues("controller", ControllerName))
 	}
 
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+
 	if len(r.HealthCheckers) == 0 {
 		if err := r.setDefaultHealthChecks(); err != nil {
 			return err
@@ -66,8 +70,6 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, nodePredicate predicate.P
 }
 
 func (r *Reconciler) setDefaultHealthChecks() error {
-	clock := clock.RealClock{}
-
 	address := os.Getenv("CONTAINERD_ADDRESS")
 	if address == "" {
 		address = defaults.DefaultAddress
@@ -83,9 +85,9 @@ func (r *Reconciler) setDefaultHealthChecks() error {
 		return fmt.Errorf("error creating containerd client: %w", err)
 	}
 
-	containerdHealthChecker := NewContainerdHealthChecker(r.Client, client, clock, r.DBus, r.Recorder)
+	containerdHealthChecker := NewContainerdHealthChecker(r.Client, client, r.Clock, r.DBus, r.Recorder)
 
-	kubeletHealthChecker := NewKubeletHealthChecker(r.Client, clock, r.DBus, r.Recorder, net.InterfaceAddrs)
+	kubeletHealthChecker := NewKubeletHealthChecker(r.Client, r.Clock, r.DBus, r.Recorder, net.InterfaceAddrs)
 	r.HealthCheckers = []HealthChecker{containerdHealthChecker, kubeletHealthChecker}
 	return nil
 }
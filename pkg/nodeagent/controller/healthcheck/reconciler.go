@@ -10,6 +10,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -23,8 +24,11 @@ type Reconciler struct {
 	Client                     client.Client
 	Recorder                   record.EventRecorder
 	DBus                       dbus.DBus
+	Clock                      clock.Clock
 	HealthCheckers             []HealthChecker
 	HealthCheckIntervalSeconds int32
+	// Status is updated with the result of each health check execution (optional).
+	Status *Status
 }
 
 // Reconcile executes all defined healtchecks
@@ -45,8 +49,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	if err := flow.Parallel(taskFns...)(ctx); err != nil {
+		r.recordStatus(false)
 		return reconcile.Result{}, err
 	}
+	r.recordStatus(true)
 
 	return reconcile.Result{RequeueAfter: time.Duration(r.HealthCheckIntervalSeconds) * time.Second}, nil
 }
+
+func (r *Reconciler) recordStatus(healthy bool) {
+	if r.Status != nil {
+		r.Status.Record(r.Clock.Now(), healthy)
+	}
+}
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"sync"
+	"time"
+)
+
+// Status records the result of the last execution of the health checks. It can be shared with other controllers which
+// need to know whether the node is healthy, e.g. before they continue with the next disruptive operation.
+type Status struct {
+	lock           sync.RWMutex
+	lastCheck      time.Time
+	healthy        bool
+	unhealthySince time.Time
+}
+
+// Record records the result of a health check execution at the given time.
+func (s *Status) Record(t time.Time, healthy bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.lastCheck = t
+	s.healthy = healthy
+
+	if healthy {
+		s.unhealthySince = time.Time{}
+	} else if s.unhealthySince.IsZero() {
+		s.unhealthySince = t
+	}
+}
+
+// HealthyAfter returns true if the last health check was executed after the given time and was successful.
+func (s *Status) HealthyAfter(t time.Time) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.healthy && s.lastCheck.After(t)
+}
+
+// UnhealthySince returns the time of the first failed health check since the last successful one. It returns the zero
+// time if the last health check was successful or if no health check was executed yet.
+func (s *Status) UnhealthySince() time.Time {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.unhealthySince
+}
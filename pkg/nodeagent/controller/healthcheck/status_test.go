@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
+)
+
+var _ = Describe("Status", func() {
+	var (
+		status *Status
+		now    = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	)
+
+	BeforeEach(func() {
+		status = &Status{}
+	})
+
+	It("should not be healthy if no check was recorded", func() {
+		Expect(status.HealthyAfter(time.Time{})).To(BeFalse())
+	})
+
+	It("should be healthy after the given time if a successful check was recorded afterwards", func() {
+		status.Record(now, true)
+
+		Expect(status.HealthyAfter(now.Add(-time.Second))).To(BeTrue())
+		Expect(status.HealthyAfter(now)).To(BeFalse())
+	})
+
+	It("should not be healthy if the last check failed", func() {
+		status.Record(now, true)
+		status.Record(now.Add(time.Minute), false)
+
+		Expect(status.HealthyAfter(now.Add(-time.Second))).To(BeFalse())
+	})
+
+	It("should return the time since when the node is unhealthy", func() {
+		Expect(status.UnhealthySince()).To(BeZero())
+
+		status.Record(now, false)
+		status.Record(now.Add(time.Minute), false)
+		Expect(status.UnhealthySince()).To(Equal(now))
+
+		status.Record(now.Add(2*time.Minute), true)
+		Expect(status.UnhealthySince()).To(BeZero())
+	})
+})
@@ -22,6 +22,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -35,6 +36,7 @@ import (
 	"github.com/gardener/gardener/pkg/nodeagent"
 	"github.com/gardener/gardener/pkg/nodeagent/apis/config"
 	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
 	"github.com/gardener/gardener/pkg/nodeagent/dbus"
 	filespkg "github.com/gardener/gardener/pkg/nodeagent/files"
 	"github.com/gardener/gardener/pkg/nodeagent/registry"
@@ -47,7 +49,9 @@ const lastAppliedOperatingSystemConfigFilePath = nodeagentv1alpha1.BaseDir + "/l
 // node.
 type Reconciler struct {
 	Client        client.Client
+	APIReader     client.Reader
 	Config        config.OperatingSystemConfigControllerConfig
+	Clock         clock.Clock
 	Recorder      record.EventRecorder
 	DBus          dbus.DBus
 	FS            afero.Afero
@@ -55,6 +59,10 @@ type Reconciler struct {
 	CancelContext context.CancelFunc
 	HostName      string
 	NodeName      string
+	// HealthStatus is the status of the node's health checks. It is required for releasing rollout slots of disruptive
+	// updates only after the node has become healthy again, and for rolling back updates after which the node does not
+	// become healthy.
+	HealthStatus *healthcheck.Status
 }
 
 // Reconcile decodes the OperatingSystemConfig resources from secrets and applies the systemd units and files to the
@@ -91,47 +99,43 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	if node != nil && node.Annotations[nodeagentv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig] == oscChecksum {
 		log.Info("Configuration on this node is up to date, nothing to be done")
-		return reconcile.Result{}, nil
+		return r.verifyUpdate(ctx, log, node)
 	}
 
-	log.Info("Applying new or changed files")
-	if err := r.applyChangedFiles(ctx, log, oscChanges.files.changed); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed applying changed files: %w", err)
+	if rolledBack, err := r.wasRolledBack(oscChecksum); err != nil {
+		return reconcile.Result{}, err
+	} else if rolledBack && node != nil {
+		log.Info("Configuration was rolled back because the node did not become healthy, waiting for a new one", "checksum", oscChecksum)
+		return r.verifyUpdate(ctx, log, node)
 	}
 
-	log.Info("Applying new or changed units")
-	if err := r.applyChangedUnits(ctx, log, oscChanges.units.changed); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed applying changed units: %w", err)
-	}
-
-	log.Info("Removing no longer needed units")
-	if err := r.removeDeletedUnits(ctx, log, node, oscChanges.units.deleted); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed removing deleted units: %w", err)
+	initialProvisioning, err := r.isInitialProvisioning()
+	if err != nil {
+		return reconcile.Result{}, err
 	}
 
-	log.Info("Reloading systemd daemon")
-	if err := r.DBus.DaemonReload(ctx); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed reloading systemd daemon: %w", err)
+	disruptive := r.Config.DisruptiveUpdates != nil && node != nil && !initialProvisioning && oscChanges.isDisruptive()
+	if disruptive {
+		acquired, err := r.acquireRolloutSlot(ctx, log, node)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed acquiring rollout slot for disruptive update: %w", err)
+		}
+		if !acquired {
+			log.Info("Waiting for a free rollout slot before applying disruptive update, requeuing", "requeueAfter", waitForSlotPeriod)
+			return reconcile.Result{RequeueAfter: waitForSlotPeriod}, nil
+		}
 	}
 
-	log.Info("Executing unit commands (start/stop)")
-	mustRestartGardenerNodeAgent, err := r.executeUnitCommands(ctx, log, node, oscChanges.units.changed)
+	mustRestartGardenerNodeAgent, err := r.applyChanges(ctx, log, node, oscChanges)
 	if err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed executing unit commands: %w", err)
+		return reconcile.Result{}, err
 	}
-
-	log.Info("Removing no longer needed files")
-	if err := r.removeDeletedFiles(log, oscChanges.files.deleted); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed removing deleted files: %w", err)
+	if disruptive {
+		if err := r.recordDisruptiveUpdate(ctx, node); err != nil {
+			return reconcile.Result{}, err
+		}
 	}
 
-	log.Info("Successfully applied operating system config",
-		"changedFiles", len(oscChanges.files.changed),
-		"deletedFiles", len(oscChanges.files.deleted),
-		"changedUnits", len(oscChanges.units.changed),
-		"deletedUnits", len(oscChanges.units.deleted),
-	)
-
 	log.Info("Persisting current operating system config as 'last-applied' file to the disk", "path", lastAppliedOperatingSystemConfigFilePath)
 	if err := r.FS.WriteFile(lastAppliedOperatingSystemConfigFilePath, oscRaw, 0644); err != nil {
 		return reconcile.Result{}, fmt.Errorf("unable to write current OSC to file path %q: %w", lastAppliedOperatingSystemConfigFilePath, err)
@@ -161,7 +165,68 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	metav1.SetMetaDataLabel(&node.ObjectMeta, v1beta1constants.LabelWorkerKubernetesVersion, r.Config.KubernetesVersion.String())
 	metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig, oscChecksum)
 
-	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, r.Client.Patch(ctx, node, patch)
+	if err := r.Client.Patch(ctx, node, patch); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if result, err := r.verifyUpdate(ctx, log, node); err != nil || result.RequeueAfter > 0 {
+		return result, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// applyChanges applies the given changes of the operating system config to the node. It returns true if the
+// gardener-node-agent unit itself must be restarted.
+func (r *Reconciler) applyChanges(ctx context.Context, log logr.Logger, node client.Object, changes *operatingSystemConfigChanges) (bool, error) {
+	log.Info("Applying new or changed files")
+	if err := r.applyChangedFiles(ctx, log, changes.files.changed); err != nil {
+		return false, fmt.Errorf("failed applying changed files: %w", err)
+	}
+
+	log.Info("Applying new or changed units")
+	if err := r.applyChangedUnits(ctx, log, changes.units.changed); err != nil {
+		return false, fmt.Errorf("failed applying changed units: %w", err)
+	}
+
+	log.Info("Removing no longer needed units")
+	if err := r.removeDeletedUnits(ctx, log, node, changes.units.deleted); err != nil {
+		return false, fmt.Errorf("failed removing deleted units: %w", err)
+	}
+
+	log.Info("Reloading systemd daemon")
+	if err := r.DBus.DaemonReload(ctx); err != nil {
+		return false, fmt.Errorf("failed reloading systemd daemon: %w", err)
+	}
+
+	log.Info("Executing unit commands (start/stop)")
+	mustRestartGardenerNodeAgent, err := r.executeUnitCommands(ctx, log, node, changes.units.changed)
+	if err != nil {
+		return false, fmt.Errorf("failed executing unit commands: %w", err)
+	}
+
+	log.Info("Removing no longer needed files")
+	if err := r.removeDeletedFiles(log, changes.files.deleted); err != nil {
+		return false, fmt.Errorf("failed removing deleted files: %w", err)
+	}
+
+	log.Info("Successfully applied operating system config",
+		"changedFiles", len(changes.files.changed),
+		"deletedFiles", len(changes.files.deleted),
+		"changedUnits", len(changes.units.changed),
+		"deletedUnits", len(changes.units.deleted),
+	)
+
+	return mustRestartGardenerNodeAgent, nil
+}
+
+// isInitialProvisioning returns true if no operating system config was applied to the node so far.
+func (r *Reconciler) isInitialProvisioning() (bool, error) {
+	exists, err := r.FS.Exists(lastAppliedOperatingSystemConfigFilePath)
+	if err != nil {
+		return false, fmt.Errorf("failed checking whether file %q exists: %w", lastAppliedOperatingSystemConfigFilePath, err)
+	}
+	return !exists, nil
 }
 
 func (r *Reconciler) getNode(ctx context.Context) (*metav1.PartialObjectMetadata, error) {
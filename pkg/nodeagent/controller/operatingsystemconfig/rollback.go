@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+const (
+	lastKnownGoodOperatingSystemConfigFilePath  = nodeagentv1alpha1.BaseDir + "/last-known-good-osc.yaml"
+	failedOperatingSystemConfigChecksumFilePath = nodeagentv1alpha1.BaseDir + "/failed-osc-checksum"
+
+	// verifyUpdatePeriod is the duration after which the health of the node is checked again after an update of the
+	// operating system config.
+	verifyUpdatePeriod = 10 * time.Second
+)
+
+// verifyUpdate checks whether the node is healthy after the last update of the operating system config. If it is, the
+// last applied operating system config is remembered as last-known-good. If kubelet or containerd stay unhealthy for
+// longer than the configured threshold, the last-known-good operating system config is restored. Afterwards, the
+// rollout slot for disruptive updates is released (if held by this node).
+func (r *Reconciler) verifyUpdate(ctx context.Context, log logr.Logger, node *metav1.PartialObjectMetadata) (reconcile.Result, error) {
+	verifyResult, err := r.rollbackIfUnhealthy(ctx, log, node)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	slotResult, err := r.completeDisruptiveUpdate(ctx, log, node)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if verifyResult.RequeueAfter == 0 || (slotResult.RequeueAfter > 0 && slotResult.RequeueAfter < verifyResult.RequeueAfter) {
+		return slotResult, nil
+	}
+	return verifyResult, nil
+}
+
+func (r *Reconciler) rollbackIfUnhealthy(ctx context.Context, log logr.Logger, node *metav1.PartialObjectMetadata) (reconcile.Result, error) {
+	if r.Config.Rollback == nil || r.HealthStatus == nil {
+		return reconcile.Result{}, nil
+	}
+
+	lastApplied, err := r.readFileIfExists(lastAppliedOperatingSystemConfigFilePath)
+	if err != nil || lastApplied == nil {
+		return reconcile.Result{}, err
+	}
+
+	lastKnownGood, err := r.readFileIfExists(lastKnownGoodOperatingSystemConfigFilePath)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if bytes.Equal(lastApplied, lastKnownGood) {
+		return reconcile.Result{}, nil
+	}
+
+	info, err := r.FS.Stat(lastAppliedOperatingSystemConfigFilePath)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed reading file info of %q: %w", lastAppliedOperatingSystemConfigFilePath, err)
+	}
+	appliedAt := info.ModTime()
+
+	if r.HealthStatus.HealthyAfter(appliedAt) {
+		log.Info("Node is healthy after update of operating system config, remembering it as last-known-good", "path", lastKnownGoodOperatingSystemConfigFilePath)
+		if err := r.FS.WriteFile(lastKnownGoodOperatingSystemConfigFilePath, lastApplied, 0644); err != nil {
+			return reconcile.Result{}, fmt.Errorf("unable to write last-known-good OSC to file path %q: %w", lastKnownGoodOperatingSystemConfigFilePath, err)
+		}
+		return reconcile.Result{}, r.resetRollback(ctx, node)
+	}
+
+	unhealthySince := r.HealthStatus.UnhealthySince()
+	if unhealthySince.IsZero() {
+		log.Info("Waiting for node to become healthy after update of operating system config")
+		return reconcile.Result{RequeueAfter: verifyUpdatePeriod}, nil
+	}
+
+	if unhealthySince.Before(appliedAt) {
+		unhealthySince = appliedAt
+	}
+	if unhealthyFor := r.Clock.Since(unhealthySince); unhealthyFor < r.Config.Rollback.UnhealthyThreshold.Duration {
+		log.Info("Node is unhealthy after update of operating system config, waiting before rolling back", "unhealthyFor", unhealthyFor.Round(time.Second))
+		return reconcile.Result{RequeueAfter: verifyUpdatePeriod}, nil
+	}
+
+	if lastKnownGood == nil {
+		log.Info("Node is unhealthy after update of operating system config, but there is no last-known-good operating system config to roll back to")
+		return reconcile.Result{}, nil
+	}
+
+	if err := r.rollback(ctx, log, node, lastKnownGood); err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{RequeueAfter: verifyUpdatePeriod}, nil
+}
+
+// rollback restores the given last-known-good operating system config and reports the rollback on the node.
+func (r *Reconciler) rollback(ctx context.Context, log logr.Logger, node *metav1.PartialObjectMetadata, lastKnownGoodRaw []byte) error {
+	failedChecksum := node.Annotations[nodeagentv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig]
+	log.Info("Node did not become healthy after update of operating system config, rolling back to last-known-good operating system config", "failedChecksum", failedChecksum)
+
+	osc := &extensionsv1alpha1.OperatingSystemConfig{}
+	if err := runtime.DecodeInto(decoder, lastKnownGoodRaw, osc); err != nil {
+		return fmt.Errorf("unable to decode last-known-good OSC from file path %q: %w", lastKnownGoodOperatingSystemConfigFilePath, err)
+	}
+
+	changes, err := computeOperatingSystemConfigChanges(r.FS, osc)
+	if err != nil {
+		return fmt.Errorf("failed calculating the OSC changes for the rollback: %w", err)
+	}
+
+	mustRestartGardenerNodeAgent, err := r.applyChanges(ctx, log, node, changes)
+	if err != nil {
+		return fmt.Errorf("failed rolling back to last-known-good OSC: %w", err)
+	}
+
+	if err := r.FS.WriteFile(failedOperatingSystemConfigChecksumFilePath, []byte(failedChecksum), 0644); err != nil {
+		return fmt.Errorf("unable to write checksum of failed OSC to file path %q: %w", failedOperatingSystemConfigChecksumFilePath, err)
+	}
+
+	if err := r.FS.WriteFile(lastAppliedOperatingSystemConfigFilePath, lastKnownGoodRaw, 0644); err != nil {
+		return fmt.Errorf("unable to write last-known-good OSC to file path %q: %w", lastAppliedOperatingSystemConfigFilePath, err)
+	}
+
+	message := fmt.Sprintf("Operating system config with checksum %s was rolled back to the last-known-good operating system config because kubelet or containerd did not become healthy within %s", failedChecksum, r.Config.Rollback.UnhealthyThreshold.Duration)
+	r.Recorder.Event(node, corev1.EventTypeWarning, "OSCRolledBack", message)
+
+	if err := r.patchRolledBackCondition(ctx, node.Name, corev1.ConditionTrue, "RolledBack", message); err != nil {
+		return err
+	}
+
+	// Remove the checksum of the failed operating system config from the node to reflect that it is not up-to-date. The
+	// rollback is recorded as disruptive update so that a held rollout slot is only released once the node is healthy.
+	patch := client.MergeFrom(node.DeepCopy())
+	delete(node.Annotations, nodeagentv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig)
+	metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentv1alpha1.AnnotationKeyLastDisruptiveUpdate, r.Clock.Now().UTC().Format(time.RFC3339Nano))
+	if err := r.Client.Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed removing checksum annotation from node: %w", err)
+	}
+
+	if mustRestartGardenerNodeAgent {
+		log.Info("Must restart myself (gardener-node-agent unit), canceling the context to initiate graceful shutdown")
+		r.CancelContext()
+	}
+
+	return nil
+}
+
+// wasRolledBack returns true if the operating system config with the given checksum was rolled back before.
+func (r *Reconciler) wasRolledBack(checksum string) (bool, error) {
+	if r.Config.Rollback == nil {
+		return false, nil
+	}
+
+	failedChecksum, err := r.readFileIfExists(failedOperatingSystemConfigChecksumFilePath)
+	if err != nil {
+		return false, err
+	}
+
+	return failedChecksum != nil && string(failedChecksum) == checksum, nil
+}
+
+// resetRollback removes the information about a previous rollback after a new operating system config was applied
+// successfully.
+func (r *Reconciler) resetRollback(ctx context.Context, node *metav1.PartialObjectMetadata) error {
+	failedChecksum, err := r.readFileIfExists(failedOperatingSystemConfigChecksumFilePath)
+	if err != nil || failedChecksum == nil {
+		return err
+	}
+
+	if err := r.patchRolledBackCondition(ctx, node.Name, corev1.ConditionFalse, "Healthy", "Node is healthy after update of operating system config."); err != nil {
+		return err
+	}
+
+	if err := r.FS.Remove(failedOperatingSystemConfigChecksumFilePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+		return fmt.Errorf("failed removing file %q: %w", failedOperatingSystemConfigChecksumFilePath, err)
+	}
+	return nil
+}
+
+func (r *Reconciler) patchRolledBackCondition(ctx context.Context, nodeName string, status corev1.ConditionStatus, reason, message string) error {
+	now := metav1.NewTime(r.Clock.Now())
+
+	patch, err := json.Marshal(map[string]any{"status": map[string]any{"conditions": []corev1.NodeCondition{{
+		Type:               nodeagentv1alpha1.NodeConditionOperatingSystemConfigRolledBack,
+		Status:             status,
+		LastHeartbeatTime:  now,
+		LastTransitionTime: now,
+		Reason:             reason,
+		Message:            message,
+	}}}})
+	if err != nil {
+		return err
+	}
+
+	if err := r.Client.Status().Patch(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: nodeName}}, client.RawPatch(types.StrategicMergePatchType, patch)); err != nil {
+		return fmt.Errorf("failed patching %s condition of node: %w", nodeagentv1alpha1.NodeConditionOperatingSystemConfigRolledBack, err)
+	}
+	return nil
+}
+
+func (r *Reconciler) readFileIfExists(path string) ([]byte, error) {
+	data, err := r.FS.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading file %q: %w", path, err)
+	}
+	return data, nil
+}
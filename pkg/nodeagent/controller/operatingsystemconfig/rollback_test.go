@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/nodeagent/apis/config"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+)
+
+var _ = Describe("Rollback", func() {
+	var (
+		ctx = context.Background()
+		log = logr.Discard()
+
+		fakeClient   client.Client
+		fakeClock    *testclock.FakeClock
+		fakeFS       afero.Afero
+		fakeDBus     *fakedbus.DBus
+		recorder     *record.FakeRecorder
+		healthStatus *healthcheck.Status
+		r            *Reconciler
+
+		node *metav1.PartialObjectMetadata
+
+		goodOSCRaw, brokenOSCRaw []byte
+	)
+
+	encodeOSC := func(content string) []byte {
+		osc := &extensionsv1alpha1.OperatingSystemConfig{
+			TypeMeta: metav1.TypeMeta{APIVersion: "extensions.gardener.cloud/v1alpha1", Kind: "OperatingSystemConfig"},
+			Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+				Units: []extensionsv1alpha1.Unit{{Name: "kubelet.service", Content: ptr.To(content), FilePaths: []string{"/var/lib/kubelet/config"}}},
+				Files: []extensionsv1alpha1.File{{Path: "/var/lib/kubelet/config", Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: content}}}},
+			},
+		}
+
+		raw, err := json.Marshal(osc)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return raw
+	}
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+		fakeDBus = fakedbus.New()
+		recorder = record.NewFakeRecorder(10)
+		healthStatus = &healthcheck.Status{}
+
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.SeedScheme).
+			WithStatusSubresource(&corev1.Node{}).
+			WithObjects(&corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Name:        "node",
+				Annotations: map[string]string{"checksum/cloud-config-data": "broken-checksum"},
+			}}).
+			Build()
+
+		node = &metav1.PartialObjectMetadata{}
+		node.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Node"))
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "node"}, node)).To(Succeed())
+		node.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Node"))
+
+		r = &Reconciler{
+			Client:   fakeClient,
+			Clock:    fakeClock,
+			Recorder: recorder,
+			DBus:     fakeDBus,
+			FS:       fakeFS,
+			Config: config.OperatingSystemConfigControllerConfig{
+				Rollback: &config.RollbackConfig{UnhealthyThreshold: &metav1.Duration{Duration: 5 * time.Minute}},
+			},
+			CancelContext: func() {},
+			HealthStatus:  healthStatus,
+		}
+
+		goodOSCRaw, brokenOSCRaw = encodeOSC("good"), encodeOSC("broken")
+
+		Expect(fakeFS.MkdirAll(nodeagentv1alpha1.TempDir, 0755)).To(Succeed())
+		Expect(fakeFS.WriteFile(lastKnownGoodOperatingSystemConfigFilePath, goodOSCRaw, 0644)).To(Succeed())
+		Expect(fakeFS.WriteFile(lastAppliedOperatingSystemConfigFilePath, brokenOSCRaw, 0644)).To(Succeed())
+		Expect(fakeFS.WriteFile("/etc/systemd/system/kubelet.service", []byte("broken"), 0600)).To(Succeed())
+		Expect(fakeFS.WriteFile("/var/lib/kubelet/config", []byte("broken"), 0600)).To(Succeed())
+		Expect(fakeFS.Chtimes(lastAppliedOperatingSystemConfigFilePath, fakeClock.Now(), fakeClock.Now())).To(Succeed())
+	})
+
+	Describe("#verifyUpdate", func() {
+		It("should do nothing if rollback is not configured", func() {
+			r.Config.Rollback = nil
+
+			Expect(r.verifyUpdate(ctx, log, node)).To(BeZero())
+			Expect(fakeFS.ReadFile(lastKnownGoodOperatingSystemConfigFilePath)).To(Equal(goodOSCRaw))
+		})
+
+		It("should do nothing if the last applied config is the last-known-good config", func() {
+			Expect(fakeFS.WriteFile(lastAppliedOperatingSystemConfigFilePath, goodOSCRaw, 0644)).To(Succeed())
+
+			Expect(r.verifyUpdate(ctx, log, node)).To(BeZero())
+		})
+
+		It("should remember the last applied config as last-known-good if the node is healthy after the update", func() {
+			fakeClock.Step(time.Minute)
+			healthStatus.Record(fakeClock.Now(), true)
+
+			Expect(r.verifyUpdate(ctx, log, node)).To(BeZero())
+			Expect(fakeFS.ReadFile(lastKnownGoodOperatingSystemConfigFilePath)).To(Equal(brokenOSCRaw))
+		})
+
+		It("should reset a previous rollback if the node is healthy after the update", func() {
+			Expect(fakeFS.WriteFile(failedOperatingSystemConfigChecksumFilePath, []byte("previous-checksum"), 0644)).To(Succeed())
+			fakeClock.Step(time.Minute)
+			healthStatus.Record(fakeClock.Now(), true)
+
+			Expect(r.verifyUpdate(ctx, log, node)).To(BeZero())
+
+			Expect(fakeFS.Exists(failedOperatingSystemConfigChecksumFilePath)).To(BeFalse())
+			nodeObj := &corev1.Node{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "node"}, nodeObj)).To(Succeed())
+			Expect(nodeObj.Status.Conditions).To(ConsistOf(And(
+				HaveField("Type", corev1.NodeConditionType("OperatingSystemConfigRolledBack")),
+				HaveField("Status", corev1.ConditionFalse),
+			)))
+		})
+
+		It("should wait if no health check was executed since the update", func() {
+			Expect(r.verifyUpdate(ctx, log, node)).To(Equal(reconcile.Result{RequeueAfter: verifyUpdatePeriod}))
+		})
+
+		It("should wait if the node is unhealthy for less than the threshold", func() {
+			healthStatus.Record(fakeClock.Now().Add(time.Second), false)
+			fakeClock.Step(4 * time.Minute)
+
+			Expect(r.verifyUpdate(ctx, log, node)).To(Equal(reconcile.Result{RequeueAfter: verifyUpdatePeriod}))
+			Expect(fakeFS.ReadFile(lastAppliedOperatingSystemConfigFilePath)).To(Equal(brokenOSCRaw))
+		})
+
+		It("should not roll back if there is no last-known-good config", func() {
+			Expect(fakeFS.Remove(lastKnownGoodOperatingSystemConfigFilePath)).To(Succeed())
+			healthStatus.Record(fakeClock.Now().Add(time.Second), false)
+			fakeClock.Step(10 * time.Minute)
+
+			Expect(r.verifyUpdate(ctx, log, node)).To(BeZero())
+			Expect(fakeFS.ReadFile(lastAppliedOperatingSystemConfigFilePath)).To(Equal(brokenOSCRaw))
+		})
+
+		It("should roll back to the last-known-good config if the node stays unhealthy", func() {
+			healthStatus.Record(fakeClock.Now().Add(time.Second), false)
+			fakeClock.Step(10 * time.Minute)
+
+			Expect(r.verifyUpdate(ctx, log, node)).To(Equal(reconcile.Result{RequeueAfter: verifyUpdatePeriod}))
+
+			By("restoring the files and units")
+			Expect(fakeFS.ReadFile("/etc/systemd/system/kubelet.service")).To(Equal([]byte("good")))
+			Expect(fakeFS.ReadFile("/var/lib/kubelet/config")).To(Equal([]byte("good")))
+			Expect(fakeDBus.Actions).To(ContainElement(fakedbus.SystemdAction{Action: fakedbus.ActionRestart, UnitNames: []string{"kubelet.service"}}))
+			Expect(fakeFS.ReadFile(lastAppliedOperatingSystemConfigFilePath)).To(Equal(goodOSCRaw))
+
+			By("remembering the checksum of the failed config")
+			Expect(fakeFS.ReadFile(failedOperatingSystemConfigChecksumFilePath)).To(Equal([]byte("broken-checksum")))
+			Expect(r.wasRolledBack("broken-checksum")).To(BeTrue())
+			Expect(r.wasRolledBack("new-checksum")).To(BeFalse())
+
+			By("reporting the rollback on the node")
+			Expect(recorder.Events).To(Receive(And(ContainSubstring("OSCRolledBack"), ContainSubstring("broken-checksum"))))
+
+			nodeObj := &corev1.Node{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "node"}, nodeObj)).To(Succeed())
+			Expect(nodeObj.Annotations).NotTo(HaveKey("checksum/cloud-config-data"))
+			Expect(nodeObj.Annotations).To(HaveKeyWithValue("node-agent.gardener.cloud/last-disruptive-update", fakeClock.Now().UTC().Format(time.RFC3339Nano)))
+			Expect(nodeObj.Status.Conditions).To(ConsistOf(And(
+				HaveField("Type", corev1.NodeConditionType("OperatingSystemConfigRolledBack")),
+				HaveField("Status", corev1.ConditionTrue),
+				HaveField("Reason", "RolledBack"),
+				HaveField("Message", ContainSubstring("broken-checksum")),
+			)))
+		})
+	})
+})
@@ -0,0 +1,251 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+const (
+	// labelRolloutPool is the label key for the name of the worker pool a rollout slot Lease belongs to.
+	labelRolloutPool = "node-agent.gardener.cloud/rollout-pool"
+
+	// waitForSlotPeriod is the duration after which the acquisition of a rollout slot is retried.
+	waitForSlotPeriod = 30 * time.Second
+	// waitForHealthyPeriod is the duration after which the health of the node is checked again before the held rollout
+	// slot is released.
+	waitForHealthyPeriod = 10 * time.Second
+)
+
+// isDisruptive returns true if applying the changes requires restarting or removing systemd units. The restart of the
+// gardener-node-agent unit itself is not considered disruptive.
+func (o *operatingSystemConfigChanges) isDisruptive() bool {
+	for _, unit := range o.units.changed {
+		if unit.Name != nodeagentv1alpha1.UnitName {
+			return true
+		}
+	}
+	return len(o.units.deleted) > 0
+}
+
+func rolloutSlotLeaseName(pool string, index int) string {
+	return fmt.Sprintf("gardener-node-agent-rollout-%s-%d", pool, index)
+}
+
+// acquireRolloutSlot tries to acquire one of the rollout slots of the worker pool of the given node. Rollout slots are
+// Leases in the kube-system namespace, and a slot is held by a node if it is the holder of the respective Lease. Slots
+// whose holder did not renew them within the configured timeout are considered free. It returns true if the node holds
+// a slot after the call.
+func (r *Reconciler) acquireRolloutSlot(ctx context.Context, log logr.Logger, node client.Object) (bool, error) {
+	pool := node.GetLabels()[v1beta1constants.LabelWorkerPool]
+	if pool == "" {
+		log.Info("Node does not belong to a worker pool, disruptive updates are not coordinated")
+		return true, nil
+	}
+
+	leases, err := r.listRolloutSlots(ctx, pool)
+	if err != nil {
+		return false, err
+	}
+
+	var (
+		now         = r.Clock.Now()
+		activeSlots = make(map[string]struct{}, len(leases))
+	)
+
+	for _, lease := range leases {
+		if ptr.Deref(lease.Spec.HolderIdentity, "") == node.GetName() {
+			log.Info("Rollout slot is already held by this node, renewing it", "lease", client.ObjectKeyFromObject(&lease))
+			return true, r.holdRolloutSlot(ctx, &lease, node.GetName(), now)
+		}
+
+		if r.isActive(&lease, now) {
+			activeSlots[lease.Name] = struct{}{}
+		}
+	}
+
+	maxConcurrentNodes, err := r.maxConcurrentNodes(ctx, pool)
+	if err != nil {
+		return false, err
+	}
+
+	if len(activeSlots) >= maxConcurrentNodes {
+		log.Info("All rollout slots of the worker pool are held by other nodes", "maxConcurrentNodes", maxConcurrentNodes)
+		return false, nil
+	}
+
+	for i := 0; i < maxConcurrentNodes; i++ {
+		name := rolloutSlotLeaseName(pool, i)
+		if _, ok := activeSlots[name]; ok {
+			continue
+		}
+
+		lease := &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceSystem}}
+		if err := r.APIReader.Get(ctx, client.ObjectKeyFromObject(lease), lease); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return false, fmt.Errorf("failed reading rollout slot lease %s: %w", client.ObjectKeyFromObject(lease), err)
+			}
+
+			lease.Labels = map[string]string{labelRolloutPool: pool}
+			lease.Spec = r.slotLeaseSpec(node.GetName(), now)
+			if err := r.Client.Create(ctx, lease); err != nil {
+				if apierrors.IsAlreadyExists(err) {
+					// Another node was faster, try again later.
+					return false, nil
+				}
+				return false, fmt.Errorf("failed creating rollout slot lease %s: %w", client.ObjectKeyFromObject(lease), err)
+			}
+		} else if err := r.holdRolloutSlot(ctx, lease, node.GetName(), now); err != nil {
+			if apierrors.IsConflict(err) {
+				// Another node was faster, try again later.
+				return false, nil
+			}
+			return false, err
+		}
+
+		log.Info("Acquired rollout slot", "lease", client.ObjectKeyFromObject(lease))
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// completeDisruptiveUpdate releases the rollout slots held by the given node as soon as the node has become healthy
+// after the last disruptive update. As long as the node is not healthy yet, the slot is renewed and the request is
+// requeued.
+func (r *Reconciler) completeDisruptiveUpdate(ctx context.Context, log logr.Logger, node client.Object) (reconcile.Result, error) {
+	pool := node.GetLabels()[v1beta1constants.LabelWorkerPool]
+	if r.Config.DisruptiveUpdates == nil || pool == "" {
+		return reconcile.Result{}, nil
+	}
+
+	leases, err := r.listRolloutSlots(ctx, pool)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	now := r.Clock.Now()
+
+	for _, lease := range leases {
+		if ptr.Deref(lease.Spec.HolderIdentity, "") != node.GetName() {
+			continue
+		}
+
+		if r.HealthStatus != nil && !r.HealthStatus.HealthyAfter(lastDisruptiveUpdate(node)) {
+			log.Info("Waiting for node to become healthy before releasing rollout slot", "lease", client.ObjectKeyFromObject(&lease))
+			if err := r.holdRolloutSlot(ctx, &lease, node.GetName(), now); err != nil {
+				return reconcile.Result{}, err
+			}
+			return reconcile.Result{RequeueAfter: waitForHealthyPeriod}, nil
+		}
+
+		lease.Spec.HolderIdentity = nil
+		if err := r.Client.Update(ctx, &lease); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed releasing rollout slot lease %s: %w", client.ObjectKeyFromObject(&lease), err)
+		}
+		log.Info("Released rollout slot", "lease", client.ObjectKeyFromObject(&lease))
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// recordDisruptiveUpdate persists the current time as time of the last disruptive update in an annotation on the given
+// node. Keeping it on the node ensures that the rollout slot is not released prematurely if gardener-node-agent is
+// restarted before the node has become healthy again, e.g., because its own unit was changed by the same update.
+func (r *Reconciler) recordDisruptiveUpdate(ctx context.Context, node *metav1.PartialObjectMetadata) error {
+	patch := client.MergeFrom(node.DeepCopy())
+	metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentv1alpha1.AnnotationKeyLastDisruptiveUpdate, r.Clock.Now().UTC().Format(time.RFC3339Nano))
+	if err := r.Client.Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed recording time of disruptive update on node: %w", err)
+	}
+	return nil
+}
+
+// lastDisruptiveUpdate returns the time of the last disruptive update recorded on the given node. It returns the zero
+// time if no (valid) time is recorded.
+func lastDisruptiveUpdate(node client.Object) time.Time {
+	t, err := time.Parse(time.RFC3339Nano, node.GetAnnotations()[nodeagentv1alpha1.AnnotationKeyLastDisruptiveUpdate])
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+func (r *Reconciler) listRolloutSlots(ctx context.Context, pool string) ([]coordinationv1.Lease, error) {
+	leaseList := &coordinationv1.LeaseList{}
+	if err := r.APIReader.List(ctx, leaseList, client.InNamespace(metav1.NamespaceSystem), client.MatchingLabels{labelRolloutPool: pool}); err != nil {
+		return nil, fmt.Errorf("failed listing rollout slot leases for worker pool %q: %w", pool, err)
+	}
+	return leaseList.Items, nil
+}
+
+func (r *Reconciler) holdRolloutSlot(ctx context.Context, lease *coordinationv1.Lease, holder string, now time.Time) error {
+	if ptr.Deref(lease.Spec.HolderIdentity, "") == holder {
+		lease.Spec.RenewTime = &metav1.MicroTime{Time: now}
+		lease.Spec.LeaseDurationSeconds = r.slotLeaseSpec(holder, now).LeaseDurationSeconds
+	} else {
+		lease.Spec = r.slotLeaseSpec(holder, now)
+	}
+
+	if err := r.Client.Update(ctx, lease); err != nil {
+		return fmt.Errorf("failed updating rollout slot lease %s: %w", client.ObjectKeyFromObject(lease), err)
+	}
+	return nil
+}
+
+func (r *Reconciler) slotLeaseSpec(holder string, now time.Time) coordinationv1.LeaseSpec {
+	return coordinationv1.LeaseSpec{
+		HolderIdentity:       &holder,
+		LeaseDurationSeconds: ptr.To(int32(r.Config.DisruptiveUpdates.SlotTimeout.Duration / time.Second)),
+		AcquireTime:          &metav1.MicroTime{Time: now},
+		RenewTime:            &metav1.MicroTime{Time: now},
+	}
+}
+
+// isActive returns true if the given rollout slot lease is held by a node which renewed it within the lease duration.
+func (r *Reconciler) isActive(lease *coordinationv1.Lease, now time.Time) bool {
+	if ptr.Deref(lease.Spec.HolderIdentity, "") == "" || lease.Spec.RenewTime == nil {
+		return false
+	}
+	return lease.Spec.RenewTime.Add(time.Duration(ptr.Deref(lease.Spec.LeaseDurationSeconds, 0)) * time.Second).After(now)
+}
+
+// maxConcurrentNodes computes the number of rollout slots of the given worker pool. Percentages are computed based on
+// the number of nodes in the worker pool, however, at least one slot is always available.
+func (r *Reconciler) maxConcurrentNodes(ctx context.Context, pool string) (int, error) {
+	maxConcurrentNodes := ptr.Deref(r.Config.DisruptiveUpdates.MaxConcurrentNodes, intstr.FromInt32(1))
+
+	total := 0
+	if maxConcurrentNodes.Type == intstr.String {
+		nodeList := &metav1.PartialObjectMetadataList{}
+		nodeList.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("NodeList"))
+		if err := r.APIReader.List(ctx, nodeList, client.MatchingLabels{v1beta1constants.LabelWorkerPool: pool}); err != nil {
+			return 0, fmt.Errorf("failed listing nodes of worker pool %q: %w", pool, err)
+		}
+		total = len(nodeList.Items)
+	}
+
+	value, err := intstr.GetScaledValueFromIntOrPercent(&maxConcurrentNodes, total, false)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value for maximum number of concurrent nodes: %w", err)
+	}
+
+	return max(value, 1), nil
+}
@@ -0,0 +1,248 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/nodeagent/apis/config"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
+)
+
+var _ = Describe("Rollout", func() {
+	Describe("#isDisruptive", func() {
+		It("should not be disruptive if no units changed", func() {
+			changes := &operatingSystemConfigChanges{files: files{changed: []extensionsv1alpha1.File{{Path: "/foo"}}}}
+			Expect(changes.isDisruptive()).To(BeFalse())
+		})
+
+		It("should not be disruptive if only the gardener-node-agent unit changed", func() {
+			changes := &operatingSystemConfigChanges{units: units{changed: []changedUnit{{Unit: extensionsv1alpha1.Unit{Name: "gardener-node-agent.service"}}}}}
+			Expect(changes.isDisruptive()).To(BeFalse())
+		})
+
+		It("should be disruptive if other units changed", func() {
+			changes := &operatingSystemConfigChanges{units: units{changed: []changedUnit{{Unit: extensionsv1alpha1.Unit{Name: "kubelet.service"}}}}}
+			Expect(changes.isDisruptive()).To(BeTrue())
+		})
+
+		It("should be disruptive if units were deleted", func() {
+			changes := &operatingSystemConfigChanges{units: units{deleted: []extensionsv1alpha1.Unit{{Name: "foo.service"}}}}
+			Expect(changes.isDisruptive()).To(BeTrue())
+		})
+	})
+
+	Describe("rollout slots", func() {
+		var (
+			ctx = context.Background()
+			log = logr.Discard()
+
+			fakeClient   client.Client
+			fakeClock    *testclock.FakeClock
+			healthStatus *healthcheck.Status
+			r            *Reconciler
+
+			node *corev1.Node
+		)
+
+		newNode := func(name string) *corev1.Node {
+			return &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{"worker.gardener.cloud/pool": "pool"},
+			}}
+		}
+
+		slot := func(index int) *coordinationv1.Lease {
+			lease := &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Name: rolloutSlotLeaseName("pool", index), Namespace: "kube-system"}}
+			ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(lease), lease)).To(Succeed())
+			return lease
+		}
+
+		createSlot := func(index int, holder string, renewTime time.Time) {
+			ExpectWithOffset(1, fakeClient.Create(ctx, &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      rolloutSlotLeaseName("pool", index),
+					Namespace: "kube-system",
+					Labels:    map[string]string{"node-agent.gardener.cloud/rollout-pool": "pool"},
+				},
+				Spec: coordinationv1.LeaseSpec{
+					HolderIdentity:       &holder,
+					LeaseDurationSeconds: ptr.To[int32](600),
+					RenewTime:            &metav1.MicroTime{Time: renewTime},
+				},
+			})).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+			fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+			healthStatus = &healthcheck.Status{}
+
+			r = &Reconciler{
+				Client:    fakeClient,
+				APIReader: fakeClient,
+				Clock:     fakeClock,
+				Config: config.OperatingSystemConfigControllerConfig{
+					DisruptiveUpdates: &config.DisruptiveUpdatesConfig{
+						MaxConcurrentNodes: ptr.To(intstr.FromInt32(1)),
+						SlotTimeout:        &metav1.Duration{Duration: 10 * time.Minute},
+					},
+				},
+				HealthStatus: healthStatus,
+			}
+
+			node = newNode("node1")
+			Expect(fakeClient.Create(ctx, node)).To(Succeed())
+		})
+
+		Describe("#acquireRolloutSlot", func() {
+			It("should always succeed if the node does not belong to a worker pool", func() {
+				node.Labels = nil
+
+				Expect(r.acquireRolloutSlot(ctx, log, node)).To(BeTrue())
+			})
+
+			It("should create the slot lease if it does not exist yet", func() {
+				Expect(r.acquireRolloutSlot(ctx, log, node)).To(BeTrue())
+
+				lease := slot(0)
+				Expect(lease.Labels).To(HaveKeyWithValue("node-agent.gardener.cloud/rollout-pool", "pool"))
+				Expect(lease.Spec.HolderIdentity).To(Equal(ptr.To("node1")))
+				Expect(lease.Spec.LeaseDurationSeconds).To(Equal(ptr.To[int32](600)))
+				Expect(lease.Spec.RenewTime.Time.Equal(fakeClock.Now())).To(BeTrue())
+			})
+
+			It("should renew the slot lease if it is already held by the node", func() {
+				createSlot(0, "node1", fakeClock.Now().Add(-time.Minute))
+
+				Expect(r.acquireRolloutSlot(ctx, log, node)).To(BeTrue())
+				Expect(slot(0).Spec.RenewTime.Time.Equal(fakeClock.Now())).To(BeTrue())
+			})
+
+			It("should not acquire a slot if all slots are held by other nodes", func() {
+				createSlot(0, "node2", fakeClock.Now().Add(-time.Minute))
+
+				Expect(r.acquireRolloutSlot(ctx, log, node)).To(BeFalse())
+				Expect(slot(0).Spec.HolderIdentity).To(Equal(ptr.To("node2")))
+			})
+
+			It("should take over a slot whose holder did not renew it in time", func() {
+				createSlot(0, "node2", fakeClock.Now().Add(-time.Hour))
+
+				Expect(r.acquireRolloutSlot(ctx, log, node)).To(BeTrue())
+				Expect(slot(0).Spec.HolderIdentity).To(Equal(ptr.To("node1")))
+			})
+
+			It("should take over a released slot", func() {
+				createSlot(0, "", fakeClock.Now().Add(-time.Minute))
+
+				Expect(r.acquireRolloutSlot(ctx, log, node)).To(BeTrue())
+				Expect(slot(0).Spec.HolderIdentity).To(Equal(ptr.To("node1")))
+			})
+
+			It("should compute the number of slots based on the percentage of nodes in the worker pool", func() {
+				r.Config.DisruptiveUpdates.MaxConcurrentNodes = ptr.To(intstr.FromString("50%"))
+				for _, name := range []string{"node2", "node3", "node4"} {
+					Expect(fakeClient.Create(ctx, newNode(name))).To(Succeed())
+				}
+				createSlot(0, "node2", fakeClock.Now())
+
+				Expect(r.acquireRolloutSlot(ctx, log, node)).To(BeTrue())
+				Expect(slot(1).Spec.HolderIdentity).To(Equal(ptr.To("node1")))
+
+				Expect(r.acquireRolloutSlot(ctx, log, newNode("node3"))).To(BeFalse())
+			})
+
+			It("should allow at least one node if the percentage is rounded down to zero", func() {
+				r.Config.DisruptiveUpdates.MaxConcurrentNodes = ptr.To(intstr.FromString("10%"))
+
+				Expect(r.acquireRolloutSlot(ctx, log, node)).To(BeTrue())
+			})
+		})
+
+		Describe("#recordDisruptiveUpdate", func() {
+			It("should persist the time of the disruptive update on the node", func() {
+				nodeMetadata := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: node.Name}}
+				nodeMetadata.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Node"))
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(nodeMetadata), nodeMetadata)).To(Succeed())
+				nodeMetadata.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Node"))
+
+				Expect(r.recordDisruptiveUpdate(ctx, nodeMetadata)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+				Expect(node.Annotations).To(HaveKeyWithValue("node-agent.gardener.cloud/last-disruptive-update", "2024-01-01T12:00:00Z"))
+				Expect(lastDisruptiveUpdate(node)).To(Equal(fakeClock.Now()))
+			})
+		})
+
+		Describe("#completeDisruptiveUpdate", func() {
+			BeforeEach(func() {
+				metav1.SetMetaDataAnnotation(&node.ObjectMeta, "node-agent.gardener.cloud/last-disruptive-update", fakeClock.Now().Format(time.RFC3339Nano))
+				createSlot(0, "node1", fakeClock.Now())
+			})
+
+			It("should do nothing if disruptive updates are not coordinated", func() {
+				r.Config.DisruptiveUpdates = nil
+
+				Expect(r.completeDisruptiveUpdate(ctx, log, node)).To(BeZero())
+				Expect(slot(0).Spec.HolderIdentity).To(Equal(ptr.To("node1")))
+			})
+
+			It("should keep the slot and requeue if the node is not healthy yet", func() {
+				fakeClock.Step(time.Minute)
+				healthStatus.Record(fakeClock.Now(), false)
+
+				Expect(r.completeDisruptiveUpdate(ctx, log, node)).To(Equal(reconcile.Result{RequeueAfter: waitForHealthyPeriod}))
+				lease := slot(0)
+				Expect(lease.Spec.HolderIdentity).To(Equal(ptr.To("node1")))
+				Expect(lease.Spec.RenewTime.Time.Equal(fakeClock.Now())).To(BeTrue())
+			})
+
+			It("should keep the slot if the node was only healthy before the disruptive update", func() {
+				healthStatus.Record(fakeClock.Now().Add(-time.Second), true)
+
+				Expect(r.completeDisruptiveUpdate(ctx, log, node)).To(Equal(reconcile.Result{RequeueAfter: waitForHealthyPeriod}))
+				Expect(slot(0).Spec.HolderIdentity).To(Equal(ptr.To("node1")))
+			})
+
+			It("should release the slot once the node is healthy", func() {
+				fakeClock.Step(time.Minute)
+				healthStatus.Record(fakeClock.Now(), true)
+
+				Expect(r.completeDisruptiveUpdate(ctx, log, node)).To(BeZero())
+				Expect(slot(0).Spec.HolderIdentity).To(BeNil())
+			})
+
+			It("should release the slot if no disruptive update was recorded on the node", func() {
+				delete(node.Annotations, "node-agent.gardener.cloud/last-disruptive-update")
+				healthStatus.Record(fakeClock.Now().Add(-time.Second), true)
+
+				Expect(r.completeDisruptiveUpdate(ctx, log, node)).To(BeZero())
+				Expect(slot(0).Spec.HolderIdentity).To(BeNil())
+			})
+
+			It("should not touch slots held by other nodes", func() {
+				Expect(r.completeDisruptiveUpdate(ctx, log, newNode("node2"))).To(BeZero())
+				Expect(slot(0).Spec.HolderIdentity).To(Equal(ptr.To("node1")))
+			})
+		})
+	})
+})
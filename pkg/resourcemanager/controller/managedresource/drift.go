@@ -0,0 +1,297 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/resourcemanager/metrics"
+)
+
+// maxDriftedFields is the maximum number of drifted fields reported per object.
+const maxDriftedFields = 10
+
+var metricDriftedObjects = metrics.Factory.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "managedresource",
+		Name:      "drifted_objects",
+		Help:      "Number of objects of ManagedResources in report-only mode whose actual state deviates from their desired state.",
+	},
+	[]string{
+		"namespace",
+		"name",
+	},
+)
+
+// isReportOnly returns true if the resources of the given ManagedResource must not be written but only be checked for
+// drift.
+func isReportOnly(mr *resourcesv1alpha1.ManagedResource) bool {
+	return ptr.Deref(mr.Spec.Mode, resourcesv1alpha1.ManagedResourceModeEnforce) == resourcesv1alpha1.ManagedResourceModeReportOnly
+}
+
+// reportDrift reports the given drift (or the error which occurred while computing it) in the status of the
+// ManagedResource and in the drifted objects metric.
+func (r *Reconciler) reportDrift(ctx context.Context, log logr.Logger, mr *resourcesv1alpha1.ManagedResource, objectCount int, drift []resourcesv1alpha1.ObjectDrift, driftErr error) (reconcile.Result, error) {
+	conditionResourcesDrifted := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesDrifted)
+
+	if driftErr != nil {
+		conditionResourcesDrifted = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesDrifted, gardencorev1beta1.ConditionUnknown, resourcesv1alpha1.ConditionDriftDetectionFailed, driftErr.Error())
+		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesDrifted); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+		}
+
+		return reconcile.Result{}, fmt.Errorf("could not detect drift of resources: %w", driftErr)
+	}
+
+	metricDriftedObjects.WithLabelValues(mr.Namespace, mr.Name).Set(float64(len(drift)))
+
+	if len(drift) > 0 {
+		log.Info("Resources deviate from their desired state", "driftedObjects", len(drift))
+		conditionResourcesDrifted = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesDrifted, gardencorev1beta1.ConditionTrue, resourcesv1alpha1.ConditionDriftDetected, fmt.Sprintf("%d of %d objects deviate from their desired state.", len(drift), objectCount))
+	} else {
+		conditionResourcesDrifted = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesDrifted, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionNoDriftDetected, "All objects match their desired state.")
+	}
+
+	mr.Status.Conditions = v1beta1helper.MergeConditions(mr.Status.Conditions, conditionResourcesDrifted)
+	mr.Status.Drift = drift
+	mr.Status.ObservedGeneration = mr.Generation
+	if err := r.SourceClient.Status().Update(ctx, mr); err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+	}
+
+	log.Info("Finished to check ManagedResource for drift")
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// resetDrift removes the drift information from the status of the given ManagedResource (without updating it) and
+// from the drifted objects metric. It is used for ManagedResources which are not in report-only mode.
+func resetDrift(mr *resourcesv1alpha1.ManagedResource) {
+	mr.Status.Drift = nil
+	mr.Status.Conditions = v1beta1helper.RemoveConditions(mr.Status.Conditions, resourcesv1alpha1.ResourcesDrifted)
+	metricDriftedObjects.DeleteLabelValues(mr.Namespace, mr.Name)
+}
+
+// computeDrift compares the actual state of the given objects in the target cluster with the state they would have
+// after being applied by the controller. It does not write anything to the target cluster. If the given server-side
+// apply options are not nil, the expected state is computed by a server-side apply dry-run request under the
+// configured field manager, otherwise it is computed by merging the desired into the current state locally.
+func (r *Reconciler) computeDrift(ctx context.Context, origin string, newResourcesObjects []object, labelsToInject map[string]string, equivalences Equivalences, ssa *serverSideApplyOptions) ([]resourcesv1alpha1.ObjectDrift, error) {
+	horizontallyScaledObjects, verticallyScaledObjects, err := computeAllScaledObjectKeys(ctx, r.TargetClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute all HPA and HVPA target ref object keys: %w", err)
+	}
+
+	var drift []resourcesv1alpha1.ObjectDrift
+
+	for _, obj := range newResourcesObjects {
+		var (
+			desired     = obj.obj.DeepCopy()
+			resource    = unstructuredToString(desired)
+			objectDrift = resourcesv1alpha1.ObjectDrift{
+				APIVersion: desired.GetAPIVersion(),
+				Kind:       desired.GetKind(),
+				Namespace:  desired.GetNamespace(),
+				Name:       desired.GetName(),
+			}
+		)
+
+		current := &unstructured.Unstructured{}
+		current.SetGroupVersionKind(desired.GroupVersionKind())
+		if err := r.TargetClient.Get(ctx, client.ObjectKeyFromObject(desired), current); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("error getting object %q: %w", resource, err)
+			}
+
+			objectDrift.Missing = true
+			drift = append(drift, objectDrift)
+			continue
+		}
+
+		// Ignored objects are not updated once they exist, hence they cannot deviate from their desired state.
+		if ignore(desired) {
+			continue
+		}
+
+		if err := injectLabels(desired, labelsToInject); err != nil {
+			return nil, fmt.Errorf("error injecting labels into object %q: %w", resource, err)
+		}
+
+		var (
+			scaledHorizontally = isScaled(desired, horizontallyScaledObjects, equivalences)
+			scaledVertically   = isScaled(desired, verticallyScaledObjects, equivalences)
+			expected           *unstructured.Unstructured
+		)
+
+		if ssa != nil {
+			expected, err = r.dryRunServerSideApply(ctx, origin, desired, ssa, scaledHorizontally, scaledVertically)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			expected = current.DeepCopy()
+			if err := merge(origin, desired, expected, obj.forceOverwriteLabels, obj.oldInformation.Labels, obj.forceOverwriteAnnotations, obj.oldInformation.Annotations, scaledHorizontally, scaledVertically); err != nil {
+				return nil, fmt.Errorf("error merging object %q: %w", resource, err)
+			}
+		}
+
+		if fields := diffFields("", expected.Object, current.Object); len(fields) > 0 {
+			if len(fields) > maxDriftedFields {
+				fields = fields[:maxDriftedFields]
+			}
+			objectDrift.Fields = fields
+			drift = append(drift, objectDrift)
+		}
+	}
+
+	return drift, nil
+}
+
+// dryRunServerSideApply returns the state the given object would have after being applied with server-side apply. The
+// object is sent as a dry-run request, i.e., the API server computes the result (including the field ownership of
+// other field managers and defaulting) without persisting it.
+func (r *Reconciler) dryRunServerSideApply(ctx context.Context, origin string, desired *unstructured.Unstructured, ssa *serverSideApplyOptions, scaledHorizontally, scaledVertically bool) (*unstructured.Unstructured, error) {
+	resource := unstructuredToString(desired)
+
+	expected := desired.DeepCopy()
+	if err := prepareForServerSideApply(origin, expected, scaledHorizontally, scaledVertically); err != nil {
+		return nil, fmt.Errorf("error preparing object %q for server-side apply: %w", resource, err)
+	}
+
+	if err := r.TargetClient.Patch(ctx, expected, client.Apply, append(ssa.patchOptions(), client.DryRunAll)...); err != nil {
+		if apierrors.IsConflict(err) {
+			return nil, fmt.Errorf("conflict with other field managers during server-side apply dry-run of object %q: %w", resource, err)
+		}
+		return nil, fmt.Errorf("error during server-side apply dry-run of object %q: %w", resource, err)
+	}
+
+	// These fields change with every (dry-run) apply which modifies the object, hence they are not relevant for the
+	// drift. The relevant changes are reported for the fields which caused them.
+	unstructured.RemoveNestedField(expected.Object, "metadata", "managedFields")
+	unstructured.RemoveNestedField(expected.Object, "metadata", "resourceVersion")
+	unstructured.RemoveNestedField(expected.Object, "metadata", "generation")
+
+	return expected, nil
+}
+
+// diffFields returns the paths of all fields whose value in `current` deviates from the value in `expected`. Fields
+// which are only present in `current` are not considered since they are typically defaulted by the API server, except
+// for labels and annotations. Zero values are considered equal to absent fields.
+func diffFields(path string, expected, current interface{}) []string {
+	if isZero(expected) && isZero(current) {
+		return nil
+	}
+
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		c, ok := current.(map[string]interface{})
+		if !ok {
+			return []string{path}
+		}
+
+		keys := make([]string, 0, len(e))
+		for k := range e {
+			keys = append(keys, k)
+		}
+		if path == ".metadata.labels" || path == ".metadata.annotations" {
+			for k := range c {
+				if _, ok := e[k]; !ok {
+					keys = append(keys, k)
+				}
+			}
+		}
+		slices.Sort(keys)
+
+		var fields []string
+		for _, k := range keys {
+			fields = append(fields, diffFields(fieldPath(path, k), e[k], c[k])...)
+		}
+		return fields
+
+	case []interface{}:
+		c, ok := current.([]interface{})
+		if !ok || len(c) != len(e) {
+			return []string{path}
+		}
+
+		var fields []string
+		for i := range e {
+			fields = append(fields, diffFields(fmt.Sprintf("%s[%d]", path, i), e[i], c[i])...)
+		}
+		return fields
+	}
+
+	if !scalarsEqual(expected, current) {
+		return []string{path}
+	}
+	return nil
+}
+
+var identifier = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+func fieldPath(path, key string) string {
+	if identifier.MatchString(key) {
+		return path + "." + key
+	}
+	return path + "[" + strconv.Quote(key) + "]"
+}
+
+func isZero(v interface{}) bool {
+	switch t := v.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(t) == 0
+	case []interface{}:
+		return len(t) == 0
+	}
+
+	if f, ok := toFloat64(v); ok {
+		return f == 0
+	}
+	return reflect.ValueOf(v).IsZero()
+}
+
+// scalarsEqual compares two scalar values. Numbers are compared by value since objects decoded from JSON contain
+// float64 values while objects converted from typed objects contain int64 values.
+func scalarsEqual(a, b interface{}) bool {
+	if af, ok := toFloat64(a); ok {
+		bf, ok := toFloat64(b)
+		return ok && af == bf
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func toFloat64(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
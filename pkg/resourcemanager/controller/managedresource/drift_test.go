@@ -0,0 +1,386 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+	resourcemanagerclient "github.com/gardener/gardener/pkg/resourcemanager/client"
+)
+
+var _ = Describe("Drift", func() {
+	Describe("#isReportOnly", func() {
+		It("should return false if no mode is set", func() {
+			Expect(isReportOnly(&resourcesv1alpha1.ManagedResource{})).To(BeFalse())
+		})
+
+		It("should return false for the enforce mode", func() {
+			Expect(isReportOnly(&resourcesv1alpha1.ManagedResource{Spec: resourcesv1alpha1.ManagedResourceSpec{Mode: ptr.To(resourcesv1alpha1.ManagedResourceModeEnforce)}})).To(BeFalse())
+		})
+
+		It("should return true for the report-only mode", func() {
+			Expect(isReportOnly(&resourcesv1alpha1.ManagedResource{Spec: resourcesv1alpha1.ManagedResourceSpec{Mode: ptr.To(resourcesv1alpha1.ManagedResourceModeReportOnly)}})).To(BeTrue())
+		})
+	})
+
+	Describe("#diffFields", func() {
+		It("should not report equal objects", func() {
+			Expect(diffFields("", map[string]interface{}{
+				"spec": map[string]interface{}{"replicas": float64(1), "paused": false, "selector": map[string]interface{}{}},
+			}, map[string]interface{}{
+				"spec": map[string]interface{}{"replicas": int64(1)},
+			})).To(BeEmpty())
+		})
+
+		It("should ignore fields which are only present in the current object", func() {
+			Expect(diffFields("", map[string]interface{}{
+				"spec": map[string]interface{}{"foo": "bar"},
+			}, map[string]interface{}{
+				"spec": map[string]interface{}{"foo": "bar", "defaulted": "value"},
+			})).To(BeEmpty())
+		})
+
+		It("should report changed, added and removed fields", func() {
+			Expect(diffFields("", map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"labels": map[string]interface{}{"app": "foo", "app.kubernetes.io/name": "foo"},
+				},
+				"spec": map[string]interface{}{
+					"replicas": int64(2),
+					"containers": []interface{}{
+						map[string]interface{}{"name": "foo", "image": "foo:v2"},
+					},
+					"ports": []interface{}{int64(80)},
+				},
+			}, map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"labels": map[string]interface{}{"app": "foo", "added": "label"},
+				},
+				"spec": map[string]interface{}{
+					"replicas": int64(1),
+					"containers": []interface{}{
+						map[string]interface{}{"name": "foo", "image": "foo:v1"},
+					},
+					"ports": []interface{}{int64(80), int64(443)},
+				},
+			})).To(Equal([]string{
+				`.metadata.labels.added`,
+				`.metadata.labels["app.kubernetes.io/name"]`,
+				`.spec.containers[0].image`,
+				`.spec.ports`,
+				`.spec.replicas`,
+			}))
+		})
+	})
+
+	Describe("#computeDrift and #reportDrift", func() {
+		var (
+			ctx = context.Background()
+			log = logr.Discard()
+
+			sourceClient client.Client
+			fakeClock    *testclock.FakeClock
+			r            *Reconciler
+
+			// newTargetClient returns a client for the target cluster which fails the test on write requests.
+			newTargetClient = func(objs ...client.Object) client.Client {
+				return fakeclient.NewClientBuilder().WithScheme(resourcemanagerclient.TargetScheme).WithObjects(objs...).WithInterceptorFuncs(interceptor.Funcs{
+					Create: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.CreateOption) error {
+						Fail("unexpected create call")
+						return nil
+					},
+					Update: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.UpdateOption) error {
+						Fail("unexpected update call")
+						return nil
+					},
+					Patch: func(_ context.Context, _ client.WithWatch, _ client.Object, _ client.Patch, _ ...client.PatchOption) error {
+						Fail("unexpected patch call")
+						return nil
+					},
+					Delete: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.DeleteOption) error {
+						Fail("unexpected delete call")
+						return nil
+					},
+				}).Build()
+			}
+
+			mr                          *resourcesv1alpha1.ManagedResource
+			configMap, desiredConfigMap *unstructured.Unstructured
+			injectLabels                = map[string]string{resourcesv1alpha1.ManagedBy: "gardener"}
+		)
+
+		BeforeEach(func() {
+			mr = &resourcesv1alpha1.ManagedResource{
+				ObjectMeta: metav1.ObjectMeta{Name: "mr", Namespace: "default", Generation: 2},
+				Spec:       resourcesv1alpha1.ManagedResourceSpec{Mode: ptr.To(resourcesv1alpha1.ManagedResourceModeReportOnly)},
+			}
+
+			sourceClient = fakeclient.NewClientBuilder().WithScheme(resourcemanagerclient.CombinedScheme).WithObjects(mr).WithStatusSubresource(mr).Build()
+			fakeClock = testclock.NewFakeClock(time.Now())
+
+			r = &Reconciler{
+				SourceClient: sourceClient,
+				TargetClient: newTargetClient(),
+				Clock:        fakeClock,
+				Config:       config.ManagedResourceControllerConfig{SyncPeriod: &metav1.Duration{Duration: time.Minute}},
+			}
+
+			desiredConfigMap = &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata": map[string]interface{}{
+					"name":      "foo",
+					"namespace": "bar",
+				},
+				"data": map[string]interface{}{
+					"foo": "bar",
+				},
+			}}
+
+			configMap = desiredConfigMap.DeepCopy()
+			configMap.SetLabels(injectLabels)
+			configMap.SetAnnotations(map[string]string{
+				descriptionAnnotation:              descriptionAnnotationText,
+				resourcesv1alpha1.OriginAnnotation: "origin",
+			})
+		})
+
+		It("should report missing objects", func() {
+			drift, err := r.computeDrift(ctx, "origin", []object{{obj: desiredConfigMap}}, injectLabels, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drift).To(Equal([]resourcesv1alpha1.ObjectDrift{{APIVersion: "v1", Kind: "ConfigMap", Namespace: "bar", Name: "foo", Missing: true}}))
+		})
+
+		It("should not report objects matching their desired state", func() {
+			r.TargetClient = newTargetClient(configMap)
+
+			drift, err := r.computeDrift(ctx, "origin", []object{{obj: desiredConfigMap}}, injectLabels, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drift).To(BeEmpty())
+		})
+
+		It("should not report fields defaulted by the API server", func() {
+			desiredDeployment := &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata": map[string]interface{}{
+					"name":              "foo",
+					"namespace":         "bar",
+					"creationTimestamp": nil,
+				},
+				"spec": map[string]interface{}{
+					"replicas": float64(1),
+					"selector": map[string]interface{}{"matchLabels": map[string]interface{}{"app": "foo"}},
+					"template": map[string]interface{}{
+						"metadata": map[string]interface{}{"labels": map[string]interface{}{"app": "foo"}},
+						"spec": map[string]interface{}{
+							"containers": []interface{}{
+								map[string]interface{}{"name": "foo", "image": "foo:v1", "resources": map[string]interface{}{}},
+							},
+						},
+					},
+				},
+				"status": map[string]interface{}{},
+			}}
+
+			deployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "foo",
+					Namespace:   "bar",
+					Labels:      injectLabels,
+					Annotations: map[string]string{descriptionAnnotation: descriptionAnnotationText, resourcesv1alpha1.OriginAnnotation: "origin"},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas:             ptr.To[int32](1),
+					RevisionHistoryLimit: ptr.To[int32](10),
+					Selector:             &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+					Strategy:             appsv1.DeploymentStrategy{Type: appsv1.RollingUpdateDeploymentStrategyType},
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "foo", resourcesv1alpha1.ManagedBy: "gardener"}},
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{
+								Name:                     "foo",
+								Image:                    "foo:v1",
+								ImagePullPolicy:          corev1.PullIfNotPresent,
+								TerminationMessagePath:   corev1.TerminationMessagePathDefault,
+								TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+							}},
+							RestartPolicy: corev1.RestartPolicyAlways,
+						},
+					},
+				},
+				Status: appsv1.DeploymentStatus{Replicas: 1},
+			}
+			r.TargetClient = newTargetClient(deployment)
+
+			drift, err := r.computeDrift(ctx, "origin", []object{{obj: desiredDeployment}}, injectLabels, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drift).To(BeEmpty())
+		})
+
+		It("should report objects deviating from their desired state without changing them", func() {
+			configMap.Object["data"] = map[string]interface{}{"foo": "baz"}
+			r.TargetClient = newTargetClient(configMap)
+
+			drift, err := r.computeDrift(ctx, "origin", []object{{obj: desiredConfigMap}}, injectLabels, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drift).To(Equal([]resourcesv1alpha1.ObjectDrift{{APIVersion: "v1", Kind: "ConfigMap", Namespace: "bar", Name: "foo", Fields: []string{".data.foo"}}}))
+
+			current := &corev1.ConfigMap{}
+			Expect(r.TargetClient.Get(ctx, client.ObjectKey{Namespace: "bar", Name: "foo"}, current)).To(Succeed())
+			Expect(current.Data).To(Equal(map[string]string{"foo": "baz"}))
+		})
+
+		It("should not report ignored objects", func() {
+			configMap.Object["data"] = map[string]interface{}{"foo": "baz"}
+			desiredConfigMap.SetAnnotations(map[string]string{resourcesv1alpha1.Ignore: "true"})
+			r.TargetClient = newTargetClient(configMap)
+
+			drift, err := r.computeDrift(ctx, "origin", []object{{obj: desiredConfigMap}}, injectLabels, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drift).To(BeEmpty())
+		})
+
+		Context("server-side apply", func() {
+			var (
+				ssa          *serverSideApplyOptions
+				patchOptions []client.PatchOptions
+				patchErr     error
+
+				// newDryRunTargetClient returns a client for the target cluster which simulates server-side apply
+				// dry-run requests by merging the data of the applied object into the stored object.
+				newDryRunTargetClient = func(objs ...client.Object) client.Client {
+					return fakeclient.NewClientBuilder().WithScheme(resourcemanagerclient.TargetScheme).WithObjects(objs...).WithInterceptorFuncs(interceptor.Funcs{
+						Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+							options := client.PatchOptions{}
+							options.ApplyOptions(opts)
+							patchOptions = append(patchOptions, options)
+
+							Expect(patch.Type()).To(Equal(types.ApplyPatchType))
+							Expect(options.DryRun).To(ConsistOf(metav1.DryRunAll), "unexpected patch call without dry-run")
+
+							if patchErr != nil {
+								return patchErr
+							}
+
+							applied := obj.(*unstructured.Unstructured)
+							current := &unstructured.Unstructured{}
+							current.SetGroupVersionKind(applied.GroupVersionKind())
+							if err := c.Get(ctx, client.ObjectKeyFromObject(applied), current); err != nil {
+								return err
+							}
+
+							data, _, _ := unstructured.NestedStringMap(current.Object, "data")
+							appliedData, _, _ := unstructured.NestedStringMap(applied.Object, "data")
+							for k, v := range appliedData {
+								data[k] = v
+							}
+							Expect(unstructured.SetNestedStringMap(current.Object, data, "data")).To(Succeed())
+							current.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: options.FieldManager, Operation: metav1.ManagedFieldsOperationApply}})
+							current.SetResourceVersion("2")
+
+							applied.Object = current.Object
+							return nil
+						},
+					}).Build()
+				}
+			)
+
+			BeforeEach(func() {
+				ssa = &serverSideApplyOptions{fieldManager: "gardener-resource-manager", forceConflicts: true}
+				patchOptions, patchErr = nil, nil
+
+				// Fields of other field managers are not touched by server-side apply, hence they are no drift.
+				configMap.Object["data"] = map[string]interface{}{"foo": "bar", "other": "value"}
+			})
+
+			It("should not report objects matching their desired state", func() {
+				r.TargetClient = newDryRunTargetClient(configMap)
+
+				drift, err := r.computeDrift(ctx, "origin", []object{{obj: desiredConfigMap}}, injectLabels, nil, ssa)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(drift).To(BeEmpty())
+
+				Expect(patchOptions).To(HaveLen(1))
+				Expect(patchOptions[0].FieldManager).To(Equal("gardener-resource-manager"))
+				Expect(patchOptions[0].Force).To(Equal(ptr.To(true)))
+			})
+
+			It("should report objects deviating from their desired state without changing them", func() {
+				configMap.Object["data"] = map[string]interface{}{"foo": "baz", "other": "value"}
+				r.TargetClient = newDryRunTargetClient(configMap)
+
+				drift, err := r.computeDrift(ctx, "origin", []object{{obj: desiredConfigMap}}, injectLabels, nil, ssa)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(drift).To(Equal([]resourcesv1alpha1.ObjectDrift{{APIVersion: "v1", Kind: "ConfigMap", Namespace: "bar", Name: "foo", Fields: []string{".data.foo"}}}))
+
+				current := &corev1.ConfigMap{}
+				Expect(r.TargetClient.Get(ctx, client.ObjectKey{Namespace: "bar", Name: "foo"}, current)).To(Succeed())
+				Expect(current.Data).To(Equal(map[string]string{"foo": "baz", "other": "value"}))
+			})
+
+			It("should return an error in case of conflicts with other field managers", func() {
+				ssa.forceConflicts = false
+				patchErr = apierrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, "foo", fmt.Errorf("conflict with \"other\""))
+				r.TargetClient = newDryRunTargetClient(configMap)
+
+				_, err := r.computeDrift(ctx, "origin", []object{{obj: desiredConfigMap}}, injectLabels, nil, ssa)
+				Expect(err).To(MatchError(ContainSubstring("conflict with other field managers during server-side apply dry-run of object")))
+				Expect(patchOptions[0].Force).To(BeNil())
+			})
+		})
+
+		It("should update the status of the ManagedResource if drift was detected", func() {
+			drift := []resourcesv1alpha1.ObjectDrift{{APIVersion: "v1", Kind: "ConfigMap", Namespace: "bar", Name: "foo", Missing: true}}
+
+			Expect(r.reportDrift(ctx, log, mr, 2, drift, nil)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+			Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(mr), mr)).To(Succeed())
+			Expect(mr.Status.Drift).To(Equal(drift))
+			Expect(mr.Status.ObservedGeneration).To(Equal(int64(2)))
+			condition := v1beta1helper.GetCondition(mr.Status.Conditions, resourcesv1alpha1.ResourcesDrifted)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(resourcesv1alpha1.ConditionDriftDetected))
+			Expect(condition.Message).To(Equal("1 of 2 objects deviate from their desired state."))
+		})
+
+		It("should update the status of the ManagedResource if no drift was detected", func() {
+			mr.Status.Drift = []resourcesv1alpha1.ObjectDrift{{APIVersion: "v1", Kind: "ConfigMap", Namespace: "bar", Name: "foo", Missing: true}}
+
+			Expect(r.reportDrift(ctx, log, mr, 2, nil, nil)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+			Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(mr), mr)).To(Succeed())
+			Expect(mr.Status.Drift).To(BeEmpty())
+			condition := v1beta1helper.GetCondition(mr.Status.Conditions, resourcesv1alpha1.ResourcesDrifted)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(resourcesv1alpha1.ConditionNoDriftDetected))
+		})
+	})
+})
@@ -282,6 +282,12 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 	// (otherwise, the order will be different on each update)
 	sortObjectReferences(newResourcesObjectReferences)
 
+	if isReportOnly(mr) {
+		injectLabels := mergeMaps(mr.Spec.InjectLabels, map[string]string{resourcesv1alpha1.ManagedBy: *r.Config.ManagedByLabelValue})
+		drift, err := r.computeDrift(reconcileCtx, origin, newResourcesObjects, injectLabels, equivalences, serverSideApplyOptionsFor(mr))
+		return r.reportDrift(ctx, log, mr, len(newResourcesObjects), drift, err)
+	}
+
 	// invalidate conditions, if resources have been added/removed from the managed resource
 	if !apiequality.Semantic.DeepEqual(mr.Status.Resources, newResourcesObjectReferences) || mr.Status.SecretsDataChecksum == nil || *mr.Status.SecretsDataChecksum != secretsDataChecksum {
 		conditionResourcesHealthy := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesHealthy)
@@ -343,7 +349,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 	}
 
 	injectLabels := mergeMaps(mr.Spec.InjectLabels, map[string]string{resourcesv1alpha1.ManagedBy: *r.Config.ManagedByLabelValue})
-	if err := r.applyNewResources(reconcileCtx, log, origin, newResourcesObjects, injectLabels, equivalences); err != nil {
+	if err := r.applyNewResources(reconcileCtx, log, origin, newResourcesObjects, injectLabels, equivalences, serverSideApplyOptionsFor(mr)); err != nil {
 		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionApplyFailed, err.Error())
 		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
 			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
@@ -358,6 +364,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionTrue, resourcesv1alpha1.ConditionApplySucceeded, "All resources are applied.")
 	}
 
+	resetDrift(mr)
 	if err := updateManagedResourceStatus(ctx, r.SourceClient, mr, &secretsDataChecksum, newResourcesObjectReferences, conditionResourcesApplied); err != nil {
 		return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
 	}
@@ -378,7 +385,9 @@ func (r *Reconciler) delete(ctx context.Context, log logr.Logger, mr *resourcesv
 
 	conditionResourcesApplied := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesApplied)
 
-	if keepObjects := mr.Spec.KeepObjects; keepObjects == nil || !*keepObjects {
+	if isReportOnly(mr) {
+		log.Info("Skipping deletion of objects as ManagedResource is in report-only mode")
+	} else if keepObjects := mr.Spec.KeepObjects; keepObjects == nil || !*keepObjects {
 		existingResourcesIndex := NewObjectIndex(mr.Status.Resources, nil)
 
 		msg := "The resources are currently being deleted."
@@ -423,6 +432,7 @@ func (r *Reconciler) delete(ctx context.Context, log logr.Logger, mr *resourcesv
 	}
 
 	log.Info("All resources have been deleted")
+	metricDriftedObjects.DeleteLabelValues(mr.Namespace, mr.Name)
 
 	if controllerutil.ContainsFinalizer(mr, r.ClassFilter.FinalizerName()) {
 		log.Info("Removing finalizer")
@@ -461,7 +471,7 @@ func (r *Reconciler) updateConditionsForDeletion(ctx context.Context, mr *resour
 	return updateConditions(ctx, r.SourceClient, mr, conditionResourcesHealthy, conditionResourcesProgressing)
 }
 
-func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, origin string, newResourcesObjects []object, labelsToInject map[string]string, equivalences Equivalences) error {
+func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, origin string, newResourcesObjects []object, labelsToInject map[string]string, equivalences Equivalences, ssa *serverSideApplyOptions) error {
 	newResourcesObjects = sortByKind(newResourcesObjects)
 
 	// get all HPA and HVPA targetRefs to check if we should prevent overwriting replicas and/or resource requirements.
@@ -484,6 +494,16 @@ func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, ori
 
 		resourceLogger.V(1).Info("Applying")
 
+		// Ignored objects are only created if they do not exist yet, hence they are always handled by the update strategy.
+		if ssa != nil && !ignore(obj.obj) {
+			if err := r.applyWithServerSideApply(ctx, obj.obj, origin, labelsToInject, ssa, scaledHorizontally, scaledVertically); err != nil {
+				return err
+			}
+
+			resourceLogger.V(1).Info("Applied resource with server-side apply", "fieldManager", ssa.fieldManager)
+			continue
+		}
+
 		operationResult, err := controllerutils.TypedCreateOrUpdate(ctx, r.TargetClient, r.TargetScheme, current, ptr.Deref(r.Config.AlwaysUpdate, false), func() error {
 			metadata, err := meta.Accessor(obj.obj)
 			if err != nil {
@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+// defaultFieldManager is the name of the field manager used for server-side apply if the ManagedResource does not
+// specify one.
+const defaultFieldManager = "gardener-resource-manager"
+
+// serverSideApplyOptions contains the settings for applying resources with server-side apply.
+type serverSideApplyOptions struct {
+	fieldManager   string
+	forceConflicts bool
+}
+
+// serverSideApplyOptionsFor returns the server-side apply settings for the given ManagedResource. It returns nil if the
+// resources of the ManagedResource are not applied with server-side apply.
+func serverSideApplyOptionsFor(mr *resourcesv1alpha1.ManagedResource) *serverSideApplyOptions {
+	if ptr.Deref(mr.Spec.ApplyStrategy, resourcesv1alpha1.ApplyStrategyUpdate) != resourcesv1alpha1.ApplyStrategyServerSideApply {
+		return nil
+	}
+
+	opts := &serverSideApplyOptions{
+		fieldManager:   defaultFieldManager,
+		forceConflicts: true,
+	}
+
+	if config := mr.Spec.ServerSideApply; config != nil {
+		opts.fieldManager = ptr.Deref(config.FieldManager, opts.fieldManager)
+		opts.forceConflicts = ptr.Deref(config.ForceConflicts, opts.forceConflicts)
+	}
+
+	return opts
+}
+
+// patchOptions returns the options for the server-side apply patch request.
+func (o *serverSideApplyOptions) patchOptions() []client.PatchOption {
+	opts := []client.PatchOption{client.FieldOwner(o.fieldManager)}
+	if o.forceConflicts {
+		opts = append(opts, client.ForceOwnership)
+	}
+	return opts
+}
+
+// applyWithServerSideApply injects the given labels into the desired object and applies it with server-side apply.
+func (r *Reconciler) applyWithServerSideApply(ctx context.Context, obj *unstructured.Unstructured, origin string, labelsToInject map[string]string, opts *serverSideApplyOptions, scaledHorizontally, scaledVertically bool) error {
+	resource := unstructuredToString(obj)
+
+	if err := injectLabels(obj, labelsToInject); err != nil {
+		return fmt.Errorf("error injecting labels into object %q: %s", resource, err)
+	}
+
+	if err := prepareForServerSideApply(origin, obj, scaledHorizontally, scaledVertically); err != nil {
+		return fmt.Errorf("error preparing object %q for server-side apply: %s", resource, err)
+	}
+
+	if err := r.TargetClient.Patch(ctx, obj, client.Apply, opts.patchOptions()...); err != nil {
+		if apierrors.IsConflict(err) {
+			return fmt.Errorf("conflict with other field managers during server-side apply of object %q (set .spec.serverSideApply.forceConflicts=true to take over the ownership of the conflicting fields): %s", resource, err)
+		}
+
+		if apierrors.IsInvalid(err) && deleteOnInvalidUpdate(obj, err) {
+			if deleteErr := r.TargetClient.Delete(ctx, obj); client.IgnoreNotFound(deleteErr) != nil {
+				return fmt.Errorf("error deleting object %q after 'invalid' update error: %s", resource, deleteErr)
+			}
+			// return error directly, so that the apply after delete will be retried
+			return fmt.Errorf("deleted object %q because of 'invalid' update error, and 'delete-on-invalid-update' annotation on object or the resource is an immutable ConfigMap/Secret: %s", resource, err)
+		}
+
+		return fmt.Errorf("error during server-side apply of object %q: %s", resource, err)
+	}
+
+	return nil
+}
+
+var (
+	replicasGroupKinds = []schema.GroupKind{
+		appsv1.SchemeGroupVersion.WithKind("Deployment").GroupKind(),
+		extensionsv1beta1.SchemeGroupVersion.WithKind("Deployment").GroupKind(),
+		appsv1.SchemeGroupVersion.WithKind("StatefulSet").GroupKind(),
+		extensionsv1beta1.SchemeGroupVersion.WithKind("StatefulSet").GroupKind(),
+	}
+
+	podTemplatePaths = map[schema.GroupKind][]string{
+		appsv1.SchemeGroupVersion.WithKind("Deployment").GroupKind():             {"spec", "template"},
+		extensionsv1beta1.SchemeGroupVersion.WithKind("Deployment").GroupKind():  {"spec", "template"},
+		appsv1.SchemeGroupVersion.WithKind("StatefulSet").GroupKind():            {"spec", "template"},
+		extensionsv1beta1.SchemeGroupVersion.WithKind("StatefulSet").GroupKind(): {"spec", "template"},
+		appsv1.SchemeGroupVersion.WithKind("DaemonSet").GroupKind():              {"spec", "template"},
+		batchv1.SchemeGroupVersion.WithKind("Job").GroupKind():                   {"spec", "template"},
+		batchv1.SchemeGroupVersion.WithKind("CronJob").GroupKind():               {"spec", "jobTemplate", "spec", "template"},
+	}
+)
+
+// prepareForServerSideApply prepares the desired object for being applied with server-side apply. Other than merge, it
+// does not need to preserve fields of the current object since server-side apply only touches fields contained in the
+// applied configuration. Fields managed by autoscalers (replicas and resource requirements) are dropped from the
+// applied configuration so that their ownership remains with the autoscalers.
+func prepareForServerSideApply(origin string, obj *unstructured.Unstructured, preserveReplicas, preserveResources bool) error {
+	obj.SetResourceVersion("")
+	obj.SetManagedFields(nil)
+	delete(obj.Object, "status")
+
+	annotations := obj.GetAnnotations()
+	if annotations[resourcesv1alpha1.PreserveReplicas] == "true" {
+		preserveReplicas = true
+	}
+	if annotations[resourcesv1alpha1.PreserveResources] == "true" {
+		preserveResources = true
+	}
+
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[descriptionAnnotation] = descriptionAnnotationText
+	annotations[resourcesv1alpha1.OriginAnnotation] = origin
+	obj.SetAnnotations(annotations)
+
+	groupKind := obj.GroupVersionKind().GroupKind()
+
+	if preserveReplicas && slices.Contains(replicasGroupKinds, groupKind) {
+		unstructured.RemoveNestedField(obj.Object, "spec", "replicas")
+	}
+
+	if path, ok := podTemplatePaths[groupKind]; ok && preserveResources {
+		return dropContainerResources(obj, slices.Concat(path, []string{"spec", "containers"})...)
+	}
+
+	return nil
+}
+
+// dropContainerResources removes the CPU and memory requests and limits of all containers at the given path.
+func dropContainerResources(obj *unstructured.Unstructured, path ...string) error {
+	containers, found, err := unstructured.NestedSlice(obj.Object, path...)
+	if err != nil || !found {
+		return err
+	}
+
+	for _, c := range containers {
+		container, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, field := range []string{"requests", "limits"} {
+			for _, resourceName := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+				unstructured.RemoveNestedField(container, "resources", field, string(resourceName))
+			}
+		}
+	}
+
+	return unstructured.SetNestedSlice(obj.Object, containers, path...)
+}
@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+var _ = Describe("ServerSideApply", func() {
+	Describe("#serverSideApplyOptionsFor", func() {
+		var mr *resourcesv1alpha1.ManagedResource
+
+		BeforeEach(func() {
+			mr = &resourcesv1alpha1.ManagedResource{}
+		})
+
+		It("should return nil if no apply strategy is set", func() {
+			Expect(serverSideApplyOptionsFor(mr)).To(BeNil())
+		})
+
+		It("should return nil for the update strategy", func() {
+			mr.Spec.ApplyStrategy = ptr.To(resourcesv1alpha1.ApplyStrategyUpdate)
+			Expect(serverSideApplyOptionsFor(mr)).To(BeNil())
+		})
+
+		It("should return the default options for the server-side apply strategy", func() {
+			mr.Spec.ApplyStrategy = ptr.To(resourcesv1alpha1.ApplyStrategyServerSideApply)
+			Expect(serverSideApplyOptionsFor(mr)).To(Equal(&serverSideApplyOptions{fieldManager: "gardener-resource-manager", forceConflicts: true}))
+		})
+
+		It("should return the configured options for the server-side apply strategy", func() {
+			mr.Spec.ApplyStrategy = ptr.To(resourcesv1alpha1.ApplyStrategyServerSideApply)
+			mr.Spec.ServerSideApply = &resourcesv1alpha1.ServerSideApplyConfig{
+				FieldManager:   ptr.To("foo"),
+				ForceConflicts: ptr.To(false),
+			}
+			Expect(serverSideApplyOptionsFor(mr)).To(Equal(&serverSideApplyOptions{fieldManager: "foo", forceConflicts: false}))
+		})
+	})
+
+	Describe("#prepareForServerSideApply", func() {
+		var obj *unstructured.Unstructured
+
+		BeforeEach(func() {
+			obj = &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata": map[string]interface{}{
+					"name":            "foo",
+					"namespace":       "bar",
+					"resourceVersion": "42",
+				},
+				"spec": map[string]interface{}{
+					"replicas": int64(2),
+					"template": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"containers": []interface{}{
+								map[string]interface{}{
+									"name": "foo",
+									"resources": map[string]interface{}{
+										"requests": map[string]interface{}{"cpu": "100m", "memory": "100Mi", "ephemeral-storage": "1Gi"},
+										"limits":   map[string]interface{}{"memory": "200Mi"},
+									},
+								},
+							},
+						},
+					},
+				},
+				"status": map[string]interface{}{
+					"replicas": int64(2),
+				},
+			}}
+		})
+
+		It("should add the annotations and drop the resource version and status", func() {
+			Expect(prepareForServerSideApply("origin", obj, false, false)).To(Succeed())
+
+			Expect(obj.GetResourceVersion()).To(BeEmpty())
+			Expect(obj.Object).NotTo(HaveKey("status"))
+			Expect(obj.GetAnnotations()).To(Equal(map[string]string{
+				"resources.gardener.cloud/description": descriptionAnnotationText,
+				"resources.gardener.cloud/origin":      "origin",
+			}))
+			Expect(obj.Object).To(HaveKeyWithValue("spec", HaveKeyWithValue("replicas", int64(2))))
+		})
+
+		It("should drop the replicas if the object is scaled horizontally", func() {
+			Expect(prepareForServerSideApply("origin", obj, true, false)).To(Succeed())
+
+			Expect(obj.Object).To(HaveKeyWithValue("spec", Not(HaveKey("replicas"))))
+		})
+
+		It("should drop the replicas if the object has the preserve-replicas annotation", func() {
+			obj.SetAnnotations(map[string]string{resourcesv1alpha1.PreserveReplicas: "true"})
+
+			Expect(prepareForServerSideApply("origin", obj, false, false)).To(Succeed())
+
+			Expect(obj.Object).To(HaveKeyWithValue("spec", Not(HaveKey("replicas"))))
+		})
+
+		It("should drop the CPU and memory resources if the object is scaled vertically", func() {
+			Expect(prepareForServerSideApply("origin", obj, false, true)).To(Succeed())
+
+			resources, found, err := unstructured.NestedMap(obj.Object["spec"].(map[string]interface{})["template"].(map[string]interface{})["spec"].(map[string]interface{})["containers"].([]interface{})[0].(map[string]interface{}), "resources")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(resources).To(Equal(map[string]interface{}{
+				"requests": map[string]interface{}{"ephemeral-storage": "1Gi"},
+				"limits":   map[string]interface{}{},
+			}))
+			Expect(obj.Object).To(HaveKeyWithValue("spec", HaveKeyWithValue("replicas", int64(2))))
+		})
+	})
+
+	Describe("#applyWithServerSideApply", func() {
+		var (
+			ctx = context.Background()
+
+			obj     *unstructured.Unstructured
+			patches []client.Patch
+			options []client.PatchOptions
+			err     error
+
+			r *Reconciler
+		)
+
+		BeforeEach(func() {
+			obj = &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata": map[string]interface{}{
+					"name":      "foo",
+					"namespace": "bar",
+				},
+			}}
+			patches, options, err = nil, nil, nil
+
+			r = &Reconciler{
+				TargetClient: fakeclient.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+					Patch: func(_ context.Context, _ client.WithWatch, _ client.Object, patch client.Patch, opts ...client.PatchOption) error {
+						patchOptions := client.PatchOptions{}
+						patchOptions.ApplyOptions(opts)
+
+						patches = append(patches, patch)
+						options = append(options, patchOptions)
+						return err
+					},
+				}).Build(),
+			}
+		})
+
+		It("should apply the object with the configured field manager", func() {
+			Expect(r.applyWithServerSideApply(ctx, obj, "origin", map[string]string{"foo": "bar"}, &serverSideApplyOptions{fieldManager: "test", forceConflicts: true}, false, false)).To(Succeed())
+
+			Expect(patches).To(HaveLen(1))
+			Expect(patches[0].Type()).To(Equal(types.ApplyPatchType))
+			Expect(options[0].FieldManager).To(Equal("test"))
+			Expect(options[0].Force).To(Equal(ptr.To(true)))
+			Expect(obj.GetLabels()).To(Equal(map[string]string{"foo": "bar"}))
+			Expect(obj.GetAnnotations()).To(HaveKeyWithValue("resources.gardener.cloud/origin", "origin"))
+		})
+
+		It("should not force the ownership if conflicts should not be forced", func() {
+			Expect(r.applyWithServerSideApply(ctx, obj, "origin", nil, &serverSideApplyOptions{fieldManager: "test"}, false, false)).To(Succeed())
+
+			Expect(patches).To(HaveLen(1))
+			Expect(options[0].Force).To(BeNil())
+		})
+
+		It("should return a descriptive error in case of conflicts", func() {
+			err = apierrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, "foo", fmt.Errorf("conflict with \"other\""))
+
+			Expect(r.applyWithServerSideApply(ctx, obj, "origin", nil, &serverSideApplyOptions{fieldManager: "test"}, false, false)).To(MatchError(And(
+				ContainSubstring("conflict with other field managers during server-side apply of object"),
+				ContainSubstring("forceConflicts=true"),
+			)))
+		})
+	})
+})
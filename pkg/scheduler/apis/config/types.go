@@ -16,6 +16,9 @@ const (
 	SameRegion CandidateDeterminationStrategy = "SameRegion"
 	// MinimalDistance Strategy determines a seed candidate for a shoot if the cloud profile are identical. Then chooses the seed with the minimal distance to the shoot.
 	MinimalDistance CandidateDeterminationStrategy = "MinimalDistance"
+	// Score Strategy does not narrow down the seed candidates by region. Instead, the configured score plugins decide
+	// which of the remaining seeds is chosen.
+	Score CandidateDeterminationStrategy = "Score"
 	// Default Strategy is the default strategy to use when there is no configuration provided
 	Default CandidateDeterminationStrategy = SameRegion
 	// SchedulerDefaultLockObjectNamespace is the default lock namespace for leader election.
@@ -31,7 +34,7 @@ const (
 )
 
 // Strategies defines all currently implemented SeedCandidateDeterminationStrategies
-var Strategies = []CandidateDeterminationStrategy{SameRegion, MinimalDistance}
+var Strategies = []CandidateDeterminationStrategy{SameRegion, MinimalDistance, Score}
 
 // CandidateDeterminationStrategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 type CandidateDeterminationStrategy string
@@ -73,6 +76,10 @@ type SchedulerControllerConfiguration struct {
 	// Shoot defines the configuration of the Shoot controller.
 	// +optional
 	Shoot *ShootSchedulerConfiguration
+	// Rebalancer defines the configuration of the controller which migrates shoots away from overloaded seeds. The
+	// controller is only enabled if this field is set.
+	// +optional
+	Rebalancer *RebalancerConfiguration
 }
 
 // BackupBucketSchedulerConfiguration defines the configuration of the BackupBucket to Seed
@@ -103,6 +110,57 @@ type ShootSchedulerConfiguration struct {
 	ConcurrentSyncs int
 	// Strategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 	Strategy CandidateDeterminationStrategy
+	// Scoring configures the score plugins which rank the seed candidates after filtering. If not set, the seed with
+	// the least number of shoots is chosen.
+	Scoring *ShootSchedulerScoring
+}
+
+// ShootSchedulerScoring contains the configuration of the score plugins of the Shoot to Seed scheduler.
+type ShootSchedulerScoring struct {
+	// Plugins is the list of score plugins which are used to rank the seed candidates.
+	Plugins []ScorePlugin
+}
+
+// ScorePlugin contains the configuration of a score plugin.
+type ScorePlugin struct {
+	// Name is the name of the score plugin.
+	Name ScorePluginName
+	// Weight is the factor by which the score of this plugin is multiplied before it is added to the overall score of
+	// a seed.
+	Weight int32
+}
+
+// ScorePluginName is the name of a score plugin.
+type ScorePluginName string
+
+const (
+	// ScorePluginAllocatableShoots prefers seeds with more remaining capacity for shoots, see `.status.allocatable`.
+	ScorePluginAllocatableShoots ScorePluginName = "AllocatableShoots"
+	// ScorePluginZones prefers seeds with more zones.
+	ScorePluginZones ScorePluginName = "Zones"
+	// ScorePluginDistance prefers seeds with a smaller distance to the region of the shoot.
+	ScorePluginDistance ScorePluginName = "Distance"
+	// ScorePluginProjectShoots prefers seeds hosting fewer shoots of the shoot's project.
+	ScorePluginProjectShoots ScorePluginName = "ProjectShoots"
+)
+
+// ScorePlugins defines all currently implemented score plugins.
+var ScorePlugins = []ScorePluginName{ScorePluginAllocatableShoots, ScorePluginZones, ScorePluginDistance, ScorePluginProjectShoots}
+
+// RebalancerConfiguration defines the configuration of the controller which migrates shoots away from overloaded
+// seeds.
+type RebalancerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs int
+	// SyncPeriod is the duration how often the utilization of the seeds is checked.
+	SyncPeriod *metav1.Duration
+	// UtilizationThreshold is the percentage of the seed's allocatable shoots above which the seed is considered to
+	// be overloaded.
+	UtilizationThreshold *int32
+	// MaxConcurrentMigrationsPerSeed is the maximum number of shoots which are migrated away from a seed at the same
+	// time.
+	MaxConcurrentMigrationsPerSeed *int32
 }
 
 // ServerConfiguration contains details for the HTTP(S) servers.
@@ -111,6 +169,10 @@ type ServerConfiguration struct {
 	HealthProbes *Server
 	// Metrics is the configuration for serving the metrics endpoint.
 	Metrics *Server
+	// Explain is the configuration for serving the endpoint which explains scheduling decisions for shoots. Requests
+	// must present a bearer token of a garden cluster user who is allowed to get the referenced shoot (respectively, to
+	// create the posted shoot). If unspecified, the endpoint is not served. The port defaults to 10444.
+	Explain *HTTPSServer
 }
 
 // Server contains information for HTTP(S) server configuration.
@@ -120,3 +182,18 @@ type Server struct {
 	// Port is the port on which to serve unsecured, unauthenticated access.
 	Port int
 }
+
+// HTTPSServer is the configuration for the HTTPSServer server.
+type HTTPSServer struct {
+	// Server is the configuration for the bind address and the port.
+	Server
+	// TLSServer contains information about the TLS configuration for a HTTPS server.
+	TLS TLSServer
+}
+
+// TLSServer contains information about the TLS configuration for a HTTPS server.
+type TLSServer struct {
+	// ServerCertDir is the path to a directory containing the server's TLS certificate and key (the files must be
+	// named tls.crt and tls.key respectively).
+	ServerCertDir string
+}
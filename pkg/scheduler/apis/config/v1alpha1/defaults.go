@@ -5,7 +5,11 @@
 package v1alpha1
 
 import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	"k8s.io/utils/ptr"
 )
 
 // SetDefaults_SchedulerConfiguration sets defaults for the configuration of the Gardener scheduler.
@@ -46,6 +50,32 @@ func SetDefaults_SchedulerControllerConfiguration(obj *SchedulerControllerConfig
 	}
 }
 
+// SetDefaults_RebalancerConfiguration sets defaults for the configuration of the rebalancer controller.
+func SetDefaults_RebalancerConfiguration(obj *RebalancerConfiguration) {
+	if obj.ConcurrentSyncs == 0 {
+		obj.ConcurrentSyncs = 2
+	}
+
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 30 * time.Minute}
+	}
+
+	if obj.UtilizationThreshold == nil {
+		obj.UtilizationThreshold = ptr.To[int32](90)
+	}
+
+	if obj.MaxConcurrentMigrationsPerSeed == nil {
+		obj.MaxConcurrentMigrationsPerSeed = ptr.To[int32](1)
+	}
+}
+
+// SetDefaults_ScorePlugin sets defaults for the configuration of a score plugin.
+func SetDefaults_ScorePlugin(obj *ScorePlugin) {
+	if obj.Weight == 0 {
+		obj.Weight = 1
+	}
+}
+
 // SetDefaults_ClientConnectionConfiguration sets defaults for the garden client connection.
 func SetDefaults_ClientConnectionConfiguration(obj *componentbaseconfigv1alpha1.ClientConnectionConfiguration) {
 	if obj.QPS == 0.0 {
@@ -91,4 +121,8 @@ func SetDefaults_ServerConfiguration(obj *ServerConfiguration) {
 	if obj.Metrics.Port == 0 {
 		obj.Metrics.Port = 19251
 	}
+
+	if obj.Explain != nil && obj.Explain.Port == 0 {
+		obj.Explain.Port = 10444
+	}
 }
@@ -90,6 +90,65 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("RebalancerConfiguration defaulting", func() {
+		It("should not enable the rebalancer by default", func() {
+			schedulerv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Rebalancer).To(BeNil())
+		})
+
+		It("should default the rebalancer configuration", func() {
+			obj.Schedulers.Rebalancer = &schedulerv1alpha1.RebalancerConfiguration{}
+
+			schedulerv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Rebalancer).To(Equal(&schedulerv1alpha1.RebalancerConfiguration{
+				ConcurrentSyncs:                2,
+				SyncPeriod:                     &metav1.Duration{Duration: 30 * time.Minute},
+				UtilizationThreshold:           ptr.To[int32](90),
+				MaxConcurrentMigrationsPerSeed: ptr.To[int32](1),
+			}))
+		})
+
+		It("should not overwrite already set values for the rebalancer configuration", func() {
+			obj.Schedulers.Rebalancer = &schedulerv1alpha1.RebalancerConfiguration{
+				ConcurrentSyncs:                1,
+				SyncPeriod:                     &metav1.Duration{Duration: time.Hour},
+				UtilizationThreshold:           ptr.To[int32](75),
+				MaxConcurrentMigrationsPerSeed: ptr.To[int32](3),
+			}
+
+			schedulerv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Rebalancer).To(Equal(&schedulerv1alpha1.RebalancerConfiguration{
+				ConcurrentSyncs:                1,
+				SyncPeriod:                     &metav1.Duration{Duration: time.Hour},
+				UtilizationThreshold:           ptr.To[int32](75),
+				MaxConcurrentMigrationsPerSeed: ptr.To[int32](3),
+			}))
+		})
+	})
+
+	Describe("ScorePlugin defaulting", func() {
+		It("should default the weight of score plugins", func() {
+			obj.Schedulers.Shoot = &schedulerv1alpha1.ShootSchedulerConfiguration{
+				Scoring: &schedulerv1alpha1.ShootSchedulerScoring{
+					Plugins: []schedulerv1alpha1.ScorePlugin{
+						{Name: schedulerv1alpha1.ScorePluginZones},
+						{Name: schedulerv1alpha1.ScorePluginDistance, Weight: 3},
+					},
+				},
+			}
+
+			schedulerv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Shoot.Scoring.Plugins).To(Equal([]schedulerv1alpha1.ScorePlugin{
+				{Name: schedulerv1alpha1.ScorePluginZones, Weight: 1},
+				{Name: schedulerv1alpha1.ScorePluginDistance, Weight: 3},
+			}))
+		})
+	})
+
 	Describe("ServerConfiguration defaulting", func() {
 		It("should not overwrite already set values for ServerConfiguration", func() {
 			serverConfiguration := &schedulerv1alpha1.ServerConfiguration{
@@ -123,6 +182,15 @@ var _ = Describe("Defaults", func() {
 			schedulerv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
 			Expect(obj.Server).To(Equal(expectedServerConfiguration))
 		})
+
+		It("should default the port of the explain server", func() {
+			obj.Server = schedulerv1alpha1.ServerConfiguration{
+				Explain: &schedulerv1alpha1.HTTPSServer{},
+			}
+
+			schedulerv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+			Expect(obj.Server.Explain.Port).To(Equal(10444))
+		})
 	})
 
 	Describe("ClientConnection defaulting", func() {
@@ -13,6 +13,7 @@ import (
 	unsafe "unsafe"
 
 	config "github.com/gardener/gardener/pkg/scheduler/apis/config"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfig "k8s.io/component-base/config"
@@ -36,6 +37,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HTTPSServer)(nil), (*config.HTTPSServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HTTPSServer_To_config_HTTPSServer(a.(*HTTPSServer), b.(*config.HTTPSServer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.HTTPSServer)(nil), (*HTTPSServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_HTTPSServer_To_v1alpha1_HTTPSServer(a.(*config.HTTPSServer), b.(*HTTPSServer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RebalancerConfiguration)(nil), (*config.RebalancerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RebalancerConfiguration_To_config_RebalancerConfiguration(a.(*RebalancerConfiguration), b.(*config.RebalancerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.RebalancerConfiguration)(nil), (*RebalancerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_RebalancerConfiguration_To_v1alpha1_RebalancerConfiguration(a.(*config.RebalancerConfiguration), b.(*RebalancerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SchedulerConfiguration)(nil), (*config.SchedulerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SchedulerConfiguration_To_config_SchedulerConfiguration(a.(*SchedulerConfiguration), b.(*config.SchedulerConfiguration), scope)
 	}); err != nil {
@@ -56,6 +77,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ScorePlugin)(nil), (*config.ScorePlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ScorePlugin_To_config_ScorePlugin(a.(*ScorePlugin), b.(*config.ScorePlugin), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ScorePlugin)(nil), (*ScorePlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ScorePlugin_To_v1alpha1_ScorePlugin(a.(*config.ScorePlugin), b.(*ScorePlugin), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Server)(nil), (*config.Server)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Server_To_config_Server(a.(*Server), b.(*config.Server), scope)
 	}); err != nil {
@@ -86,6 +117,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootSchedulerScoring)(nil), (*config.ShootSchedulerScoring)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootSchedulerScoring_To_config_ShootSchedulerScoring(a.(*ShootSchedulerScoring), b.(*config.ShootSchedulerScoring), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootSchedulerScoring)(nil), (*ShootSchedulerScoring)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootSchedulerScoring_To_v1alpha1_ShootSchedulerScoring(a.(*config.ShootSchedulerScoring), b.(*ShootSchedulerScoring), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TLSServer)(nil), (*config.TLSServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TLSServer_To_config_TLSServer(a.(*TLSServer), b.(*config.TLSServer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TLSServer)(nil), (*TLSServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TLSServer_To_v1alpha1_TLSServer(a.(*config.TLSServer), b.(*TLSServer), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -109,6 +160,62 @@ func Convert_config_BackupBucketSchedulerConfiguration_To_v1alpha1_BackupBucketS
 	return autoConvert_config_BackupBucketSchedulerConfiguration_To_v1alpha1_BackupBucketSchedulerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_HTTPSServer_To_config_HTTPSServer(in *HTTPSServer, out *config.HTTPSServer, s conversion.Scope) error {
+	if err := Convert_v1alpha1_Server_To_config_Server(&in.Server, &out.Server, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_TLSServer_To_config_TLSServer(&in.TLS, &out.TLS, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_HTTPSServer_To_config_HTTPSServer is an autogenerated conversion function.
+func Convert_v1alpha1_HTTPSServer_To_config_HTTPSServer(in *HTTPSServer, out *config.HTTPSServer, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HTTPSServer_To_config_HTTPSServer(in, out, s)
+}
+
+func autoConvert_config_HTTPSServer_To_v1alpha1_HTTPSServer(in *config.HTTPSServer, out *HTTPSServer, s conversion.Scope) error {
+	if err := Convert_config_Server_To_v1alpha1_Server(&in.Server, &out.Server, s); err != nil {
+		return err
+	}
+	if err := Convert_config_TLSServer_To_v1alpha1_TLSServer(&in.TLS, &out.TLS, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_HTTPSServer_To_v1alpha1_HTTPSServer is an autogenerated conversion function.
+func Convert_config_HTTPSServer_To_v1alpha1_HTTPSServer(in *config.HTTPSServer, out *HTTPSServer, s conversion.Scope) error {
+	return autoConvert_config_HTTPSServer_To_v1alpha1_HTTPSServer(in, out, s)
+}
+
+func autoConvert_v1alpha1_RebalancerConfiguration_To_config_RebalancerConfiguration(in *RebalancerConfiguration, out *config.RebalancerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.UtilizationThreshold = (*int32)(unsafe.Pointer(in.UtilizationThreshold))
+	out.MaxConcurrentMigrationsPerSeed = (*int32)(unsafe.Pointer(in.MaxConcurrentMigrationsPerSeed))
+	return nil
+}
+
+// Convert_v1alpha1_RebalancerConfiguration_To_config_RebalancerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_RebalancerConfiguration_To_config_RebalancerConfiguration(in *RebalancerConfiguration, out *config.RebalancerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RebalancerConfiguration_To_config_RebalancerConfiguration(in, out, s)
+}
+
+func autoConvert_config_RebalancerConfiguration_To_v1alpha1_RebalancerConfiguration(in *config.RebalancerConfiguration, out *RebalancerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.UtilizationThreshold = (*int32)(unsafe.Pointer(in.UtilizationThreshold))
+	out.MaxConcurrentMigrationsPerSeed = (*int32)(unsafe.Pointer(in.MaxConcurrentMigrationsPerSeed))
+	return nil
+}
+
+// Convert_config_RebalancerConfiguration_To_v1alpha1_RebalancerConfiguration is an autogenerated conversion function.
+func Convert_config_RebalancerConfiguration_To_v1alpha1_RebalancerConfiguration(in *config.RebalancerConfiguration, out *RebalancerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_RebalancerConfiguration_To_v1alpha1_RebalancerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_SchedulerConfiguration_To_config_SchedulerConfiguration(in *SchedulerConfiguration, out *config.SchedulerConfiguration, s conversion.Scope) error {
 	if err := configv1alpha1.Convert_v1alpha1_ClientConnectionConfiguration_To_config_ClientConnectionConfiguration(&in.ClientConnection, &out.ClientConnection, s); err != nil {
 		return err
@@ -190,6 +297,7 @@ func Convert_config_SchedulerConfiguration_To_v1alpha1_SchedulerConfiguration(in
 func autoConvert_v1alpha1_SchedulerControllerConfiguration_To_config_SchedulerControllerConfiguration(in *SchedulerControllerConfiguration, out *config.SchedulerControllerConfiguration, s conversion.Scope) error {
 	out.BackupBucket = (*config.BackupBucketSchedulerConfiguration)(unsafe.Pointer(in.BackupBucket))
 	out.Shoot = (*config.ShootSchedulerConfiguration)(unsafe.Pointer(in.Shoot))
+	out.Rebalancer = (*config.RebalancerConfiguration)(unsafe.Pointer(in.Rebalancer))
 	return nil
 }
 
@@ -201,6 +309,7 @@ func Convert_v1alpha1_SchedulerControllerConfiguration_To_config_SchedulerContro
 func autoConvert_config_SchedulerControllerConfiguration_To_v1alpha1_SchedulerControllerConfiguration(in *config.SchedulerControllerConfiguration, out *SchedulerControllerConfiguration, s conversion.Scope) error {
 	out.BackupBucket = (*BackupBucketSchedulerConfiguration)(unsafe.Pointer(in.BackupBucket))
 	out.Shoot = (*ShootSchedulerConfiguration)(unsafe.Pointer(in.Shoot))
+	out.Rebalancer = (*RebalancerConfiguration)(unsafe.Pointer(in.Rebalancer))
 	return nil
 }
 
@@ -209,6 +318,28 @@ func Convert_config_SchedulerControllerConfiguration_To_v1alpha1_SchedulerContro
 	return autoConvert_config_SchedulerControllerConfiguration_To_v1alpha1_SchedulerControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ScorePlugin_To_config_ScorePlugin(in *ScorePlugin, out *config.ScorePlugin, s conversion.Scope) error {
+	out.Name = config.ScorePluginName(in.Name)
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_v1alpha1_ScorePlugin_To_config_ScorePlugin is an autogenerated conversion function.
+func Convert_v1alpha1_ScorePlugin_To_config_ScorePlugin(in *ScorePlugin, out *config.ScorePlugin, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ScorePlugin_To_config_ScorePlugin(in, out, s)
+}
+
+func autoConvert_config_ScorePlugin_To_v1alpha1_ScorePlugin(in *config.ScorePlugin, out *ScorePlugin, s conversion.Scope) error {
+	out.Name = ScorePluginName(in.Name)
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_config_ScorePlugin_To_v1alpha1_ScorePlugin is an autogenerated conversion function.
+func Convert_config_ScorePlugin_To_v1alpha1_ScorePlugin(in *config.ScorePlugin, out *ScorePlugin, s conversion.Scope) error {
+	return autoConvert_config_ScorePlugin_To_v1alpha1_ScorePlugin(in, out, s)
+}
+
 func autoConvert_v1alpha1_Server_To_config_Server(in *Server, out *config.Server, s conversion.Scope) error {
 	out.BindAddress = in.BindAddress
 	out.Port = in.Port
@@ -234,6 +365,7 @@ func Convert_config_Server_To_v1alpha1_Server(in *config.Server, out *Server, s
 func autoConvert_v1alpha1_ServerConfiguration_To_config_ServerConfiguration(in *ServerConfiguration, out *config.ServerConfiguration, s conversion.Scope) error {
 	out.HealthProbes = (*config.Server)(unsafe.Pointer(in.HealthProbes))
 	out.Metrics = (*config.Server)(unsafe.Pointer(in.Metrics))
+	out.Explain = (*config.HTTPSServer)(unsafe.Pointer(in.Explain))
 	return nil
 }
 
@@ -245,6 +377,7 @@ func Convert_v1alpha1_ServerConfiguration_To_config_ServerConfiguration(in *Serv
 func autoConvert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in *config.ServerConfiguration, out *ServerConfiguration, s conversion.Scope) error {
 	out.HealthProbes = (*Server)(unsafe.Pointer(in.HealthProbes))
 	out.Metrics = (*Server)(unsafe.Pointer(in.Metrics))
+	out.Explain = (*HTTPSServer)(unsafe.Pointer(in.Explain))
 	return nil
 }
 
@@ -256,6 +389,7 @@ func Convert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in *conf
 func autoConvert_v1alpha1_ShootSchedulerConfiguration_To_config_ShootSchedulerConfiguration(in *ShootSchedulerConfiguration, out *config.ShootSchedulerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.Strategy = config.CandidateDeterminationStrategy(in.Strategy)
+	out.Scoring = (*config.ShootSchedulerScoring)(unsafe.Pointer(in.Scoring))
 	return nil
 }
 
@@ -267,6 +401,7 @@ func Convert_v1alpha1_ShootSchedulerConfiguration_To_config_ShootSchedulerConfig
 func autoConvert_config_ShootSchedulerConfiguration_To_v1alpha1_ShootSchedulerConfiguration(in *config.ShootSchedulerConfiguration, out *ShootSchedulerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.Strategy = CandidateDeterminationStrategy(in.Strategy)
+	out.Scoring = (*ShootSchedulerScoring)(unsafe.Pointer(in.Scoring))
 	return nil
 }
 
@@ -274,3 +409,43 @@ func autoConvert_config_ShootSchedulerConfiguration_To_v1alpha1_ShootSchedulerCo
 func Convert_config_ShootSchedulerConfiguration_To_v1alpha1_ShootSchedulerConfiguration(in *config.ShootSchedulerConfiguration, out *ShootSchedulerConfiguration, s conversion.Scope) error {
 	return autoConvert_config_ShootSchedulerConfiguration_To_v1alpha1_ShootSchedulerConfiguration(in, out, s)
 }
+
+func autoConvert_v1alpha1_ShootSchedulerScoring_To_config_ShootSchedulerScoring(in *ShootSchedulerScoring, out *config.ShootSchedulerScoring, s conversion.Scope) error {
+	out.Plugins = *(*[]config.ScorePlugin)(unsafe.Pointer(&in.Plugins))
+	return nil
+}
+
+// Convert_v1alpha1_ShootSchedulerScoring_To_config_ShootSchedulerScoring is an autogenerated conversion function.
+func Convert_v1alpha1_ShootSchedulerScoring_To_config_ShootSchedulerScoring(in *ShootSchedulerScoring, out *config.ShootSchedulerScoring, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootSchedulerScoring_To_config_ShootSchedulerScoring(in, out, s)
+}
+
+func autoConvert_config_ShootSchedulerScoring_To_v1alpha1_ShootSchedulerScoring(in *config.ShootSchedulerScoring, out *ShootSchedulerScoring, s conversion.Scope) error {
+	out.Plugins = *(*[]ScorePlugin)(unsafe.Pointer(&in.Plugins))
+	return nil
+}
+
+// Convert_config_ShootSchedulerScoring_To_v1alpha1_ShootSchedulerScoring is an autogenerated conversion function.
+func Convert_config_ShootSchedulerScoring_To_v1alpha1_ShootSchedulerScoring(in *config.ShootSchedulerScoring, out *ShootSchedulerScoring, s conversion.Scope) error {
+	return autoConvert_config_ShootSchedulerScoring_To_v1alpha1_ShootSchedulerScoring(in, out, s)
+}
+
+func autoConvert_v1alpha1_TLSServer_To_config_TLSServer(in *TLSServer, out *config.TLSServer, s conversion.Scope) error {
+	out.ServerCertDir = in.ServerCertDir
+	return nil
+}
+
+// Convert_v1alpha1_TLSServer_To_config_TLSServer is an autogenerated conversion function.
+func Convert_v1alpha1_TLSServer_To_config_TLSServer(in *TLSServer, out *config.TLSServer, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TLSServer_To_config_TLSServer(in, out, s)
+}
+
+func autoConvert_config_TLSServer_To_v1alpha1_TLSServer(in *config.TLSServer, out *TLSServer, s conversion.Scope) error {
+	out.ServerCertDir = in.ServerCertDir
+	return nil
+}
+
+// Convert_config_TLSServer_To_v1alpha1_TLSServer is an autogenerated conversion function.
+func Convert_config_TLSServer_To_v1alpha1_TLSServer(in *config.TLSServer, out *TLSServer, s conversion.Scope) error {
+	return autoConvert_config_TLSServer_To_v1alpha1_TLSServer(in, out, s)
+}
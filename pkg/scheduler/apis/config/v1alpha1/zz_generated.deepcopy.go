@@ -10,6 +10,7 @@
 package v1alpha1
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
@@ -30,6 +31,55 @@ func (in *BackupBucketSchedulerConfiguration) DeepCopy() *BackupBucketSchedulerC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPSServer) DeepCopyInto(out *HTTPSServer) {
+	*out = *in
+	out.Server = in.Server
+	out.TLS = in.TLS
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPSServer.
+func (in *HTTPSServer) DeepCopy() *HTTPSServer {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPSServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RebalancerConfiguration) DeepCopyInto(out *RebalancerConfiguration) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.UtilizationThreshold != nil {
+		in, out := &in.UtilizationThreshold, &out.UtilizationThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxConcurrentMigrationsPerSeed != nil {
+		in, out := &in.MaxConcurrentMigrationsPerSeed, &out.MaxConcurrentMigrationsPerSeed
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RebalancerConfiguration.
+func (in *RebalancerConfiguration) DeepCopy() *RebalancerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(RebalancerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SchedulerConfiguration) DeepCopyInto(out *SchedulerConfiguration) {
 	*out = *in
@@ -86,7 +136,12 @@ func (in *SchedulerControllerConfiguration) DeepCopyInto(out *SchedulerControlle
 	if in.Shoot != nil {
 		in, out := &in.Shoot, &out.Shoot
 		*out = new(ShootSchedulerConfiguration)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rebalancer != nil {
+		in, out := &in.Rebalancer, &out.Rebalancer
+		*out = new(RebalancerConfiguration)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -101,6 +156,22 @@ func (in *SchedulerControllerConfiguration) DeepCopy() *SchedulerControllerConfi
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScorePlugin) DeepCopyInto(out *ScorePlugin) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScorePlugin.
+func (in *ScorePlugin) DeepCopy() *ScorePlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(ScorePlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -130,6 +201,11 @@ func (in *ServerConfiguration) DeepCopyInto(out *ServerConfiguration) {
 		*out = new(Server)
 		**out = **in
 	}
+	if in.Explain != nil {
+		in, out := &in.Explain, &out.Explain
+		*out = new(HTTPSServer)
+		**out = **in
+	}
 	return
 }
 
@@ -146,6 +222,11 @@ func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootSchedulerConfiguration) DeepCopyInto(out *ShootSchedulerConfiguration) {
 	*out = *in
+	if in.Scoring != nil {
+		in, out := &in.Scoring, &out.Scoring
+		*out = new(ShootSchedulerScoring)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -158,3 +239,40 @@ func (in *ShootSchedulerConfiguration) DeepCopy() *ShootSchedulerConfiguration {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootSchedulerScoring) DeepCopyInto(out *ShootSchedulerScoring) {
+	*out = *in
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]ScorePlugin, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootSchedulerScoring.
+func (in *ShootSchedulerScoring) DeepCopy() *ShootSchedulerScoring {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootSchedulerScoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSServer) DeepCopyInto(out *TLSServer) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSServer.
+func (in *TLSServer) DeepCopy() *TLSServer {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSServer)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -7,6 +7,7 @@ package validation
 import (
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/gardener/pkg/logger"
@@ -17,6 +18,7 @@ import (
 func ValidateConfiguration(config *schedulerconfig.SchedulerConfiguration) field.ErrorList {
 	allErrs := field.ErrorList{}
 
+	allErrs = append(allErrs, validateServerConfiguration(config.Server, field.NewPath("server"))...)
 	allErrs = append(allErrs, validateSchedulerControllerConfiguration(config.Schedulers, field.NewPath("schedulers"))...)
 
 	if config.LogLevel != "" {
@@ -34,6 +36,30 @@ func ValidateConfiguration(config *schedulerconfig.SchedulerConfiguration) field
 	return allErrs
 }
 
+func validateServerConfiguration(conf schedulerconfig.ServerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.Explain != nil {
+		explainPath := fldPath.Child("explain")
+
+		for _, msg := range validation.IsValidPortNum(conf.Explain.Port) {
+			allErrs = append(allErrs, field.Invalid(explainPath.Child("port"), conf.Explain.Port, msg))
+		}
+		if conf.HealthProbes != nil && conf.HealthProbes.Port == conf.Explain.Port {
+			allErrs = append(allErrs, field.Invalid(explainPath.Child("port"), conf.Explain.Port, "must not be the same as the port of the health probes server"))
+		}
+		if conf.Metrics != nil && conf.Metrics.Port == conf.Explain.Port {
+			allErrs = append(allErrs, field.Invalid(explainPath.Child("port"), conf.Explain.Port, "must not be the same as the port of the metrics server"))
+		}
+
+		if len(conf.Explain.TLS.ServerCertDir) == 0 {
+			allErrs = append(allErrs, field.Required(explainPath.Child("tls", "serverCertDir"), "must provide the directory containing the server certificate"))
+		}
+	}
+
+	return allErrs
+}
+
 // validateSchedulerControllerConfiguration validates the scheduler controller configuration.
 func validateSchedulerControllerConfiguration(schedulers schedulerconfig.SchedulerControllerConfiguration, fldPath *field.Path) field.ErrorList {
 	var (
@@ -47,6 +73,35 @@ func validateSchedulerControllerConfiguration(schedulers schedulerconfig.Schedul
 	if schedulers.Shoot != nil {
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(schedulers.Shoot.ConcurrentSyncs), fldPath.Child("shoot", "concurrentSyncs"))...)
 		allErrs = append(allErrs, validateStrategy(schedulers.Shoot.Strategy, fldPath.Child("shoot", "strategy"))...)
+		allErrs = append(allErrs, validateScoring(schedulers.Shoot.Scoring, fldPath.Child("shoot", "scoring"))...)
+
+		if schedulers.Shoot.Strategy == schedulerconfig.Score && (schedulers.Shoot.Scoring == nil || len(schedulers.Shoot.Scoring.Plugins) == 0) {
+			allErrs = append(allErrs, field.Required(fldPath.Child("shoot", "scoring", "plugins"), "at least one score plugin must be configured when using the 'Score' strategy"))
+		}
+	}
+
+	if schedulers.Rebalancer != nil {
+		allErrs = append(allErrs, validateRebalancer(schedulers.Rebalancer, fldPath.Child("rebalancer"))...)
+	}
+
+	return allErrs
+}
+
+func validateRebalancer(rebalancer *schedulerconfig.RebalancerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(rebalancer.ConcurrentSyncs), fldPath.Child("concurrentSyncs"))...)
+
+	if rebalancer.SyncPeriod != nil && rebalancer.SyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), rebalancer.SyncPeriod.Duration.String(), "must be positive"))
+	}
+
+	if threshold := rebalancer.UtilizationThreshold; threshold != nil && (*threshold <= 0 || *threshold > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("utilizationThreshold"), *threshold, "must be between 1 and 100"))
+	}
+
+	if rebalancer.MaxConcurrentMigrationsPerSeed != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*rebalancer.MaxConcurrentMigrationsPerSeed), fldPath.Child("maxConcurrentMigrationsPerSeed"))...)
 	}
 
 	return allErrs
@@ -70,3 +125,31 @@ func validateStrategy(strategy schedulerconfig.CandidateDeterminationStrategy, f
 
 	return allErrs
 }
+
+func validateScoring(scoring *schedulerconfig.ShootSchedulerScoring, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[schedulerconfig.ScorePluginName]()
+	)
+
+	if scoring == nil {
+		return allErrs
+	}
+
+	for i, plugin := range scoring.Plugins {
+		idxPath := fldPath.Child("plugins").Index(i)
+
+		if !sets.New(schedulerconfig.ScorePlugins...).Has(plugin.Name) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("name"), plugin.Name, schedulerconfig.ScorePlugins))
+		} else if names.Has(plugin.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), plugin.Name))
+		}
+		names.Insert(plugin.Name)
+
+		if plugin.Weight <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("weight"), plugin.Weight, "must be greater than 0"))
+		}
+	}
+
+	return allErrs
+}
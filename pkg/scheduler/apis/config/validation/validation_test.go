@@ -5,11 +5,14 @@
 package validation
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	schedulerconfig "github.com/gardener/gardener/pkg/scheduler/apis/config"
 )
@@ -92,6 +95,151 @@ var _ = Describe("gardener-scheduler", func() {
 					"Field": Equal("schedulers.shoot.concurrentSyncs"),
 				}))))
 			})
+
+			It("should pass because the rebalancer configuration is valid", func() {
+				configuration := defaultAdmissionConfiguration
+				configuration.Schedulers.Rebalancer = &schedulerconfig.RebalancerConfiguration{
+					ConcurrentSyncs:                1,
+					SyncPeriod:                     &metav1.Duration{Duration: time.Minute},
+					UtilizationThreshold:           ptr.To[int32](80),
+					MaxConcurrentMigrationsPerSeed: ptr.To[int32](2),
+				}
+
+				Expect(ValidateConfiguration(&configuration)).To(BeEmpty())
+			})
+
+			It("should fail because the rebalancer configuration is invalid", func() {
+				invalidConfiguration := defaultAdmissionConfiguration
+				invalidConfiguration.Schedulers.Rebalancer = &schedulerconfig.RebalancerConfiguration{
+					ConcurrentSyncs:                -1,
+					SyncPeriod:                     &metav1.Duration{},
+					UtilizationThreshold:           ptr.To[int32](101),
+					MaxConcurrentMigrationsPerSeed: ptr.To[int32](-1),
+				}
+
+				Expect(ValidateConfiguration(&invalidConfiguration)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.rebalancer.concurrentSyncs"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.rebalancer.syncPeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.rebalancer.utilizationThreshold"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.rebalancer.maxConcurrentMigrationsPerSeed"),
+					})),
+				))
+			})
+
+			It("should pass because the Gardener Scheduler Configuration with the 'Score' Strategy and score plugins is a valid configuration", func() {
+				scoreConfiguration := defaultAdmissionConfiguration
+				scoreConfiguration.Schedulers.Shoot.Strategy = schedulerconfig.Score
+				scoreConfiguration.Schedulers.Shoot.Scoring = &schedulerconfig.ShootSchedulerScoring{
+					Plugins: []schedulerconfig.ScorePlugin{
+						{Name: schedulerconfig.ScorePluginAllocatableShoots, Weight: 3},
+						{Name: schedulerconfig.ScorePluginDistance, Weight: 1},
+					},
+				}
+
+				Expect(ValidateConfiguration(&scoreConfiguration)).To(BeEmpty())
+			})
+
+			It("should fail because the 'Score' Strategy is used without score plugins", func() {
+				invalidConfiguration := defaultAdmissionConfiguration
+				invalidConfiguration.Schedulers.Shoot.Strategy = schedulerconfig.Score
+
+				Expect(ValidateConfiguration(&invalidConfiguration)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("schedulers.shoot.scoring.plugins"),
+				}))))
+			})
+
+			It("should fail because the score plugins are invalid", func() {
+				invalidConfiguration := defaultAdmissionConfiguration
+				invalidConfiguration.Schedulers.Shoot.Scoring = &schedulerconfig.ShootSchedulerScoring{
+					Plugins: []schedulerconfig.ScorePlugin{
+						{Name: schedulerconfig.ScorePluginZones, Weight: 1},
+						{Name: schedulerconfig.ScorePluginZones, Weight: 1},
+						{Name: "foo", Weight: 1},
+						{Name: schedulerconfig.ScorePluginProjectShoots, Weight: 0},
+					},
+				}
+
+				Expect(ValidateConfiguration(&invalidConfiguration)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("schedulers.shoot.scoring.plugins[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("schedulers.shoot.scoring.plugins[2].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.shoot.scoring.plugins[3].weight"),
+					})),
+				))
+			})
+		})
+
+		Context("ServerConfiguration", func() {
+			BeforeEach(func() {
+				defaultAdmissionConfiguration.Server = schedulerconfig.ServerConfiguration{
+					HealthProbes: &schedulerconfig.Server{Port: 10251},
+					Metrics:      &schedulerconfig.Server{Port: 19251},
+				}
+			})
+
+			It("should pass because the explain server is not configured", func() {
+				Expect(ValidateConfiguration(&defaultAdmissionConfiguration)).To(BeEmpty())
+			})
+
+			It("should pass because the explain server is configured correctly", func() {
+				defaultAdmissionConfiguration.Server.Explain = &schedulerconfig.HTTPSServer{
+					Server: schedulerconfig.Server{Port: 10444},
+					TLS:    schedulerconfig.TLSServer{ServerCertDir: "/etc/gardener-scheduler/srv"},
+				}
+
+				Expect(ValidateConfiguration(&defaultAdmissionConfiguration)).To(BeEmpty())
+			})
+
+			It("should fail because the explain server has an invalid port and no certificate directory", func() {
+				defaultAdmissionConfiguration.Server.Explain = &schedulerconfig.HTTPSServer{
+					Server: schedulerconfig.Server{Port: 70000},
+				}
+
+				Expect(ValidateConfiguration(&defaultAdmissionConfiguration)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("server.explain.port"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("server.explain.tls.serverCertDir"),
+					})),
+				))
+			})
+
+			It("should fail because the explain server uses the port of another server", func() {
+				defaultAdmissionConfiguration.Server.Explain = &schedulerconfig.HTTPSServer{
+					Server: schedulerconfig.Server{Port: 19251},
+					TLS:    schedulerconfig.TLSServer{ServerCertDir: "/etc/gardener-scheduler/srv"},
+				}
+
+				Expect(ValidateConfiguration(&defaultAdmissionConfiguration)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("server.explain.port"),
+						"Detail": Equal("must not be the same as the port of the metrics server"),
+					})),
+				))
+			})
 		})
 	})
 })
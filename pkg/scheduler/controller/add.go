@@ -9,7 +9,9 @@ import (
 
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	"github.com/gardener/gardener/pkg/scheduler/controller/rebalancer"
 	"github.com/gardener/gardener/pkg/scheduler/controller/shoot"
 )
 
@@ -21,5 +23,27 @@ func AddToManager(mgr manager.Manager, cfg *config.SchedulerConfiguration) error
 		return fmt.Errorf("failed adding Shoot controller: %w", err)
 	}
 
+	if cfg.Server.Explain != nil {
+		if err := (&shoot.ExplainHandler{
+			Log:        mgr.GetLogger().WithName("webhook").WithName(shoot.ExplainHandlerName),
+			Reconciler: &shoot.Reconciler{Config: cfg.Schedulers.Shoot},
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding explain handler: %w", err)
+		}
+	}
+
+	if cfg.Schedulers.Rebalancer != nil {
+		if err := (&rebalancer.Reconciler{
+			Config: *cfg.Schedulers.Rebalancer,
+			Scheduler: &shoot.Reconciler{
+				Client:          mgr.GetClient(),
+				Config:          cfg.Schedulers.Shoot,
+				GardenNamespace: v1beta1constants.GardenNamespace,
+			},
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding Rebalancer controller: %w", err)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,218 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalancer
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	"github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+const (
+	// EventRebalancingProposed is an event reason for shoots whose control planes should be migrated away from an
+	// overloaded seed, but the migration is not triggered automatically.
+	EventRebalancingProposed = "RebalancingProposed"
+	// EventRebalancingTriggered is an event reason for shoots whose control plane migration away from an overloaded
+	// seed was triggered.
+	EventRebalancingTriggered = "RebalancingTriggered"
+)
+
+// Reconciler checks the utilization of seeds and migrates the control planes of shoots away from overloaded seeds.
+type Reconciler struct {
+	Client    client.Client
+	Config    config.RebalancerConfiguration
+	Scheduler *shoot.Reconciler
+	Clock     clock.Clock
+	Recorder  record.EventRecorder
+}
+
+// Reconcile checks the utilization of seeds and migrates the control planes of shoots away from overloaded seeds.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, request.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if seed.DeletionTimestamp != nil {
+		log.V(1).Info("Seed is being deleted, nothing to rebalance")
+		return reconcile.Result{}, nil
+	}
+
+	requeueAfter := reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}
+
+	if _, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; !ok {
+		log.V(1).Info("Seed does not limit the number of shoots, nothing to rebalance")
+		return requeueAfter, nil
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	var (
+		shoots    = v1beta1helper.ConvertShootList(shootList.Items)
+		seedUsage = v1beta1helper.CalculateSeedUsage(shoots)
+		excess    = seedUsage[seed.Name] - r.maxShoots(seed)
+	)
+
+	if excess <= 0 {
+		log.V(1).Info("Seed is not overloaded", "shoots", seedUsage[seed.Name])
+		return requeueAfter, nil
+	}
+
+	log.Info("Seed is overloaded", "shoots", seedUsage[seed.Name], "excessShoots", excess, "utilizationThreshold", *r.Config.UtilizationThreshold)
+
+	if seed.Spec.Backup == nil {
+		log.Info("Seed does not have backups configured, control planes cannot be migrated")
+		return requeueAfter, nil
+	}
+
+	var (
+		candidates []*gardencorev1beta1.Shoot
+		migrations int
+	)
+
+	for _, s := range shoots {
+		switch {
+		case isMigratingAwayFrom(s, seed.Name):
+			migrations++
+		case isMigrationCandidate(s, seed.Name):
+			candidates = append(candidates, s)
+		}
+	}
+
+	// Shoots which allow the rebalancing are considered first, so that the proposals do not consume the excess.
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if allowsRebalancing(candidates[i]) != allowsRebalancing(candidates[j]) {
+			return allowsRebalancing(candidates[i])
+		}
+		return client.ObjectKeyFromObject(candidates[i]).String() < client.ObjectKeyFromObject(candidates[j]).String()
+	})
+
+	var (
+		budget = int(*r.Config.MaxConcurrentMigrationsPerSeed) - migrations
+		accept = func(target *gardencorev1beta1.Seed) bool {
+			return seedUsage[target.Name] < r.maxShoots(target)
+		}
+	)
+
+	for _, s := range candidates {
+		if excess <= 0 {
+			break
+		}
+
+		shootLog := log.WithValues("shoot", client.ObjectKeyFromObject(s))
+
+		target, err := r.Scheduler.DetermineMigrationTarget(ctx, shootLog, s, seed, accept)
+		if err != nil {
+			shootLog.Info("No target seed found for migrating the control plane", "reason", err.Error())
+			continue
+		}
+
+		excess--
+		seedUsage[target.Name]++
+
+		if !allowsRebalancing(s) || budget <= 0 || !gardenerutils.IsNowInEffectiveShootMaintenanceTimeWindow(s, r.Clock) {
+			if err := r.proposeMigration(ctx, shootLog, s, seed.Name, target.Name); err != nil {
+				return reconcile.Result{}, err
+			}
+			continue
+		}
+
+		s.Spec.SeedName = &target.Name
+		if err := r.Client.SubResource("binding").Update(ctx, s); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed binding shoot %s to seed %q: %w", client.ObjectKeyFromObject(s), target.Name, err)
+		}
+		budget--
+
+		shootLog.Info("Triggered control plane migration", "targetSeed", target.Name)
+		r.Recorder.Eventf(s, corev1.EventTypeNormal, EventRebalancingTriggered, "Migrating control plane from overloaded seed %q to seed %q", seed.Name, target.Name)
+	}
+
+	return requeueAfter, nil
+}
+
+// proposeMigration announces the proposed control plane migration of the given shoot via an event. The proposal is
+// remembered in an annotation so that the event is only emitted again when the proposal changes.
+func (r *Reconciler) proposeMigration(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, seedName, targetSeedName string) error {
+	proposal := seedName + "/" + targetSeedName
+	if shoot.Annotations[v1beta1constants.AnnotationSchedulingRebalancingProposed] == proposal {
+		log.V(1).Info("Control plane migration was already proposed", "targetSeed", targetSeedName)
+		return nil
+	}
+
+	log.Info("Proposing control plane migration", "targetSeed", targetSeedName)
+	r.Recorder.Eventf(shoot, corev1.EventTypeNormal, EventRebalancingProposed, "Control plane should be migrated from overloaded seed %q to seed %q", seedName, targetSeedName)
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationSchedulingRebalancingProposed, proposal)
+	if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+		return fmt.Errorf("failed remembering proposed control plane migration of shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+	}
+	return nil
+}
+
+// maxShoots returns the number of shoots the given seed can host without exceeding the utilization threshold. If the
+// seed does not limit the number of shoots, the maximum integer is returned.
+func (r *Reconciler) maxShoots(seed *gardencorev1beta1.Seed) int {
+	allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]
+	if !ok {
+		return math.MaxInt
+	}
+	return int(allocatableShoots.Value() * int64(*r.Config.UtilizationThreshold) / 100)
+}
+
+// isMigratingAwayFrom returns true if the control plane of the given shoot is currently migrated away from the seed.
+func isMigratingAwayFrom(shoot *gardencorev1beta1.Shoot, seedName string) bool {
+	return ptr.Deref(shoot.Status.SeedName, "") == seedName && ptr.Deref(shoot.Spec.SeedName, "") != seedName
+}
+
+// isMigrationCandidate returns true if the control plane of the given shoot runs on the seed and can be migrated, i.e.,
+// the shoot is managed by the default scheduler and its last operation succeeded.
+func isMigrationCandidate(shoot *gardencorev1beta1.Shoot, seedName string) bool {
+	return shoot.DeletionTimestamp == nil &&
+		ptr.Deref(shoot.Spec.SeedName, "") == seedName &&
+		ptr.Deref(shoot.Status.SeedName, "") == seedName &&
+		ptr.Deref(shoot.Spec.SchedulerName, v1beta1constants.DefaultSchedulerName) == v1beta1constants.DefaultSchedulerName &&
+		shoot.Status.LastOperation != nil &&
+		shoot.Status.LastOperation.State == gardencorev1beta1.LastOperationStateSucceeded
+}
+
+// allowsRebalancing returns true if the shoot owner opted in for automatic control plane migrations.
+func allowsRebalancing(shoot *gardencorev1beta1.Shoot) bool {
+	allowed, _ := strconv.ParseBool(shoot.Annotations[v1beta1constants.AnnotationSchedulingRebalancing])
+	return allowed
+}
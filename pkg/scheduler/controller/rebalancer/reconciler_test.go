@@ -0,0 +1,251 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalancer_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	. "github.com/gardener/gardener/pkg/scheduler/controller/rebalancer"
+	"github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		recorder   *record.FakeRecorder
+		reconciler *Reconciler
+
+		cloudProfile *gardencorev1beta1.CloudProfile
+		sourceSeed   *gardencorev1beta1.Seed
+		targetSeed   *gardencorev1beta1.Seed
+		request      reconcile.Request
+
+		newSeed = func(name string, allocatableShoots int64) *gardencorev1beta1.Seed {
+			return &gardencorev1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: gardencorev1beta1.SeedSpec{
+					Backup:   &gardencorev1beta1.SeedBackup{Provider: "foo"},
+					Provider: gardencorev1beta1.SeedProvider{Type: "foo", Region: "europe"},
+					Networks: gardencorev1beta1.SeedNetworks{
+						Nodes:    ptr.To("10.10.0.0/16"),
+						Pods:     "10.20.0.0/16",
+						Services: "10.30.0.0/16",
+					},
+					Settings: &gardencorev1beta1.SeedSettings{
+						Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: true},
+					},
+				},
+				Status: gardencorev1beta1.SeedStatus{
+					Allocatable: corev1.ResourceList{gardencorev1beta1.ResourceShoots: *resource.NewQuantity(allocatableShoots, resource.DecimalSI)},
+					Conditions: []gardencorev1beta1.Condition{
+						{Type: gardencorev1beta1.SeedGardenletReady, Status: gardencorev1beta1.ConditionTrue},
+						{Type: gardencorev1beta1.SeedBackupBucketsReady, Status: gardencorev1beta1.ConditionTrue},
+					},
+					LastOperation: &gardencorev1beta1.LastOperation{},
+				},
+			}
+		}
+		createShoot = func(name, seedName string, allowRebalancing bool, mutate ...func(*gardencorev1beta1.Shoot)) *gardencorev1beta1.Shoot {
+			s := &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-dev"},
+				Spec: gardencorev1beta1.ShootSpec{
+					CloudProfileName: cloudProfile.Name,
+					Region:           "europe",
+					Provider:         gardencorev1beta1.Provider{Type: "foo"},
+					SeedName:         &seedName,
+					Maintenance: &gardencorev1beta1.Maintenance{
+						TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"},
+					},
+				},
+				Status: gardencorev1beta1.ShootStatus{
+					SeedName:      &seedName,
+					LastOperation: &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateSucceeded},
+				},
+			}
+			if allowRebalancing {
+				metav1.SetMetaDataAnnotation(&s.ObjectMeta, v1beta1constants.AnnotationSchedulingRebalancing, "true")
+			}
+			for _, m := range mutate {
+				m(s)
+			}
+			ExpectWithOffset(1, fakeClient.Create(ctx, s)).To(Succeed())
+			return s
+		}
+		seedNameOf = func(s *gardencorev1beta1.Shoot) string {
+			ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(s), s)).To(Succeed())
+			return *s.Spec.SeedName
+		}
+	)
+
+	BeforeEach(func() {
+		ctx = logf.IntoContext(context.Background(), logr.Discard())
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithInterceptorFuncs(interceptor.Funcs{
+			// The fake client does not know the binding subresource, hence it is emulated by a regular update.
+			SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+				if subResourceName == "binding" {
+					return c.Update(ctx, obj)
+				}
+				return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+			},
+		}).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 22, 30, 0, 0, time.UTC))
+		recorder = record.NewFakeRecorder(10)
+
+		shootConfig := &config.ShootSchedulerConfiguration{Strategy: config.SameRegion}
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: config.RebalancerConfiguration{
+				SyncPeriod:                     &metav1.Duration{Duration: time.Hour},
+				UtilizationThreshold:           ptr.To[int32](50),
+				MaxConcurrentMigrationsPerSeed: ptr.To[int32](1),
+			},
+			Scheduler: &shoot.Reconciler{Client: fakeClient, Config: shootConfig},
+			Clock:     fakeClock,
+			Recorder:  recorder,
+		}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "cloudprofile"}}
+		sourceSeed = newSeed("source", 4)
+		targetSeed = newSeed("target", 10)
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(sourceSeed)}
+
+		Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+		Expect(fakeClient.Create(ctx, sourceSeed)).To(Succeed())
+		Expect(fakeClient.Create(ctx, targetSeed)).To(Succeed())
+	})
+
+	It("should do nothing if the seed is not overloaded", func() {
+		shoot1 := createShoot("shoot-1", sourceSeed.Name, true)
+		shoot2 := createShoot("shoot-2", sourceSeed.Name, true)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(seedNameOf(shoot1)).To(Equal(sourceSeed.Name))
+		Expect(seedNameOf(shoot2)).To(Equal(sourceSeed.Name))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should migrate shoots which opted in and propose the migration for the others", func() {
+		shoot1 := createShoot("shoot-1", sourceSeed.Name, false)
+		shoot2 := createShoot("shoot-2", sourceSeed.Name, true)
+		shoot3 := createShoot("shoot-3", sourceSeed.Name, false)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(seedNameOf(shoot1)).To(Equal(sourceSeed.Name))
+		Expect(seedNameOf(shoot2)).To(Equal(targetSeed.Name))
+		Expect(seedNameOf(shoot3)).To(Equal(sourceSeed.Name))
+		Expect(recorder.Events).To(HaveLen(1))
+		Expect(<-recorder.Events).To(Equal(fmt.Sprintf("Normal %s Migrating control plane from overloaded seed %q to seed %q", EventRebalancingTriggered, sourceSeed.Name, targetSeed.Name)))
+	})
+
+	It("should only propose migrations outside of the maintenance time window", func() {
+		fakeClock.SetTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+		shoot1 := createShoot("shoot-1", sourceSeed.Name, true)
+		createShoot("shoot-2", sourceSeed.Name, true)
+		createShoot("shoot-3", sourceSeed.Name, true)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(seedNameOf(shoot1)).To(Equal(sourceSeed.Name))
+		Expect(<-recorder.Events).To(Equal(fmt.Sprintf("Normal %s Control plane should be migrated from overloaded seed %q to seed %q", EventRebalancingProposed, sourceSeed.Name, targetSeed.Name)))
+	})
+
+	It("should propose a migration only once as long as the proposal does not change", func() {
+		fakeClock.SetTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+		shoot1 := createShoot("shoot-1", sourceSeed.Name, true)
+		createShoot("shoot-2", sourceSeed.Name, true)
+		createShoot("shoot-3", sourceSeed.Name, true)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(recorder.Events).To(HaveLen(1))
+		Expect(<-recorder.Events).To(HavePrefix("Normal " + EventRebalancingProposed))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot1), shoot1)).To(Succeed())
+		Expect(shoot1.Annotations).To(HaveKeyWithValue(v1beta1constants.AnnotationSchedulingRebalancingProposed, "source/target"))
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(recorder.Events).To(BeEmpty())
+
+		By("Simulate an outdated proposal")
+		metav1.SetMetaDataAnnotation(&shoot1.ObjectMeta, v1beta1constants.AnnotationSchedulingRebalancingProposed, "source/other")
+		Expect(fakeClient.Update(ctx, shoot1)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(recorder.Events).To(HaveLen(1))
+		Expect(<-recorder.Events).To(Equal(fmt.Sprintf("Normal %s Control plane should be migrated from overloaded seed %q to seed %q", EventRebalancingProposed, sourceSeed.Name, targetSeed.Name)))
+	})
+
+	It("should respect the migration budget of the seed", func() {
+		createShoot("shoot-0", targetSeed.Name, false, func(s *gardencorev1beta1.Shoot) {
+			s.Status.SeedName = &sourceSeed.Name
+		})
+		shoot1 := createShoot("shoot-1", sourceSeed.Name, true)
+		shoot2 := createShoot("shoot-2", sourceSeed.Name, true)
+		createShoot("shoot-3", sourceSeed.Name, true)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(seedNameOf(shoot1)).To(Equal(sourceSeed.Name))
+		Expect(seedNameOf(shoot2)).To(Equal(sourceSeed.Name))
+		Expect(recorder.Events).To(HaveLen(2))
+		Expect(<-recorder.Events).To(HavePrefix("Normal " + EventRebalancingProposed))
+		Expect(<-recorder.Events).To(HavePrefix("Normal " + EventRebalancingProposed))
+	})
+
+	It("should not migrate shoots to seeds which would become overloaded", func() {
+		Expect(fakeClient.Delete(ctx, targetSeed)).To(Succeed())
+		targetSeed = newSeed("target", 1)
+		Expect(fakeClient.Create(ctx, targetSeed)).To(Succeed())
+
+		shoot1 := createShoot("shoot-1", sourceSeed.Name, true)
+		shoot2 := createShoot("shoot-2", sourceSeed.Name, true)
+		shoot3 := createShoot("shoot-3", sourceSeed.Name, true)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(seedNameOf(shoot1)).To(Equal(sourceSeed.Name))
+		Expect(seedNameOf(shoot2)).To(Equal(sourceSeed.Name))
+		Expect(seedNameOf(shoot3)).To(Equal(sourceSeed.Name))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should do nothing if the seed does not have backups configured", func() {
+		sourceSeed.Spec.Backup = nil
+		Expect(fakeClient.Update(ctx, sourceSeed)).To(Succeed())
+
+		shoot1 := createShoot("shoot-1", sourceSeed.Name, true)
+		createShoot("shoot-2", sourceSeed.Name, true)
+		createShoot("shoot-3", sourceSeed.Name, true)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(seedNameOf(shoot1)).To(Equal(sourceSeed.Name))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+})
@@ -0,0 +1,277 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-logr/logr"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/yaml"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+)
+
+const (
+	// ExplainHandlerName is the name of the HTTP handler which explains the scheduling decision for a shoot.
+	ExplainHandlerName = "shoot-scheduling-explain"
+	// ExplainHandlerPath is the path of the HTTP handler which explains the scheduling decision for a shoot.
+	ExplainHandlerPath = "/scheduling/shoots/explain"
+)
+
+// maxExplainRequestBodySize is the maximum size of a shoot manifest accepted by the explain handler.
+const maxExplainRequestBodySize = 1 << 20
+
+// Explanation describes which seed the scheduler would choose for a shoot and why all other seeds were rejected.
+type Explanation struct {
+	// Seed is the name of the seed the shoot would be scheduled to.
+	Seed string `json:"seed,omitempty"`
+	// Error is the error which would be reported if the shoot cannot be scheduled.
+	Error string `json:"error,omitempty"`
+	// Seeds contains the result of the filter and score plugins for every seed.
+	Seeds []SeedExplanation `json:"seeds"`
+}
+
+// SeedExplanation describes the result of the filter and score plugins for a single seed.
+type SeedExplanation struct {
+	// Name is the name of the seed.
+	Name string `json:"name"`
+	// Shoots is the number of shoots which are currently scheduled to the seed.
+	Shoots int `json:"shoots"`
+	// RejectedBy is the name of the filter plugin which rejected the seed.
+	RejectedBy string `json:"rejectedBy,omitempty"`
+	// Reason is the reason why the seed was rejected.
+	Reason string `json:"reason,omitempty"`
+	// Score is the overall score of the seed if score plugins are configured and the seed was not rejected.
+	Score *int64 `json:"score,omitempty"`
+	// PluginScores contains the unweighted scores of the individual score plugins.
+	PluginScores map[config.ScorePluginName]int64 `json:"pluginScores,omitempty"`
+}
+
+// Explain runs all filter and score plugins for the given shoot without any side effects and returns the result for
+// every seed.
+func (r *Reconciler) Explain(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (*Explanation, error) {
+	sctx, seeds, err := r.newSchedulingContext(ctx, log, shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		explanation  = &Explanation{Seeds: make([]SeedExplanation, 0, len(seeds))}
+		seedToResult = make(map[string]*SeedExplanation, len(seeds))
+		candidates   = seeds
+	)
+
+	for _, seed := range seeds {
+		explanation.Seeds = append(explanation.Seeds, SeedExplanation{Name: seed.Name, Shoots: sctx.seedUsage[seed.Name]})
+	}
+	for i := range explanation.Seeds {
+		seedToResult[explanation.Seeds[i].Name] = &explanation.Seeds[i]
+	}
+
+	for _, plugin := range filterPlugins {
+		filteredSeeds, err := plugin.filter(sctx, candidates)
+
+		remaining := sets.New[string]()
+		for _, seed := range filteredSeeds {
+			remaining.Insert(seed.Name)
+		}
+		for _, seed := range candidates {
+			if !remaining.Has(seed.Name) {
+				seedToResult[seed.Name].RejectedBy = plugin.name
+				seedToResult[seed.Name].Reason = plugin.reason(sctx, &seed)
+			}
+		}
+
+		if err != nil {
+			explanation.Error = err.Error()
+			return explanation, nil
+		}
+		candidates = filteredSeeds
+	}
+
+	if r.Config.Scoring == nil {
+		seed, err := getSeedWithLeastShootsDeployed(candidates, sctx.shootList)
+		if err != nil {
+			return nil, err
+		}
+		explanation.Seed = seed.Name
+		return explanation, nil
+	}
+
+	scores, err := scoreSeeds(sctx, candidates, r.Config.Scoring.Plugins)
+	if err != nil {
+		return nil, err
+	}
+	for _, score := range scores {
+		seedToResult[score.seed.Name].Score = &score.score
+		seedToResult[score.seed.Name].PluginScores = score.pluginScores
+	}
+	explanation.Seed = scores[0].seed.Name
+
+	return explanation, nil
+}
+
+// ExplainHandler is a HTTP handler which explains the scheduling decision for a shoot. A shoot manifest (JSON or YAML)
+// can be posted to the handler in order to find out why it cannot be scheduled before it is created. Alternatively,
+// an existing shoot can be referenced via the 'namespace' and 'name' query parameters of a GET request.
+// Requests must present a bearer token of a garden cluster user. The token is authenticated via a TokenReview and the
+// user must be allowed to get the referenced shoot (GET) or to create the posted shoot (POST).
+type ExplainHandler struct {
+	Log        logr.Logger
+	Reconciler *Reconciler
+}
+
+// AddToManager registers the handler at the webhook server of the given manager which serves it via HTTPS.
+func (h *ExplainHandler) AddToManager(mgr manager.Manager) error {
+	if h.Reconciler.Client == nil {
+		h.Reconciler.Client = mgr.GetClient()
+	}
+	if h.Reconciler.GardenNamespace == "" {
+		h.Reconciler.GardenNamespace = v1beta1constants.GardenNamespace
+	}
+
+	mgr.GetWebhookServer().Register(ExplainHandlerPath, h)
+	return nil
+}
+
+func (h *ExplainHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet && req.Method != http.MethodPost {
+		http.Error(w, "only GET and POST requests are supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	user, err := h.authenticate(req)
+	if err != nil {
+		h.Log.Error(err, "Failed authenticating request")
+		http.Error(w, "failed authenticating request", http.StatusInternalServerError)
+		return
+	}
+	if user == nil {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	shoot := &gardencorev1beta1.Shoot{}
+	verb := "create"
+
+	switch req.Method {
+	case http.MethodGet:
+		key := client.ObjectKey{Namespace: req.URL.Query().Get("namespace"), Name: req.URL.Query().Get("name")}
+		if key.Namespace == "" || key.Name == "" {
+			http.Error(w, "query parameters 'namespace' and 'name' are required", http.StatusBadRequest)
+			return
+		}
+
+		// Check the permissions before reading the shoot in order to not reveal whether it exists.
+		verb = "get"
+		if !h.authorize(w, req, user, verb, key.Namespace, key.Name) {
+			return
+		}
+
+		if err := h.Reconciler.Client.Get(req.Context(), key, shoot); err != nil {
+			http.Error(w, fmt.Sprintf("failed reading shoot %s: %v", key, err), http.StatusBadRequest)
+			return
+		}
+
+	case http.MethodPost:
+		body, err := io.ReadAll(io.LimitReader(req.Body, maxExplainRequestBodySize))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed reading request body: %v", err), http.StatusBadRequest)
+			return
+		}
+		if err := yaml.Unmarshal(body, shoot); err != nil {
+			http.Error(w, fmt.Sprintf("failed decoding shoot: %v", err), http.StatusBadRequest)
+			return
+		}
+		if shoot.Namespace == "" {
+			http.Error(w, "shoot manifest must specify a namespace", http.StatusBadRequest)
+			return
+		}
+
+		if !h.authorize(w, req, user, verb, shoot.Namespace, "") {
+			return
+		}
+	}
+
+	log := h.Log.WithValues("shoot", client.ObjectKeyFromObject(shoot))
+
+	explanation, err := h.Reconciler.Explain(req.Context(), log, shoot)
+	if err != nil {
+		log.Error(err, "Failed explaining scheduling decision")
+		http.Error(w, fmt.Sprintf("failed explaining scheduling decision: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(explanation); err != nil {
+		log.Error(err, "Failed writing response")
+	}
+}
+
+// authenticate reviews the bearer token of the request. It returns nil if the request does not carry a valid token.
+func (h *ExplainHandler) authenticate(req *http.Request) (*authenticationv1.UserInfo, error) {
+	token, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if !ok || strings.TrimSpace(token) == "" {
+		return nil, nil
+	}
+
+	tokenReview := &authenticationv1.TokenReview{Spec: authenticationv1.TokenReviewSpec{Token: strings.TrimSpace(token)}}
+	if err := h.Reconciler.Client.Create(req.Context(), tokenReview); err != nil {
+		return nil, fmt.Errorf("failed creating TokenReview: %w", err)
+	}
+
+	if !tokenReview.Status.Authenticated {
+		return nil, nil
+	}
+	return &tokenReview.Status.User, nil
+}
+
+// authorize checks whether the user is allowed to perform the verb on the shoot. It writes an error response and
+// returns false if the request must not be served.
+func (h *ExplainHandler) authorize(w http.ResponseWriter, req *http.Request, user *authenticationv1.UserInfo, verb, namespace, name string) bool {
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for k, v := range user.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+
+	subjectAccessReview := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   user.Username,
+			Groups: user.Groups,
+			UID:    user.UID,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Group:     gardencorev1beta1.SchemeGroupVersion.Group,
+				Resource:  "shoots",
+				Verb:      verb,
+				Namespace: namespace,
+				Name:      name,
+			},
+		},
+	}
+	if err := h.Reconciler.Client.Create(req.Context(), subjectAccessReview); err != nil {
+		h.Log.Error(err, "Failed creating SubjectAccessReview")
+		http.Error(w, "failed authorizing request", http.StatusInternalServerError)
+		return false
+	}
+
+	if !subjectAccessReview.Status.Allowed {
+		http.Error(w, fmt.Sprintf("user %q is not allowed to %s shoots in namespace %q", user.Username, verb, namespace), http.StatusForbidden)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,297 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+)
+
+var _ = Describe("Explain", func() {
+	var (
+		ctx        = context.Background()
+		log        = logr.Discard()
+		fakeClient client.Client
+		reconciler *Reconciler
+
+		cloudProfile *gardencorev1beta1.CloudProfile
+		shoot        *gardencorev1beta1.Shoot
+
+		newSeed = func(name, region string) *gardencorev1beta1.Seed {
+			return &gardencorev1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: gardencorev1beta1.SeedSpec{
+					Provider: gardencorev1beta1.SeedProvider{Type: "foo", Region: region},
+					Networks: gardencorev1beta1.SeedNetworks{
+						Nodes:    ptr.To("10.10.0.0/16"),
+						Pods:     "10.20.0.0/16",
+						Services: "10.30.0.0/16",
+					},
+					Settings: &gardencorev1beta1.SeedSettings{
+						Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: true},
+					},
+				},
+				Status: gardencorev1beta1.SeedStatus{
+					Conditions: []gardencorev1beta1.Condition{
+						{Type: gardencorev1beta1.SeedGardenletReady, Status: gardencorev1beta1.ConditionTrue},
+					},
+					LastOperation: &gardencorev1beta1.LastOperation{},
+				},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: &config.ShootSchedulerConfiguration{Strategy: config.SameRegion},
+		}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "cloudprofile"}}
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: cloudProfile.Name,
+				Region:           "europe",
+				Provider:         gardencorev1beta1.Provider{Type: "foo"},
+				Networking: &gardencorev1beta1.Networking{
+					Nodes:    ptr.To("10.40.0.0/16"),
+					Pods:     ptr.To("10.50.0.0/16"),
+					Services: ptr.To("10.60.0.0/16"),
+				},
+			},
+		}
+
+		invisibleSeed := newSeed("seed-invisible", "europe")
+		invisibleSeed.Spec.Settings.Scheduling.Visible = false
+		otherProviderSeed := newSeed("seed-other-provider", "europe")
+		otherProviderSeed.Spec.Provider.Type = "bar"
+		taintedSeed := newSeed("seed-tainted", "europe")
+		taintedSeed.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: "foo"}}
+		fullSeed := newSeed("seed-full", "europe")
+		fullSeed.Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("0")}
+
+		Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+		for _, seed := range []*gardencorev1beta1.Seed{
+			newSeed("seed-1", "europe"),
+			newSeed("seed-2", "europe"),
+			newSeed("seed-other-region", "asia"),
+			invisibleSeed,
+			otherProviderSeed,
+			taintedSeed,
+			fullSeed,
+		} {
+			Expect(fakeClient.Create(ctx, seed)).To(Succeed())
+		}
+	})
+
+	Describe("#Explain", func() {
+		It("should explain why seeds were rejected and which seed is chosen", func() {
+			explanation, err := reconciler.Explain(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(explanation.Seed).To(Equal("seed-1"))
+			Expect(explanation.Error).To(BeEmpty())
+			Expect(explanation.Seeds).To(ConsistOf(
+				SeedExplanation{Name: "seed-1"},
+				SeedExplanation{Name: "seed-2"},
+				SeedExplanation{Name: "seed-other-region", RejectedBy: "Strategy", Reason: `seed is not a candidate of strategy "SameRegion" for region "europe"`},
+				SeedExplanation{Name: "seed-invisible", RejectedBy: "UsableSeeds", Reason: "seed is not visible for scheduling"},
+				SeedExplanation{Name: "seed-other-provider", RejectedBy: "Providers", Reason: `seed provider type "bar" does not match provider type "foo" of the shoot`},
+				SeedExplanation{Name: "seed-tainted", RejectedBy: "NetworksTaintsAndCapacity", Reason: "shoot does not tolerate the seed's taints"},
+				SeedExplanation{Name: "seed-full", RejectedBy: "NetworksTaintsAndCapacity", Reason: "seed does not have available capacity for shoots"},
+			))
+		})
+
+		It("should report the error if no seed is left", func() {
+			shoot.Spec.SeedSelector = &gardencorev1beta1.SeedSelector{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}}
+
+			explanation, err := reconciler.Explain(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(explanation.Seed).To(BeEmpty())
+			Expect(explanation.Error).To(ContainSubstring("none out of the 6 seeds has the matching labels required by seed selector of 'Shoot'"))
+			for _, seed := range explanation.Seeds {
+				Expect(seed.RejectedBy).NotTo(BeEmpty(), seed.Name)
+			}
+		})
+
+		It("should contain the scores if score plugins are configured", func() {
+			reconciler.Config.Scoring = &config.ShootSchedulerScoring{Plugins: []config.ScorePlugin{{Name: config.ScorePluginProjectShoots, Weight: 2}}}
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: shoot.Namespace},
+				Spec:       gardencorev1beta1.ShootSpec{SeedName: ptr.To("seed-1")},
+			})).To(Succeed())
+
+			explanation, err := reconciler.Explain(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(explanation.Seed).To(Equal("seed-2"))
+			Expect(explanation.Seeds).To(ContainElements(
+				SeedExplanation{Name: "seed-1", Shoots: 1, Score: ptr.To[int64](0), PluginScores: map[config.ScorePluginName]int64{config.ScorePluginProjectShoots: 0}},
+				SeedExplanation{Name: "seed-2", Score: ptr.To[int64](200), PluginScores: map[config.ScorePluginName]int64{config.ScorePluginProjectShoots: 100}},
+			))
+		})
+	})
+
+	Describe("#ExplainHandler", func() {
+		var (
+			handler *ExplainHandler
+
+			allowed             bool
+			subjectAccessReview *authorizationv1.SubjectAccessReview
+
+			newRequest = func(method, target string, body io.Reader) *http.Request {
+				req := httptest.NewRequest(method, target, body)
+				req.Header.Set("Authorization", "Bearer valid-token")
+				return req
+			}
+		)
+
+		BeforeEach(func() {
+			allowed = true
+			subjectAccessReview = nil
+
+			authClient := interceptor.NewClient(fakeClient.(client.WithWatch), interceptor.Funcs{
+				Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					switch o := obj.(type) {
+					case *authenticationv1.TokenReview:
+						if o.Spec.Token == "valid-token" {
+							o.Status.Authenticated = true
+							o.Status.User = authenticationv1.UserInfo{Username: "alice", Groups: []string{"devs"}}
+						}
+						return nil
+					case *authorizationv1.SubjectAccessReview:
+						o.Status.Allowed = allowed
+						subjectAccessReview = o
+						return nil
+					}
+					return c.Create(ctx, obj, opts...)
+				},
+			})
+
+			handler = &ExplainHandler{Log: log, Reconciler: &Reconciler{Client: authClient, Config: reconciler.Config}}
+		})
+
+		It("should explain a posted shoot manifest", func() {
+			body, err := json.Marshal(shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, newRequest(http.MethodPost, ExplainHandlerPath, strings.NewReader(string(body))))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			explanation := &Explanation{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), explanation)).To(Succeed())
+			Expect(explanation.Seed).To(Equal("seed-1"))
+			Expect(explanation.Seeds).To(HaveLen(7))
+
+			Expect(subjectAccessReview.Spec.User).To(Equal("alice"))
+			Expect(subjectAccessReview.Spec.Groups).To(ConsistOf("devs"))
+			Expect(subjectAccessReview.Spec.ResourceAttributes).To(Equal(&authorizationv1.ResourceAttributes{
+				Group:     "core.gardener.cloud",
+				Resource:  "shoots",
+				Verb:      "create",
+				Namespace: "garden-dev",
+			}))
+		})
+
+		It("should explain an existing shoot", func() {
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, newRequest(http.MethodGet, ExplainHandlerPath+"?namespace=garden-dev&name=shoot", nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"seed":"seed-1"`))
+
+			Expect(subjectAccessReview.Spec.ResourceAttributes).To(Equal(&authorizationv1.ResourceAttributes{
+				Group:     "core.gardener.cloud",
+				Resource:  "shoots",
+				Verb:      "get",
+				Namespace: "garden-dev",
+				Name:      "shoot",
+			}))
+		})
+
+		It("should reject requests without a token", func() {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, ExplainHandlerPath+"?namespace=garden-dev&name=shoot", nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+			Expect(subjectAccessReview).To(BeNil())
+		})
+
+		It("should reject requests with an invalid token", func() {
+			req := httptest.NewRequest(http.MethodGet, ExplainHandlerPath+"?namespace=garden-dev&name=shoot", nil)
+			req.Header.Set("Authorization", "Bearer invalid-token")
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+			Expect(subjectAccessReview).To(BeNil())
+		})
+
+		It("should reject requests of users who are not allowed to read the shoot", func() {
+			allowed = false
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, newRequest(http.MethodGet, ExplainHandlerPath+"?namespace=garden-dev&name=shoot", nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusForbidden))
+			Expect(recorder.Body.String()).NotTo(ContainSubstring("seed-1"))
+		})
+
+		It("should reject posted shoot manifests without namespace", func() {
+			shoot.Namespace = ""
+			body, err := json.Marshal(shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, newRequest(http.MethodPost, ExplainHandlerPath, strings.NewReader(string(body))))
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(subjectAccessReview).To(BeNil())
+		})
+
+		It("should fail if the shoot is not referenced", func() {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, newRequest(http.MethodGet, ExplainHandlerPath, nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should reject other methods", func() {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, newRequest(http.MethodDelete, ExplainHandlerPath, nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+		})
+	})
+})
@@ -0,0 +1,329 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+)
+
+// maxSeedScore is the highest score a single score plugin can assign to a seed.
+const maxSeedScore int64 = 100
+
+// schedulingContext contains the information which is needed by the filter and score plugins to determine a seed for
+// a shoot.
+type schedulingContext struct {
+	log          logr.Logger
+	shoot        *gardencorev1beta1.Shoot
+	shootList    []*gardencorev1beta1.Shoot
+	seedUsage    map[string]int
+	cloudProfile *gardencorev1beta1.CloudProfile
+	regionConfig *corev1.ConfigMap
+	strategy     config.CandidateDeterminationStrategy
+}
+
+// filterPlugin removes the seeds which are not suitable for hosting the shoot. It returns an error if none of the
+// given seeds is suitable.
+type filterPlugin struct {
+	name   string
+	filter func(sctx *schedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error)
+	// reason returns why the given seed was removed by the filter.
+	reason func(sctx *schedulingContext, seed *gardencorev1beta1.Seed) string
+}
+
+// filterPlugins is the ordered list of filter plugins which are run before the seed candidates are scored.
+var filterPlugins = []filterPlugin{
+	{
+		name: "UsableSeeds",
+		filter: func(_ *schedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterUsableSeeds(seeds)
+		},
+		reason: func(_ *schedulingContext, seed *gardencorev1beta1.Seed) string {
+			switch {
+			case seed.DeletionTimestamp != nil:
+				return "seed is being deleted"
+			case !seed.Spec.Settings.Scheduling.Visible:
+				return "seed is not visible for scheduling"
+			default:
+				return "seed is not ready"
+			}
+		},
+	},
+	{
+		name: "CloudProfileSeedSelector",
+		filter: func(sctx *schedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterSeedsMatchingLabelSelector(seeds, sctx.cloudProfile.Spec.SeedSelector, "CloudProfile")
+		},
+		reason: func(_ *schedulingContext, _ *gardencorev1beta1.Seed) string {
+			return "seed labels do not match the seed selector of the CloudProfile"
+		},
+	},
+	{
+		name: "ShootSeedSelector",
+		filter: func(sctx *schedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterSeedsMatchingLabelSelector(seeds, sctx.shoot.Spec.SeedSelector, "Shoot")
+		},
+		reason: func(_ *schedulingContext, _ *gardencorev1beta1.Seed) string {
+			return "seed labels do not match the seed selector of the Shoot"
+		},
+	},
+	{
+		name: "Providers",
+		filter: func(sctx *schedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterSeedsMatchingProviders(sctx.cloudProfile, sctx.shoot, seeds)
+		},
+		reason: func(sctx *schedulingContext, seed *gardencorev1beta1.Seed) string {
+			return fmt.Sprintf("seed provider type %q does not match provider type %q of the shoot", seed.Spec.Provider.Type, sctx.shoot.Spec.Provider.Type)
+		},
+	},
+	{
+		name: "ZonalShootControlPlanes",
+		filter: func(sctx *schedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterSeedsForZonalShootControlPlanes(seeds, sctx.shoot)
+		},
+		reason: func(_ *schedulingContext, _ *gardencorev1beta1.Seed) string {
+			return "seed has less than 3 zones for hosting a shoot control plane with failure tolerance type 'zone'"
+		},
+	},
+	{
+		name: "NetworksTaintsAndCapacity",
+		filter: func(sctx *schedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterCandidates(sctx.shoot, sctx.shootList, seeds)
+		},
+		reason: func(sctx *schedulingContext, seed *gardencorev1beta1.Seed) string {
+			if err := checkCandidate(seed, sctx.shoot, sctx.seedUsage); err != nil {
+				return err.Error()
+			}
+			return ""
+		},
+	},
+	{
+		name: "Strategy",
+		filter: func(sctx *schedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return applyStrategy(sctx.log, sctx.shoot, seeds, sctx.strategy, sctx.regionConfig)
+		},
+		reason: func(sctx *schedulingContext, _ *gardencorev1beta1.Seed) string {
+			return fmt.Sprintf("seed is not a candidate of strategy %q for region %q", sctx.strategy, sctx.shoot.Spec.Region)
+		},
+	},
+}
+
+// scorePlugin ranks the seed candidates which survived the filter plugins.
+type scorePlugin interface {
+	// Score returns a score between 0 and maxSeedScore for each of the given seeds, keyed by the seed names. Seeds with
+	// higher scores are preferred.
+	Score(sctx *schedulingContext, seeds []gardencorev1beta1.Seed) (map[string]int64, error)
+}
+
+// scorePlugins contains all known score plugins.
+var scorePlugins = map[config.ScorePluginName]scorePlugin{
+	config.ScorePluginAllocatableShoots: allocatableShootsScorePlugin{},
+	config.ScorePluginZones:             zonesScorePlugin{},
+	config.ScorePluginDistance:          distanceScorePlugin{},
+	config.ScorePluginProjectShoots:     projectShootsScorePlugin{},
+}
+
+// seedScore is the overall score of a seed candidate.
+type seedScore struct {
+	seed         *gardencorev1beta1.Seed
+	score        int64
+	pluginScores map[config.ScorePluginName]int64
+}
+
+// scoreSeeds runs the configured score plugins for the given seeds and returns the weighted sum of the plugin scores
+// per seed. The result is ordered from the best to the worst candidate. Seeds with equal scores are ordered by the
+// number of shoots they host and their names.
+func scoreSeeds(sctx *schedulingContext, seeds []gardencorev1beta1.Seed, plugins []config.ScorePlugin) ([]seedScore, error) {
+	scores := make([]seedScore, 0, len(seeds))
+	for i := range seeds {
+		scores = append(scores, seedScore{seed: &seeds[i], pluginScores: make(map[config.ScorePluginName]int64, len(plugins))})
+	}
+
+	for _, pluginConfig := range plugins {
+		plugin, ok := scorePlugins[pluginConfig.Name]
+		if !ok {
+			return nil, fmt.Errorf("unknown score plugin %q", pluginConfig.Name)
+		}
+
+		pluginScores, err := plugin.Score(sctx, seeds)
+		if err != nil {
+			return nil, fmt.Errorf("failed running score plugin %q: %w", pluginConfig.Name, err)
+		}
+
+		for i := range scores {
+			score := pluginScores[scores[i].seed.Name]
+			scores[i].pluginScores[pluginConfig.Name] = score
+			scores[i].score += score * int64(pluginConfig.Weight)
+		}
+	}
+
+	sort.SliceStable(scores, func(i, j int) bool {
+		if scores[i].score != scores[j].score {
+			return scores[i].score > scores[j].score
+		}
+		if usageI, usageJ := sctx.seedUsage[scores[i].seed.Name], sctx.seedUsage[scores[j].seed.Name]; usageI != usageJ {
+			return usageI < usageJ
+		}
+		return scores[i].seed.Name < scores[j].seed.Name
+	})
+
+	return scores, nil
+}
+
+// formatSeedScores returns a human-readable representation of the given seed scores.
+func formatSeedScores(scores []seedScore) string {
+	out := make([]string, 0, len(scores))
+	for _, score := range scores {
+		out = append(out, fmt.Sprintf("%s=%d", score.seed.Name, score.score))
+	}
+	return strings.Join(out, ", ")
+}
+
+// normalizeScore scales the given value to the range between 0 and maxSeedScore relative to the given maximum value.
+func normalizeScore(value, maxValue int64) int64 {
+	if maxValue <= 0 {
+		return maxSeedScore
+	}
+	return value * maxSeedScore / maxValue
+}
+
+// normalizeInverseScore is like normalizeScore but assigns the highest score to the smallest value.
+func normalizeInverseScore(value, maxValue int64) int64 {
+	if maxValue <= 0 {
+		return maxSeedScore
+	}
+	return maxSeedScore - normalizeScore(value, maxValue)
+}
+
+// allocatableShootsScorePlugin prefers seeds with more remaining capacity for shoots. Seeds without allocatable shoots
+// in their status are considered to have unlimited capacity.
+type allocatableShootsScorePlugin struct{}
+
+func (allocatableShootsScorePlugin) Score(sctx *schedulingContext, seeds []gardencorev1beta1.Seed) (map[string]int64, error) {
+	var (
+		remaining    = make(map[string]int64, len(seeds))
+		maxRemaining int64
+	)
+
+	for _, seed := range seeds {
+		allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]
+		if !ok {
+			continue
+		}
+
+		remaining[seed.Name] = max(allocatableShoots.Value()-int64(sctx.seedUsage[seed.Name]), 0)
+		maxRemaining = max(maxRemaining, remaining[seed.Name])
+	}
+
+	scores := make(map[string]int64, len(seeds))
+	for _, seed := range seeds {
+		if r, ok := remaining[seed.Name]; ok {
+			scores[seed.Name] = normalizeScore(r, maxRemaining)
+		} else {
+			scores[seed.Name] = maxSeedScore
+		}
+	}
+	return scores, nil
+}
+
+// zonesScorePlugin prefers seeds with more zones.
+type zonesScorePlugin struct{}
+
+func (zonesScorePlugin) Score(_ *schedulingContext, seeds []gardencorev1beta1.Seed) (map[string]int64, error) {
+	var maxZones int64
+	for _, seed := range seeds {
+		maxZones = max(maxZones, int64(len(seed.Spec.Provider.Zones)))
+	}
+
+	scores := make(map[string]int64, len(seeds))
+	for _, seed := range seeds {
+		scores[seed.Name] = normalizeScore(int64(len(seed.Spec.Provider.Zones)), maxZones)
+	}
+	return scores, nil
+}
+
+// distanceScorePlugin prefers seeds with a smaller distance to the shoot's region. The distances are taken from the
+// region ConfigMap if it contains the shoot's region. Seeds whose regions are not listed in the ConfigMap are considered
+// to be the most distant ones. Without region ConfigMap, the Levenshtein based distance is used.
+type distanceScorePlugin struct{}
+
+func (distanceScorePlugin) Score(sctx *schedulingContext, seeds []gardencorev1beta1.Seed) (map[string]int64, error) {
+	var (
+		distances   = make(map[string]int64, len(seeds))
+		unknown     []string
+		maxDistance int64
+	)
+
+	if sctx.regionConfig != nil && sctx.regionConfig.Data[sctx.shoot.Spec.Region] != "" {
+		regionDistances, err := regionConfigDistances(sctx.regionConfig, sctx.shoot.Spec.Region)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, seed := range seeds {
+			dist, ok := regionDistances[seed.Spec.Provider.Region]
+			if !ok {
+				unknown = append(unknown, seed.Name)
+				continue
+			}
+			distances[seed.Name] = int64(dist)
+		}
+	} else {
+		for _, seed := range seeds {
+			distances[seed.Name] = int64(levenshteinDistance(&seed, sctx.shoot))
+		}
+	}
+
+	for _, dist := range distances {
+		maxDistance = max(maxDistance, dist)
+	}
+	if len(unknown) > 0 {
+		maxDistance++
+		for _, name := range unknown {
+			distances[name] = maxDistance
+		}
+	}
+
+	scores := make(map[string]int64, len(seeds))
+	for _, seed := range seeds {
+		scores[seed.Name] = normalizeInverseScore(distances[seed.Name], maxDistance)
+	}
+	return scores, nil
+}
+
+// projectShootsScorePlugin prefers seeds hosting fewer shoots of the shoot's project in order to spread the shoots of a
+// project across multiple seeds.
+type projectShootsScorePlugin struct{}
+
+func (projectShootsScorePlugin) Score(sctx *schedulingContext, seeds []gardencorev1beta1.Seed) (map[string]int64, error) {
+	var (
+		projectShoots    = make(map[string]int64, len(seeds))
+		maxProjectShoots int64
+	)
+
+	for _, shoot := range sctx.shootList {
+		if shoot.Namespace != sctx.shoot.Namespace || shoot.Spec.SeedName == nil {
+			continue
+		}
+		projectShoots[*shoot.Spec.SeedName]++
+	}
+
+	for _, seed := range seeds {
+		maxProjectShoots = max(maxProjectShoots, projectShoots[seed.Name])
+	}
+
+	scores := make(map[string]int64, len(seeds))
+	for _, seed := range seeds {
+		scores[seed.Name] = normalizeInverseScore(projectShoots[seed.Name], maxProjectShoots)
+	}
+	return scores, nil
+}
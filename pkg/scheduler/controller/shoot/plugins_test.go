@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+)
+
+var _ = Describe("Plugins", func() {
+	var (
+		shoot *gardencorev1beta1.Shoot
+		seeds []gardencorev1beta1.Seed
+		sctx  *schedulingContext
+
+		newSeed = func(name, region string, zones int, allocatableShoots *int64) gardencorev1beta1.Seed {
+			seed := gardencorev1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: gardencorev1beta1.SeedSpec{
+					Provider: gardencorev1beta1.SeedProvider{Type: "foo", Region: region},
+				},
+			}
+			for i := 0; i < zones; i++ {
+				seed.Spec.Provider.Zones = append(seed.Spec.Provider.Zones, string(rune('a'+i)))
+			}
+			if allocatableShoots != nil {
+				seed.Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: *resource.NewQuantity(*allocatableShoots, resource.DecimalSI)}
+			}
+			return seed
+		}
+		newShoot = func(namespace, seedName string) *gardencorev1beta1.Shoot {
+			return &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace},
+				Spec:       gardencorev1beta1.ShootSpec{SeedName: &seedName},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+			Spec: gardencorev1beta1.ShootSpec{
+				Provider: gardencorev1beta1.Provider{Type: "foo"},
+				Region:   "eu-west-1",
+			},
+		}
+
+		seeds = []gardencorev1beta1.Seed{
+			newSeed("seed-1", "eu-west-1", 1, ptr.To[int64](10)),
+			newSeed("seed-2", "us-east-1", 3, ptr.To[int64](100)),
+			newSeed("seed-3", "eu-west-2", 2, nil),
+		}
+
+		shootList := []*gardencorev1beta1.Shoot{
+			newShoot("garden-dev", "seed-1"),
+			newShoot("garden-dev", "seed-2"),
+			newShoot("garden-dev", "seed-2"),
+			newShoot("garden-other", "seed-2"),
+		}
+
+		sctx = &schedulingContext{
+			log:       logr.Discard(),
+			shoot:     shoot,
+			shootList: shootList,
+			seedUsage: v1beta1helper.CalculateSeedUsage(shootList),
+		}
+	})
+
+	Describe("#allocatableShootsScorePlugin", func() {
+		It("should prefer seeds with more remaining capacity", func() {
+			Expect(allocatableShootsScorePlugin{}.Score(sctx, seeds)).To(Equal(map[string]int64{
+				"seed-1": 9,
+				"seed-2": 100,
+				"seed-3": 100,
+			}))
+		})
+	})
+
+	Describe("#zonesScorePlugin", func() {
+		It("should prefer seeds with more zones", func() {
+			Expect(zonesScorePlugin{}.Score(sctx, seeds)).To(Equal(map[string]int64{
+				"seed-1": 33,
+				"seed-2": 100,
+				"seed-3": 66,
+			}))
+		})
+	})
+
+	Describe("#distanceScorePlugin", func() {
+		It("should prefer seeds with a smaller Levenshtein distance", func() {
+			scores, err := distanceScorePlugin{}.Score(sctx, seeds)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scores["seed-1"]).To(Equal(maxSeedScore))
+			Expect(scores["seed-3"]).To(BeNumerically(">", scores["seed-2"]))
+		})
+
+		It("should use the distances of the region config and consider unknown regions as most distant", func() {
+			sctx.regionConfig = &corev1.ConfigMap{
+				Data: map[string]string{
+					"eu-west-1": "eu-west-2: 10\nus-east-1: 30",
+				},
+			}
+			seeds = append(seeds, newSeed("seed-4", "ap-south-1", 0, nil))
+
+			Expect(distanceScorePlugin{}.Score(sctx, seeds)).To(Equal(map[string]int64{
+				"seed-1": 100,
+				"seed-2": 4,
+				"seed-3": 68,
+				"seed-4": 0,
+			}))
+		})
+	})
+
+	Describe("#projectShootsScorePlugin", func() {
+		It("should prefer seeds with fewer shoots of the same project", func() {
+			Expect(projectShootsScorePlugin{}.Score(sctx, seeds)).To(Equal(map[string]int64{
+				"seed-1": 50,
+				"seed-2": 0,
+				"seed-3": 100,
+			}))
+		})
+	})
+
+	Describe("#scoreSeeds", func() {
+		It("should rank the seeds by the weighted sum of the plugin scores", func() {
+			scores, err := scoreSeeds(sctx, seeds, []config.ScorePlugin{
+				{Name: config.ScorePluginAllocatableShoots, Weight: 2},
+				{Name: config.ScorePluginZones, Weight: 1},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(formatSeedScores(scores)).To(Equal("seed-2=300, seed-3=266, seed-1=51"))
+			Expect(scores[0].pluginScores).To(Equal(map[config.ScorePluginName]int64{
+				config.ScorePluginAllocatableShoots: 100,
+				config.ScorePluginZones:             100,
+			}))
+		})
+
+		It("should prefer the seed with fewer shoots if the scores are equal", func() {
+			scores, err := scoreSeeds(sctx, seeds, []config.ScorePlugin{{Name: config.ScorePluginAllocatableShoots, Weight: 1}})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(formatSeedScores(scores)).To(Equal("seed-3=100, seed-2=100, seed-1=9"))
+		})
+
+		It("should fail for unknown score plugins", func() {
+			_, err := scoreSeeds(sctx, seeds, []config.ScorePlugin{{Name: "foo", Weight: 1}})
+			Expect(err).To(MatchError(ContainSubstring(`unknown score plugin "foo"`)))
+		})
+	})
+})
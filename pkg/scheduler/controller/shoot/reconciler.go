@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"slices"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -67,7 +68,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	// If no Seed is referenced, we try to determine an adequate one.
-	seed, err := r.determineSeed(ctx, log, shoot)
+	seed, scores, err := r.determineSeed(ctx, log, shoot)
 	if err != nil {
 		r.reportFailedScheduling(ctx, log, shoot, err)
 		return reconcile.Result{}, fmt.Errorf("failed to determine seed for shoot: %w", err)
@@ -85,8 +86,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		"region", shoot.Spec.Region,
 		"seed", seed.Name,
 		"strategy", r.Config.Strategy,
+		"scores", formatSeedScores(scores),
 	)
 
+	if len(scores) > 0 {
+		r.reportEvent(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventSchedulingSuccessful, "Scheduled to seed '%s' (scores: %s)", seed.Name, formatSeedScores(scores))
+		return reconcile.Result{}, nil
+	}
+
 	r.reportEvent(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventSchedulingSuccessful, "Scheduled to seed '%s'", seed.Name)
 	return reconcile.Result{}, nil
 }
@@ -112,64 +119,109 @@ func (r *Reconciler) reportEvent(shoot *gardencorev1beta1.Shoot, eventType strin
 	r.Recorder.Eventf(shoot, eventType, eventReason, messageFmt, args...)
 }
 
-// determineSeed returns an appropriate Seed cluster (or nil).
+// DetermineMigrationTarget returns the best seed for migrating the control plane of the given shoot away from its
+// current seed. The same filter and score plugins as for scheduling new shoots are used. Additionally, the target seed
+// must differ from the current seed, have the same provider type, have backups configured and be accepted by the given
+// function.
+func (r *Reconciler) DetermineMigrationTarget(
+	ctx context.Context,
+	log logr.Logger,
+	shoot *gardencorev1beta1.Shoot,
+	currentSeed *gardencorev1beta1.Seed,
+	accept func(seed *gardencorev1beta1.Seed) bool,
+) (
+	*gardencorev1beta1.Seed,
+	error,
+) {
+	seed, _, err := r.determineSeed(ctx, log, shoot, filterPlugin{
+		name: "MigrationTarget",
+		filter: func(_ *schedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			var matchingSeeds []gardencorev1beta1.Seed
+			for _, seed := range seeds {
+				if seed.Name != currentSeed.Name && seed.Spec.Provider.Type == currentSeed.Spec.Provider.Type && seed.Spec.Backup != nil && accept(&seed) {
+					matchingSeeds = append(matchingSeeds, seed)
+				}
+			}
+
+			if len(matchingSeeds) == 0 {
+				return nil, fmt.Errorf("none out of the %d seeds is a suitable target for migrating the control plane away from seed %q", len(seeds), currentSeed.Name)
+			}
+			return matchingSeeds, nil
+		},
+	})
+	return seed, err
+}
+
+// determineSeed returns an appropriate Seed cluster (or nil). If score plugins are configured, the scores of all
+// seed candidates are returned as well, ordered from the best to the worst candidate. The given additional filter
+// plugins are run after the default filter plugins.
 func (r *Reconciler) determineSeed(
 	ctx context.Context,
 	log logr.Logger,
 	shoot *gardencorev1beta1.Shoot,
+	additionalFilterPlugins ...filterPlugin,
 ) (
 	*gardencorev1beta1.Seed,
+	[]seedScore,
 	error,
 ) {
+	sctx, seeds, err := r.newSchedulingContext(ctx, log, shoot)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	filteredSeeds := seeds
+	for _, plugin := range slices.Concat(filterPlugins, additionalFilterPlugins) {
+		filteredSeeds, err = plugin.filter(sctx, filteredSeeds)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if r.Config.Scoring == nil {
+		seed, err := getSeedWithLeastShootsDeployed(filteredSeeds, sctx.shootList)
+		return seed, nil, err
+	}
+
+	scores, err := scoreSeeds(sctx, filteredSeeds, r.Config.Scoring.Plugins)
+	if err != nil {
+		return nil, nil, err
+	}
+	return scores[0].seed, scores, nil
+}
+
+// newSchedulingContext reads all information which is required for determining a seed for the given shoot. It returns
+// the scheduling context and all existing seeds.
+func (r *Reconciler) newSchedulingContext(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (*schedulingContext, []gardencorev1beta1.Seed, error) {
 	seedList := &gardencorev1beta1.SeedList{}
 	if err := r.Client.List(ctx, seedList); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	sl := &gardencorev1beta1.ShootList{}
 	if err := r.Client.List(ctx, sl); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	shootList := v1beta1helper.ConvertShootList(sl.Items)
 
 	cloudProfile := &gardencorev1beta1.CloudProfile{}
 	if err := r.Client.Get(ctx, kubernetesutils.Key(shoot.Spec.CloudProfileName), cloudProfile); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	regionConfig, err := r.getRegionConfigMap(ctx, log, cloudProfile)
 	if err != nil {
-		return nil, err
-	}
-
-	filteredSeeds, err := filterUsableSeeds(seedList.Items)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, cloudProfile.Spec.SeedSelector, "CloudProfile")
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, shoot.Spec.SeedSelector, "Shoot")
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsMatchingProviders(cloudProfile, shoot, filteredSeeds)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsForZonalShootControlPlanes(filteredSeeds, shoot)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterCandidates(shoot, shootList, filteredSeeds)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = applyStrategy(log, shoot, filteredSeeds, r.Config.Strategy, regionConfig)
-	if err != nil {
-		return nil, err
-	}
-	return getSeedWithLeastShootsDeployed(filteredSeeds, shootList)
+		return nil, nil, err
+	}
+
+	return &schedulingContext{
+		log:          log,
+		shoot:        shoot,
+		shootList:    shootList,
+		seedUsage:    v1beta1helper.CalculateSeedUsage(shootList),
+		cloudProfile: cloudProfile,
+		regionConfig: regionConfig,
+		strategy:     r.Config.Strategy,
+	}, seedList.Items, nil
 }
 
 func (r *Reconciler) getRegionConfigMap(ctx context.Context, log logr.Logger, cloudProfile *gardencorev1beta1.CloudProfile) (*corev1.ConfigMap, error) {
@@ -292,6 +344,9 @@ func applyStrategy(log logr.Logger, shoot *gardencorev1beta1.Shoot, seedList []g
 		if err != nil {
 			return nil, err
 		}
+	case strategy == config.Score:
+		// The seeds are ranked by the score plugins, hence all remaining seeds are candidates.
+		candidates = seedList
 	default:
 		return nil, fmt.Errorf("failed to determine seed candidates. shoot purpose: '%s', strategy: '%s', valid strategies are: %v", *shoot.Spec.Purpose, strategy, config.Strategies)
 	}
@@ -310,20 +365,8 @@ func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1b
 	)
 
 	for _, seed := range seedList {
-		if shoot.Spec.Networking != nil {
-			if disjointed, err := networksAreDisjointed(&seed, shoot); !disjointed {
-				candidateErrors[seed.Name] = err
-				continue
-			}
-		}
-
-		if !v1beta1helper.TaintsAreTolerated(seed.Spec.Taints, shoot.Spec.Tolerations) {
-			candidateErrors[seed.Name] = errors.New("shoot does not tolerate the seed's taints")
-			continue
-		}
-
-		if allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; ok && int64(seedUsage[seed.Name]) >= allocatableShoots.Value() {
-			candidateErrors[seed.Name] = errors.New("seed does not have available capacity for shoots")
+		if err := checkCandidate(&seed, shoot, seedUsage); err != nil {
+			candidateErrors[seed.Name] = err
 			continue
 		}
 
@@ -336,6 +379,26 @@ func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1b
 	return candidates, nil
 }
 
+// checkCandidate returns an error if the networks of the given seed and shoot overlap, the shoot does not tolerate the
+// seed's taints or the seed does not have capacity for another shoot.
+func checkCandidate(seed *gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot, seedUsage map[string]int) error {
+	if shoot.Spec.Networking != nil {
+		if disjointed, err := networksAreDisjointed(seed, shoot); !disjointed {
+			return err
+		}
+	}
+
+	if !v1beta1helper.TaintsAreTolerated(seed.Spec.Taints, shoot.Spec.Tolerations) {
+		return errors.New("shoot does not tolerate the seed's taints")
+	}
+
+	if allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; ok && int64(seedUsage[seed.Name]) >= allocatableShoots.Value() {
+		return errors.New("seed does not have available capacity for shoots")
+	}
+
+	return nil
+}
+
 // getSeedWithLeastShootsDeployed finds the best candidate (i.e. the one managing the smallest number of shoots right now).
 func getSeedWithLeastShootsDeployed(seedList []gardencorev1beta1.Seed, shootList []*gardencorev1beta1.Shoot) (*gardencorev1beta1.Seed, error) {
 	var (
@@ -410,14 +473,9 @@ func regionConfigMinimalDistance(log logr.Logger, seeds []gardencorev1beta1.Seed
 		return candidates, nil
 	}
 
-	regionConfigData := make(map[string]int)
-	if err := yaml.Unmarshal([]byte(regionConfig.Data[shoot.Spec.Region]), &regionConfigData); err != nil {
-		return nil, fmt.Errorf("failed to determine seed candidates. Wrong format in region ConfigMap %s/%s, Region %q: %w", regionConfig.Namespace, regionConfig.Name, shoot.Spec.Region, err)
-	}
-
-	// If not configured otherwise, assume that a region has the smallest possible distance to itself.
-	if _, ok := regionConfigData[shoot.Spec.Region]; !ok {
-		regionConfigData[shoot.Spec.Region] = 0
+	regionConfigData, err := regionConfigDistances(regionConfig, shoot.Spec.Region)
+	if err != nil {
+		return nil, err
 	}
 
 	minDistance := math.MaxInt32
@@ -442,21 +500,40 @@ func regionConfigMinimalDistance(log logr.Logger, seeds []gardencorev1beta1.Seed
 	return candidates, nil
 }
 
+// regionConfigDistances returns the distances of all seed regions to the given shoot region as configured in the
+// region ConfigMap.
+func regionConfigDistances(regionConfig *corev1.ConfigMap, shootRegion string) (map[string]int, error) {
+	regionConfigData := make(map[string]int)
+	if err := yaml.Unmarshal([]byte(regionConfig.Data[shootRegion]), &regionConfigData); err != nil {
+		return nil, fmt.Errorf("failed to determine seed candidates. Wrong format in region ConfigMap %s/%s, Region %q: %w", regionConfig.Namespace, regionConfig.Name, shootRegion, err)
+	}
+
+	// If not configured otherwise, assume that a region has the smallest possible distance to itself.
+	if _, ok := regionConfigData[shootRegion]; !ok {
+		regionConfigData[shootRegion] = 0
+	}
+
+	return regionConfigData, nil
+}
+
+// levenshteinDistance returns the Levenshtein based distance between the regions of the given seed and shoot. Seeds of
+// a different provider type are considered to be further away.
+func levenshteinDistance(seed *gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot) int {
+	dist := distance(seed.Spec.Provider.Region, shoot.Spec.Region)
+	if shoot.Spec.Provider.Type != seed.Spec.Provider.Type {
+		dist = dist + 2
+	}
+	return dist
+}
+
 func levenshteinMinimalDistance(seeds []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot) []gardencorev1beta1.Seed {
 	var (
-		minDistance   = 1000
-		shootRegion   = shoot.Spec.Region
-		shootProvider = shoot.Spec.Provider.Type
-		candidates    []gardencorev1beta1.Seed
+		minDistance = 1000
+		candidates  []gardencorev1beta1.Seed
 	)
 
 	for _, seed := range seeds {
-		seedRegion := seed.Spec.Provider.Region
-		dist := distance(seedRegion, shootRegion)
-
-		if shootProvider != seed.Spec.Provider.Type {
-			dist = dist + 2
-		}
+		dist := levenshteinDistance(&seed, shoot)
 
 		if dist == minDistance {
 			candidates = append(candidates, seed)
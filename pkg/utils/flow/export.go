@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// sortedIDs returns the IDs of all tasks of the flow in lexicographical order.
+func (f *Flow) sortedIDs() TaskIDSlice {
+	ids := make(TaskIDs, len(f.nodes))
+	for id := range f.nodes {
+		ids.Insert(id)
+	}
+	return ids.List()
+}
+
+// DOT renders the flow as a directed graph in the DOT language of Graphviz. Skipped tasks are drawn with a dashed
+// border.
+func (f *Flow) DOT() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "digraph %s {\n", strconv.Quote(f.name))
+	for _, id := range f.sortedIDs() {
+		if f.nodes[id].skip {
+			fmt.Fprintf(&b, "  %s [style=dashed];\n", strconv.Quote(string(id)))
+		} else {
+			fmt.Fprintf(&b, "  %s;\n", strconv.Quote(string(id)))
+		}
+	}
+	for _, id := range f.sortedIDs() {
+		for _, target := range f.nodes[id].targetIDs.List() {
+			fmt.Fprintf(&b, "  %s -> %s;\n", strconv.Quote(string(id)), strconv.Quote(string(target)))
+		}
+	}
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+// Mermaid renders the flow as a Mermaid flowchart. Skipped tasks are assigned the 'skipped' class.
+func (f *Flow) Mermaid() string {
+	var (
+		b        strings.Builder
+		ids      = f.sortedIDs()
+		idToNode = make(map[TaskID]string, len(ids))
+	)
+
+	// Task IDs may contain characters which are not allowed in Mermaid node IDs, hence they are only used as labels.
+	for i, id := range ids {
+		idToNode[id] = fmt.Sprintf("t%d", i)
+	}
+
+	fmt.Fprintf(&b, "---\ntitle: %s\n---\nflowchart TD\n", f.name)
+	for _, id := range ids {
+		label := strings.ReplaceAll(string(id), `"`, "#quot;")
+		if f.nodes[id].skip {
+			fmt.Fprintf(&b, "  %s[\"%s\"]:::skipped\n", idToNode[id], label)
+		} else {
+			fmt.Fprintf(&b, "  %s[\"%s\"]\n", idToNode[id], label)
+		}
+	}
+	for _, id := range ids {
+		for _, target := range f.nodes[id].targetIDs.List() {
+			fmt.Fprintf(&b, "  %s --> %s\n", idToNode[id], idToNode[target])
+		}
+	}
+	b.WriteString("  classDef skipped stroke-dasharray: 5 5\n")
+
+	return b.String()
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("Export", func() {
+	var f *flow.Flow
+
+	BeforeEach(func() {
+		var (
+			g = flow.NewGraph("Shoot cluster reconciliation")
+			x = g.Add(flow.Task{Name: "Deploying namespace"})
+			y = g.Add(flow.Task{Name: `Waiting for "infrastructure"`, SkipIf: true, Dependencies: flow.NewTaskIDs(x)})
+			_ = g.Add(flow.Task{Name: "Deploying workers", Dependencies: flow.NewTaskIDs(x, y)})
+		)
+		f = g.Compile()
+	})
+
+	Describe("#DOT", func() {
+		It("should render the flow in the DOT language", func() {
+			Expect(f.DOT()).To(Equal(`digraph "Shoot cluster reconciliation" {
+  "Deploying namespace";
+  "Deploying workers";
+  "Waiting for \"infrastructure\"" [style=dashed];
+  "Deploying namespace" -> "Deploying workers";
+  "Deploying namespace" -> "Waiting for \"infrastructure\"";
+  "Waiting for \"infrastructure\"" -> "Deploying workers";
+}
+`))
+		})
+	})
+
+	Describe("#Mermaid", func() {
+		It("should render the flow as Mermaid flowchart", func() {
+			Expect(f.Mermaid()).To(Equal(`---
+title: Shoot cluster reconciliation
+---
+flowchart TD
+  t0["Deploying namespace"]
+  t1["Deploying workers"]
+  t2["Waiting for #quot;infrastructure#quot;"]:::skipped
+  t0 --> t1
+  t0 --> t2
+  t2 --> t1
+  classDef skipped stroke-dasharray: 5 5
+`))
+		})
+	})
+})
@@ -70,6 +70,7 @@ type node struct {
 	required  int
 	fn        TaskFn
 	skip      bool
+	resumable bool
 }
 
 func (n *node) String() string {
@@ -97,6 +98,11 @@ type Opts struct {
 	ErrorCleaner func(ctx context.Context, taskID string)
 	// ErrorContext is used to store any error related context.
 	ErrorContext *errorsutils.ErrorContext
+	// Journal is used to persist the successfully completed tasks, so that resumable tasks can be skipped if the flow
+	// is executed again after it was interrupted.
+	Journal Journal
+	// Tracer is notified about the timing of every executed task.
+	Tracer Tracer
 }
 
 // Run starts an execution of a Flow.
@@ -109,6 +115,7 @@ type nodeResult struct {
 	TaskID  TaskID
 	Error   error
 	skipped bool
+	resumed bool
 }
 
 // Stats are the statistics of a Flow execution.
@@ -177,6 +184,9 @@ func newExecution(flow *Flow, opts Opts) *execution {
 		opts.ProgressReporter,
 		opts.ErrorCleaner,
 		opts.ErrorContext,
+		opts.Journal,
+		NewTaskIDs(),
+		opts.Tracer,
 		make(chan *nodeResult),
 		make(map[TaskID]int),
 	}
@@ -192,6 +202,9 @@ type execution struct {
 	progressReporter ProgressReporter
 	errorCleaner     ErrorCleaner
 	errorContext     *errorsutils.ErrorContext
+	journal          Journal
+	completed        TaskIDs
+	tracer           Tracer
 
 	done          chan *nodeResult
 	triggerCounts map[TaskID]int
@@ -219,14 +232,33 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 	e.stats.Pending.Delete(id)
 	e.stats.Running.Insert(id)
 
+	if node.resumable && e.completed.Has(id) {
+		log.Info("Already completed in previous execution, skipping")
+
+		go func() {
+			e.done <- &nodeResult{TaskID: id, Error: nil, resumed: true}
+		}()
+
+		return
+	}
+
 	go func() {
+		taskCtx, attempts := contextWithAttemptsCounter(ctx)
 		start := time.Now().UTC()
 
 		log.V(1).Info("Started")
-		err := node.fn(ctx)
+		err := node.fn(taskCtx)
 		end := time.Now().UTC()
 		log.V(1).Info("Finished", "duration", end.Sub(start))
 
+		if e.tracer != nil {
+			trace := TaskTrace{TaskID: id, Start: start, Duration: end.Sub(start), Attempts: max(attempts.Load(), 1)}
+			if err != nil {
+				trace.Error = err.Error()
+			}
+			e.tracer.TaskFinished(e.flow.name, trace)
+		}
+
 		if err != nil {
 			log.Error(err, "Error")
 			err = fmt.Errorf("task %q failed: %w", id, err)
@@ -258,6 +290,42 @@ func (e *execution) processTriggers(ctx context.Context, id TaskID) {
 	}
 }
 
+func (e *execution) loadJournal(ctx context.Context) {
+	if e.journal == nil {
+		return
+	}
+
+	completed, err := e.journal.Completed(ctx, e.flow.name)
+	if err != nil {
+		e.log.Error(err, "Failed reading journal, executing all tasks")
+		return
+	}
+	if completed.Len() > 0 {
+		e.log.Info("Resuming from journal", "completedTasks", completed.StringList())
+	}
+	e.completed = completed
+}
+
+func (e *execution) recordSuccess(ctx context.Context, id TaskID) {
+	if e.journal == nil {
+		return
+	}
+
+	if err := e.journal.Record(ctx, e.flow.name, id); err != nil {
+		e.log.Error(err, "Failed recording task in journal", logKeyTask, id)
+	}
+}
+
+func (e *execution) clearJournal(ctx context.Context) {
+	if e.journal == nil {
+		return
+	}
+
+	if err := e.journal.Clear(ctx, e.flow.name); err != nil {
+		e.log.Error(err, "Failed clearing journal")
+	}
+}
+
 func (e *execution) cleanErrors(ctx context.Context, taskID TaskID) {
 	if e.errorCleaner != nil {
 		e.errorCleaner(ctx, string(taskID))
@@ -281,6 +349,7 @@ func (e *execution) run(ctx context.Context) error {
 	}
 
 	e.log.Info("Starting")
+	e.loadJournal(ctx)
 	e.reportProgress(ctx)
 
 	var (
@@ -308,6 +377,9 @@ func (e *execution) run(ctx context.Context) error {
 				e.updateFailure(result.TaskID)
 			} else {
 				e.updateSuccess(result.TaskID)
+				if !result.resumed {
+					e.recordSuccess(ctx, result.TaskID)
+				}
 				if e.errorContext != nil && e.errorContext.HasLastErrorWithID(string(result.TaskID)) {
 					e.cleanErrors(ctx, result.TaskID)
 				}
@@ -321,6 +393,9 @@ func (e *execution) run(ctx context.Context) error {
 	}
 
 	e.log.Info("Finished")
+	if cancelErr == nil && len(e.taskErrors) == 0 {
+		e.clearJournal(ctx)
+	}
 	return e.result(cancelErr)
 }
 
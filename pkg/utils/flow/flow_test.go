@@ -13,6 +13,7 @@ import (
 	. "github.com/onsi/gomega"
 	"go.uber.org/goleak"
 	"go.uber.org/mock/gomock"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
 	"github.com/gardener/gardener/pkg/utils/flow"
@@ -169,6 +170,87 @@ var _ = Describe("Flow", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(flow.WasCanceled(err)).To(BeTrue())
 		})
+
+		Context("with journal", func() {
+			var (
+				journal *flow.ConfigMapJournal
+				list    *AtomicStringList
+				failY   bool
+
+				newFlow = func() *flow.Flow {
+					var (
+						g = flow.NewGraph("foo")
+						x = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error {
+							list.Append("x")
+							return nil
+						}, Resumable: true})
+						y = g.Add(flow.Task{Name: "y", Fn: func(_ context.Context) error {
+							list.Append("y")
+							if failY {
+								return errors.New("err")
+							}
+							return nil
+						}, Dependencies: flow.NewTaskIDs(x)})
+						_ = g.Add(flow.Task{Name: "z", Fn: func(_ context.Context) error {
+							list.Append("z")
+							return nil
+						}, Resumable: true, Dependencies: flow.NewTaskIDs(y)})
+					)
+					return g.Compile()
+				}
+			)
+
+			BeforeEach(func() {
+				journal = &flow.ConfigMapJournal{
+					Client:     fakeclient.NewClientBuilder().Build(),
+					Namespace:  "default",
+					Name:       "journal",
+					Generation: 1,
+				}
+				list = NewAtomicStringList()
+				failY = false
+			})
+
+			It("should skip resumable tasks which completed in a previous execution", func() {
+				failY = true
+				Expect(newFlow().Run(ctx, flow.Opts{Journal: journal})).NotTo(Succeed())
+				Expect(list.Values()).To(Equal([]string{"x", "y"}))
+				Expect(journal.Completed(ctx, "foo")).To(Equal(flow.NewTaskIDs(flow.TaskID("x"))))
+
+				failY = false
+				list = NewAtomicStringList()
+				Expect(newFlow().Run(ctx, flow.Opts{Journal: journal})).To(Succeed())
+				Expect(list.Values()).To(Equal([]string{"y", "z"}))
+				Expect(journal.Completed(ctx, "foo")).To(BeEmpty())
+			})
+
+			It("should report resumed tasks as succeeded", func() {
+				Expect(journal.Record(ctx, "foo", "x")).To(Succeed())
+
+				var lastStats *flow.Stats
+				Expect(newFlow().Run(ctx, flow.Opts{Journal: journal, ProgressReporter: flow.NewImmediateProgressReporter(func(_ context.Context, stats *flow.Stats) {
+					lastStats = stats
+				})})).To(Succeed())
+
+				Expect(list.Values()).To(Equal([]string{"y", "z"}))
+				Expect(lastStats.Succeeded).To(Equal(flow.NewTaskIDs(flow.TaskID("x"), flow.TaskID("y"), flow.TaskID("z"))))
+			})
+
+			It("should not skip non-resumable tasks", func() {
+				Expect(journal.Record(ctx, "foo", "y")).To(Succeed())
+
+				Expect(newFlow().Run(ctx, flow.Opts{Journal: journal})).To(Succeed())
+				Expect(list.Values()).To(Equal([]string{"x", "y", "z"}))
+			})
+
+			It("should ignore records of another generation", func() {
+				Expect(journal.Record(ctx, "foo", "x")).To(Succeed())
+				journal.Generation = 2
+
+				Expect(newFlow().Run(ctx, flow.Opts{Journal: journal})).To(Succeed())
+				Expect(list.Values()).To(Equal([]string{"x", "y", "z"}))
+			})
+		})
 	})
 
 	Describe("#Sequential", func() {
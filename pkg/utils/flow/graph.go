@@ -11,9 +11,13 @@ import (
 // Task is a unit of work. It has a name, a payload function and a set of dependencies.
 // A is only started once all its dependencies have been completed successfully.
 type Task struct {
-	Name         string
-	Fn           TaskFn
-	SkipIf       bool
+	Name   string
+	Fn     TaskFn
+	SkipIf bool
+	// Resumable marks the Task as safe to be skipped if a Journal recorded that it already completed successfully in a
+	// previous execution of the flow. This must only be set for tasks whose effects are persisted, i.e., which do not
+	// populate any in-memory state required by subsequent tasks.
+	Resumable    bool
 	Dependencies TaskIDs
 }
 
@@ -22,6 +26,7 @@ func (t *Task) Spec() *TaskSpec {
 	return &TaskSpec{
 		t.Fn,
 		t.SkipIf,
+		t.Resumable,
 		t.Dependencies.Copy(),
 	}
 }
@@ -31,6 +36,7 @@ func (t *Task) Spec() *TaskSpec {
 type TaskSpec struct {
 	Fn           TaskFn
 	Skip         bool
+	Resumable    bool
 	Dependencies TaskIDs
 }
 
@@ -86,6 +92,7 @@ func (g *Graph) Compile() *Flow {
 		node := nodes.getOrCreate(taskName)
 		node.fn = taskSpec.Fn
 		node.skip = taskSpec.Skip
+		node.resumable = taskSpec.Resumable
 		node.required = taskSpec.Dependencies.Len()
 	}
 
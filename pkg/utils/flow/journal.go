@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Journal persists the IDs of the tasks which completed successfully during a flow execution, so that a subsequent
+// execution of the same flow can resume where a previous, interrupted one stopped.
+type Journal interface {
+	// Completed returns the IDs of the tasks which completed successfully in previous executions of the given flow.
+	Completed(ctx context.Context, flowName string) (TaskIDs, error)
+	// Record records that the task with the given ID completed successfully in the given flow.
+	Record(ctx context.Context, flowName string, id TaskID) error
+	// Clear removes all records of the given flow. It is called once an execution of the flow succeeded.
+	Clear(ctx context.Context, flowName string) error
+}
+
+// ConfigMapJournal is a Journal which stores the completed tasks in a ConfigMap. Every flow is stored in a separate
+// key. Records of another generation (e.g., of the object reconciled by the flow) or revision (e.g., of the component
+// executing the flow) are ignored, so that changes of the desired state always lead to a complete execution of the flow.
+type ConfigMapJournal struct {
+	// Client is used to read and write the ConfigMap.
+	Client client.Client
+	// Namespace is the namespace of the ConfigMap.
+	Namespace string
+	// Name is the name of the ConfigMap.
+	Name string
+	// Generation is the generation the records belong to.
+	Generation int64
+	// Revision is the revision the records belong to.
+	Revision string
+}
+
+var _ Journal = &ConfigMapJournal{}
+
+type journalEntry struct {
+	Generation int64    `json:"generation"`
+	Revision   string   `json:"revision,omitempty"`
+	Tasks      []TaskID `json:"tasks"`
+}
+
+var invalidConfigMapKeyChars = regexp.MustCompile(`[^-._a-zA-Z0-9]+`)
+
+// journalKey returns a valid ConfigMap key for the given flow name.
+func journalKey(flowName string) string {
+	return invalidConfigMapKeyChars.ReplaceAllString(flowName, "-")
+}
+
+// Completed returns the IDs of the tasks which completed successfully in previous executions of the given flow with
+// the same generation.
+func (j *ConfigMapJournal) Completed(ctx context.Context, flowName string) (TaskIDs, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := j.Client.Get(ctx, client.ObjectKey{Namespace: j.Namespace, Name: j.Name}, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return NewTaskIDs(), nil
+		}
+		return nil, fmt.Errorf("failed reading journal: %w", err)
+	}
+
+	entry, err := j.readEntry(configMap, flowName)
+	if err != nil {
+		return nil, err
+	}
+	return NewTaskIDs(TaskIDSlice(entry.Tasks)), nil
+}
+
+// Record records that the task with the given ID completed successfully in the given flow.
+func (j *ConfigMapJournal) Record(ctx context.Context, flowName string, id TaskID) error {
+	configMap := &corev1.ConfigMap{}
+	if err := j.Client.Get(ctx, client.ObjectKey{Namespace: j.Namespace, Name: j.Name}, configMap); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed reading journal: %w", err)
+		}
+
+		configMap.Namespace, configMap.Name = j.Namespace, j.Name
+		if err := j.writeEntry(configMap, flowName, journalEntry{Generation: j.Generation, Revision: j.Revision, Tasks: []TaskID{id}}); err != nil {
+			return err
+		}
+		if err := j.Client.Create(ctx, configMap); err != nil {
+			return fmt.Errorf("failed creating journal: %w", err)
+		}
+		return nil
+	}
+
+	patch := client.MergeFrom(configMap.DeepCopy())
+
+	entry, err := j.readEntry(configMap, flowName)
+	if err != nil {
+		return err
+	}
+	tasks := NewTaskIDs(TaskIDSlice(entry.Tasks)).Insert(id).List()
+
+	if err := j.writeEntry(configMap, flowName, journalEntry{Generation: j.Generation, Revision: j.Revision, Tasks: tasks}); err != nil {
+		return err
+	}
+	if err := j.Client.Patch(ctx, configMap, patch); err != nil {
+		return fmt.Errorf("failed updating journal: %w", err)
+	}
+	return nil
+}
+
+// Clear removes all records of the given flow.
+func (j *ConfigMapJournal) Clear(ctx context.Context, flowName string) error {
+	configMap := &corev1.ConfigMap{}
+	if err := j.Client.Get(ctx, client.ObjectKey{Namespace: j.Namespace, Name: j.Name}, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed reading journal: %w", err)
+	}
+
+	if _, ok := configMap.Data[journalKey(flowName)]; !ok {
+		return nil
+	}
+
+	patch := client.MergeFrom(configMap.DeepCopy())
+	delete(configMap.Data, journalKey(flowName))
+	if err := j.Client.Patch(ctx, configMap, patch); err != nil {
+		return fmt.Errorf("failed clearing journal: %w", err)
+	}
+	return nil
+}
+
+func (j *ConfigMapJournal) readEntry(configMap *corev1.ConfigMap, flowName string) (journalEntry, error) {
+	entry := journalEntry{}
+
+	data, ok := configMap.Data[journalKey(flowName)]
+	if !ok {
+		return entry, nil
+	}
+	if err := json.Unmarshal([]byte(data), &entry); err != nil {
+		return entry, fmt.Errorf("failed decoding journal of flow %q: %w", flowName, err)
+	}
+	if entry.Generation != j.Generation || entry.Revision != j.Revision {
+		return journalEntry{}, nil
+	}
+	return entry, nil
+}
+
+func (j *ConfigMapJournal) writeEntry(configMap *corev1.ConfigMap, flowName string, entry journalEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed encoding journal of flow %q: %w", flowName, err)
+	}
+	if configMap.Data == nil {
+		configMap.Data = make(map[string]string, 1)
+	}
+	configMap.Data[journalKey(flowName)] = string(data)
+	return nil
+}
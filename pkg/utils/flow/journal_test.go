@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("ConfigMapJournal", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		journal    *ConfigMapJournal
+		configMap  *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().Build()
+		journal = &ConfigMapJournal{
+			Client:     fakeClient,
+			Namespace:  "shoot--foo--bar",
+			Name:       "flow-journal",
+			Generation: 3,
+		}
+		configMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: journal.Namespace, Name: journal.Name}}
+	})
+
+	Describe("#Completed", func() {
+		It("should return an empty set if the ConfigMap does not exist", func() {
+			Expect(journal.Completed(ctx, "Shoot cluster reconciliation")).To(BeEmpty())
+		})
+
+		It("should fail if the journal cannot be decoded", func() {
+			configMap.Data = map[string]string{"foo": "{"}
+			Expect(fakeClient.Create(ctx, configMap)).To(Succeed())
+
+			_, err := journal.Completed(ctx, "foo")
+			Expect(err).To(MatchError(ContainSubstring(`failed decoding journal of flow "foo"`)))
+		})
+	})
+
+	Describe("#Record", func() {
+		It("should create the ConfigMap and record the tasks per flow", func() {
+			Expect(journal.Record(ctx, "Shoot cluster reconciliation", "b")).To(Succeed())
+			Expect(journal.Record(ctx, "Shoot cluster reconciliation", "a")).To(Succeed())
+			Expect(journal.Record(ctx, "other", "c")).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.Data).To(Equal(map[string]string{
+				"Shoot-cluster-reconciliation": `{"generation":3,"tasks":["a","b"]}`,
+				"other":                        `{"generation":3,"tasks":["c"]}`,
+			}))
+
+			Expect(journal.Completed(ctx, "Shoot cluster reconciliation")).To(Equal(NewTaskIDs(TaskID("a"), TaskID("b"))))
+		})
+
+		It("should drop the records of other generations", func() {
+			Expect(journal.Record(ctx, "foo", "a")).To(Succeed())
+
+			journal.Generation = 4
+			Expect(journal.Completed(ctx, "foo")).To(BeEmpty())
+			Expect(journal.Record(ctx, "foo", "b")).To(Succeed())
+			Expect(journal.Completed(ctx, "foo")).To(Equal(NewTaskIDs(TaskID("b"))))
+		})
+
+		It("should drop the records of other revisions", func() {
+			Expect(journal.Record(ctx, "foo", "a")).To(Succeed())
+
+			journal.Revision = "v1.2.3"
+			Expect(journal.Completed(ctx, "foo")).To(BeEmpty())
+			Expect(journal.Record(ctx, "foo", "b")).To(Succeed())
+			Expect(journal.Completed(ctx, "foo")).To(Equal(NewTaskIDs(TaskID("b"))))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKeyWithValue("foo", `{"generation":3,"revision":"v1.2.3","tasks":["b"]}`))
+		})
+	})
+
+	Describe("#Clear", func() {
+		It("should do nothing if the ConfigMap does not exist", func() {
+			Expect(journal.Clear(ctx, "foo")).To(Succeed())
+		})
+
+		It("should only remove the records of the given flow", func() {
+			Expect(journal.Record(ctx, "foo", "a")).To(Succeed())
+			Expect(journal.Record(ctx, "bar", "b")).To(Succeed())
+
+			Expect(journal.Clear(ctx, "foo")).To(Succeed())
+
+			Expect(journal.Completed(ctx, "foo")).To(BeEmpty())
+			Expect(journal.Completed(ctx, "bar")).To(Equal(NewTaskIDs(TaskID("b"))))
+		})
+	})
+
+	Describe("#Run", func() {
+		It("should resume an interrupted flow after a restart", func() {
+			var executions []string
+
+			newFlow := func(interrupt context.CancelFunc) *Flow {
+				g := NewGraph("Shoot cluster reconciliation")
+				a := g.Add(Task{Name: "a", Resumable: true, Fn: func(context.Context) error {
+					executions = append(executions, "a")
+					return nil
+				}})
+				b := g.Add(Task{Name: "b", Fn: func(context.Context) error {
+					executions = append(executions, "b")
+					return nil
+				}, Dependencies: NewTaskIDs(a)})
+				c := g.Add(Task{Name: "c", Resumable: true, Fn: func(context.Context) error {
+					executions = append(executions, "c")
+					if interrupt != nil {
+						interrupt()
+					}
+					return nil
+				}, Dependencies: NewTaskIDs(b)})
+				g.Add(Task{Name: "d", Resumable: true, Fn: func(context.Context) error {
+					executions = append(executions, "d")
+					return nil
+				}, Dependencies: NewTaskIDs(c)})
+				return g.Compile()
+			}
+
+			By("Interrupt first execution")
+			interruptedCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			Expect(newFlow(cancel).Run(interruptedCtx, Opts{Journal: journal})).To(HaveOccurred())
+			Expect(executions).To(Equal([]string{"a", "b", "c"}))
+
+			By("Resume with new journal instance after restart")
+			executions = nil
+			restartedJournal := &ConfigMapJournal{Client: fakeClient, Namespace: journal.Namespace, Name: journal.Name, Generation: journal.Generation}
+			Expect(restartedJournal.Completed(ctx, "Shoot cluster reconciliation")).To(Equal(NewTaskIDs(TaskID("a"), TaskID("b"), TaskID("c"))))
+			Expect(newFlow(nil).Run(ctx, Opts{Journal: restartedJournal})).To(Succeed())
+			Expect(executions).To(Equal([]string{"b", "d"}))
+
+			By("Execute all tasks after successful execution")
+			executions = nil
+			Expect(restartedJournal.Completed(ctx, "Shoot cluster reconciliation")).To(BeEmpty())
+			Expect(newFlow(nil).Run(ctx, Opts{Journal: restartedJournal})).To(Succeed())
+			Expect(executions).To(Equal([]string{"a", "b", "c", "d"}))
+		})
+	})
+})
@@ -43,6 +43,7 @@ func (t TaskFn) RetryUntilTimeout(interval, timeout time.Duration) TaskFn {
 		defer cancel()
 
 		return retry.Until(ctx, interval, func(ctx context.Context) (done bool, err error) {
+			recordAttempt(ctx)
 			if err := t(ctx); err != nil {
 				return retry.MinorError(err)
 			}
@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+// TaskTrace contains the timing information of a single task execution.
+type TaskTrace struct {
+	// TaskID is the ID of the executed task.
+	TaskID TaskID `json:"task"`
+	// Start is the time the task was started.
+	Start time.Time `json:"start"`
+	// Duration is the time the task took to finish.
+	Duration time.Duration `json:"duration"`
+	// Attempts is the number of attempts of tasks retried with RetryUntilTimeout. It is 1 for all other tasks.
+	Attempts int32 `json:"attempts"`
+	// Error is the error returned by the task, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// End returns the time the task finished.
+func (t TaskTrace) End() time.Time {
+	return t.Start.Add(t.Duration)
+}
+
+func (t TaskTrace) String() string {
+	return fmt.Sprintf("%s (%s)", t.TaskID, t.Duration.Round(time.Millisecond))
+}
+
+// Tracer is notified about every finished task of a flow execution. Implementations must be safe for concurrent use
+// since tasks are executed in parallel.
+type Tracer interface {
+	// TaskFinished is called after a task of the given flow finished.
+	TaskFinished(flowName string, trace TaskTrace)
+}
+
+// Tracers is a Tracer which notifies all contained Tracers.
+type Tracers []Tracer
+
+var _ Tracer = Tracers{}
+
+// TaskFinished notifies all contained Tracers about the finished task.
+func (t Tracers) TaskFinished(flowName string, trace TaskTrace) {
+	for _, tracer := range t {
+		tracer.TaskFinished(flowName, trace)
+	}
+}
+
+// LogTracer is a Tracer which logs the trace of every finished task as structured log message.
+type LogTracer struct {
+	log logr.Logger
+}
+
+var _ Tracer = &LogTracer{}
+
+// NewLogTracer returns a new LogTracer which logs to the given logger.
+func NewLogTracer(log logr.Logger) *LogTracer {
+	return &LogTracer{log: log}
+}
+
+// TaskFinished logs the trace of the given task.
+func (l *LogTracer) TaskFinished(flowName string, trace TaskTrace) {
+	keysAndValues := []any{"flow", flowName, "task", trace.TaskID, "start", trace.Start, "duration", trace.Duration, "attempts", trace.Attempts}
+	if trace.Error != "" {
+		keysAndValues = append(keysAndValues, "error", trace.Error)
+	}
+	l.log.Info("Task trace", keysAndValues...)
+}
+
+// TraceRecorder is a Tracer which records the traces of all tasks in memory.
+type TraceRecorder struct {
+	lock   sync.Mutex
+	traces []TaskTrace
+}
+
+var _ Tracer = &TraceRecorder{}
+
+// NewTraceRecorder returns a new TraceRecorder.
+func NewTraceRecorder() *TraceRecorder {
+	return &TraceRecorder{}
+}
+
+// TaskFinished records the trace of the given task.
+func (r *TraceRecorder) TaskFinished(_ string, trace TaskTrace) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.traces = append(r.traces, trace)
+}
+
+// Traces returns all recorded traces ordered by their start time.
+func (r *TraceRecorder) Traces() []TaskTrace {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	out := make([]TaskTrace, len(r.traces))
+	copy(out, r.traces)
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Start.Before(out[j].Start)
+	})
+	return out
+}
+
+// CriticalPath returns the chain of tasks which determined the overall duration of a flow execution based on the
+// given traces. Starting with the task which finished last, the dependency which finished last is followed until a
+// task without traced dependencies is reached. The first element of the result is the task which was started first.
+func (f *Flow) CriticalPath(traces []TaskTrace) []TaskTrace {
+	if len(traces) == 0 {
+		return nil
+	}
+
+	idToTrace := make(map[TaskID]TaskTrace, len(traces))
+	for _, trace := range traces {
+		idToTrace[trace.TaskID] = trace
+	}
+
+	dependencies := make(map[TaskID]TaskIDs, len(f.nodes))
+	for id, node := range f.nodes {
+		for target := range node.targetIDs {
+			if dependencies[target] == nil {
+				dependencies[target] = NewTaskIDs()
+			}
+			dependencies[target].Insert(id)
+		}
+	}
+
+	last := traces[0]
+	for _, trace := range traces[1:] {
+		if trace.End().After(last.End()) {
+			last = trace
+		}
+	}
+
+	path := []TaskTrace{last}
+	for {
+		var (
+			next  TaskTrace
+			found bool
+		)
+		for _, id := range dependencies[path[0].TaskID].List() {
+			trace, ok := idToTrace[id]
+			if ok && (!found || trace.End().After(next.End())) {
+				next, found = trace, true
+			}
+		}
+		if !found {
+			return path
+		}
+		path = append([]TaskTrace{next}, path...)
+	}
+}
+
+type attemptsKey struct{}
+
+// contextWithAttemptsCounter returns a context which counts the attempts of tasks retried with RetryUntilTimeout.
+func contextWithAttemptsCounter(ctx context.Context) (context.Context, *atomic.Int32) {
+	counter := &atomic.Int32{}
+	return context.WithValue(ctx, attemptsKey{}, counter), counter
+}
+
+// recordAttempt increments the attempts counter of the given context, if any.
+func recordAttempt(ctx context.Context) {
+	if counter, ok := ctx.Value(attemptsKey{}).(*atomic.Int32); ok {
+		counter.Add(1)
+	}
+}
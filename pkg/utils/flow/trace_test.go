@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("Trace", func() {
+	var (
+		ctx = context.Background()
+
+		noop = func(_ context.Context) error { return nil }
+	)
+
+	Describe("#TraceRecorder", func() {
+		It("should record a trace for every executed task", func() {
+			var (
+				attempts int
+				recorder = flow.NewTraceRecorder()
+
+				g = flow.NewGraph("foo")
+				x = g.Add(flow.Task{Name: "x", Fn: noop})
+				_ = g.Add(flow.Task{Name: "y", Fn: flow.TaskFn(func(_ context.Context) error {
+					attempts++
+					if attempts < 3 {
+						return errors.New("not yet")
+					}
+					return nil
+				}).RetryUntilTimeout(time.Millisecond, time.Second), Dependencies: flow.NewTaskIDs(x)})
+				_ = g.Add(flow.Task{Name: "z", Fn: func(_ context.Context) error { return errors.New("err") }, Dependencies: flow.NewTaskIDs(x)})
+				_ = g.Add(flow.Task{Name: "skipped", Fn: noop, SkipIf: true})
+			)
+
+			Expect(g.Compile().Run(ctx, flow.Opts{Tracer: recorder})).NotTo(Succeed())
+
+			traces := recorder.Traces()
+			Expect(traces).To(HaveLen(3))
+			Expect(traces[0].TaskID).To(Equal(flow.TaskID("x")))
+			Expect(traces).To(ContainElements(
+				And(HaveField("TaskID", flow.TaskID("y")), HaveField("Attempts", int32(3)), HaveField("Error", BeEmpty())),
+				And(HaveField("TaskID", flow.TaskID("z")), HaveField("Attempts", int32(1)), HaveField("Error", ContainSubstring("err"))),
+			))
+		})
+	})
+
+	Describe("#LogTracer", func() {
+		It("should log a trace for every executed task including the retry attempts", func() {
+			var (
+				attempts  int
+				logBuffer = gbytes.NewBuffer()
+				recorder  = flow.NewTraceRecorder()
+				tracer    = flow.Tracers{recorder, flow.NewLogTracer(logger.MustNewZapLogger(logger.DebugLevel, logger.FormatJSON, logzap.WriteTo(logBuffer)))}
+
+				g = flow.NewGraph("foo")
+				_ = g.Add(flow.Task{Name: "x", Fn: flow.TaskFn(func(_ context.Context) error {
+					attempts++
+					if attempts < 2 {
+						return errors.New("not yet")
+					}
+					return nil
+				}).RetryUntilTimeout(time.Millisecond, time.Second)})
+			)
+
+			Expect(g.Compile().Run(ctx, flow.Opts{Tracer: tracer})).To(Succeed())
+
+			Expect(recorder.Traces()).To(HaveLen(1))
+			Eventually(logBuffer).Should(gbytes.Say(`"msg":"Task trace","flow":"foo","task":"x","start":".+","duration":".+","attempts":2}`))
+		})
+	})
+
+	Describe("#CriticalPath", func() {
+		It("should follow the dependencies which finished last", func() {
+			var (
+				g = flow.NewGraph("foo")
+				a = g.Add(flow.Task{Name: "a"})
+				b = g.Add(flow.Task{Name: "b", Dependencies: flow.NewTaskIDs(a)})
+				c = g.Add(flow.Task{Name: "c", Dependencies: flow.NewTaskIDs(a)})
+				_ = g.Add(flow.Task{Name: "d", Dependencies: flow.NewTaskIDs(b, c)})
+				f = g.Compile()
+
+				start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+				trace = func(id string, startOffset, duration time.Duration) flow.TaskTrace {
+					return flow.TaskTrace{TaskID: flow.TaskID(id), Start: start.Add(startOffset), Duration: duration, Attempts: 1}
+				}
+				traces = []flow.TaskTrace{
+					trace("a", 0, time.Second),
+					trace("b", time.Second, time.Second),
+					trace("c", time.Second, 5*time.Second),
+					trace("d", 6*time.Second, time.Second),
+				}
+			)
+
+			path := f.CriticalPath(traces)
+			Expect(path).To(Equal([]flow.TaskTrace{traces[0], traces[2], traces[3]}))
+			Expect(path[1].String()).To(Equal("c (5s)"))
+		})
+
+		It("should return nothing if there are no traces", func() {
+			Expect(flow.NewGraph("foo").Compile().CriticalPath(nil)).To(BeEmpty())
+		})
+	})
+})
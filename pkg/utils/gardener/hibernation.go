@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+)
+
+// HibernationExcludedDateFormat is the format of the dates in the
+// `shoot.gardener.cloud/hibernation-excluded-dates` annotation.
+const HibernationExcludedDateFormat = time.DateOnly
+
+// GetShootHibernationKeepAwakeUntil returns the time until which the hibernation schedules must not hibernate the
+// Shoot with the given annotations. It returns nil if the annotation is not set.
+func GetShootHibernationKeepAwakeUntil(annotations map[string]string) (*time.Time, error) {
+	return parseTimeAnnotation(annotations, v1beta1constants.AnnotationShootHibernationKeepAwakeUntil)
+}
+
+// GetShootHibernationWakeUpRequestedAt returns the time of the last wake-up request for the Shoot with the given
+// annotations. It returns nil if the annotation is not set.
+func GetShootHibernationWakeUpRequestedAt(annotations map[string]string) (*time.Time, error) {
+	return parseTimeAnnotation(annotations, v1beta1constants.AnnotationShootHibernationWakeUpRequestedAt)
+}
+
+// GetShootHibernationWakeUpIdleTimeout returns the idle timeout after which a Shoot with the given annotations which
+// was woken up on demand is hibernated again. It returns nil if the annotation is not set, i.e., if waking up the Shoot
+// on demand is not enabled.
+func GetShootHibernationWakeUpIdleTimeout(annotations map[string]string) (*time.Duration, error) {
+	return parsePositiveDurationAnnotation(annotations, v1beta1constants.AnnotationShootHibernationWakeUpIdleTimeout)
+}
+
+// GetShootHibernationExcludedDates returns the set of dates on which the hibernation schedules of the Shoot with the
+// given annotations are not executed.
+func GetShootHibernationExcludedDates(annotations map[string]string) (sets.Set[string], error) {
+	excludedDates := sets.New[string]()
+
+	value, ok := annotations[v1beta1constants.AnnotationShootHibernationExcludedDates]
+	if !ok {
+		return excludedDates, nil
+	}
+
+	for _, date := range strings.Split(value, ",") {
+		date = strings.TrimSpace(date)
+		if _, err := time.Parse(HibernationExcludedDateFormat, date); err != nil {
+			return nil, fmt.Errorf("invalid value for annotation %s: %w", v1beta1constants.AnnotationShootHibernationExcludedDates, err)
+		}
+		excludedDates.Insert(date)
+	}
+
+	return excludedDates, nil
+}
+
+// GetShootLastUserActivity returns the time of the last observed user activity for the Shoot with the given
+// annotations. It returns nil if the annotation is not set.
+func GetShootLastUserActivity(annotations map[string]string) (*time.Time, error) {
+	return parseTimeAnnotation(annotations, v1beta1constants.AnnotationShootLastUserActivity)
+}
+
+func parsePositiveDurationAnnotation(annotations map[string]string, key string) (*time.Duration, error) {
+	value, ok := annotations[key]
+	if !ok {
+		return nil, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for annotation %s: %w", key, err)
+	}
+	if duration <= 0 {
+		return nil, fmt.Errorf("invalid value for annotation %s: duration must be positive", key)
+	}
+
+	return &duration, nil
+}
+
+func parseTimeAnnotation(annotations map[string]string, key string) (*time.Time, error) {
+	value, ok := annotations[key]
+	if !ok {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for annotation %s: %w", key, err)
+	}
+
+	return &t, nil
+}
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	. "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+var _ = Describe("Hibernation", func() {
+	Describe("#GetShootHibernationKeepAwakeUntil", func() {
+		It("should return nil if the annotation is not set", func() {
+			Expect(GetShootHibernationKeepAwakeUntil(nil)).To(BeNil())
+		})
+
+		It("should return the parsed time", func() {
+			until, err := GetShootHibernationKeepAwakeUntil(map[string]string{"shoot.gardener.cloud/hibernation-keep-awake-until": "2024-04-12T18:00:00+02:00"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(until.Equal(time.Date(2024, 4, 12, 16, 0, 0, 0, time.UTC))).To(BeTrue())
+		})
+
+		It("should fail for invalid values", func() {
+			_, err := GetShootHibernationKeepAwakeUntil(map[string]string{"shoot.gardener.cloud/hibernation-keep-awake-until": "friday"})
+			Expect(err).To(MatchError(ContainSubstring("invalid value for annotation shoot.gardener.cloud/hibernation-keep-awake-until")))
+		})
+	})
+
+	Describe("#GetShootHibernationWakeUpRequestedAt", func() {
+		It("should return the parsed time", func() {
+			requestedAt, err := GetShootHibernationWakeUpRequestedAt(map[string]string{"shoot.gardener.cloud/hibernation-wake-up-requested-at": "2024-04-12T10:00:00Z"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requestedAt.Equal(time.Date(2024, 4, 12, 10, 0, 0, 0, time.UTC))).To(BeTrue())
+		})
+	})
+
+	Describe("#GetShootLastUserActivity", func() {
+		It("should return the parsed time", func() {
+			lastActivity, err := GetShootLastUserActivity(map[string]string{"shoot.gardener.cloud/last-user-activity": "2024-04-12T10:00:00Z"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lastActivity.Equal(time.Date(2024, 4, 12, 10, 0, 0, 0, time.UTC))).To(BeTrue())
+		})
+	})
+
+	Describe("#GetShootHibernationWakeUpIdleTimeout", func() {
+		It("should return nil if the annotation is not set", func() {
+			Expect(GetShootHibernationWakeUpIdleTimeout(nil)).To(BeNil())
+		})
+
+		It("should return the parsed duration", func() {
+			Expect(GetShootHibernationWakeUpIdleTimeout(map[string]string{"shoot.gardener.cloud/hibernation-wake-up-idle-timeout": "2h"})).To(HaveValue(Equal(2 * time.Hour)))
+		})
+
+		It("should fail for invalid values", func() {
+			_, err := GetShootHibernationWakeUpIdleTimeout(map[string]string{"shoot.gardener.cloud/hibernation-wake-up-idle-timeout": "foo"})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail for non-positive durations", func() {
+			_, err := GetShootHibernationWakeUpIdleTimeout(map[string]string{"shoot.gardener.cloud/hibernation-wake-up-idle-timeout": "0s"})
+			Expect(err).To(MatchError(ContainSubstring("duration must be positive")))
+		})
+	})
+
+	Describe("#GetShootHibernationExcludedDates", func() {
+		It("should return an empty set if the annotation is not set", func() {
+			Expect(GetShootHibernationExcludedDates(nil)).To(BeEmpty())
+		})
+
+		It("should return the parsed dates", func() {
+			Expect(GetShootHibernationExcludedDates(map[string]string{"shoot.gardener.cloud/hibernation-excluded-dates": "2024-12-24, 2024-12-25,2024-12-26"})).To(Equal(sets.New("2024-12-24", "2024-12-25", "2024-12-26")))
+		})
+
+		It("should fail for invalid dates", func() {
+			_, err := GetShootHibernationExcludedDates(map[string]string{"shoot.gardener.cloud/hibernation-excluded-dates": "2024-12-24,24.12.2024"})
+			Expect(err).To(MatchError(ContainSubstring("invalid value for annotation shoot.gardener.cloud/hibernation-excluded-dates")))
+		})
+	})
+})
@@ -6,6 +6,8 @@ package gardener
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -55,3 +57,18 @@ func ProjectAndNamespaceFromReader(ctx context.Context, reader client.Reader, na
 
 	return project, namespace, nil
 }
+
+// GetProjectStaleSnoozeUntil returns the time until which the Project with the given annotations must not be considered
+// stale. It returns nil if the annotation is not set.
+func GetProjectStaleSnoozeUntil(annotations map[string]string) (*time.Time, error) {
+	value, ok := annotations[v1beta1constants.ProjectStaleSnoozeUntil]
+	if !ok {
+		return nil, nil
+	}
+
+	snoozeUntil, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for annotation %s: %w", v1beta1constants.ProjectStaleSnoozeUntil, err)
+	}
+	return &snoozeUntil, nil
+}
@@ -7,6 +7,7 @@ package gardener_test
 import (
 	"context"
 	"errors"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -142,4 +143,19 @@ var _ = Describe("Project", func() {
 			Expect(projectResult).To(Equal(project))
 		})
 	})
+
+	Describe("#GetProjectStaleSnoozeUntil", func() {
+		It("should return nil if the annotation is not set", func() {
+			Expect(GetProjectStaleSnoozeUntil(nil)).To(BeNil())
+		})
+
+		It("should return the parsed timestamp", func() {
+			Expect(GetProjectStaleSnoozeUntil(map[string]string{"project.gardener.cloud/stale-snooze-until": "2024-12-31T12:00:00Z"})).To(HaveValue(Equal(time.Date(2024, 12, 31, 12, 0, 0, 0, time.UTC))))
+		})
+
+		It("should fail for invalid values", func() {
+			_, err := GetProjectStaleSnoozeUntil(map[string]string{"project.gardener.cloud/stale-snooze-until": "tomorrow"})
+			Expect(err).To(MatchError(ContainSubstring("invalid value for annotation project.gardener.cloud/stale-snooze-until")))
+		})
+	})
 })
@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+)
+
+// QuotaMetricNames contains the names of all metrics which can be constrained by a Quota.
+var QuotaMetricNames = []corev1.ResourceName{
+	core.QuotaMetricCPU,
+	core.QuotaMetricGPU,
+	core.QuotaMetricMemory,
+	core.QuotaMetricStorageStandard,
+	core.QuotaMetricStoragePremium,
+	core.QuotaMetricLoadbalancer,
+	core.QuotaMetricShoots,
+	core.QuotaMetricNodes,
+	core.QuotaMetricMaxSurge,
+}
+
+// ExceededQuotaMetrics returns the names of the metrics whose usage is higher than the given limits. Metrics without
+// limit are not considered.
+func ExceededQuotaMetrics(limits, usage corev1.ResourceList) []corev1.ResourceName {
+	var exceededMetrics []corev1.ResourceName
+	for _, metric := range QuotaMetricNames {
+		limit, ok := limits[metric]
+		if !ok {
+			continue
+		}
+		if limit.Cmp(usage[metric]) == -1 {
+			exceededMetrics = append(exceededMetrics, metric)
+		}
+	}
+	return exceededMetrics
+}
+
+// SumQuotaResources adds up the given resource lists for all quota metrics.
+func SumQuotaResources(resourceLists ...corev1.ResourceList) corev1.ResourceList {
+	sum := make(corev1.ResourceList, len(QuotaMetricNames))
+	for _, metric := range QuotaMetricNames {
+		quantity := resource.Quantity{}
+		for _, resources := range resourceLists {
+			quantity.Add(resources[metric])
+		}
+		sum[metric] = quantity
+	}
+	return sum
+}
+
+// ComputeShootQuotaResources computes the resources the given Shoot allocates with respect to the quota metrics. For
+// the worker pools, always the maximum amount of machines is considered.
+func ComputeShootQuotaResources(shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) (corev1.ResourceList, error) {
+	var (
+		countLB   int64 = 1
+		resources       = make(corev1.ResourceList)
+	)
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		var (
+			machineType *gardencorev1beta1.MachineType
+			volumeType  *gardencorev1beta1.VolumeType
+			volume      = worker.Volume
+		)
+
+		// Get the proper machineType
+		for _, e := range cloudProfile.Spec.MachineTypes {
+			element := e
+			if element.Name == worker.Machine.Type {
+				machineType = &element
+				break
+			}
+		}
+		if machineType == nil {
+			return nil, fmt.Errorf("machineType %s not found in CloudProfile %s", worker.Machine.Type, cloudProfile.Name)
+		}
+
+		if volume == nil && machineType.Storage != nil && machineType.Storage.StorageSize != nil {
+			volume = &gardencorev1beta1.Volume{
+				Type:       &machineType.Storage.Type,
+				VolumeSize: machineType.Storage.StorageSize.String(),
+			}
+		}
+
+		if volume != nil {
+			if machineType.Storage != nil {
+				volumeType = &gardencorev1beta1.VolumeType{
+					Class: machineType.Storage.Class,
+				}
+			} else {
+				// Get the proper VolumeType
+				for _, e := range cloudProfile.Spec.VolumeTypes {
+					element := e
+					if volume.Type != nil && element.Name == *volume.Type {
+						volumeType = &element
+						break
+					}
+				}
+			}
+		}
+		if volumeType == nil {
+			return nil, fmt.Errorf("VolumeType %s not found in CloudProfile %s", worker.Machine.Type, cloudProfile.Name)
+		}
+
+		resources[core.QuotaMetricCPU] = sumQuantity(resources[core.QuotaMetricCPU], multiplyQuantity(machineType.CPU, worker.Maximum))
+		resources[core.QuotaMetricGPU] = sumQuantity(resources[core.QuotaMetricGPU], multiplyQuantity(machineType.GPU, worker.Maximum))
+		resources[core.QuotaMetricMemory] = sumQuantity(resources[core.QuotaMetricMemory], multiplyQuantity(machineType.Memory, worker.Maximum))
+
+		size, _ := resource.ParseQuantity("0Gi")
+		if volume != nil {
+			var err error
+			size, err = resource.ParseQuantity(volume.VolumeSize)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		switch volumeType.Class {
+		case gardencorev1beta1.VolumeClassStandard:
+			resources[core.QuotaMetricStorageStandard] = sumQuantity(resources[core.QuotaMetricStorageStandard], multiplyQuantity(size, worker.Maximum))
+		case gardencorev1beta1.VolumeClassPremium:
+			resources[core.QuotaMetricStoragePremium] = sumQuantity(resources[core.QuotaMetricStoragePremium], multiplyQuantity(size, worker.Maximum))
+		default:
+			return nil, fmt.Errorf("unknown volumeType class %s", volumeType.Class)
+		}
+
+		maxSurge := gardencorev1beta1.DefaultWorkerMaxSurge
+		if worker.MaxSurge != nil {
+			maxSurge = *worker.MaxSurge
+		}
+		surge, err := intstr.GetScaledValueFromIntOrPercent(&maxSurge, int(worker.Maximum), true)
+		if err != nil {
+			return nil, fmt.Errorf("invalid maxSurge of worker pool %s: %w", worker.Name, err)
+		}
+
+		resources[core.QuotaMetricNodes] = sumQuantity(resources[core.QuotaMetricNodes], *resource.NewQuantity(int64(worker.Maximum), resource.DecimalSI))
+		resources[core.QuotaMetricMaxSurge] = sumQuantity(resources[core.QuotaMetricMaxSurge], *resource.NewQuantity(int64(surge), resource.DecimalSI))
+	}
+
+	if v1beta1helper.NginxIngressEnabled(shoot.Spec.Addons) {
+		countLB++
+	}
+	resources[core.QuotaMetricLoadbalancer] = *resource.NewQuantity(countLB, resource.DecimalSI)
+	resources[core.QuotaMetricShoots] = *resource.NewQuantity(1, resource.DecimalSI)
+
+	return resources, nil
+}
+
+func sumQuantity(values ...resource.Quantity) resource.Quantity {
+	res := resource.Quantity{}
+	for _, v := range values {
+		res.Add(v)
+	}
+	return res
+}
+
+func multiplyQuantity(quantity resource.Quantity, multiplier int32) resource.Quantity {
+	res := resource.Quantity{}
+	for i := 0; i < int(multiplier); i++ {
+		res.Add(quantity)
+	}
+	return res
+}
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+var _ = Describe("Quota", func() {
+	Describe("#ExceededQuotaMetrics", func() {
+		It("should return the metrics whose usage is higher than the limit", func() {
+			Expect(ExceededQuotaMetrics(
+				corev1.ResourceList{"cpu": resource.MustParse("10"), "memory": resource.MustParse("10Gi"), "nodes": resource.MustParse("3")},
+				corev1.ResourceList{"cpu": resource.MustParse("11"), "memory": resource.MustParse("10Gi"), "gpu": resource.MustParse("1")},
+			)).To(ConsistOf(corev1.ResourceName("cpu")))
+		})
+	})
+
+	Describe("#ComputeShootQuotaResources", func() {
+		var (
+			cloudProfile *gardencorev1beta1.CloudProfile
+			shoot        *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			cloudProfile = &gardencorev1beta1.CloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					MachineTypes: []gardencorev1beta1.MachineType{{
+						Name:   "small",
+						CPU:    resource.MustParse("2"),
+						GPU:    resource.MustParse("1"),
+						Memory: resource.MustParse("8Gi"),
+					}},
+					VolumeTypes: []gardencorev1beta1.VolumeType{{
+						Name:  "ssd",
+						Class: gardencorev1beta1.VolumeClassPremium,
+					}},
+				},
+			}
+			shoot = &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Provider: gardencorev1beta1.Provider{
+						Workers: []gardencorev1beta1.Worker{
+							{
+								Name:     "a",
+								Machine:  gardencorev1beta1.Machine{Type: "small"},
+								Maximum:  4,
+								MaxSurge: ptr.To(intstr.FromString("50%")),
+								Volume:   &gardencorev1beta1.Volume{Type: ptr.To("ssd"), VolumeSize: "20Gi"},
+							},
+							{
+								Name:    "b",
+								Machine: gardencorev1beta1.Machine{Type: "small"},
+								Maximum: 1,
+								Volume:  &gardencorev1beta1.Volume{Type: ptr.To("ssd"), VolumeSize: "20Gi"},
+							},
+						},
+					},
+					Addons: &gardencorev1beta1.Addons{
+						NginxIngress: &gardencorev1beta1.NginxIngress{Addon: gardencorev1beta1.Addon{Enabled: true}},
+					},
+				},
+			}
+		})
+
+		It("should compute the resources of the shoot", func() {
+			resources, err := ComputeShootQuotaResources(shoot, cloudProfile)
+			Expect(err).NotTo(HaveOccurred())
+
+			for metric, quantity := range map[corev1.ResourceName]string{
+				"cpu":             "10",
+				"gpu":             "5",
+				"memory":          "40Gi",
+				"storage.premium": "100Gi",
+				"loadbalancer":    "2",
+				"shoots":          "1",
+				"nodes":           "5",
+				"maxsurge":        "3",
+			} {
+				actual := resources[metric]
+				Expect(actual.Cmp(resource.MustParse(quantity))).To(BeZero(), "metric %s", metric)
+			}
+		})
+
+		It("should fail if the machine type is unknown", func() {
+			shoot.Spec.Provider.Workers[0].Machine.Type = "unknown"
+
+			_, err := ComputeShootQuotaResources(shoot, cloudProfile)
+			Expect(err).To(MatchError("machineType unknown not found in CloudProfile profile"))
+		})
+	})
+})
@@ -37,7 +37,15 @@ func (m *manager) Cleanup(ctx context.Context) error {
 
 		fns = append(fns, func(ctx context.Context) error {
 			m.logger.Info("Deleting stale secret", "namespace", secret.Namespace, "name", secret.Name)
-			return client.IgnoreNotFound(m.client.Delete(ctx, &secret))
+			if err := client.IgnoreNotFound(m.client.Delete(ctx, &secret)); err != nil {
+				return err
+			}
+
+			if ref := string(secret.Data[DataKeyPrivateKeyCARef]); ref != "" && m.keyStore != nil {
+				m.logger.Info("Deleting private key of stale secret from key store", "namespace", secret.Namespace, "name", secret.Name)
+				return m.keyStore.Delete(ctx, ref)
+			}
+			return nil
 		})
 	}
 
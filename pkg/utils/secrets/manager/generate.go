@@ -54,7 +54,7 @@ func (m *manager) Generate(ctx context.Context, config secretsutils.ConfigInterf
 			return nil, fmt.Errorf("failed reading secret %s for config %s: %w", client.ObjectKeyFromObject(secret), config.GetName(), err)
 		}
 
-		secret, err = m.generateAndCreate(ctx, config, objectMeta)
+		secret, err = m.generateAndCreate(ctx, config, objectMeta, options.KeepPrivateKeyInSecret)
 		if err != nil {
 			return nil, fmt.Errorf("failed generating and creating new secret %s for config %s: %w", client.ObjectKey{Name: objectMeta.Name, Namespace: objectMeta.Namespace}, config.GetName(), err)
 		}
@@ -65,6 +65,9 @@ func (m *manager) Generate(ctx context.Context, config secretsutils.ConfigInterf
 	}
 
 	if !options.isBundleSecret {
+		if err := m.loadExternalPrivateKey(ctx, secret); err != nil {
+			return nil, err
+		}
 		if err := m.addToStore(config.GetName(), secret, current); err != nil {
 			return nil, fmt.Errorf("failed adding current secret %s for config %s to internal store: %w", client.ObjectKeyFromObject(secret), config.GetName(), err)
 		}
@@ -89,7 +92,7 @@ func (m *manager) Generate(ctx context.Context, config secretsutils.ConfigInterf
 	return secret, nil
 }
 
-func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.ConfigInterface, objectMeta metav1.ObjectMeta) (*corev1.Secret, error) {
+func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.ConfigInterface, objectMeta metav1.ObjectMeta, keepPrivateKeyInSecret bool) (*corev1.Secret, error) {
 	// Use secret name as common name to make sure the x509 subject names in the CA certificates are always unique.
 	if certConfig := certificateSecretConfig(config); certConfig != nil && certConfig.CertType == secretsutils.CACert {
 		certConfig.CommonName = objectMeta.Name
@@ -105,8 +108,22 @@ func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.Con
 		return nil, fmt.Errorf("failed taking over data from existing secret when needed: %w", err)
 	}
 
+	var privateKeyRef string
+	if m.keyStore != nil && !keepPrivateKeyInSecret && isCASecret(dataMap) {
+		dataMap, privateKeyRef, err = m.externalizePrivateKey(ctx, objectMeta.Name, dataMap)
+		if err != nil {
+			return nil, fmt.Errorf("failed storing private key in key store: %w", err)
+		}
+	}
+
 	secret := Secret(objectMeta, dataMap)
 	if err := m.client.Create(ctx, secret); err != nil {
+		if privateKeyRef != "" {
+			if err := m.keyStore.Delete(ctx, privateKeyRef); err != nil {
+				return nil, fmt.Errorf("failed deleting private key from key store: %w", err)
+			}
+		}
+
 		if !apierrors.IsAlreadyExists(err) {
 			return nil, fmt.Errorf("failed creating new secret: %w", err)
 		}
@@ -190,6 +207,10 @@ func (m *manager) storeOldSecrets(ctx context.Context, name, currentSecretName s
 		return nil
 	}
 
+	if err := m.loadExternalPrivateKey(ctx, oldSecret); err != nil {
+		return err
+	}
+
 	return m.addToStore(oldSecret.Labels[LabelKeyName], oldSecret, old)
 }
 
@@ -354,6 +375,9 @@ type GenerateOptions struct {
 	// IgnoreConfigChecksumForCASecretName specifies whether the secret config checksum should be ignored when
 	// computing the secret name for CA secrets.
 	IgnoreConfigChecksumForCASecretName bool
+	// KeepPrivateKeyInSecret specifies whether the private key of a CA secret should be stored in the secret even if a
+	// KeyStore is configured, e.g., because other components read it from the secret.
+	KeepPrivateKeyInSecret bool
 
 	signingCAChecksum *string
 	isBundleSecret    bool
@@ -454,7 +478,7 @@ func SignedByCA(name string, opts ...SignedByCAOption) GenerateOption {
 			}
 		}
 
-		ca, err := secretsutils.LoadCertificate(name, secret.obj.Data[secretsutils.DataKeyPrivateKeyCA], secret.obj.Data[secretsutils.DataKeyCertificateCA])
+		ca, err := secretsutils.LoadCertificate(name, mgr.caPrivateKey(secret.obj), secret.obj.Data[secretsutils.DataKeyCertificateCA])
 		if err != nil {
 			return err
 		}
@@ -515,6 +539,14 @@ func IgnoreConfigChecksumForCASecretName() GenerateOption {
 	}
 }
 
+// KeepPrivateKeyInSecret returns a function which sets the 'KeepPrivateKeyInSecret' field to true.
+func KeepPrivateKeyInSecret() GenerateOption {
+	return func(_ Interface, _ secretsutils.ConfigInterface, options *GenerateOptions) error {
+		options.KeepPrivateKeyInSecret = true
+		return nil
+	}
+}
+
 func isBundleSecret() GenerateOption {
 	return func(_ Interface, _ secretsutils.ConfigInterface, options *GenerateOptions) error {
 		options.isBundleSecret = true
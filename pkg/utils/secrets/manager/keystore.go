@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	corev1 "k8s.io/api/core/v1"
+
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+// DataKeyPrivateKeyCARef is a constant for a key in the data map of a Secret describing the reference of the CA private
+// key in the KeyStore. Secrets with this key do not contain the CA private key in their data. The reference is kept in
+// the data (and not in an annotation) so that it is persisted in the ShootState together with the CA certificate.
+const DataKeyPrivateKeyCARef = "ca.key-ref"
+
+// ErrKeyNotFound is returned by KeyStore implementations if no private key is stored for a reference.
+var ErrKeyNotFound = errors.New("private key not found")
+
+// KeyStore is a storage backend for the private keys of certificate authorities. If a KeyStore is configured for the
+// manager, the private keys of generated CAs are stored in the KeyStore instead of the Kubernetes secrets. Certificates
+// signed by such CAs are signed with the private key fetched from the KeyStore.
+// Note that consumers reading the 'ca.key' data key of CA secrets cannot be used together with a KeyStore. Also, all
+// gardenlets potentially taking over the secrets (e.g., during control plane migration) must use the same KeyStore.
+type KeyStore interface {
+	// Get returns the private key stored for the given reference. It returns ErrKeyNotFound if there is none.
+	Get(ctx context.Context, ref string) ([]byte, error)
+	// Store stores the private key for the given reference.
+	Store(ctx context.Context, ref string, key []byte) error
+	// Delete deletes the private key stored for the given reference. It does not return an error if there is none.
+	Delete(ctx context.Context, ref string) error
+}
+
+// FileKeyStore is a KeyStore storing the private keys as files in a directory, e.g., a volume mounted from an external
+// secret store. It serves as reference implementation for KeyStores backed by a KMS or Vault.
+type FileKeyStore struct {
+	// Directory is the directory the private keys are stored in.
+	Directory string
+}
+
+var _ KeyStore = &FileKeyStore{}
+
+var invalidFileNameChars = regexp.MustCompile(`[^-._a-zA-Z0-9]+`)
+
+func (f *FileKeyStore) path(ref string) string {
+	return filepath.Join(f.Directory, invalidFileNameChars.ReplaceAllString(ref, "_"))
+}
+
+// Get returns the private key stored for the given reference.
+func (f *FileKeyStore) Get(_ context.Context, ref string) ([]byte, error) {
+	key, err := os.ReadFile(f.path(ref))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, ref)
+		}
+		return nil, fmt.Errorf("failed reading private key %s: %w", ref, err)
+	}
+	return key, nil
+}
+
+// Store stores the private key for the given reference.
+func (f *FileKeyStore) Store(_ context.Context, ref string, key []byte) error {
+	if err := os.WriteFile(f.path(ref), key, 0600); err != nil {
+		return fmt.Errorf("failed writing private key %s: %w", ref, err)
+	}
+	return nil
+}
+
+// Delete deletes the private key stored for the given reference.
+func (f *FileKeyStore) Delete(_ context.Context, ref string) error {
+	if err := os.Remove(f.path(ref)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed deleting private key %s: %w", ref, err)
+	}
+	return nil
+}
+
+// externalizePrivateKey stores the CA private key of the given data in the KeyStore and returns a copy of the data
+// in which the private key is replaced by the reference of the key.
+func (m *manager) externalizePrivateKey(ctx context.Context, secretName string, data map[string][]byte) (map[string][]byte, string, error) {
+	// The reference contains a random suffix so that concurrent Generate calls for the same secret do not overwrite
+	// each other's keys.
+	suffix, err := secretsutils.GenerateRandomString(8)
+	if err != nil {
+		return nil, "", err
+	}
+	ref := m.namespace + "/" + secretName + "-" + suffix
+
+	if err := m.keyStore.Store(ctx, ref, data[secretsutils.DataKeyPrivateKeyCA]); err != nil {
+		return nil, "", err
+	}
+
+	out := make(map[string][]byte, len(data))
+	for k, v := range data {
+		if k != secretsutils.DataKeyPrivateKeyCA {
+			out[k] = v
+		}
+	}
+	out[DataKeyPrivateKeyCARef] = []byte(ref)
+	return out, ref, nil
+}
+
+// loadExternalPrivateKey fetches the CA private key of the given secret from the KeyStore if the secret references
+// one.
+func (m *manager) loadExternalPrivateKey(ctx context.Context, secret *corev1.Secret) error {
+	ref := string(secret.Data[DataKeyPrivateKeyCARef])
+	if ref == "" {
+		return nil
+	}
+	if m.keyStore == nil {
+		return fmt.Errorf("secret %s references external private key %s but no key store is configured", secret.Name, ref)
+	}
+
+	key, err := m.keyStore.Get(ctx, ref)
+	if err != nil {
+		return fmt.Errorf("failed fetching private key for secret %s: %w", secret.Name, err)
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.privateKeys[secret.Name] = key
+	return nil
+}
+
+// caPrivateKey returns the CA private key of the given secret. It prefers the key fetched from the KeyStore over the
+// key in the secret data.
+func (m *manager) caPrivateKey(secret *corev1.Secret) []byte {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if key, ok := m.privateKeys[secret.Name]; ok {
+		return key
+	}
+	return secret.Data[secretsutils.DataKeyPrivateKeyCA]
+}
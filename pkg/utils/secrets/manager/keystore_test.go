@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"crypto/x509"
+	"os"
+	"path/filepath"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/utils"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("KeyStore", func() {
+	var (
+		ctx       = context.TODO()
+		namespace = "shoot--foo--bar"
+		identity  = "test"
+
+		keyStore *FileKeyStore
+	)
+
+	BeforeEach(func() {
+		keyStore = &FileKeyStore{Directory: GinkgoT().TempDir()}
+	})
+
+	Describe("#FileKeyStore", func() {
+		It("should store, get and delete private keys", func() {
+			Expect(keyStore.Store(ctx, "shoot--foo--bar/ca-123", []byte("key"))).To(Succeed())
+			Expect(filepath.Join(keyStore.Directory, "shoot--foo--bar_ca-123")).To(BeARegularFile())
+			Expect(keyStore.Get(ctx, "shoot--foo--bar/ca-123")).To(Equal([]byte("key")))
+
+			Expect(keyStore.Delete(ctx, "shoot--foo--bar/ca-123")).To(Succeed())
+			_, err := keyStore.Get(ctx, "shoot--foo--bar/ca-123")
+			Expect(err).To(MatchError(ErrKeyNotFound))
+		})
+
+		It("should not fail deleting non-existing private keys", func() {
+			Expect(keyStore.Delete(ctx, "foo")).To(Succeed())
+		})
+	})
+
+	Describe("#Generate", func() {
+		var (
+			fakeClient client.Client
+			m          *manager
+
+			serverConfig *secretsutils.CertificateSecretConfig
+
+			// The config is mutated by Generate, hence a new one is required for every call.
+			caConfig = func() *secretsutils.CertificateSecretConfig {
+				return &secretsutils.CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: secretsutils.CACert}
+			}
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+
+			mgr, err := New(ctx, logr.Discard(), clock.RealClock{}, fakeClient, namespace, identity, Config{KeyStore: keyStore})
+			Expect(err).NotTo(HaveOccurred())
+			m = mgr.(*manager)
+
+			serverConfig = &secretsutils.CertificateSecretConfig{Name: "server", CommonName: "server", CertType: secretsutils.ServerCert, SkipPublishingCACertificate: true}
+		})
+
+		It("should store the CA private key in the key store and sign certificates with it", func() {
+			caSecret, err := m.Generate(ctx, caConfig())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(caSecret.Data).To(HaveKey(secretsutils.DataKeyCertificateCA))
+			Expect(caSecret.Data).NotTo(HaveKey(secretsutils.DataKeyPrivateKeyCA))
+			Expect(string(caSecret.Data[DataKeyPrivateKeyCARef])).To(HavePrefix(namespace + "/" + caSecret.Name + "-"))
+			Expect(keyStore.Get(ctx, string(caSecret.Data[DataKeyPrivateKeyCARef]))).NotTo(BeEmpty())
+
+			By("Sign certificate with a new manager instance")
+			mgr, err := New(ctx, logr.Discard(), clock.RealClock{}, fakeClient, namespace, identity, Config{KeyStore: keyStore})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = mgr.Generate(ctx, caConfig())
+			Expect(err).NotTo(HaveOccurred())
+
+			serverSecret, err := mgr.Generate(ctx, serverConfig, SignedByCA("ca"))
+			Expect(err).NotTo(HaveOccurred())
+
+			caCert, err := utils.DecodeCertificate(caSecret.Data[secretsutils.DataKeyCertificateCA])
+			Expect(err).NotTo(HaveOccurred())
+			serverCert, err := utils.DecodeCertificate(serverSecret.Data[secretsutils.DataKeyCertificate])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(serverCert.CheckSignatureFrom(caCert)).To(Succeed())
+			Expect(serverCert.ExtKeyUsage).To(ContainElement(x509.ExtKeyUsageServerAuth))
+		})
+
+		It("should keep the CA private key in the secret if requested", func() {
+			caSecret, err := m.Generate(ctx, caConfig(), KeepPrivateKeyInSecret())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(caSecret.Data).To(HaveKey(secretsutils.DataKeyPrivateKeyCA))
+			Expect(caSecret.Data).NotTo(HaveKey(DataKeyPrivateKeyCARef))
+			Expect(os.ReadDir(keyStore.Directory)).To(BeEmpty())
+		})
+
+		It("should fail if the private key cannot be found in the key store", func() {
+			caSecret, err := m.Generate(ctx, caConfig())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keyStore.Delete(ctx, string(caSecret.Data[DataKeyPrivateKeyCARef]))).To(Succeed())
+
+			_, err = m.Generate(ctx, caConfig())
+			Expect(err).To(MatchError(ErrKeyNotFound))
+		})
+
+		It("should fail if no key store is configured for secrets referencing private keys", func() {
+			_, err := m.Generate(ctx, caConfig())
+			Expect(err).NotTo(HaveOccurred())
+
+			mgr, err := New(ctx, logr.Discard(), clock.RealClock{}, fakeClient, namespace, identity, Config{})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = mgr.Generate(ctx, caConfig())
+			Expect(err).To(MatchError(ContainSubstring("no key store is configured")))
+		})
+
+		It("should delete the private keys of stale secrets from the key store", func() {
+			caSecret, err := m.Generate(ctx, caConfig())
+			Expect(err).NotTo(HaveOccurred())
+			ref := string(caSecret.Data[DataKeyPrivateKeyCARef])
+
+			mgr, err := New(ctx, logr.Discard(), clock.RealClock{}, fakeClient, namespace, identity, Config{KeyStore: keyStore})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mgr.Cleanup(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(caSecret), &corev1.Secret{})).To(BeNotFoundError())
+			_, err = os.Stat(keyStore.path(ref))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+})
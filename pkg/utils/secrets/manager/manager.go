@@ -73,6 +73,8 @@ type (
 		namespace                   string
 		identity                    string
 		lastRotationInitiationTimes nameToUnixTime
+		keyStore                    KeyStore
+		privateKeys                 map[string][]byte
 	}
 
 	nameToUnixTime map[string]string
@@ -96,6 +98,9 @@ type (
 		// SecretNamesToTimes is a map whose keys are secret names and whose values are the last rotation initiation
 		// times.
 		SecretNamesToTimes map[string]time.Time
+		// KeyStore is an optional storage backend for the private keys of CA secrets. If it is set, the private keys of
+		// newly generated CAs are not stored in the secrets.
+		KeyStore KeyStore
 	}
 )
 
@@ -130,6 +135,8 @@ func New(
 		namespace:                   namespace,
 		identity:                    identity,
 		lastRotationInitiationTimes: make(nameToUnixTime),
+		keyStore:                    rotation.KeyStore,
+		privateKeys:                 make(map[string][]byte),
 	}
 
 	if err := m.initialize(ctx, rotation); err != nil {
@@ -167,7 +174,7 @@ func (m *manager) initialize(ctx context.Context, rotation Config) error {
 
 	// Check if the secrets must be automatically renewed because they are about to expire.
 	for name, secret := range nameToNewestSecret {
-		if isCASecret(secret.Data) && !rotation.CASecretAutoRotation {
+		if isCASecretObject(&secret) && !rotation.CASecretAutoRotation {
 			continue
 		}
 
@@ -361,6 +368,15 @@ func isCASecret(data map[string][]byte) bool {
 	return data[secretsutils.DataKeyCertificateCA] != nil && data[secretsutils.DataKeyPrivateKeyCA] != nil
 }
 
+// isCASecretObject returns true if the given secret contains a CA, i.e., if it contains the CA certificate and either
+// the CA private key or a reference to the private key in the KeyStore.
+func isCASecretObject(secret *corev1.Secret) bool {
+	if _, ok := secret.Data[DataKeyPrivateKeyCARef]; ok {
+		return secret.Data[secretsutils.DataKeyCertificateCA] != nil
+	}
+	return isCASecret(secret.Data)
+}
+
 func certificateSecretConfig(config secretsutils.ConfigInterface) *secretsutils.CertificateSecretConfig {
 	var certificateConfig *secretsutils.CertificateSecretConfig
 
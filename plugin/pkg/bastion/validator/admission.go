@@ -9,21 +9,32 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/apis/operations"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	operationsv1alpha1helper "github.com/gardener/gardener/pkg/apis/operations/v1alpha1/helper"
 	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
 	gardencoreclientset "github.com/gardener/gardener/pkg/client/core/clientset/versioned"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	operationsinformers "github.com/gardener/gardener/pkg/client/operations/informers/externalversions"
+	operationsv1alpha1listers "github.com/gardener/gardener/pkg/client/operations/listers/operations/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/kubernetes"
 	plugin "github.com/gardener/gardener/plugin/pkg"
+	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
 )
 
 // Register registers a plugin.
@@ -33,15 +44,34 @@ func Register(plugins *admission.Plugins) {
 	})
 }
 
+const (
+	// AuditAnnotationPrefix is the prefix of the audit annotations which are added to the audit events of requests
+	// creating or extending Bastions.
+	AuditAnnotationPrefix = "bastion.admission.gardener.cloud/"
+	// AuditAnnotationOperation is the audit annotation containing the operation performed on the Bastion, i.e.,
+	// "create" or "keepalive".
+	AuditAnnotationOperation = AuditAnnotationPrefix + "operation"
+	// AuditAnnotationShoot is the audit annotation containing the name of the Shoot the Bastion provides access to.
+	AuditAnnotationShoot = AuditAnnotationPrefix + "shoot"
+	// AuditAnnotationIngress is the audit annotation containing the CIDRs which are allowed to access the Bastion.
+	AuditAnnotationIngress = AuditAnnotationPrefix + "ingress"
+)
+
 // Bastion contains listers and admission handler.
 type Bastion struct {
 	*admission.Handler
-	coreClient gardencoreclientset.Interface
-	readyFunc  admission.ReadyFunc
+	authorizer          authorizer.Authorizer
+	coreClient          gardencoreclientset.Interface
+	projectLister       gardencorev1beta1listers.ProjectLister
+	bastionPolicyLister operationsv1alpha1listers.BastionPolicyLister
+	readyFunc           admission.ReadyFunc
 }
 
 var (
+	_ = admissioninitializer.WantsAuthorizer(&Bastion{})
 	_ = admissioninitializer.WantsCoreClientSet(&Bastion{})
+	_ = admissioninitializer.WantsCoreInformerFactory(&Bastion{})
+	_ = admissioninitializer.WantsOperationsInformerFactory(&Bastion{})
 
 	readyFuncs []admission.ReadyFunc
 )
@@ -59,16 +89,46 @@ func (v *Bastion) AssignReadyFunc(f admission.ReadyFunc) {
 	v.SetReadyFunc(f)
 }
 
+// SetAuthorizer sets the authorizer.
+func (v *Bastion) SetAuthorizer(authorizer authorizer.Authorizer) {
+	v.authorizer = authorizer
+}
+
 // SetCoreClientSet sets the garden core clientset.
 func (v *Bastion) SetCoreClientSet(c gardencoreclientset.Interface) {
 	v.coreClient = c
 }
 
+// SetCoreInformerFactory sets the garden core informer factory.
+func (v *Bastion) SetCoreInformerFactory(f gardencoreinformers.SharedInformerFactory) {
+	projectInformer := f.Core().V1beta1().Projects()
+	v.projectLister = projectInformer.Lister()
+
+	readyFuncs = append(readyFuncs, projectInformer.Informer().HasSynced)
+}
+
+// SetOperationsInformerFactory sets the operations informer factory.
+func (v *Bastion) SetOperationsInformerFactory(f operationsinformers.SharedInformerFactory) {
+	bastionPolicyInformer := f.Operations().V1alpha1().BastionPolicies()
+	v.bastionPolicyLister = bastionPolicyInformer.Lister()
+
+	readyFuncs = append(readyFuncs, bastionPolicyInformer.Informer().HasSynced)
+}
+
 // ValidateInitialization checks whether the plugin was correctly initialized.
 func (v *Bastion) ValidateInitialization() error {
+	if v.authorizer == nil {
+		return errors.New("missing authorizer")
+	}
 	if v.coreClient == nil {
 		return errors.New("missing garden core client")
 	}
+	if v.projectLister == nil {
+		return errors.New("missing project lister")
+	}
+	if v.bastionPolicyLister == nil {
+		return errors.New("missing bastion policy lister")
+	}
 	return nil
 }
 
@@ -146,6 +206,21 @@ func (v *Bastion) Admit(ctx context.Context, a admission.Attributes, _ admission
 		return apierrors.NewInvalid(gk, bastion.Name, field.ErrorList{fieldErr})
 	}
 
+	var oldBastion *operations.Bastion
+	if a.GetOperation() == admission.Update {
+		oldBastion, ok = a.GetOldObject().(*operations.Bastion)
+		if !ok {
+			return apierrors.NewBadRequest("could not convert old object to Bastion")
+		}
+	}
+
+	// ensure bastion complies with the bastion policies of its project
+	if bastion.DeletionTimestamp == nil && (oldBastion == nil || !apiequality.Semantic.DeepEqual(bastion.Spec.Ingress, oldBastion.Spec.Ingress)) {
+		if err := v.admitBastionPolicies(a, bastion); err != nil {
+			return err
+		}
+	}
+
 	// update bastion
 	bastion.Spec.SeedName = shoot.Spec.SeedName
 	bastion.Spec.ProviderType = &shoot.Spec.Provider.Type
@@ -154,9 +229,136 @@ func (v *Bastion) Admit(ctx context.Context, a admission.Attributes, _ admission
 		metav1.SetMetaDataAnnotation(&bastion.ObjectMeta, v1beta1constants.GardenCreatedBy, userInfo.GetName())
 	}
 
+	// only the keepalive operation may change the user who last extended the bastion
+	if userInfo := a.GetUserInfo(); oldBastion != nil && userInfo != nil && bastion.Annotations[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationKeepalive {
+		metav1.SetMetaDataAnnotation(&bastion.ObjectMeta, operationsv1alpha1.AnnotationLastHeartbeatBy, userInfo.GetName())
+	} else {
+		keepAnnotation(bastion, oldBastion, operationsv1alpha1.AnnotationLastHeartbeatBy)
+	}
+
+	// the audited heartbeat is maintained by gardener-controller-manager, hence, it must not be set or changed by end-users
+	if a.GetUserInfo() != nil {
+		isSystemComponent, err := v.isSystemComponent(ctx, a, bastion)
+		if err != nil {
+			return apierrors.NewInternalError(err)
+		}
+		if !isSystemComponent {
+			keepAnnotation(bastion, oldBastion, operationsv1alpha1.AnnotationAuditedHeartbeatTimestamp)
+		}
+	}
+
+	if err := addAuditAnnotations(a, bastion, oldBastion); err != nil {
+		return apierrors.NewInternalError(err)
+	}
+
 	// ensure bastions are cleaned up when shoots are deleted
 	ownerRef := *metav1.NewControllerRef(shoot, gardencorev1beta1.SchemeGroupVersion.WithKind("Shoot"))
 	bastion.OwnerReferences = kubernetes.MergeOwnerReferences(bastion.OwnerReferences, ownerRef)
 
 	return nil
 }
+
+func (v *Bastion) admitBastionPolicies(a admission.Attributes, bastion *operations.Bastion) error {
+	project, err := admissionutils.ProjectForNamespaceFromLister(v.projectLister, bastion.Namespace)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// bastion policies only apply to bastions in project namespaces
+			return nil
+		}
+		return apierrors.NewInternalError(fmt.Errorf("could not get project for namespace %s: %w", bastion.Namespace, err))
+	}
+
+	bastionPolicies, err := v.bastionPolicyLister.List(labels.Everything())
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not list bastion policies: %w", err))
+	}
+
+	bastionPolicies, err = operationsv1alpha1helper.BastionPoliciesForProject(bastionPolicies, project)
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not determine bastion policies for project %s: %w", project.Name, err))
+	}
+
+	var allErrs field.ErrorList
+
+	for _, bastionPolicy := range bastionPolicies {
+		if userInfo := a.GetUserInfo(); a.GetOperation() == admission.Create && userInfo != nil &&
+			!operationsv1alpha1helper.BastionPolicyAllowsUser(bastionPolicy, userInfo.GetName(), userInfo.GetGroups()) {
+			return admission.NewForbidden(a, fmt.Errorf("user %q is not allowed to create bastions in project %q by bastion policy %q", userInfo.GetName(), project.Name, bastionPolicy.Name))
+		}
+
+		for i, ingress := range bastion.Spec.Ingress {
+			if !operationsv1alpha1helper.BastionPolicyAllowsSourceCIDR(bastionPolicy, ingress.IPBlock.CIDR) {
+				allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "ingress").Index(i).Child("ipBlock", "cidr"), fmt.Sprintf("CIDR %s is not allowed by bastion policy %q", ingress.IPBlock.CIDR, bastionPolicy.Name)))
+			}
+		}
+	}
+
+	if len(allErrs) > 0 {
+		return apierrors.NewInvalid(schema.GroupKind{Group: operations.GroupName, Kind: "Bastion"}, bastion.Name, allErrs)
+	}
+
+	return nil
+}
+
+// isSystemComponent returns whether the user of the request is a system component, i.e., whether the user is allowed to
+// update the status of the given Bastion (which is only the case for gardenlet and gardener-controller-manager).
+func (v *Bastion) isSystemComponent(ctx context.Context, a admission.Attributes, bastion *operations.Bastion) (bool, error) {
+	decision, _, err := v.authorizer.Authorize(ctx, authorizer.AttributesRecord{
+		User:            a.GetUserInfo(),
+		Verb:            "update",
+		APIGroup:        operations.GroupName,
+		Resource:        "bastions",
+		Subresource:     "status",
+		Namespace:       bastion.Namespace,
+		Name:            bastion.Name,
+		ResourceRequest: true,
+	})
+	if err != nil {
+		return false, fmt.Errorf("could not authorize user %q: %w", a.GetUserInfo().GetName(), err)
+	}
+	return decision == authorizer.DecisionAllow, nil
+}
+
+// keepAnnotation resets the given annotation of the Bastion to the value of the old Bastion, or removes it if the old
+// Bastion does not exist or does not have the annotation.
+func keepAnnotation(bastion, oldBastion *operations.Bastion, key string) {
+	if oldBastion != nil {
+		if value, ok := oldBastion.Annotations[key]; ok {
+			metav1.SetMetaDataAnnotation(&bastion.ObjectMeta, key, value)
+			return
+		}
+	}
+	delete(bastion.Annotations, key)
+}
+
+// addAuditAnnotations adds annotations to the audit event of requests creating or extending a Bastion. Contrary to
+// events, audit events are persisted outside of the garden cluster (if auditing is configured), hence, they provide a
+// durable trail of who accessed which Shoot.
+func addAuditAnnotations(a admission.Attributes, bastion, oldBastion *operations.Bastion) error {
+	var operation string
+	switch {
+	case oldBastion == nil:
+		operation = "create"
+	case bastion.Annotations[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationKeepalive:
+		operation = v1beta1constants.GardenerOperationKeepalive
+	default:
+		return nil
+	}
+
+	ingress := make([]string, 0, len(bastion.Spec.Ingress))
+	for _, policy := range bastion.Spec.Ingress {
+		ingress = append(ingress, policy.IPBlock.CIDR)
+	}
+
+	for key, value := range map[string]string{
+		AuditAnnotationOperation: operation,
+		AuditAnnotationShoot:     bastion.Spec.ShootRef.Name,
+		AuditAnnotationIngress:   strings.Join(ingress, ","),
+	} {
+		if err := a.AddAnnotation(key, value); err != nil {
+			return fmt.Errorf("could not add audit annotation %q: %w", key, err)
+		}
+	}
+
+	return nil
+}
@@ -11,12 +11,15 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/admission"
 	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
 	"k8s.io/client-go/testing"
 	"k8s.io/utils/ptr"
 
@@ -25,6 +28,8 @@ import (
 	"github.com/gardener/gardener/pkg/apis/operations"
 	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
 	corefake "github.com/gardener/gardener/pkg/client/core/clientset/versioned/fake"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	operationsinformers "github.com/gardener/gardener/pkg/client/operations/informers/externalversions"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 	. "github.com/gardener/gardener/plugin/pkg/bastion/validator"
 )
@@ -38,16 +43,21 @@ const (
 	provider    = "foo-provider"
 	region      = "foo-region"
 	userName    = "ginkgo"
+
+	systemComponentName = "gardener-controller-manager"
 )
 
 var _ = Describe("Bastion", func() {
 	Describe("#Admit", func() {
 		var (
-			bastion          *operations.Bastion
-			shoot            *gardencorev1beta1.Shoot
-			coreClient       *corefake.Clientset
-			dummyOwnerRef    *metav1.OwnerReference
-			admissionHandler *Bastion
+			bastion                   *operations.Bastion
+			shoot                     *gardencorev1beta1.Shoot
+			project                   *gardencorev1beta1.Project
+			coreClient                *corefake.Clientset
+			coreInformerFactory       gardencoreinformers.SharedInformerFactory
+			operationsInformerFactory operationsinformers.SharedInformerFactory
+			dummyOwnerRef             *metav1.OwnerReference
+			admissionHandler          *Bastion
 		)
 
 		BeforeEach(func() {
@@ -87,6 +97,19 @@ var _ = Describe("Bastion", func() {
 					ShootRef: corev1.LocalObjectReference{
 						Name: shootName,
 					},
+					Ingress: []operations.BastionIngressPolicy{{
+						IPBlock: networkingv1.IPBlock{CIDR: "10.1.2.3/32"},
+					}},
+				},
+			}
+
+			project = &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "garden",
+					Labels: map[string]string{"restricted": "true"},
+				},
+				Spec: gardencorev1beta1.ProjectSpec{
+					Namespace: ptr.To(namespace),
 				},
 			}
 
@@ -94,9 +117,17 @@ var _ = Describe("Bastion", func() {
 			admissionHandler, err = New()
 			Expect(err).ToNot(HaveOccurred())
 			admissionHandler.AssignReadyFunc(func() bool { return true })
+			admissionHandler.SetAuthorizer(fakeAuthorizer{})
 
 			coreClient = &corefake.Clientset{}
 			admissionHandler.SetCoreClientSet(coreClient)
+
+			coreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetCoreInformerFactory(coreInformerFactory)
+			Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+
+			operationsInformerFactory = operationsinformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetOperationsInformerFactory(operationsInformerFactory)
 		})
 
 		It("should do nothing if the resource is not a Bastion", func() {
@@ -278,6 +309,178 @@ var _ = Describe("Bastion", func() {
 			err := admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, oldBastion, admission.Update), nil)
 			Expect(err).To(Succeed())
 		})
+
+		Context("bastion policies", func() {
+			var bastionPolicy *operationsv1alpha1.BastionPolicy
+
+			BeforeEach(func() {
+				coreClient.AddReactor("get", "shoots", func(_ testing.Action) (bool, runtime.Object, error) {
+					return true, shoot, nil
+				})
+
+				bastionPolicy = &operationsv1alpha1.BastionPolicy{
+					ObjectMeta: metav1.ObjectMeta{Name: "restricted"},
+					Spec: operationsv1alpha1.BastionPolicySpec{
+						ProjectSelector:    &metav1.LabelSelector{MatchLabels: map[string]string{"restricted": "true"}},
+						AllowedSourceCIDRs: []string{"10.0.0.0/8"},
+						Subjects:           []rbacv1.Subject{{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: userName}},
+					},
+				}
+				Expect(operationsInformerFactory.Operations().V1alpha1().BastionPolicies().Informer().GetStore().Add(bastionPolicy)).To(Succeed())
+			})
+
+			It("should allow the Bastion creation if it complies with the policy", func() {
+				Expect(admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, nil, admission.Create), nil)).To(Succeed())
+			})
+
+			It("should ignore policies not selecting the project", func() {
+				project.Labels = nil
+				bastion.Spec.Ingress[0].IPBlock.CIDR = "0.0.0.0/0"
+
+				Expect(admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, nil, admission.Create), nil)).To(Succeed())
+			})
+
+			It("should forbid the Bastion creation if the user is not a subject of the policy", func() {
+				bastionPolicy.Spec.Subjects = []rbacv1.Subject{{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "operators"}}
+
+				err := admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, nil, admission.Create), nil)
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring(`user "ginkgo" is not allowed to create bastions in project "garden" by bastion policy "restricted"`)))
+			})
+
+			It("should forbid the Bastion creation if the ingress is not allowed by the policy", func() {
+				bastion.Spec.Ingress = append(bastion.Spec.Ingress, operations.BastionIngressPolicy{IPBlock: networkingv1.IPBlock{CIDR: "0.0.0.0/0"}})
+
+				err := admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, nil, admission.Create), nil)
+				Expect(err).To(BeInvalidError())
+				Expect(getErrorList(err)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.ingress[1].ipBlock.cidr"),
+					})),
+				))
+			})
+
+			It("should forbid changing the ingress to a CIDR not allowed by the policy", func() {
+				oldBastion := bastion.DeepCopy()
+				bastion.Spec.Ingress[0].IPBlock.CIDR = "0.0.0.0/0"
+
+				err := admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, oldBastion, admission.Update), nil)
+				Expect(err).To(BeInvalidError())
+			})
+
+			It("should not check the subjects of the policy on updates", func() {
+				bastionPolicy.Spec.Subjects = []rbacv1.Subject{{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "operators"}}
+				oldBastion := bastion.DeepCopy()
+				bastion.Spec.Ingress[0].IPBlock.CIDR = "10.0.0.0/16"
+
+				Expect(admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, oldBastion, admission.Update), nil)).To(Succeed())
+			})
+		})
+
+		Context("heartbeats", func() {
+			BeforeEach(func() {
+				coreClient.AddReactor("get", "shoots", func(_ testing.Action) (bool, runtime.Object, error) {
+					return true, shoot, nil
+				})
+			})
+
+			It("should record the user of the keepalive operation", func() {
+				oldBastion := bastion.DeepCopy()
+				oldBastion.Annotations = map[string]string{operationsv1alpha1.AnnotationLastHeartbeatBy: "someone-else"}
+				bastion.Annotations = map[string]string{v1beta1constants.GardenerOperation: v1beta1constants.GardenerOperationKeepalive}
+
+				Expect(admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, oldBastion, admission.Update), nil)).To(Succeed())
+				Expect(bastion.Annotations).To(HaveKeyWithValue(operationsv1alpha1.AnnotationLastHeartbeatBy, userName))
+			})
+
+			It("should not allow to change the user of the last heartbeat otherwise", func() {
+				oldBastion := bastion.DeepCopy()
+				oldBastion.Annotations = map[string]string{operationsv1alpha1.AnnotationLastHeartbeatBy: "someone-else"}
+				bastion.Annotations = map[string]string{operationsv1alpha1.AnnotationLastHeartbeatBy: "foo"}
+
+				Expect(admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, oldBastion, admission.Update), nil)).To(Succeed())
+				Expect(bastion.Annotations).To(HaveKeyWithValue(operationsv1alpha1.AnnotationLastHeartbeatBy, "someone-else"))
+			})
+
+			It("should not allow to set the user of the last heartbeat otherwise", func() {
+				oldBastion := bastion.DeepCopy()
+				bastion.Annotations = map[string]string{operationsv1alpha1.AnnotationLastHeartbeatBy: "foo"}
+
+				Expect(admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, oldBastion, admission.Update), nil)).To(Succeed())
+				Expect(bastion.Annotations).NotTo(HaveKey(operationsv1alpha1.AnnotationLastHeartbeatBy))
+			})
+
+			It("should not allow to set the user of the last heartbeat on creation", func() {
+				bastion.Annotations = map[string]string{operationsv1alpha1.AnnotationLastHeartbeatBy: "foo"}
+
+				Expect(admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, nil, admission.Create), nil)).To(Succeed())
+				Expect(bastion.Annotations).NotTo(HaveKey(operationsv1alpha1.AnnotationLastHeartbeatBy))
+			})
+
+			It("should not allow end-users to set the audited heartbeat on creation", func() {
+				bastion.Annotations = map[string]string{operationsv1alpha1.AnnotationAuditedHeartbeatTimestamp: "2024-01-01T00:00:00Z"}
+
+				Expect(admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, nil, admission.Create), nil)).To(Succeed())
+				Expect(bastion.Annotations).NotTo(HaveKey(operationsv1alpha1.AnnotationAuditedHeartbeatTimestamp))
+			})
+
+			It("should not allow end-users to change the audited heartbeat", func() {
+				oldBastion := bastion.DeepCopy()
+				oldBastion.Annotations = map[string]string{operationsv1alpha1.AnnotationAuditedHeartbeatTimestamp: "2024-01-01T00:00:00Z"}
+				bastion.Annotations = map[string]string{operationsv1alpha1.AnnotationAuditedHeartbeatTimestamp: "2024-01-01T01:00:00Z"}
+
+				Expect(admissionHandler.Admit(context.TODO(), getBastionAttributes(bastion, oldBastion, admission.Update), nil)).To(Succeed())
+				Expect(bastion.Annotations).To(HaveKeyWithValue(operationsv1alpha1.AnnotationAuditedHeartbeatTimestamp, "2024-01-01T00:00:00Z"))
+			})
+
+			It("should allow system components to change the audited heartbeat", func() {
+				oldBastion := bastion.DeepCopy()
+				oldBastion.Annotations = map[string]string{operationsv1alpha1.AnnotationAuditedHeartbeatTimestamp: "2024-01-01T00:00:00Z"}
+				bastion.Annotations = map[string]string{operationsv1alpha1.AnnotationAuditedHeartbeatTimestamp: "2024-01-01T01:00:00Z"}
+
+				attrs := getBastionAttributesForUser(bastion, oldBastion, admission.Update, systemComponentName)
+				Expect(admissionHandler.Admit(context.TODO(), attrs, nil)).To(Succeed())
+				Expect(bastion.Annotations).To(HaveKeyWithValue(operationsv1alpha1.AnnotationAuditedHeartbeatTimestamp, "2024-01-01T01:00:00Z"))
+			})
+		})
+
+		Context("audit annotations", func() {
+			BeforeEach(func() {
+				coreClient.AddReactor("get", "shoots", func(_ testing.Action) (bool, runtime.Object, error) {
+					return true, shoot, nil
+				})
+			})
+
+			It("should add audit annotations on creation", func() {
+				attrs := &annotationRecorder{Attributes: getBastionAttributes(bastion, nil, admission.Create)}
+
+				Expect(admissionHandler.Admit(context.TODO(), attrs, nil)).To(Succeed())
+				Expect(attrs.annotations).To(Equal(map[string]string{
+					AuditAnnotationOperation: "create",
+					AuditAnnotationShoot:     shootName,
+					AuditAnnotationIngress:   "10.1.2.3/32",
+				}))
+			})
+
+			It("should add audit annotations on keepalive", func() {
+				oldBastion := bastion.DeepCopy()
+				bastion.Annotations = map[string]string{v1beta1constants.GardenerOperation: v1beta1constants.GardenerOperationKeepalive}
+				attrs := &annotationRecorder{Attributes: getBastionAttributes(bastion, oldBastion, admission.Update)}
+
+				Expect(admissionHandler.Admit(context.TODO(), attrs, nil)).To(Succeed())
+				Expect(attrs.annotations).To(HaveKeyWithValue(AuditAnnotationOperation, "keepalive"))
+			})
+
+			It("should not add audit annotations on other updates", func() {
+				oldBastion := bastion.DeepCopy()
+				bastion.Finalizers = []string{"foo"}
+				attrs := &annotationRecorder{Attributes: getBastionAttributes(bastion, oldBastion, admission.Update)}
+
+				Expect(admissionHandler.Admit(context.TODO(), attrs, nil)).To(Succeed())
+				Expect(attrs.annotations).To(BeEmpty())
+			})
+		})
 	})
 
 	Describe("#Register", func() {
@@ -312,7 +515,10 @@ var _ = Describe("Bastion", func() {
 
 		It("should not fail if the required clients are set", func() {
 			admissionHandler, _ := New()
+			admissionHandler.SetAuthorizer(fakeAuthorizer{})
 			admissionHandler.SetCoreClientSet(&corefake.Clientset{})
+			admissionHandler.SetCoreInformerFactory(gardencoreinformers.NewSharedInformerFactory(nil, 0))
+			admissionHandler.SetOperationsInformerFactory(operationsinformers.NewSharedInformerFactory(nil, 0))
 
 			err := admissionHandler.ValidateInitialization()
 			Expect(err).ToNot(HaveOccurred())
@@ -321,8 +527,17 @@ var _ = Describe("Bastion", func() {
 })
 
 func getBastionAttributes(bastion *operations.Bastion, oldBastion *operations.Bastion, op admission.Operation) admission.Attributes {
+	return getBastionAttributesForUser(bastion, oldBastion, op, userName)
+}
+
+func getBastionAttributesForUser(bastion *operations.Bastion, oldBastion *operations.Bastion, op admission.Operation, name string) admission.Attributes {
+	var oldObj runtime.Object
+	if oldBastion != nil {
+		oldObj = oldBastion
+	}
+
 	return admission.NewAttributesRecord(bastion,
-		oldBastion,
+		oldObj,
 		operationsv1alpha1.Kind("Bastion").WithVersion("v1alpha1"),
 		bastion.Namespace,
 		bastion.Name,
@@ -332,11 +547,34 @@ func getBastionAttributes(bastion *operations.Bastion, oldBastion *operations.Ba
 		&metav1.CreateOptions{},
 		false,
 		&user.DefaultInfo{
-			Name: userName,
+			Name: name,
 		},
 	)
 }
 
+// fakeAuthorizer only allows the system component to update the status of Bastions.
+type fakeAuthorizer struct{}
+
+func (fakeAuthorizer) Authorize(_ context.Context, a authorizer.Attributes) (authorizer.Decision, string, error) {
+	if a.GetUser().GetName() == systemComponentName && a.GetResource() == "bastions" && a.GetSubresource() == "status" {
+		return authorizer.DecisionAllow, "", nil
+	}
+	return authorizer.DecisionNoOpinion, "", nil
+}
+
+type annotationRecorder struct {
+	admission.Attributes
+	annotations map[string]string
+}
+
+func (a *annotationRecorder) AddAnnotation(key, value string) error {
+	if a.annotations == nil {
+		a.annotations = map[string]string{}
+	}
+	a.annotations[key] = value
+	return nil
+}
+
 func getErrorList(err error) field.ErrorList {
 	statusError, ok := err.(*apierrors.StatusError)
 	if !ok {
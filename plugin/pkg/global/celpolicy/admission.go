@@ -0,0 +1,356 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package celpolicy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/admission"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+	"k8s.io/apiserver/pkg/cel/environment"
+	"k8s.io/apiserver/pkg/warning"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	plugin "github.com/gardener/gardener/plugin/pkg"
+	"github.com/gardener/gardener/plugin/pkg/global/celpolicy/apis/celpolicy"
+	"github.com/gardener/gardener/plugin/pkg/global/celpolicy/apis/celpolicy/validation"
+	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(plugin.PluginNameCELPolicy, func(cfg io.Reader) (admission.Interface, error) {
+		config, err := LoadConfiguration(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := validation.ValidateConfiguration(config); len(err) > 0 {
+			return nil, fmt.Errorf("invalid config: %+v", err)
+		}
+
+		return New(config)
+	})
+}
+
+// CELPolicy contains listers and admission handler.
+type CELPolicy struct {
+	*admission.Handler
+
+	projectLister gardencorev1beta1listers.ProjectLister
+	readyFunc     admission.ReadyFunc
+
+	policies []policy
+}
+
+type policy struct {
+	name            string
+	kinds           sets.Set[string]
+	projectSelector labels.Selector
+	enforcementMode celpolicy.EnforcementMode
+	validations     []compiledValidation
+}
+
+type compiledValidation struct {
+	expression string
+	message    string
+	program    cel.Program
+}
+
+var (
+	_ = admissioninitializer.WantsCoreInformerFactory(&CELPolicy{})
+
+	readyFuncs []admission.ReadyFunc
+)
+
+// New creates a new CELPolicy admission plugin. It compiles the CEL expressions of all policies and returns an error
+// if any of them is invalid.
+func New(config *celpolicy.Configuration) (*CELPolicy, error) {
+	env, err := newEnvironment()
+	if err != nil {
+		return nil, fmt.Errorf("failed creating CEL environment: %w", err)
+	}
+
+	policies := make([]policy, 0, len(config.Policies))
+	for _, p := range config.Policies {
+		compiled := policy{
+			name:            p.Name,
+			kinds:           sets.New(p.Kinds...),
+			enforcementMode: p.EnforcementMode,
+		}
+
+		if p.ProjectSelector != nil {
+			compiled.projectSelector, err = metav1.LabelSelectorAsSelector(p.ProjectSelector)
+			if err != nil {
+				return nil, fmt.Errorf("invalid project selector of policy %q: %w", p.Name, err)
+			}
+		}
+
+		for _, v := range p.Validations {
+			program, err := compile(env, v.Expression)
+			if err != nil {
+				return nil, fmt.Errorf("failed compiling expression %q of policy %q: %w", v.Expression, p.Name, err)
+			}
+
+			compiled.validations = append(compiled.validations, compiledValidation{
+				expression: v.Expression,
+				message:    v.Message,
+				program:    program,
+			})
+		}
+
+		policies = append(policies, compiled)
+	}
+
+	return &CELPolicy{
+		Handler:  admission.NewHandler(admission.Create, admission.Update),
+		policies: policies,
+	}, nil
+}
+
+func newEnvironment() (*cel.Env, error) {
+	envSet, err := environment.MustBaseEnvSet(environment.DefaultCompatibilityVersion()).Extend(environment.VersionedOptions{
+		IntroducedVersion: environment.DefaultCompatibilityVersion(),
+		EnvOptions: []cel.EnvOption{
+			cel.Variable("object", cel.DynType),
+			cel.Variable("oldObject", cel.DynType),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The expressions are read from the configuration file, hence, the most permissive environment is used.
+	return envSet.StoredExpressionsEnv(), nil
+}
+
+func compile(env *cel.Env, expression string) (cel.Program, error) {
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("expression must evaluate to bool but has type %s", ast.OutputType())
+	}
+
+	return env.Program(ast,
+		cel.CostLimit(celconfig.PerCallLimit),
+		cel.InterruptCheckFrequency(celconfig.CheckFrequency),
+	)
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (c *CELPolicy) AssignReadyFunc(f admission.ReadyFunc) {
+	c.readyFunc = f
+	c.SetReadyFunc(f)
+}
+
+// SetCoreInformerFactory gets Lister from SharedInformerFactory.
+func (c *CELPolicy) SetCoreInformerFactory(f gardencoreinformers.SharedInformerFactory) {
+	projectInformer := f.Core().V1beta1().Projects()
+	c.projectLister = projectInformer.Lister()
+
+	readyFuncs = append(readyFuncs, projectInformer.Informer().HasSynced)
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (c *CELPolicy) ValidateInitialization() error {
+	if c.projectLister == nil {
+		return errors.New("missing project lister")
+	}
+	return nil
+}
+
+func (c *CELPolicy) waitUntilReady(attrs admission.Attributes) error {
+	// Wait until the caches have been synced
+	if c.readyFunc == nil {
+		c.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+
+	if !c.WaitForReady() {
+		return admission.NewForbidden(attrs, errors.New("not yet ready to handle request"))
+	}
+
+	return nil
+}
+
+var _ admission.ValidationInterface = &CELPolicy{}
+
+// Validate evaluates the configured policies for Shoots, Seeds and CloudProfiles. Depending on the enforcement mode of
+// the violated policies, the request is denied, a warning is returned to the client, or an annotation is added to the
+// audit event.
+func (c *CELPolicy) Validate(ctx context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	if len(c.policies) == 0 {
+		return nil
+	}
+
+	// Ignore all subresources, e.g. status updates by gardenlet.
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	kind := a.GetKind().GroupKind()
+	if kind.Group != core.GroupName {
+		return nil
+	}
+
+	var matchingPolicies []policy
+	for _, p := range c.policies {
+		if p.kinds.Has(kind.Kind) {
+			matchingPolicies = append(matchingPolicies, p)
+		}
+	}
+	if len(matchingPolicies) == 0 {
+		return nil
+	}
+
+	if err := c.waitUntilReady(a); err != nil {
+		return fmt.Errorf("err while waiting for ready %w", err)
+	}
+
+	matchingPolicies, err := c.filterByProject(matchingPolicies, a.GetNamespace())
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	if len(matchingPolicies) == 0 {
+		return nil
+	}
+
+	activation := map[string]any{"object": nil, "oldObject": nil}
+	if activation["object"], err = toUnstructured(a.GetObject()); err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("failed converting object: %w", err))
+	}
+	if a.GetOperation() == admission.Update {
+		if activation["oldObject"], err = toUnstructured(a.GetOldObject()); err != nil {
+			return apierrors.NewInternalError(fmt.Errorf("failed converting old object: %w", err))
+		}
+	}
+
+	var denials []string
+	for _, p := range matchingPolicies {
+		violations := p.evaluate(ctx, activation)
+		if len(violations) == 0 {
+			continue
+		}
+
+		message := fmt.Sprintf("policy %q is violated: %s", p.name, strings.Join(violations, "; "))
+		switch p.enforcementMode {
+		case celpolicy.EnforcementModeWarn:
+			warning.AddWarning(ctx, "", message)
+		case celpolicy.EnforcementModeAudit:
+			if err := a.AddAnnotation(celpolicy.GroupName+"/"+p.name, strings.Join(violations, "; ")); err != nil {
+				return apierrors.NewInternalError(fmt.Errorf("failed adding audit annotation for policy %q: %w", p.name, err))
+			}
+		default:
+			denials = append(denials, message)
+		}
+	}
+
+	if len(denials) > 0 {
+		return admission.NewForbidden(a, errors.New(strings.Join(denials, ", ")))
+	}
+
+	return nil
+}
+
+func (c *CELPolicy) filterByProject(policies []policy, namespace string) ([]policy, error) {
+	var (
+		project        *gardencorev1beta1.Project
+		projectFetched bool
+		result         []policy
+	)
+
+	for _, p := range policies {
+		if p.projectSelector == nil {
+			result = append(result, p)
+			continue
+		}
+
+		// Cluster-scoped objects do not belong to any project.
+		if namespace == "" {
+			continue
+		}
+
+		if !projectFetched {
+			var err error
+			project, err = admissionutils.ProjectForNamespaceFromLister(c.projectLister, namespace)
+			if err != nil && !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("could not get project for namespace %q: %w", namespace, err)
+			}
+			projectFetched = true
+		}
+
+		if project != nil && p.projectSelector.Matches(labels.Set(project.Labels)) {
+			result = append(result, p)
+		}
+	}
+
+	return result, nil
+}
+
+func (p policy) evaluate(ctx context.Context, activation map[string]any) []string {
+	var violations []string
+
+	for _, v := range p.validations {
+		out, _, err := v.program.ContextEval(ctx, activation)
+		if err != nil {
+			violations = append(violations, fmt.Sprintf("expression %q resulted in error: %v", v.expression, err))
+			continue
+		}
+
+		if result, ok := out.Value().(bool); !ok {
+			violations = append(violations, fmt.Sprintf("expression %q did not evaluate to bool", v.expression))
+		} else if !result {
+			violations = append(violations, v.violationMessage())
+		}
+	}
+
+	return violations
+}
+
+func (v compiledValidation) violationMessage() string {
+	if v.message != "" {
+		return v.message
+	}
+	return fmt.Sprintf("failed expression: %s", v.expression)
+}
+
+// toUnstructured converts the given internal object to its unstructured representation in version
+// core.gardener.cloud/v1beta1 which is the version the expressions are written for.
+func toUnstructured(obj runtime.Object) (map[string]any, error) {
+	if obj == nil {
+		return nil, nil
+	}
+
+	external, err := api.Scheme.ConvertToVersion(obj, gardencorev1beta1.SchemeGroupVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(external)
+}
@@ -0,0 +1,281 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package celpolicy_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/warning"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	. "github.com/gardener/gardener/plugin/pkg/global/celpolicy"
+	"github.com/gardener/gardener/plugin/pkg/global/celpolicy/apis/celpolicy"
+)
+
+var _ = Describe("CELPolicy", func() {
+	Describe("#Validate", func() {
+		var (
+			ctx      context.Context
+			warnings *warningRecorder
+
+			config                    *celpolicy.Configuration
+			gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory
+
+			project *gardencorev1beta1.Project
+			shoot   *core.Shoot
+			seed    *core.Seed
+		)
+
+		BeforeEach(func() {
+			warnings = &warningRecorder{}
+			ctx = warning.WithWarningRecorder(context.TODO(), warnings)
+
+			config = &celpolicy.Configuration{
+				Policies: []celpolicy.Policy{{
+					Name:            "three-zones",
+					Kinds:           []string{"Shoot"},
+					EnforcementMode: celpolicy.EnforcementModeDeny,
+					Validations: []celpolicy.Validation{{
+						Expression: "object.spec.provider.workers.all(w, has(w.zones) && size(w.zones) >= 3)",
+						Message:    "workers must use at least 3 zones",
+					}},
+				}},
+			}
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+
+			project = &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "prod", Labels: map[string]string{"stage": "prod"}},
+				Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-prod")},
+			}
+			Expect(gardenCoreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+
+			shoot = &core.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-prod"},
+				Spec: core.ShootSpec{
+					Region: "europe-central-1",
+					Provider: core.Provider{
+						Workers: []core.Worker{{Name: "worker", Zones: []string{"a", "b", "c"}}},
+					},
+				},
+			}
+			seed = &core.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed"}}
+		})
+
+		newPlugin := func() *CELPolicy {
+			admissionHandler, err := New(config)
+			Expect(err).NotTo(HaveOccurred())
+			admissionHandler.AssignReadyFunc(func() bool { return true })
+			admissionHandler.SetCoreInformerFactory(gardenCoreInformerFactory)
+			return admissionHandler
+		}
+
+		shootAttributes := func(operation admission.Operation, oldShoot *core.Shoot) admission.Attributes {
+			var oldObj runtime.Object
+			if oldShoot != nil {
+				oldObj = oldShoot
+			}
+			return admission.NewAttributesRecord(shoot, oldObj, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", operation, nil, false, nil)
+		}
+
+		It("should allow the request if no policy is configured", func() {
+			config.Policies = nil
+			shoot.Spec.Provider.Workers[0].Zones = nil
+
+			Expect(newPlugin().Validate(ctx, shootAttributes(admission.Create, nil), nil)).To(Succeed())
+		})
+
+		It("should allow the request if the policy is satisfied", func() {
+			Expect(newPlugin().Validate(ctx, shootAttributes(admission.Create, nil), nil)).To(Succeed())
+		})
+
+		It("should deny the request if the policy is violated", func() {
+			shoot.Spec.Provider.Workers[0].Zones = []string{"a"}
+
+			err := newPlugin().Validate(ctx, shootAttributes(admission.Create, nil), nil)
+			Expect(err).To(BeForbiddenError())
+			Expect(err).To(MatchError(ContainSubstring(`policy "three-zones" is violated: workers must use at least 3 zones`)))
+		})
+
+		It("should deny the request with a default message if the expression cannot be evaluated", func() {
+			config.Policies[0].Validations = []celpolicy.Validation{{Expression: "object.spec.foo == 'bar'"}}
+
+			err := newPlugin().Validate(ctx, shootAttributes(admission.Create, nil), nil)
+			Expect(err).To(BeForbiddenError())
+			Expect(err).To(MatchError(ContainSubstring(`expression "object.spec.foo == 'bar'" resulted in error`)))
+		})
+
+		It("should use a default message if none is configured", func() {
+			config.Policies[0].Validations[0].Message = ""
+			shoot.Spec.Provider.Workers[0].Zones = []string{"a"}
+
+			Expect(newPlugin().Validate(ctx, shootAttributes(admission.Create, nil), nil)).To(MatchError(ContainSubstring("failed expression: object.spec.provider.workers.all")))
+		})
+
+		It("should provide the old object for updates", func() {
+			config.Policies[0].Validations = []celpolicy.Validation{{
+				Expression: "oldObject == null || object.spec.region == oldObject.spec.region",
+				Message:    "region must not be changed",
+			}}
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.Region = "europe-west-1"
+
+			Expect(newPlugin().Validate(ctx, shootAttributes(admission.Create, nil), nil)).To(Succeed())
+			Expect(newPlugin().Validate(ctx, shootAttributes(admission.Update, oldShoot), nil)).To(MatchError(ContainSubstring("region must not be changed")))
+		})
+
+		It("should ignore subresources", func() {
+			shoot.Spec.Provider.Workers[0].Zones = nil
+			attrs := admission.NewAttributesRecord(shoot, shoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "status", admission.Update, nil, false, nil)
+
+			Expect(newPlugin().Validate(ctx, attrs, nil)).To(Succeed())
+		})
+
+		It("should ignore kinds which are not selected by the policy", func() {
+			config.Policies[0].Kinds = []string{"Seed"}
+			shoot.Spec.Provider.Workers[0].Zones = nil
+
+			Expect(newPlugin().Validate(ctx, shootAttributes(admission.Create, nil), nil)).To(Succeed())
+		})
+
+		It("should evaluate policies for seeds", func() {
+			config.Policies[0].Kinds = []string{"Seed"}
+			config.Policies[0].Validations = []celpolicy.Validation{{Expression: "has(object.metadata.labels)", Message: "seeds must be labeled"}}
+			attrs := admission.NewAttributesRecord(seed, nil, core.Kind("Seed").WithVersion("version"), "", seed.Name, core.Resource("seeds").WithVersion("version"), "", admission.Create, nil, false, nil)
+
+			Expect(newPlugin().Validate(ctx, attrs, nil)).To(MatchError(ContainSubstring("seeds must be labeled")))
+		})
+
+		Context("project selector", func() {
+			BeforeEach(func() {
+				shoot.Spec.Provider.Workers[0].Zones = []string{"a"}
+			})
+
+			It("should evaluate the policy if the project matches", func() {
+				config.Policies[0].ProjectSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"stage": "prod"}}
+
+				Expect(newPlugin().Validate(ctx, shootAttributes(admission.Create, nil), nil)).To(BeForbiddenError())
+			})
+
+			It("should not evaluate the policy if the project does not match", func() {
+				config.Policies[0].ProjectSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"stage": "dev"}}
+
+				Expect(newPlugin().Validate(ctx, shootAttributes(admission.Create, nil), nil)).To(Succeed())
+			})
+
+			It("should not evaluate the policy for cluster-scoped objects", func() {
+				config.Policies[0].Kinds = []string{"Seed"}
+				config.Policies[0].ProjectSelector = &metav1.LabelSelector{}
+				attrs := admission.NewAttributesRecord(seed, nil, core.Kind("Seed").WithVersion("version"), "", seed.Name, core.Resource("seeds").WithVersion("version"), "", admission.Create, nil, false, nil)
+
+				Expect(newPlugin().Validate(ctx, attrs, nil)).To(Succeed())
+			})
+		})
+
+		Context("enforcement modes", func() {
+			BeforeEach(func() {
+				shoot.Spec.Provider.Workers[0].Zones = []string{"a"}
+			})
+
+			It("should return a warning in mode Warn", func() {
+				config.Policies[0].EnforcementMode = celpolicy.EnforcementModeWarn
+
+				Expect(newPlugin().Validate(ctx, shootAttributes(admission.Create, nil), nil)).To(Succeed())
+				Expect(warnings.warnings).To(ConsistOf(`policy "three-zones" is violated: workers must use at least 3 zones`))
+			})
+
+			It("should add an audit annotation in mode Audit", func() {
+				config.Policies[0].EnforcementMode = celpolicy.EnforcementModeAudit
+				attrs := &annotationRecorder{Attributes: shootAttributes(admission.Create, nil)}
+
+				Expect(newPlugin().Validate(ctx, attrs, nil)).To(Succeed())
+				Expect(warnings.warnings).To(BeEmpty())
+				Expect(attrs.annotations).To(HaveKeyWithValue(
+					"celpolicy.admission.gardener.cloud/three-zones", "workers must use at least 3 zones",
+				))
+			})
+		})
+	})
+
+	Describe("#New", func() {
+		It("should only handle CREATE and UPDATE operations", func() {
+			admissionHandler, err := New(&celpolicy.Configuration{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(admissionHandler.Handles(admission.Create)).To(BeTrue())
+			Expect(admissionHandler.Handles(admission.Update)).To(BeTrue())
+			Expect(admissionHandler.Handles(admission.Connect)).NotTo(BeTrue())
+			Expect(admissionHandler.Handles(admission.Delete)).NotTo(BeTrue())
+		})
+
+		It("should fail if an expression cannot be compiled", func() {
+			_, err := New(&celpolicy.Configuration{Policies: []celpolicy.Policy{{
+				Name:        "invalid",
+				Validations: []celpolicy.Validation{{Expression: "object.spec.("}},
+			}}})
+			Expect(err).To(MatchError(ContainSubstring(`failed compiling expression "object.spec.(" of policy "invalid"`)))
+		})
+
+		It("should fail if an expression does not evaluate to bool", func() {
+			_, err := New(&celpolicy.Configuration{Policies: []celpolicy.Policy{{
+				Name:        "invalid",
+				Validations: []celpolicy.Validation{{Expression: "'foo'"}},
+			}}})
+			Expect(err).To(MatchError(ContainSubstring("expression must evaluate to bool")))
+		})
+	})
+
+	Describe("#Register", func() {
+		It("should register the plugin", func() {
+			plugins := admission.NewPlugins()
+			Register(plugins)
+
+			registered := plugins.Registered()
+			Expect(registered).To(HaveLen(1))
+			Expect(registered).To(ContainElement("CELPolicy"))
+		})
+	})
+
+	Describe("#ValidateInitialization", func() {
+		It("should return error if no ProjectLister is set", func() {
+			admissionHandler, _ := New(&celpolicy.Configuration{})
+			Expect(admissionHandler.ValidateInitialization()).To(MatchError("missing project lister"))
+		})
+
+		It("should not return error if ProjectLister is set", func() {
+			admissionHandler, _ := New(&celpolicy.Configuration{})
+			admissionHandler.SetCoreInformerFactory(gardencoreinformers.NewSharedInformerFactory(nil, 0))
+			Expect(admissionHandler.ValidateInitialization()).To(Succeed())
+		})
+	})
+})
+
+type warningRecorder struct {
+	warnings []string
+}
+
+func (w *warningRecorder) AddWarning(_, text string) {
+	w.warnings = append(w.warnings, text)
+}
+
+type annotationRecorder struct {
+	admission.Attributes
+	annotations map[string]string
+}
+
+func (a *annotationRecorder) AddAnnotation(key, value string) error {
+	if a.annotations == nil {
+		a.annotations = map[string]string{}
+	}
+	a.annotations[key] = value
+	return nil
+}
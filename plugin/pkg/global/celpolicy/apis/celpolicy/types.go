@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package celpolicy
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Configuration provides configuration for the CELPolicy admission controller.
+type Configuration struct {
+	metav1.TypeMeta
+	// Policies is the list of policies which are evaluated for the admitted objects.
+	Policies []Policy
+}
+
+// Policy is a set of CEL validation rules for objects of certain kinds.
+type Policy struct {
+	// Name is the name of the policy.
+	Name string
+	// Kinds is the list of kinds of objects which the policy applies to. Supported kinds are `Shoot`, `Seed` and
+	// `CloudProfile`.
+	Kinds []string
+	// ProjectSelector restricts the policy to objects in projects whose labels match the selector. Seeds and
+	// CloudProfiles do not belong to a project, hence, policies with a project selector only apply to Shoots.
+	ProjectSelector *metav1.LabelSelector
+	// EnforcementMode defines how violations of the policy are handled.
+	EnforcementMode EnforcementMode
+	// Validations is the list of CEL expressions which must be satisfied by the objects the policy applies to.
+	Validations []Validation
+}
+
+// EnforcementMode is a type for the enforcement modes of policies.
+type EnforcementMode string
+
+const (
+	// EnforcementModeDeny denies requests violating the policy.
+	EnforcementModeDeny EnforcementMode = "Deny"
+	// EnforcementModeWarn admits requests violating the policy but returns a warning to the client.
+	EnforcementModeWarn EnforcementMode = "Warn"
+	// EnforcementModeAudit admits requests violating the policy but adds an annotation to the audit event.
+	EnforcementModeAudit EnforcementMode = "Audit"
+)
+
+// Validation is a CEL expression which must evaluate to true.
+type Validation struct {
+	// Expression is the CEL expression. The variables `object` and `oldObject` contain the object of the request and,
+	// for updates, the existing object in version `core.gardener.cloud/v1beta1`.
+	Expression string
+	// Message is the message reported when the expression evaluates to false.
+	Message string
+}
@@ -0,0 +1,20 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+// SetDefaults_Policy sets default values for Policy objects.
+func SetDefaults_Policy(obj *Policy) {
+	if obj.EnforcementMode == "" {
+		obj.EnforcementMode = EnforcementModeDeny
+	}
+}
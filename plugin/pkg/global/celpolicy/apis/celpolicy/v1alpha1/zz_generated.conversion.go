@@ -0,0 +1,129 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by conversion-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	unsafe "unsafe"
+
+	celpolicy "github.com/gardener/gardener/plugin/pkg/global/celpolicy/apis/celpolicy"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*Configuration)(nil), (*celpolicy.Configuration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Configuration_To_celpolicy_Configuration(a.(*Configuration), b.(*celpolicy.Configuration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*celpolicy.Configuration)(nil), (*Configuration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_celpolicy_Configuration_To_v1alpha1_Configuration(a.(*celpolicy.Configuration), b.(*Configuration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Policy)(nil), (*celpolicy.Policy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Policy_To_celpolicy_Policy(a.(*Policy), b.(*celpolicy.Policy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*celpolicy.Policy)(nil), (*Policy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_celpolicy_Policy_To_v1alpha1_Policy(a.(*celpolicy.Policy), b.(*Policy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Validation)(nil), (*celpolicy.Validation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Validation_To_celpolicy_Validation(a.(*Validation), b.(*celpolicy.Validation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*celpolicy.Validation)(nil), (*Validation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_celpolicy_Validation_To_v1alpha1_Validation(a.(*celpolicy.Validation), b.(*Validation), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_Configuration_To_celpolicy_Configuration(in *Configuration, out *celpolicy.Configuration, s conversion.Scope) error {
+	out.Policies = *(*[]celpolicy.Policy)(unsafe.Pointer(&in.Policies))
+	return nil
+}
+
+// Convert_v1alpha1_Configuration_To_celpolicy_Configuration is an autogenerated conversion function.
+func Convert_v1alpha1_Configuration_To_celpolicy_Configuration(in *Configuration, out *celpolicy.Configuration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Configuration_To_celpolicy_Configuration(in, out, s)
+}
+
+func autoConvert_celpolicy_Configuration_To_v1alpha1_Configuration(in *celpolicy.Configuration, out *Configuration, s conversion.Scope) error {
+	out.Policies = *(*[]Policy)(unsafe.Pointer(&in.Policies))
+	return nil
+}
+
+// Convert_celpolicy_Configuration_To_v1alpha1_Configuration is an autogenerated conversion function.
+func Convert_celpolicy_Configuration_To_v1alpha1_Configuration(in *celpolicy.Configuration, out *Configuration, s conversion.Scope) error {
+	return autoConvert_celpolicy_Configuration_To_v1alpha1_Configuration(in, out, s)
+}
+
+func autoConvert_v1alpha1_Policy_To_celpolicy_Policy(in *Policy, out *celpolicy.Policy, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kinds = *(*[]string)(unsafe.Pointer(&in.Kinds))
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	out.EnforcementMode = celpolicy.EnforcementMode(in.EnforcementMode)
+	out.Validations = *(*[]celpolicy.Validation)(unsafe.Pointer(&in.Validations))
+	return nil
+}
+
+// Convert_v1alpha1_Policy_To_celpolicy_Policy is an autogenerated conversion function.
+func Convert_v1alpha1_Policy_To_celpolicy_Policy(in *Policy, out *celpolicy.Policy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Policy_To_celpolicy_Policy(in, out, s)
+}
+
+func autoConvert_celpolicy_Policy_To_v1alpha1_Policy(in *celpolicy.Policy, out *Policy, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kinds = *(*[]string)(unsafe.Pointer(&in.Kinds))
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	out.EnforcementMode = EnforcementMode(in.EnforcementMode)
+	out.Validations = *(*[]Validation)(unsafe.Pointer(&in.Validations))
+	return nil
+}
+
+// Convert_celpolicy_Policy_To_v1alpha1_Policy is an autogenerated conversion function.
+func Convert_celpolicy_Policy_To_v1alpha1_Policy(in *celpolicy.Policy, out *Policy, s conversion.Scope) error {
+	return autoConvert_celpolicy_Policy_To_v1alpha1_Policy(in, out, s)
+}
+
+func autoConvert_v1alpha1_Validation_To_celpolicy_Validation(in *Validation, out *celpolicy.Validation, s conversion.Scope) error {
+	out.Expression = in.Expression
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha1_Validation_To_celpolicy_Validation is an autogenerated conversion function.
+func Convert_v1alpha1_Validation_To_celpolicy_Validation(in *Validation, out *celpolicy.Validation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Validation_To_celpolicy_Validation(in, out, s)
+}
+
+func autoConvert_celpolicy_Validation_To_v1alpha1_Validation(in *celpolicy.Validation, out *Validation, s conversion.Scope) error {
+	out.Expression = in.Expression
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_celpolicy_Validation_To_v1alpha1_Validation is an autogenerated conversion function.
+func Convert_celpolicy_Validation_To_v1alpha1_Validation(in *celpolicy.Validation, out *Validation, s conversion.Scope) error {
+	return autoConvert_celpolicy_Validation_To_v1alpha1_Validation(in, out, s)
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/plugin/pkg/global/celpolicy/apis/celpolicy"
+)
+
+var (
+	supportedKinds            = sets.New("Shoot", "Seed", "CloudProfile")
+	supportedEnforcementModes = sets.New(
+		string(celpolicy.EnforcementModeDeny),
+		string(celpolicy.EnforcementModeWarn),
+		string(celpolicy.EnforcementModeAudit),
+	)
+)
+
+// ValidateConfiguration validates the configuration.
+func ValidateConfiguration(config *celpolicy.Configuration) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	for i, policy := range config.Policies {
+		idxPath := field.NewPath("policies").Index(i)
+
+		for _, msg := range apivalidation.NameIsDNSLabel(policy.Name, false) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), policy.Name, msg))
+		}
+		if names.Has(policy.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), policy.Name))
+		}
+		names.Insert(policy.Name)
+
+		if len(policy.Kinds) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("kinds"), "at least one kind must be specified"))
+		}
+		for j, kind := range policy.Kinds {
+			if !supportedKinds.Has(kind) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("kinds").Index(j), kind, sets.List(supportedKinds)))
+			}
+		}
+
+		if policy.ProjectSelector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(policy.ProjectSelector, metav1validation.LabelSelectorValidationOptions{}, idxPath.Child("projectSelector"))...)
+		}
+
+		if !supportedEnforcementModes.Has(string(policy.EnforcementMode)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("enforcementMode"), policy.EnforcementMode, sets.List(supportedEnforcementModes)))
+		}
+
+		if len(policy.Validations) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("validations"), "at least one validation must be specified"))
+		}
+		for j, validation := range policy.Validations {
+			if len(validation.Expression) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("validations").Index(j).Child("expression"), "expression must not be empty"))
+			}
+		}
+	}
+
+	return allErrs
+}
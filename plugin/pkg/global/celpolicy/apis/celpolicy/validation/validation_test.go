@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/plugin/pkg/global/celpolicy/apis/celpolicy"
+	. "github.com/gardener/gardener/plugin/pkg/global/celpolicy/apis/celpolicy/validation"
+)
+
+var _ = Describe("Validation", func() {
+	Describe("#ValidateConfiguration", func() {
+		var config *celpolicy.Configuration
+
+		BeforeEach(func() {
+			config = &celpolicy.Configuration{
+				Policies: []celpolicy.Policy{{
+					Name:            "three-zones",
+					Kinds:           []string{"Shoot"},
+					ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"stage": "prod"}},
+					EnforcementMode: celpolicy.EnforcementModeDeny,
+					Validations: []celpolicy.Validation{{
+						Expression: "object.spec.provider.workers.all(w, size(w.zones) >= 3)",
+						Message:    "workers must use at least 3 zones",
+					}},
+				}},
+			}
+		})
+
+		It("should allow an empty configuration", func() {
+			Expect(ValidateConfiguration(&celpolicy.Configuration{})).To(BeEmpty())
+		})
+
+		It("should allow valid policies", func() {
+			Expect(ValidateConfiguration(config)).To(BeEmpty())
+		})
+
+		It("should forbid invalid and duplicate names", func() {
+			config.Policies = append(config.Policies, config.Policies[0], config.Policies[0])
+			config.Policies[2].Name = "Foo_Bar"
+
+			Expect(ValidateConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("policies[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("policies[2].name"),
+				})),
+			))
+		})
+
+		It("should forbid missing and unsupported kinds", func() {
+			config.Policies = append(config.Policies, config.Policies[0])
+			config.Policies[0].Kinds = nil
+			config.Policies[1].Name = "other"
+			config.Policies[1].Kinds = []string{"Project"}
+
+			Expect(ValidateConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("policies[0].kinds"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("policies[1].kinds[0]"),
+				})),
+			))
+		})
+
+		It("should forbid invalid project selectors", func() {
+			config.Policies[0].ProjectSelector.MatchLabels = map[string]string{"in valid": "prod"}
+
+			Expect(ValidateConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("policies[0].projectSelector.matchLabels"),
+				})),
+			))
+		})
+
+		It("should forbid unsupported enforcement modes", func() {
+			config.Policies[0].EnforcementMode = "Ignore"
+
+			Expect(ValidateConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("policies[0].enforcementMode"),
+				})),
+			))
+		})
+
+		It("should forbid missing validations and expressions", func() {
+			config.Policies = append(config.Policies, config.Policies[0])
+			config.Policies[0].Validations = nil
+			config.Policies[1].Name = "other"
+			config.Policies[1].Validations = []celpolicy.Validation{{Message: "foo"}}
+
+			Expect(ValidateConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("policies[0].validations"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("policies[1].validations[0].expression"),
+				})),
+			))
+		})
+	})
+})
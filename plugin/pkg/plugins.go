@@ -16,6 +16,8 @@ import (
 const (
 	// PluginNameBastion is the name of the Bastion admission plugin.
 	PluginNameBastion = "Bastion"
+	// PluginNameCELPolicy is the name of the CELPolicy admission plugin.
+	PluginNameCELPolicy = "CELPolicy"
 	// PluginNameControllerRegistrationResources is the name of the ControllerRegistrationResources admission plugin.
 	PluginNameControllerRegistrationResources = "ControllerRegistrationResources"
 	// PluginNameCustomVerbAuthorizer is the name of the CustomVerbAuthorizer admission plugin.
@@ -38,6 +40,8 @@ const (
 	PluginNameProjectValidator = "ProjectValidator"
 	// PluginNameSeedValidator is the name of the SeedValidator admission plugin.
 	PluginNameSeedValidator = "SeedValidator"
+	// PluginNameShootBlueprint is the name of the ShootBlueprint admission plugin.
+	PluginNameShootBlueprint = "ShootBlueprint"
 	// PluginNameShootDNS is the name of the ShootDNS admission plugin.
 	PluginNameShootDNS = "ShootDNS"
 	// PluginNameShootDNSRewriting is the name of the ShootDNSRewriting admission plugin.
@@ -68,6 +72,7 @@ const (
 func AllPluginNames() []string {
 	return []string{
 		lifecycle.PluginName,                        // NamespaceLifecycle
+		PluginNameShootBlueprint,                    // ShootBlueprint
 		PluginNameResourceReferenceManager,          // ResourceReferenceManager
 		PluginNameExtensionValidator,                // ExtensionValidator
 		PluginNameExtensionLabels,                   // ExtensionLabels
@@ -92,6 +97,7 @@ func AllPluginNames() []string {
 		PluginNameManagedSeed,                       // ManagedSeed
 		PluginNameManagedSeedShoot,                  // ManagedSeedShoot
 		PluginNameBastion,                           // Bastion
+		PluginNameCELPolicy,                         // CELPolicy
 
 		// new admission plugins should generally be inserted above here
 		// webhook, and resourcequota plugins must go at the end
@@ -110,6 +116,7 @@ func AllPluginNames() []string {
 func DefaultOnPlugins() sets.Set[string] {
 	return sets.New[string](
 		lifecycle.PluginName,                      // NamespaceLifecycle
+		PluginNameShootBlueprint,                  // ShootBlueprint
 		PluginNameResourceReferenceManager,        // ResourceReferenceManager
 		PluginNameExtensionValidator,              // ExtensionValidator
 		PluginNameExtensionLabels,                 // ExtensionLabels
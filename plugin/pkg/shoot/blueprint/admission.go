@@ -0,0 +1,353 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprint
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apiserver/pkg/admission"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	"github.com/gardener/gardener/pkg/features"
+	versionutils "github.com/gardener/gardener/pkg/utils/version"
+	plugin "github.com/gardener/gardener/plugin/pkg"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(plugin.PluginNameShootBlueprint, func(_ io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// Blueprint contains listers and admission handler.
+type Blueprint struct {
+	*admission.Handler
+
+	shootBlueprintLister gardencorev1beta1listers.ShootBlueprintLister
+	readyFunc            admission.ReadyFunc
+}
+
+var (
+	_ = admissioninitializer.WantsCoreInformerFactory(&Blueprint{})
+
+	readyFuncs []admission.ReadyFunc
+)
+
+// New creates a new Blueprint admission plugin.
+func New() (*Blueprint, error) {
+	return &Blueprint{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (b *Blueprint) AssignReadyFunc(f admission.ReadyFunc) {
+	b.readyFunc = f
+	b.SetReadyFunc(f)
+}
+
+// SetCoreInformerFactory sets the external garden core informer factory.
+func (b *Blueprint) SetCoreInformerFactory(f gardencoreinformers.SharedInformerFactory) {
+	shootBlueprintInformer := f.Core().V1beta1().ShootBlueprints()
+	b.shootBlueprintLister = shootBlueprintInformer.Lister()
+
+	readyFuncs = append(readyFuncs, shootBlueprintInformer.Informer().HasSynced)
+}
+
+func (b *Blueprint) waitUntilReady(attrs admission.Attributes) error {
+	// Wait until the caches have been synced
+	if b.readyFunc == nil {
+		b.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+
+	if !b.WaitForReady() {
+		return admission.NewForbidden(attrs, errors.New("not yet ready to handle request"))
+	}
+
+	return nil
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (b *Blueprint) ValidateInitialization() error {
+	if b.shootBlueprintLister == nil {
+		return errors.New("missing ShootBlueprint lister")
+	}
+	return nil
+}
+
+// Admit derives the specification of a Shoot from the referenced ShootBlueprint and the overrides of the Shoot.
+// The blueprint is applied when the Shoot is created, when the blueprint reference or the overrides change, and when
+// the Shoot is annotated with `gardener.cloud/operation=apply-blueprint`.
+func (b *Blueprint) Admit(_ context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	if !features.DefaultFeatureGate.Enabled(features.ShootBlueprints) {
+		return nil
+	}
+
+	if err := b.waitUntilReady(a); err != nil {
+		return fmt.Errorf("err while waiting for ready %w", err)
+	}
+
+	if a.GetKind().GroupKind() != core.Kind("Shoot") {
+		return nil
+	}
+
+	// Ignore any updates to shoot subresources.
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*core.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	var oldShoot *core.Shoot
+	if a.GetOperation() == admission.Update {
+		oldShoot, ok = a.GetOldObject().(*core.Shoot)
+		if !ok {
+			return apierrors.NewBadRequest("could not convert old resource into Shoot object")
+		}
+	}
+
+	if shoot.Spec.Blueprint == nil {
+		delete(shoot.Annotations, v1beta1constants.AnnotationShootBlueprintGeneration)
+		return nil
+	}
+
+	if !mustApplyBlueprint(shoot, oldShoot) {
+		// The applied generation is maintained by this plugin only, hence, end-users must not change it.
+		if oldGeneration, ok := oldShoot.Annotations[v1beta1constants.AnnotationShootBlueprintGeneration]; ok {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootBlueprintGeneration, oldGeneration)
+		} else {
+			delete(shoot.Annotations, v1beta1constants.AnnotationShootBlueprintGeneration)
+		}
+		return nil
+	}
+
+	shootBlueprint, err := b.shootBlueprintLister.ShootBlueprints(shoot.Namespace).Get(shoot.Spec.Blueprint.Name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return admission.NewForbidden(a, fmt.Errorf("referenced ShootBlueprint %q not found", shoot.Spec.Blueprint.Name))
+		}
+		return apierrors.NewInternalError(fmt.Errorf("could not get referenced ShootBlueprint %q: %w", shoot.Spec.Blueprint.Name, err))
+	}
+
+	if err := applyBlueprint(shoot, oldShoot, shootBlueprint); err != nil {
+		return admission.NewForbidden(a, err)
+	}
+
+	if shoot.Annotations[v1beta1constants.GardenerOperation] == v1beta1constants.ShootOperationApplyBlueprint {
+		delete(shoot.Annotations, v1beta1constants.GardenerOperation)
+	}
+
+	return nil
+}
+
+func mustApplyBlueprint(shoot, oldShoot *core.Shoot) bool {
+	if oldShoot == nil {
+		return true
+	}
+
+	if shoot.DeletionTimestamp != nil {
+		return false
+	}
+
+	return !apiequality.Semantic.DeepEqual(shoot.Spec.Blueprint, oldShoot.Spec.Blueprint) ||
+		shoot.Annotations[v1beta1constants.GardenerOperation] == v1beta1constants.ShootOperationApplyBlueprint
+}
+
+// applyBlueprint renders the template of the given ShootBlueprint including the overrides of the Shoot. On creation,
+// the rendered specification replaces the specification of the Shoot. When the blueprint is re-applied to an existing
+// Shoot, fields which are managed by Gardener or which are immutable are kept, see preserveManagedFields.
+func applyBlueprint(shoot, oldShoot *core.Shoot, shootBlueprint *gardencorev1beta1.ShootBlueprint) error {
+	template := shootBlueprint.Spec.Template.DeepCopy()
+
+	if overrides := shoot.Spec.Blueprint.Overrides; overrides != nil && len(overrides.Raw) > 0 {
+		original, err := json.Marshal(template)
+		if err != nil {
+			return fmt.Errorf("failed marshalling template of ShootBlueprint %q: %w", shootBlueprint.Name, err)
+		}
+
+		patched, err := strategicpatch.StrategicMergePatch(original, overrides.Raw, gardencorev1beta1.ShootTemplate{})
+		if err != nil {
+			return fmt.Errorf("failed applying overrides to template of ShootBlueprint %q: %w", shootBlueprint.Name, err)
+		}
+
+		template = &gardencorev1beta1.ShootTemplate{}
+		if err := json.Unmarshal(patched, template); err != nil {
+			return fmt.Errorf("failed unmarshalling template of ShootBlueprint %q with applied overrides: %w", shootBlueprint.Name, err)
+		}
+	}
+
+	derivedShoot := &gardencorev1beta1.Shoot{
+		ObjectMeta: *shoot.ObjectMeta.DeepCopy(),
+		Spec:       template.Spec,
+	}
+	api.Scheme.Default(derivedShoot)
+
+	internalShoot := &core.Shoot{}
+	if err := api.Scheme.Convert(derivedShoot, internalShoot, nil); err != nil {
+		return fmt.Errorf("failed converting Shoot derived from ShootBlueprint %q: %w", shootBlueprint.Name, err)
+	}
+
+	if oldShoot != nil {
+		if err := preserveManagedFields(&internalShoot.Spec, &shoot.Spec, &template.Spec); err != nil {
+			return fmt.Errorf("failed re-applying ShootBlueprint %q: %w", shootBlueprint.Name, err)
+		}
+	}
+
+	blueprintReference := shoot.Spec.Blueprint
+	shoot.Spec = internalShoot.Spec
+	shoot.Spec.Blueprint = blueprintReference
+
+	for key, value := range template.Labels {
+		metav1.SetMetaDataLabel(&shoot.ObjectMeta, key, value)
+	}
+	for key, value := range template.Annotations {
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, key, value)
+	}
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootBlueprintGeneration, strconv.FormatInt(shootBlueprint.Generation, 10))
+
+	return nil
+}
+
+// preserveManagedFields copies the fields which must survive a re-application of a blueprint from the current
+// specification of the Shoot into the rendered specification:
+//   - fields managed by Gardener or its users independently of the blueprint (seed assignment, DNS, hibernation),
+//   - immutable fields (region, cloud profile, secret binding, exposure class, provider type, networking ranges and the
+//     failure tolerance type of the control plane),
+//   - Kubernetes and machine image versions which are higher than the rendered ones, e.g., because the maintenance
+//     already updated them, so that re-applying a blueprint never downgrades the Shoot, and
+//   - the maintenance time window if the template does not specify one, since it is otherwise defaulted randomly.
+func preserveManagedFields(rendered, current *core.ShootSpec, template *gardencorev1beta1.ShootSpec) error {
+	rendered.SeedName = current.SeedName
+	rendered.SeedSelector = current.SeedSelector
+	rendered.SchedulerName = current.SchedulerName
+	rendered.DNS = current.DNS
+	rendered.Hibernation = current.Hibernation
+
+	rendered.Region = current.Region
+	rendered.CloudProfileName = current.CloudProfileName
+	rendered.CloudProfile = current.CloudProfile
+	rendered.SecretBindingName = current.SecretBindingName
+	rendered.ExposureClassName = current.ExposureClassName
+	rendered.Provider.Type = current.Provider.Type
+
+	if current.Networking != nil {
+		if rendered.Networking == nil {
+			rendered.Networking = &core.Networking{}
+		}
+		rendered.Networking.Type = current.Networking.Type
+		rendered.Networking.IPFamilies = current.Networking.IPFamilies
+		rendered.Networking.Pods = current.Networking.Pods
+		rendered.Networking.Services = current.Networking.Services
+		rendered.Networking.Nodes = current.Networking.Nodes
+	}
+
+	if current.ControlPlane != nil && current.ControlPlane.HighAvailability != nil {
+		if rendered.ControlPlane == nil {
+			rendered.ControlPlane = &core.ControlPlane{}
+		}
+		rendered.ControlPlane.HighAvailability = current.ControlPlane.HighAvailability
+	}
+
+	if (template.Maintenance == nil || template.Maintenance.TimeWindow == nil) && current.Maintenance != nil && current.Maintenance.TimeWindow != nil {
+		if rendered.Maintenance == nil {
+			rendered.Maintenance = &core.Maintenance{}
+		}
+		rendered.Maintenance.TimeWindow = current.Maintenance.TimeWindow
+	}
+
+	version, err := higherVersion(rendered.Kubernetes.Version, current.Kubernetes.Version)
+	if err != nil {
+		return fmt.Errorf("failed comparing Kubernetes versions: %w", err)
+	}
+	rendered.Kubernetes.Version = version
+
+	for i, worker := range rendered.Provider.Workers {
+		var currentWorker *core.Worker
+		for j := range current.Provider.Workers {
+			if current.Provider.Workers[j].Name == worker.Name {
+				currentWorker = &current.Provider.Workers[j]
+				break
+			}
+		}
+		if currentWorker == nil {
+			continue
+		}
+
+		if err := preserveWorkerVersions(&rendered.Provider.Workers[i], currentWorker); err != nil {
+			return fmt.Errorf("failed comparing versions of worker pool %q: %w", worker.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func preserveWorkerVersions(rendered, current *core.Worker) error {
+	if currentImage := current.Machine.Image; currentImage != nil {
+		switch renderedImage := rendered.Machine.Image; {
+		case renderedImage == nil:
+			rendered.Machine.Image = currentImage
+		case renderedImage.Name == currentImage.Name:
+			version, err := higherVersion(renderedImage.Version, currentImage.Version)
+			if err != nil {
+				return err
+			}
+			renderedImage.Version = version
+		}
+	}
+
+	if current.Kubernetes != nil && current.Kubernetes.Version != nil && rendered.Kubernetes != nil && rendered.Kubernetes.Version != nil {
+		version, err := higherVersion(*rendered.Kubernetes.Version, *current.Kubernetes.Version)
+		if err != nil {
+			return err
+		}
+		rendered.Kubernetes.Version = &version
+	}
+
+	return nil
+}
+
+func higherVersion(rendered, current string) (string, error) {
+	if rendered == "" || current == "" {
+		if rendered == "" {
+			return current, nil
+		}
+		return rendered, nil
+	}
+
+	currentIsHigher, err := versionutils.CompareVersions(current, ">", rendered)
+	if err != nil {
+		return "", err
+	}
+	if currentIsHigher {
+		return current, nil
+	}
+	return rendered, nil
+}
@@ -0,0 +1,299 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprint_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	"github.com/gardener/gardener/pkg/features"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/blueprint"
+)
+
+var _ = Describe("blueprint", func() {
+	Describe("#Admit", func() {
+		var (
+			ctx = context.TODO()
+
+			shoot          *core.Shoot
+			shootBlueprint *gardencorev1beta1.ShootBlueprint
+
+			admissionHandler          *Blueprint
+			gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory
+		)
+
+		BeforeEach(func() {
+			DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.ShootBlueprints, true))
+
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+
+			admissionHandler, _ = New()
+			admissionHandler.AssignReadyFunc(func() bool { return true })
+			admissionHandler.SetCoreInformerFactory(gardenCoreInformerFactory)
+
+			shoot = &core.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "garden-dev",
+				},
+				Spec: core.ShootSpec{
+					Blueprint: &core.ShootBlueprintReference{Name: "small"},
+				},
+			}
+
+			shootBlueprint = &gardencorev1beta1.ShootBlueprint{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "small",
+					Namespace:  "garden-dev",
+					Generation: 3,
+				},
+				Spec: gardencorev1beta1.ShootBlueprintSpec{
+					Template: gardencorev1beta1.ShootTemplate{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels:      map[string]string{"team": "dev"},
+							Annotations: map[string]string{"foo": "bar"},
+						},
+						Spec: gardencorev1beta1.ShootSpec{
+							CloudProfileName:  "aws",
+							Region:            "eu-west-1",
+							SecretBindingName: ptr.To("my-secret"),
+							Kubernetes:        gardencorev1beta1.Kubernetes{Version: "1.29.1"},
+							Provider: gardencorev1beta1.Provider{
+								Type: "aws",
+								Workers: []gardencorev1beta1.Worker{{
+									Name:    "worker",
+									Minimum: 1,
+									Maximum: 2,
+									Machine: gardencorev1beta1.Machine{Type: "m5.large"},
+								}},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		admit := func(operation admission.Operation, oldShoot *core.Shoot) error {
+			var oldObj runtime.Object
+			if oldShoot != nil {
+				oldObj = oldShoot
+			}
+			attrs := admission.NewAttributesRecord(shoot, oldObj, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", operation, nil, false, nil)
+			return admissionHandler.Admit(ctx, attrs, nil)
+		}
+
+		It("should do nothing because the resource is not Shoot", func() {
+			attrs := admission.NewAttributesRecord(nil, nil, core.Kind("Test").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("foos").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+			Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+		})
+
+		It("should do nothing because the feature gate is disabled", func() {
+			DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.ShootBlueprints, false))
+
+			Expect(admit(admission.Create, nil)).To(Succeed())
+			Expect(shoot.Spec.Region).To(BeEmpty())
+		})
+
+		It("should remove the generation annotation if no blueprint is referenced", func() {
+			shoot.Spec.Blueprint = nil
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootBlueprintGeneration, "1")
+
+			Expect(admit(admission.Create, nil)).To(Succeed())
+			Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationShootBlueprintGeneration))
+		})
+
+		It("should forbid the request because the referenced blueprint does not exist", func() {
+			Expect(admit(admission.Create, nil)).To(MatchError(ContainSubstring(`referenced ShootBlueprint "small" not found`)))
+		})
+
+		Context("blueprint exists", func() {
+			BeforeEach(func() {
+				Expect(gardenCoreInformerFactory.Core().V1beta1().ShootBlueprints().Informer().GetStore().Add(shootBlueprint)).To(Succeed())
+			})
+
+			It("should derive the specification from the blueprint on creation", func() {
+				Expect(admit(admission.Create, nil)).To(Succeed())
+
+				Expect(shoot.Spec.Blueprint).To(Equal(&core.ShootBlueprintReference{Name: "small"}))
+				Expect(shoot.Spec.CloudProfileName).To(Equal("aws"))
+				Expect(shoot.Spec.Region).To(Equal("eu-west-1"))
+				Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.29.1"))
+				Expect(shoot.Spec.Provider.Workers).To(ConsistOf(HaveField("Name", "worker")))
+				Expect(shoot.Spec.Networking).NotTo(BeNil(), "defaults should be applied")
+				Expect(shoot.Labels).To(HaveKeyWithValue("team", "dev"))
+				Expect(shoot.Annotations).To(And(
+					HaveKeyWithValue("foo", "bar"),
+					HaveKeyWithValue(v1beta1constants.AnnotationShootBlueprintGeneration, "3"),
+				))
+			})
+
+			It("should apply the overrides on top of the blueprint", func() {
+				shoot.Spec.Blueprint.Overrides = &runtime.RawExtension{Raw: []byte(`{"spec":{"region":"eu-central-1","provider":{"workers":[{"name":"worker","maximum":5}]}}}`)}
+
+				Expect(admit(admission.Create, nil)).To(Succeed())
+
+				Expect(shoot.Spec.Region).To(Equal("eu-central-1"))
+				Expect(shoot.Spec.Provider.Workers).To(ConsistOf(And(
+					HaveField("Name", "worker"),
+					HaveField("Minimum", int32(1)),
+					HaveField("Maximum", int32(5)),
+					HaveField("Machine.Type", "m5.large"),
+				)))
+			})
+
+			It("should forbid the request because the overrides cannot be applied", func() {
+				shoot.Spec.Blueprint.Overrides = &runtime.RawExtension{Raw: []byte(`{"spec":{"region":5}}`)}
+
+				Expect(admit(admission.Create, nil)).To(MatchError(ContainSubstring(`failed unmarshalling template of ShootBlueprint "small"`)))
+			})
+
+			Context("update", func() {
+				var oldShoot *core.Shoot
+
+				BeforeEach(func() {
+					Expect(admit(admission.Create, nil)).To(Succeed())
+					oldShoot = shoot.DeepCopy()
+					shoot = oldShoot.DeepCopy()
+
+					shootBlueprint = shootBlueprint.DeepCopy()
+					shootBlueprint.Generation = 4
+					shootBlueprint.Spec.Template.Spec.Region = "eu-north-1"
+					shootBlueprint.Spec.Template.Spec.Provider.Workers[0].Maximum = 4
+					Expect(gardenCoreInformerFactory.Core().V1beta1().ShootBlueprints().Informer().GetStore().Update(shootBlueprint)).To(Succeed())
+				})
+
+				It("should not re-apply the blueprint if the reference is unchanged", func() {
+					shoot.Spec.Kubernetes.Version = "1.29.2"
+					delete(shoot.Annotations, v1beta1constants.AnnotationShootBlueprintGeneration)
+
+					Expect(admit(admission.Update, oldShoot)).To(Succeed())
+
+					Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.29.2"))
+					Expect(shoot.Spec.Provider.Workers).To(ConsistOf(HaveField("Maximum", int32(2))))
+					Expect(shoot.Annotations).To(HaveKeyWithValue(v1beta1constants.AnnotationShootBlueprintGeneration, "3"))
+				})
+
+				It("should re-apply the blueprint if the overrides changed", func() {
+					shoot.Spec.Blueprint.Overrides = &runtime.RawExtension{Raw: []byte(`{"spec":{"kubernetes":{"version":"1.29.3"}}}`)}
+
+					Expect(admit(admission.Update, oldShoot)).To(Succeed())
+
+					Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.29.3"))
+					Expect(shoot.Spec.Provider.Workers).To(ConsistOf(HaveField("Maximum", int32(4))))
+					Expect(shoot.Annotations).To(HaveKeyWithValue(v1beta1constants.AnnotationShootBlueprintGeneration, "4"))
+				})
+
+				It("should re-apply the blueprint if the shoot is annotated accordingly", func() {
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationApplyBlueprint)
+
+					Expect(admit(admission.Update, oldShoot)).To(Succeed())
+
+					Expect(shoot.Spec.Provider.Workers).To(ConsistOf(HaveField("Maximum", int32(4))))
+					Expect(shoot.Annotations).To(HaveKeyWithValue(v1beta1constants.AnnotationShootBlueprintGeneration, "4"))
+					Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+				})
+
+				It("should keep managed and immutable fields when re-applying the blueprint", func() {
+					shoot.Spec.SeedName = ptr.To("seed")
+					shoot.Spec.DNS = &core.DNS{Domain: ptr.To("test.dev.example.com")}
+					shoot.Spec.Hibernation = &core.Hibernation{Enabled: ptr.To(true)}
+					shoot.Spec.Networking.Nodes = ptr.To("10.250.0.0/16")
+					shoot.Spec.Kubernetes.Version = "1.29.5"
+					shoot.Spec.Provider.Workers[0].Machine.Image = &core.ShootMachineImage{Name: "gardenlinux", Version: "1443.3.0"}
+					oldShoot = shoot.DeepCopy()
+
+					shootBlueprint.Spec.Template.Spec.Provider.Workers[0].Machine.Image = &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("1312.3.0")}
+					Expect(gardenCoreInformerFactory.Core().V1beta1().ShootBlueprints().Informer().GetStore().Update(shootBlueprint)).To(Succeed())
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationApplyBlueprint)
+
+					Expect(admit(admission.Update, oldShoot)).To(Succeed())
+
+					Expect(shoot.Spec.Region).To(Equal("eu-west-1"), "the region is immutable")
+					Expect(shoot.Spec.SeedName).To(PointTo(Equal("seed")))
+					Expect(shoot.Spec.DNS).To(Equal(oldShoot.Spec.DNS))
+					Expect(shoot.Spec.Hibernation).To(Equal(oldShoot.Spec.Hibernation))
+					Expect(shoot.Spec.Networking.Nodes).To(PointTo(Equal("10.250.0.0/16")))
+					Expect(shoot.Spec.Maintenance.TimeWindow).To(Equal(oldShoot.Spec.Maintenance.TimeWindow))
+					Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.29.5"), "versions must not be downgraded")
+					Expect(shoot.Spec.Provider.Workers).To(ConsistOf(And(
+						HaveField("Maximum", int32(4)),
+						HaveField("Machine.Image", &core.ShootMachineImage{Name: "gardenlinux", Version: "1443.3.0"}),
+					)))
+				})
+
+				It("should update versions when the blueprint specifies higher ones", func() {
+					shoot.Spec.Provider.Workers[0].Machine.Image = &core.ShootMachineImage{Name: "gardenlinux", Version: "1312.3.0"}
+					oldShoot = shoot.DeepCopy()
+
+					shootBlueprint.Spec.Template.Spec.Kubernetes.Version = "1.30.0"
+					shootBlueprint.Spec.Template.Spec.Provider.Workers[0].Machine.Image = &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("1443.3.0")}
+					Expect(gardenCoreInformerFactory.Core().V1beta1().ShootBlueprints().Informer().GetStore().Update(shootBlueprint)).To(Succeed())
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationApplyBlueprint)
+
+					Expect(admit(admission.Update, oldShoot)).To(Succeed())
+
+					Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.30.0"))
+					Expect(shoot.Spec.Provider.Workers).To(ConsistOf(HaveField("Machine.Image", &core.ShootMachineImage{Name: "gardenlinux", Version: "1443.3.0"})))
+				})
+
+				It("should not re-apply the blueprint if the shoot is being deleted", func() {
+					shoot.DeletionTimestamp = &metav1.Time{}
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationApplyBlueprint)
+
+					Expect(admit(admission.Update, oldShoot)).To(Succeed())
+
+					Expect(shoot.Spec.Provider.Workers).To(ConsistOf(HaveField("Maximum", int32(2))))
+				})
+			})
+		})
+	})
+
+	Describe("#Register", func() {
+		It("should register the plugin", func() {
+			plugins := admission.NewPlugins()
+			Register(plugins)
+
+			registered := plugins.Registered()
+			Expect(registered).To(HaveLen(1))
+			Expect(registered).To(ContainElement("ShootBlueprint"))
+		})
+	})
+
+	Describe("#New", func() {
+		It("should only handle CREATE and UPDATE operations", func() {
+			admissionHandler, err := New()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(admissionHandler.Handles(admission.Create)).To(BeTrue())
+			Expect(admissionHandler.Handles(admission.Update)).To(BeTrue())
+			Expect(admissionHandler.Handles(admission.Connect)).NotTo(BeTrue())
+			Expect(admissionHandler.Handles(admission.Delete)).NotTo(BeTrue())
+		})
+	})
+
+	Describe("#ValidateInitialization", func() {
+		It("should return error if no ShootBlueprintLister is set", func() {
+			admissionHandler, _ := New()
+			Expect(admissionHandler.ValidateInitialization()).To(MatchError("missing ShootBlueprint lister"))
+		})
+
+		It("should not return error if ShootBlueprintLister is set", func() {
+			admissionHandler, _ := New()
+			admissionHandler.SetCoreInformerFactory(gardencoreinformers.NewSharedInformerFactory(nil, 0))
+			Expect(admissionHandler.ValidateInitialization()).To(Succeed())
+		})
+	})
+})
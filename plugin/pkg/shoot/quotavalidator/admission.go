@@ -14,9 +14,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/warning"
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -26,20 +26,11 @@ import (
 	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	timeutils "github.com/gardener/gardener/pkg/utils/time"
 	plugin "github.com/gardener/gardener/plugin/pkg"
 )
 
-var (
-	quotaMetricNames = [6]corev1.ResourceName{
-		core.QuotaMetricCPU,
-		core.QuotaMetricGPU,
-		core.QuotaMetricMemory,
-		core.QuotaMetricStorageStandard,
-		core.QuotaMetricStoragePremium,
-		core.QuotaMetricLoadbalancer}
-)
-
 // Register registers a plugin.
 func Register(plugins *admission.Plugins) {
 	plugins.Register(plugin.PluginNameShootQuotaValidator, func(_ io.Reader) (admission.Interface, error) {
@@ -115,7 +106,7 @@ func (q *QuotaValidator) ValidateInitialization() error {
 var _ admission.ValidationInterface = &QuotaValidator{}
 
 // Validate checks that the requested Shoot resources do not exceed the quota limits.
-func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+func (q *QuotaValidator) Validate(ctx context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
 	// Wait until the caches have been synced
 	if q.readyFunc == nil {
 		q.AssignReadyFunc(func() bool {
@@ -194,16 +185,16 @@ func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ a
 			}
 
 			if checkQuota {
-				exceededMetrics, err := q.isQuotaExceeded(*shoot, *quota)
+				requiredResources, err := q.determineRequiredResources(*quota, *shoot)
 				if err != nil {
 					return apierrors.NewInternalError(err)
 				}
-				if exceededMetrics != nil {
-					message := ""
-					for _, metric := range *exceededMetrics {
-						message = message + metric.String() + " "
-					}
-					return admission.NewForbidden(a, fmt.Errorf("quota limits exceeded. Unable to allocate further %s", message))
+				if exceededMetrics := gardenerutils.ExceededQuotaMetrics(quota.Spec.Metrics, requiredResources); len(exceededMetrics) > 0 {
+					return admission.NewForbidden(a, fmt.Errorf("quota limits exceeded. Unable to allocate further %s", joinMetrics(exceededMetrics)))
+				}
+
+				if exceededMetrics := gardenerutils.ExceededQuotaMetrics(quota.Spec.SoftLimits, requiredResources); len(exceededMetrics) > 0 {
+					warning.AddWarning(ctx, "", fmt.Sprintf("soft limits of quota %s/%s exceeded for %s", quota.Namespace, quota.Name, joinMetrics(exceededMetrics)))
 				}
 			}
 		}
@@ -230,59 +221,39 @@ func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ a
 	return nil
 }
 
-func (q *QuotaValidator) isQuotaExceeded(shoot core.Shoot, quota gardencorev1beta1.Quota) (*[]corev1.ResourceName, error) {
-	allocatedResources, err := q.determineAllocatedResources(quota, shoot)
-	if err != nil {
-		return nil, err
-	}
-	requiredResources, err := q.determineRequiredResources(allocatedResources, shoot)
+// determineRequiredResources returns the resources allocated by all Shoots referring the given Quota including the
+// given Shoot.
+func (q *QuotaValidator) determineRequiredResources(quota gardencorev1beta1.Quota, shoot core.Shoot) (corev1.ResourceList, error) {
+	shoots, err := q.findShootsReferQuota(quota, shoot)
 	if err != nil {
 		return nil, err
 	}
 
-	exceededMetrics := make([]corev1.ResourceName, 0)
-	for _, metric := range quotaMetricNames {
-		if _, ok := quota.Spec.Metrics[metric]; !ok {
-			continue
-		}
-		if !hasSufficientQuota(quota.Spec.Metrics[metric], requiredResources[metric]) {
-			exceededMetrics = append(exceededMetrics, metric)
-		}
-	}
-	if len(exceededMetrics) != 0 {
-		return &exceededMetrics, nil
-	}
-	return nil, nil
-}
-
-func (q *QuotaValidator) determineAllocatedResources(quota gardencorev1beta1.Quota, shoot core.Shoot) (corev1.ResourceList, error) {
-	shoots, err := q.findShootsReferQuota(quota, shoot)
-	if err != nil {
+	v1beta1Shoot := &gardencorev1beta1.Shoot{}
+	if err := gardencorev1beta1.Convert_core_Shoot_To_v1beta1_Shoot(&shoot, v1beta1Shoot, nil); err != nil {
 		return nil, err
 	}
+	shoots = append(shoots, v1beta1Shoot)
 
 	// Collect the resources which are allocated according to the shoot specs
-	allocatedResources := make(corev1.ResourceList)
+	resourceLists := make([]corev1.ResourceList, 0, len(shoots))
 	for _, s := range shoots {
 		shootResources, err := q.getShootResources(s)
 		if err != nil {
 			return nil, err
 		}
-
-		for _, metric := range quotaMetricNames {
-			allocatedResources[metric] = sumQuantity(allocatedResources[metric], shootResources[metric])
-		}
+		resourceLists = append(resourceLists, shootResources)
 	}
 
 	// TODO: We have to determine and add the amount of storage, which is allocated by manually created persistent volumes
 	// and the count of loadbalancer, which are created due to manually created services of type loadbalancer
 
-	return allocatedResources, nil
+	return gardenerutils.SumQuotaResources(resourceLists...), nil
 }
 
-func (q *QuotaValidator) findShootsReferQuota(quota gardencorev1beta1.Quota, shoot core.Shoot) ([]core.Shoot, error) {
+func (q *QuotaValidator) findShootsReferQuota(quota gardencorev1beta1.Quota, shoot core.Shoot) ([]*gardencorev1beta1.Shoot, error) {
 	var (
-		shootsReferQuota []core.Shoot
+		shootsReferQuota []*gardencorev1beta1.Shoot
 		secretBindings   []gardencorev1beta1.SecretBinding
 	)
 
@@ -319,135 +290,20 @@ func (q *QuotaValidator) findShootsReferQuota(quota gardencorev1beta1.Quota, sho
 				continue
 			}
 			if ptr.Deref(s.Spec.SecretBindingName, "") == binding.Name {
-				coreShoot := &core.Shoot{}
-				if err := gardencorev1beta1.Convert_v1beta1_Shoot_To_core_Shoot(s, coreShoot, nil); err != nil {
-					return nil, apierrors.NewInternalError(err)
-				}
-
-				shootsReferQuota = append(shootsReferQuota, *coreShoot)
+				shootsReferQuota = append(shootsReferQuota, s)
 			}
 		}
 	}
 	return shootsReferQuota, nil
 }
 
-func (q *QuotaValidator) determineRequiredResources(allocatedResources corev1.ResourceList, shoot core.Shoot) (corev1.ResourceList, error) {
-	shootResources, err := q.getShootResources(shoot)
-	if err != nil {
-		return nil, err
-	}
-
-	requiredResources := make(corev1.ResourceList)
-	for _, metric := range quotaMetricNames {
-		requiredResources[metric] = sumQuantity(allocatedResources[metric], shootResources[metric])
-	}
-	return requiredResources, nil
-}
-
-func (q *QuotaValidator) getShootResources(shoot core.Shoot) (corev1.ResourceList, error) {
+func (q *QuotaValidator) getShootResources(shoot *gardencorev1beta1.Shoot) (corev1.ResourceList, error) {
 	cloudProfile, err := q.cloudProfileLister.Get(shoot.Spec.CloudProfileName)
 	if err != nil {
 		return nil, apierrors.NewInternalError(fmt.Errorf("could not find referenced cloud profile: %+v", err.Error()))
 	}
 
-	var (
-		countLB      int64 = 1
-		resources          = make(corev1.ResourceList)
-		workers            = getShootWorkerResources(&shoot, cloudProfile)
-		machineTypes       = cloudProfile.Spec.MachineTypes
-		volumeTypes        = cloudProfile.Spec.VolumeTypes
-	)
-
-	for _, worker := range workers {
-		var (
-			machineType *gardencorev1beta1.MachineType
-			volumeType  *gardencorev1beta1.VolumeType
-		)
-
-		// Get the proper machineType
-		for _, e := range machineTypes {
-			element := e
-			if element.Name == worker.Machine.Type {
-				machineType = &element
-				break
-			}
-		}
-		if machineType == nil {
-			return nil, fmt.Errorf("machineType %s not found in CloudProfile %s", worker.Machine.Type, cloudProfile.Name)
-		}
-
-		if worker.Volume != nil {
-			if machineType.Storage != nil {
-				volumeType = &gardencorev1beta1.VolumeType{
-					Class: machineType.Storage.Class,
-				}
-			} else {
-				// Get the proper VolumeType
-				for _, e := range volumeTypes {
-					element := e
-					if worker.Volume.Type != nil && element.Name == *worker.Volume.Type {
-						volumeType = &element
-						break
-					}
-				}
-			}
-		}
-		if volumeType == nil {
-			return nil, fmt.Errorf("VolumeType %s not found in CloudProfile %s", worker.Machine.Type, cloudProfile.Name)
-		}
-
-		// For now we always use the max. amount of resources for quota calculation
-		resources[core.QuotaMetricCPU] = sumQuantity(resources[core.QuotaMetricCPU], multiplyQuantity(machineType.CPU, worker.Maximum))
-		resources[core.QuotaMetricGPU] = sumQuantity(resources[core.QuotaMetricGPU], multiplyQuantity(machineType.GPU, worker.Maximum))
-		resources[core.QuotaMetricMemory] = sumQuantity(resources[core.QuotaMetricMemory], multiplyQuantity(machineType.Memory, worker.Maximum))
-
-		size, _ := resource.ParseQuantity("0Gi")
-		if worker.Volume != nil {
-			size, err = resource.ParseQuantity(worker.Volume.VolumeSize)
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		switch volumeType.Class {
-		case core.VolumeClassStandard:
-			resources[core.QuotaMetricStorageStandard] = sumQuantity(resources[core.QuotaMetricStorageStandard], multiplyQuantity(size, worker.Maximum))
-		case core.VolumeClassPremium:
-			resources[core.QuotaMetricStoragePremium] = sumQuantity(resources[core.QuotaMetricStoragePremium], multiplyQuantity(size, worker.Maximum))
-		default:
-			return nil, fmt.Errorf("unknown volumeType class %s", volumeType.Class)
-		}
-	}
-
-	if helper.NginxIngressEnabled(shoot.Spec.Addons) {
-		countLB++
-	}
-	resources[core.QuotaMetricLoadbalancer] = *resource.NewQuantity(countLB, resource.DecimalSI)
-
-	return resources, nil
-}
-
-func getShootWorkerResources(shoot *core.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) []core.Worker {
-	workers := make([]core.Worker, 0, len(shoot.Spec.Provider.Workers))
-
-	for _, worker := range shoot.Spec.Provider.Workers {
-		workerCopy := worker.DeepCopy()
-
-		if worker.Volume == nil {
-			for _, machineType := range cloudProfile.Spec.MachineTypes {
-				if worker.Machine.Type == machineType.Name && machineType.Storage != nil && machineType.Storage.StorageSize != nil {
-					workerCopy.Volume = &core.Volume{
-						Type:       &machineType.Storage.Type,
-						VolumeSize: machineType.Storage.StorageSize.String(),
-					}
-				}
-			}
-		}
-
-		workers = append(workers, *workerCopy)
-	}
-
-	return workers
+	return gardenerutils.ComputeShootQuotaResources(shoot, cloudProfile)
 }
 
 func lifetimeVerificationNeeded(new, old core.Shoot) bool {
@@ -472,7 +328,7 @@ func quotaVerificationNeeded(new, old core.Shoot) bool {
 			if worker.Name == oldWorker.Name {
 				oldHasWorker = true
 
-				if worker.Machine.Type != oldWorker.Machine.Type || worker.Maximum != oldWorker.Maximum || !apiequality.Semantic.DeepEqual(worker.Volume, oldWorker.Volume) {
+				if worker.Machine.Type != oldWorker.Machine.Type || worker.Maximum != oldWorker.Maximum || !apiequality.Semantic.DeepEqual(worker.MaxSurge, oldWorker.MaxSurge) || !apiequality.Semantic.DeepEqual(worker.Volume, oldWorker.Volume) {
 					return true
 				}
 			}
@@ -486,23 +342,10 @@ func quotaVerificationNeeded(new, old core.Shoot) bool {
 	return false
 }
 
-func hasSufficientQuota(limit, required resource.Quantity) bool {
-	compareCode := limit.Cmp(required)
-	return compareCode != -1
-}
-
-func sumQuantity(values ...resource.Quantity) resource.Quantity {
-	res := resource.Quantity{}
-	for _, v := range values {
-		res.Add(v)
-	}
-	return res
-}
-
-func multiplyQuantity(quantity resource.Quantity, multiplier int32) resource.Quantity {
-	res := resource.Quantity{}
-	for i := 0; i < int(multiplier); i++ {
-		res.Add(quantity)
+func joinMetrics(metrics []corev1.ResourceName) string {
+	message := ""
+	for _, metric := range metrics {
+		message = message + metric.String() + " "
 	}
-	return res
+	return message
 }
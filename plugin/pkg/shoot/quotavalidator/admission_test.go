@@ -14,7 +14,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/warning"
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -339,6 +341,89 @@ var _ = Describe("quotavalidator", func() {
 					Expect(err.Error()).To(ContainSubstring("quota limits exceeded"))
 				})
 			})
+
+			Context("count based metrics", func() {
+				It("should fail because the number of shoots is exceeded", func() {
+					shoot2 := *versionedShootBase.DeepCopy()
+					shoot2.Name = "test-shoot-2"
+					Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(&shoot2)).To(Succeed())
+
+					quotaSecret.Spec.Metrics = corev1.ResourceList{core.QuotaMetricShoots: resource.MustParse("1")}
+					quotaProject.Spec.Metrics = nil
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring("quota limits exceeded. Unable to allocate further shoots")))
+				})
+
+				It("should fail because the number of nodes is exceeded", func() {
+					shoot.Spec.Provider.Workers = workersBase2
+					quotaProject.Spec.Metrics = corev1.ResourceList{core.QuotaMetricNodes: resource.MustParse("1")}
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring("quota limits exceeded. Unable to allocate further nodes")))
+				})
+
+				It("should fail because the maxSurge headroom is exceeded", func() {
+					shoot.Spec.Provider.Workers[0].Maximum = 4
+					shoot.Spec.Provider.Workers[0].MaxSurge = ptr.To(intstr.FromString("50%"))
+					quotaProject.Spec.Metrics = corev1.ResourceList{core.QuotaMetricMaxSurge: resource.MustParse("1")}
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring("quota limits exceeded. Unable to allocate further maxsurge")))
+				})
+
+				It("should verify the quota if the maxSurge of a worker pool was changed", func() {
+					oldShoot = *shoot.DeepCopy()
+					quotaProject.Spec.Metrics = corev1.ResourceList{core.QuotaMetricMaxSurge: resource.MustParse("1")}
+
+					shoot.Spec.Provider.Workers[0].MaxSurge = ptr.To(intstr.FromInt32(2))
+					attrs := admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring("quota limits exceeded")))
+				})
+			})
+
+			Context("soft limits", func() {
+				var (
+					ctx      context.Context
+					warnings *warningRecorder
+				)
+
+				BeforeEach(func() {
+					warnings = &warningRecorder{}
+					ctx = warning.WithWarningRecorder(context.TODO(), warnings)
+				})
+
+				It("should pass with a warning because a soft limit is exceeded", func() {
+					quotaProject.Spec.SoftLimits = corev1.ResourceList{"cpu": resource.MustParse("1"), "nodes": resource.MustParse("5")}
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+					Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+					Expect(warnings.warnings).To(ConsistOf("soft limits of quota trial/project-quota exceeded for cpu "))
+				})
+
+				It("should pass without warning because no soft limit is exceeded", func() {
+					quotaProject.Spec.SoftLimits = corev1.ResourceList{"cpu": resource.MustParse("2")}
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+					Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+					Expect(warnings.warnings).To(BeEmpty())
+				})
+
+				It("should fail because the hard limit is exceeded as well", func() {
+					shoot.Spec.Provider.Workers[0].Maximum = 2
+					quotaProject.Spec.SoftLimits = corev1.ResourceList{"cpu": resource.MustParse("1")}
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+					Expect(admissionHandler.Validate(ctx, attrs, nil)).To(MatchError(ContainSubstring("quota limits exceeded")))
+				})
+			})
 		})
 
 		Context("tests for Quota validation corner cases", func() {
@@ -449,3 +534,11 @@ var _ = Describe("quotavalidator", func() {
 		})
 	})
 })
+
+type warningRecorder struct {
+	warnings []string
+}
+
+func (w *warningRecorder) AddWarning(_, text string) {
+	w.warnings = append(w.warnings, text)
+}
@@ -15,6 +15,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/utils"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
@@ -309,6 +310,19 @@ var _ = Describe("Project Stale controller tests", func() {
 			Eventually(func() error {
 				return testClient.Get(ctx, client.ObjectKeyFromObject(project), project)
 			}).Should(BeNotFoundError())
+
+			By("Ensure deletion was recorded")
+			configMapList := &corev1.ConfigMapList{}
+			Expect(testClient.List(ctx, configMapList, client.InNamespace(v1beta1constants.GardenNamespace), client.MatchingLabels{
+				v1beta1constants.LabelProjectDeletionAudit: "true",
+				v1beta1constants.ProjectName:               project.Name,
+			})).To(Succeed())
+			Expect(configMapList.Items).To(HaveLen(1))
+			Expect(configMapList.Items[0].Data).To(HaveKeyWithValue("namespace", testNamespace.Name))
+
+			DeferCleanup(func() {
+				Expect(testClient.Delete(ctx, &configMapList.Items[0])).To(Succeed())
+			})
 		})
 	})
 })
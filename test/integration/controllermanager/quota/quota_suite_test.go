@@ -107,7 +107,7 @@ var _ = BeforeSuite(func() {
 		Config: config.QuotaControllerConfiguration{
 			ConcurrentSyncs: ptr.To(5),
 		},
-	}).AddToManager(mgr)).To(Succeed())
+	}).AddToManager(ctx, mgr)).To(Succeed())
 
 	By("Start manager")
 	mgrContext, mgrCancel := context.WithCancel(ctx)
@@ -369,6 +369,111 @@ var _ = Describe("Shoot Maintenance controller tests", func() {
 		})
 	})
 
+	Context("maintenance freeze periods", func() {
+		BeforeEach(func() {
+			By("Create Project with active maintenance freeze period")
+			project := &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "test-",
+				},
+				Spec: gardencorev1beta1.ProjectSpec{
+					Namespace: &testNamespace.Name,
+					Maintenance: &gardencorev1beta1.ProjectMaintenance{
+						FreezePeriods: []gardencorev1beta1.MaintenanceFreezePeriod{{
+							Name:  "end-of-quarter",
+							Begin: &metav1.Time{Time: fakeClock.Now().Add(-time.Hour)},
+							End:   &metav1.Time{Time: fakeClock.Now().Add(time.Hour)},
+						}},
+					},
+				},
+			}
+			Expect(testClient.Create(ctx, project)).To(Succeed())
+			log.Info("Created Project for test", "project", client.ObjectKeyFromObject(project))
+
+			DeferCleanup(func() {
+				By("Delete Project")
+				Expect(client.IgnoreNotFound(testClient.Delete(ctx, project))).To(Succeed())
+			})
+
+			By("Enable automatic Kubernetes version updates")
+			patch := client.MergeFrom(shoot.DeepCopy())
+			shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = true
+			Expect(testClient.Patch(ctx, shoot, patch)).To(Succeed())
+		})
+
+		It("should skip the maintenance of Shoots confining spec update rollouts", func() {
+			By("Move maintenance time window to now")
+			patch := client.MergeFrom(shoot.DeepCopy())
+			shoot.Spec.Maintenance.ConfineSpecUpdateRollout = ptr.To(true)
+			shoot.Spec.Maintenance.TimeWindow = &gardencorev1beta1.MaintenanceTimeWindow{
+				Begin: timewindow.NewMaintenanceTime(fakeClock.Now().UTC().Add(-time.Hour).Hour(), 0, 0).Formatted(),
+				End:   timewindow.NewMaintenanceTime(fakeClock.Now().UTC().Add(2*time.Hour).Hour(), 0, 0).Formatted(),
+			}
+			Expect(testClient.Patch(ctx, shoot, patch)).To(Succeed())
+
+			Eventually(func(g Gomega) {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+				g.Expect(shoot.Status.LastMaintenance).NotTo(BeNil())
+				g.Expect(shoot.Status.LastMaintenance.Description).To(ContainSubstring(`Maintenance skipped due to active maintenance freeze period(s) "end-of-quarter"`))
+				g.Expect(shoot.Status.LastMaintenance.State).To(Equal(gardencorev1beta1.LastOperationStatePending))
+			}).Should(Succeed())
+
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal(testKubernetesVersionLowPatchLowMinor.Version))
+			Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+		})
+
+		It("should not update versions of other Shoots", func() {
+			By("Move maintenance time window to now")
+			patch := client.MergeFrom(shoot.DeepCopy())
+			shoot.Spec.Maintenance.TimeWindow = &gardencorev1beta1.MaintenanceTimeWindow{
+				Begin: timewindow.NewMaintenanceTime(fakeClock.Now().UTC().Add(-time.Hour).Hour(), 0, 0).Formatted(),
+				End:   timewindow.NewMaintenanceTime(fakeClock.Now().UTC().Add(2*time.Hour).Hour(), 0, 0).Formatted(),
+			}
+			Expect(testClient.Patch(ctx, shoot, patch)).To(Succeed())
+
+			Eventually(func(g Gomega) {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+				g.Expect(shoot.Status.LastMaintenance).NotTo(BeNil())
+				g.Expect(shoot.Status.LastMaintenance.Description).To(ContainSubstring(`Automatic updates of Kubernetes and machine image versions skipped due to active maintenance freeze period(s) "end-of-quarter"`))
+				g.Expect(shoot.Status.LastMaintenance.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+			}).Should(Succeed())
+
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal(testKubernetesVersionLowPatchLowMinor.Version))
+		})
+
+		It("should perform forced updates of expired versions of other Shoots", func() {
+			By("Expire Shoot's kubernetes version in the CloudProfile")
+			Expect(patchCloudProfileForKubernetesVersionMaintenance(ctx, testClient, shoot.Spec.CloudProfileName, testKubernetesVersionLowPatchLowMinor.Version, &expirationDateInThePast, &deprecatedClassification)).To(Succeed())
+
+			By("Wait until manager has observed the CloudProfile update")
+			waitKubernetesVersionToBeExpiredInCloudProfile(shoot.Spec.CloudProfileName, testKubernetesVersionLowPatchLowMinor.Version, &expirationDateInThePast)
+
+			By("Move maintenance time window to now")
+			patch := client.MergeFrom(shoot.DeepCopy())
+			shoot.Spec.Maintenance.TimeWindow = &gardencorev1beta1.MaintenanceTimeWindow{
+				Begin: timewindow.NewMaintenanceTime(fakeClock.Now().UTC().Add(-time.Hour).Hour(), 0, 0).Formatted(),
+				End:   timewindow.NewMaintenanceTime(fakeClock.Now().UTC().Add(2*time.Hour).Hour(), 0, 0).Formatted(),
+			}
+			Expect(testClient.Patch(ctx, shoot, patch)).To(Succeed())
+
+			Eventually(func(g Gomega) string {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+				g.Expect(shoot.Status.LastMaintenance).NotTo(BeNil())
+				g.Expect(shoot.Status.LastMaintenance.Description).To(ContainSubstring("Control Plane: Updated Kubernetes version from \"0.0.1\" to \"0.0.5\". Reason: Kubernetes version expired - force update required"))
+				return shoot.Spec.Kubernetes.Version
+			}).Should(Equal(testKubernetesVersionHighestPatchLowMinor.Version))
+		})
+
+		It("should perform an explicitly requested maintenance", func() {
+			Expect(kubernetesutils.SetAnnotationAndUpdate(ctx, testClient, shoot, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationMaintain)).To(Succeed())
+
+			Eventually(func(g Gomega) string {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+				return shoot.Spec.Kubernetes.Version
+			}).Should(Equal(testKubernetesVersionHighestPatchLowMinor.Version))
+		})
+	})
+
 	It("should add task annotations", func() {
 		By("Trigger maintenance")
 		Expect(kubernetesutils.SetAnnotationAndUpdate(ctx, testClient, shoot, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationMaintain)).To(Succeed())
@@ -926,6 +1031,34 @@ var _ = Describe("Shoot Maintenance controller tests", func() {
 				}).Should(Equal(testKubernetesVersionHighestPatchLowMinor.Version))
 			})
 
+			It("Kubernetes version should not be updated: pre-update checks fail", func() {
+				// set test specific shoot settings
+				patch := client.MergeFrom(shoot.DeepCopy())
+				shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = true
+				Expect(testClient.Patch(ctx, shoot, patch)).To(Succeed())
+
+				patch = client.MergeFrom(shoot.DeepCopy())
+				shoot.Status.Conditions = []gardencorev1beta1.Condition{{
+					Type:               gardencorev1beta1.ShootControlPlaneHealthy,
+					Status:             gardencorev1beta1.ConditionFalse,
+					LastTransitionTime: metav1.Time{Time: fakeClock.Now()},
+					LastUpdateTime:     metav1.Time{Time: fakeClock.Now()},
+					Reason:             "DeploymentUnhealthy",
+					Message:            "Deployment kube-apiserver is unhealthy",
+				}}
+				Expect(testClient.Status().Patch(ctx, shoot, patch)).To(Succeed())
+
+				Expect(kubernetesutils.SetAnnotationAndUpdate(ctx, testClient, shoot, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationMaintain)).To(Succeed())
+
+				Eventually(func(g Gomega) {
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					g.Expect(shoot.Status.LastMaintenance).NotTo(BeNil())
+					g.Expect(shoot.Status.LastMaintenance.State).To(Equal(gardencorev1beta1.LastOperationStateFailed))
+					g.Expect(shoot.Status.LastMaintenance.FailureReason).To(HaveValue(ContainSubstring(`Control Plane: Kubernetes maintenance failure due to: update to version "0.0.5" blocked by failed pre-update checks: condition "ControlPlaneHealthy" has status "False": Deployment kube-apiserver is unhealthy`)))
+				}).Should(Succeed())
+				Expect(shoot.Spec.Kubernetes.Version).To(Equal(testKubernetesVersionLowPatchLowMinor.Version))
+			})
+
 			It("Kubernetes version should be updated: force update patch version", func() {
 				By("Expire Shoot's kubernetes version in the CloudProfile")
 				Expect(patchCloudProfileForKubernetesVersionMaintenance(ctx, testClient, shoot.Spec.CloudProfileName, testKubernetesVersionLowPatchLowMinor.Version, &expirationDateInThePast, &deprecatedClassification)).To(Succeed())
@@ -945,6 +1078,35 @@ var _ = Describe("Shoot Maintenance controller tests", func() {
 				}).Should(Equal(testKubernetesVersionHighestPatchLowMinor.Version))
 			})
 
+			It("Kubernetes version should be updated: force update patch version although pre-update checks fail", func() {
+				patch := client.MergeFrom(shoot.DeepCopy())
+				shoot.Status.Conditions = []gardencorev1beta1.Condition{{
+					Type:               gardencorev1beta1.ShootControlPlaneHealthy,
+					Status:             gardencorev1beta1.ConditionFalse,
+					LastTransitionTime: metav1.Time{Time: fakeClock.Now()},
+					LastUpdateTime:     metav1.Time{Time: fakeClock.Now()},
+					Reason:             "DeploymentUnhealthy",
+					Message:            "Deployment kube-apiserver is unhealthy",
+				}}
+				Expect(testClient.Status().Patch(ctx, shoot, patch)).To(Succeed())
+
+				By("Expire Shoot's kubernetes version in the CloudProfile")
+				Expect(patchCloudProfileForKubernetesVersionMaintenance(ctx, testClient, shoot.Spec.CloudProfileName, testKubernetesVersionLowPatchLowMinor.Version, &expirationDateInThePast, &deprecatedClassification)).To(Succeed())
+
+				By("Wait until manager has observed the CloudProfile update")
+				waitKubernetesVersionToBeExpiredInCloudProfile(shoot.Spec.CloudProfileName, testKubernetesVersionLowPatchLowMinor.Version, &expirationDateInThePast)
+
+				Expect(kubernetesutils.SetAnnotationAndUpdate(ctx, testClient, shoot, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationMaintain)).To(Succeed())
+
+				Eventually(func(g Gomega) string {
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					g.Expect(shoot.Status.LastMaintenance).NotTo(BeNil())
+					g.Expect(shoot.Status.LastMaintenance.Description).To(ContainSubstring("Control Plane: Updated Kubernetes version from \"0.0.1\" to \"0.0.5\". Reason: Kubernetes version expired - force update required"))
+					g.Expect(shoot.Status.LastMaintenance.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+					return shoot.Spec.Kubernetes.Version
+				}).Should(Equal(testKubernetesVersionHighestPatchLowMinor.Version))
+			})
+
 			It("Kubernetes version should be updated: force update minor version(>= v1.27) and set EnableStaticTokenKubeconfig value to false", func() {
 				shoot126.Spec.Kubernetes.Version = "1.26.0"
 				shoot126.Spec.Kubernetes.EnableStaticTokenKubeconfig = ptr.To(true)